@@ -29,17 +29,17 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o#9r\xef\xfe\x15\x05\xe7a\xee\x00K\xbe\xc1\xbd\x04~\x9b\xf5x\x11a7sƎ\xcfy\b\xf2@u\x97$\x9e\xd9d\x87d\xcbV\x82\xfc\xf7\xa0\x8ad\u007f\xa8?5\xeb\xbb\xc5⦟\xec\x16Y,\xd6w\x15\x8b}\xb5Z\xad\xaeD)\x9f\xd1:i\xf4\x1d\x88R\xe2\x9bGM\xff\xb9\xf5˿\xba\xb54\xb7Ǐ[\xf4\xe2\xe3Ջ\xd4\xf9\x1d\xdcWΛ\xe2\x17t\xa6\xb2\x19~Ɲ\xd4\xd2K\xa3\xaf\n\xf4\"\x17^\xdc]\x01\b\xad\x8d\x17\xf4\xdaѿ\x00\x99\xd1\xde\x1a\xa5Ю\xf6\xa8\xd7/\xd5\x16\xb7\x95T9Z^!\xad\u007f\xfc\xd3\xfa\xcf\xeb?]\x01d\x16y\xfa\x93,\xd0yQ\x94w\xa0+\xa5\xae\x00\xb4(\xf0\x0e\xb6\"{\xa9J\xb7>\xa2Bk\xd6\xd2\\\xb9\x123ZkoMU\xdeA\xf3C\x98\x12\xf1\b{\xf8\x81g\xf3\v%\x9d\xff\xa9\xf5\xf2g\xe9<\xffP\xaa\xca\nU\xaf\xc4\xef\x9c\xd4\xfbJ\t\x9b\xde^\x01\x94\x16\x1d\xda#\xfeU\xbfh\xf3\xaa\u007f\x94\xa8rw\a;\xa1\x1c^\x01\xb8̔x\a_\b\x83Rd\x98_\x01\x1c\x85\x929\xef.\xe0dJԟ\x1e7\xcf\u007f\xfe\x9a\x1d\xb0\x10\xe1%@\x8e.\xb3\xb2\xe4q\x119\x90\x0e\x04<\xf3\xd6\xc0F\x16\x80?\b\x0f\x16\x19\x13\xed\x1d\xf8\x03B&J_Y\x04\xb3\x83\x9f\xaa-Z\x8d\x1e]\x04\f\x90\xa9\xcay\xb4\xe0\xbc\xf0\b\u0083\x80\xd2H\xedAj\xf0\xb2@\xf8ç\xc7\r\x98\xed\xdf0\xf3\x0e\x84\xceA8g2)<\xe6p4\xaa*0\xcc\xfd\xe3:\xc2,\xad)\xd1z\x99\xe8LOK\xb0\xeawg\xdb\xfa@\xfb\x0ec 'Q\u0080~\x14\b\xcc\xc11Mh\x1f\xfe ]\xb3M\xa6_\v,\xd0\x10\xa1#\xd2k\xf8JL\xb1\x0e\xdc\xc1T*'\xf9;\xa2%2ef\xaf\xe5\xffԐ\x1dx\xc3K*\xe11\xb2>=R{\xb4Z(\xe2X\x857L\x88B\x9c\xc0\"\xad\x01\x95nA\xe3!n\r\xffn,\x82\xd4;s\a\a\xefKww{\xbb\x97>\xa9Rf\x8a\xa2\xd2ҟnY!\xe4\xb6\xf2ƺ\xdb\x1c\x8f\xa8n\x9dܯ\x84\xcd\x0e\xd2cF̻\x15\xa5\\1\xe2\x9a5i]\xe4\xff\x92\x98\xee>\xb40\xf5'\x921\xe7\xad\xd4\xfb\xfa5K\xfa(\xddI\xe4\x834\x85i\x01\xff\x86\xbc\xf4\x8a\xa8\xf2\xcb\xc3ק\xb6\xa4Iץ9S\xbb%|\r\xe1\x89PR\xef\xd0\x06\xc6\xed\xac)\x18\"\xea<\xc8\x1a\x8b\xa9\x92\xa8\xbbDwն\x90\x9e8\xfd\xdf\x15:\x12g\xb3\x86{6(\xb0E\xa8ʜ\xa4p\r\x1b\r\xf7\xa2@u/\x1c\xfe\xdd\xc9N\x14v+\"\xe9<\xe1\xdbv\xb0;0P\xab~\x9d,\xd6 \x87\x82\xc2\u007f-1\xeb(\x06͑;\x99\xb1\xf8\xc3\xce\xd8\xc6\x1e\x04\x93\xb4n\x01\x1cRʰ\xd0NT\xca?\xb3\"\xbb'\xf3\v:/\xb3\xee\x983t>\x0fNI蠃\xd7\x03\xfa\x03Z\x92\x15\xfe\x81\xd5\xee\f\"0\x03\x1d\xe6\xacs\xe2\x05AD\xacYy\x95\x82\xd2$\xfb\xe2`{J\x88\xae\xcf\xe0\x04jn\x8dQ(\xba6\x00\xdf2U\xe5\x98\xd7\xf6\xd6M\xee\xea\xa17\x9c\x1d\x95\x90\x9a4\x83\\\x03!\xa6\x9b_\xd9\xd4\n\x8b\xbd\x8d\x91tJ\x1d\xa0\xb1\x15=\xe0\x00C\xe8\x91\x1e\x8b\x1eV#\xa2\x14aWJ\x89\xad\xc2;\xf0\xb6:_:\xcc\x13֊\xd3 %\x92\xa3^F\x88zt\xb4\rJf\xecCj\v\xc0\xb4\xf8\x1d\x91\xe1`\xcc\xcb\xf4\xd6\xff\x8dF4\x16\f2\x8eo`\x8b\aq\x94\xc6\xc6\xcdF7\xb2E\xc07\xcc*\x8f}\xd9\x16\x1er\xb9ۡ%(\xe5A8t\xc1m\x8d\x91`L=\xe9\xb1cl\xeb\xe1߰LX\f\xfb\x1dC\x99\x94T32}ꆇB\f\x9dˣ\xcc+\xa1@j\xe7\x85\xce\xc2>D\x8d\xd3\xf9>`\x9c\x9d=l\x83YK8\x13\xed;&\xceh\x04c\xa1 k\xde\x1f\xea\x06\xe1\xc3\xe8v\xb7\x82l\x8d\tbh+\x85..\x94\xb3\xe5l\xf4\xfaf\x04pͅ\xe0\xfb\x95آ\x02\x87\n3o\xec\x10\x19\xa6\x99\x1a\x9ey\x1b5B\xbb\x01k\xd5\xd8_\xdab\xdbP\x99Q\x98\x00\xaf\a\x99\x1d\x82[&ya(\x90\x1bt\xac\xbf\xa2,\xd5ixs0\xcd\xe9\xf0L\xa8p\xf3L*\xf39\xac\xbeZ7Ϭ\x9dk\x9e\x19\x8bץe\xcd\xfa\u007f\x1eR&\xc3}\xb1`nz\x13\xdfS0\x89\x88\x92B\xeb\xcd\x0e\xb0(\xfd\xe9\x06\xa4Oo)\x92\x10\x9c\x17\x8e\x92\xa7^\xfbwǈKezs>\xef\x1de\xfaWr\xa1^\xfaw\xc3\x046\xf6_\xa3\xad_Ȁ\x9f\xdbsn@\xeej\x06\xe47\xb0\x93ʣ=\xe3\xc4\xd4v\xcd4'~-\t\xe6=\x15=\x85\xf0\xd9\xe1።#הs\x16Q\xe3|j\x88)ST\xddu\xa6\x93P\x81\x93Ai\xb1\b)\xe6\x13S\xb0yÑϧ/\x9f1\x1f'\n,\x91\xb0\xde\x16>\x9d\xa1\xd9^6\x86\xc8\xcb6\x10\x83\x94:\xbb\b\xe5\x82\x1b\x10\xf0\x82\xa7\x10]\b\r\xc4\x10A\xcb\xd0\xe0Y\x88\x16\xb9f\xc1\x02\xf5\x82'\x06\x12\xcb\x103s\x97\xb1></x\x9a\x1ftF6\xc2F\xbaXV!\xfa\xd1\v&\x00\xe7\xb0KI\x06\\DJ\x16fnS\xb0\xd4D\xa4'Q\xfb\xe2\xed\xd5lj\x15ݘ\x91\x1f\\`\nI\xfbA\x96\x8b6H\xa6\x13\x1c\xb2N\xa4\"ҳP2\xaf\x97\t\xf2\xbd\xd17\xf0\xc5\xf8\x8d\x1e\vV\xbb\xcfÛt\xb1v\xf7٠\xfbb<\xbfyw\"\x06\x94/&a\x98\xc6*\xa4\x83\x19\xa6\xfd\xb7kQ\xb3B\x1c\x9eMȰj\x96H\a\x1bM9D\xa0U\xa8&\x86Ŧ\xac}\xf7)*\xc7\xc5&m\xf4\x8a\x9d\xddzh\x9dH⅂\xdc\xe6B\x1f\xadzɰ\xdc\"\x88O\xe4\x17\xc2\xecP\x19U\"\xc3\x1c\xf2\x8a\x89ȕ=\xe1q/3(\xd0\xee\xc7\x1dA\xfb)\xc9f/Y~\x91-\r\xcfE\xf2\xb4\xc45\xa7'\x1a\xe3|\x0e\x8d\x15\xe9\xe6\xec\x98\xc4ڙ\x81\x83\xa5\xbc\xf1\x81s\xfb`'\xc9q\xc3\f5E\x9e\xf39\x8bP\x8f\x8b\xad\xf7b\xca\xf7\xfdv@)\xf8\xb8Bp\x81\xee\u007f\xc9U\xb1\xd0\xfe\x1f\x94B\xdaY\r\xfd\xc4\a&\n;3cU\xa8\xbd\b\xc1\x97\x0e\x88\x9bG\xa1\xce\v\xc2\x03\xdb2d5P\x057lv\xbdH\xe3\x06^\x0f\xc6\x05\xaf\xb8\x93\xa8r\x90S\x91\x16=\xd7/x\xba\xbe\xe9\xe9\xf8\xf5F_\a\xf7\xdc\xd3\xd8\xe4\xcbg\x00\x1b\xadNp\xcd3\xaf\xbf=tY$u\v\x06\xf1\xe9ٲ`\x96\xb2\xb9\xe4\xc5iZ}\x06C\xa1\xe88\xb6\vd\xae4\xce/D\xe2\xd18\x1f*t\x9d\xe0q\xa064\x9d\xd3Ě\x10\x88]8\xf726\x9dp\x90!;+U\x12\x97\x1c\x0e\x168{\x10\xf3\bR(\x05\u05cd\x8e\x06\xfbx\x1d\x8e=x\t\x91qX0\x01\x91D\xa1\xb4&C\xe7\xa6\xc4a\xd6\xf2\xce\x14\xdc\xeab\x9b\bIE8D\x98*\xee\xa5gi\xd8H\xa4\xb9(\xcc~xk\xd5\x00I\xb5\xe9\xffi1\xbb\f#\xe0#\xe8\xa2\x10z\xd6Y\xf4\x90\xbb\x0f\xf3\x92*D0!d\xb7\xfb\x8a\xd5xi\xa4\x17\x85\xe6\xb7u\xb0\x85\xd4\x1b\x06\x0e\x1f\xdf\xd5\x1dC2\x89xyH}\x9ff6d\xae_\x04\xdd,M\xbf\xe4>\xf4\xbc\x1e\xd0b\x87S\xfd\xca0\x87s\xda\xf8Vz\xbe\x8c\xd0\x01\x8f\x0f\x0ev\xd2:\xdfF\xd2\xf1\xc1\xd6\xfb\xe7(\xfa\xc1\xdaoHQ\xfe\x12\xe6\xb5\n@\a\xf3\x9aN\nG\x0e\xe7\x86\x1e>\x06A\x90;\x90\x1ePg\xa6\xd2\\\xc4 %\xe5\x05\x02I\x831\x9du\xb2\xe1Y\xa2\xd8\xf4\xa0\xae\x8a%\x1b_\xb1\xf4H=Q\xebh\x0f\xfeQȩJUz.b\x93\x97\x05\x9aj©5O\x87MOa^爷\x10o\xb2\xa8\n\x10\x05\x11{\x11E\xc93\xcb\x02\xbb\xfc\x85W!=[w\x82ʦ\xde\x1bR\x8aR\xa1_\x96\rlqg,뢓9\xd6.3\xf2\xdch\x10\xb0\x13RUv\x91E\xbb\x80\xa2\xcb#\xfb\xa8\xe4\xef\x13\xb4/Yv\xc5۟-S.\nզ\xacji\x97\x06j\x8f\x16\xdf3D*\xad$\x991\xef\x1b%EQ\x12\xfa\xf4=Lj\xd1\xe6{\x98\xd4{\xbe\x87I\x9d\xe7{\x98\xf4=L\x9a|\xbe\x87I\xdfä\u007f\xd60i\x1a\x93\x15\u05ed\x06\u007f\x9aY}\xf6\bu\x1c\xb1Q\xc8\xf1T\xff>\xf4^/\xeb\xcb\xdb\f\xcf\x19軌-\xdd+n8\xef\xf3\xb99\xfao\xcc|ݨG\u009f\x8474\x96N\xb6\xee-h\xc4\x1b\xea͜o/\x99k*\xe9\xf6$֍\x1d\xa9)Ѥ%z\xbbO\x9d\xec\x14f\xb6;\x18\x84R\xed\xde\x14a\x1b\xa2\xfcF\xfd\x8a\xb3\xad\x1f3\r\x1f\xd3m\x9b\xe3\x14:\v\xed\xbb$\xb2\x9d\x16\xc3ߘB\x93}\x19\xe3\xdd\x18\xf1$\x03\xbd8~\\w\u007f\xf1&\xf6f\xc0\xab\xf4\x87\xde\x06\xb8i\x92R\x16\xbdo7G&\x99\x8a\xd7\a\xce)\aƂ\x96\xeaf\xb0/\xa6\xbeY\xd1&'\xfc\xa5\fI\xd1E\xfa6\x15\xda/\xe9\xdd\xf8掍nOƠ\x91\xbd\xec\xb0ci\v\xe9\xf2\x9e\x8cn\xcfň\x93YЉqq\xa7\xc5|\xbe5\xd9U\xf1\r\xbd\x14\xa9Ob\xca\xe1NtP,\x889\xe6\xbb%\xbe\xa9G\x82\x0f\xf3&\xb0\xbe\xa83\xa2\xd5\xf50\x01rY?\xc4\x02\x92\xcc\xf5>\\\xdc\xf1p\xdee0\xb1\x89\xb9>\x87\xf1\x1e\x86\t\xa0\x83\xdd\rK:\x17&`\xd6=\r\xefد0ӥ\xf0>\x9d\x84\xbf6\xf6\x1c\xeb9\x98\xe94\x98\x89L\xa7\xb0\x9a\xe9%X\xdeA0C\x9fo\xec\x16\xa8\xfb\x01\x06\u05fc\xb4G\xa0\xdb\x050\brag\xc0\xc8\xd9\xff \xc8\x05\xfd\x003'\xfe\x83`'\x1d\xe3\x84D\x8c\xfedl\x8ev\"\x8c\\&\v\x13r\xd0-\xa3\x9c\xadv\xd6w\x9c\xeexѨvXڧ\x85\xa9;f3\xf8I\xea<\x90\x8fx\xdfr\x83|w\x91;\x12j?\xdc\x04*C \xcf\xc2`\x87\xa5\xb0|\x95u{\n\x89\xb1[Ã\xc8\x0e݁p\x10\x8eR\xa3b\xa0\x15\xf3\xba\xce\x1an\xd3\x1czs\xbd\x06\xf8\xd1\xd4\xc9X\xfb\xfe\x88\x93E\xa9NP9\x84\xeb\xee\x94˃\xe2\x01~;-Jw0\xe9\x82\xded\\\xfc\xb5;v \x99L\xd7\xf32e\xaa\xbc\x86=\xc8.\xa1O\xf0\xf8\xccN\x9d\xaf>e\xcdů\xe8\xbaS\xb0{~/\xec\x87\xf7L.\x9d7V\xec\xf1g\x93\xb5.V\x8f\xed\xbf;\xb6s\r6*q*\u193e\x17\x91\xeecv\xa7\x0e\xe5\n\xb1\xaa\x1ae\xbeɶ\tþ~\x8fj\x98\xf7jr\x13OO?\aĽ,p\xfd\xb9\n\x89\xfb\xaa\x14\xd6!\xd1/m(L\xdaҟ\a\xf3\xdaCX\x99\xb8\xd3\x1f\xce\xf1\xb5\xc85[\xae\x0e,\xc6:\xdc\xddL\x02\x96\xc84-\x8e\xcf\xc3sZ\xb9G\x8b)A\x83\xcdnlVo\x83\xad\x8b\xeb\x94݅\x0e\xa6\xf7\xba\x928쌇/\xfbz\xe1+7wݗ\a\xa5\xcb\xfb\xb1\xc2_Y\xbeQ\x18\x00\x04a\xbc\xf8\xc6o,gv>\xa80œ\xfb\xfex\xbe:o\xf3\x80\x14\x97Q\xeb˻\xaf\xc2\xd5\x05\xd3\x01\x0f\xd6\x00\v\xf38\xf8#X\x98\x03\x1eQ\x83\xd1\\\x1f\xe5\x1b{\xe1\xb3\x0e\xe7s\xfa\xf5\x8a\x16\x8cX~\xadJeD\x9e47\xf9\x9c\xf89\x80'\xb6G\xf6\x88\xf6\x83\x1b\x85\xc8W\x93w\xc6\x0em\xff\\\xb2\x82c\xb8\x83\\x\\\r\x00\\`\xc7\x06D\x8a\x0f\vf\xae\xea\xf2\x90\xa0\x1d|ΐ\xeeN\x87\x83\x86\x02\x9d\x13\xfbtG\xf7\x95\xcc\xd1\x1e5\xb2\xbf\xeb\xed9\x86\xdeM\xa1\xba{_5d\xf0\"\xf3\x95\x88\xe0Sɢ5\xeaC_\xe7\x94\xd9\xc3N*\x1e\x18\xbf\x10\x10\xed\xf3\xb0!\x91\xda\xe3\x1e\xbb\xe10\xbe\x95\xd2\xce\xdb\xf2\x87z\x18Q\x84K5\xac\xe1\xcd\xf72Pɽ$\x83H\x8c\xdd\v\xbb\x15{\\eFQ\xde,\x8d>\xc7\xe8\xef\xc3\xd7\x00u\xe0k\x18\xbd\r\xfd\xd8\x1e\x99\"\x9e(\xcc\x01J\xfa8\xc6M\xf4\xa8\xc4\xc1B\xfc\xcd\xd8\xfe\xe1\\!\xb5\xb1!\\\xe5\x94)M]l\xcf\xf9\x1a\xf3$\xbe\x8f4\xa2>\x8dl\xd9*L\xc24\xec\xe7\x87N\xadV\xf0\x05\xcf]T8\x88\xc2\xfc\xb9\xfehJo\xc0F?Z\xb3\xa7\b\xbf\xf7\xd3}\xb2J\xbd_\x1e\x85\xf5R(u\n\xe0GV\xed\xbd\xfe\x8cd\x17F\x1c\xc1\x10\x01#f\xd34\x8c\x83\x9a\x14B\xea\xc0k><ښ\xcaw\x14\xaeQ\xd8\x1e\xc7\xd3zk\xf8b<\xa6:\x91\xecB$\x0f\x88ίp\xb73և|e\xb5\x02\xb9\x8b\x8e\xa5\a\x95\xac3W:\xc3\xd77@\xfa&kod\x93cA\x8b±lz\xfe\x02\b\x1f3\x88,\xa3\xf8\x04o\x9d\x17\xaag\x03\xbe\xb9\xbc\xc9\xfe\x9a\xa4\v\xf3\xbf\xf6\xdcY\x8fț\xf6躯\xb9*\xb6!'a`\x81^|\x94\x1b\xac\x9e\x1aN᷈\x1a^\xad\xf4\x9e\xecM\xbb\x00\f\x9e,\x8cR\xe0\f\xec\xc4\xe0\x1d\xf1q\x9bǿ\x1a/\xd4f\xac\x80\xd1\r\x01\xeb\xa1i;<\xb9\xbf)Cl\xd8\xf2\xd6\a\xb7\x13\x9ay\xa4K3\x89q\xd9A\xe8=\t\x905\xd5\xfe\x90$p\xc4S\f\x17m+B\bJU\xedI\xa4c!\xd5WV\xb7\xb2\xcfXZ\xcd[\xa8\x8a\xec\x05\xaar\xb8\xd3 |\x1b(~\xd9\xe96\xde\xfd^\xed\xac)V\x91\xfe\\#\xbd\x89\x99\xa1\x95\x86B&\xcei\xe2\xf5\xcb\x11\xb0\xcc\xf6\xb2D\r\xc2E\\f\xfb\x8c\xa6\x189\x9e\xa8ya\xfd\xb2 \xeckg\xe8L\xfc\xc5p1_\xc3ט\xdd\xf6\x95ؚ\x02\xeeϿ\xabE\x99\xa9N\x1f\x91\n\xb9t`\xbd\xa3\xb0\xcc\"\xa7-\xe1\xcee\x0fb'\xa0\xea\x04P]\xd4\xff1\xb1S\xf3Y\xad\x87\xf9(\xea\xf9l\xf0\xd9\xc1\x19ip\x03/\xc5>\u007f\x90\xbb~~Q\x96Jf\x84\xed\x1f\u007f\xa3\x03\xb1エ\xe2\xc3d@\xc1\xd1C\x1d\x1b\xc0g,-f\xa4\x95}\xe4\x1f\x15\x92\xbfw\x88\xddH\xe5\xc3\xe2\xc0\xae\x9b\"\xbaO\xdecQ\x0e\xac5\x91#6\x93\xc6\f\x9fH\x03z\x1bH_/K\xa0b\xe7\xc7hR\xb8x#u\xa8q\xc9F\xeaIc\x1bqUF\x06hW\r\xb9\xa2:\xe7z\xc7]\xbd\nK\x89\xf6\xb4\xf6\xfcG\x1c4\x90\x85\xc4\xf9\uf6c7\xb4Ґ\x84\xdf?(\x11\x19\xb0\xe3g\xaf\x9ao\x17~l\xfec\xf2\xad\xe2\xb7\n\x8f\xa1\u007f\x90\xade\xdeR\xed\x88J|\xd3\x14\bD\x96!\xc9\xee\x97\xf3\xcf\x16^_\xf3?\xe9˄\xfcoft\xf0\xa5\xee\x0e\xfe\xf3\xbf\xae ֙\x9e\x13\x1e\xf4\xf2\xff\x03\x00\x00\xff\xff\x9c\x9f\xb0p\xe7Q\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYKs\xe3\xb8\x11\xbe\xebWt\xcd\x1e|\x19Q3\xd9KJ\x97\x94F\xceVM\xe2\x19\xbbF^琤j!\xa2)!\x02\x01\x06\x0fi\x95T\xfe{\xaa\xf1\x10)\x92\x92\xec\xad\xdd\xe5\xc5&\xd9\x04\xbe\xfe\xfa\rM\xa6\xd3\xe9\x845\xe2\x05\x8d\x15Ź5\x02\u007fv\xa8\xe8\xce\x16\xbb?\xdaB\xe8\xd9\xfe\xe3\x1a\x1d\xfb8\xd9\t\xc5\xe7\xb0\xf4\xd6\xe9\xfa\x1bZ\xedM\x89\xf7X\t%\x9c\xd0jR\xa3c\x9c96\x9f\x000\xa5\xb4c\xf4\xd8\xd2-@\xa9\x953ZJ4\xd3\r\xaab\xe7\u05f8\xf6Br4a\x87\xbc\xff\xfeC\xf1}\xf1a\x02P\x1a\f\x9f?\x8b\x1a\xadcu3\a奜\x00(V\xe3\x1c֬\xdc\xf9\xc6:m\xd8\x06\xa5.\xe3^\xc5\x1e%\x1a]\b=\xb1\r\x96\x01\t\xe7\x01\x1e\x93OF(\x87f\xa9\xa5\xaf#\xac)\xfce\xf5\xf8\xf5\x89\xb9\xed\x1c\n\xeb\x98\xf3\xb6h\xb6\xccb\x80\xccіF4.\x00\xfb\x14\xf6\x83U\xdc\x10\x1eҎ\x10\xbf\x02\xeb\xcb-0\v\x8b=\x13\x92\xad%\xce~T,\xff\x1fV\x8b\xb0\x9fN\xab\xbbc\x83s\xb0\xce\b\xb5\xb9\x00E2\xeb^\x98\x14\xfc\xc4\xc4\x10\xd7\xc3@\x06\x84\x05\xb7E\xa0\xaf\xc1\xd1\x03\xba\x8b|\x01\x11\x86\x90\xf9\x82\x03\xb3aI\x80}\\\x03y\a,\xad\r/g/\"j\xba\xefc\xce\xd6/\x06\x96문\xd8\xe0\x8de\xc8l\x05Ǌy\xe9\x86\xda\xde\xc7\x17]m\xc8\x1aY\x9f\xceN\xf7\x9d%\xe2nk\xad%2\x92\xd9\x18\xed\x9b9\xb4\xbe\x12?J\x9e\x1a\xbd<\xda;\x99\xfb\xa1\xbb\xbe\x14\xd6\xfd\xf5\xb2̃\xb0q\xd7Fz\xc3\xe4%O\r\"v\xab\x8d\xfb\xdan=\x85\xb5\x95\xf1\x8dP\x1b/\x99\xb9\xf0\xf9\x04\xa01h\xd1\xec\xf1G\xb5S\xfa\xa0~\x10(\xb9\x9dC\xc5dp0[jR:,ް2\x98\xcf\xfa\xb5Ia\x9b6\x8c\x8e6\x87\xff\xfeorr\x01\":\xbc\xd4\r\xaa\xc5\xd3\xe7\x97\xefW\xe5\x16k6O\x9e2\x12\x16=\n\xc8\x03Y\xc7ɶh\x10^\x02\xdb\xd1\x01m\xd2*\xad\b\xa0\xd7\xff\xc2\xd2e_l\x8cn\xd08\x91Q\xd2\xd5IR\xa7g=,w\x046\xca\x00\xa7\xb4\x841\x10RrA\x0e6(\x02\xba\x02\xb7\x15\x16\f\x06\x12\x95k\x8d{\x02T\x01S\tV\x01+\"\xdaX\xb2\x97\x97\x9cr\xd9\x1e\x8d\x03\x83\xa5\xde(\xf1\x9f\xd3\xca\x16\x9cN\xb1\xe70\xb9A\xbeB\xeeQL\x12\xcd\x1e\xdf\x03S\x1cjv\x04\x83\xb4\ax\xd5Y-\x88\xd8\x02\xbeP\xb0\nU\xe99l\x9dk\xec|6\xdb\b\x97\xd3r\xa9\xeb\xda+Ꮃ\x90\\\xc5\xda;m\xec\x8c\xe3\x1e\xe5͔̊\x99r+\x1c\x96\xce\x1b\x9c\xb1FL\x03p\x153eͿ;9\xc3]\ai//\xc5+\xc4\xc4E\xde)\x1a\xa2\xcd\xe3g\x11\u007fK/=\"V\xbe\xfdy\xf5\fy\xd3`\x82s\xce\x03\xdb\xedg\xb6%\x9e\x88\x12\xaaB\x13\rW\x19]\x87\x15Q\xf1F\v\xe5\xc2M)\x05\xaasҭ_\xd7\u0091\xa5\xff\xed\xd1:\xb2O\x01\xcbP\x9c`\x8d\xe0\x9b\x90\xd9\n\xf8\xac`\xc9j\x94Kf\xf17\xa7\x9d\x18\xb6S\xa2\xf46\xf1ݚz.\x18\xd9:=\xce\xe5n\xd4B\xa3Q\xbaj\xb0<\x8b\x13\x8eV\x18\xf2e\xc7\x1c\x86\bHA{F\xe9\xe5\xc4x9xC\x00\x97%Z\xfbEs<\u007fރ\xba8\x89\x9dak\xd0\xd4\u0086\xae\x04*m\xfa%\x8d\xa5\xbaҽr\xfe)zoP\xf9\xba\x0fa\nߐ\xf1G%\x8f\xa3/\xfef\x84\xebo0j.\xba\"\xac\xd5Q\x95Oh\x84\xe6W\xd5\xfd\xd4\x13>)\xbd\xd5\a\xa8\x82\xdb*'\x8f\x94W\xecQ\x95\xfd\xbc\x99\xaf\xc5\xd3\xe7\x9cCcp\xa4XJ\xdc\x14\xb0H1\xa9+\xf8\x00\\XjKlX\xb2O\x0fuY\xf4v\x0e\xce\xf8W+]jU\x89M_\xd5n\xef5\xee\x15W\x17\xedq\xb5\f{P\xa2!\x0fh\x8c\xde\v\x8efJ\x9e/*Q&\f\xdeĪS\x85\x82\xd8\xd7n4v\x82\x02\x069\xc5(\x93W\xed\xb5<\x89\x85\x8e\x96\t\x15\xfd\xb3\xfd<$\x0eS\xa7B\xa8\x1c*\x9ez\xa73\x1c:\xe4\x1f\x8b\x1c\x0e\xc2mcZ\x93\xc3h\x82+\x11E\xd7\x0e\x8fÇ=\xcc\xcf[$\xb9X\xf6\x10,\x96\x06]\xf0(\x94\xe4$\xe40\x05\xc0\x17oCRd\xb1\x11\x18Y\x15\xf2\xb7;<\xf6\x89\xbda\xc8Ԗ݂zG\xfdJ\x06j\xb0B\x83ʍ&d\x1a \x8cB\x87!'s]ZJ\xc7%6\xce\xce\xf4\x1e\xcd^\xe0av\xd0f'\xd4fJ\x14OS|\xccB\xab7\xfb.\xfc\x19U\xf2\xf9\xf1\xfeq\x0e\v\xceA\xbb-\x1a\xb2R\xe5ev\xa8N'\xf2>\xd4\xc5\xf7\xe0\x05\xff\xd3\xdd[\xf9\xd0M\x8c\x8c\x9b\x9c\xac\x82\u007f\x1f\xa9\x8d\np\x88\x9aU\xb4\x836@Ս\x8c['\xeb\xc5\xfc1f\xbd~\x17ܽ(\xd1P\xee\x1f\xe6\xc5\x1d\xf6S\xe2\xc5\x10J]\xfb\xd5\xf8\xc9\r\xbcP\\\x94\xd4$\x9d{~\x9e]\xf8h\x9f\xff\xea\x14\u007fY\xd5\b;U\xaf\xabH\x1f\xbb\x92\xed\xb8\x17\x93M\xaaJ\x16\x1d57\x16\x14R\xd5b\xa6\xcfU\b\xf4R+Eq\xe64\xb0Sں\xb3\xfd\x1c\xfd\x86\xa8_\xfbr\x87\x03\xa2\a*|\nb\x99\xd3\xf8\x11\xa1\xf0\x16C\n\xbd\x0e\x00nypɖhn\xa3X.H\xecT\xd8\x18,\x17\xb0\xf6\x8aK\xccX\x0e[Tԥ\x8b\xeaH\xad\xe2\xf3\xc3j4.\x13\x8f\xa1\aH}vfs\f{\xcc\xc2sX\x1f\a\xb5\xfb\xa6j\x8d\xc1J\xfc|S\xb5\xa7 \x96\tn\x98ۂPVpJ\xa2C\xbaG\x9a\xa9|\x9d\xea\xf4c\xca\no4\xc6\xe5\xf8\x8d0^\x1b\u0099ϫ\x91\xf1\x94\x84Nz\xe7\xfb\x94\xb7σv<6G\xb4h\xc7\xcf\x1fb\xdfS\x0eJ\xdb\x19\x8c\x97\xa1\xfc\x95\xee)\x9fo\f\x03\x94\xaa\xb76\x06m\xa3\x15'\xff{]\xef\xd4\xc2\xfd5:\xa81\x03Nϳ\xd5ٛ\xcc\xf9ͱ \x0e\xf8o\x1b\f\xe2\x91V\xb7\xfd\xd6\xebp\xd6Й\r~\xe31\xe0]g\x0e\xa0\xc9R\x81W\xa1[\nU\xb8\x80\u007f(\xb8\xa79\x91j\b\x9f\x13F\xea\x10\x86\xf5\\\xe9\x03}\xdcY-,\x00Z\xc5:J3\x10M\xe2q\xac\f\xaf\x0eBJ\xaa\xa4\x06k\xbd\x1f\xa9\xa4\xd4\xe6\x19\x94G`\x96\x88\xd8\xff\xa1\xf8P\xbc\xfb\x9dg\fɬ\xa3\xa1\x01\xf97܋\xfe\xa9Ȑ͇\x81|\x0eޓk\xd3\xcdOyܜ\x99$\xf6\xd3@\xfdJH\xea\xc5F\"\xbd\xad\xe2\xc3\xe3\xc7O\xab\x87;\x1bZf\x1a\xec\a\x8b\x1e\xc8|6\x00\xa4\x9eY\xa7y\xde[\x87f\xc4\xd8'[\t\vJ\x83\xd4js\x16\n\xf1J\xd3=uI\xd1u\xb4\x01\x8e4\x98S\x94\x97[\xa66؞\xd8$\xec\x1d\x94\xe4\x18C\xa4\xe7\xde\xd1z\x83P\xe3\xae\xf0\n\x1b>\x8bak<8\xe4mE\xc7\x0fxO\xa8\x93-/\f\x137\xb8\xeeI\xe7\x1aJDN]>\x80n\xaf_6,\x0eϵoj\xff\x8b\x8f\xb8\x87\xea3\xdb\x1ev\xff\xfe\xba\x87\x9f\x17\xae\x97W\x92\xc8\x1a\x96\xde\xd0\b\xd4\xe6\xdd\x10Lc\xb9\xf7u\xc7\x1c\x8b\xb3\xdf$\xbao\xfa\xbfW\xdc\xd4e\xa4\xde\xf4\x1e\xb5\xbf\xea|l\xef\xd2\x0f/\xf1\x94>\xbc\xa0\xb1\x92\x8aK\x87ȔQғ\xb6\x88Q\xf5h\x1c\xf2\xaf\xfd\xe3\xfaw1\xec\xf2\x99{\xb8-\xa9\x9eǟ\xa0\xe0\xef\xff\x9c\xc4U\x91\xbfd\x1c\xf4\xf0\xff\x01\x00\x00\xff\xff\xed\x93\x00\x8d\x01\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96\xcdn\xe46\f\x80\xef~\nb{\xd8Kǳ\xc1^\n\xdf\xda\xec\x16\b\xda\x06A\xb2ͥ\xe8A#q\xc6ldI%\xa9Iӧ/$ۙ\x9f8\xc8\xf6\xb0\xbe\x89\xa2\xf8\xf3\x91\x94լV\xab\xc6$\xbaG\x16\x8a\xa1\x03\x93\b\xffQ\fe%\xed\xc3\x0f\xd2R\\\xef/6\xa8\xe6\xa2y\xa0\xe0:\xb8̢q\xb8E\x89\x99-~\xc2-\x05R\x8a\xa1\x19P\x8d3j\xba\x06\xc0\x84\x10\xd5\x14\xb1\x94%\x80\x8dA9z\x8f\xbc\xdaah\x1f\xf2\x067\x99\xbcC\xae\x1ef\xff\xfb\x0f\xed\xc7\xf6C\x03`\x19\xeb\xf1/4\xa0\xa8\x19R\a!{\xdf\x00\x043`\a\x0e=*n\x8c}ȉ\xf1\uf322\xd2\xee\xd1#ǖb#\tmq\xbc\xe3\x98S\a\x87\x8d\xf1\xfc\x14ԘЧj\xea\xa7j\xeav4Uw=\x89\xfe\xf2\x9aƯ4i%\x9f\xd9\xf8倪\x82P\xd8eoxQ\xa5\x01H\x8c\x82\xbc\xc7\xdf\xc3C\x88\x8f\xe1gB賈\xad\xf1\x82\r\x80ؘ\xb0\x83\xeb\x12u2\x16]\x03\xb07\x9e\\\xc53\xe6\x11\x13\x86\x1fo\xae\xee?\xde\xd9\x1e\a3\n\x01\x1c\x8aeJUo)\a \x01\x03S$\xa0q\n\x10b@\x88\fCd\x841Zi'\x93\x89cBV\x9a\t\x96\xef\xa8\u007f\x9eeg\xceߗ\xe8F\x1dp\xa5cP@{\x84\xa9\xee\xe8@j\xe4\x10\xb7\xa0=\t0V,a\xec\xa1#\xb3PTL\x80\xb8\xf9\v\xad\xb6pWб\x80\xf41{W\xdal\x8f\xac\xc0h\xe3.пϖ\xa5\xe4W\\z\xa3s\x81珂\"\a\xe3\v\u05cc߃\t\x0e\x06\xf3\x04\x8c\xc5\a\xe4pd\xad\xaaH\v\xbf\x158\x14\xb6\xb1\x83^5I\xb7^\xefH牱q\x18r }Z\u05fe\xa7M\xd6Ȳv\xb8G\xbf\x16ڭ\f۞\x14\xadfƵI\xb4\xaa\x81\x87:0\xed\xe0\xbe\xe3i\xbc\xe4\xfdQ\xa4\xfaT:A\x94)\xec\x9eŵ\x87_\xe5^\xfaw,\xf3xl\x8c\xff\x80\xb7\x88\n\x95\xdb\xcfw_`vZKpʼ\xd2>\x1c\x93\x03\xf8\x02\x8a\xc2\x16y,ܖ\xe3P-bp)Rк\xb0\x9e0\x9cB\x97\xbc\x19Hen\xbfR\x9f\x16.\xeb\xbd\x01\x1b\x84\x9c\x9cQt-\\\x05\xb84\x03\xfaK#\xf8ͱ\x17²*H\xdf\x06\u007f|ݝ*\x8e\xb4\x9e\xc5\xf3]\xb4X\xa1\x85\xb1\xbcKhK\xcd\n\xb8r\x96\xb6d\xeb\x18\xc062<\xf6d\xfby,O\x88>\x0fp{$^\x1a\xd8\xf2\x8d\x06ʭr*\u007f%Y\xa8u\"Ɠ^[\x1d\x99y\x93\x82\x1a\xcd\xf2\xbf8\xd4\x133\t\x9b\x991\xe8d\xa7\xde\x02K\x87\xbe&wd\x8e,\xe7y\x9f\x84\xf3\xb9\xaaԿ\x96\xa1 `\xc2\xd3t\f\xb47\n\x8fȥ\xc5m\xcc\xe5\xee@\a.\x9f\xf1\x9aP\xf48\x16\xa5\x94/q\xb4(Ҟi\x91\xe2\xf0\"\x9aW\xebP\xbe\xf2'4\x1b\x8f\x1d(g\\\xac\x9fa6O';\xa97\xf2\xa2\xd8'I\xdf\x14\x8d%\xde8\xde\xcb\xf8\x16\xf0\n7\xe4\xe1\xdc\xcb\n\xae\xf1\xf1\x85\xec*\xdcp\xdc1\x8a\xbcغ\x19I՟\xddW0Yh\xb83\xd1\xe1\x81qqXU\xe8\xab\xe9AQ7\x00\xea\xaf\xd8\x1d\x81\x15\x8dlv3\xeaC\x17\x1bk1)\xba\xeb\xf3\xe7Ļw'\uf0ba\xb418\x1a_C\xf0ǟ\xcdh\x15\xdd\xfd\x1cG\x11\xfe\x17\x00\x00\xff\xff\"\xf7\xf4 \x8c\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WOoܶ\x13\xbd\xebS\f\xf2;\xe4W \xd2&ȥЭuR h\x1a\x04\xb6\xe3K\xd1\x03E\xceJ\xac)\x92\xe5\x90\xeb\xb8E\xbf{1\xa4\xb4\u007f\xb4Z;=to\x1a\x0e\x87\x8f\x8f\xef\r\xb9U]ו\xf0\xfa\x0e\x03ig[\x10^\xe3\u05c8\x96\xbf\xa8\xb9\xff\x9e\x1a\xed6\xbb7\x1dF\xf1\xa6\xba\xd7V\xb5p\x95(\xba\xf1\x1aɥ \xf1\x1dn\xb5\xd5Q;[\x8d\x18\x85\x12Q\xb4\x15\x80\xb0\xd6E\xc1a\xe2O\x00\xe9l\f\xce\x18\fu\x8f\xb6\xb9O\x1dvI\x1b\x85!\xaf0\xaf\xbf{ݼm^W\x002`\x9e~\xabG\xa4(F߂M\xc6T\x00V\x8c\u0602r\x0f\xd68\xa1\x02\xfe\x91\x90\"5;4\x18\\\xa3]E\x1e%/\xda\a\x97|\v\x87\x812w\x02T6\xf3n*s]\xca\xe4\x11\xa3)\xfe\xbc6\xfaQO\x19ޤ \xcc9\x88<H\xda\xf6Ɉp6\\\x01\xf8\x80\x84a\x87_\xec\xbdu\x0f\xf6'\x8dFQ\v[a\b+\x00\x92\xcec\v\x9f\x18\xa5\x17\x12\x15\xc7R\x17&\xae'\xe4\x14EL\xd4\xc2_\u007fW\x00;a\xb4\xcaL\x95A\xe7\xd1\xfe\xf0\xf9\xc3\xdd\xdb\x1b9\xe0(J\x10@!ɠ}\xce[n\v4\x81\x80\t$D\xb7\xc7\r\u0082\bQo\x85\x8c\xb0\rn\x84N\xc8\xfb䧚\x00\xae\xfb\x1de\x04\x8a.\x88\x1e_\x01%9\x80\xe0j%\x11\x8c\xeba\xab\r6\xd3\x14\x1f\x9c\xc7\x10\xf5\xbc\x15\xfe\x1d\xc9o\x1f[\x00~\xc9;*9\xa0XpH\x10\a\x84I6\xa8\x80\xf2n\xc1m!\x0e\x9a `f\xda\x16\t\x1e\x95\x05N\x11vB\xde\xc0\r\x9fF \xa0\xc1%\xa3X\xa5;\f\x11\x02J\xd7[\xfd\xe7\xbe21/\xbc\xa4\x11q\xd6\xc9\xfc\xd36b\xb0\xc2\xf0Y$|\x05\xc2*\x18\xc5#\x04\xcc\xec${T-\xa7P\x03\xbf\xb8\x80\xa0\xedֵ0\xc4\xe8\xa9\xddlz\x1dg\xc3I7\x8e\xc9\xea\xf8\xb8ɶ\xd1]\x8a.\xd0F\xe1\x0e͆t_\x8b \a\x1dQ\xc6\x14p#\xbc\xae3p\x9b\xfd\u058c\xea\u007f{ż<B\x1a\x1fY\\\x14\x83\xb6\xfd>\x9cmp\x91w\xb6A\x91G\x99V\xf0\x1f\xe8\xe5\x10\xb3r\xfd\xfe\xe6\x16\xe6E\xf3\x11\x9cr^t\xb2\x9fF\a\xe2\x99(m\xb7\x18\xca\xc1e\x95qE\xb4\xca;mc\xfe\x90F\xa3=%\x9dR7\xeaH\xb3l\xf9|\x1a\xb8\xcam\a:\x84䕈\xa8\x1a\xf8`\xe1J\x8ch\xae\x04\xe1\u007fN;3L5S\xfa<\xf1\xc7\xdd\xf24\xb1\xb0\xb5\x0f\xcf\xedl\xf5\x84\x16V\xbe\xf1(\xf9\xbc\x984\x9e\xa7\xb7Zf\v\xc0\xd6\x05\x10\agO\xb45Gu\u05fc\x99A\x89\xd0c<\x8d-P\xdc\xe6\x14^\xf8a\x10\xa7-\xe4\xff\xd8\xf4\r\xf7\x01\x9a \x94\xce\xf0]\xb3\xa8wi\xf55\x8d\xaeb\x98\xa5\xca[g\x1e\xd9\xe8\xdcz\x8e\xd1,\x17\xe5\x1f\xda4\xae\x15\xaf\xe1ǌ\xf4\xa3\xeb\x9f\x18\xbdr6\xb2\xa0\x9fH\xb9s&\x8dxc\x85\xa7\xc1=\x999ߩ\xfb{f\x99v\x8d\xdcj\xf1\x12\xa4i\xf8\x1a)\x99ՅV\x858\xff\xf2\xbd\xfa\x1c\xcb|5\xcd,\xf3\x84\xd2q\x11\xf8>\x0f\x16#ҡ\r<\xe88\xc0à\xe5\xb0R\x15\xf2\xb4|@\xdc_\x88\x9c\xd4ٱ\xff\x0e6\xebX\a<\x93G\x9dEs\x16d\xc8\xd5Z\xf1\x85\xe7\xd6\vד\x17\x9eul\xb9\xa0\xbfճ9{&U\xa6\x10\xd0ƩF\xbe\xad\x96\x13\xbeŴ\xb3\xe2\xbf\\\u007f|ҹ\xef\x0ey\xf9\x89&\xb4-8|\xc0\x9at\xcfw+\x8f\xb1w\xb3\xb3\x96\x04\x94\xdf\xf1\x1d\xff\xec\xa9\xe1W\xaf\xc3ѓ\xe5\x02\xb4\xf7\xfb\xb4\xd2XЖ+b\xf9z\xc9\xe5\x90\xf2\xb5+\x85=\xc3\xd6!(4\x18QA\xf7X:\xe3#E\x1c\x97x\xb7.\x8c\"\xb6\xc0\x17G\x1d\xf5\x99P\xf8\xf9):\x83-Đ\xd6U\xb4\xb2Y?\b:\xb3\xd5\xc9>?s\xc6\xda\xf1\xef\xcd\xf5\xc4\xf9Å\x0eV\xc3'|8\x8b}\x0eN\"\x11.\x8dq\x01\xfd\x8a\xb8\x17\xa1û\xfd\xcd\xe1+K\xb1\x9e\xde\xe9y\x00 \xbfz\xd5\x11uӛq\x8a\x1c\x1c#\xa4D\x1fQ}Z\xbe\xd4_\xbc8yz\xe7O\xe9\xac\xd2\xe5O\x06\xfc\xfa[U\xaa\xa2\xba\x9bqp\xf0\x9f\x00\x00\x00\xff\xff]]l+\xe3\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y\xdfs۸\xf1\u007f\xd7_\xb1\x93{\xf0\xf7fB\xea\x92\xfbN\xa7\xa3\xb7\x8b\xddt\xdc\xde9\x9eȗ\x97L\x1eV\xc4JD\r\x02(\x16\x94\xa2v\xfa\xbfw\x16 %Q\xa2e\xf9\xdaK\xf9b\x13\\,\xf6\xf7~\x16\x9a\x14E1A\xaf?Q`\xed\xec\f\xd0k\xfa\x1a\xc9\xca\x1b\x97\x8f\u007f\xe4R\xbb\xe9\xfa͂\"\xbe\x99<j\xabfp\xddrt\xcdGb׆\x8anh\xa9\xad\x8e\xda\xd9IC\x11\x15F\x9cM\x00\xd0Z\x17Q\x96Y^\x01*gcp\xc6P(Vd\xcb\xc7vA\x8bV\x1bE!\x9dП\xbf\xfe\xa1\xfc\xb1\xfca\x02P\x05J\xdb\x1ftC\x1c\xb1\xf13\xb0\xad1\x13\x00\x8b\r\xcd\xc0;\xb5v\xa6mh\x81\xd5c\xeb\xb9\\\x93\xa1\xe0J\xed&쩒CW\xc1\xb5~\x06\xfb\x0fyo'PV\xe6ީO\x89ͻ\xc4&}1\x9a\xe3_Ǿ\xfe\xac9&\noڀ\xe6T\x88\xf4\x91\xb5]\xb5\x06\xc3\xc9\xe7\t\x80\x0f\xc4\x14\xd6\xf4\xab}\xb4nc\xdfk2\x8ag\xb0D\xc34\x01\xe0\xcay\x9a\xc1\x9dH\xe9\xb1\"5\x01X\xa3\xd1*\x99\"\xcb\xed<ٟ\xeeo?\xfd8\xafjj0/\ng\xe7)Dݫ'ρcwk\x00\x8a\xb8\n\xda'\x8ep%\xac2\r(q%1Ě\xa0s\b)\xe0t\f\xb8%\xc4Z3\x04J:\xd8\xec\xdc\x03\xb6 $h\xc1-\xfeFU,a.z\x06\x06\xae]k\x94\xf8\u007fM!B\xa0ʭ\xac\xfeǎ3Ct\xe9H\x83\x91:\xfb\xf6\x8f\xb6\x91\x82E#Fh\xe95\xa0U\xd0\xe0\x16\x02\xc9\x19\xd0\xda\x03n\x89\x84K\xf8\xc5\x05\x02m\x97n\x06u\x8c\x9eg\xd3\xe9J\xc7>\x94+\xd74\xad\xd5q;M\x01\xa9\x17mt\x81\xa7\x8a\xd6d\xa6\xacW\x05\x86\xaa֑\xaa\xd8\x06\x9a\xa2\xd7E\x12ܦH.\x1b\xf5]\xe8➯\x0e$\x8d[q\x1bǠ\xedj\xb7\x9c\x02\xecI\xbbK\x80\x81f\xc0n[\x96\u007fo^Y\x12\xab|\xfc\xd3\xfc\x01\xfaC\x93\v\x866O\xd6\xdeo\xe3\xbd\xe1\xc5P\xda.)d\xc7-\x83k\x12G\xb2\xca;mcz\xa9\x8c&;4:\xb7\x8bFG\xf1\xf4\xdf[\xe2(\xfe)\xe1:%4,\bZ\xaf0\x92*\xe1\xd6\xc256d\xae\x91\xe9w7\xbbX\x98\v1\xe9\xf3\x86?\xacCC\xc2l\xad\xddr_(F=t\x94\xfbsO\x95\xf8K\x8c&\xfb\xf4RW)\x05`\xe9\x02\xe01yy\xc0v,5\xe5\xc9Ua\x1e]\xc0\x15\xfd쪃$\u007fB\xa6wc;z\xa9\xa4\xb6\xe54\xa5\x8e5p\xa6<b\t`\xfa\xad\x9b\x9a\x02\xa5\x1d\x818\xeaJ\x02ɱ\x8e.l\x85\xad\xec'U\x1e\xed\x1f5\xba<\xd6):+\xff\x9dS4&\xael\x84Xc\x8e\xc9{\x972#\xb4\xd6J\x168{\xb1\x00ީ\xb3\xe7w\x9c\x11\x02-)\x90\x95\x8c\xca\xc5ǻT\xa2\"j\xdbg^.\xdd\x10݉\xf9\x16\xd9\xc0\xa4`\xe8\xe8sΆ'\xeb\xf1\xa8\xa4?\xdd\xdf\xf65\xb87R's<>\xf1\xacE\xe4YJ\x97\xb9\xc7X?{\xea\xd5\xed2\x1f\x93*Rt\x80\xe05U4(\xed\xa0-GB\x95\x17GX\x02H\xe2\x06\xea\xe8_\xe7\xfaӕ\xb9};\x10[\x03\xe6\xfe\x06\u007f\x99\u007f\xb8\x9b\xfe\xd9eYGybU\x11\v\x1b\x8cԐ\x8d\xaf\x81۪\x06dQA\aRs\xf9R6h\xf5\x928\x96\xdd\t\x14\xf8\xf3\xdb/c6\x03x\xef\x02\xd0Wl\xbc\xa1נ\xb3\x95w\x05\xb5\x0f\x10\xcd\xd9\x10;~\xb0ѱ\xd6㊣\x04R\xa7\xf0&)\x1a\xf1\x91\xc0u\x8a\xb6\x04F?\xd2\f^I\t9\x10\xf1\x9f\x92\r\xffz5\xca\xf3\xffr\x92\xbe\x12\x92WY\xb0]\xcf<L\xa2\xbd\x809\x93\x82^\xad(и5S#\x90\x02\xfb=\xb8 \xba[w\xc0 \xb1\x15\x9f\xe5BG\xeaD\xe0\xcfo\xbf<!\xed\xd0N\xa0\xad\xa2\xaf\xf0\x16\xb4\xcdV\xf1N}_\xc2C\x8a\x88\xad\x8d\xf8UΩj\xc7d\xc1Y\xb3\x1d\x97\xd6A\x8dk\x02v\r\xc1\x86\x8c)2VQ\xb0\xc1\xad\xe8\u07fbK\"\f\xc1c\x88C42\xca\xf5\xe1\xc3͇Y\x96JBh\x95*\xa9t\xb9\xa5\x16\xcc!`#wN\x89\xc9d\x8e6\aGtP\xd5hG\n+$В\xac\xbbl\xa5\x97\x95W/\xcd\xd6c\xd8\xd0?#\xf0\xe1\xb80\xfc\x8f\x9a\xf0Ej%\xd4\xfe\xacZw\a\xf1|V-\x99\x1f\x82\xa5HI3\xe5*\x16\xa5*\xf2\x91\xa7nMa\xadi3ݸ\xf0\xa8\xed\xaa\x90@,r$\xf04\x8d\x00\xd3\xefҟߤEB早\x92H\xbf\x85>r\x0eO_\xacN\x8f+/\xedJW\xf3\x0e\xf9\x1c\uf514\xd8Ժ\xaa\xfb!a_=Gs\xa4A\x95K.\xda\xed\xef\x1e\xb6b\xc86\x88<ۢ\x1bC\v\xb4J\xfeg\xcdQ\xd6_l\xb9V_\x90\xa4\xbf\xde\xde|\x9b`n\xf5\x8b3r\x14\x10\xe7\x98\xf0\xeeV\x89\xf9\x96\x9a\xc2Y8\xf5q@\xda\x03\xbb\x11$\xb9\xa3\xb9\x18\xc9E\\\x9d\x00(T*]4\xa0\xb9?\x03\xb2\xce\xe8<\x10\xfe\x01W\f\x18\b\x10\x1a\xf4\xe2\xa7G\xda\x16\xb9I{\xd4\xd2c\xa5\x8dvxeA\x80\xde\x1b=\xd2N\xbbV\xdc\xc1\xc5\x0ey\xcbX\x8b+\x1e\xd7w\xc4\xeay\xf7Yk\xe7\xf1b\f>wGg\\\xb2\x83\xd0\xd1\xed\x81\xeai\xfc\x9e\x00\xd7'\xec&S\xa0\xa0\xabCъ\xf1\xd1e@!\x90~\xb0\xe0\x9d\x1a\xbc\x0f\xe3l\xf0)\xeb\xf3\xec\xf4\x161\xb6|\xf1\xfc\x96\xa8{\xeb\xe5z\x10;\x1e\t+\xfc\x96\t\xaer\x82\x1d\x87\xd7T\xe7\\x}J\x9f.D\x82\xcabE\xddH<v1\xb4A\xeeO8\x1d\xc2\xe0\x80Yޗ\xea\xae\xf0\"\x95\xa0\x9d\xa0\xce%jC\n\xfa;\xb2\xe3='<\x0fy,h)\xa5\xaa\xf5ơꇢN\xb4\xfe\x92\xe7A\xa6\xe1t\xdfp\xc5Orl\x99T\x9a\x92G\xd4?n\x0fK\x17\x1a\x8c3P\x18\xa9\x18ah[cpah\x061\xb4\xc7\x1f\x9fL\xfd\x86\x98qu>\xbd~\xc94y>\xec6\x00.\\\x1bw\x03\xe2 ů\xb8\x8b\x9e˧ӑ\x11l\x18\xb2(\x80\x99;\xf8hL\xdaq\x98\xd6\xfbK\xd4$ς\xc4-\xffi\x86\x03\xf8\x1a\xf9\xbcq\xee\x85b,yv5\xe8L\xf6@\x9a\b\xdb\xe6\xf8\x84\x02\xeehs\xb2vk\xef\x83[\x05\xe2\xe3\xd0(\xfa\xf89Q\xb6\x80\xf7)\xce/ַ;\xe0\xbc\xca\x1d\x11\xd4\xce\xf4\xe9\xe9\"\x1a\xb0m\xb3\xa0 z/\xb6\x91xX\x84Og\xfe4E\xec\x8dv\xb0\xbb\xbfB\xc8|\xba\xa1\xa8B\x9bn\xd9$g\xa2\x03\xa5\xd9\x1b<\x9d\x8az\x15\x12\x92\x90\x94\x91\x94\xdeGk\x9f\xa6\x9eB\xfa\xf4\x92[\x8a$͍\xb3\xa3\x18\xb7\xcfOm\xe3\x1f\xfe\xffIġm\xa4ՠ\xa8w_ŀ\xef\x84\xff\u007f\x9b\xf7\x93\x8d\x95-z\xae]\xbc\xbd9\xeb\xed\xf9\x8e\xac\x8f\xf2=hI\xb5+\xdd\xfbuD\xbdˇ--?9\f.N=\x8e\x18\xe2e\xcdc> }\xa6o$\xbe\xa4J\x98\x93ǀ\xf140\xd3}\xf0\xf5\xf1\xaf,\xaf\x81u\xba\x16\x13\xec\x93\xc1P\x1euYډ@;\x17r\xac\x9er\x1c4\x82A\xe1\x1f\x8a\xfe-j\xfeH<\x1c-\xed\u007frz\xb3\u007fKqYt?1\xa5\x0f\x9dZ\xea\xe0\xf0\xeeV\xb5[\xd9\xc3\x10\xac\x04\xb2\x93\xba;\xfe\x91\xe9U\xbe*\xe9\u007f5J\xaf\x95\xb3\x19\xcd\xf2\f>\u007f\x99@w\xd7\xfa\xa9\x97C\x16\xff\x1d\x00\x00\xff\xff\x81\x16-\x05\x9e\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s۸\x11\u007fק\xd8\xf1=\xb87\x13R\x97\\\xa7\xd3\xd1\u06dd\xddt\xdc\xde9\x9eȗ\x97L\x1e b)\xa2&\x01\x16\xbb\x90\xacv\xfa\xdd;\v\x90\x92(Q\xb2\x9c\xe9\xa5zI\b,\x16\xbf\xfd\xed\x1f,\xe0I\x96e\x13՚O\xe8\xc98;\x03\xd5\x1a|f\xb4\xf2E\xf9ӟ)7n\xbaz\xbb@Vo'O\xc6\xea\x19\xdc\x04b\xd7|Dr\xc1\x17x\x8b\xa5\xb1\x86\x8d\xb3\x93\x06Yi\xc5j6\x01P\xd6:V2L\xf2\tP8\xcb\xde\xd55\xfal\x896\u007f\n\v\\\x04Sk\xf4q\x87~\xff\xd5\x0f\xf9\x8f\xf9\x0f\x13\x80\xc2c\\\xfeh\x1a$VM;\x03\x1b\xeaz\x02`U\x833h\x9d^\xb9:4\xe8\x91\xd8y\xa4|\x855z\x97\x1b7\xa1\x16\v\xd9u\xe9]hg\xb0\x9bH\x8b;Dɚ\a\xa7?E=\x1f\x93\x9e8U\x1b⿏N\xffb\x88\xa3H[\a\xaf\xea\x11\x1cq\x96\x8c]\x86Z\xf9\xe3\xf9\t@\xeb\x91Я\xf07\xfbd\xddھ7Xk\x9aA\xa9j\x92i*\\\x8b3\xb8\x17\xa4\xad*PO\x00V\xaa6:\U00091c3b\x16\xedO\x0fw\x9f~\x9c\x17\x156*\r\x8afעgӛ(\xbf=\xefn\xc7\x004R\xe1M\x1b5µ\xa8J2\xa0şH\xc0\x15B\xe7\x15\xd4@q\x1bp%pe\b<F\x1bl\xf2\xf0\x9eZ\x10\x11e\xc1-\xfe\x81\x05\xe70\x17;=\x01U.\xd4Z\x82`\x85\x9e\xc1c\xe1\x96\xd6\xfck\xab\x99\x80]ܲV\x8c\x1d\xc3\xfd\xcfXFoU-$\x04|\x03\xcajh\xd4\x06<\xca\x1e\x10잶(B9\xfc\xea<\x82\xb1\xa5\x9bA\xc5\xdc\xd2l:]\x1a\xee\xe3\xb9pM\x13\xac\xe1\xcd4F\xa5Y\x04v\x9e\xa6\x1aWXO\xc9,3\xe5\x8b\xca0\x16\x1c<NUk\xb2\b\xdc\xc6p\xce\x1b\xfd\x9d\uf09f\xae\xf7\x90\xf2F\xdcF\xec\x8d]n\x87c\x90\x9d\xe4]b\f\f\x81\xea\x96%\xfc;zeHX\xf9\xf8\x97\xf9#\xf4\x9bF\x17\f9\x8fl\xef\x96юx!\xca\xd8\x12}r\\\xe9]\x135\xa2խ3\x96\xe3GQ\x1b\xb4C\xd2),\x1a\xc3\xe2\xe9\u007f\x06$\x16\xff\xe4p\x13\xb3\x1a\x16\b\xa1ՊQ\xe7pg\xe1F5X\xdf(\xc2ߝva\x982\xa1\xf4e\xe2\xf7\x8b\xd1P0\xb1\xb5\x1d\xee\x8bŨ\x87\x0e\xd3\u007f\xdeb!\x0e\x13\xd6d\xa1)M\x11s\x00J\xe7A\x1d\xc9\xe7{\x8aǒS~\vU<\x85v\xceΫ%\xfe⊽4?\x81\xea\xe7\xb1\x15=,\xa9p)Q\xb1S\r\x94$\x0fT\x02\xd4\xfd\xd2u\x85\x1e\xe3\n\xa9R\xa6\x90Prd\xd8\xf9\x8d\xa8\x8d\xa6\xe8\xfc`\xfd(\xed\xd1P\xa7\xcf\xc2\u007fp]\xd0{,ѣ\x95\x90N\xd9ߺX#X\x19ۇ~*\x9e\xc0\xee\b\xfd\"\xa1\x1d\x83v\x8aj8Y\x0fG\x81\xfe\xf4p\xd7\xd7\xc0\x9e\xd1\x0e2\x1f\xeex\x96\x10\xf9\x95R\xe5\x1f\x14W/\xeez}W\xa6mbE`\a\nZ\x83\x05\x0eJ+\x18K\x8cJ\xa7\xc1\x11\x95\x00\x928\x1e;\xf97)\xff\xbb2\xb3+\xc7B5\xa8t\xbe\xc0\xdf\xe6\x1f\xee\xa7\u007fu\t\xeb\xa8NU\x14H\xa2F16h\xf9\rP(*P$&\x18\x8fz.3y\xa3\xac)\x918\xefv@O\x9f\xdf}\x19\xe3\f\xe0\xbd\xf3\x80Ϫik|\x03&\xb1\xbc-h}|\x18JDl\xf5\xc1\xdape\xc6\rW\x12G\x9d\xc1\xebh(\xab'\x04\xd7\x19\x1a\x10j\xf3\x843\xb8\x92\fރ\xf8oI\x9d\xff\\\x8d\xea\xfcCJ\x91+\x11\xb9J\xc0\xb6g\xd6~\xc6\xed\x00r\xa5\x18؛\xe5\x12=\x8e\xb3\x19\v\xb1\x14\xb8\xef\xc1y\xb1ݺ=\x05Q\xad\xf8,\xd5\x19\xd4G\x80?\xbf\xfbr\x02\xed\x90'0V\xe33\xbc\x03c\x13+\xad\xd3\xdf\xe7\xf0\x18#bcY=\xcb>E\xe5\b-8[o\xc6\xd1:\xa8\xd4\n\x81\\\x83\xb0ƺ\xceR\xaf\xa0a\xad6b\u007f\xef.\x890\x05\xad\xf2<\xec\x06F\xb5>~\xb8\xfd0K\xa8$\x84\x96\xb1\x8e\xc9)S\x1a9\xf3\xe5\xb0O'\x97\xc4d\xa4#\xa4\xe0`\aE\xa5\xecHY\x83\xd84Dv\xcb gI~\xfd\xdal=<\xb6\xfb\xdf\xc8\xf1}X\x18\xfeO\x87\xe0Ef\xc5\xd6\xf9E\xb3\xee\xf7\xe2\xf9\xacY\xd2\xc4{\x8b\x8c\xd12\xed\n\x12\xa3\nl\x99\xa6n\x85~ep=];\xffd\xec2\x93@\xccR$\xd04\xb6\xe1\xd3\xef\xe2?_eE\xec\x8c/3%\x8a~\v{d\x1f\x9a\xbeڜ\xbe\xaf\xbb\xf4T\xba\x9ew\x8d\xc7\xe1JI\x89ue\x8a\xaao\xd2w\xd5s4G\x1a\xa5S\xc9Uv\U000fb1ed\x10\x19\xbc\xe0\xd9d\xdd]0SV\xcb\xff\xc9\x10\xcb\xf8\xab\x99\v\xe6\x82$\xfd\xed\xee\xf6\xdb\x04s0\xaf\xce\xc8ц4\xc5D\xeb\xee\xb4\xd0W\x1a\xf4g\xbb\xa9\x8f\x03Ѿ\v\x1c\xe9\xe3\xb62\x177rdUK\x95\xe3\xbb۳\b\xe6[\xb1~\xf7\x1d\xe5]\xfb\xd6k\x92\x10=ӷ\x9dD\x92ԜE\x91\xfa\xee\xb1.\xb8Ð:\x868\"\x1d\xe8W!\x91됴9\xfbH\xb2\xf1\x0e~ \xd1:=\xf8\x1e\xfaw0\xb5#}0\x9c\x8cx\xf12Ê\x03]~\x9d\x89\xe2=g)?\xb9S\x12\xcf\uebfa\xd0\x14N\x9a\xb9\xe1\xe3\xcd9\xcf\xdd\x1c\xcb\xc7\x17\x02\xaf\x13.6\r\xc6\xdbBD\x00kE\xfd\x16\xc7~\x83=mia\xac\x84\xa2\ful\xb6\xa4\x0f,\x95\xa9Q\xc3\xf6\xe9\b\x1e\xe5>\x17\xaf\xcc\xd7ǵ\xb2W\x13\bu\xbc\xe7\x8d\x00>\\U:\xdf(\x9e\x81\\\x933Qp0oC]\xabE\x8d3`\x1f\x0e'O\xa6A\x83Djy>\x0f~M2\xe9\x86\xd5-\x00\xb5p\x81\xb7W\xac.!:\xf3\xaf\xa9\xf3\xf8\xe5\x17\xbcJ\xd1y\x10\x0f\"1\x16Wۤ<\x17X\x10o/\xa19\xdc\"\x83{\\\x1f\x8d\xdd\xd9\a\xef\x96\x1e\xe9\xd0\aY﨣\xf6;\x83\xf71\x02.6\xb8\xdb\xe0\xbc͝\x10T\xae\xee#ױ\xaa\xc1\x86f\x81^\f_l\x18\xa9g\xa0O\xf4\xe3\x1bj\xecyw\xbc\xed\xd6\xf7\xd5*)\xea:\xf8B\xd9\xf8$#\xd1\xc9\x0e\xb4\xa1\xb6V\xc7-|oC<\xf6$8%Cvq\xd1g\x97\xa4t\x9c{͝:¹uv\xb4#\xebS\xc1X\xfe\xd3\x1fO\x9e\x8f\xc62.\a\xa5\xb0\x9b\x15\n\u007f\x16\xfd\xffk\xdd'\x0f_b\xe5\xf9\xb2\xd25\x1f\x88\xbeT\xb5\xa2ⱚ\xb5_~\x8e\xcb\xcdp\x93oQiF\xa89\x18\xda=ؿ\xdd}E\x17e\xdd\x03}\x9c\x80d\x96\xdeۼ{\x8c\xeaFv\a\x96*\xa4\xd7B}\u007f\xf8B\u007fu5xp\x8f\x9f\x85\xb3ڤ\xbf.\xc0\xe7/\x13螨>\xf58d\xf0\xbf\x01\x00\x00\xff\xff\x98\xaaEc\xdc\x18\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xcdn\xe36\x10\xbe\xfb)\x06\xdb\xc3^*y\x83\xbd\x14\xba\xb5i\x17\b\x9a\x04\vg\x9bK\xd1\x03E\x8d\xeci(\x92\xe5\f\x9d\xbaO_\x90\x92lٖ\xbd\xc1\x02\xab\x1b\x87Ùo\xbe\xf9!\xb5(\x8ab\xa1<=c`r\xb6\x02\xe5\t\xff\x15\xb4i\xc5\xe5\xcbO\\\x92[noj\x14u\xb3x!\xdbTp\x1bY\\\xb7Bv1h\xfc\x15[\xb2$\xe4\xec\xa2CQ\x8d\x12U-\x00\x94\xb5NT\x12sZ\x02hg%8c0\x14k\xb4\xe5K\xac\xb1\x8ed\x1a\f\xd9\xc3\xe8\u007f\xfb\xa1\xfcX~X\x00\xe8\x80\xf9\xf8\x17\xea\x90Eu\xbe\x02\x1b\x8dY\x00X\xd5a\x05\x01YH\a\xf4\x8eI\\ \xe4r\x8b\x06\x83+\xc9-أNn\xd7\xc1E_\xc1a\xa3?=@\xea\xc3YeC\xab\xd1\xd0.o\x19b\xf9}v\xfb\x9eX\xb2\x8a71(3\a$o3\xd9u4*\x9c)$\a> c\xd8\xe2\x1f\xf6źW\xfb\x89\xd04\\A\xab\f\xe3\x02\x80\xb5\xf3X\xc1c\x82\xea\x95\xc6f\x01\xb0U\x86\x9a\xccH\x0f\xdey\xb4?\u007f\xbe{\xfe\xf8\xa47ة^\x98,;\x8fAh\x8c1}\x93\xfc\xeee\x00\r\xb2\x0e\xe4\xb3Ex\x9fL\xf5:Ф\x8c\"\x83l\x10\x86\xbc`\x03\x9c݀kA6\xc4\x100\xc7`\xfb\x1cO\xccBRQ\x16\\\xfd7j)\xe1)\xc5\x19\x18x\xe3\xa2iR\x19l1\b\x04\xd4nm\u9ffde\x06q٥Q\x82\x03\xc5\xe3GV0Xe\x12\t\x11\u007f\x04e\x1b\xe8\xd4\x0e\x02&\x1f\x10\xed\xc4ZV\xe1\x12\x1e\\@ ۺ\n6\"\x9e\xab\xe5rM2V\xb4v]\x17-\xc9n\x99\xeb\x92\xea(.\xf0\xb2\xc1-\x9a%ӺPAoHPK\f\xb8T\x9e\x8a\f\xdc\xe6\x82.\xbb\xe6\x870\x94?\xbf\x9f \x95]J\x1bK \xbbދs\x95]\xe4=\x15\x19\x10\x83\x1a\x8e\xf5\xf8\x0f\xf4&Qbe\xf5\xdb\xd3\x17\x18\x9d\xe6\x14\x1cs\x9e\xd9>\x1c\xe3\x03\xf1\x89(\xb2-\x86>qmp]\xb6\x88\xb6\xf1\x8e\xac\xe4\x856\x84\xf6\x98t\x8euG\x922\xfdOD\x96\x94\x9f\x12ns_C\x8d\x10}\xa3\x04\x9b\x12\xee,ܪ\x0eͭb\xfc\xee\xb4'\x86\xb9H\x94~\x9d\xf8\xe98:V\xec\xd9ڋ\xc7i1\x9b\xa1\xd3\xfe\u007f\xf2\xa8S\xc2\x12k\xe9 \xb5\xa4s\x0f@\xeb\x02\xa83\xfdrbx\xae9\xd3W+\xfd\x12\xfd\x93\xb8\xa0\xd6x\xef\xf4\xa4\xcd/\xa0\xfae\xee\xc4\b+\x8d\xb8\xbeQq^\xf1\xc42\x80l\x94L:T\x14\xd9}\x9b\xcf\xc4q\x91\xf2L\xbbJ\xedj\x95\xd5\xf8)\u05ceջ\xab\xb1<\xcc\x1cH\xa1l\xdc+\xb8V\xd0NM\x8e(k<\v\"D\xfbf\x90\xfdL\xbekRi\xb5\x84\xe1*\xc0Չ\xf2\xc8s\x1b\x8d\x19,\x15\xdau^\t\xd5\x06\xc7Fn]8\x83H\xbd\x8d]\xdf\xd5\xdf\xc6\xef֙\xd8\xe1\xfen\xb8\x8a\xfc\xf9XwZ \xbd`\x00\x91B\x80p|\x05N\xbf\xa1&\x18\xbck\x06\x00C\xd1r\x8a\xf3\x8d\xd8Sr)\xe0\xd14,\xe6\x8b\xffHc\xae\xa2\x8e\x14N\xb3y\xb4y\xc2\xd7W\x87\x81(\x89\xfc\xf6q\x90\xd5Gbu\f\x01\xad\fF\xf2M\xf8M\x03\xc1(\x96I[\xa47\xd0\xd5<ߟ돐\x92)\x90$\x98vѫ\xe2\xb9~i]\xe8\x94T\x90F{\x91\x0e\x9d\xec\xa7\x17\x98\xaa\rV !\x9en^\x9e\bȬ\xd6\xd7#x\xe8u\xfa\xabp8\x00\xaavQ.\x10\x9b/\xc5+\xd4^E\xe47\x8a\xaf\xe3\xf9\x9c4\xe6Ҋou\x8e6v\xa7.\nx\xc4\xd73\xd9\nUs\xdas\x05<:\x99۸\x10\xd3L-\x9f\x88\x0eO\xec\x9b\xc3*\xd7]1<\xa9\xf3\x06@~\x996\x93\x14sߛ\x83\xe4\xd0 Jk\xf4\x82\xcd\xe3\xe9\x93\xfaݻ\xa3\x17r^jg\x1b\xea\xff\a\xe0Ͽ\x16\xbdUl\x9eG\x1cI\xf8\u007f\x00\x00\x00\xff\xfflC\xbf\xee\x8e\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfbs\xdc6\x92\xf0\xef\xf9+P\xda\xd4'\xe9\xa2\x19\xd9\xfb\xaa]\xd7֗\xd2\xdarV\x95XVY\x8a\U000e5cb9\x1c\x86\xec\x99\xc1\x89\x04\xb8\x008\xd2\xec\xe5\xfe\xf7\xaf\xd0\x00\xf8\x98\xe1<\x00J\xb2\x92\x10Wu\x1b\x8d\xc9&\xd0h\xf4\xbb\x1b\xb4`\x1fA*&\xf8+B\v\x06\xf7\x1a\xb8\xf9K\x8do\xff\xa2\xc6L\x9c.^N@ӗ\x9f\xdd2\x9e\xbe\"\xafK\xa5E\xfe\x01\x94(e\x02o`\xca8\xd3L\xf0\xcfr\xd04\xa5\x9a\xbe\xfa\x8c\x10ʹ\xd0\xd4\xfc\xac̟\x84$\x82k)\xb2\f\xe4h\x06||[N`R\xb2,\x05\x89_\xf0\xdf_\xbc\x18\xffa\xfc\xe23B\x12\t\xf8\xfa\r\xcbAi\x9a\x17\xaf\b/\xb3\xec3B8\xcd\xe1\x15\x91\xa0\xb4\x90\xa0\xc6\v\xc8@\x8a1\x13\x9f\xa9\x02\x12\xf3\xb1\x99\x14e\xf1\x8a\xd4\xff`\xdfq\x13\xb1\x8b\xf8`_\xc7_2\xa6\xf4\xd7\xcd_\xbfaJ\xe3\xbf\x14Y)iV\u007f\f\u007fT\x8c\xcfʌ\xca\xea\xe7\xcf\b)$(\x90\v\xf8\x96\xdfrq\xc7\xdf2\xc8R\xf5\x8aLi\xa6\xcc?\xabD\x14\xf0\x8a\\\x9aY\x144\x81\xf43B\x164c).\xd1\xceK\x14\xc0Ϯ.>\xfe\xe1:\x99CN폄\xa4\xa0\x12\xc9\n|\xceϏ0E(\xf9\x88\xeb3\x93\xc0\x8d zN5\x91\x80S\xe1Z\x11=\aB\x8b\"c\t~\x85\x88\xa9\x03I\xaaw\x14\x99J\x91װ&4\xb9-\v\xa2\x05\xa1DS9\x03M\xbe.' 9hP$\xc9J\xa5A\x8e\x1d\x98B\x8a\x02\xa4f\x1e\xb1f4H\xa9\xfame\r\x87f\x91\xf6\x19\x92\x1a\xe2\x01;UG\x02\x90\x12\x85\b bJ\xf4\x9c\xa9zI\xb8\x8c\x06Xb\x1e\xa1\x9c\x88\xc9\u007fC\xa2\xc7\xe4\xda\xec\x80TD\xcdE\x99\xa5\x86\xe2\x16 \rJ\x121\xe3\xec\xdf\x15de\x16h>\x99Q\rn\xa7\xfd`\\\x83\xe443\xdbS\xc2\t\xa1<%9]\x12\t\xe6\x1b\xa4\xe4\rh\xf8\x88\x1a\x93w\xb8%|*^\x91\xb9օzuz:c\xda\x1f\x9eD\xe4yə^\x9e\xe2\x11`\x93R\v\xa9NSX@v\xaa\xd8lDe2g\x1a\x12]J8\xa5\x05\x1b\xe1\xc49\x9e\x9dq\x9e\xfe\xaeڬ\xc3\xc6L\xf5\xd2\x10\x94Ғ\xf1Y\xf53\x92\xf6F\xbc\x1b\x12\xb7\x94c_\xb3\xf3\xaf\xd1k~2X\xf9p~}Ӥ*\xa6\xda8Gl7\b\xadF\xbcA\x14\xe3S\x90v㐶\fD\xe0i!\x18\xd7\xf8G\x921\xe0m\xa4\xabr\x923mv\xfa_%(C\xbabL^#\v!\x13 e\x91R\r\xe9\x98\\p\xf2\x9a搽\xa6\n\x1e\x1d\xed\x06\xc3jdP\xba\x1b\xf1M\xce\xd7~\xd0b\xab\xfaٳ\xa8\xce\x1dr\xa7\xfb\xba\x80\xa4u2\xccKl\xea\x8f\xf1T\xc8\xd6\xe17\xaf\x8c\x1b \xbb\x8e\xa5\x19\xf6l\x1b\x16\xd4\xfe}e\x12\u007f\xaf\x1e3\xb4b>_r\xf6\xaf\x12\x90\x85\xda3\t\xeb\xecB6\xd8is\x18\x12\x18\xaf\xfcډA3\xe0>\xc9\xca\x14ҊM\xaa\xad3=_{\x1c\x85\fe\xdcиa\xeaf\xba\xbc\xfeWd\x90\xb4c\x96\x86\xce\x18\xb7\xd0\b\xe3\xb8\xc4\x0e̚\xc14\xe4k\xd3ڲ&\x82R\x8bN2xE\xb4,W\xbfmߣR\xd2e'*\xbc\x94\xdd\x0f\x13\xd5\xd3\xee\x98g,\xc1-\xab\x0e3\"㗄\x87\xb9\x10\xb7\xdb\xd7\xfe\x0f\xf3D͍H\x82\xda\t\x99\xc0\x9c.\x98\x90n\xb5N$L\x80\xc0=$\xa5F\t\xbc\x02\xb5D\xa6($)\x84қֽ\xe9t\x91\xa6T]\xff\xa7\x8d\b[[\x8fc\x02~+\xcd\xf2Z\fAp0s\xcc\r\U000eb7d5\xa2\xb4Ϫ\xce/\x90MX \x13\xaa %\xc2\xedu\x99\x81r_J\x91\xd1ԧ\xe7d\x03\xe0j\xd1VVft\x02\x19Q\x90A\xa2\x85\\\xc5\xden\x1cڱ\x9b\x13l\xc0^\aOp\xdc\xd3\xf1\xd2&;\x10\x1ba\x12r7g\xc9܊1C\x83\b\x85\xa4\x02\x14\x1e\x12\xa3V-\xbb\x17G\xb6\xef\xb5\x1d[\x8eI=\xb6\x1e\x98UX\xebG\xa7\x1e;\x99I=v\xb0\x956.k-\xf27\x83J\xcf\x1d\x83\t\xf3b\xedŇ$LT\xf3\x8d*z1%\x90\x17zyB\x98\xf6\xbf\xa2:\x8f\x96\xd3F\xf4T\xdf\xfe\xc5mD(M_\xac\xbe\xf7\x804\xdds\x17\xaaO\xffb6\x01\x99\xfd\xb5\xe3\xf5{n\xc07\xcdwN\b\x9bV\x1b\x90\x9e\x90)\xcb4ȕ\x9dض\\\xb1}'\xfa\xa2`\xb7\xa42#\xa7:\x99\x9f\xdf\x1b\rD\xd5\x0e\x8f\xbd\xb0\xb1\xfa\xaaUܼ\xee\xda\x16\xa6[\xa1\x124\x9e\x98\x84ܚd7\x88\xc1\xfa\x17\xa3\uf473\xcb7\x90nF\nه\xc2֖p\xb62\xcd\xe6g\x9d\x1e\xba\xdf\x02\x9c\x92R\xe9\xf0ּ>!\x94\xdc\xc2\xd2j\x17\xc6\xd8/@R\xf3\x19\xf3\xf0N\x88\x12\xd0\xc6G\x82\xba\x85%\x02qf\xfb\x8ew\xf7\xdbz;na\xb9\xfb\xa1\x15\xb4\x99\xd98\x03\xcb\xe2\xcf\xfc\x80\b@\x93o_\x94\x11t\xbax\x0e\xb3kQd_\x16\xe1\x87\xc7v\xf0\xf2\xaamj8\xa4p#\x0f\x95\xdd\x14C\xedsV\xec\xb5@\xf4G)\xc03\xe1\x9d.\x1fi\xc6\xd2\xea3\x96\xbe/\xf8\t\xb9\x14\xfa\x82oRV\xdb\xe3\xfc\x9e)3-\x9e\x927\x02ԥ\xd0\xf8˃#\xd1N9\x18\x85\xf65<Bܲa\xb3\xfe\xa6\xeff'\x11\xdbqa\x8d\xf6jK\x98\"\x17\xdc\x18\x11\x16W\xd6\xfbf?\xb6\x8d۷G^*t\xcep\xc1G(\xec\xc6]\xdfq(ޓ\x90\x9b\xbb\xb0>\xad\xea\x93\xf6s{A\xbc1r\xc1\xbem=\x89\x19M \xf5\xb6\x1ez¨\x86\x19KH\x0er\xb6Y\x104Gax\xf6>\x9fߋ\x97\xda\x11DO\xfb\x88f?\x1c3NwMcd\xce\xe6\xceg\xfc\xd6\xeex\xb0\xd3\xf5\xb5\xf9\xc1]\xeb@!\x89z\xc3\x0el\xd24\xc5H\x04ͮ\xf6\xe6\xde{c~]n\xdb)Y\x19\x97\xd3\u009c\xce\xff1\xa2\n\x89\xf6\u007fIA\x99\xdcyB\xcf0\x9c\x90A\xebM\xe7zi~\xc4\xc0g\x8a\x98\xdd\\\xd0lՁڱ,a\xb8\x06dV\f\x8b隦qB\xee\xe6BY\xa98e\x90\xa5\x84mӴ\xcc8\xb8\x85\xe5\xc1\xc9\xda\x19?\xb8\xe0\aV<\xaf\x9dX/\xcbw\x00\x16<[\x92\x03|\xf3 ^uً\xea\xf6x\x88w\xb8H\xeb\xd1\"\x83\xa6\x9b\xb4\xf6\x8f:Ut\xf3l\xf7\xa0\xb9B(\xfd\x8f.\xe7׆\x99\\\xf9\xe7\xdb\x1ad\x877i\x87e\xe3<C\x15\x8b4Z\xd7T\x83t\x0e1\xcb6\xbdn\xde\xc3R\xd9\xe5\xf4\xaa\x1c^Ի\xe2\x10\xa9[)\xc0\xba\xc6wOn\u007f\xed\xce`#H\x1b>\xbfo\xf8\xea\xcc\t4\u007f7\x17\xf0\x90zg\"\xf2\x9c\xf2\x9d\xbc}m\x92\xaf\xed{\x9er\x1d\x18\xbb\xd7rV\xe2\xa9\xdbW1\xf3\xf4\x82\xc1\x9e;\xa6\xe7\x8c\x13\xea\x0f>HG<\x94\x14b\xdd\xe5\xda5\xe6T\x91\t\x00\xf7H\xdbq\xe8\xedx<I\x9b3~\x81\xc0\xc9\xcb\a\x95ˤFQ\xc4\xf6y\xe4V\x1bX\xfd`%ǾȾ\x9b\x83\x84\x16\r\xac\xbb\x88Q\xaf\xe3B7\xec\xf4\xfd\x10m\xe7q\xa8ȔI\xa5\x9b\x93T\xa4T\xfbml\xd0n\x99\x19߰\x1cD\xa9\x83qz^\xbfۊ\xbd\xe5\xf4\x9e\xe5eNh.ʝB\xd7\x0e#\x03X^\x05\xc9\x1cF\xef(\xd3Ƞ\fT\xf4Tha\xb0^d\xa0\xf7\xd3;'05L$\x11\\\xb1\x14\xa4\x0f\xd7\xda}b\xc2\x1c\xbb)eY\xb9\x1e\xb4\xe8\x1aaf ?\x972\xc2\n|o\xdfk\xf8\xd8\xe6⮍\x98=\x97>\xa7\v lJ\x98&\xc0\x13\xb3\x17 -\x83\xc5\x0f8$ Jv\xea1v\xecÌ\xcd\x00^\xe6\xfb,|\x84\xe7\x92\xf1-\xee\xa4\xe6\xc3o)\xdb\xe6\f\xf4#h\x9b\f\x8d\xc5\x1e\x80\xef\xeaw\x9f\xe0\x00\xd4\xcc`\xab2R\x8f\t\x90\x0f@ӥ?\x05Tkc\x06\xe2\x8e\v\"K\xde\xe4b\x0fL\xff\xfb\xdbP\xee\xfb\x0fe\x1e1\xcevn\xe4\x8aw\x9b\xe9\xa6\xf6a\x00<\x9a\xf6a\x80W\xa2(ܽq\xd1z\xdd\b\x05\xaf\xb4\xe2\xac+\n\xd9[\x13\x99\x801\x00!\xb5\xee\xa2BTf\xbeM-\xe9\f\xe7v\xaek\u007feb\xc5\x11\xeaL\xb9f\xd2U\x83\xd0\xf7\xf1Wڱ\x14%\xb9\xa3\\{ҮԪB\xecE\xdba\xfbh\a\x95\xb3\xbd\x9f]\xcb\xe8\xf2J\xa3O\xac\x02\xae\xe5\x12S~\xf6\x9b\xae\x1d\xc6\xf0KErkT\x84\x9c\xce\xe0\xf0P\x91\xd7\xef\xdex}\xc1\xb0\xff\xbd\xb9\xbb\x1d\xcc\xc6\x18\v)\x16,5\xaa\xccG*\x19\x9dd\xc6\xc0\x9c\x82\x04\x9e\x80\"\x9f\x1f}<\xfb\xf0\xd3\xe5ٻ\xf3\xe3\x00\xd0\xc6(\x85\xfb\x82rCq\xa5\xf2Ҹ\xdao3y\xe0\v&\x057\xa8\t\xc1\xc3ŔP\xb2\xf03M\xaa<(c\xd8d\vHO\\|ĭ \x04\x1f\x96M2^\x94\xda{\x12\xefX\x96a\x96\x15O\xe6\x94\xcf\f\x96n\xe6!@\x1b\xf8#j\xc95\xbd7sF\x15R%\xb4\x80\x14\xe9\x97\xd0\x00\x90\xa9(\xcd\xd2?\xff\xfc\x840xE>o|bL\xce\x1d\xd4z\v\x03 \xe3j9,@Z\x1d\xd7n\xe0\t\x910\xa32\xcd@)Á\xee\xe6\xa0簟\xd3\xd2\x0e\xeb\xfap[\x06\xde\xebi\xa8\xaf+\x93-\x00pG\x96\xdbm\x95\x929f\xe24\x15\x89:\xd5TݪSƍH\x19\xa5T\xd3Q\x83\t\x9dZ\x890r\xd2i\xe4m\xbcQE\xac\xa7\xbf\x93%\xe7\x8c\xcfF\xb4z\x8a\xf1\x11\x1d\xa99d\xd9\xe1\xde\xd3\r`\x9d\x0ema\xd6X\xf3\xa5\xfd]\xd5A\x86\xb2\x1dm\xfev^\xb13\xfb\xd51\xb9\x14zs&\xd1\xe6Q1r\xc4븓\xe3\x9d_\xde|\xf8\xfe\xea\xfd\xc5\xe5M\x18\xa3k\xb2\xc8͌/\x00f7\x8b\xec`|\x81\xc7d#\x8bl3\xbe\x00\xa8;Y\xa4c|A\x9cr'\x8b\x8c\x14\x1c\xdbXd\x83\xf1\x85\xccu\x0f\x16\x89k\b\x809\xb0\xc8\xdf\x18\x8b\x04\xbe\x88d\x8f\xdf8\xb5\xbdq\x94\xab}\x0e\x11\xcdZ`\x8c\x97\xf16\x97\xe8E\x1c\xc1\xd8n;\x85\xf8\xe2#m\x87\xb0ys\x99\x01pIM\xfa>S\x15\x05Ae\x01\x85\x10|\xb8vo\xc7\xf6\xc8F\xf7X\x8fw\xb8\x1c\xf0X<\x90\x06.\xc6䝋\xe9R\xf2\xfa\xa7\x8b7\xe7\x977\x17o/\xce?\x84 \x83Ğ\x11\xe2C\xf3\xbdPr\xf8p&\x85\x1d\x1b\f\x8bB\u0082\x89\xb2J\xcf\r\x86\xdby<\xd7N[\xf8t1p\xb0$\n\xe4\x82%\xd0\xfd\x99\xd0\xfd\xdc\xc3\x06\n\x86إ\x10\xb4\xc4|0\xc4\aU\v\xec\xd8C9\b\x86\xf9\bV\x94\x1d\xbbm\xa9`\x90\xb5b\xb1A]\b\x86\x88\xea\xc5\x1b\x98\xd22\xb3\xfe\x89\x83\x83\xf1\xfe\xd2ڎ~\xec\xe5\xad\x14{9\x90\x9b\xa3\xc5b\xaem\xf1\x86\xf7\x9d>\x04\xe3=t\xe9u-\xe1j\r\x88\b\x98Y\t\xde\xe2\b\xc8ͩG\xac<#6\x8c6e\xb3w\xb4\xf8\x1a\x96\x1f`\x1a\x0e`\x15٘y\xe7\x92հ\xc00\x02\"1r\xddN+\x9c\xf5\xf5\xc3\a\xd9?\x1f\xb1k\xb4pq\xe3\xb2&Q33h\x89Y\f\xe9s\x80\xfc\x88\xd1\\\xfch\x8b\xeb\xa6\n\xe3x_\xf4\xb2\xf65=\x12\xc1\x13(\xb4:\x15\v#%\xe1\xee\xf4N\xc8[cK\x18\xce>\xb2\x91\x00u\x8ai\xf8\xa7\xbf\xc3\xff\x89\x9e\xd1\xcd\xfb7\xef_\x91\xb34%\x02\xd9h\xa9`Zf6\xc5'B\x0e\xfbQ\x17\xf6\x9e`\x99\xe9\t)Y\xfae(#\xf5\xa37=\x88\xc2\xe6y=\bM\\ctr\x19aҶ\x87!\xa9\xea\xdc\x1bӖi\x85\xe7'/U8\xab\xf6c\x02\xd1*\x9f\x9b\x16\"{\"D\x06\x94G\xc0\xd87\xfc\xd55\xf6I+\xec\x1a{\x87Ⱥ\x06\xd2\xfaCȂ\xc3Z\x18\xd8\x149\x11.\x1dI\x9d\n\U0004aa32(\x84Ԫ*\x18\x1e\x9b\xc3\x1e\xae˒F\xcd\xf1\xb8\xaa\xde9!\xffU\xfd\x889\xe5\xea\x87\xc3ÿ}}\xfe\xfd\xff=<\xfc\xf1\xbf\xe2\xbeRCltt\xe8\x0fV\x15\x90\x8c\xb9H\xc1\xb0\xe3\x13\xfb\xa7\xb3 \xce\x12\f\xef_F#Fi\xaaK5\x9e\v\xa5/\xaeN\xfc\x9f\x85HW\xffR\xc1\x8a y\x00\xe1\xdc\xdd\"!d\xb4hԷR\xb0\"-\x9a˸\x9e\v\x86R\xb1y\xc5\x15\xd5s\xa3\xd3\xddI\xa65İ\r;\x8c\x9d\x052WDLO\f\x1f\xab\xd5\xf0\xc5˃O\xa6NL\xfd\x12\x1fd\v\x10WN\xa5@\xc8\xf1\x12\xc4+Z\xde>\xadr\xae\xa2A\x9e]]\xf8\xd6\x1a\x9f\b\xdd\xfd\xe4G\xb5UO-E|\x1a\xe9\xdbG\x90&\x1ev\x9c\xee3m\xbbl^\xd9\xfc\xe9}\xea\xe56\x8f\x8ca\a\x0e\xcaӺ\vǑ\xfdq\x9c\x14e\x1c'v\xef\xe7\x90\v\xb9<\xf1\u007fB1\x87\x1c$\xcdFJ\vIg\x91l\xdeO\x13\xa7W\xffe?\x16Ǚ\x1b\x8b_\x9fe\xb83\x878o^RJcedK/\xff!\xfd$\x92\xa7\xa2\x98\xae& \xfb\x8e6Iש\xa8},\xb4\x9aG\xa0\x93c!\xb22\auRi\xf9\xd1`\r4\xe0\v\xb2\xa0R}2[%e\v\xa6\xf6K\x9e\xec\x1a\x94/\xdfG1\x1f\x82\xfc\xd3N\x9fq\r\xb3h\xd3f\xd4\x1f\t\x9d&\x97/\xba\x16\xa5.\xcax\vi*dNu\x15\x97\xb8/\x84BǦoL\x11\r\xb8\xa5\xaf\xbc<\x88\x84SP\xadA\xf2W\xe4?\x8f\xfe\xf9\xc5ϣ\xe3/\x8f\x8e~x1\xfa\xeb\x8f_\x1c\xfds\x8c\xff\xf1\x1f\xc7_\x1e\xff\xec\xff\xf8\xe2\xf8\xf8\xe8臯\xdf}usu\xfe#;\xfe\xf9\a^\xe6\xb7\xf6\xaf\x9f\x8f~\x80\xf3\x1f\xf7\x04r|\xfc\xe5\xe7\x91\x13\xbe\x1f\xd5>\x8c\x11\xe3z$\xe4\xc8n\xfd\x8er\xe9m\xc3o\xc7\xc3\xf0\x9d\x0f^\xa7\xe8'JIS\xe7\xfaD\f\xa2\x9fz\xd4c\xf9\xbd\xb4#\x05\x89\x04\xfd\xbc|\xaevN\x8d\x1a\x88CU\xb7\xb6\xf8\x15\xb8a\xfb\x9ax\x16=\xb5\x8d\x81\u0379\b\x86`\xfbx\xa7\xa8me\xe8\xe1\xdfB\xb0\xffߏ\xc1M<\xb8\x89\x9b\xe3\xd7\xeb&\xbe\xb6ge\xf0\x11\u007f\x1a\x1fq\xe4\xab1\xab\x1c!S\nI\x83\x8a\x99[T\xbeWX`\xba3\xe7\xabn\x96D\nQ\x94\x19ձ\xf1\xe9\xcd))c/\x00cr_\xea\x8c[\x1bD\xcf{\xe7\x1b\x9de\x19a܊<\x9c\x94O\x03\x91`m{B\x15\t:D\xb0\x00\xae\r[\xe1\xab՜\x8a(M\xa5f|6&\xdf̓ܰV\x97ry\x13\x8c\x93\xbc\xcc4+2 U\xbb\xbe\xaaZ?\x04\xaaR\"aT\xfb\xa4\x14۾Fi\x8f^ą\xa6\xb7!0\v\t\t\xa4\xc0\x13\xc0\xae.e\xa3\x05\xe1dI('\xe7|\x81_\vZ}Z\xda\xe4N\xab:U\xf3j}\xcd\xe6>\x04\x80\xfd$)\x88昺\x14\x90vw\xe7 \xa6\xe76\xc8(\u05fe\x95N\x15\xab\fQ#b\x95\xe2*O#\xc2`Xӆ\xeb(k\xa5͆G\t\xa5ȟ0O%V5},\xb5\xf4y\xa9\xa4\x8f\xa0\x8e>\x9c*\xdaK\r\xed\xa3\x82nS?\xa3M\xc1\xfa\xecxY\x18\xaf:\xf6Q\x1b\xa3շB\u0094\xdd\xf7\xe2!g\xbc\xda\x17\xc2R\xe0\x9aMY\x84Fo\xb4\x1e\t\x05p\xac9\x05\x9a\xccm[7\xdeN\x05\t\xa7\xdfO\x9c\x15m-\xf9\x87`\xd4\xd7]>\x87\x81\xeb\x0e\\w\xf7\xf8uq]w\x10~\x91,\xf7\x89,R\xac\x80\x8c-\xd1|Ө\xa2\xc4S\u07fc\x1f\"`\xad\xfb\x9cʺ5\xc1)~/\xe4\xf0aCB\xdfo\xad\x16B\xb69\xb0\xb8#s63d\x96\xc1\x02B\u009eV\xbb&9\xe5tf[\xbei\xe1\xc3WDHb\x18\x89diPQem\x86\xe2\"\x8dX3l(\x134m\xdc\xe6\x13\xb2\xf8\x8c\xdd\x02y\x03E&\x96\xae\xb3\x1bOɵ\xa6ڰ\x9dk\xd0!\tY\x11\xec\x01\xd7qUfٕ\xc8X\x12\xe0\x9bo\x93\xda\x05\xd2XQf\x19)\x10И\xbc\xe7(\x1fβ;\xba\f\x8a7^\xc2\x02\xe4\t\xb9\x98^\n}eM\xbbv\xb5\x82\x05\x19\x00\x91M\xc9+{\xaf\r\xd1t\x86.\x84\xba\xbf\xb2\x90\xadO\x05\x80E\x01q\xc7\x14t^\xcc\xf2tG\xedw\xf8M#\n\xedߏJ0\x19\x9bB\xb2L\xb2X\xaet\x96`\xbad\xddַq>\xd5Ri\bQ\x85\\\x1b\x1dtb0l\x8fV\b\xae\xc0\xb6\x91\xf2G\xb5\x9aq\xa8\xfbI\xf5*\xb3\x8cS\xd1\n\xa1\xf4\xb5\xa6r\xaffE\xf5h\x9f\xc6+\x0fĐzB\xb3\fR\xc2\xf2\x1cRF5d\xa1~e߭\xae\xe5\x83ë\xc8\\#\xb4p\xf9?\xa7<\xcd@bo.\xe7ukA\xd7 s\xc6iX#\x01R\xa5+\xa1\x83\x10RB\x93D\xc8\xd4\xf5C\xf2\x1do\xa8\f\xf5\x8bT\x1c\r\xb5\x9d\x06\xbd\xaef\x9d\x05\u009dd\"\xb9U\xa4\xe4\x9aeu\v4\xdf\xff\xcc]\xa2\x15\bs\u007f=\xba\xc1F\xaa\xff\x1cUge\x84\xb7̜\xfe\xae\xfe'\xfc!Li\x8d\xb7R\xf6\xe91\xb9>V\xfa\xac\x01\x92\x03&\x02\n\x0e\xf1\xa1\xe2\xa90j\x88!\xa3\xba\x13`%@\xc6\xd8&/\x02j\xfb\x8e\x05\x8al\x11{\x05\xd1۽Z2\xb5G\xbf\xb8|p/\x90\xe6أ\x8dfd\x04.c\x1c\x9a\xfd4\x19v\xf9k\x9f\xb9\xd8L&\x03\xc4Y\x90$e\x12;\xcb/}=a$L\xdf0\x12\xfbj\v\xa1\xc9\xd1\xe1\xe9\xe1qx\xa3\x8d6L\xdf\x19\xc4\xe8\xc8\x19X\x19\x19ڏ\xa8k\x96F\rby\x91-\x11\xbf\x87\xe9\ta\xb1\xd1VW\xe8(K\xee\xf7ȵs9!j\xbf^v\xebCK\xea;W[X\x06\xb4\x96\xa5\xd5\x1f\"\x81\x1e\x1d\xfe|xB@'\xc7\xe4N\xf0C\x8d$0&7\xc2\xd8\xf9\x910\xab\xa5.EI8\xd8fkp_d,a:X\xda\xfaa\xc46\x11\xa5\xb6\xed\xc3\xf0\xa2*l\x8fs~\x1f\xbdK\xb6\xce\xc3\xf0\xc1\x17x>\xad\b'T\x91\x8c-\xe0t\x0e4\xd3\xf3\xd8\xf9\x1a\x8a\u208f\xfe\rR`\xeb\x1d\xee\xe0\xc5\xf9L\x82#D\xcd\xd1;G\"\xdcP_}7*\x04o\xc4\xf6W\x10\xa8\xfa\x91\xb5\xdb\xdfnn\xae\xbe\x02\xdd\x160\x11h0\xb3\xf1\xb9\xdf\xe8\xd6\x059\x15r\xed\xea\xc2ݣ\x9fl\x9a\v\x15\x81\x11\xb2~'\x9eҶ\x1f\xb95\x0exL|\xcc\x0e-\xdae;.\xb3\x8e\\\\\xc5&\t}/J\x83\xa5\t\x9dd˪ˡ\x02M\x0e̴c\x93l\x19\xc7=\xfc\a\xd0\x14\xbbIr\xa5\x81\x06u\x11\xaaG\xcf#\u0558\xc7C(\x19\xf6>ù[؞\xedR\xd7G\xa3\xb5\x8e\xa3\xf31\x9e\x1e\xebw\x8a\x951\x12\n\xcbX\xdd\xfc>\x01\x03\\\xe3\a\x16\xf7\xee\xf7I\x8f\x1c9\xea/\x93\xb4\x8bs=FKգ\x1a\x8bq\x8bts\x00\xa2g\xd67/\x95\xf4̔$]\x91\x1e\x8b\xa3\x1e\x10]U^h\xba\xd4\xeax\x80J\x85ȶ@\xcd\xf1x\xe8\t\xcd\xd8Y\x1d\x0f\x80\x9f>\xc9~$&%\xae\xfdr\x1f\f\xf4\xcay'=\xb5%,\x05\x89,9]/8Ղ\xd0$\xc1n|\xb1\xe5\xb9F\x18 ;»\xeb\x83Z\x905\x80\xf4#\xa8B\x84\xfa\xff\xfc\xe8Q\x18\xf5\x10eQ\x0fP\x14\xd5\xd1[M\x12^\xe6\x13\x90\xb1\xad\x06|\xb3\x01\xa9[\x04\xb2\x92Q\x19\t\xfa\xd2N\xcd\a1\xbd:A\xf9\x9e7g\xad\x8f\x97f\x96\u007f\xfeӟ\xfe\xf0\xa7\xb1E@\x95\x9f\x19K\xd3\x17g\x97g?]\u007f|\x8d}\xae\xe2\x16\xfa\b\xf5OX^\x1f)Q\xda\xf1h\x04d\xb0V*l\t\x15\xefj1V\x81\xf3\x17[\x87\xacjĞ\xa2\xcd\x05d(\x9f\x80\x93\xc4\v\xa5\x11\x1e\x97\xa7\xb4}uR\\\x8b\u4db7\xf5{x\xf3\xfa\xca\x02\xaa\r\xe0\b\xccS\xee]\xb2\x8c/D\xb6\xb0w<ݼ\xbeB\xc4\xc4\xec\xa5y\x17}\xe8\xe8*[\x9a\xf9\xf9\xcag\x9bt\x12\x01\x93养͌\x12\t4cJ\xb3\x04\xbf\x14\x13\xf4\xf2\xc3\xcc2<;\xe5YX\xf9\x87\xef}\x92Km\xf0\xc7\x1f[\xc7\x10\xba\f\xfeX3ź\t\xe2\x8a\u007f\x06\xad⁴\n\xa7MH\u007f?ݠUČ\xe7\xa8U\xfcr$^䋅\x84k-\x8a^\xd9\x01\x16ă\xe4\x06\xf8\x9b\x876\x85\xefI\x1a\xbc\x89\xf6\x96γ\xab\x8b\xca\xf7,ZAwL\xcd\b\x84\xa9\xcad\xee\xe3\x1c\x1c\x94:\xc54\x80\xb2\xb0>'\u007fEXh(\xb1\x90\x80\xf7-\t~R՜#\"\x80\xdb\x1fA'\xa1\xe7\x02\xfd\".;\xc2E\xd5\xfc&\xf5K6H$Us\xc0\xee\xf2p\xcf\xea\xebЩ\x12܆=ݦ\xb1`ә)RP\xa5l\xe0K\xd7\v\xb0\x9f\xb8\x12\xe9\xe1a\xa8\n֘\f\x99I\x9a\x00)@2\x91\x12샖\x8a;N&0\xdb}\x8b\xea\xeap\xf4j&鏁\xd1v\x00\xa3\xa1\xd5\xed~\x81@?\xb4.\x01p\xcd;\x12Q\xe7G;|\x84\xd2W;-\x06˵\x90\xf8K\x9ae\xcb\xfa\x90\x05Bu\xd5\u007f\xbaښud\x87\x9e\x03ܚ'Ϗ1\xa4\x8c\xff\x16\x81֍\xf4\x857b\xd3d\x1eN\x05\x81i\xecC\xfa;cH\xbf\xd9:\x86\xf4\x1b?\x86\xf4\x9b!\xfdfH\xbf\x19\xd2o\x86\xf4\x9b\xd6x\x16\x8e\xb9!\xfdfH\xbfY\x1dC\xfaM\xf0\x18\xd2o6\x8f!\xfdf\xeb\x18\xd2o\xb6\x8c!\xfd&|\f\xe97k\xe3\xd7\x16(\x1b\xd2o~\xad\x81\xb2!\xfdf\xbf\x97\x87\xf4\x9b\x9dcH\xbf\x19\xd2o\x86\xf4\x9b=\xbe=h\x15C\xfaͯ[\xab\xf8\xe5H\xbc\x1e\xfd\x9b\x82^\xf2\x19'WRL\xa2\x1b9]al\x9a%.]EL\xa3B\xea~*\xe3\xfa\x82\xf5F\x9f^\xdf3#\xe8\xb2[{\t\xb7O\xa1\xe9\xec\x97\x12\xda\xc4b\xff\b\xbao\xbc\xa4N\va\xff_\x1d?o\x04έ_k\u007f\x96\x1f'H\xc3#\xe6\xfbD\xcb\xeb\xd8wh\xc2ӦHy\xb4V\xd67J\x1e\xaf\x9fDG\xc7\x1f'2\xfeXQ\xf1\xad\x11\xf1fl;\x02\xf6Z4|S\\;F\xb1n\xcc\xee\x81b\xda[\xe3\xd9\xcd\xc8t\x8cٻ\x16\xcb^\x8bJG@mƱ;#\xd2\x110\xeb\x18\xf6\xa6ht\x04\xd0\xf3{\xa6\x1f/\x12\xfd\x80Q\xe8\xe8\x00L/e5֗\x1a\xa9\x87\xb8\xc4ӛ\xb9\x045\x17Y \x8fk\xf1\xb7w\x8c\xb3\xbc\xcc\xcd\xc1V\x861\xb1E\x95\xd7\x1a\xca1<ϱ\x92݆\x98\fX\x96\x02^GGY\x16ޘ\v\x9b\x88\xcd)Z\xf2\xaaL\x12\x80\xd4ȤF_\xbf@\x88\u007f\x18Wk\xaen\xdb\u007f\x19Fg\xf6\x924\xb4\x8e\xfe\xf0\xfb\x88\xfd\x0e\xb7\xaa\xa2R\fv\xa7\x17 \xdc@\xfc\xf5M-\x88\x17\xe8qΆ\xc7H'ؒJ@\xbe\x17e\x8c\x95\xbf9\x8d`%! F.Ʀ\x10\xf4\xe0\x89\xbdR\a\xb6\xa7\r\x18\xdcDaac\xca@\x15\xfc\x8fq\x81Ŧ\vDK\xaa\xc7I\x13\u061c\"@X\x9c\xaf\xa1_z@\xdfԀ\a\xbb\xbf\xac\x8ey\xf7\xbc\x91\xba\x8fW\xb3\xaf'\xadW\x1a\xc0㠣\u007f\xf0\xfb\x13\xdd\x13\x19\xb9\x8f\xf1\xe1\xfe^\xa1\xfe\xf80\u007f\\\x88\u007f{x?\xd2\t\xdf+\xb4߃X\xe2\x9c\uf44e\xf7\xbeN\xf7\x9e\x0e\xf7\xed!\xfcȍ{\x04G\xfb\x16';\xba\xcb#@v;\xd8\xfb\xba\xca\x1f\xd8M\x1e\x1bx\xdf\x1eto\x84ϣ\x14ᎀ{|\xe8<\x9a~\xe3\x18zD\xf0 \x92\x153\xce4\xa3\xd9\x1b\xc8\xe8\xf2\x1a\x12\xc1\xd3@\xadf\xe5\x12\x95\xeaT*\v\xcc\xda\xc9\x11\xaeٺNpN\xdd\ry\x90\xfarG\xef\xf9\x0fe\x9a\xa8\xf2\xe1u\xfdv\xdd+}\xed?\xa5\x97\x9e|\x12\xf3\xdd\x16\t\xf6\xdf\xf8\u007f\x88;\"\xa6\x1a89b\xdc\xef\xfdq8\xcfs\x86{\xed\xad\xa9\x0e\xaf9\xbb/_x\xd0\xc1\xb5\x8c\xbf8\xc7\n\xba\x94\x94z,O\x9a\x03\xffЮ4\avZ\x86z\xb2[\xee4\xeb\x90k\xf3\xed\xc0\r\xab\xaf\xd7z\x89s\xf6\x1c\x03=\xba\xaeX\xfe\xd7OD\x91IP;\x13\xa0\xeat\xa6@\x14v&?\xb5S\x99\x02!v$>u\xa71\x05\xc2m%=E\xa40}Ro\xe2\x03\xa5-mOY\"\x85\x88\xb1\xb1\xa3ҕ\x06Ki\xaf\xb1=-i\xb0\x94>\xad\xa5\xf4\xdcm\x01\xcdr\x10\xa5~6f\xc0ݜ%\xf3\xa6\xb6\xc1rPD\x94\xf1)\xd4F\x8fpS\xea\f\xb6=\xee\x055\xbf\"\xcb!\x82\xc2\xc2\xdc\xde\x1d>\x9f\x95\xde+u\"P\xc0z\xa9\"\x94\xbc\xb9\xbc\xfe雳\xbf\x9f\u007f3&\xe74\x997[=qB\x03\xc5\x1a\xf2\x9a9]\x00\xa1\xa4\xe4\xec_\xa5\xbd\x99\x90\x1cU_9~\xa2;\xc8#$\x87\xe1,\x01\a\xbd\xb5)\xdf0\x85\rq\x10\x86kQ \x14\x84^\xfeږ%\xe4\xdc\x00\xb1\xfa!ʝ9H 3\xb6\b2T\fL\x9b\xffChZ5}0\a՜\x12&8\xa1\x13Q\x06\xb1\xc69\x10\x0eڜ\xe0\xca/%\xb8j\xf5\t+\x15\x04]\v8)\xf1:\xb3B\xb2\x9cJ\x96-\x9b\x13\xa4٘\\\n\xafq/\xc3t\x81&\xea\u07bc?\xbf&\x97\xefoH!\xb1ՒͶ\xc1\u007f\x0fܨ\t\x98m\xb1\x9b\x9c\x8e\xc9\x19_Z0\x96K3E\x8c\x9a\r<l\xaaN\x99\xf0\x97X\x1e\xbc\x18\xe3\xff\x1d\x98}\x93F۰\xe9RA\x8bO֒A\xad\xe6\xc2&\x99\xa5\xce@=\xc8\xed{\xaf\xbb\xf3\x82C\xaa+\xa9~nEW\x06\xe1\x12\n{\xb3\xa3\"4\x88\xd5{\x02\xc6mCVgNZ\x16\xa9\xcb\xc5\x1a8Is1\xbd\ue7ae\xb5\f\xaf\xa2Z\xea\f\xd6\xf2\x1c\x15\x16\"=T\xe4\xe2\xca\x13\xdf\xd8^\xe4j8|0H\xbc\xd7{A3\x96\xda\xc9\xd9p\xc5\tyA\xfeF\xee\xc9\xdfP]\xfds\xa8>\x1a/\xe5\xe3]\b\xd6\x1e\xbd\xb8\xea\xb5S\xdf\x19\xa6c\xe0\x18\xecjA&\x8c\xa7Q\xd6\b\xdck\x90\x86\x99\xbb\x1d\u007f\xb2\xdb\xd2\xcd\xe4\x9f\x1d\xc1\xda\xe8\xc6Ŵy\xfb\xab~^$K\xcc\xf4\xfe!\x94\xbeţ}W\xad\x99m0DT\xb9r\xaa\x93y\x9b3\x1a\xf5]\xe9\x9a\xc1\x84CN\x05\xe6\xe9\xda\x14\xd79\vv3\u007f\x9a\x03\x1a\x93PҢˇ\xa4\xa0\x15\x93\x1b\xfd\xadN/\xb6\x8d\x1a\xc3}?\x965;e\xdd,6m\x88\xb0\x18'\xd4\x06\x9d\xddy\x0fb\n~\xeb\xd2-\xc3\xe9\x12\xcam\r\xca\x14\xa4\xb4\xfd\xbb&\xe1\xd9\xc7\n\xe4\x82%\x10L\x84\xd1<\xae\x90B\x8bD\x04ߧ\xdfN\xacp@\xd0\xebnݻ\xef\"i\xe9\xdb7W'\xe4\xe6\xf5\x15^i}\xfd\xfa\xe6\xaaOv-!\a7\xaf\xaf\x0e\x9e\b\x991\xae\x9eQ[5\nz\xd3o]\x88I\xf34\x17\xfe\xaf\xf8Ќ\x910\xcai1\xba\x85e\x80\xe2\x18\x8b\x9b\b̬O\xd7.:\xa7\xfb&$K\xa0){&5r\x8e\x89\xd4s\xea.\x96\xcb\xc5\"ȏ\x82f\x94\x87\r<-\x043\xf6\x88k\xe9ܬ\xa0\v\x00\xba\xf5\xce\xf9\xa1\x82n\xa8\xa0\xab\xc6PA7T\xd0\r\x15tC\x05ݞc\xa8\xa0\x1b*\xe8\xf6_\xe8PA7T\xd0\r\x15t[\xc6PA\xb7s>C\x05ݶ1T\xd05\xc6PA\xd7\x1eC\x05]\xe0\xcbC\x05ݐ\x17\xbac\f\x15t\xcf9/t\xa8\xa0\xdb6\x9e{\xd6\xecPA\xf7L\xbc\xf4d\xa8\xa0\x1b*\xe8\x1ac\xa8\xa0\x1b*\xe8\xaa1T\xd0m\x1cC\x05\x9d\x1dC\x05݆\xf1۵\x94\x86\n\xba\xe7e)=w[`\xa8\xa0\x1b*\xe8\x82\xde\n\xa20\u007f%\u007fl\xc5\xd6\xe1k\x91\x17\xa5\x06\xf2\xc1\x03\xaa\x0eTX~*f\b7\x8a\xb6\x9e\xb2Iz\"\xf8\x94\xcdJ\x89eR\xa7\xf6n\xf6Qb\x176\xaa04\xaafw\xfa\xd8i^\x19\xcbYH\x11\x9d\x19uU\xdaU\xb4\x92\x13%_\xfbI\xd7^\xb2\xb5\xa0Z\x83\xe4\xaf\xc8\u007f\x1e\xfd\xf3\x8b\x9fG\xc7_\x1e\x1d\xfd\xf0b\xf4\xd7\x1f\xbf8\xfa\xe7\x18\xff\xe3?\x8e\xbf<\xfe\xd9\xff\xf1\xc5\xf1\xf1\xd1\xd1\x0f_\xbf\xfb\xea\xe6\xea\xfcGv\xfc\xf3\x0f\xbc\xcco\xed_?\x1f\xfd\x00\xe7?\xee\t\xe4\xf8\xf8\xcb\xcf\x03'\xfa\xa0\x12\xab}\x00\xbfAZ\xa9\xa3yȚszo\xb8h\xe8\xf6\xe7\xa2\xe4ڦ\x85\xdaS]\x11\xbf\x8d|>Ņ\xff\x8fu\x12I\xbc\bv1\xe0\xe1@\xee\x1cÁ$\x87\x1f\x1c\xb5\xac\x1eI\xab\xd8<\xe0\x91\xf4\x826\xf4L^LI5G\xa6\x88ș6V\xfaT\xc8f\xa5khr)\xd3-SԱ%\xccަX\x94\x1c}\xdd|\xa3\x8eH\xe89\xc8;\xa6\xd0\xc9Ey\xedS@\x861Ja\xcaxpZ\x06\xaa\x9a\xc1\x1e\xe7\xe7Ȫ\"^R\x90\x94\x92\xe9\xe5k\xc15\xdc\a\xd8\xe4m\xa2\xbfv`\x88(l\xb6\xab\xcfq\xb2)\xe2!̶\xe4X\xd5\x15\xbc!\x85\xc8X\xb2<\xf5\vB\xccý>\r\xf8\xf6~_\xd4T\xdd\xd6\xfb\x0f#c2\xd4ۼ\xf6\xfd\xc7V\x16Q2_I\xb6`\x19\xcc\xe0\\%4C\x9a\xecc*\x9em\x80\x19x\xb2\f\n\xa4\xc8\x14\xb9\x9b\x839\xb9\x84\x9a5\xa2\xc3\"\xa1\x9c\xcchp\xaaPnv\xa8\xf0\x133df\xb8\x80V\xa4\xa0\x12\xb8\xf6\xe0CY\"\x16eO\x84\xc8\\N|\xb6\xac\xe7\xee\nP\xb8\xf8\x89\xc3\xddO\xe6\xdb\xc1\xee\xf9\x8cΪ\xc2\x18\x05z\xcd[\x13;\xedM\xdbdӭK 4\xbb\xa3\xcb\xd0\xe9\xde\xcdau~L\xbd\"/\x8f\xf1lRE\xaa/\x86r\xda\xdf\x1fc\xdc\xf0\xf5\xd9\xd5O\xd7\xdf_\xfft\xf6\xe6\xdd\xc5e\f[4;\x05A\x97\xc2%\xb4\xa0\x13\x96\xb1p%l-\x9b\xa9\t\n\xc5P\x9a\x9e\xa6R\x84&\xc6\"\x96e\xc99\xe3\xb3F}q\x9f\\\xe5f\xdb\v$\xb3i{\xb23Iyx\xd6\xe2d\xb9B\f\xb2\xe4\x9a\xe5OV\x98CӾE9gi\ni\v\x15\xc1\xf0\x1e&\xfb\xf2\xb5\x9f²\xee\xb8\x11\x01\x93\x90\xab\xf7\xd7\x17\xffo\x85\x12\x97E|\xb2\xd8\x13\xd71\x10b\x0eL\xcf]\xfd`+\f\x87}\xed\x1c\xbf\xa4\xfa\x94J\x9e\xf7\x89\xa7\u007f(y\xbb\xebV\x11+\xa5r\x91\u0098\\Y\x91\f\xaa\r+\xbe\x15\x04\x95@\f@\xae\x19Ͳ%1\xd6ۂf`\x13\xf8\xb1v.X\xc1\xeaΦ\x9a\xd2L\x05\xb2\xe7X\xb9j\x14\x97w\xc6D\xed\xb1s\x15\f\x92\x02\x17\xda\xd9\xcb\x11t/\xa6\b\x8bX\x9b\xb9\x91\xb4֒_\x11\xcaa-V\x99\U00098faaf\x8d\x11\x91@\x98\xa5\x02\xd5-V++:\"\aD\x02M\xb1\xb6\xb7\xa0zn\xb3*r\xaan!\xb5?Di\xc5\xce\xcb`g[-\xfafY\x00\x99\x02\xd5eph\x06\xb5a\x9b\xa3\x02\x9cN\xb2P\aFt\xfb\x04\x9a\xbe\xe7\xd9\xf2\x83\x10\xfamU\x8aڃl\xbfs6M;ra\x14\xdcPƀs\x1b\xe1\xc6!\x1bhT\xcazj\vuƨ\xa7d\x02\xb2\xe4g\xea+)\xca@\x91\xbe\xa6Z\u007fu\xf1\x06yai\xed\x0f\xe0Z.\xb1\r@8#趯ȷ\xe6ܹ\x93\x16\xaa\xb2x\x160%%W\xa0\xc7\xe4\x1d]\x12\x9a)\xe1ͺ`k\xf6\n\xb3\xfc\x9a\xfe\x971\xba\xe7,02\x11:\x94\xaf\xac\x80C\x16\xb0\xfe\x95PߞA\xa6\r\xc8V\xbe83\xbf\x15\xa8\xa1@\xe9-(RHH \x05\x9e\x04\xd2j#\xb6\xfa\xe7?>I\xda\x16R\xf9\xa5\xe0\x86\x81\xf4\xa0\xf3\v\x9e\xb2\x84Z)Gu\x9bNC\x15\x95Rio\x93S\xac\x88F\xf6Q*\x90\xd8\xc2K\xcb\x12b\xb6\xfa\xebr\x02\x19h\xeb\xb2\xc0\xee]T\xdb\xd6\x03,\xa7\xc1\xb7\xbbS]\x896-\bpUJpNaMR\x011\xf9en\xd1\xdf^\xbc!/ȑY\xf51\x92\xfa\x94\xb2\fK\xfe4\r\xbe(}\xc5\xe31\xf5\xd3CT\xe2\x89'\xc1]\x9c\x90\t\x9f\x10.\x88*\x93\xb9\xc7%\x13\xbcr\a\xb9\xdcڈ\xc8\xda\x1a\xf3\xd9\xc4NB\xdd\xed5\xf3\xf9\xed\xb0\x93^\xa2\xef[\x05\xb2\xa7\xe4\xfb\xf6\xd1%_\xbc[\xc9\xf0\x93\xf6N!\x1b 9h\x9aRMî\xc3G\x88\xbc\xd1/f \xe4\x15\xa0\xbf0\xb9\xa8\xe0\x1b\xc6\xcb{\x9b\xdc\xda\u05f9z}\x8e\xc0\x88\v\x9eX;!T\xe0\x14E\xc6l\x8b\xbc\x95NЖ\x91W\xe1\xc4^\x02\xc2\xcb4d\xe44˄\x11\xea\xe1\x9a?\xe5\xa9\xc8זm\x8c9h\xf5\x11\x1f#\xc7\x0f\x85?\x1c\xab\x1ah\xafc\x15\xef\xbe\xce`\x01\xc1\xed\x0fW\xfb\xa2\x1b\x18ƨ\xf3t\x82@#\xbc\x82\x19\x9d@f\x95/{J\xd4\xfa)\x89\xf4\x16F\xb9\x1a\xa5\xc8\xfa\x96(~\x10\x19\xe6\x89\xd2\n9\x06\xe8\xaf\x007\xf8j?ܠ\x97\xa6\x85\x9bHo\xf2s\xc3M\x19\xacq\x91U\xdc\x18\xa5\xad\x8d\x1b\x03\xf4\x17\x8f\x9bH\x17\xbc\x82$\x11yq%Ŕ\x85\x1e\xc95!\xee\x80չ 艍\t;\xb6s\x82\xd13\xd2\x02\x1d\xe1\x82/\xa4X\xb0\x14Rc\x97\xa2\f\xf3\x99*\xff'\xda\x0eGn|\xb2\xa2\x1f\xf8ŋ\x05H\x19v\xdf\x00\xa9;\x14{0O&\xadDB3lL\x1eC\td\x95\x1aV\xc1\x11\xe6\xbd\x1f\x11\a\r\x93G\x10\x8a\xcb\xf3\xb2ݚ\xf1\x97\xe8V\x11\\\xa4\xd0\xe8cY\xe2E\x147\xb6\xba\xc1@\x8e\x00\xe9\v]\x8c\xaeᓄR\x9f\xf3a\xbe\x17\x13\xce\x13\xae\xf9\x9f/\xa0\xa4\x88h\xe0)\xe33\xf4\xee\xc74\x83\x94\x90Q,<u\f\xeb\xd6:~\x0e\xf1Թ\x89G\x80\xf5\x87\xd4o\x17\xea\xc6Lp7{\xc1\x83S\x00\x89\xedތ\xaa\xe7\x14y\x9ca\xdd\a\xdfx\xf2\n\xee\xc1\xfc\xa9%\xf3\x81]C\xe5\v\x8c\x89!\x99q\xcbx\xea*zZ(w\ue958Sf\xad\xa71\xf9\x88m\xfb=\x1b\xa3\x12^\x91\u007frR\xa1<\x02\xf4h\xc7\x11\x8e9\x17\xeeH\xad\x1d\xe1\x0f\xd6<\x8b\v\x9f\xd8\xc9n\r\xcfE@\xf4K_\x9d\xea\xb7\x1cO[x⪝&\x17\x1d\x90\xfd.\x1e<ݹ\x88-\xa4\vVI#U\x9c;\xc6Sq\xa7\x1e\xc6O\xf1\x9d\x05\xe6\r\xd4İ&\xcd\xf8,X#\xa9}\x154\xcbZy`\xfd\x9d\x15\xfe\xec\xfa\v\x8a:L\xf3@\xa8\xdekm\t\xb7\x1d\xa9\xeai\x9fop\x1dt9\x03B\x83\xa1k\xae\x83O\xe6\f\x98劾\x96曚\xd1\xec\xba\b\xed\xe6MVi\xf1\xabw\xd7gm\x80q\xad\x9b\xef\xf0f/\x83k\x03\x91\xd04gJa\x9c\x02&s!n#@\x1e\xf9\x14\xea\x19\xd3\xf3r2ND\xdeȦ\x1e)6S\xa7\xeeL\x8e\f^\x8e#\xbe\xc1x\xc6x#\x93\x02o\xb0r>p\xb3\x90\b\x90I\x85M$8w9\x88K\x82\\G\xf7e\\\x11?\xf6\xc2{R\xa5e\x9d\xf4.\xa3Z\x1e\xee \xbfH|\xb8~鍚xK\x88\xf5n\xc4\xe8\xa1f\xff\xac\xf1\xf9\xb4^-\x1f\x14z\x00\fcpȁ2\x9c\xcc\t\x9e8\x13b=\xbc\xb4\x160\x8a\xd3k\xd6BL\b\xb4\x1d8\x8a:\xde롦V\xf0(\x02\xe6~\xe1\xa6\b\xc0ۥ!\x89\xbb\x06\xe0q$\"y\f\xa9H\x9e\xdcm\x15S\xeed\x9b\f\xf5\xbaE\xe5\xba\x01\xa3a\xc2\xe99\r\xbd\xfe\xf3J\xa4\xb6\x91Zՠ\t\xaf\xec\xc4\xe6o\xec\xdfV\xc5\nq\xe2Յr\\\xd8Z\xb9fw5w\x95D\b\xb1\x18\x9b'\xf3~\xb8\xbcȌ\xe4n\xcd\xd6晄ݸָ\xca\xe5\xa4BC}o\x8c\xeb*\x17\xa2\xf0\xfew\xa94\xa1U\xa9\x8eo+uU}\xc8:u\x82f\xe9.\xdc\u008e\xc6Zx\xb7!I\xd9t\n\xbe\xd4h\x02\xa4\xa0\x92\xe6\xa0\xc3ҁ]\xde\xcf\x04f\xcc\xd6\u007f\x88)\xa1\x06\r\x87\x87\xaa\xeeo\x14\x82\x01\xac&a\x9a\xe4l6\xb7\a\x99P\x92\t>#>\xf1&\x134%\x86\x87\x06@\x15\x92\xdcQ\x99\x13J\x12\x9a\xcc\xe1Ė[\xa5\xa5\xc4\xf6\xfa\x1ah\xba\x1c)\x1d\x16\xf74\xaa\xb3\xf3\x06a~i\xb2\xde\xe8!p\xa7Љ?\x01M}B\xaa\xcf+\xf5Z[\xf3\xc0\x06\xc0\xf5Ц\x19\x9d=\x97\x86\x84õA\x9dc\xb86ȍ\xe1ڠ\xf6\x18\xae\r\x1a\xae\r\xf2c\xb86h\xb86\xa8{\f\xd7\x06\xe1\x18\xae\r\x1a\xae\r\x1a\xae\r\x1a\xae\r\xc21\\\x1b\xb4\xcf\x18\xae\rj\x8e\xe1ڠ\xe6\x18\xae\r\xdag\f\xd7\x06\xfd\x86\x9ba\x0f\xd7\x06=\xaff\xd8õA\xdb\xc6so\x15>\\\x1b\xf4L\xbc\xf4d\xb86h\xb86\xa81\x86k\x83\x86k\x83\xaa1\\\x1b\xb4q\f\xd7\x06\xd91\\\x1b\xb4a\xfcv-\xa5\xe1ڠ\xe7e)=w[`\xb86h\xb86(\xe8\xad\xc04ʔ\x054\x18ߧo^p\xa3x\xdfs\x83P2)\xa7S\x90\xa8\x1b\xe2\xcc\xd6\xf2H\x02\xc0\xfa\xee\xc6>\xb1\xd1\xe7{(\xd0'ب\xcf\xd6ӄh\xff\x9dS\xf2\x8dC\xee\xe8R\x11\t*\xac\xa6\x8cqr\xfe\xfemmP\x857\xfc\x8b\xe9x\x84+yϓ\xd8\xd4\xd9z\xeb;*\xebB0j\x13ȒL(\x9b\xdbdQ\x9c\xcc)\xe7\x909\xfb#(\xb9gN\x15\x99\x00p\"\n\xe06s\x90\x12\xc5\xf8,\x03B\xb5\xa6\xc9|lf\x1f\xa2\"\xbbmw\x9d\xd8\xebY*-\x81\xe6v\xfb%\xe4a=\xf0\xcd\xf4\bM\xa4P\x8a\xe4e\xa6YQM\x90(\xc0\x92\x1d\x15\x9a5\xec7\x15\x13\xa4\xc0\xa6\xf1\xc8\x12N\xea\x15X\xa4\x84L\xb3ً\x17-\xb4\x13\xbc\x02$/\xf4\xb2J*\x062e2\xa8\x904\xc9\x18\x1a\x02\xb8^\xdbg\x01\xe7x\x82\x96\xa0Ǝ\xea\x88\xd1\x10YbQ\xcaSԉ\n\xad0I\xb61I\xf7є)\xa7?\xab\x90\x04:\xaa\xbd\xe8c9\xd4\x18E\xd2M\xf1\xb3\xe13v/7\xa6\xd8h\xd4_gP\x87hH\x9e\xd9asV\xcfLN\x9a\xf7\xc1\xf82\x8f /\x03\xa6\x83\xd5Lӭ\x1fI\x9f\xc3\u009c}H\x80-B\xce>\xdd\xc0\xf9\x1e\x95\xf1i\x909㘶\xfc\x0e\x94\xa23\xb8\n\n[m2\xe80rU\x93H\x90J\x8f\x15\xbfZ44\xab:m\xf2P5\xa7\x1c\x004\xb7\xab\xab\xd2\xf1\xef$\xd3\x1a\x90d\xb1\xab2\xc6\xe9\x83t\xfa\xb5\x895\xbb۾\U000dfcdf\t\x11\x80\n\xf5\x1c\x9e\xda\xf4\xfc\t\x90\x89d0%S\xc6i\xe6r\bO\xb0\xebb\bmYg\x88R\xc6\xd8\x17ܧ\xa8y\xac\x8c\xc9w\xc1e\xf5Z\x96<\xc1\x04F\x97\x8c\x8e\xd5\xealJf\x98\xd7(mJ\xfd\x1f_\xfc\xf5\xcf\x01@'K\xa3\x93b\x90\\\vM\xb3j\xdb2\xe03CQV@\xd0,\xc4sW\xd7\x1eW\xbb\x8f\xf7\x10Z\x04\xbf\xfc\xfd\xed$JUׂ\x9c\xa6\xb08m\xd0\xe3(\x13\xb3\xae\x1b\x1e\xf7W\x93#\f\xeb\x8e#\x8c\x17\x06E\x1eb\xdfƕ\xccŝ\xedW\xde\xeb\xbc\xd5)\xf1\x85(\xca\xcc\x063\xdeV\x9d\x1c\xc2\xda\xe7\xacU\xc3vr\xaf0\xd3\xdcOkE\u07b8d]\xbf\x8c\xa0\xb5c\x99\x9cs2W\xdd[K\tc\xf2\x96fل&\xb77\xe2\x1b1S\xef\xf9\xb9\x94A\xadW=\xcel5\x10U\x9a$\xf3\x92\xdf\xdak\xd4\xfc\xd43\x11\xe2\x93\x11\xa5.J\xed+\x8c\x1a\x18\xad\xd6n\xfbۄ\x1c\r\xab\x0e9ե13\xb8\xc7Sw\xc7\xccQ\xe6\x04\xcc\xeaC\x84\xb9\xe1\v\x99\x98UsV̓\xfc\xfb\x17\u007f\xfc\x8be !\xab\x97\xe4//\xb0\xb8@\x9dX\x81\x83\xd2\xdb(\x8c9\xcd2\x90\xb1\xac\xc1\x90x\x17+xTN\xa0c\x0f\xfd#\x98\xae77ߣ\xddʴ\x82lzbKS}\xcf\xfd\x00\x90\x87\xa8Z\x1d:Yh\xf4\xf7\xa76\x0e\x17\"+sx\x03\v\x16\u007f\x9dp\v\x86\xaf\x86ɘ\xd2D\x84\x984\x93L$\xb7$u`\x1a9\x86\xabw\x19폑\xe0<ʍ\xebj\xdc\vIIN\x8b\"\xd49\x8cł\x92\u07b5\x96\x89\xdc\x02\x9b\xe9D9sc#\x1c\xf6\xe3aʰ\u007f\xb3\x81\x9f\x1a\x8c\xdf\xf4\x82\x06\xf7\xbe'\xbe\x1eg\xad\tr\xd5i\xdd~'\x18\xaeׇ\xccn!\x17\ru>G\a\x02b\xf2K[\x98\xe5\x95\x0f=\xa7\xda\xd9\tQ\x11$\xa4\xba\x02\xa4b\xca(\x16\x1f\x91\xa2_g\x94\xe5ε\x15\f1<\xe4\x14}\xf5G\xb8\xaf~Ԡɠ\xd7\x02\x91ۣ\xf0=$\xdb\xd22 \xbc\xba%\x967_\x89ԁA\x96j/\xd91\xc6`\xe0\xe6o(\xee\xeb\xa3\x04\xf4c\xce\x1fkܴy\xb3\xf9%\x8a9[\x88\x9f\x88%\xe3\xb4{sd\xe4\xc5n\x01\xfd\x1a\x844\xdd\x1b\x8e\x80\x1a\xe6\x8e\xf3*\x8cm\x8eG0pC1nj\xe4\xf0\xd5\xe1\x93\xf1e\x8bd)\n:\x8b\xb8lu\x05\u05eb\xc0H\n\xd6\xc0\x88(i0\xe6(\xc2S\xbe\xdb)B\x85\xb4\xea\x02\x16\x01\xd2\x16b\xd5\xf2ԛ,\xb6\xc5\xc4]p\xce7!T\x8a\x92\xa7֧^\x87Wޭ \xe2R\xf0\xf0\xe92Uu\xf14\x8a/\x83\fK\u007f\xb1A\x00\xe3\xe4\xe5\xf8\xe5\x8b_\x8e\xf8\xc65\xac\x88\xef\xa8\x16K\r\xbe\xf4d\xab\xf7Wn\xf5\xc2\xc0;\xe7v\xac\xef\xc8bq7\xdb\xd8\xf9\x8c\xee$\xd3иH\xfc\bM#c\xe16\x1a\v\x1d\x87g\x17\xf4\xbc\x80/\xfe\n\x12BT9yp~o\x19u0\x16\x90\xc9ty\xa4U,\xc4\x0eQ\xd1D\xf5Ax\x87\xcb#;\x93C\x85\x9d\a\x82\xb7:\xfa8\xb8m:\xbf/\x82{\x97\xb7\xb6\xea\xfc\xbe\xa0\xe8\xf7.\xda{\x16\x8c\b'\x8c7\xefY,Ď=\xfb;\xcc\xe9\"B\x9e)\x96\xb3\x8c\xcali6\xfb\xdab\x90LJM\x80/\x98\x14<\x8f\xb9juA%\xa3\x93\f\x88\x04l擀\"\x9f\x1f}<\xfb\x80\x99E\xc7Fr\x06\xc3\x04\xbf+\xa5b|\xb6F\xfd\x8d\xe9\xf6\xe3-\a\ak\x04\xec\xf1b(+\\\x12\xf3\xb4«\xd1\x18\xf2R\x97\xf6~\xd2\xfb$+\x15[<\x95\xbc\x88\xb3\xd2*m\xf7W`\xa4\xb9\x06+oX\x00\u007fXi#S\x13\xdcZ\xb7\x96\xc0p0*euC\xb1Δ\x8d \x0e\xe1\xefOl\xf6\x90u\xced\u05f6ʦ\x9f\x87\xf7\x1d_K\xad\xc1\xa6\x81O\xebV\x0e\xa3\xde\x00\n\f\xa4\xbd\x10\xaas9\x82\xfbL\xb9\xad\x94\xda\xf7\\\x0fo\xeb\xaf\xcb\xe9=&\xe0Q<\x90{\xadLLmR\xc4G\xc8@\n/4\xee(\xd3Ue\x02\xe3L\xbf\x0e\xbbp\x19\r\x15۪n\x9f\xed\x0e\xd8\xe8=wb\xaf\xc7vm\xd3vr\xdaB>;\xbe\xbe\xf9\xbb\x1b_d<\xc9\xca\x14^g\xa5\xd2 ?\x80\x12\xa5\xec\xf0\xf0\xafDG;\xdfi\x14\x1dܹPJb\x1f\x19\xa9D\x14\x1d\x87^֯V:\x85\x9bP\xea\v\v\xb1^\xc5\x1a\xceU\xf7\x05\xa5\x85\x84\xceD(^f\xd9J\xfa\xbb,\xd7H\xc5<e4\x84\xce\xcc\xe0͚\xba\x9f\x9a1\xd1TA\xf7DS\xe3q\xdb\xccNe,A76\xf7\xff`\xff\xcb\xcc\xd6}bm]v\xe7l\x9e\r&/bt\xf1\x04ۊ\xf3\x1a\xbe\xad\x97\xb3\x9f]]\xf4\x067ږ#\xb2\a\x9a\xd6i\xcd\u007f>\x88\x94\xea\xa7WP\xe4)d7\x86։\xa3\x89\xa3\x9a\xd2\xdcs\x13\x9aܖ\xc5s@\x18\xde0t\r\x19\xca\xf1\xad\xc8\xfa\xa6\xf9\xa4ET\x0e\x9a.^\x8e\xdb\xffblT\x96i\xccB\xedP\x9d\xeel&\x9d\xc1\x93Q!\x18Oق\xa5%\xcdZT\xd6\xc0R\x8dL,Q`ٺq\x8eM\xc2\xdc\xdb-\x9c\x12\x9f\x0e\x15t\x06\xb7yG\xd1Ub\x94a\x97\x10\xd9\xc5D\xdb\x0e\xb8\x95\x17,\xe6\\\xdc\xd1]\xf0\xa4<\xee\x1ck6\x9a\xfc\x86\xd2\xc5\x1b\xd7\x00\xc6?\x85\xeb=\xbb|ӭ\x80lq^\xb7&y\xb6e\"\xeeLT\xdb;\xa7\x95[t\x93\xd4\xc4LyuB(\xb9\x85\xa5M\xa0\xa4\xdcu\xe7\xf4 \xf0~\x18'\xe0o\xc1\xa6*\xd8\xf7\xba\x17\xbe\xdbe}\v[\xbcA\xad\xe5\x9a\xef\xf9\x000\xae\xdb\xfcP\x05\U000aa97a+\xb7\xb6\xc9\xe3-Ѻ=\xa4\xbf\xc7ȞӮ\x10(\xc1\x90\x93\xdd~3wc\xad\xe1u;\x82\xab9+\xb0\bgˬ\xf1\xba\xe1\xa9\xc7vu\x19\x8b\x05n)ꂟ\x90K\xa1\xcd\xff\x9c\xdf3\xa5Վ\x1e\xd3o\x04\xa8K\xa1\xf1\xd9^(\xb1\x93\xda\x13!\xf6a$Pny\x1b\x96\x92 \xfcjy\x17Swa\x85]ߖE0E.\xb8a2n\xe5U3l\xe5\x80\xfbz!.\xf8\b9\x92\x87\xbe\x05h\xb5iLyT\n\xd9\xc2׆\x0fm\x819\x01\xe2>\x8f>\\\xfb\x0e\xa6\xe7\x16\x19M \xf5mt\xa9\xc1\x05\xd50c\t\xc9An\xbd^\xbb0|j\xf3\xd6\xed\f\x83\xed\xa5\xec\xf6UMo\xa1\xfb\xbd\xd1\xf6\xed\x8dV\\\x1d\xbfG\x01\u05f9z\x9a\xfa\x8e\x9cW;\xf8\xd3\x0e\xfc\xac\xcb\f\xfbQ'hia(\xfb\u007f\f;EB\xf9_RP&\u0558\x9c\xb9J\x82\xceo6\x9fw\x9aG\x13\xb4\x81\xca\x1418_\xd0\fl\xdb6\xca\tآ\xd8N\x90b\xba&ь\xa1-\x94\xe5\xe2UH\xe4\xe0\x16\x96\a'\xad\x93\xb7)\x81\xed\xe0\x82\x1fTY\xf6\xeds\xe0\xe5\x8cm\x0f|\x80\xffv0^\x13\x82\x9d`\xb7\n\xc6-\x14\xb1\xf1\x9f*M\xf7\x9dM\xacY\xdd\xe7\xfdha\v\x1d\xac\xf5\xafi~\xadE\bM\xb5\xb4\xa5¯\u007f\x8e\xca\x19\xe8.e\xdf\xe9\xaa\x18f\x1f\x933\xbe\\\x83\xda]f]\x99H\x15E\x15\xad\x0e\xebB\xbaD\xee& \x976\xa3hn\xe1\xaf\xee\xc9F\xa4\xa3\xa0\x93\v\xb8\x14)\\\t\xb9\x9eaЎ4\xac>\xdda\x156\x96.\xb2\x14s\xa6\xf1ѵ\x85\xa2\x1e\xeatЇ1\xe1\xdcw\xaf>n_Ň\xea\xb1\xedӧ\xd8\xcf\xd7\xed\xc6\xd5\xc7\r+P\x9c\x16j.49Z0\xea\xaaND\x99\xba\xa6\xf3r-F\x11\xb96\x95\xcc!-3躗d\xad\x81\x8f\u007f\xd0ka%g\xff*\xdbW\xb4xύ{z\x9d\xb0k<Tfiçh\xd8\xc9\xdfq\xef\xfcw\x9c=\xe6\xe0\x1a\x8a\xed\xf2\bT\x00-m\v\xa5\xb1\x8e\x84\xebF\xcb\no\xbe%\xae}\xb0{\x9c\xa9j\xb6\xdd\xe4\xbdv\xe8\xbb\xc4\xdd\xc8A_\x89\xc4v\x1e\x10\x9b!\xdd|\xbb\x8b\x8e\xaem\x1euB\v]J\u007f\xa1Q)\U0006207a!2\xf5\x98qHh\x00ݤz;_\x18\x13\xfc\x86\xe5\xa04͋\xad;\xffz\xfdy\x83\\!S;){\x9d@mF\xd7\xfd\xfc\u05edHZ\xdf\x1a\x91\x8e\x1b\x90-\x10ԅ\f`H\t,\x80\x13Wp\x81\xb1^k\xa2\xaf\x81\xb4\xb7\xcb\xca\x05z\xb8=\x14\xcc\xec\x9c\ni\xfb\xfcW\xd3^=j\xbe\xac6\xa5\x1aF\x1d\x05\x87{\x9c\xa9\x0e\x89\x80\xc9\xd9\xdbY\x05f\xaf;%!\xc1,#\xb3\x95Yf\xdf\xf5\xf9\xe3\xf6\x02\x11r\a\x12\xc8\f\xb8Aj\x87?\xcc)\x8d\xb6\xbd\xbbA\xa5;\x89\x95S\xe3\xc6v\xc27\xb6\xba\x9d\x1a\xca؊\xe3oR\xb0\xcc\x03t\xb6\xe1Lt\x15\x14\xbb\\\xfd\x0f@\xd5z\xf6Kk\xf9o\x9bO:;\xc0\xaeܚ\xa9\xd4^\xb1a\xaf%b\x12:\x88\xdbMF\xe0W\xf7<\xb7\x84\x14s\xaa\xb6\xb3\xb9+\xf3D\xd5t\xbfq\xdc*\x0e\xf7\xa1s.\xc0\xcb|\x15\xf0\x88\\\xc2\xdd\xdaoo\x91\xa0\xd1z\xeb:$#r\xc1\xaf\xa4\x98\xc9\xf5\xfeW#\u007f`֨`D\xae\xa8Ԍf\xd9\xf2mW\xb7k\xffս\xf1\xe4&\xb0Cjۇju\x8fq{\xa2\f\x15\xd2\t\xf6\u007f\xaf\t\xf1P\xd54\xba\xb6\x91\xfe\x83ccā7\xeaY\x1b$\xa6\xec(=\x82\xe9TH{i,\x19\x8d\b\x9b:\x1e\xb8.H)\xcb\xec]\xd3\x18\xec!L\xd7&V%4\x84$\x94/\x89Db<\xc1\x1bS\xe8\xd2:\x95i\x92\x94\xe6Н*MץC\xb43\n\x8d2GF\x9d6S\xdb\xdb\xd9|\xba\xba\x15\xad\xea\xc0\x80\xc0,\xc2\xe6t\xe1O\xfa\x86\xebt\xb1\x9e\xb9\xd2\xf6\x949=]\xa6\xd7\xf6\xa6\x01X_v\xb1ɰl\x87\x85\xaaG\xfd\xc4mq\xda\xda\xf4ES\r\xdd\xe4Bcʿhv(\x99c?\x11=\x97\xa2\x9c\xcd=\xb1mb\x83\xddδ\x12\xcb%\x8b\xac\x9c\x19\xf2u\x0e.]Jް\n\x9c\xcb+\xad\xa7\xba\x19\xe46\xc4mԕUKFmW\xc2Z\x8f\xee)\x85\x8d\xd4\xed\xe0\xee\xb6 \xf8\x19\xca\xcfE\xc5\x1a\xcfwKҏ+\x0f\xaf\x84\x02\x8cL\xad\xe1y\xf9w\xc4փ@\xe85L\xcclWo\x9d|\"\x97\xfe\x1d\x95\x9c\xf1\xd9\xf6\xe5~\xe7\x1e\xeaP\x1d\xdc\xfb\x8f\xa7<\xf8\t\xb6Շ5\x90\x96\xc2CՇ\x8eӱ\xf2\xd3\x02\xa4\xb2\xee×\xf5_\x88-\x1b\xfbt\xff@,5\xa7\rܻ\xa9\xb8_j\xed\xdbV\xf7\xbbX\x9bE\xfb-\xe3\xe9+\x9fAVd\xa5\xa4\x99\xfb3\x11\xdcr\x04\xf5\x8a\xfc\xf0\xe3g\xc4aࣟ\x87\xf9\xf1\xff\a\x00\x00\xff\xff\xdfޱ\xd6\xfe\xba\x01\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko#\xb9\xd1w\xff\x8a\x82\xbf\xc3|\x01,y\a{\t|\x9b\xf5x\x11a'\xb3\x83\x19\xaf/A\x0eTw\xc9b\xcc&;$[\xb6\x12\xe4\xbf\aU\xec\xb7\xfa\xc1v\xec`\xb20O\x12\x9b,\x16\xeb\xcdbu\x9f\xadV\xab3\x91\xcb;\xb4N\x1a}\x05\"\x97\xf8\xe4Q\xd3?\xb7~\xf8\xa3[Ksyx\xbfE/ޟ=H\x9d^\xc1u\xe1\xbcɾ\xa23\x85M\xf0#\ue916^\x1a}\x96\xa1\x17\xa9\xf0\xe2\xea\f@hm\xbc\xa0nG\u007f\x01\x12\xa3\xbd5J\xa1]ݣ^?\x14[\xdc\x16R\xa5hy\x85j\xfd\xc3\x0f\xeb\x1f\xd7?\x9c\x01$\x16y\xfa\xad\xcc\xd0y\x91\xe5W\xa0\v\xa5\xce\x00\xb4\xc8\xf0\n\\\xb2ǴP\xe8\xd6\aTh\xcdZ\x9a3\x97cB\xab\xdd[S\xe4W\xd0<\b\x93JL\xc2.\xbe\x95\xf3\xb9KI\xe7\u007f\xe9t\u007f\x92\xce\xf3\xa3\\\x15V\xa8\xd6z\xdc뤾/\x94\xb0M\xff\x19@nѡ=\xe0o\xfaA\x9bG\xfd\xb3D\x95\xba+\xd8\t\xe5\xe8\xb1KL\x8eW\xf0\x990\xc9E\x82\xe9\x19\xc0A(\x99\xf2>\x03n&G\xfd\xe1\xcb\xe6\xeeG\xc2#\x13\xa1\x13 E\x97X\x99\xf3\xb8\x1aE\x90\x0e\x04\xdc\xf1&\xc1\x96\xec\x00\xbf\x17\x1e,2.\xdaӈ\xdc\xe2\xaa\xc22\x05cK\x98\x009ZiR\x99\xc0O\"y(\xf20\xd5\xedM\xa1R\xd8\"\xd8B\xaf˱\xb959Z/+\x12RkIM\xdd\xd7\xc3\xf4\x1dm%\x8c\x81\x94\xe4\x04\x1d\xf8=B\xc9mL\x99z\x99\x00\xb3\x03\xbf\x97\xae\xc1\x9bI\xd2\x02\v4Dh0ۿa\xe2\xd7\xf0\x8d\xe8l]\x85mb\xf4\x01-\xed;1\xf7Z\xfe\xa3\x86\xec\xc0\x1b^R\t\x8f%G\xab&\xb5G\xab\x85\"&\x14x\x01B\xa7\x90\x89#X\xa45\xa0\xd0-h<ĭ\xe1\xcf\xc6\"H\xbd3W\xb0\xf7>wW\x97\x97\xf7\xd2Wz\x92\x98,+\xb4\xf4\xc7K\x96v\xb9-\xbc\xb1\xee2\xc5\x03\xaaK'\xefW\xc2&{\xe91\xf1\x85\xc5K\x91\xcb\x15#\xaeYM\xd6Y\xfa\u007f\x15\x17ݻ\x16\xa6\xfeHb㼕\xfa\xbe\xeef!\x1e\xa5;\xc9r\x10\x8f0-\xe0ߐ\x97\xba\x88*_o\xbeݶEG\xba.͙\xda-ij\bO\x84\x92z\x8760ngM\xc6\x10Q\xa7\xb9\x91\xda\xf3\x9fDI\xd4]\xa2\xbbb\x9bIO\x9c\xfe{\x81\xce\x13\u007f\xd6p\xcdւd\xae\xc8S\xe11]\xc3Fõ\xc8P]\v\x87\xafNv\xa2\xb0[\x11I\xe7\t\xdf6r݁\x81Zuwe\x8c\x069T\xe9\xf0\xb7\x1c\x93\x8ej\xd0,\xb9\x93\t+\x00\xec\x8cmTܵ-ָ^\xf2\xda\xe5\xd0n\xef\xa4\x1d\xb9\xb6F\x03>\x11\xa7\x1b}%9yܣ&-\xb2\x85&\f{\x10\xa14\x1e\xeb^\xff \xed\xf8\x01f9)\xe3$j\xb7\xe5 B\x8d\xa8\x92\xd6N&\x98\n\xacM\x96)-\x15\x98a\xecrk\x0e2-\xedA\x8fzS\x14\f(\xedD\xa1\xfc\x9dQE\x86\xee\xd6|E\xe7er:\xae\x87\xfc\xc7\xc1i\x15g\xd1\x11E\xfd\x1e-)\x1e?`\x1b6\x00\x15X#\x1c\xa6l\xc4\xc4\x03\x82\x80m\xd87YC\xa5 7)\x1c\xc2:\xb0=V\b\xf7y\xd1\xf0ck\x8cB\xa1O\x9e\xe3S\xa2\x8a\x14\xd3\xda7\rP\xa3\xb7˛\x93)\xec\xe2\x85\xd4$M\xe4P\tI\xdd<%\xef2\xb8Ia\x11H\xfd\xa5\x0e\x10A2+˭\x0emFz\xcc\x061\x9c\x90\xbb\xd0(\x84\x10[\x85W\xe0mq*-\xd5|a\xad8\x8eR\xa9\n}\xe2\x89T\xcf(\x8d\xb2\x92\t\x12yj\xd3\xcbt\xfa\x1d\x90ho\xcc\xc3<Y\xfeD\xa3\x1a\xb7\x02\tG\x94\xb0Ž8Hc]?\x12\xc1'L\n\x8f\xc3:\"<\xa4r\xb7CK\x90\xf2\xbdp\xe8*#1N\x9e)\xb5\xa7f\xa7X|\xb2\x9f\x86\xbd\xc4(\xa6\xc1\xd8\x16\u061c\x8e\xc0\x04F\x98ln\x91\x83ԩ<ȴ\x10\n\xa4v^\xe8$\xecKԸ\r\xed\v\xa6Y\u007f\x82y0\xa3\x15\xfeė\x8eG2\x1a\xc1X\xc8\xc8\xfd\x9e\x0eu\xa3k\xc0\xe8\xf6\xb7\x82\xecY0\xd6`)\x9e.\x17K\xd9\xd95\xf6\xe2b\x02x͝\x10\xb4)\xb1E\x05\x0e\x15&\xde\xd81\xb2\xcc3=\xb48[8B\xcf\x01\xab\xd8\xd8}\xdar\xb3\xc1I\xa0@&\xffq/\x93}\x88\xafH\xa6\x18\x12\xa4\x06\x1d\xdb\x02\x91\xe7\xea8\xbeY\x98\x97\x84r\xa1isдY\xc3Ї9l\"\x9a\x16eO\x9b6cY\xbbt\xaeE\xe4\x8d̕\xd3x\x96@oN&\xbf\xb4@\x13\x81%\x9d\xad6;\xc0,\xf7\xc7\v\x90\xbeꝇIaP\x83\xc3\xef\x82Q\xcfчM\u007f\xee\v\xeb\xc3\vp\xa9F\xe1\u007f\x9aI\xecl\xbe\x95\xbef\x01\x83>\xb5\xe7]\x80\xdc\xd5\fJ/`'\x95G\x8e{\xa6Ql\xb9\xbeYN\xbd\x14Y\xe2\xbc&\xb5L\xf8d\u007fS\x1f!g\xc7\xf7(ԟ\x1eb\xe5\xea$\xd1u\U000b34013\f\xd2b\x16\xf2\x16\xb7\xac\x03M\x0fGj\x1f>\u007f\xc4t\x9aP\x10+\x91'\xdb\xf9\xd0C\xb9\xbd|y\f\x88\xdfL\x19P\xd5'\xac\x90\x8f\xba\x00\x01\x0fx\fQ\x90\xd0@\x8c\x12\xb4\xd4\xe8A\xe2\x94H\x9c\x18c\x13\xf1\x80G\x06T\xe6\xba\"\xe6ǋFh\x0fx\x8c\x1b\xd8#%aVf\x02\x02M\xa9\x83\t\xc2)\x93%d\x04\xce\\\xb2\x86\x8071\x9b\x84%\xe6\xa6j\x15'\x9e\xb5ݚ\x8d\x9d4\xee\x03\x1e߹\xc00Ҏ\xbḍ7L\x06\x18\x1c\xb2\x1eU\x99\xcc;\xa1dZ/\x15\xf4a\xa3\xa7\xa2\xeen\xfbl\xfcF_\xc0͓t\x84\x9eN\xe1\xa3A\xf7\xd9x\xeey5\xc2\x06\xf4\x9fE\xd60\x95UO\a3O\xf4h'H\xa3\x84>\xb4M8a֬\x92\x0e6\x9a\xceJ%]8\xcd\xcd0\xe3ŒQ\xca\nǙPm\xf4\x8a\x1d\xedz`\xadh\x98%{\x8c\xedp\xa7\x8d^k\xd9h\xa8t\xa0\v\xa8ݒ\xef\t\x10B\xfa^\x89\x04SH\v&\xaa\x88\x86\xe8\xbc\x15\x1e\xefe\x02\x19\xda{\x84\x9c|A,7\xa2\xedsh\x8be.64\xa8Zi\xe8\xd3\x18\x94V\xa4\xd7Q\xe3*\xf6G\f\x1e\xccGO\x0f\x8e\xd9\x1b;h\x8ec\"\xa8-Ҕ\x13\xb6B}Y\xe4%\x16q\xe74~\b\xe8\x05\xff\x9a\tN\x94\xfe\x93\\$\v\xfb\xbf \x17\xd2Fi\xf9\a\xbe\xe2Sؙ]f\xdd\xda\v\xd1\x1a\xd2\x01q\xfc T\xff\xb6cd\x8b\x86,\x10\xaa\x10\n\x98\xddI\xe4s\x01\x8f{\xe3\x82G\xdeI\x1cI\x0ew\x9btp\xfe\x80\xc7\xf3\x8b\x13\xbbt\xbe\xd1\xe7!D\xe8k}\x04\xd8:\xe20Z\x1d\xe1\x9cg\x9f\xffg\xe1T\xb4tF\x0e\xe4\xfb\xe0\xf8@\x9cN\xb2U4AS\xeb\xcbG\n\xa1\xa7\xb1\x8f\x94\xcd\xdc8\xbf\x00\xa1/\xc6\xf9\x90\x11\xed\x04\xbc\xcb\xf2m\x10\xe4\xaa̳\x81\xd8y\xb4༱\xd5U\x1f\x19\xc9^ژ\xb8\xe8\xe6\x0e\x1c\xc4\xd8:{\x17\xc0\xd2a\xee\xbc\xd1\xef`\u007f\xcf\xc3\x1d \xfd\x9e\x83\x98p\x00Ðsk\x12tnNl\xa2,\xfcLb\xb3Nj\x8apX\u2ef5Ya\x9dK\xb6VmI(L\xe4\\|\x9c\xb8yj\xe5e\xc9|\xd0\xffy\x91]\x8e\x1d\xb0\xd6g\x99\xd0Q\x0e\xec\x04\xd1\xeb0\xb7R\xb1\x12T8\xa2\xd8\xfb\x82\xcdŒȵ\x14\xbe\xef'\x18Ȥ\xde\xf0\"\xf0\xfeU\u0087\xda\xe8\xe2\xf3\x8e\x0f\xd7\xd5\xec\x86\x05u\xc7\xf0%\xe9X\xcb\r\xdfWX\xecp\xf24\xab\xbf(l\xd6ƷS\x1f\x0497\xe9;\a;i\x9do\x90\x8d\x86)\x1d_\x92\xbe\xdeYN\xdfX\xfḅܯan+\x19\xb77\x8f\xf5\x85\xfe\xf8\xc5\xefP\xe3\xeb1\x04\xb9\x03\xe9\x01ub\n\xcdI#2\x06\xbcH`G\xbc C\xac\xdfk\x1a\xea\"\x8b%Ċ%Q\xea\x99\xfcR{\xc2\xcfB\xaa\xd7b\xa3\x97\x19\x9ab\xc617\xad[\x8f\x10\xe6v*52\xf1$\xb3\"\x03\x91\x11#\xa2INц̰+\x03\xf0(\xa4g\x8fD\x90\xd9=y\x13\r21Y\xae\xd0#lqg,뻓)֮\xbf\x94\v3~\xef\xd8o\x02vB\xaa\xc2F[݅\xdcXvB*\r\xcf\xcb\x1e|bQX1\xf9\xa2\xd2\xd1\xd1!\xed\x9c'\xc8풀\xf6\x8bŗ\x0e\x1fs+I\x16\xcd\\\x049\x03\x91\xe3\xcbn\x04Y\x8a\xa8\xd0Ǳ\x10r\x06&c\xf1\x16B\xbe\x85\x90qp\xdfBȷ\x10r\xbe\xbd\x85\x90o!d̄\xb7\x10\xf2-\x84\\\x8e\xc2\u007f?\x84\x9c\xc7lŹ\xcf\xd1\xc7\x11\xd8D\x95\x10L#;\xb9JY\rs\xad\n\xe7\xd1\xc6\xd7\xdcn\x86\xe7\r\xd4_'aȊ_\xcc\x19\x96\x8d\xa6ܢqYu\x11.)[\xa5(\xa1^\u007f6:\x8e,\xae\x1d\xabӎ+\xe5\x9a+\xe0\xea\xd6 \xd7\xc5SU\x11\xf2\xb0\xd5(\x97.\xb9\x15\xde\xf8hW\x03u\xeb\xb082\xaf\xb0\xfd\x8eꓣJ\xacf\n\xab\xa6K\xb8M\xb5\xc6\b\x15;\t\xf8.\xf9l\xa7\x8c\xf8;\xa4\xdel\xed\xd3x\xc5Sy#\x87^\x1cޯ\xbbO\xbc)\xeb\x9f\xe0Q\xfa\xfd\xe0\xa6\xf8\x1d\x14:.\xea\xfbvat%\x8b\xe5;^}\xaa\x82\xb1\xa0\xa5\x1a\xaei \x82W\xf3;\xe4\x86_\xf3p(}\x96\xfe\xce\x1d\x93bk\xa4\x9e]\x19խ{\x1a5\xf0\xcb/\U00016515\xc7\xd7>͕*-\xa9xjW3M\x80\x8c\xads\x8a;\xf1\xce\xd64=\xa3\x92)\xba\xae\xf2E\xae+\xe3j\x95^\xa3BiA]R\xb7\xdeh\x06\xee\xb2j\xa4H2\xc5T\x1e-\xae7*k{f\xf6\x13Qe4Z=4\x03z\xa0\x8ei\xbefh\x8e\xfc\x1dT^\xa4R\xe8\x19\xf5A/[G\xfcRQ\xf7T\xb5OD\x8dOD\\>\x87iD\x15ϲڝ\b\x1a>\xb3N\xa7\xae\xc2\x19]{iuN\xb7\xf6f\x14lLM\xceH\xc5\xcd(\xcc\xc9J\x9c\xd8:\x9bQ\xe8\xb3\xee{Fr&\x1f\x1b\x9b\xa2\x9d\t\x9a\xe3efF^\xbaɯ\xdeʽ\xb7\x1a\xaa\xb7aiT;\x18\x1f\xa6\x93\xa9k\xee\x13\xf8E\xea4\x90\x97d\xa4\xe5\x96\xf9\xd5y\xae\v\xaac\x04\x96\xd2A\x88U\b\xd6;\x048\xcc\x05٫\x14\xb6ǐzpk\xb8\x11ɾ;p\x10\xe4^8:Xf\xc2\xc3y}\x9e\xba\xac\xe6Q\xcf\xf9\x1a\xe0gS\x1f_\xdbo\xc39\x99\xe5jX\xed\v\x87p\xde\x05\xf3\xfc\xe3\xc1\x88\x9c8-r\xb77իϳ'\x84o\xdd\xf1\x03G\xf4\xea\xc5\xe7D\x99\"\xadᏲW\xe8#|\xb9\xe3 \x84_\bM\x9aWe\xcb0\xa3\n\xf9\xfbo\xd2\x0e\xbfž\x80$cGv\xe7\x8d\x15\xf7\xf8\xc9$\xad\xcf|LѤ;\xbe\xf3\x15\x87\xd2HTI\xb9\xb2zm\xf8xS\xee\xa8\x0f\xae\xc9ŗ\xba\xd3\xe45\b\xd3a\xfb1\xa9\xb1ޫ\xd9M\xdd\xde~\n\x1b\xf12\xc3\xf5\xc7\"\xa4KV\xb9\xb0\x0e\x89\xb6\xd5\x06ä\xed\x98#ٛGP\xa6\xdc\xfdO}\xfc-r֟\xf32\x8bw\x11ޢ\xaf\x04\xb2\"\u05fc\b\xdf\r\xcfk\x9d\xd0ZLc}\x1e\x93\xdd1H\xc29\x93H\xb6&t>\x0e\t\xf7\xd7x\xf1{<p\x18U\xfa\xc2ᯏ\x1a\xed\xd7J\xdd\xdcF\a\xbeL~\xdeᷓi\x153\x87\f\x00Y\xae\xde\xf0S\x0f\xa5K\x92\xb8\xf0\xe1\xa0`z\x99T\xd5g.\xfaĚ\xd4\xeb1\x9d\x1e\n\xf1VCߖX\xd5\x1f\xba8\x9b\xa1\xa3\xf3\xc2\x17n\xfeK!<\f\x12\x91\xfb\u0096\xee/),\xbf\xf5N 0\xbc\x1c\xbe\xfc[!J8\x1f\xc1\xb3O\xf5\xb0\xe6\x00\xeb|\xb8\x88\xa8\x8c\r<\n\xc7\x1f\xe3\b\xd7\x10-⟚\xc5\xe1\a\xc1\xf3]A*<\xae\b\xf6r\xa6\r\xc87\u007f\x15`rw_hD}\xd9Y\x92\x95\xa7U\xdf\x12\x18\xd9\xc9\xd0m\xd6\n>\xe3\xe3Iߍ&\xc4\xfbi\xe6pa\x85\xe9]\xfd!\xa8\xd8M5\x9f\x8e\xe2\xfb\xc1\x13\x9d\uf6a9\xde\xe0^\x12S(Ղ\x17\xee\x02\x1d\xfc\xbf<\xf5-\x9c\x99Hh'\u007f\xe8=\x1b1<\x13Fg\xd8\xe0\f(I\xaf\xab\xf9X\xd8\xfb\xe6\x1f/\xbd*?\x0ev\b\xd7\xea\xfc5\xae\xb4%+\xa53.{\x1a\xcd\x13I\x82\xb9/\x93\xe4\xed\xaf\x84\x9d\x9f\xf3\x9f\xea#`\xfc71:\x84\xba\xee\n\xfe\xf2\xd73(\x1d\xe7]\x85\au\xfe;\x00\x00\xff\xff5<\xc4\xf5XM\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\xe44\x13\xbe\xe7W\x94\xf6=\xec\xe5MzG{A\xb9\xa1\x06\xa4\x110\x1aM/sA\x1c\x1c\xa7\xd2mƱCU\xb9\x87\x06\xf1ߑ\xed\xa4?\xd2\xe9\x9d\xdd\x03\xbe\xa5\\\x1f\x8f\x9f\xfaH\x15eY\x16j0\xcfHl\xbc\xabA\r\x06\xff\x14t\U0004bad7o\xb82~\xb5\xbfkP\xd4]\xf1b\\[\xc3:\xb0\xf8\xfe\t\xd9\a\xd2\xf8\x1dv\xc6\x191\xde\x15=\x8aj\x95\xa8\xba\x00P\xceyQQ\xcc\xf1\x13@{'\xe4\xadE*\xb7誗\xd0`\x13\x8cm\x91R\x84)\xfe\xfeC\xf5\xb1\xfaP\x00h\xc2d\xfe\xc9\xf4Ȣ\xfa\xa1\x06\x17\xac-\x00\x9c\xea\xb1\x06F\x8aF\xa2$0\xe1\x1f\x01Y\xb8ڣE\xf2\x95\xf1\x05\x0f\xa8c\xe0-\xf90\xd4p\xba\xc8\xf6#\xa8\xfc\xa0Mr\xb5I\xae\x9e\xb2\xabtk\rˏ\xb74~2\xa3\xd6`\x03)\xbb\f()\xf0Γ<\x9c\x82\x96\xc0L\xf9Ƹm\xb0\x8a\x16\x8d\v\x80\x810]\xfc\xe2^\x9c\u007fu?\x18\xb4-\xd7\xd0)\xcbX\x00\xb0\xf6\x03\u0590\\\x0fJc\x1be\xa1\xa113c\xb8촆\xbf\xff)\x00\xf6ʚ6\xf1\x9a/\xfd\x80\xee\xdb\xc7\xfb\xe7\x8f\x1b\xbd\xc3^e!@\x8b\xac\xc9\fIo\xe9\xf1`\x18\x14\x8c@A<(\xad\x91\x19t B'cL0\xae\xf3ԧp\xa3c\x00\xd5\xf8  ;\x84甓\xf1\xe9ը0\x90\x1f\x90\xc4L\xe8\x93ɩ>\x8f\xb2\x19\xc6\xf7\xf1\x11Y\a\xdaX\x91\xc8)\xc6XW\xd8\x02\xa7\a\x82\xef@v\x86\x810\x91\xeb\xe4\x12]\xe2\xa4\x03\xe5\xc07\xbf\xa3\x96j|=\xc7,\x06\xdb\xc62\xde#\t\x10j\xbfu毣g\x8e4ĐV\xc9T@\xd31N\x90\x9c\xb2\x91\xfe\x80\xff\a\xe5Z\xe8\xd5\x01\bc\f\b\xee\xcc[R\xe1\n~\xf6\x84\x89\xc0\x1av\"\x03\u05eb\xd5\xd6\xc8ԑ\xda\xf7}pF\x0e\xab\xd4W\xa6\t\xe2\x89W-\xeeѮ\xd8lKEzg\x04\xb5\x04\u0095\x1aL\x99\x80\xbbԐU\xdf\xfe\xefX$\xefϐ\xca!\xd6\x13\v\x19\xb7=\x8aS\x8f\xdc\xe4=\xf6G\xae\x86l\x96\xf1\x9f荢\xc8\xca\xd3\xf7\x9bO0\x05M)\xb8\xe4<\xb1}2\xe3\x13\xf1\x91(\xe3:\xa4\x9c\xb8\x8e|\x9f<\xa2k\ao\\\xae%m\r\xbaK\xd294\xbd\x11\x9e\xaa4槂u\x9aK\xd0 \x84\xa1U\x82m\x05\xf7\x0e֪G\xbbV\x8c\xff9\xed\x91a.#\xa5o\x13\u007f>N/\x153[G\xf14\xeb\x163\xb4н\x9b\x01u\xccY$.ښ\xce\xe8\xd4\x06\xd0y\x02\xb5dR\xbd\x89!O\x99\xafA1Έ\x8cc69b\x0f\xbe\x85ciT$\xf9N1^\x8afh\x1e\xa3\xc6<\xb25\x1dꃶ\x98\x1d\xe4I\x81o\x81\x88\a]\xe8\xe7\xf1Jx\xc0\xd7+\xd9#\xf98'Ӥ>?\x8b\xf9\x87\xfcs\xd9\x1aǟ\u007fM\xd6I\xbf\xab\xf3\x91{6jG7@\xc1\xb9ؑ\xdeE\xf1\xcc)\\N\xe4٭\x11\xec\xafp,\"\xb9w\x9dO\xbf{\x15C*\xc9}\x82cR\xc7\x18\x19ѕ\xbb[9\xcdg>\x8a\xbe\x80\xc0|\xd2\xca\xf0\xf5\x86qt\x18\u0085\x98e² \x8e\x91\xaeċ\x1d3\"\v֪\xc6b\rBan\x99\xed\x14\x91:\\V\xc5TF\xa7\xe5\xe8\xb3\x05r\xa5\x1ek\xffu\x87\xeeV\x85ë\xe2\xa5\xdcd7\xd0\x1cn\x19\xae\x8f[\u07bcIrY\xd6\x10\xa7n)报/ b!K\xb9T\x17\xb6\x83+\x126\xe7\x9aS\xef_\x14\xfc\xb4,̑\xdf\b\xbe\x90ԙ\xe8\xb4\xd4ޝ\xbeRa\x97\xe3\x12\x9b.\xc6W\xb4g/g\xf1\xa4\xb6\x13\x17\xa7\xd9\x1a\u05ecA\xb0}\x98\xaf\xb0\xef\xde]\xec\xa2\xe9S{ך\xbc\x81ï\xbf\x15\xd9+\xb6\xcf\x13\x8e(\xfc7\x00\x00\xff\xff\xad\x01\x9a\xeb\x00\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xdc6\f\xbd\xfb+\x88\xf4\x90K\xed\xc9\"\x97·`\xdb\x02A\xd3`\x91M\xe6R\xf4\xa0\x91\xe8\x19veI\x15)\xa7ۯ/$\xcb;\xe3ٙ\xa4E\x11\xdfDS\xe4\xe3\xe3#\xa1\xa6m\xdbF\x05\xdabd\xf2\xae\a\x15\b\xff\x12t\xf9\xc4\xdd\xc3\x0fܑ\xdfL7;\x14u\xd3<\x903=\xdc&\x16?~@\xf6)j\xfc\x11\ar$\xe4]3\xa2(\xa3D\xf5\r\x80r\u038b\xcaf\xceG\x00\xed\x9dDo-\xc6v\x8f\xae{H;\xdc%\xb2\x06cɰ\xe4\x9f^u\xaf\xbbW\r\x80\x8eX\xae\u007f\xa4\x11Y\xd4\x18zp\xc9\xda\x06\xc0\xa9\x11{\x98\xbcM#\xb2S\x81\x0f^\xac\xd7s\xb2nB\x8b\xd1w\xe4\x1b\x0e\xa8s\xee}\xf4)\xf4p\xfc1\x87\xa8\xb8暶%\xda}\x8d\xf6\xaeF+\x0e\x96X~\xf9\x82\xd3;b)\x8e\xc1\xa6\xa8\xecUdŇ\xc9\xed\x93U\xf1\x9aW\x03\x10\"2\xc6\t?\xb9\a\xe7?\xbb\x9f\t\xad\xe1\x1e\x06e\x19\x1b\x00\xd6>`\x0f\xefs\x05Ai4\r\xc0\xa4,\x99r\u007f\xae\xc9\ato\xee\xden_\xdf\xeb\x03\x8ej6\x02\x18d\x1d)\x14\xbf+\xc5\x001(X\xd0\xc0\xe7\x03F\x84ma\x0eX|D\xae\xc0kH\x80\xa5\x02\xee\xaa)D\x1f0\n-\x04\xe7\xefDaO\xb63</3\xe0\xd9\aL\xd6\x142\xc8\x01\xa1*\x03\rp)\x06\xfc\x00r \x86\x88\x85)'\xc7V-\x9f\x1f@9\xf0\xbb?PK\a\xf7\x99\xcd\xc8\xc0\a\x9f\xac\xc9B\x9c0\nD\xd4~\xef\xe8\xef\xa7\xc8\f\xe2KJ\xab\x04kO\x97\x8f\x9c`t\xcaf\xaa\x13~\x0f\xca\x19\x18\xd5#D\xcc9 \xb9\x93hŅ;\xf8\xd5G\x04r\x83\xef\xe1 \x12\xb8\xdfl\xf6$\xcbLi?\x8eɑ<n\xcad\xd0.\x89\x8f\xbc18\xa1\xdd0\xed[\x15\xf5\x81\x04\xb5\xa4\x88\x1b\x15\xa8-\xc0ݬ\xf2\xd1|\x17\xeb\x00\xf2\xcb\x13\xa4\xf2\x98\xc5\xc1\x12\xc9\xed\x9f\xccE\xe2Wy\xcfڞ\xdb>_\x9b\xf1\x1f\xe9ͦ\xccʇ\x9f\xee?\u0092\xb4\xb4`\xcdya\xfbx\x8d\x8f\xc4g\xa2\xc8\r\x18\xe7\xc6\rя%\":\x13<9)\am\tݚtN\xbb\x91$w\xfaτ,\xb9?\x1dܖ\xcd\x02;\x84\x14\x8c\x124\x1d\xbcup\xabF\xb4\xb7\x8a\xf1\x9bӞ\x19\xe66S\xfau\xe2O\x17\xe2\xdaqf\xeb8DuU]\xec\xd0\xe5I\xbd\x0f\xa8W\x83\x92c\xd0@ur\a\x1fA\xadجS|9Zw\xe2zi\x80a\xde\xe0\x03\xed\xd76\x00eL\xd9\xfe\xca\xde]\xb9w\x95\x9e\v\xb5ޖ\x1cY\x8e\xb9\x80\x10\xfdD\x06c\xbb\xd4V1\xa4X\x8b,\xbb\xb1k.\xe5:c\xb8\x16V\u009d\xc3[!\xb8\xabN\x19C\xa6u\xb94\xef\x1d\xac\xeb\xaf,C\xb5\xc7˹\x9fՙ\x15L\x11WS\xd8>\x85\xfe\xaa:DI\xe2\xff\xaa\x8fr\xa9z\xee\xaaFt\x8a\x11\x9dԈ\xe0\x87\x15|\xf5\xff5\x12\x0e\x8a\xf1\x8b\xfc^\x8e}\x97\xef-\x94[\x1aP?j\x8bs\xb8\xb2Ο)\xea_C\xcd\x1f\xba4\x9e\xa3j\xe1ͤȪ\x9d\xc5g\u007f>9u\xe5ߕ\x06_\xe8ۙ\xe9\xf8¹9\x9e\n{\xed\U000a2e59\x9f\byk\x9a\x1e$\xa69y\x95Z\xb5\x1cŠ\xb4\xc6 hޟ?f^\xbcX\xbdG\xcaQ{7\xcf)\xf7\xf0\xdb\xef\xcd\x1c\x15\xcdv\xc1\x91\x8d\xff\x04\x00\x00\xff\xffJ\xbeWz\r\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[\x8f\xe38s\xe8\xbb\x7f\x05O\x9f\x87N\x02۳\x9b\x1b\x0e\x8c @\xef\\\x90\xc6^\xa613\x99<\x04y\xa0%\xdafZ\"\x15\x92r\x8f\xe7\xe0\xfc\xf7\x83*^t\xa3$\xaa\xbb\xbf\xfd\xf6K\xdcn\xecN\xcbT\xa9XU,֕Zm6\x9b\x15\xad\xf8W\xa64\x97bGh\xc5\xd97\xc3\x04\xfc\xa5\xb7\x8f\xffGo\xb9|s\xfeq\xcf\f\xfdq\xf5\xc8E\xbe#okmd\xf9\x89iY\xab\x8c\xbdc\a.\xb8\xe1R\xacJfhN\rݭ\b\xa1BHCᲆ?\tɤ0J\x16\x05S\x9b#\x13\xdb\xc7z\xcf\xf65/r\xa6\xf0\t\xfe\xf9\xe7\x1f\xb6\x7f\xb7\xfdaEH\xa6\x18\xde\xfe\x85\x97L\x1bZV;\"\xea\xa2X\x11\"h\xc9vdO\xb3Ǻ\xd2\xdb3+\x98\x92[.W\xbab\x19<\xeb\xa8d]\xedH\xf3\x85\xbd\xc5\xe1a\xe7\xf0\x13ލ\x17\n\xae\xcdϭ\x8b\xbfpm\xf0\x8b\xaa\xa8\x15-\u0093\xf0\x9a\xe6\xe2X\x17T\xf9\xab+B*\xc54Sg\xf6\xaf\xe2Q\xc8'\xf1\x81\xb3\"\xd7;r\xa0\x85f+Bt&+\xb6#\xbfђ\xe9\x8af,_\x11r\xa6\x05\xcfqv\x16'Y1q\xf7p\xff\xf5\xef>g'V\"\xfd\xe0r\xcet\xa6x\x85\xe3\x1cr\x84kB\xc9W\x9c\x1aQ\x8e\x05Ĝ\xa8!\x8a!&\xc2hbN\x8cd\xb42\xb5bD\x1e\xc8\xcf\xf5\x9e)\xc1\f\xd3\x0e0!YQk\xc3\x14ц\x1aF\xa8!\x94T\x92\vC\xb8 \x86\x97\x8c\xfc\xd5\xdd\xc3=\x91\xfb\xffd\x99ф\x8a\x9cP\xadeƩa99ˢ.\x99\xbd\xf7\xaf\xb7\x0ef\xa5dŔ\xe1\x9e\xce\xf0i\tV\xb8֛\xd6-\xccێ!9\x88\x12\xb3\xe8\x9f\xed5\x96\x13\x8d4\x81y\x98\x13\xd7\xcd4\x91~-\xb0\x04\x86P\xe1\x90ޒ\xcf\xc0\x14\xa5\x89>ɺ\xc8A\xfe\xceL\x01\x992y\x14\xfc{\x80\xac\x89\x91\xf8Ȃ\x1a\xa6M\a\"\x17\x86)A\v\xe0X\xcd\xd6H\x88\x92^\x88b@\x18R\x8b\x164\x1c\xa2\xb7\xe4W\xa9\x18\xe1\xe2 w\xe4dL\xa5wo\xde\x1c\xb9\xf1K)\x93eY\vn.opA\xf0}m\xa4\xd2orvf\xc5\x1b͏\x1b\xaa\xb2\x137,\x03潡\x15\xdf \xe2\x02&\xab\xb7e\xfe\xbf=\xd3\xf5m\vSs\x01\x19\xd3Fqq\f\x97Q\xd2G\xe9\x0e\"o\xa5\xc9\xdef\xa7ؐ\x97\x8b#R\xe5\xd3\xfb\xcf_ڒ\xc6\x1b!\x82\x8f\xa5vs\x9bn\b\x0f\x84\xe2\xe2\xc0\x14\xdeE\x0eJ\x96\b\x91\x89\xdc\xca\x1a\xfc\x91\x15\x9c\x89.\xd1u\xbd/\xb9\x01N\xffW\xcd4\x88\xb3ܒ\xb7\xa8PȞ\x91\xba\xcaA\n\xb7\xe4^\x90\xb7\xb4d\xc5[\xaaٟ\x9c\xec@a\xbd\x01\x92\xce\x13\xbe\xad\a\xfd\x0fܿs\xd4\n\x97\xbdƊr\xc8.\xf8\xcf\x15\xcb:\v\x03\xee\xe1\a\x9e\xa1\xf8\x93\x83T\x8d>\xb0*\xc9/ȱE\t\x9fL\x96\xa0,\xfa+s\x80\xc3\xdbf\x1c\xc8\n0\x8c\x16G\xa9\xb89\x95\xa4\xd6,\x87\xb5\xe3\x81!zA-v?\x86\xaa=-\x8a-y\xc7\x0e\xb4.LXt\xa8:խ\x869\xc2\x17n\x12m\f\xdb\x13\x82\x0f\x13u\xd9\xc7zC\x8e\xdfy\xff\xb1\x1b\xf2]\x9b|p\xb1\xf8\xfe\xf7\x83kB\nֻ\x18e-\xfc:L\xbf\xa2\x16\xd4_\xe4'\xa6\r\xcf&\xe9\xf8.z\x8b\xe7%\xd3\xe4\xe9\xc4̉)Xh\xf8\x05\xea\xac\x1eD\x82\xd2\xef\x88n\xe8##\xd4S\v4_Q\x90Jz\xe5\xac\xc9\xfe\xe2\x11\xed\xd3\xcfNl/e\xc1\xa8\xe8|ǾeE\x9d3\x87\xad\xdf\xe1\xf5\xe4\xd4\xde\xc7\xef\xf1\xb0\xac\xd0\xf8\x8d\x047\xaa\xc8v\xe4?\xb8\xe93M@܌&\xf2I\xac\x89\xae\xb3\x13\xa1\x9a0\x9a\x9d\xecF\x0e\xdbhKb@\x84x\xc6\b\xcd2Y\xf7t\t\xfc\x82\xd2\x06sc\xa3\xa44\x9b\x8cn3e`;8\xf0#)i\xb5&\xb5(\xbc\xf8r\xc3Jr\xe0\x05\xec\x8d\\43(\aPٷ\xaa\xe0\x197\xc5eK\xbe\xb4\xa6\xe8\xe6\x9d\x13\xaa@\xba\r0\xa2\x11\xf4>/\xc0\xa4\xa1\xfb\x82\xed\x88Q5[ʨ\xfc.XY)<j\ro\xd8\xe3\xf1ΨR\x17P\xfa\x94\x94\xd4d\xa7\xbe\xd4\x13\xd26\xea\x1amn%pM\x14;R\x95#!q\xabf\x9e~9\xee\x9a\x1e\xe3\x01\xcc\xc0Qkf\x84\xedmK\xee\x0fD\xf0bM\x84\fH\x02M=$ l\x83\xd0\"\u008e\xa9E\xf8<\xb2\xcb\xf0b\x8f\x9e?\xb3\x8bW\x87\x8f\xec\xe2\xe7;\x8eL\xc3̈:\x81_\xdc{g\x1f\xfb\x15F\xf9\a\xe3-\xbd璲ֆ\x9c\xe8\x99!\xf5XY\x99\xcb:\x02\xd5oۚ<qs\x1a\x00\x01\xf6\xf7\xf8\t\xfb1>q\xe1\xd4`\x0f\xe7\x8au\xec\x10\xf8ݐGv\xe9]\x8bn\x91miw\xa6u\xef6\x9a\xe7\xe8~\xd0\xe2a\x82\xad\xb0\xb0\xf5n\x19\xf2\xfeK\xaa\x14\xbd\xac&\x18\xe3חE\x10\x94\x8a\xb6^\xc8&\x88s\xa3\xc7n*\x99\xeb\x1b\"\xd5\xe0i79\xab\ny)ь\xa2U\xa5oְM\x1e,Tԝ\xb0\x00\x14+\xe5\x99\xe5\xcd\x12\xf4\x0f\xb9ի1>\xef\xd9\x01\xccRsb\x97[\xc5\b\xcds\xb7\x8d\x84\x15\xbc%\x0e{xD.\xcdF\xb3\x8a*\xb0\xb4\x06@+jN\xed\t\x81#P\xe3\x94ȍ\xb7}\xb6%\x15\xf4\xe8Ircu\xe4\xcd\xdf\xdcD\xf8\x0e~BUpP\xb4\x12\xb7\xb1@\xb4E\x8bzV|\x82\v\xa6w)\xccl\x86\xc3fa(\x17`,\x83\xb7\b\v\xbe\xa5\xb6<cz@\t\x01\x835(A.\xda\xc4^%I\xe7\x84l&\x90b(\xb6\x9e\x12\x1f\x9f\x04S\xe0\x00\xa4Q\xa2\x19>\xdc6p\xf2\xa0q\xd0\xf5\x82\x81=\x88\x84(v`\x8a\x89\f}Q)\x98ӗ\x9a\x114\xa7\x1bA\x82\x85\x810P\xb7\x7fb\xb0\xc1\xd2\xcf\xcc\fź\xb5\x16\xacɐ\xbbm\x96+\x04\xa0Є\x00cJ*\xb6%8U\x1c\x7f\x90\xaa\xa4&&\xd4T\x93\x1b\x18\xb7Ņ{\xd3\x12\xef\x06\x11\xbf(\xa5\xb2co\xd0\xfe\x86%\x98\xc9\b\xff\xc1\x85Fh[\xf2Q\x14\x97@3yh\xc4\"\xc8zgo\xb3\x9e&\xd0c\x00\x145v0\xf2h\xf6\xc8rRW0}g;\x02\x1cZ<ы&\x8f\xac2\x7ffQ[dD6\xa3\x9dgZ\x80]'\x0f\x81J\xd6\xfe\v\xec\xff㯸\x93\x94\x8f\xd3S\xff\x17\x18\xd1\xf8\xcf$\xc3\xe8\x1aٳ\x13=s\xa9\xdcd]\x10c\x0f\xe6\x0f\xcb\xea\xa8\x00\x1b\x92\xf3\x03.5C\xaa\x13\xd5,Xbq\x12LYA\xf6\x8e\xe1\xf5xP\nD\x0eg\xda,L\x8f&\x91\xb0\xf0+\xa6\x1cȸ9\xa2(z?\xe6D\x05.)\xb4\xf5\xbd\x19\nn\r\xee\x1f\\\x91*<\xa9\xfd\x90(L\xb7\xe5Q\x11\f4\x8b\xc1\xad\xb6\xcc\xc7\x05\x17\x16\x90]qV\x83TR\x9b(H\xf7\xe4\x03x\a\xb0QY\xb8%Z\\d\xcfX\xd4\xf0\x1b\x91\xb4\x11Z\xfe\x02\x11\t\x90\x89\x8e\xe3\x8fZS\x91\x12\xf6\xf0\u07b8\xa1\x86p\x129ǉ\x18\xae\xd3B\xe1\xd6\x01\x84_G\xbe\xebM\t\xb6Po\xaf\xc2\xca\rA< d\xfc\xf93\x8b\xd0\x7f\x80E\x89H<Hm\x80\xa0N\xa7\xf8\r\xbcOF0>\x1c\xa9F\xe1\x92\x01\xef'e\n\x85\xf6r\xdb\xe2\xc1\x04dvf\x82\xf06P\xc07\xa3\"c\x050O\xf9\xad\x13L\xf1f\x19\x1c(/\xf4z\ncM\n\tn\x1d\xb0\x81k\x8c9ܶ!<1pT\rU\x10T\x1b\x054!\xc5\x11\x9a\xf7\x88\x1b\xe4\xd8\a,6\x05\x88\xf9\x8c\x18\xc0\xef\xfbo43\xb0uڹ\xbf\xff\xc62\\\xa8\x0fE}\xe4\xce\xdfه\xa0\xd8\xd4\x04RD\xdbo]ݨ\xdc\xecl\xdf\x7fk-U\x8a\xb3\xb2\x8aб\x1d\xb62\b@R\x91\xaf&`\xba\x883\x0eF{\x93)\x983D\xe6'\xe75\xbb9=\x97\x10.RX\xd2n\x1c9\x89&o\xed|\xfd\xeaw`\x90wT\x1dk\xf4q\x12`\x92ֲ\x9c\xa3A\x92\x98.\xd22\xedO\xc9\xc5=\xae\x01\xf2c\xc2\xe81{ \xf6\x13\xb8\xfd\f\"{9\td\x0e\x17\xac\xbbQ\xc9y\x91\x83\xcf\xd3\t\xb4@\x9bSC\x8b\x03c\n\xe0ӄն^\xcd\x02\x06ZX<n59p\xa5M\x1bI\x8dA\xe4\xedꕹ\x15\x9ep_\xd2#\xdb͎\x1f#+\xde\x0e\"Lɱ\x90{4\xfc)DF \x05\x98\x00\x15tI\x13??\x10n\xac\xe6=\xf0o,\xb7\xb1\x97\x1bŎ\xec\xdb\xeef=\x1eu\x8b\xfd\x00\xa78b\xe7\xf6\xa1\x18\xe7\x1b\xae&\xc1\x9c\xe4\xbc\xc1\x883b\x9f\xb1\x1c\xbc\xb9$\x98\xf2\xccTCOkd!\x15\xa8 L)\xa9\x80,B62\x11\x89a\xc4>v\xeeH20\x8f\x10\fZGhB\xc2N\x99K\xa6}h7\td\x97\xeb\x1f@T\x7f\x05\xf8\xc0\x7fP\xaa\x7fb)m\x1e\xf8Byma\xaeY\xe1\x9c\xf44n\xd9\xf5\xe9$\xca.ـ$\xe4(\xa4f]\xca?\x83\xb0 \x94\"«t\xf2\xc6\x02ⱟ\x10\xa2YL\xd0\x10\xfa\xf1z5\x80j\x11'\x01(\xe9hT\xaeÂ\"<\xea*\xbcH\x9c\xa4x\x0f\vj\xf1d?\xda\xfb\x82V\xd7\xe4$\x9f|Jq$\x11\x15\xfb\xa0\xc7\xca`!rC\x98\xc0\\\fS\xad\x95n'\x0f)\x86A\x16y\xec3o \x8e\xa7\x04c?\x1b\x14F.fM$\xf8ݐ\x0f\x94\x17\xafͦJ\xe6\x9fqY>\x83U\x0fͽ\xed\xb5\x8d\xba\xbe%i\t`\xc93\xa4q\x89\xd9\b\x1f\xd4\x11\xef\xc3\x06\x98xWo\xca} ]'\xae\xa0{\x96\xc2!\x97n\xf7\xb4sY\t4B\xd1I\xeb\\\x89F\x96\xc6>w\xbf\xbdK3`\x16Z\xa7\x03B\xdc\xd9\xc9F'\x91\f\x91\xb8\xb8\x9a\x87\x81^\x8aS\xf1.\xc1\xa3ׄB\x90>Ͷs>&X\xf5\x82\x80x\xd0\x00V1,\xa8\tY\xb2T\xc1le\xc4t*a\x97\v\xe7d\xb2/\x91%\x8fM\x1a\xd0\xf2\x06.\xc0\xdc\x17\x81$.=\xecX\xd2\xe4B\xd2'\xbfX\t5\x1fϳ\x17\x90!\xb0\xbd)\x02\xb2\"t\xab\x17\x01\x85LA\x81)H}\xe2\x158~\x14\x13\xe8\xf2ु|\x85¹\x85@=z68s/\xd6\xe47i\xe0\x7f\xef\xbfq\xa8.Z&\x97\xf0y'\x99\xfeM\x1a\xbc\xffwa\x92\x9d\xfe\vXd\x01\xe0\xe2\x17\xd6+\x05\xed\xb9\x18\x8f\xd6\xc2\x04_\x10\xe460\x9fk(Ȓ\xcaQw!Ԑ\xd1\xd6\x0e=\x1f\xd9\x11Rl0\x8f\xbd\x8c\xd0$\x86\x9fc\xb8T\x1d\x0e\xbe\x1a\xaa\x16M\xf2%ոi~\xec\x94mqc\x015\xa2$\xaf\x815\xa0\xaa\r\xe4`\x8f<[\b\xb2d\xea\xc8H\x05\xbb\xe72\xca-ܣ^$\xd7\xcb\xe2$\xfeg\xac\xa6`\xfc'Vm0\xfe\xb3\tB\x93|\xcbh\xee\xf9\xf5f\x8e\x86\xd0/\xb0\xcd$s'\xad2\xe2\x15y\xda\xd19-\x84a\xf1AY\x11\x16\xaa\xfd_0.p\x01\xfd\xbfd\\*ʕޒ;\xac\xbf.X\x1b\x86\x8fw\xb4\x1e\x97\f\x160\x02;\xf8\xbfj~\xa6\x05\x13\x067\x1dAX\x81f\x15`۷?ӵ\x85u\x97\xc1$\xc0\x02\x0e\xa0\xc1\xcd#\xbbܬ\xfbz)\x19\xe2ͽ\xb8\ty\xaa\xae\x0e\n6\x9c\x84D\xf3\r~w\x93\xbe\xf0c&\xf02\xd3v\xe1\nX4\x1c\n\xd2emv\xb3\x03{\x12\b\xad\x03\xb26!4\x0fT+\xe97^\xd6%\xa1e\xb4V0\xf6\x01\x97\x1fJ\xe2;.1y\xa2܄\xf4?\xf8\xa9\xbe\x14\xb6`\x93\x19\xa4A\x822\x93B\xf3\x9c)_p\xed\xdcd)\b\xc5\xecN\xad^;\xf4\x94\xaa@7\x89\x0e妉\x8f̎l\xb9\xbf\xabW\x12\x91\nsA\xbb\xd5\x02\xc9p\xe9\xa3XΆ\x8b\xb3\x84@'u\x19BH\x1d\xdee\x83^\x83\xd8\xc7\"\xf2gK\xd5L'fG(\x11OѲ\xe5\x93O'\xc0_pd\v(c'I\x143\xb5\x12Mx\v\xb3#\x10\xdaN\x02\xd9M\xa34:\x04\x16|\xbb\x90\xfe\xbfU\xf0+]\xef\x80$\xbe\x8ezH\x1a6o\x8bUjbiu\x84\xeeA\xb1W\xab6XV²]=ۦ\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfbk\xf2\xfe\x9a\xbc\xbf&\xef\xaf\xc9\xfb?z\xf2~\x1a\xf1\ttg\x9e>\xab\n\xa6\x10\vǾ\xecV3\xeb\xa09\x12\xa5w\xca\xc60\xd3c\xa3\xf3 {c\xec\x84C\x05D\xce\xcf<\xafiA\xb8\xd0\x06\xce\x17\xc0sBh\xc0i\xbbZ\xe4Xt\xb0\xb5j\xd7\xe3\x9cp\x8eE{\xe8\xd8\xea\x1b\x9b\xee\x9e\xc2a\x88Қ\x90\xaa.\x98v\x0f\xcaqo\x0f\xfbڨ\xdd\x17\xb8`s[\xdd\x18\xddv\xf5<\x9dޜ\xa4\xe6\xf7ʱ\x91=\xda\xdd\rn\\Cf\xac\xa5i\xec\xc1\x84s)\xa10+\x88\xc2<\x9dxvj\x0e\xdd@u\x1a\xc2T\xe1̤\x06\xe7I\x1b\x19\xe8\xaca\x9fy\xa2\x17\x82ށ\xc7\x14[8\x9b3\x98\x90\x92z\x8d\x878\xa6\xd9\xf6\x96\xea-\xdcmª\x85\x98=e\x05P8r8+\xc3\x05<'`\x02O\xe9\xc0\t\xf0\n\x01l\xe5\xe6\xfc;\x1f^\x98w\x1e}\xec\xbeE\xe6\xf6\x19X\r\xc6\x168\x9c?3zr\x9e\xff|i\\\x02\x1f\x90\xc03\xa2\x9dH\xba\xb0\xcd\xea\x85VI\x9a=ҧ\xd7\xd4؞\x04'\xc4\xfb\x89N3 \xe7\xa3\xfc).NR<\xa4\xbb\b{h\xb6\x1fۍ\xbf\xcf@%\x93\xf1\xf9h\xd4}\x16b$*\xef\xe0N\x93!\x95\xf5\x8b\xe2\xeai\xd1\xf4N|<\x01j\xfb0\xc0\xb9I-\xb4`\x96\xc5\xcb;\xd3\vl\x1a\xb6\xb8u\xe2\xde\tp\xc9xl|4ڝ\x04\xd6i0\x90\x89\xa51\xeeEDL\x8fgwH8\x15\xc5N\x14\xe2\xd1\xd8p;v=\b\xaf$\x01\x1dF\xac'c\xd0I0;q\xeaa\xd4\xc7?rA\x80\x1cZ˒\xe2\xcd6\x82\x9c\x0439ʜ\xa4K\x9f!Oӆr\xf7gږ_\x163N\x8e\x14'y!\xe9\xf3h\xd9C\xbb\xd5kG\x80\x93)\xdfY\x9b\t\xd1^\x17ŝy|b\x8cw\x18\xbb\x9d\x81;\x1fٍDlg`\xc6\xe3\xb9)q\xda\x19\xc0\x9d(\xee\xb3M\x97$\xa9K\x18\xe4\x0f\xe2\feQ\x132\x14=\x93\xb3\xb91\xb8\"\xbd\xba*ثGa\x92\xb8\v\x82\xfe\x02\x04R`\xbb\x9fP\x7f\xb3Z'I\xe2\x13\r\xe4\xf9\x05<{\xac\xe9\f1\xc3}=Z\xb6\x9c\x89\xff)\xa4\xe4\xa2/_\x89\xb4\xbc\x17\x7fJ\xc1t\xe6g\xeb\x00u(\x89k\x8cR8\xfcq\x02f\xf3\xec\xbf8F,\x95\xe9\xfb\xfe}\xaf(\xd3/\xe4Bx\xf4_\f\x13\x8av4%\x91\x01\x9d\b\xccD\xac\xa8\xe1\xc4(\\2\x17+ھ\x94\x04\xd7\xe0\xc35\xf8p\r>\\\x83\x0f\xd7\xe0\xc35\xf8p\r>\\\x83\x0f\xd7\xe0\xc3\xff\x80\xe0\xc3tUKB-\x8b3E\xb7\xab\x17\xc8\xdck\xbd+\xc0\xfb9\xbe\x81\x7f\x14$\xf1)j\xf7\xba\x00x\v\x8d/\x10\x03E\xe6\x85\xd2U\x89\x00\x974\x9bi\\\tI\xef\xe6\r\x047\xcd\x1a\xb5\xbe\xfd\x8d}%\x14\xfc\x9bP,\xfc\x99\x92\x16\x10\x85JɌ\xe9\xc9.\xdeY\xcd\xdb!\xe0\x90R\xfd:\xad\x83T3\xb5\x06K\xcd\xc6\x17\xb6\xfc\v\xe4VR\xb7\xda\x12C\xd6U\xdf\xcd\x0f\xbc\xf6\xde_{ﯽ\xf7\xd7\xde\xfbk\xef\xfd\xb5\xf7\xfe\x8f\xd1{\x7fmF\xff\x8bhF\xbfvV\xfc\xb1;+\x92\x9c\xa8\xb4\xc7nP\xe4W/|\xd6\xefu\xcc\xd93\x1c\xa5Jq\xa9@T^\xd5Wr\xa2\x04%\x98Wg\xe9\xea,]\x9d\xa5\xab\xb3tu\x96\xae\xce\xd2\xd5Y\xba:KWg\xe9\xea,]\x9d\xa5\xab\xb3\xf4lg\xe9\x0f\xd8V:\n\xd9U\xf8\xdd=\xdc\x7ff\xea\xcc#%~\xb1¾\xd6\xf0\x96\x96z:1\xf7\xaevF\xda#\xa25D\x8a\x1d\xb9\xc6|\xd5\xf1\xa8ؑB\xd7\xe6\xdd\xc3=\xd1L\x9d!\xa2\xd3\x18\x01\xbe\b\xb1\xe7\xef\r \xfe\x1b\x04\xc1\x802\xeb\x01\x066\xcc\x06\xa0y\x86\xc1g\x0e\xddx\r\xe4P\x9a9\x00\xda9F;\xbc\x89;+j\xc0}\xa33Y\xb1<xa\x98D\x15\xb7fkq9\xd0B\xb3\xa1\x89*d\a\xb7\xeeA\xdd\xf6\xceZhfփa\x1e\xdf\x01H\f|\xb9\xb9\x14\xfcѝ\v\x8e\xcc\x18Au\xbbZ [\xe3۞\xc3\xe8\xad}\x88\xf7W\x93d\xa8\x7fOD\x90\xba\xb8\xafFK9c\xc2\x02\xba\xd3\xeb>l\u009a\x16\x9f\x97\xcd\xff\x13\x16\xc0\xe5\xcf!\xc3ȭ\xf1eՃG\xc6\x05Q\xb1\x03S`%\xe7\xae\xf5\xb6%\xc5A\xda'H\xba&\xba\xceN\xf6\xd5\xf0\xf0\x0ex\xa9\xc0t\xca\n\xaa]KxŔ\x86\x05,\f9ˢ\x8eh\xb4\xac\xa0\xbc$n\vs\x88\x12%\v\xe6z\xca\xe1_{.r.\x8e\xeb\b\a\a\xf0:\xfc\x03\xaa\x88QQ\x82$\x18,!4\xf7\xa1\xe9b\x00L˲S\r\xde]\x85\xaf*\x1c\x13e\xecs\xc5\xeb\x98&\xd3\x05(-yhе\xff\x02\x8b\xc0=\xa2\a\x968M\xaf1\x90ծ\x94\x86\xe2\x80ެ=\x96\xdbUR$c\xc2\x10H \xd3po\xf2\x8f\x0f\xccK\xa2Q\x87\xd5-\x12y\xf9\x9d\xa7PW\x1b\xf4H\x14\x96\xc1\x1f\x84B\xb6F\x87\x16s\xb4\xf1\xe3\xe2\xdaý\x19\"\xf4\xa4\x8b[C\xb2\x13\x15\xc7\xc8b\xd3\\d\xe0 A!\b;sY\xeb`}\xe6~\t:?\r\xdb\xfeuvby]`\xfc\x95\x14\xec`\x88\xac\x87\x9b\xbe<\xb4\x97\xb0\xa1jO\x8bb\xed\xaa˃\xcar(\x86\x17Z\x80\v\x88%J\x87HM\x9c9a$X\x1bF\U000ed2db:\x00O\xa0\x01a\x8e\x8a\x81v\x06EH\x03\xa2\x18KA\xbb`\x002\xcc\xe5D\xa1{\x86\xd4\x1a\xa4\xba!\x84El\x8dS=\xd4E\xe1.\xe8\xedrnGՆa%\xf89\xb5b_N\x8a\xe9\x93,r=\xcd\xf9\xd8\x1d\xd8G\fY\xb6r\xf4%!=\x98\x84dT\xe0\xd9T\xb0p\xf6-\x85\x88\x87fp<FM\x1b\x0e3fP\x8d\x82gb\xe5MUZX6\x03\xc0\xceuG\xf9\xb3\x0fh\xc4\xc0\x9e\xbfs\xc1\a\xa3\xae\x12\xbc\x00\x98\x17祖\xf41\x02\xb1\x05\xe0\x81*\xc3iQ\\\x80j,_Ć\xa9Pt\xa6\xb8\xe1\x19-FUӀ\x11o\xfbw\xa0\x8ctH\xd3l\xac7\x9ae\x8a\x19}\x13\x81J@:orV\x15\xf2\x02KZoiU\xe9\x1b_N\x88\xdcC´\ti\xa7\x0f\xf6)\x15\x97U\x04\xa6\x93\x82\xd2\x1fG\xd4e2\x00\xa7\x86\x9d\x9d\xb20A\x92\xfa\x04\x9d\xd0}3\xfao\x96\x1dSz\x10>%\xfdfgy?\xf6\xfc\x0e?~\xed\f\xf7Aꂪ#ӆ\x88\xba\xdc\xdbw\xb1\x1c\x1c墋\x02~A\xfe}\x0fL\xa07\xd7\xcd\"h\x04\x17\x06\xf4!Gar\x87\x13\xa8႗ܩ(n4+\x0e1\x9a\x97\\\xc0\x19w;\xf2\xc3\xf3\xc9ʅaG\xa6f\b\xfb\xc0TƄYH_wW\x9f̕\xbd\f\xf6\xa3<D \xb6\xd72T\xb1\x18\x03;\xb1g\x87kW\xf1\x8aɇD\x1c\vPOEa\x8ep\xa6\x83\x8c\xe3N\x8c\x13Q\x98\xd3ܱ'\x10\xeeȏ?\xfc\xf0\xbb2oܷ\x87m\x01\xcf\x05ڭ&\x98x\x1f\x86\x85\xce0o>\x86S{\x8eJ\x82\x82\xf0Zl\xdd\x18\x91=\xc8pȑ\xf5\xa7\x89\x91G\x8c\a\xac\xc1\xf3\xf29VP:\xc0?iN\xcd\xf3\x1a\x16\xb9\a\x0f\x81ڝ\xdc\xe2\xc7`\xb7\xe1\x9a<\xd1A\x7f³u\xbdw\x9f\xd3\xf4\x8a\xefٽ\x0f\xafڪ\xa81\f\u038ds\x1b-\x88\x14T}ㅱY\x85\xf4\"Bے\a\x0f\xa4\x9b\xa2\xb9\xfd_\xb7D\xb1\x8d3C=\x8d\xec\xc2\xc0\xa4M\x140\x15\x96\xc6\x1e\xfa\x1fG\x89s\xb1\x84\xd6\xf7\xe25i\xed\x9e\xdd7\xf8=M\xa7\xcc\xfd?\x1b\xc5F\x17\xf8dg\xe8x?\xa8\xeb\xa5`\x86\x9e\x7f\xdcv\xbf1ҭ1\x94\xbc\x1eD,i\xb5KY\x1cۧ\xc5y\xea\x19\x19\xf5\xa9\xc0\x96A\x05\x1c\xeb̍R\x9e|D\xbci\xb1]-\xa0\xe2\xd4\xfa\xee7f̊]\xff\x86\xa9\x9eQ\x1f{\xb6\x96\xda\xea\xe5'\xf1N\x88\xd93\xbbB\xbb]\x9f\xab\xa9\x16\xba\xc9^\xd0Ž\x9eSLI\xe8\xeb|F7\xa7\xef\xd4\x1c\x85I&{8g\xd6qZ\xbff\a\xed@\xc0\x99#\xa2@?\xd1Q\x90dYof\xab\xefr\x95\xd6\v\xf8\"\x92\xccu_v\b\x92\xd2s\xd9\xefs\x1c\x85Lf;-ǻ('\x80F\xfb+Sz''`\x86\xae\xcaW옜铜\xd0$ɼ\x9dڛ\xd22^c]\x8f3\xbd\x8e\xa3\x1b\xdf<V\xad\xae\xbe\x18R\xe9=\x8c3\xf4\xe9\xc8uz\xbfb\xe8H\x8c>si\x97b\xb7\x0f1\n2\xb17q\xc9Q\xf6\x8b\x8f\xad\x1fl\x82Q\xb0\x93\x1b\xe3\x84D\x8c~Ur\b#}\xb6)\x8c_df\xf5\xedn5\xc1\xc8_\xa3\xb7tM\x00\xf0q\xd0\xe2ld\xa9\a\x92\xb8p\xe4\x00Nز\x9a@B&+\x0e~\x8dt\x8d\x81\xd8\xd0\x01i\x97\x88+\xca\x05\xe9\x81ܒ\xb7\xb2\xba\xf8\x14\x7f\xe3\x1bc\xb0\x87k\xb2g\xdal\xd8\xe1 \x95\xb1\x1c\x83\xee\x00q\xdb'!!\xf4p`Y\x1b7h\xae9Q=p\x1fF\xf4\xca\xc4j\x994\xddƖr)s,\xf4\xfb\f\xe1\xe8i\x96\xb5G\xda\x13O \x85Z0z\x86\x02\xad\xdaN*X\xaa\xad\xf8p\x0f*\t\xf1b\xa9\xc2\xf3\xc1\x85\xb5>'\x17\xb9|\xf2\xc5\x15-jkC\x95\x81\xd3U\x8e2\xa6)\x82'\x8d\xc0!\x1d\tE\x1f\xdaв\nK\b\xaf8\xa7\x86+RRA\x8f,\xb7k3b]}ts\xb1!\xc1\x82j\xe3\xd0͚\a\xb8\xa0ƣ\x90O\xa2cb\xaf\t\x1d\xaej\x18\xd0\xce\x0e\x89\xbc%\xdc.Jb_nnCU\xf6\\x\xbd]\xce鈄H\x95\x83k\x1fB\xa7}n\xa7\xa9\xec\t\x01\xec\x88\xcb\xc7\xde\xd3Z\x19\x93\x16S\x11\xa7v^iH3\x19\x8e\xd6\xc9\xc8\xcf\\\xe4X\x87\x8e*\xbae\xad\xc2\x176(\x12\xcc\xe5F\x99\xc4@\xf6\xf2X\x9aUT\xf9\x1c\x06V\xcd\xe8-yO\xb3Sw &,\x0eR\x95\x91z\x8b\x9b\xc0\xd87\xfe\x1e\xb8r\xb3%\xe4\x83\f\xa9\xf6\x00O\xaf\x89\xe6eU\\\xa0\x9b\x90\xdctoY\xce\xef\x88ZV,\xa7\x99\xf9lc\xe0\xbb)^}j\x8f\x1cIm\xe5\xd4P\xbf\aɸm\xd7d3\\\xe0\x1d\x17D\xb0\xbd\xc0ᅼ6Xb\x10\xf6\x86ֺG\xc6*\xa7U\xb9\x82\x8d2\xb2fDNJf( \xb0&\xba\xed\x01c:e\x0f)\xb1\xec\xc4\xcf\xee\x11N\x13\x95[\xe2&4\x80\x98Q\bu\xed\x19\xf0\x027\x00\xcc\xe2\x86w\xac\xbb]\xbb;\a \x10˟\xc1\x98X\x02\xca3\xfb\xee\xe1\xfe+SQw9m1\x8e\x1a\x9f\x13\xabt|/\x18H\xc5\x00KXv\xda\xc6*7~\x12\xed\x84\xcb\x13Ϗ\xcc\xe8-\xfbF!8\xbc\xcddy3\xac\xcfqQ\f(F:{\xc0\xe6\xc4.\xb7\xed\x12\x01BM$\xac\xc9\x15d\t\x0fLŶm\a\xcc\t\t\xbeI\x117x\x8dr\x92\x9d$\xa8q\x90\x1e7\x106ppe/V\xa5\xdc\xfc\xcd\xcd\x18HDK\xb7\xdaA\x1c\x82\x90˹\x84A֤\x80\xf2\xaa\x9cP\x03\xfd\xa7\xb1l\a\xc8S&ř\xc1n\x06(0P3\xe1A\x97@\x1b\xa8\xa6\x825b\xd3\"\x19-\xdc\v.\xec\xcd:\xf6n\x91'\xb6\xc7RFy Y\xad\x8d,\x03\xc2nG\xc6\xe6>)\xd83\x049\xaaa,5:!\xaeg\x8ar\xea\xbe\xf2)\xfa\xcci\xc1\x1c<,d\x06ǲ\x80F\xf6\x02?\x8e~1\xb5\xe7\x9fwۄ\xf5\xc1M%\xb4\xd0\xd2\xfa\x04.\x11Hs\xa8\x9b\xea\xc6\xf0\x06\xd0\xfc\xfctPf\xe0x\b\xa3.\xa8M\xd1\xde\x0fQ\xfa}\xefU\x00\xaf\xc3V-h\xa5O\xd2|Ų#\xbd\x9bb\xc7\xe7\xee\xd8\xd8\xe6!\xb1\v\x9bd\x85\xac\xf3\x00{\xc8\x130\xf4Ņ<|\xbd\xed\x14W9\xf7̅f<\x81\xbd\x95\xe5\xbf\xfe\xe95k\xcet\xd7\xf6\x9f\x9e\x7fw\xac\x8b\t\xa2\x14{'\xcd{\r\xfed\x15\xff\x82\xa4ޭ\xab\xf1\x8e\x1d\xb7/5%\\\x80\xe1p7\x1a]B\xc6L\x17\xb6|\xf9\xf2\x8bE\x1c\f\xe4\xed\xbbZ!B\x9b\x8a*̀~~Bv\xe6{\xf8\xe7I>\xf5 \x12RH7ӟ\xfa\xf8*\x06\x84\x00S[\xaad\xacm՛\x170O\xa6iq\xfc\x1a\xbf\xa7\xb1\x05\xdbL\t\x0e\xe6\xc8]\xbd\a\x11B\xb5\x96\x19G3ѽ\xbc\x82\xfbb\x95\xed*ig\x1e\x9d\xecخ\x1c]\xa4\xdaPSw\xa0w\x88\xe0\xc5\v\x06\x91\x8cV\xa6V\xce\xea\xcej\xa5 rm\x01Xate\xf1\xc3i\x8c\x05\x96\xbd\xb55^u\xf7\xba\x1a\xffn\xf0<\xab\xedq\xdf\fF\xb7\xd7\x04v\x1eÇ\xa1?JA\xb5\xc0-\xaeV\xa9\xb9\xbb\xa4UŔ\x7fG\x95\xd3\xd1\xf0\xb5mfR,\x03Wehs\xd4\"o:\xb2\xbaeW[\xb0\xa0`\xa1\xc2\xed\xb0\xbfC\"\xcd7,9c& 0\x00\f\b\x81\x90\xe2Tͩe\xe4\xc2=\x84\x15\x9a\xe1I\x19\xcf\xd0y\x11\x95\x0f\xaf\xf3\xb1b\xb3\x9bb\xc5Oa\xd8\xf0\xb8\xa80}[\xf7\xed+\xe5z\xe0\x88\x1f\xf5D!&SV\x14\xecoz\x84\xa4\x89\xb1vX\xa7\x88.o\xd5\xd0AD/\x96\x0fW^!z>\x84z\xb6\x80\x98\xf6\xd8aUZ\a\xdf\xe1N\x84\x1c\x0f/8\x02\x98\xb5\x12`\xce\xddj\x1b^\x0656Y\x986*ڮďK\xf1\xc5G'&\t\xfev8\xdeɢ\x0e\x11\rB\xfb4\xb5e\x19\xab\x91\x8a\x8dV\xe8\"ȵ-\xbc\x97\xc2\x17l\xf8y\xf5\xef\x19\xc0l\xc3p1\x9b\xba*$\xcd\xfd\xae\xe7P\xb32g\x19l\r\xdb[=\n\x11ZF\x91Ƒ\xe9\xf7\xd9e\xbd\xf1\x1dɩa\x9b\b\xc0\x84\xf50\xc2'\x17ƽ+\x8eRqs*g\x19տ\xc1\xaf\x11\x1a.\f\x95D\x0f&\t<\x04`n\x9fi\xaa.\xbdI\xc8ml\xad?\x90\x1c\xbf\xf3\x81\x11\x14k\x14\xdb\xe0\xc8\xc1\xc5\xef\xda\xf45܆\x14\xdf\xff~pMH\xb1\x80\x94v1\xbe=\xb1\xecQ\xd7sd\xec\x0e\xf6$\xcc\xfcߝ\xa5\xdb*]\xed\x01%\x9e\xbek\xaf\x13@N\x88>ѿ\xfd\x87\x7f\xdc\xfdӉ}\xfb\xe7\xf5@pq\xdd[\xe9]`\\1\x91\xa9\v\x94SMN\xec\xbd\x1f\xe5\x9bM\t\x1f\x99\x89\xc7\x1c$\xa1\a\xb1\xf50\x14\f(\x96\n\xad\x03\x853[@Iه\xc1\xa2\x85\x18\x19\x16\x15p\x13\x05\x18\xa6\x8b+3\xe0\x00\x96\x81h=\xcd\tm8\xba\xd4\x7f1\x80\x87\xcf\xf2μ\x82\x92\xe1d\xb3\x1b\x8bQ\xf54\x15q\b\x90\x90\x12춄\xad\a\xcaK\\!+Ӛ\x1e]C\x94]!G& \xc9\x12\xc1ԥ\x02\x9bv\xbd\x8ehm\xed\x8b\xc1hf\xa0\xfe\x02\xc1\xfb\x12\x8a\x0e\xdb\x06`\vy\x84\n\x0f\x1ch\x95\x9e\xe7\xd0v\x95ZrƾU\\\xcd\xfb\x1e\xef\xc30\xa0\x88\xa3<\xd7N[\xc05V\xf0#\a\x03\x1e\x94\xe9\x11\xe4\xea\xc86\x99,\xa0\xaa\x02\xb2\x1a\xbf\x8b.\xf5\xdej\xb4\x1a\xa93\xa1\x0f푖\xc1:\x14 9\xae:s\x95A(\x12\xf8\x1aͿ\xfb\xfa\xf8.Oɞe\x14\xa2\xad\xf2`\x8dG\t\xa7\x1ciW\x90\xa3\xb7Kf;Uu\x91\xb3\x03\xad\v\x13<\xf9\xe1\x88\u07bc\xdf\xf5n\xf0Z\xaf\xa9r\xb5\x04\b\xd3r\x13\x89\xc0\xc5ZO\x8c\xa49,B\x90\xc6\xd1\xef\xe7zϔ`\xf0*3̔@\xeeF \x19\xe4\x93\xd8.\xac\x8c\x9c*\x81\x9c*\x83\x9c\x9d\xdfe\xb4x \xe8Kx\xb2c\xddr\xb4g\x8f\xfc\xef`މ\xb1\xbc\x989$\x97`Ժ\xc8PK\x97\xf4BN\xcbg5\x99̛N\xe8\xbd|V\xfdf\x90Ar\xaf5\xd1\x0e\xa2Q\x906\r\xb8\x9c\x04\xc1\xa1ҳ\xf3o\xf9\x93/\x9c|ۑC\a\xa3\xfb\nT\x80]jV\x9cY\xdb\xed[\x03\xc9]\xedh\xbe|\xa2\xf2I0\x05i\xaf\xd9y~\xf4#Sy\f\xf8^V\x03\xa0\x84\x84\x17m\xe2ý\x91\xe0\xa6@\x1e!9\xb7x\x1eA=\xcd\xce\xe3\xd5\xd4#o\xb2pm\x1e4y\x8c&~\xd1\xcb\xc9F!\x8e\xe7i\xe7f?ꎃ\xb1J\x8d˺\xecV\x13D\xf9\xd0\x1e\xe9\t\xe3\xb6;\vŧ\x16\xd6.h\n\x8eYI\xffS\xaaab\xa6\xe4B\xba#\\\xb0\xea\xc9ߺM\xdd\xea!\xf9\xf0y\x10\x9c\x1a \xfd/a\x98\x0fǅw\xa5\xd4E\xdbm\xc7i\x9c\xa2\xaf'nL<U\v_\xd7\xd5\xdc\xf5j\x9b9>\xfdηL\f\xbf\x8fL\xad\x19>\x94\xd4\xd6K\xa4AaF\xc0\x11\xa2\xeagl\xc4\b\xd8\xf6\xe2\xa4!雙&0<D۽\xe6qq|\x9c\xc5\xe3\x93\xe3w\xab\x87\xab\xcd\x7f\xc4$8p\x10cPcK\xfa\x12DaK\xee\xa0LC\x1bh\x13q\xc9[\xeb\xe6aV\a\x12\xce\xd3\x06<|4\xff\xce\xc8^Bp/ߒ\x8f\xaeS\x13\x8e\xb3CL)\xec\xde\xe2\xb2\xee#\x1d\x97\xd4 \xad\xba\xce2\xc6@U\x02Z\xb9\x92\x15\xb4\x94\xe3\x91<1\x1a\x8f&w{T\xb4\xe6>\xac)KO\xcfR\x8b\x18\x10S\xd5B\xc0\xf2\xf0\x81\xa2\xd5ңg\xa6\x16H\xe2)r\x1d\x94GΎk\x82\xb4\xf1\x150K\x97\x19\xf5\x94\xac\x10\xe6\xa2\xf2\xed\x1f\xa7\xc1\x98J\x9e\xbb\x1b\xdf\xcc\xde_\xf0B\x7f\xab\x13Nnqt\"|\x82T\t\x94\xc8]\xb6'\x11{\x9f\x1c\x02\xe4\xa1\xf75\xe4|\xdc\x11:\xf8\x9fi\xfe% \x85\xfev\"F\xf6\xdc[GK\xbc\xb1\xc1\xc7E3!Vb㷣 \x89\x8b캨\f\xce&\xac\xd7\x17\xcd\xe5\xc5\xefa\b2\x11\x8c\x16\xa9\xdcB\xde\x14\xec̊\xd5\bl\xb7\xa41'\x8bf\xe9?A\x9d˦yK\xfa?\x83\xc7\xef\x14\xbeO\xdc\xfa\xe3,&\x80\xdas\xc3\x1a0\xfa\xc5\xf4A\xcby\x01\x91\\Ƨ\xa1\x94\xbd\xe0\xc8\x05\xa7\x88{\xb2\xa5-\xa0~lߥ\x81\x00\xc0\xcb&7{BYgj\xb7\xfe`2\xb7\xb8\xc29b\xc0\xb70\xa5h\x1c\xaf\xf9\x9c\xa8\xc8\v\x96\xefl\x84\xf0\x03\r\x8d\x99p\\ \xd5\xe2\xf6\xd64U6\xd6؋\xb6\"5\x1f\x7f\x80غ\xb5\xc4@\xfb`-B!\x8fG\x96ooW\xcf;\xa8,\xe1x\xb2\x99C\xc9\x12\xb8\x80\x05\xb6\x89<x\x80\xb1\x84w\x8b\xdf<\xd9Q^\\\xb4\x18N\x8a\x84J\xe2\xd5치\xc1%l/\xda`g5\xa6\x88u6\xd63\xfc\r\xbc\xdb>\x9b\xe4\xd5\xc4\x11\xbe\x1b|gˋ\xa8\x8d\x8b'\x95\xdc88\xa6\xf4\x1a\xb3\xe6\x0e\x83\x95\x0e\xee(XBh\x97\xc0\xadEL\xb88\xcb\xc7\x17*\xf2J\xa6\x9a7\x0f2\x8fͨ\xad\x97\xda\xdag\x14(\x99\xd2Kv\xe9\x0e\xc4*\x9c\x11<%Chh\t\x19\fC\xc0\f\xe3Q/$\x11\xd6h\x87T]\"\xb5>wnj\x85\xb2\x1d\xa5\\\xe1\xf78bsa\xebg\x1a}\x133\x9dk\xc7\x19=\x80n\xd3\xecV#\u07fb\x1dc\xe4[\xd4dcߍ\x9c\x04<\x1aeH\xa4ɔ\t윟\x8f%7)\xde\xf1\xa7\xce\xf01\xdf\xd3\xd6\xdf:\xd0\x11\x90\xc4:{\xc1\x81\x82\xcd\xd1A^\uaace҆7'\xbe\f|\x8dΔ\xee[\x03\x89\xae˒*\xfe\x9d\r\xd2\x16\xce\x12m\x9f\b҃J@\xa5\xdb\x05\x8f\x1b+T\xbcٓFH\xce\a\xcb\xf2\xd9\xf1\x8cp\xfaCZsx\bet\x0e\xf8h86\xcc\xcc\x18\xc5G\x02e0y\x9a=\x92\xbajǢ@\x02\xa4`/\f9\xf8\xa3dZ\xa7e$\x1f&Ӻg8A\xc78;ϔ\xe35<&!ƨ\x97\xcf\xe6\xb0`\x16\x93؏H\xe0j\xb1a\x91\x82o\xea\xa1&\x1fڣ=\xce\xddsC\xe0J\uf712\xd5\xe8Ff\x9f\xbe\xb6\x06\xafy\x92$g\x19/)X\x00\xb4[\x9f\xfe\xe3\xf6o\xff\xe1f|zQ\x8d?\xdb\xf6\x06q\xcc\xe1J\x8b\xb6\xbb١.\"\xd551]\xb0\xf3\x89\xb6Z\xd5z I'ki\xbb\xee\x06\xe9\xff\x81\xb4\x8d\x84K:\xf8Y[\xab\x8d\xa5gK\xbbR\xb0\x11qY]H\xd4\x14\x83\x17\xe3,\xc4o.\xba\x14\x8a\x10b_\xbe\xb8\xca\"\n\xd3V\xe14\xd5\x0f\xaeؓ%\xd6Z\x8c\xc0\xe4\xc6\x15\xf7X\xeeƊ\xf7\xe6J$\x9c\xe0\xd92\x87\x04z\xfcjG\xf6\xa3#\xdd\xe6\xc8\f\xaa\x8a!s7\xa2\x17\x88G٧\x87\xe4a\xedμF\x7fr\xe4M\xc33FԄO6\xef\x8f\xc5\x16K\x9bAQ\xb0$\xb0m\xbbZ\xe65m|\x19\xdc\xc8ƶq*\xed9tp\x18\xfb\xa2\xe3\x04\x8aDJ\xce\xfb\x9e\x86[{\xd1:\xf3gpk\xdc\xdc\x1d\xb3H7\xfdy\rF\x8c\xaa\xd5\x19\xdbf\xcc\x1a\x8d\xcaS\\\x92\xfa\x95Ёl\xf1.\x82\x98\\l\xc8o\xeci\x15\x97\x02<6\"6\xe9\r\xb9\x17\x0fJ\x1e\xd5\xf0\x85\x05\xe3\x12\xb6韾\xb7J\x92\xbd\ryKEƊ\xd87\xef\x18\xd4T\x0e\xf8<*\x02\x95\xccm\x85\xbc\x93'\xfe}\x86\xd0\xc3\xf1\x9e\xec\x98XqԆ\x86\xbe\xc6\xe8 \xfb\xa1w\xd1,u\x1b\xfd4<\x03/ǝ\x87뾂\x83:\xecߡ\xb7ğf\x15zq\a\x90\xc3\xe9\x9b\\Y\x9c\xdam\xbcF:[v\xbbD0\xa7v\xb1B\x1e\xc1$\xfc\xe9b\xe2{\\\x87|\xbf\xb4\x06{\xba\x19ihѡ^C\xb81\xbf\xcfR)\xb2\xdf6\x1e3\x17\xe6\x1f\xfb՝\xf3֞b\x95\xd4\xdcHuA\x1c\uf82divV\x9f\"7\r\xed\xd5\xfd\xc5\x1f}3=+\xcf\xfbNO\x95\xcd냐\x04\f9\x98\x7f\xb6\xe1?gy]\x15|J\t\xa2\xf3\xe5\xaa\xf3\xa9\xe82\x026v(\x8c\xa3\xb8\xeb\xd0\x02j\x1b/>7\xd9~\xdek\xd3{TQVN\x95\xecV\x13d\xf7\xfa\xc6g\x99\xa0u\xcab\x03[\aݻnYG\xcf[\xdd\xd4C\xf6\xa06\xcf\xdb\xc210.\rjN\xbc\v\xb1{\x16\x82}%\xf6f\x03\xe6\x82]R\x03\xa8\xe1\xa4̺\x82\xf8\rX\x15.\x99\xef\rN\x90Vt\x83\x15\xa3\x1a\xeb\x18 \xfdz\x01G\x9a\v\x9ae\x90\x91bo\xb4\xa1\x05{\xb5\x05\x8bF3\xa8/\x96\xffk5+\xdb\xf7\xedѓN\x18\xf6_ڢ\xd2ș\xd9\xf0\xbbgL\x90'\x05\xf1\x94Ї\xe2\xe8\xe0\v\x83\xb5$\a\xaa\xb6\v\xe5\b\xfan\r-Ҝ\xca/a\xa8\x9f\x0e\xde<\x9c\xd4T<\xc3\xc74\xf0,\x1ew'0ζs\x10sR\xb2>\x9e\xbc\x04\x06\xc1kk\xb8\x91\\u^\x03B.\x1c\xeaHkktڵ;x\xf4S\xf0\xdd[ч(Lx\xe4\x19et\xcb\xe5\x1bW\x14\xb4\x81\xcc\xcb\xc6\xd1\x1f\xcb\xf3\xd6\xee\x84\x01\x85'\x15wNY\x1c\x01\x8bl\xaf*&\xc0\x05\xe5\xcd!{S\xaf\xb4{\x96B\x98\x8e\xbeN\xc5\\\xa7[J|\x00\x96|v\xa7$\xf4 \x13\xdb?\xff\xd6\x1d\xbc\x11\xc0\xc2\t\av\x9b\x85*$8:\xc0\xb1\x1e\"\\\xa19\x1b䣿4I\xb7G\xa4\xd3\x13\xd2E]\xaf\xe2\x9a\xf6uK\x98\xcf\xc1\xa4{?_\xa4\xde\xd8\x7f\xedr\xf5p\x82:\xac\xe0\x06\x9e/-\xff+>\f-Ao:\xcf\x00ۿNt\xeaG'\xf0L\xa3\xda\xd5\x7fMN\xf7v\xb2\xf8\f+\xcdB\x1d\x19y\a\a\xb4e4\xea\xcd?\x14\fRo\x9a\xb1nU\xdb\xed*umt;F\x9b2\xac\x05-\xa3\xc3ڭ\xbe\xe2\va\xa9ՈiҘ\xa1\xb0qM\xf4\x88&O$\xf8\x06K&\x12n\x1a\x9b\b\x967h}\xa8c[Qh#{\xc5Y=Q\x05٦\xe9\xd5\xf3onP\xa4\xc9\xc3\xdd\xff\xbam\x1e\xad.\x0f\x8f\xdf\xef\xd4\xe7\x11\xd1\xe3\xbdK~\xf9\x91\xf3\x8f\xcd_H>\x9bGr_8m\x99\xb7\x96\xb6C\xc5]i\xfa\x85i\x961\x10n\xac}\x86\v\x04\xcbvw\xe4ƞ}Q\x15\xb5\xa2\x85\xfb3\x93\xc2\xd6\xc1\xea\x1d\xf9\xf7\xffX\x11\xd7e閥ޑ\x7f\xff\x8f\xd5\xff\x1f\x00\xb4A\x9d\xd0\xc5\xfa\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec[_\x8fܶ\x11\x7f\xdfO1p\x1e\xee\xe5V\xeb8iP\xecKq>'\x81\x9bs\xee\xe0\xb5݇4@\xb8\xe2hŞD\xaa$\xb5\x9bM\xd1\xef^\fE\xea/\xa5\xbdK\xd3\x00\x05b\x1dЮ4\x1a\xcd\xff\xf9qȬ\xd6\xeb\xf5\x8aU\xe2\x13j#\x94\xdc\x02\xab\x04\xfelQ\xd2/\x93<\xfe\xd9$Bm\x8e\x9f\xefѲ\xcfW\x8fB\xf2-\xdc\xd6ƪ\xf2=\x1aU\xeb\x14\xdf`&\xa4\xb0B\xc9U\x89\x96qf\xd9v\x05\xc0\xa4T\x96\xd1mC?\x01R%\xadVE\x81z}@\x99<\xd6{\xdcע\xe0\xa8\xdd\x17\xc2\xf7\x8f/\x93/\x92\x97+\x80T\xa3{\xfd\x83(\xd1XVV[\x90uQ\xac\x00$+q\v{\x96>֕\xb1J\xb3\x03\x16*u\xc4&9b\x81Z%B\xadL\x85)}\x9aq\xee\xc4cŃ\x16Ң\xbeUE]6b\xad\u1bfb\xfb\xef\x1f\x98ͷ\x90\x18\xcblm\x92*g\x06\x9d\xc8\x1cM\xaaEE/o\xe1\xb5\xfb\x1e\xec\x9a\x0f\u009d\xff\"4o\x81\xa9\xd3\x1c\x98\x81\x9b#\x13\x05\xdb\x17\xb8\xf9(Y\xf8\xff\x8e[#\xf6C\xcbݞ+܂\xb1Z\xc8Ì(\x053\xf6\x13+\x04o-1\x95\xebnB\x03\u0080\xcd\x11\xe8m\xb0t\x83~5\xf6\x022\x18B\xb0\x17\x9c\x98q,\x01\x8e\r\x0f\xe4=a\x897|\x1a<h\xa4\xa6\xdfc\x99\x83\xf7\x93\x89\xe7z\x1co\x0ex\x81\r\xb9-ᘱ\xba\xb0Sm\xdf4\x0f\xfaڰC\xa7O\xefK\x9e\xb2\xf7\xb5\xbdR\x052\xb9\x028hUW[\xe8b\xa5\t*\x1f\xa9M\x947\xfe\xf6\xee\x0e\xdev\xcf\va\xecw\xf34w\xc24\x82WE\xadY1\x17\xa9\x8e\xc4\xe4J\xdb\xef\xbbO\xafao(\xc4\x01\x8c\x90\x87\xba`z\xe6\xf5\x15@\xa5Ѡ>\xe2G\xf9(\xd5I~#\xb0\xe0f\v\x19+\\\x80\x99T\x91\x89\x1d\xf3\x8a\xa5ί\xa6\xdek\x9f\xb6\xfe\x83M\xa0m\xe1_\xff^\xb5!@\xe1\xee\x1e\xaa\n\xe5\xcd\xc3\xdbO_\xec\xd2\x1cK\x97\xd6\x13\x87DM@\x11\xc8zA\x96\xa3F\xf8\xe4\xac\xdd\x04\xa0\xf1Zy\x8e\x00j\xff\x0fLm\x88\xc5J\xab\n\xb5\x15\xc1,t\xf5\x8aT{o$\xcb\x15\t\xdb\xd0\x00\xa7\xb2\x84M\"\x1c\x9b{\xc8\xc18E@e`sa@\xa33\xa2\xb4\x9dså2`ҋ\x95\xc0\x8e\f\xad\r\x98\\\xd5\x05\xa7ZvDmAc\xaa\x0eR\xfc\xd2r6`\x95\xcf=\x8b\xc6\x0e8\xba\xda#YAf\xae\xf1\x1a\x98\xe4P\xb23h$ա\x96=n\x8e\xc4$\xf0\x8e\x92U\xc8Lm!\xb7\xb62\xdb\xcd\xe6 l(˩*\xcbZ\n{\u07b8\xe2*\xf6\xb5U\xdal8\x1e\xb1\xd8\x18qX3\x9d\xe6\xc2bjk\x8d\x1bV\x89\xb5\x13\\\x92\xb2&)\xf9gm0\\\xf5$\x1d\xd5%w\xafɉY\xbbS64>o^kT\xec\xcc+\xe4\xc1Y\xe5\xfd\u05fb\x0f\x10>\xea\\\xd0c\x19\x82\xa0{\xcdt\x86'C\t\x99\xa1voA\xa6U\xe98\xa2\xe4\x95\x12Һ\x1fi!P\x0e\x8dn\xea}),y\xfa\x9f5\x1aK\xfeI\xe0\xd65'\xd8#\xd4\x15\x95 \x9e\xc0[\t\xb7\xac\xc4\xe2\x96\x19\xfc\x9f\x9b\x9d,l\xd6d\xd2ˆ\xef\xf7\xd4\xf0\xaf!l\xac\xd5\xde\x0e\xed.\xea\xa1h\x96\xee*L\ay\xc2\xd1\bM\xb1l\x99EJ\x12擶\xc7\x16\x16\n\xe3|\xf2\xd2\xc5\xd2\x14\x8dy\xa78\x0e\xef\x8fD\xbdi\xc9\x06\xb2U\xa8Ka(\x8d\rdJ\x8f[\x1a\xf3}\xa5\x7f\x85\xfa\x93\x8c\x9e\xa0\xac˱\bkx\x8f\x8c\xdf\xcb\xe2\x1c}\xf07-\xec\xf8\x03Qw\xd1_#\xd6\xee,\xd3\a\xd4B\xf1Eu_\x8f\x88[\xa5su\x82̅\xad\xb4\xc5\x19\xac\x02s\x96\xa9g>\xe2\bp\xf3\xf0\xd6\a\x84O\x0e\x9fK\xde6\t\xdc\xf8\x9cT\x19\xbc\x04.\f\xc1\x12\xe3X\x8e\xcdC(\x8b\x9en\xc1\xea\xfa\xc9J\xa7Jf\xe20V\xb5\x8f\xbd\xe2Q\xb1\xc8td\xab[\xf7\r*4\x14\x01\x95VG\xc1Q\xaf)\xf2E&R*˙8\xd4\xdaE7d\xae!\x8e\xb5\x8b\xe6\x0e\xfd\xa5\x1a9\xe5(+\xb6\x8b2\xb4d\xf49˄lzL\xf7\xba+\x1c\xba\xf4\x8dPZ\x94\xdcc\xa7\xfee\x95\xab?\x069\x9c\x84͛\xb2\x16\"vD=\x97Qt=\xe2yzs$\xf3\x87\x1c\xe1\x11ϔ\xd1$\xaa\xc1T\xa3u\x11\x85\x05\xb5\x1e\n\x98\x04\xe0]m,\t\xc5(T\xc4Td\xba\xfc\xbb\x8fx\x1e\x1b\xf6\x82#=,\xbb$\xea\x15\xe1\x95 \xa8\xc6\f5J\x1b-ȴ\x80\xd0\x12-\xba\x15\nW\xa9\xa1.\x98be\xcdF\x1dQ\x1f\x05\x9e6'\xa5\x1f\x85<\xac\xc9\xc4k\x9f\x1f\x1b\x12\xc4l>s\xff\x13\x91\a\xe0\xc3\xfd\x9b\xfb-\xdcp\x0e\xca模6\x98\xd5E\b\xa8\x1e\x12\xb9v}\xf1\x1aj\xc1\xffr\xb5\x9a\xf0Y\xb6\x87r\xdea\xc5E\x9bP\x9d\x16\xd9\x19N9:q\xc84\xbb\xc6\x0fJ\x03u7rn\xe9\xbd\xd7ԏ\x98\xf7\xc6(\xb8\xff\x8f\n\r\xd5\xfe\xb10k\n\x9c\xa7\xa6\x90G\xed\xdbՂ2\x01\xc0\v\xc9E\xca,\x9aa䇵\x8bg\xf5kK\xfc\xbc\xaa\x1c\v\xb4\xf8\xa0\n\x91\x9e/\b\xda\x11\xb6E9\xb8\x80\xb0\xdb)GII\xd4p\xec5$\xb3\x1apu\xd0\xcf=\x1e\xd6d\xb09\xb3\x90\xb3#\x82T\xbe\x0f\x04ʴ\xa8\x8dE\xfd\xacҼT%\xb8>\xbf\xaf\a\xc09\xae\xb3##\x00\xa6\xb45\xa0t\x953\x89<\xe8\xd5T*<\xa2\xa4\x87N\xd2\b\xc7\xce+\x8e^ն1\x91\a\x81\xe5X\xa9e\x7f\xd1u\xd0,\xc5x/\x9d\xa8\xf0mGK\xb1D]\xb4P\xf2\x00\xcc+\xd1䉱\xecܪ\x17a\t\xb0\xc7\xccao{e\xbc\x87y\x12V\x9f\x84\"\xe1\u0557yL\x93E\x17]\xae\tN\xa4[Z\xa6\xd6\xd5E]\xef\xfbԀ\x92\xbe\xeb\xa5%cO\xdcGu>\xc2\x13b\xc1I\xa5\x94\ue7ef\x8e\b{Dٱ\v\xf0˹\x05*痘)\x00\bO\xf5\x13#\x14v\xb7n\xd5W&\xc490\x8d\xd4N\r\xf5\xf3\xbe\xa1\xe3Ҫf\x91\xfb\xdc@\x9a\xad[(S}v6\xfdn\xdaM\a\x16\xff\xbaO\xe9\xdbgS\xb0\xa8\x04\v\t,Tf\xd7٭\n\xbcGL\x03H$\xa5\xadK'J\x15\xb8\xf9z\xb7~\xf5\xa7\xaf\xd6\xdf\u07be\v\x01\xe8\\\xa0i\xa5R(\xc6\x1b\x9e\x11\x15\xe8ϻ.i\xfb}h\t\xf83K\tC~\xf1\n\xf6g\x8b&Y=#f\xff\x00\x1f\x7f\x80\x8f\xff\a\xf0\xd1$\x85_\x96nW\v*\xdd\xf7)\xc3\x02\x16\xfc*\xc2/7\rZ+\xe4\xc1\x80DZ\x8e2=\x96\xc3!\xf8TII1l\x15\xb0v=reF\xb54yFF\xed\xeb\xf4\x11\xedE\xaf\xbcvd\x01,5/\x91@\xb5A\xb7:^\x16\xe0bt\xa4\xec\x16\xf5e)no\x88\xac\x05G\fno`_K^`\x90\xc5a\xa4#j\x91\x9d\xa9#}\xb8\xdbExB\xb0\xa3[\xdc\xfb\x01Z\xb0fL\xf6fy\xb5u\xc5칪U\x1a3\xf1\xf3E\xd5\x1e\x1cY0p\xc5l\x0eµ'`\x11sG\xa6$\xe1\n.\x80{\x9fq\xcft\xc6|n4^\x7fjz\x04{nW\x8bZ7D\xad\xde\xfe\xa5P\x13}Ӛ\t\xabY-\xfc\xf0\xed\t\xa0\xfb}\x9f\xb2\r,\xfa4\xedc\x10\x94$\xe4\xad\xd1j\x81-\x9a\b\xb3\xbd\x11c\x80\x92ql\a\xb2>χىP\x15\xf5A\xc8߬#zѦ\x0f&\x8a:\xba\x00QK&\xcfn\xab\x86f\xa8\x19\x13\x05\xf20\xb2$\x92\x86+\x1fK\xd9\\\x1f\x1d20\x0eC)\x02\\\x9e\x1a\x84s\xda9\xf0\v`܊\x92rQմ\xae\xae\x8d\x8d2\xf5\xf3Q\x89\af\xc5\x11\x87\xd0\xf7eL\x90\xc6\xfb4\xe4>\xa0\x9e<\xf7\xee\xbbh\x97\x0f\xde\xcd}\xe8\x8e,\xcd[k\xa4L\x82e\x8f\xd8\x01\xf4\bKp:\x9b\x04\xdeZ\xe0\n\x8d\xbc\xa2\x05gZԜ\xfa:\xe3a\x1e\xed\xd1\x17\x05\x12W'\xe9\x11\x96o\xd5qk\a\x9cR)#\xc82de\x83vh \xa9B\xbcƘ,\x06\xd7b\"-\xe4w\xb7w\xf3\x8d3\x95\xbc\x90i\x9f\xa6\xf4\v\xa3G\xcf}*lcE\xad\xd1TJ:\xbb>m\xf0؉\x9b\xac\x9ea\x9d\x19\xcbĊ\xe4\x1aT\xbf\xcf\x0f\x9e\x84b\xb8\xba`X\xbf;\xb6\x9a\xb1at\x12\xbes\xef\fj\x97ڻ\x05Oo\xb0\x1e}su\xb9\xc4<q\x86\xfe\xa27D\xa7m\x19\t\xb5t\v\x12\x87\"\x13\xf8\xbb\x847\xb4\xc9B\x03\x18\xbe%\x19)\x93\xa6\x05T\xaa\x13\xbd\xdc\xe3\xe6\x18\xf8\xb5\xbfÆn\x1bˍp\x9aG'Q\x14\x94\x1f\x1aKu\x8c A\x9a\x91j,δW\xae28\xbeJ^&/~\xe7\x01=\xad41\xad)}\xbfa\xa2\xa85\x9aEs\xdeN\xe9C\x87\x94u\xb9\xf7\xfd\xd1Uo\xb7\x04\xd4\xea\xe4\x86;#\x9e\xfd$\x8dw\xd4nr\x923\xe3\xeb\xb6+b\xae\a\x98I\xb7\a؟\x81\xd1\xd9\x03r\x10\xcd(\xe7\xf3j\xbe>\xd39\x81\xc6ŷ9\xa6\x8f\x8b\xa6\xb8\x1b\xd2\x063h44\xadSٸזʸm\xd2\xf1\xbe\\\x17̐\xd2G\xafᔋ4'~\xba\x96~\xb6\xd6cE\x0f\xfc\x91\x9201ow\xef7\r\xa3\xb5c\xb4\xf6\x8d\x02\xf9\xb3\n\xcbRO\xa7'\xfd\x93,\v\xe6\xb9oI];\xeeLӂ\x15'\xe4\x95\xe91\xbd\x8e0\xedF\x86\x9a\xe0\x97k\xe3'\xdaD\xd7\xf5ı\xf4',\x96Q\xe9\x9eR\xb1z\xfel\xe5\xf7\x8e\x8dr\x84\x9e\xbb\x95l\x17̝F~\xf3\xbc\xefߘ\xd0\xcbV\x0f۟\xc6D\xd6s3\xea\xbdk\xa8CT\xa2\xd6J\x0fe냡\xb8L\x8b\x95\xa3\xbbZ\x8eO\x14ml\xd9!\nm\xb9]\xc3Cm\xe3\x11\xd1\\ߢ\xbd\x06:b\x02J\xfb\x19\xf5\x7f\xa5\x87\xab\x1dȧ\v\x8c\x19=v\x81\x1eD7\x0e\x1f\x9a\xb8eyI\xb0\xf84`\xbe\xa5w\xff\xd6\xdd\xe7f\x9e\xb7BD\x9f\xcf¨'\x94\x8a\xee}\xa65\x9b\xce\x05\xda\x02tsy\x05\xed\xd7;\xc8ol\x88\x8b\x16-\x91Q/ո\b\xff\xfe\xd1;ׅ\xba\xb2C\xa7\xbd\\y͔\xbe\x06C\vmf\xe1\x94+<\xa2\x86e\xa6\xc2Co,\x8a\xe65A{\x17f\xae -F\x1ea㋦\t'\xd9\xdaV0Pai,@@um\xc3a\xb9_\xe5٨\xe0\xb3AC\r\x94v\xf0\x91\xbfǣ\x18\x1fQ\x9ah\xf6\xe2nB\x1fu\xfeO\xe1\xec\xc7F{\xb2\x9fFl\x012Q`\xe8\x15sXbz\x16\xf0\xf5\xee\xeeʴ\xb3\xe7\tS\xd7i\xe8h\x00e\xb9\xb4j\xb0%5\x05\x8f-\xf6\x13\x86v\xb2h\xbbe\x840\xe8\xcf\x1f\xb5\xa1\xb2\xd5@Q\xa5\x81#\x9d\x92\xa1UC\x9a3y\xc0\xee\xf8\x94\x97\xbd'%\x01ͩ\xa4C\xb4١K!\xe3\xd0rֽ\x9d\x0f?D\xa2s\xe0\xbf\xce}\xf3\xa7-[\xa9U6\xc01ϳ\xf5\xeay\x01\xbe\x18܋\x9aw\xab\xc1'i?$\x8f[\xa0\x17\x8dK\xea\xb3v-\x88\xfc\xf7\xd7ݝ\xf5]Tם\xd7\r\x1a\xa6\xb5\xa6-\x81n\x1dG7\xa3\x98*yҒ\xa6=,<y2><\xfc\x04]|\xde\x7f\x8c\u1941J^ԏ}\xa8䎈\xd2<\x93Ӓb\x94\x82~\xf7n\xc4\x13\x1a\x94.\xacs\xa2s~\x89\xcc\xd4\x1ay\x0f\xcdsB\x88\xcc\x0e\xb7\xfdB\x89\xaaMlS_c\xa6\xd1\xd0\xc0բ>N'\xa6\xbf\x1a\xc8Ӽ8\x8a4\a\xe6yMT\xc1.VYV\x80\x11\xbf\xb4\xee\xf6ӡ\xf0\xb33S\x84o\xd8\xe6\x9b\xec\xb8\xf5#ZH\xfb\u0557\xcf\x1e\xa8\x91\xbd?6%5\x96\xb2\x13\xad\xee\x86\xf4\x83\xce\xea\xdc\xe0\xbc\x18\x1c\xb8$\xef\\\x06^t\xcdb\xf4v[8\x97=t\xef]0Y\x83\xfff\xbeY\xb2\xfd\f\f\x88\xdc\x1e\xdd\xf2ǐ\xb7p\xfc\xbc\xfb\xe5\xff3\x04\xda\x0f\xf4\x0f\xe8\x90\x15M\x8bz6\xf4\xf9\xe2\xeftS)\x02\x85\x95E\xde;AN\a\x92\xb6\xf0\xe2\xc5\xe0\x04\xba\xfb\x99Ҁ\x8e\fh\xb6\xf0Ït\x1a\x9cJ3\xf7\xbb\x89f\v?\xfc\xb8\xfa\xcf\x00\xee2\x16\n\x0f2\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VKo\xdc6\x10\xbe\xebW\f\xd2C.]m\x82\\\n\xddZ'\x05\x8c\xb6\x86a\xa7\xb9\x049p\xc9Y\x8955dg\x86뺿\xbe %y\x1f٭\xd3CE]8\x9c\xe77\x0f\xb2Y\xadV\x8dI\xfe\x13\xb2\xf8H\x1d\x98\xe4\xf1/E*;i\x1f~\x90\xd6\xc7\xf5\xee\xed\x06ռm\x1e<\xb9\x0e\xae\xb2h\x1c\xefPbf\x8b\xefq\xebɫ\x8fԌ\xa8\xc6\x195]\x03`\x88\xa2\x9aB\x96\xb2\x05\xb0\x91\x94c\bȫ\x1e\xa9}\xc8\x1b\xdcd\x1f\x1cr\xb5\xb0\xd8߽iߵo\x1a\x00\xcbX\xc5?\xfa\x11E͘:\xa0\x1cB\x03@f\xc4\x0e\x1c\x06T\xdc\x18\xfb\x90\x13\xe3\x9f\x19E\xa5\xdda@\x8e\xad\x8f\x8d$\xb4\xc5p\xcf1\xa7\x0e\xf6\a\x93\xfc\xec\xd4\x14\xd0\xfb\xaaꧪ\xeanRUO\x83\x17\xfd\xe5\x12ǯ~\xe6J!\xb3\t\xe7\x1d\xaa\f\xe2\xa9\xcf\xc1\xf0Y\x96\x06 1\n\xf2\x0e\x7f\xa7\a\x8a\x8f\xf4\xb3\xc7ः\xad\t\x82\r\x80ؘ\xb0\x83\x1b3\xa2$c\xd15\x00;\x13\xbc\xab\xf0LqĄ\xf4\xe3\xed\xf5\xa7w\xf7v\xc0\xb1&\xa0\x90\x1d\x8ae\x9f*߹\x18\xc0\v\x18\x98=\x01\x8d\xb3\x83\x10\t!2\x8c\x91\x11&o\xa5\x9dU&\x8e\tY\xfd\x82`Y\a\xf5\xf3L;1\xfe\xbax7\xf1\x80+\x15\x83\x02: \xec&\x1a:\x90\xea9\xc4-\xe8\xe0\x05\x18+,4\xd5ЁZ(,\x86 n\xfe@\xab-\xdc\x17\xe8X@\x86\x98\x83+e\xb6CV`\xb4\xb1'\xff\xf7\xb3f)\xf1\x15\x93\xc1\xe8\x92\xe0\xe5\xf3\xa4\xc8dB\xc15\xe3\xf7`\xc8\xc1h\x9e\x80\xb1\u0600L\a\xda*\x8b\xb4\xf0[\x01\xc7\xd36v0\xa8&\xe9\xd6\xeb\xde\xeb\xd216\x8ec&\xafO\xebZ\xf7~\x935\xb2\xac\x1d\xee0\xac\xc5\xf7+\xc3v\xf0\x8aV3\xe3\xda$\xbf\xaa\x8eS\tV\xda\xd1}\xc7s{\xc9\xeb\x03O\xf5\xa9T\x82({\xea\x9fɵ\x86/\xe2^\xeawJ\xf3$6\x85\xb8\x87\xd7S_\x13q\xf7\xe1\xfe#,Fk\n\x0eT\u008c\xf6^L\xf6\xc0\x17\xa0<m\x91\xab\x14l9\x8eU#\x92Kѓ֍\r\x1e\xe9\x18tɛѫ,\xe5W\xf2\xd3\xc2U\x9d\x1b\xb0A\xc8\xc9\x19E\xd7\xc25\xc1\x95\x191\\\x19\xc1\xff\x1d\xf6\x82\xb0\xac\n\xa4/\x03\x7f8\ue5af\xc8w3Z\xcf\xe4e\x16\x9d\xcdЙ\xb6\xbcOhK\xce\npE\xd6o\xbd\xadm\x00\xdb\xc8\xf08x;,my\xa0\x15\xf6\r\xbc4륆-kRP\xa6\xca1\xfdB\xb0P\xf3\xe4\x19\x8fjmu\xa0\xe6E\x14\xd4h\x96\xff\x84C\x95X\x90\xb0\x99\x19Ig=u\n\x9c\x13\xfa\x96ؑ9\xf2\t\xedĝ\x0f\x95\xa5\x8c\x135\x9e\x04\f=\xcdb\xa0\x83QxDF@\xb21\x97ف\x0e\\>\xc1k\x86b\xc0i\xaa\x96\xf4%\x8e\x16\xe5y\x96.\xcb+\x8e_ys1\x0f\xe5/7\xa1\xd9\x04\xec@9\xe3\xc9\xe1$g\x98\xcd\xd3\xd1I\x1a\x8c\xe0\xbf\x06}[8\xce\xe1\x8d\x05\xeeB|\x01\xf0\xf2#\xe5\xf1\xd4\xca\nn\xf0\xf1+\xda5\xddr\xec\x19希\v\xfb\xed\x84T\xbd\xec\xbe\x01\x933\x05wB\x9a/\x9a\x0evo\xf7\xbb\n\xfaj~P\xd4\x03\x80z\x15\xbb\x03`E#\x9b~\x81z_\xc5\xc6ZL\x8a\xee\xe6\xf49\xf1\xea\xd5ѻ\xa0nm$W\x1fI\xd2\xc1\xe7/\xe5V\xd7\xc8\xe8\xe6+Q:\xf8\xfc\xa5\xf9g\x00\"\xf7\xf4 \x8c\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4Wώ\xdb6\x13\xbf\xeb)\x06\xf9\x0e\xf9\nDr\x82\\\n\xdd\xdaM\n\x04\xdd\x06\x817\xc9%ȁ&\xc7\x12\xbb\x14\xc9r\x86v\xb6E߽\x18J\xb2\xbd\xb2w7=\xd4\xcc!\x9a\x19\x0eg~\xf3w\xab\xba\xae+\x15\xedgLd\x83oAE\x8b\xdf\x18\xbd|Qs\xfb#56\xacv\xaf6\xc8\xeaUuk\xbdi\xe1*\x13\x87a\x8d\x14r\xd2\xf8\x06\xb7\xd6[\xb6\xc1W\x03\xb22\x8aU[\x01(\xef\x03+!\x93|\x02\xe8\xe09\x05\xe70\xd5\x1d\xfa\xe66op\x93\xad3\x98\xca\v\xf3\xfb\xbb\x97\xcd\xeb\xe6e\x05\xa0\x13\x96\xeb\x1f\xed\x80\xc4j\x88-\xf8\xec\\\x05\xe0Հ-\x98\xb0\xf7.(\x93\xf0\x8f\x8c\xc4\xd4\xec\xd0a\n\x8d\r\x15E\xd4\xf2h\x97B\x8e-\x1c\x19\xe3\xddɠљ7\x93\x9a\xf5\xa8\xa6p\x9c%\xfe\xf5\x12\xf7\xdaN\x12\xd1\xe5\xa4ܹ\x11\x85I\xd6w٩tƮ\x00bB´\xc3O\xfeև\xbd\xffŢ3\xd4\xc2V9\xc2\n\x80t\x88\xd8\xc2{5 E\xa5\xd1\b-o҄\xf5d9\xb1\xe2L-\xfc\xf5w\x05\xb0SΚ\x82\xd4\xc8\f\x11\xfdO\x1f\xde}~}\xa3{\x1cJ,\x84l\x90t\xb2\xb1\xc8-\xdd\x02K\xa0`2\x128\x1c\xec\x06\xe5A%\xb6[\xa5\x19\xb6)\f\xb0Q\xfa6\xc7I'@\xd8\xfc\x8e\x9a\x818$\xd5\xe1\v\xa0\xac{P\xa2m\x14\x04\x17:\xd8Z\x87\xcdt%\xa6\x101\xb1\x9d\x83 \xe7$\xfd\x0e\xb4\x85\xc1\xcfţQ\x06\x8c$\x1c\x12p\x8f\xb0\x1bih\x80\x8a\xb7\x10\xb6\xc0\xbd%HX\x90\xf6c\n\x9e\xa8\x05\x11Q~\xb2\xbc\x81\x1b\x89F\"\xa0>dg$Kw\x98\x18\x12\xea\xd0y\xfb\xe7A3\t.\xf2\xa4S<\xe7\xc9\xfc\xb3\x9e1y\xe5$\x16\x19_\x80\xf2\x06\x06u\a\t\v:ٟh+\"\xd4\xc0o!!X\xbf\r-\xf4̑\xdaժ\xb3<\x17\x9c\x0eÐ\xbd\xe5\xbbU)\x1b\xbb\xc9\x1c\x12\xad\f\xeeЭ\xc8v\xb5J\xba\xb7\x8c\x9as\u0095\x8a\xb6.\x86{q\x96\x9a\xc1\xfc\xef\x901\xcfO,\xe5;I.\xe2d}w \x972x\x10w)\x831=\xc6k\xa3\x8bGx\xad\xefJ \xd6oo>\xc2\xfch\t\xc1\x89\xcaC\x9e\x1c\xae\xd1\x11x\x01\xca\xfa-\xa6rk\xcc2ш\xde\xc4`=\x17\xf5\xdaY\xf4\xf7A\xa7\xbc\x19,Ӝ\xb6\x12\x9f\x06\xaeJہ\rB\x8eF1\x9a\x06\xdey\xb8R\x03\xba+E\xf8\x9f\xc3.\bS-\x90>\r\xfci\xb7\x9c\x7f\xa3\xe0\x88ց<\xb7\xb3\x8b\x11Z\x94\xf2MD-\xf1\x12\xd0\xe4\x9e\xddZ]J\x00\xb6!\x81:V\xf6\x04\xdb\\\x97\x0fզ\x1cV\xa9C\xbeO[X\xf1\xb1\x88\xc8\xc3\xfb^\xddo!\xffǦk\xa4\x0f\xd0d\xc2\xd8\x19~8}\xf9\xb1\xd7/\xe5\xe8E\x1b\xe6T\x15\xd7\x05G)ti=\xa7\xd6,\x1f\x95\x83>\x0f\x97\x94\xd7\xf0s\xb1\xf4:tՂu½\n\x9e%\xa1\x1f\x11\xf9\x1c\\\x1e\xf0ƫH}xTr\x9e\xa9\x879s\xff\u0530Fi\xb5\xf8\x90I\x13{\x8d\x94\xddŇ.&\xe2|d6>\x89\xb2\x8c\xa6\x19e\xb9 (\xcb\xffe\x9e'\x8f\x8ctl\x03{\xcb=\xec{\xab\xfb\vZ\xa1\x14v\t\x90\xf4\x17\xa2\xa0m\xa9\xd8\x7fg\xb6\xe4\xb1Mx\x96\x1euI\x9a3\xa2\x98\xbc ^\xac\xb9ˊ\xeb\xa9\x16\xaa'nO\x03\xbaz\x00\xc3e\xcd\x16\xe9\x19T\x9dSBϓ\x0e\x81W-/4\xd5\xd3e3g\xfc\xa7\xf5u[=\x12\xcfY\xf5\xa7\xf5\xb5\f?V֏vĄ5\xd9Σ\x01\xe1I\xed\n\xf9\f\x80\xf1\xdf\xe9\x8c\x7f2j\xf8-\xdat\xb2\xb2<`\xdaۃ\x98`\xb3\xefя#b\x81ƨ\x0e\xa9\x8c]\xad\xee\x0f{9\x1b\x04\x83\x0e\x19\rl\xee\x8aotG\x8c\xc3\xd2\xdemH\x83\xe2\x16dp\xd4l\xcf\x12E\xd6O\xb5q\xd8\x02\xa7\x8c\xdf\xebl\xec\x15\xe1\xa3~~\x10\x89K\xe1?\x14\xd7\xc2\xe3\xa6z\xba\x83\xd5\xf0\x1e\xf7g\xb4\x0f)h$B\xf3}\xd6_H\xee\x05iZ\xc0Zؽ:~\x95ݮ\x9e\xf6\xf4\xc2\x00([\xaf9\x81n\xda\x19'ʱb\x94\xd6\x18\x19\xcd\xfb\xe5\xa6\xfe\xecٽջ|\xea\xe0M\xf9ۃZ\xf8\xf2U\x96ei\x8ffZ\x15\xa9\x85/_\xab\x7f\x06\x00]]l+\xe3\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y]\x8f۸վ\xf7\xaf8\x98\xbd\x98w\x81\xb1\xbc\x9b}Q\x14\xbeKf\x9ab\xda\xcdd\x90\x99\xcdM\x90\x8bc\xf1\xc8b-\x91,yd\xc7-\xfaߋCJ\xb6,\xcb\x1eg\x17I\xc7\x06\xc6\xe2\xc7\xc3\xf3͇\xd4d:\x9dN\xd0\xe9\x8f䃶f\x0e\xe84}a2\xf2\x14\xb2՟C\xa6\xedl\xfd\xf3\x82\x18\x7f\x9e\xac\xb4Qs\xb8m\x02\xdb\xfa\x03\x05\xdb\xf8\x9c\xee\xa8\xd0F\xb3\xb6fR\x13\xa3B\xc6\xf9\x04\x00\x8d\xb1\x8c\xd2\x1c\xe4\x11 \xb7\x86\xbd\xad*\xf2\xd3%\x99l\xd5,h\xd1\xe8J\x91\x8f+t\xeb\xaf\x7f\xca~\xc9~\x9a\x00\xe4\x9e\xe2\xf4g]S`\xac\xdd\x1cLSU\x13\x00\x835\xcd\xc1Y\xb5\xb6US\xd3\x02\xf3U\xe3B\xb6\xa6\x8a\xbcʹ\x9d\x04G\xb9,\xba\xf4\xb6qs\xd8w\xa4\xb9\xad@I\x99G\xab>F\x987\x11&\xf6T:\xf0\xdf\xc7z\x7fՁ\xe3\bW5\x1e\xabc!bg\xd0f\xd9T菺'\x00\xceS \xbf\xa6\xdf\xcc\xca؍y\xab\xa9Ra\x0e\x05V\x81&\x00!\xb7\x8e\xe6\xf0\x805\x05\x879\xa9\t\xc0\x1a+\xad\xa2)\x92\xdc֑y\xfdx\xff\U000579fc\xa4:\x1a[\x9a\x9d\xb7\x8e<\xebN=\xf9\xf4\x1c\xbbk\x03P\x14r\xaf]D\x84k\x81Jc@\x89+)\x00\x97\x04\xeb\xd4F\nB\\\x06l\x01\\\xea\x00\x9e\xa2\x0e&9\xb7\a\v2\x04\r\xd8\xc5?(\xe7\f\x9eDO\x1f \x94\xb6\xa9\x94\xf8\x7fM\x9e\xc1Sn\x97F\xffk\x87\x1c\x80m\\\xb2B\xa6\xc0\a\x88\xda0y\x83\x95\x18\xa1\xa1\x1b@\xa3\xa0\xc6-x\x925\xa01=\xb48$d\xf0\xcez\x02m\n;\x87\x92م\xf9l\xb6\xd4܅rn\xeb\xba1\x9a\xb7\xb3\x18\x90zѰ\xf5a\xa6hM\xd5,\xe8\xe5\x14}^j\xa6\x9c\x1bO3tz\x1a\x057\xa2l\xc8j\xf5\x83o\xe3>\\\xf7$學-\xb0\xd7f\xb9k\x8e\x01v\xd2\xee\x12`\xa0\x03`;-\xa9\xb87\xaf4\x89U>\xfc\xe5\xe9\x19\xbaE\xa3\vz\x90\xd0Z{?-\xec\r/\x86Ҧ \x1fgA\xe1m\x1d\xedLF9\xab\rǇ\xbc\xd2d\x0e\x8d\x1e\x9aE\xadY<\xfdφ\x02\x8b\x7f2\xb8\x8d\t\r\v\x82\xc6)dR\x19\xdc\x1b\xb8Ś\xaa[\f\xf4\xcd\xcd.\x16\x0eS1\xe9ˆ\xefס\xeeO\xe6\xcf[k횻B1\xea\xa1A\xee?9\xca\xc5_b4\x99\xa7\v\x9d\xc7\x14\x80\xc2z\xc0a\xa9\xc8z\xb0c\xa9)\x9fT\xb9\x9e\xd8z\\ү6\xef%\xf9\t\x99ތ\xcd褒\xda&9(\xbf\x134\x84\x84=\x80\x04\xa8\xba\xa9\x9b\x92<\xc5@\xf0\x14X\xe7\x12H6h\xb6~+\xb02\x9fT_\x97\x93F\x97\xaf\xb1\x8a\xce\xca\xff`\x15\x8d\x89+\x13\x81KL1\xf9h\x95\f\xf2\x8d1\x92\x05\xd6\\,\x80\xb3\xea\xec\xfa-2\x82\xa7\x82<\x19ɨT|\x9c\x8d%\x8aQ\x9b.\xf3\xd2\xf6\x02l\a\x88 Y \x06&\x05\x87\x8e>\xe7\xec\xd3\xf5xT\xd2\u05cf\xf7]\r\xee\x8c\xd4\xca\xcc\xc3\x15\xcfZD\xbe\x85\xec2\x8f\xc8勫^\xdf\x17\xc94\x82#\xa6Ap\x9ar:(\xed\xa0M`B\x95\x1aG \x01$q=\xb5\xe3oR\xfdi\xcb\xdc~;\x10[\x03J\xdd\xd3\n\xfe\xf6\xf4\xfea\xf6W\x9bd\x1d\xc5\xc4<\xa7 0\xc8T\x93\xe1\x1b\bM^\x02\x06q\xb1\xf6\xa4\x9e\x18\x99\xb2\x1a\x8d.(p֮@>|z\xf5y\xccf\x00o\xad\a\xfa\x82\xb5\xab\xe8\x06t\xb2\xf2\xae\xa0v\x01\"\xe1*\x86\xd8\xe1\xc1Fs\xa9\xc7\x15G\xd9\xf3[\x857QQ\xc6\x15\x81m\x15m\b*\xbd\xa29\\I\t\xe9\x89\xf8oɆ\xff\\\x8db\xfe_J\xd2+\x19r\x95\x04\xdb\xed\x99\xfd$\xda\v\x982\xc9\xeb\xe5\x92|\xe4\x10\xc7\x1f\x99@k2\xfc#X/\xba\x1b\xdb\x03\x88\xb0\x92\xff\xa9Б:\x12\xf8ӫ\xcf'\xa4ݣ\x88\x9d@\x1bE_\xe0\x15h\x93\xac\xe2\xac\xfa1\x83g\xf9\x19\xb6\x86\xf1\x8b\xa4z^\xda@\x06\xac\xa9\xb6\xe3\xd2Z(qM\x10lM\xb0\xa1\xaa\x9a&\xae\xa2`\x83[ѿs\x97\x84-\x82Cχld\x14\xf5\xf9\xfd\xdd\xfby\x92JBhiD\x14\xd9\xe5\n-\x9cC\xc8F\xec\x8c1)}\xa1\x89h\"N^\xa2\x19)\xac\xf2\x8d\x9a\x12\x14\x8dP\x88\xeczr4\xe0|\xb6\x0ei\xc3x\xa2F\xfa0,\f\xff\xa3M\xf8\"\xb5$\xa4^V\xeb\xa1\x17\xcfgՒ\xf3\x837\xc4\x145S6\x0f\xa2TN\x8e\xc3̮ɯ5mf\x1b\xebW\xda,\xa7\x12\x88Ӕ\xd8a&\x82\x84\xd9\x0f\xf1\xdf\xef\xd2\"2\xf3\xcbT\x89C\xbf\x87>\xb2N\x98}\xb5:\x1d\xaf\xbctW\xba~j\x99\xcfp\xa6\xa4Ħ\xd4y\xd9\x1d\x12\xf6\xd5s\x04\x13\xa0F\x95J.\x9a\xed7\x0f[1d\xe3E\x9e\xed\xb4=\x86N\xd1(\xf9\x1dt`i\xffj\xcb5\xfa\x82$\xfd\xed\xfe\xee\xfb\x04s\xa3\xbf:#G\t\xb1|\x85\x01\xde+1_\xa1\xc9\xcf'g\x14\xfcp0\xb4#v#Lr7&\x9b\\( \xe3\xf2\x88@\xa1R\xf1\xa2\x01\xab\xc73$\xeb\x8c\xce\a\xc2?\xe32\x00z\x02\x84\x1a\x9d\xf8iE\xdbiڤ\x1dj/\xca w\xc7\xd7\x05\x01:W\xe9\x91\xed\x94m\x9f.\xb6\xcc\x1bCT!\xbb\xd4\xea\x89l\xce\xcf\t\x9c\x8e\x17c\xf4\xb9]Z\"\xa3\xdd|\x84\xe8\xb2\xdd\x13\xd5\x01.\x8c\x10דvK\xe8\xef^\xe2\xf6\x1fw\xc3D\xc4\xd2nz\xa2]\a\x90\x03\x99t\xec\x88\xf3\x1cHsI~\x80\x99\xa8I\xa1+\n\xa2\x9e\x1cD\xe3\xc360\xd57BV:\xaeC\x86wC<n`Q\xd9|\x05\x8a\xd6:\xa7\xa1r\x00\xf7\x05P\xedx{\xb3\x87\x8c\xde?I\xe4\xc94\xf5P\xe1)\xbc\xdd\xc9r\xd4\xf5Fֿ̨r\xb4\x16\xca\xdaǟ\xc2b\xectw0B\xceI\a\r\xce\xf6];\x1d$\xefAWr\xe3\xe4\x85X\x14z\xdd\x1cd\xd5\xd9Cq\x1c݅d*\xb2\xdcb\x88k~ױ8\xb7B\xc8\x0f\xef\xfe\xce\x05\xde\xed\xf1\xf8x\xcb\xe4U\x12\x8bu-I\xde&\xe6\x06C\xb7\xc2\xf1\xc9\x16z`i\x9e\x9cC#\x16\xa9ȗ\x85\xca\x17\xa8+R-`Ȇs\x8e0\xfb\x18\v*\x84\xa35\xae\xb2\xa8\xba\x93f+Zws\xf6,W\f\xf1\x12\xe7:\x9cDl\x02\xa9x\xf50\xa2\xfep\xcf-\xac\xaf\x91璃4\x1d\x01\x94\x8bU\\T4\a\xf6\r]\x16\xc2 hx\xa7\x97rE\xf4m+\xf5\xdd~\xa1\x98\xb2b2\xd5>\xdbb\xa4̈\x7f\x1b\x1e\xa9z\x8bm\xdf\xdeq\xac\\1.\xbd\xe6-\xb8\xaaYj\x13@h$h\x86\r\xf6\xaa\xd5\r\xach;\x8e\x98\xe6Ej\x98}\xbdaG\xf6\x82\x9aB\xc0\xe5\xf9j\xfb.\x8d\x91\xd4\xc3n\x02\xe0\xc26\xbc\xbb\xce8ؐ\xaeC\x9b\x96\xd9\xe4B'\xb8\x91\v\x83\x03\x11\xe4F\xa1K\xfd\xa2\xa9\xaa8\xa3=\x1c\xef\x8at\xba\xf2\x97S1,H\xe2\xfd\x8f\xefG\xae\xc4p\xde8\x8f2b\xac*\xedv\xcc3e\xe9t\xed\x7f\xa0\xcdQ۽y\xf4v\xe9)\fsnڕ\x85#e\xa7\xf06\x16\x90\x8b\xf5m\x178\xafr;\bJ[uu\xcf2V`\x9azA^\xf4^l\x99\x06\t3@\x84\xf6̻7Zov\x97\t\t\xa7=\xc2\xe7h\x84d\xc4b\xc4\x16\x94\x0e\xae\xc2\xe33\xbc뤓\xb3\xa9\xd4\"\xa9\x95\xfbh\xed\xea\x9f#\x1f\xbb\xbe\xe6N-Jsg\xcdQD\xf4\v\x9f6\xfc\xa7\xff\x1f\xe9O\xc1\x1fK\xc0\x18\x11\x11\x03\xbe\xd9\xf2ز\x7f\f\xfbd\xea\xefIs\\\xf7\xb5R\xa4\xce\xfa\xfd\xc3Ȅ.\xf2\x87\xaeߛz\x80\x18\vv\xf2\xe0(\x81\xbf\x01,\x98<(R\x8d\xabt>\xea\xa4s\xf68m\x8b`Ѕ\xd2\xf2\xfd\xddY5\x9fv\xc3:\xe5\xf4\x8e\xe5\x88'b\xc0wX]\x8c\x1f\x92\xa3>\x99\xcd.ͽ\xc0\xe8y\xb7\xaf\x9e\x17\xf1`\xe8\v\f$\xe2\xcaK\x94'r葏31\xbe\xae\xb9\x1d\xbe\x04\xbd\x81\xa0e{\x8aG\x93tVI7Q\xb2mɡ\\.\xeccr\x1e#\x1eP\x8a\x03\nq(\xfa\xf7`\x0f#\t0hj/\xbf\xe7\xb0\xfey\xff\x14\x99\xe2\xb4}\x03\x1c;Z\xb5To\xf1\xf6\xa5G۲'\xb4r\x81\xec\x98\xd4\xc3\xf0\x1d\xf0\xd5\xd5\xc1K\xdd\xf8\x98[\x93(L\x98ç\xcf\xf2jV,\xab\xda\xeb\x8e0\x87O\x9f'\xff\x1d\x00\xf1\x8c\"{=\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_o\xdb\xc8\x11\x7fק\x18\xf8\x1e\xd4\x03L\xea\x92+\x8a\x82o\x17\xbb)\xdc^\x1c#\xca\xe5%\xc8Ê;$\xb7\"wٝ\xa1\x14\xb5\xe8w/fIJ\x14E\xc9\xf2\x1d.\x17\x1b\x88\xb5\x7f~;\xf3\x9b?;\xb3\x9aEQ4S\xb5\xf9\x84\x9e\x8c\xb3\t\xa8\xda\xe0WF+\x9f(^\xff\x95b\xe3\x16\x9bW+d\xf5j\xb66V'p\xd7\x10\xbb\xea\x03\x92k|\x8a\xf7\x98\x19k\xd88;\xab\x90\x95V\xac\x92\x19\x80\xb2ֱ\x92a\x92\x8f\x00\xa9\xb3\xec]Y\xa2\x8fr\xb4\xf1\xbaY\xe1\xaa1\xa5F\x1fN\xe8\xcf\xdf\xfc\x10\xff\x18\xff0\x03H=\x86\xed\x1fM\x85Ī\xaa\x13\xb0MY\xce\x00\xac\xaa0\x81\xda\xe9\x8d+\x9b\n=\x12;\x8f\x14o\xb0D\xefb\xe3fTc*\xa7\xe6\xde5u\x02\x87\x89vs'Q\xab͓ӟ\x02·\x16'L\x95\x86\xf8\x9f\x93\xd3?\x1bⰤ.\x1b\xaf\xca\t9\xc2,\x19\x9b7\xa5\xf2\xa7\xf33\x80\xda#\xa1\xdf\xe0/vm\xdd־5XjJ S%\xe1\f\x80RWc\x02\x8f\xaaB\xaaU\x8az\x06\xb0Q\xa5с\x8fVvW\xa3\xfd\xe9\xe9\xe1ӏ˴\xc0*0.õw5z6\xbd\x8a\xf23\xb0\xee~\f@#\xa5\xde\xd4\x01\x11\xe6\x02ծ\x01-\xf6D\x02.\x106\xed\x18j\xa0p\f\xb8\f\xb80\x04\x1e\x83\x0e\xb6\xb5\xf0\x00\x16d\x89\xb2\xe0V\xff\u0094cX\x8a\x9e\x9e\x80\nהZ\x9c`\x83\x9e\xc1c\xeark\xfe\xb3G&`\x17\x8e,\x15#\xf1\x11\xa2\xb1\x8cުRHh\xf0\x16\x94\xd5P\xa9\x1dx\x943\xa0\xb1\x03\xb4\xb0\x84bx\xe7<\x82\xb1\x99K\xa0`\xae)Y,rý?\xa7\xae\xaa\x1akx\xb7\b^iV\r;O\v\x8d\x1b,\x17d\xf2H\xf9\xb40\x8c)7\x1e\x17\xaa6Q\x10܊\xb2\x14W\xfa;\xdf9?\xcd\a\x92\xf2N\xccF\xec\x8d\xcd\xf7\xc3\xc1\xc9\xce\xf2.>\x06\x86@u\xdbZ\x15\x0f\xf4ʐ\xb0\xf2\xe1oˏ\xd0\x1f\x1aL0\x80\x84\x8e\xed\xc36:\x10/D\x19\x9b\xa1\x0f\xbb \xf3\xae\n<\xa3յ3\x96Ç\xb44h\x8fI\xa7fU\x19\x16K\xff\xbbAb\xb1O\fw!\xaaa\x85\xd0\xd4Z1\xea\x18\x1e,ܩ\n\xcb;E\xf8\xbb\xd3.\fS$\x94>O\xfc0\x19\xf5\xffd\x7fұ\xb5\x1f\xee\x93Ť\x85\xc6Ῥ1\x15\x83\tk\xb2\xd1d&\r1\x00\x99\xf3\xa0N\xd2E<\x00\x9e\nN\xf9Y\xa9t\xdd\xd4Kv^\xe5\xf8\xb3K\aa~F\xaa7S;z\xb1$\xc3I\x14\xca\xdf-4\x88(*\xc7\x11$@\xd9o\xdd\x16\xe81\xb8\x82dS\x93\x8a+92\xec\xfcN`e?\xea\xa1.gi\x97_\xa1\xfd\xde\xe4\xe26c5\x94\xd6\xe1\xaeP\xe5\xd3\x196.\"\x8fx\xb8?\x1c\x04\xaaS@\xb7\x9foa\x8d;\u0530\xda\xf5$\x88T!\x97\xe4\xde\xf0\xee\xe4̺lrc\xc3\xfdp\v\\\xa862\xda+fN\xddn\x92\x94h2\x83\x1aT\xae\x8c%\x06gS\x04\xc3s:A\xec\x12\xbe\x04I\x06Xռ\x13`\xec\xa1\xec\x9c\xf7hcj\xe5\xa6S\xab\x12\x13`ߌ\xed6\xe9\xc5\xf2[;\x9d\\\xa2\xeb\xc9u\xc9\xc6c\x86\x1eE\xf0.\xeb\xd6.\xe4fV\xc6\xf6)\xa7\xd5\x1c؍\x10A\xc2\x7f\xaf\xdah\U0009c2df\xbf\x87&\x05\xfd\xe9顿{zO\xeeD\xe61Sϸ\v@&\xb7\xeb\x93\xe2\xe2\xd9S\xe7\x0fY{\x8c\xe0\xc8}\xa4\xa06\x98\xe2ѕ\x06btT\xba\x1d\x9c\x80\x04\x90\x84\xe5\xb1[/&\x0f\x11\x14@\x0fנP\rJ\xf2\xbd\xd1\xf0\x8f\xe5\xfb\xc7\xc5\xdf]+\xeb$\xa6JS$\x81Q\x8c\x15Z\xbe\x05j\xd2\x02\x14\x89\x85\x8dG\xbdd\xc5\x18Wʚ\f\x89\xe3\xee\x04\xf4\xf4\xf9\xf5\x97)\xce\x00\xde:\x0f\xf8UUu\x89\xb7`Z\x96\xf7\x17I\xef\x1f\x92S\x84\x88=\x1el\r\x17fZq%\xc5N\xa7\xf06(\xcaj\x8d\xe0:E\x1b\x84Ҭ1\x81\x1bɜ\x03\x11\xff+A\xf7\xbf\x9bI\xcc?\xb5\xa9\xe9F\x96ܴ\x82\xedk\x85a\xa6;\b\xd8Ʈ7y\x8e\x1e\xa7ٔ\r\xb8A\xcb߃\xf3\xa2\xbbu\x03\x80\x00+6k\xf3;\xea\x13\x81?\xbf\xferF\xda\x03\x8a\xf0\x04\xc6j\xfc\n\xaf\xc1ؖ\x95\xda\xe9\xefc\xf8(\x7f\xd2β\xfa*\xf1\x98\x16\x8eЂ\xb3\xe5iV\x92\x1fvP\xa8\r\x02\xb9\na\x8be\x19\xb55\x9a\x86\xadډ\xfe\xbd\xb9\xc4\xc3\x14\xd4\xca\xf3q\x156\x89\xfa\xf1\xfd\xfd\xfb\xa4\x95J\\(\xb7\"\x8a\xdc\ue651ZK\x8a\xac0\x19|R\xe6\xa8\thB~Z(;q\x9d\xc8o\xd0\x14!k\xa4t\x8a糓\x05\x97\xa3u\\.M\aj(\x9bƉ\xe1\x0f*>\xaeRK\\\xeay\xb5\x1e\a\xfe|Q-i\x9e\xbcEƠ\x99v)\x89R)\xd6L\v\xb7A\xbf1\xb8]l\x9d_\x1b\x9bG\xe2\x88Q\x1bش\x10Ah\xf1]\xf8\xefWi\x11:\x92\xebT\tK\xbf\x85>r\x0e-^\xacN_O_{+͗]\xc17\xde)!\xb1-LZ\xf4\xcd\xd1!{N`\x02TJ\xb7)W\xd9\xdd\xef\xee\xb6Bd\xe3E\x9e]\xd4\xf5\xe0\x91\xb2Z\xfe&C,\xe3/f\xae1W\x04\xe9/\x0f\xf7\xdfƙ\x1b\xf3\xe2\x88<[BI\xdd\xfb\xa0\x85\xbe̠Of\x17\x14\xfcp\xb4\xb4\xaf\xbe'\xea\xe7\xfd\x9axv\xa5\x80dUM\x85\xe3\x87\xfb\x8b\x12,\xf7\xcb\xfa\xd3\x0f\x94w\xe5[\x8f$.z\xa1n;+I\vsQ\x8a\xb6ߙ\xea>:\x19\xc4fݵ \x15\xe8o\x92\xe4\x9d\xd3\xd7H#\xcbD\xa2\xc2m'\xca\xf8\xad\xa2\xd0\x14\xa0\x86\xa6\x0e\xef\t#D\x00ra\xaf\xe19\x9d\xad\xe3\xf7\xa0\x99)\xb1m@\xcei\x85\xb6\xa9\xc6bG\xf0V\xf6툱:\x99zS\xbat}\x1d5ҡK\x058ď`5\xd5\"\x1e\xad\xa8ݰ8\x8aF\xae\x7f4u\xf0ǣᖀ\xd93a%5ks\xd4\x0f\\\xee\xb0\xc3\xf2ޝ\xda\xd4\xc5\x1d\x888֯\xeb\xb1S'u\xee\xf1{\xe2%7\xba;]\x1f\x1e\xad\xbcn\xe5bSah\xa4\x82\xcc\xc1\xa3\xba#N\x8d\x0f\x03\xb4vcxAK\x9dרC\x1d*%r\xa6L\x89\xbaG$\xa9\x12\x11\xc23\xa1\x9f\xe8-{\x98\x86P\x87\xa7\x87\t\x81ǻ2\xe7+ŉ\xf4\x9f\x18\t\xc0˛Ή\xb8\xac\x90H嗃\xf2]\xbbF\x04V\xfd\x06P+\xd7\xf0\xbe\xfb\xecrE\xa7\xfe\x9c:\x8b\xc7\u05caQ\x17\x8a.\v\xf1$+\xa6\xfcj\x9f\xaf.9\xd6\xf9(~\xc4\xed\xc9\u0603}\xf2.\xf7Hc\x1bD\xbd/\x9ct&\x11\xbc\r\x1ep\xb5\xc2\xdd\x01\x97u\xee\x16A\xe1\xca\xdes\x1d\xab\x12lS\xadЋ\xe2\xab\x1d#\xf5\f\xf4\x81>\u0084\xae\x1d8\xf0v\xd8\xdfYL^Z\x18\xa9knRe%\xc9\a\xefd\a\xdaP]\xaa\x897\x97^<\xa9\xda\xc59%B\x0e~\xd1A\x83\x84t\x98{\xc9sC\x10\xe7\xde\xd9\x13\xa7\x18\x86\x82\xb1\xfc\x97?O̷n\x16\x1e\x8b\x8eRa7+\x14\xbe\xd9\xf1Ա\xbf\r\xfbl]B\xac<\xef#\xfb\xa2͗GK\x9f\xcbZ\x01x*g\r\xd3\xcfi\xba9>\xe4[d\x9a\tjFC\u074bQ\x02\x9bW\x87O\xe1≺\xef\x8c\xc2\x04\xb4YU\x0f\x0e\xef\xdeG\xbb\x91Å%\xaf.5\xa3~\x1c\x7fitss\xf4\x1dP\xf8\x98:۾mR\x02\x9f\xbf\xc8\xf78\x92Ct\xd7#P\x02\x9f\xbf\xcc\xfe?\x00R\xf1\xe2\xb3o\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4Y_o\xe3\xb8\x11\x7f\xf7\xa7\x18\xb8\x056\xd9Z\xf2f\xb7\xb8\xde\tX,\xb6\xb9\xa6\bn\xb3\b\x92t\x1f\x1a\xa7\xbd\xb14\xb2y\x91H\x1d\xff8\xf1\x15\xfd\xee\xc5P\x94%\xdbt\x92\v\xd0(\x0f\x169\xfc\xcd\xff\xe1\x90\x1a%I2\xc2F|#m\x84\x92\x19`#\xe8ђ\xe47\x93\xde\x7foR\xa1\xa6\xab\x939Y<\x19\xdd\vYdp\xea\x8cU\xf5\x15\x19\xe5tN?R)\xa4\xb0B\xc9QM\x16\v\xb4\x98\x8d\x00PJe\x91\x87\r\xbf\x02\xe4JZ\xad\xaa\x8at\xb2 \x99\u07bb9͝\xa8\nҞC\xc7\x7f\xf5.\xfd\x90\xbe\x1b\x01\xe4\x9a\xfc\xf2\x1bQ\x93\xb1X7\x19HWU#\x00\x895e\xa0\xc9X\x91kj\x94\x11ViA&]QEZ\xa5B\x8dLC9\xb3]h\xe5\x9a\f\xfa\x89vu\x10\xa9U\xe7\xca\x03]u@k?U\tc\x7f\x8aN\x7f\x11\xc6z\x92\xa6r\x1a\xab\x98 ~\xda\b\xb9p\x15\xea=\x02f\xd0h2\xa4W\xf4\x0fy/Ճ<\x13T\x15&\x83\x12+C#\x00\x93\xab\x862\xf8\x8a5\x99\x06s*F\x00+\xacD\xe1-\xd2\n\xaf\x1a\x92\x9f/Ͽ}\xb8ΗT{\x9b\xf3p\xa3UCڊNG~\x06\xfe\u074c\x01\x14dr-\x1a\x8f\bo\x18\xaa\xa5\x81\x82=J\x06\xec\x92`ՎQ\x01Ƴ\x01U\x82]\n\x03\x9a\xbc\x0e\xb2\xf5\xf1\x00\x16\x98\x04%\xa8\xf9/\x94\xdb\x14\xaeYOm\xc0,\x95\xab\n\x0e\x83\x15i\v\x9ar\xb5\x90\xe2\xb7\r\xb2\x01\xab<\xcb\n-\x19\xbb\x85(\xa4%-\xb1b#8\x9a\x00\xca\x02j\\\x83&\xe6\x01N\x0e\xd0<\x89I\xe1Bi\x02!K\x95\xc1\xd2\xda\xc6d\xd3\xe9B\xd8.\xa2sU\xd7N\n\xbb\x9e\xfa\xb8\x14sg\x956ӂVTM\x8dX$\xa8\U000e5c14[\xa7i\x8a\x8dH\xbc\xe0\x92\x955i]\xfcA\x87\xf07o\x06\x92\xda5\xbb\xcdX-\xe4b3\xec\xa3\xec\xa0\xdd9\xc8@\x18\xc0\xb0\xacU\xb17/\x0f\xb1U\xae\xfev}\x03\x1dS\xef\x82\x01$\x04k\xf7\xcbLox6\x94\x90%i\xbf\nJ\xadjog\x92E\xa3\x84\xb4\xfe%\xaf\x04\xc9m\xa3\x1b7\xaf\x85eO\xff\xea\xc8X\xf6O\n\xa7>\xafaN\xe0\x9a\x02-\x15)\x9cK8Ś\xaaS4\xf4\x7f7;[\xd8$l\xd2\xe7\r?,G\xdd\x1f\xafς\xb56\xc3]\xb5\x88zh7\xff\xaf\x1b\xca\xd9al5^(J\x91\xfb\x1c\x80Ri\xc0\xbdz\x91\x0e\x80c\xc9\xc9\xcf\x1c\xf3{\xd7\\[\xa5qA_T>H\xf3\x03R\xfd5\xb6\xa2\x13\x8bK\x1cg!\xff\x8e\x12\xee \x03\xd8%\xdaA\x86Z\x14r\x93\xe6\x11=\x0e\x9a\x9c\xffk\xe4t\x95(s:\xf3\xb1#\xf3\xf5\x93\xba\\D\x16\xb0*K\xf5\x00\xaa\xb4$\x87\x90\x9d\x94sځ\x04\xd0N\xbeFH\xae\x9f\x85\xab\xe8\xa52v\xf4,\"©V\x12葋a_<9i\x1f\x96\xb4]\x16w\xd8\xf6\x8ax\xc1\xe1\xbc\x04a\xdf\x180d'\xed/g\xa8\x00!\x8d%,؝1;\xbdX\xe1v\x13:/8\x97JA\xfaIm\xafv\x88\xbb\xc0*]U\x85\xed,\xc9Uݠ\x15\xf3\x8a\x02;\x8e\xff\x1dP\x00\xd12\\\xf3\xfck\x03j\xa5*W\xd3f3|R\xf2o۴Ì\xf0\x8b;!X\xbf\x81,;\x90\xd0%\x81\x81F\x15A\x80\x90\xa5\x86\xf5|\xa1\xec\xec%\xa1i\xab\xfc'\xf1lߢ\xa8#\x9e\xde\"\xd8\xf5\xe6\xd6䎽\x9e\xad~\x16\xad\xdb*HO\xd7?O\xde\x196wZ\x93\xb4\x01\x84\xa3\xf4u\x150W\xb2\x10\x83n\xf1\x800\xa7\x1b2@M[\x12\xf4\b]\xe9{J\x0e~\x84\xa5z\x8f\xdd\x0e\xc3\xf1\x86c\x1f\x12\x05Y\x14\x95\x8f\x03P\x92\x00y\x1b\xb0\x1d\xd7 \xcf\x1elk\xe8P\x97\x85\x81ϗ\xe7\xd0\xf5\xd0)$I\x027\x1c\x9a\xc6j\x97[6\xaf\x0f\x81\x82\nϧ\x10z\xe8\xb3\xfe\xcf\x19\x16\x00P\x02j\x8dk\xc0v?/\xb9\xa3\x84\x06\xed\x12R\xe6\xebL\xda\x1b(\x058S\x1a\xe8\x11릢I\x04\x95\xe3\x19Δ\n\xcen\x85\xfa\x8f\x9f\x9aN\xe1j\xd3ex^jν,nl\x8fP*\xf5\xc6D`;_\xb1D\x94vp?I\xf5 cbz)PS\x06\xb3\xf1\xe7\x15\x8a\n\xe7\x15\xcd\xc61\x81g\xe3K\xad\x16\xbe\b\xcb\xc5lܶ\x88\xb3\xf1\x8f\xb4\xd0XP1\x1bw\xcc\xfeԠ͗\x17\xa4\x17\xf4\x13\xad?z\x16[S\xd7V\xa3\xa5\xc5\xfac\xcd41V\x1d9\x9f\x11n\xd6\r}\xac\xb1\xd9\xc0\xf3\xe0\x056[\u0603\xa8\xbd\xbd\xe3\xaedu\x92n\xc6\"\x1c~\xfe\xc5(\x99\xcdƽ)&\xaa\xe6hm\xecz6\x86-1\xb3\xd9\xd8\vڍw\x9ae\xb31\xf3\x9f\x8d#\xf8\x8dVV\xcd]\x99\xcd\xc6\xf3\xb5%39\x99hj&\\$?\xf6<g\xe3\x9fa&;Ŕ]\x92n\xc3\xca\xc0\x7f\xf7Q\x0f%v\xfbTh\xec\x8dFi<4\x1f\xe9bT;\xb9\xb7\xbf\xa8\xab:<\x03Vԡ\x02t\"G!\x01\xec\x06\x83\x93\x89\xdb_\xce\xdaP\xb1\xac\x02\x94^\xb94$\xe0f[\x8e\xee\xe0\x01rI\xe0dA\xba\xf2;\xdb\xc6h\x90/Q.\xb8+\x86s.\a\xe83\x99;f\x7fΛ\x80=\x8c\xe9L\xd7\xeb{͘\xbb\x7f\xe3B\xe1\xedށ3$\xe695\x96\xf3a\xb7\xaa\xb5O\xa9t\x8d6\x03\xee\xd1\x13ƋR\x1d\xdcrC\xb7B\xc6\xe0\xe2%\xae\n\x94^2X\xba\x1a%h\u0082\xe5\xeb\xe7d!x\xaf\x93\x8b\xae\x82Fq\x01p\xae\\[\xc5z\xcf\x05\xe7\xf0YfN\\\xed|2\x04\xd1\xe3&\xa8\xf1\xf1\vɅ]f\xf0\xe1\xfd_\xbe\xfb\xfe5\x16hK\x1b\x15\x7f'I:ڕG\x8c\xb1\xbfhp.\xf3z\xa5\xdd\xd1$]lh\xa2\xb8\xa13ߊrx@\xdf%\xc2\x1c\xb9At\r[\x87\xeb9\xb7\x8aܠN@\x94\xbf\x87\x850ݦU\xad\xe1\xe4\xfd\x04\xe6\xc1\xfc\xfb%\xf9\xf6\xf1.\xddW\xef0\xee\x0f\x93\xed\f\xe51v\xae*}d\u0083\xb0Kn(\xfd\x06\x1a\xce\xffA\x96\x03\xa0\x83M\x946\x1a?\x9d\x03B\xda\xef\xfe\x1c\xa5\xa8\x85\x14\xb5\xab3x\x17\x9dnӃ\xf7\xe1\xc5V\x8b\xd5=\x9aм(\"Z¾\x83@hx\xb7ºF\xeeCC\x93,H\x0f\x92$\x8a\xca\xf1@\x1d\x1c\xf7\x05[\xd6}cBe\x1c\xa4ͥV\x85\xcb\xf9\x1e@\x95\a \xbbS\xec\xc0M\xacy{s\xd0^\x06\xf1\t\x87r\xbb\xb9^\xf1\xdbkM(\x85\\\x1cJ\xe3V<a\xdaM\xa3ݑ\x1f\x96ĥ\xd6k\xd1!i\xaf\x81\x11\x05i*\x00a\xe1P\xa3\xb4\xe4o\xbdb\xcf\xe7\xcbs.\a\x01aP\xb0\xb1\xbf\x88\xe8*C[6\xbc\x04\xac\xce\x01\xc4p\xa5\xe1kʳ\xc5\xe4\xe4\xdd\xfb\x83Ѵ\xa1\x89\x124h\xf9\x1e+\x83\x7f\xdd~N\xfe\x89\xc9owG\xe1ǻ\xe4\x87\x7fO\xb2\xbb\xb7\x83\u05fb\xe3O\x7f|M\xc9\xdao\xe7\x0f\x04e߲o\x05\xd1\xc4\uf3aa\x84\x1b\xcdWmg|%9\x81pQ\x197\x0eIW\xc7\x19&0f\x98X\x13\xe2'=\xfa\xa1\xd9\xc0\xf35F\xe0\xf8}\x81\t\x98\x8c\r\xd0\a\xbe\x18\\f\x81\xaf\xa9P*\x95\x86V9\xcdU=\xdd\xccǍ\x01\xbe\x97\xbf@\xb9\x86\xbep\xa6\x9e\xd3n\xc4\x1b\xcb\xcd0\xe6Z\x19\xb3\xb9\xdb;\x94N\x95\xb8'\xd8t\xc1m\x91\x9eS\x8e\xfe\x00\xa0\xe7\xc2j\xd4\xeb^\x13\x039J\x7fOg\xa8t\xd5\x01\xd0#C\x04\xa9T\x05\xed\xd7\xfa\xe3\xb6v\xe3\\T®\xf9v\xb6\xa0\\ɲ\x12\xe1|R7J[\x94\xf6\x90\x1d8E5-\xe8\x11\x84\x85\x9a\x9bS2\xbc\xf0\xa8\x90\xe6\xe4\xe4\xfd\x87k7/\x14\x1fu\xcfj;=\xfet\xf4\xab\xc3J\x94\x82\n>\xba\x9e\xd5\xf6\xf8\xb9L\xfcp\xf2\xdd3yvt\xdbf\xd3\xdd\xd1m\x12~\xbd톎?\x1d\xcd\xd2'\xe7\x8f߲X\x83\x1c\xbd\xbbM\xfa\x04M\xef\xde\x1e\x7f\x1a\xcc\x1d\xbf\"]c\x17\x04]\xf8﷿\x11\xa2\xd0\\Ef\xdaM\"2\xd1::2\xc1\x92\xee\rG\xaf\fB\xd5t\x95\x8f\xc5\f\xacv\xbb+\xdbu\xfeL\xba5\xc3Z\r.\xb2~t\xf1\xb6j+M\xbf\xc4\xd7tw\x84\x95\n}3c\x0f\xaf\xd8v0\x01\xacR\xf7\xbb\xd1\xfa\x02-\"\xce\xdbQ㊌\xab\xec\xefQ\xa2]\xd1\x1dht\xfb\xa6ʨ\x1e\xe9\xe8\xf9z\x9b\xc0\xb5\xcbs\xa2bo\xdbL\xe0\fEE\xc5+U\x8b\x1d֞R,~N\x1bh\xe3\xfb\xd7\xc8]\xedsG\x96\xd79*$ȓ\x1a\\\fO/a\xc1\xe0\x14\x12\x1aҝ\r\xf3\xb9ۥ\x83\x125K4O[\xf4\x92):\x1b\x0e\xb9\xd3K\x99\xc7#\xe4+=\xec\x8d]\x11\x16\xbbmQ\x02_\x95\x8dM\x1c\xd0)R#v\x86\xc2g\xc4\fV'\xfd\x9b\xbf{K\xc2\xe7\\?\x01\xd0\x1e-\x06.6\xed5i\x18雛\xf6\x00\xdc\xee\x15\xa1\xdb\xe1\xafB\x19\x8c\xc7[_g\xfdk\xbf\xa9ep{\xc7\x1fX\xad\xd2T\x84\x0f\x9e&\x83ۻ\xd1\xff\x06\x00\xd7\xee\x1c\xc8\n\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}\xfds\xdb6\xb6\xe8\xef\xfa+Τ\x9dQ\xf2V\x92\x9b\xd7ٝ\xf7<;\xaf\xe3u\xdc֯\x8d\xe3\x89}\xd3\xd9\xe9\xf6v!\x12\x92\xb0\xa6\x00.\x00\xca\xd6\xde\xde\xff\xfd\xce\xc1\a?$J\"@;N\xb6\x12=m,\x93\x87\xc0\xf9\xc2\xf9\xc2\x01\xc9\xd9\a*\x15\x13\xfc\x14H\xce胦\x1c\x7fS\x93\xbb\xff\xa3&L\x9c\xac^O\xa9&\xaf\aw\x8c\xa7\xa7p^(-\x96\xef\xa9\x12\x85L\xe8\x1b:c\x9ci&\xf8`I5I\x89&\xa7\x03\x00¹\xd0\x04\xbfV\xf8+@\"\xb8\x96\"˨\x1c\xcf)\x9f\xdc\x15S:-X\x96Ri\xde\xe0߿\xfaj\xf2\xf5\xe4\xab\x01@\"\xa9y\xfc\x96-\xa9\xd2d\x99\x9f\x02/\xb2l\x00\xc0ɒ\x9e\x82\xa4J\vI\xd5dE3*ń\x89\x81\xcai\x82/#ij\x06D\xb2kɸ\xa6\xf2\\d\xc5\xd2\x0ed\f\xff\xff\xe6\xdd\xd55ыS\x98(Mt\xa1&\xf9\x82(j\x06\x99R\x95H\x96\xe3ç\xf0\u07be\x01\xec]\xa0\x8ad\x01D\xc1%\xbf\x96b.\xa9R'\xe7b\x99gT\xd3\xd4<l\xc7u]\x02\xd3뜞\x82Ғ\xf1\xf9\xae7;H\x13\xa6\xe9R\xb9\x17\xa6\xdbC\xb9*\x96S*A\xcc\xc0\xdc\xe8'\x9f\x82\x120#\xb2\xf6\xfaK\xf3\xf7\x06$;\x0eDĜ\xcaC\x03\xd1B\x93\xcc\x00\xd9\x1eŅ\xd2lI4M\xc1\xdc\x05|cTZ\xc0\x94\x96c\xab\r\xea\xd6\xdc^A\xdd;\"\xcfE\x93-\x0e\xa8A<\x9b\xd7q\x9c\x12\x8d\xbfΥ(\xf2S\xa8\x18\xc2\xf2\x8ac@˼\x0e3曌)\xfdC\xfd\xdb\x1f\x99\xd2\xe6/yVH\x92ULf\xbeT\x8cϋ\x8c\xc8\xf2\xeb\x01@.\xa9\xa2rE\xff\x83\xdfqqϿe4K\xd5)\xccHf\x98@%\x02\xc7wE\x96T\xe5$1\x04Y\x91\x8c\xa5\x86\xb5\xed\xb8DN\xf9\xd9\xf5凯o\x92\x05]\x1a\xe1\xd9¼\x1b\x1f0\x05\x04>\x98\xf9\xe1 \x8c\x00\x82^\x10\r\x92\x9a\xa1p\xad@/(\x90<\xcfXb\xde\x02b\xe6@B\xf9\x8c\x82\x99\x14\xcb\n֔$wE\x0eZ\x00\x01M\xe4\x9cj\xf8\xa1\x98Rɩ\xa6\n\x92\xacP\x9aʉ\x03\x93K\x91S\xa9\x99G,^5\x15R~\xb71\x87!N\xd2\xde\x03)*\rj\x87\xba\xb2\xdf\xd1\x14\x94A\x00r\xb9^0UM\xc9L\xa3\x06\x16\xf0\x16\xc2AL\xffA\x13=\x81\x1b\xa4\x80T\xa0\x16\xa2\xc8R\xd44+*\x11%\x89\x98s\xf6\xaf\x12\xb2\xc2\t\xe2+3\xa2\xa9\xd2\r\x88Ȍ\x92\x93\fV$+\xe8\b\bOaI\xd6 )\xbe\x03\n^\x83fnQ\x13xkH\xc2g\xe2\x14\x16Z\xe7\xea\xf4\xe4dδW\x9a\x89X.\v\xce\xf4\xfaĨ>6-\xb4\x90\xea$\xa5+\x9a\x9d(6\x1f\x13\x99,\x98\xa6\x89.$=!9\x1b\x9b\x81s\x9c\xac\x9a,\xd3/Jb\rk#\xddP*\xe6;\xcb\xda;\xf1\x8e,n9\xc7>f\xa7X\xa1\x97\xf1\xb9!\xc4\xfb\x8b\x9b\xdb:W1U\x03\t\x0e\xdb\xd5c\xaaB<\"\x8a\xf1\x19\x95\xe6)\xcb[\b\x91\xf24\x17\x8ck\x03>\xc9\x18\xe5M\xa4\xabb\xbad\x1a)\xfdς*d]1\x81s\xb3t\xa0&)r\x14\xect\x02\x97\x1c\xceɒf\xe7D\xd1'G;bX\x8d\x11\xa5\x87\x11__\xf1\xfc\xc7\xdeh\xb1U~헦V\n9\xe9\xbe\xc9iҐ\f|\x88ͼ\x18τl\b?*,/\x92\xbb\xc4\x12/+ۨ\x82\x9a\xdfo\f\xe2/\xe5m\xc8+H\xb0\x82\xb3\x7f\x16ԨP\x148\xfcjK]T\x9a\xb0\xf9A\x16\xa8\x0fn'\x06\xf1'\x95\xeb\xf7\x05\xdf;\xba7\xe6\x16\x8f\x11\xaa\xe0~A\xf5\xc20\\\xb9\xe2x\xf9\xbf'ٝ\xf9~f\xed\x85\xe6\a\x17P\xc8YN3\xc6\xe9\b\x18O\xb2\"E\x11\xf0P\xcc\r$\xc1\xf7\xaa\x11\xdc3\xbd\x10\x85v\xe6\b\x9f\x83\x90[ s\xa2\x93\x05\x82 |\xad\xcd?\x18w,o\x15'\x9c\x81*\x96K\"\xd7\x1e\x91n\xc1D\xcd}\x8fJk\v悬(L)\xe5\xf6\xcd4\x1dyq\x00!Aݱ<\xa7)R\xca\x19\x02\x8c\xd7Q1D\x99RE\xa6\x9b\"\x8c\u05ccet\x02WB\x97\v\xc7\xf6\xb4\x81\x18\xb3\x87e\x19\xd0\a\x9a\x14\xb8\xe4\xa7\x05\xd2\r\b\xa4r\xbd\x05T\x16|\x93\xdah\xac\x91iFOA\xcbb\x93A,+L\x85\xc8(\u137f\xd1\a\xa4\aM\xcfJ\xfbq/_\\l\xdd\xee!('\x82\n\x12\"\xe5ڎ}\xe9(\xb5\x01\xb2n\xaezL:\x1e/u\x99\xc3\xd3\b$\x9d\x13\x99fT\xa9\x92\x98\x86\x87\x9c\xc5S\xbfp\x11\xf1\x132rd\x8c\x00e\x16\x97R\xbbO\xe0r\x06\x9ce#\xe0\xa2\x1c3\x12\x80>\xec\x00;]\xd7\xc6\x1b\x84\xf7]:\x02\xaf;\xba\xde\xfer\x03\xdd?е\xd7\x0ew\xb4d\xe6݃\xd9+\xf6\xf8c\x96\xa2\x83\xaf\xfd\x80w\xf9\x17\x9bG6\xde\v\xcbBi#3\x06\x9bt\x99\xeb\xf5\xa8\x05\xaa_Ŕ\x91\xeb- \xc8\x1d\x1b\xf4\xc5\xe5ɼ1pj\xb8\xa41I\x1b\xcb2\xfe\x8c\xe1\x8en\xcaO\xeb\x8aQ\x17\x86\xd2~\xdc\"[\xab0T\xb7\xa3-\xa4\tC\x896\xd6.R\xacƇF\x01\x90\x16\xf5\x8d\v\xb0\xe7j/\x10N\x006\xf1\x80z\xa3\x85\x9b\xf6\xa0\xa6\x83f R\x92u+*\xbc\xdb\xd9\r\x13\xe5\xdd\xce\xfe\xc9XB\x11\a\xa5\x95c\x90\xf19\xe2\xe1\x03\xba\xb4\x1d\xb1\xe0\xee\xdd\xc0\x01\xce%G\xdb[i\xca5\xac\xccM\x90d\x849/\xad~\xb9\xb9[\xa58B7\xb8d\xa3\x13\xfc\xd7\b\xee\x17BQ@c\b߃\x88s\x88J\x9f\rSLi\xc6\xe7\x9e\a\xaeEƒ\xf5\x01\x84\xb5=\x82\xf3\xb9G\x0e\xa9Q\x1fRA+%\xb2\x01\xd3M\x11X\x89\x03/j\x99\xa4$]ۡm\x19\to\xe8\x8c\xe0\x92\x8d^\n\x17|\x8b\xc3(/\x96\x9b\xc3\x1f\x9b;\xb7\xbe\xb4\xa6\xc2\xe5\xec|A\xf8|k\x05\x19\x03IӷL\xa1C\xfb\x03]oR{\fbE\xe5\xbdd\x9a\xb6\xfcu'\x99\xe6\x94SI4E\xed\xf3\x8e\x9f\v>\xcbX\xa2\xf7\xe2\xfb\xbb\xd6Gv\xc8*\x12A\xb8\xd0J\xfd\xb2\xb8\xc6\x05\xd3YJ\x96,\xc4,\xb8\xe5\xa8\xd2\xd2+a\x12\x84ds\x86\xce\x1e\u07b2\xbdNH\xe2LK½\xa55\x02f\\N|YIv&\xed;\x1adU\xc0\x9a\x16\r^\r:\xbf\xe3\xd9\x1a\xfe!\xa6\xd6\x0e\xc8E\x8af\xe6\x82%\v\xe0\u009a\x8f4S\xc8i3\xf4\xad0\xa8\xb2\xde1P\x9c\xb4*\xf2\\Ht\x93\x9eG\xcc\x16Bܩ\xbdT\xfe\x1e\xef\xa8\xfcFHL\xfc\x10\xa6tAVLH'\x1b\xcex\x9f\xd2\xd2\xe4܀\t\xde\x04\x15\x12r\xa1Jٚ\x04\xd88%/m\xffi'\xc2v\xb9k^I\xe0\xf4\x1a\xae\x9b\xe0\x14m\xf4%\xaa\x89\xea^)\n{\xef\xa6@\xf9\xcf\x0e,\xc0\x94(4\xfa\xdd\xe2SdT\xb97\xa5\xc6%\xac\x96\xf3m\xfeؘ\xb4\x8djddJ3P4\xa3\x89\x16r\x13{\x87q\xe8X\xaf4\x9bn\x1c\xa4]wn`\xefl\xebA#[\u07b3KG0c\x996\x9c\xbf\xa0;A\xd6f\x85\xeaҊ\x8f\t> ?\x1a?\xd1\xc6\xc0\xa8B\xf1\xb1\xc2Z\x99z;q\xe5\x85U\xa1\\ߓ5\xfc\x88\xd8\xf2#\xb5Z\xbf\x84g0\xa9F\xc6F4\x9e\x85\xb9y\x17}\xf1\xb2X\xaf\x8dݨ\xb4\xfa\xc0\x8c\x11\x8b\xf0a\xceV\x94\xdbX\xcd\xde\xe1\"M\x9dgs\xf1\x80A@\x8c\xa6\xa1\xffg\f\xd0%\xae\xed^a\xd9\x05N\x01\x12\x97\xe8\x16O\xb6\xba\xecHU\x1d\xcdȟft\x84\xd7F\xec\xb4-_\xef6\x93\xfd\xe7\xd6[\xef\xca\xda\xebS\xf3+\xf3,\xe9\xc2j;\x01\xecUO!\xbc\x8b\xd7&\xbe\xf6ݻ\xc1\xc1[\xa8fu\xf3\xba)^{\xa1\x96^\x02\x12IM\f~\xea\xdf\x187\xfc\xec\xeaͶnטּvL\xe1lC\v\xd4_\xeb\x96\xdfn\x13pj\xabt3\x1c\xbb\x02A7\xc7\xea\x1b\xc2K~37\x1f\x84(\xa9\x89ϖ^&\xe1e\xc8\xf5\xc0\xb3\xddH\xbf\xd7\xdd\u074b\xb6\xbb\xca\xfd\xb5\xf8\xc3/\xb47\r;\xd2\xdcI\xadSNZ\xec\xa7\xed\xc1E{\xfb\xf2\xd8\x0e\x9e^I\xa6r\xadFN\xb8\xa3k\x13Nʌ\xb8\xab\x05\xcb;\xc0\x05\xd4\xca\xc8EF&\x1c\xf5\xe0\x03\xa6>\xca\xf1Y\xa3풏0\x1au\xc9G\x83\x830\x01\xbc\x06C\x9ex#\xa8\xba\x12\xda|\xf3\xe8H\xb4C\x0eF\xa1So(B\xdc\xfa$8\xffz\xdc\xfd \x13۟˙ᩒ$\f\x13\x8fhVX\\U\x91\x10\xd5b\x93\xed\xfax\xad\xcb\x05\x1f\x9b\x18ɤ\xed=~\x91\xe8\xc6\xc8u*l\x0f\xab|\xa5}]'\x88\xb7\xb8\x8c\x9bI\xa1*\x924\xcfHR\x0f@*\x8d\x06\xfd\x9c%\xb0\xa4\xd2\xe5\x01\x0f]&D\xdb\xe5\xf5\x9dti\x04?\xed6\xa0\xb7?\xbbbG\x00\x87cI\x9b\x9fqI\xda\x037\xee\fB\xc5ͣf\x0f\xed\x9fF=Y\xdfU{w\xc6|C6kCB\xc6B\x9b)G\xe9\xfc/\\\xaa\f\xd3\xfe7\xe4\x84Ƀ\x12z\x06\xe89g\xb4\xf1\xa4\xf3\xe6\xeb/A\xf8L\x01RsE\xb2\xcd\xe4\xd7\xf6\aU&\a\x9a\x99\xd5\x1fG\xb6ii\xf8(\v.;3L5\xc3F\x8en\xfbzqG\xd7/F[2\xfeⒿ\xb0\xcb\xf3\x96\xc4\xfa\xb5\xfc\x00`\x81^\xec\v\xf3\xe4\x8bxӥ\x13\xd7u\xb8\x89n\x85>O\a\x9d\x98\xe2b\xeb\xc1Z\x92\tqS\xf9W\xa0\xc5N\x98\xd0\xee\x82\x18\x7f\x01ca\xe8\x8b\xecQ\x7f\a\xb5N'\x8e\xefh \x1f\x16`\x8fM\xef\xe2\x86\"\xb3|n\x03\x975g\xe2\xf7\x82J\xc67\xf9\xab#./\xf9S2\xa6\xf3\x8dk\xd9\x12\x8cVz\x8f\x19\x15QK괺\xaaw\x7fv\x84\b\xe5\xe9\xcb\xcd\xe7\x1e\x91\xa7{R\xa1|\xf5gC\x84\xac\x1eM\xe9H\x80F\x04fO\xac\xa8\xa2\xc4N\xb8p(V4鋂c\xf0\xe1\x18|8\x06\x1f\x8e\xc1\x87c\xf0\xe1\x18|8\x06\x1f\x8e\xc1\x87c\xf0\xe1w\x10|@o\xe8tЉ\r\xea\xf5\xb5Ua\xad3E'\x83\x1e<\x87)\xf1\xef\xdbr\xf1;Fr\xed\xefoZ\x90-\xc9\xed\x03\x9e\x8dKT\x97*\x12ӑ3M\xa5\xcbϛ\xefJ\xdb|2\x88\xd6}\x8dѷ\f\xb3̿\x13_\x19`\x90\xba\a\"\xb8\x9a\xeaÃ\xebn\xdd!6\xf6߱1\x93\x8b\x87Z\xe9\x00\xe1\x06@c\x02\x8fiwbq<i\xee\x15\xe84\xc8s\xfb\x9c\xe7\\\aƈ0\x91\xf3\xc2H]\a\x98F\xcbx~1\xf58\x98<f\x1c\x88\x17|*\x1d\xf3\x10\xc8E:\xd8\v\xcb]\v\xa2l\xa1\xb4CZ\xfa\xbc+\xed\x92\xf1K\x03\x1c^?\xea\xba\f\x15\x8a\"\xc8\xe7\x91[\x12\xb0\xfc®\x1c]\x91}\xbf\xa0\x926x`\xbbb\xc5\xd8u\x18\xa9\xab\xfc\xf4N\xb0\xdd8\x86\nfL\xaaү\xb3\xa3.\xd4!m\x1eA\xad\xf2\r\x97K2\xa7\xa7\a\xef߅V\xf38\x8e\x92\xc0<\x13\xd3\x118\xb5b\xb6\x8bu\x80\x8ajï\xb3\x18\xe0`z\xa8pkٌ=\xf8r\xb6\x17\x92\xce\xe9\xc3\xe9\x8b\xd1\xee\x1a\xf6\xb6\x0f┙ѹ\x8a\xcf6\xcaWT\xed\x04s/\xe55hrG\xcd\xe8\x13\x9aR\x9et\x83\x89\x15\x87\x15>\xad\xefn\xb0\x80\x1aQJLZ̰>\xbe\x1c\xfe\xf0\x90\x89\xe0\xe4\xdc\xccݠ\f+\xb3\f\x18S\x98\xa5\x17\x18\x1a\xe0\xd4ĕGPp,\xe8\xef\x04\xb2I\xf5o\x91U\xdf\"|\xa4?Ɔ\x9e\x98K\xab\x17\xf6\xe4\xd7\xda\xc8}\xb1OW\x0e\xb0\xf2\xe9k\x88E:,\v\xcd1\x16gsT\r\xccG \x16\x99\x92\xb7Ъ;z۶\x97\xb4}\x90{q\x8f\xa9(\xb6\xaaU\x0f\xa2\xf4\xa2z\xb6\\\xc4Q\xe6\x96\xe4\x81-\x8b%\x90\xa5(\xb8\xee&\x023\xd0lY\xee\xb1r\xd2uO\x986f\nBE{\x06\x97\xd0\xc4\xed;\xee\x04wJg\x88\xc4Dp\xc5R*\xfdn?\x9cu\x81A\x15 0#,+\xb6+){s\xae\xe0\x17(\xbb\xc1X}g\x9f+\x17\x104\x8f\uf6c8\xe9\x00\x12l\x89)E\x99g\x1a(O\x90\x16T֔\x8aC\x82A\tS\xdd̍\x0e&ٮj\xed\xb6\xcf\xd8\xf0=\xe3{\x82\xca\xd55\x86o\t\xcb\x06\a\xef\v#\x13\xf2طB\xbe\xc7\xda\xf4`Z\xfdT{\xb8e\x7f\x9e%Z\a\xa0\xd0\xca\xf1\xa5ZA\xb6ωR\xa6\xd8\x12˭\x19練\x93\x05M\xee0,\xccS\xac\xb0\xb6Σ۫^p\xce\xf8|䅄h\x8d\xb1\xa2.Hs֬,\\\x15\xbf]\x14\x8d\xedcv\x7f\xe3bcvu\xd5\xfe\xda\t\xa8,\xb8\x02\xc1\x13\xba\x81\x00\fG\xd9\xe1va\xbf\x8e\xf9\x8aX\x9d\x89D\x8aՙ?U\xcf~\x04\x9dY\xe7\xa0N0\xa7X\xb5\x8d̼\xc5\x13m\xf4~\\Y\xec\x1e|\xeb\xc6Q\x9d\"\x1c\xf8\x83-<N\a\x01D\xbc䬢\x1en\x8c\xe0L?\x99ۊ\xa3+\xad\x03\x15\xccp\x97\x8d\xc7\xd1N\xf3\xd1\x0e\x04\\3]:\x00\x06\xa79H\x9a\x9a>\x18\xd6Q\xf5\xc1\x0f\xab\x9cZ\xb7%\xf4\xf4B\x1b\x13*c\x80\xf56\x0f5F\xef\x92\xe8\xb2\xd7Z\x14pOp\x87\xbee\xed\xd2\x1f\xcfE'\xde\x0e\xa3\xa3\v\xba\xcay\xe7{7&><\xf3\xd1\x06\xdfʁr-צ\xc9@\xb7\xe1\xfa(?\x1a\x93\xc9\x1d\xfa\x96h\xa7\x0e\x87\n\xce߾\xf1\x8e&Z\f\x9d\r\x02GJ[\x9c\x92K\xb1b)zB\x1f\x88d\xa8\x80\xed>\x1et\x84\x14|\xf9\xf2\xc3\xd9\xfb_\xaf\xce\xde^\xbc\n\x00\x8d\xd1L\xfa\x90\x13\x8e\x1cW(o\xc0\x95\xf4\xc6\xc1S\xbebR\xf0%\r\xc3\xc3\xe5\f\b\xac\xfcH\x93\xb2\xf3\x02FĲ\x15&\xd8\xf5\xa26\x83\x00\xc8λd</\xb4\xd3}p\x8f\x1bʱ\xaf\x03O\xec\xbe4\x93u\x0e\x00Z\xc3\x1f\xa85\xd7\xe4\x01\x12\xc2Q\x1c\xa9JH^n\xfd\n\x00\x99\x8a\x02\xa7\xfe\xe5\x97#`\xf4\x14\xbe\xac\xbdb\x02\x17\x0ej\x89\x80\x10\x8e0\xb3\xe5\x14\x1d\xdbiE\xc0\xcd\xfd\xe3\xceN\n\x80\x8b\x14)I涜\xa1\xad!t[\xef\x8c\x00\xc0-}5\xee\xca&0\xd8Z#\x15\x89:\xd1Dݩ\x13\xc6qI\x19\xe3v\xcfqM\t\x9d\xd8\x15a\xecV\xa7\xb1\x0f\x0e\x8eKf=\xf9\xc2\x191cR\xde\xc5\xf8\x98\x8cՂf\xd9p\xb0cl}Tg\xf0*\x1c\x17\x9e\v\x8e\xb0\xb6鷋R\x9d٠\xa0\xe9\xd4P\xc6W:\x03\x85J\x91\x1b\xbcNZ5\xde\xc5\xd5\xed\xfb\xbf^\xbf\xbb\xbc\xba\r\x00\xbc\xa1\"w+\xbe\x00\x98\xed*\xb2E\xf1\x05\xc0ܫ\"\x9b\x8a/\x00\xeaA\x15\xe9\xc2j\x01 ;\xa8\xc8ȅc\x9f\x8a\xac)\xbe\x90\xb1vP\x91f\x0e\x010\x8f*\xf2w\xa6\")_E\xaa\xc7\x1f\x9d\xd9^\x13\xe5\x92\xce!K\xb3\x16fg\x12\xe3M-ы9\x82\xb1ݘ\xd9\x05_} \xcd\xda'^\x9ff\x00\\\xa8X\xdf\x01C\x9dD\xaa\xf0j\bÇ[\xf7]R\xe2\x1d\x10rU\xeb:\x15\x8b\x87:.&\xf0\xd6\x15\x03\x118\xff\xf5\xf2\xcd\xc5\xd5\xed巗\x17\xefC\x90\x11-#eMW/\x94\f\x1fϥ\xd8\xebX䒮\x98(\xcam\xe6\xc1pk\xf4*\U0006fda4-|\xb8\x98m\xe6k\xc0\x86\x8b,i\xb0E\xf5\x9aPzv\xf0\x81\x82!\xb6\x19\x04\x8de>\x18⣚\x05\x9d\x8d\x83`\x98O\xe0Eu\xf5\xa5\x82AV\x86\xc5\x0es!\x18\xa21/\xeaM\\^\xbc\x98\f\a\x81\xac\xd3K\xbd|+E\xa7\x9c\xc3N\x15sc\xaai\xca\xd8iM¢\x15\xef\xd0\xd5e7\x16W\xeb@D\xc0t}\xc0Ђ\v(\xea쿞\xb9<\xec\x8c\xcdߒ\xfc\a\xba~Og\xe1\x006\x91\xed\xb2\xae\xc4\xf7R#\x83`\x806mj\x87\x15\xae\xfa\xfa\xe1#\xa0\x90\xfd .n]\xb9\xbd\xb1\xcc\x10-1\x93\xe9%@},\x97\xd6)\r\xeb&\x8c\xd3}\xd1\xd3\xea\xeaz$\x988ʵ:\xc1\x82\x8a\x15\xa3\xf7'\xf7B\xdea\xb8\x055\xfb\xd8u\xbf3-\xbb\xd4\xc9\x17\xe6\x7f\xd1#\xba}\xf7\xe6\xdd)\x9c\xa5)\b\xa3F\vEgEfkC\xd5$\x1al\xd5Jxd\x1aێ\xa0`\xe97\xc3A\x14\xb0\xfe\xfc \f9I\xf6(<\x81}\x82\xd8l\x1d\xe1\xd26/d\xa9R\xeeѵ\xc5\xc4\x03\xca\x0fV\xbcGC\x9d\xd2h\x93/&\x87\x18\x9f\xfe\x8a\xadG\xef\x95\"k\xbb\f\xaf?\xc6Z0\xac\x16\x03\x03\xb3\u07b4;\xe4\xe3\nrN}[0\x05e;\xf5\xf6\x16b]>\r\x10f\xdb\xe7\b\xfe^~i6#\xa9\x9f\x87\xc3?\xffp\xf1\xd7\xff7\x1c\xfe\xf2\xf7\xb8\xb7T\x10k\xed\x90\xfa\x83Œ\x84\t\x17\xa9i272\x15\n\x13\xe7A\x9c%\xa6\"\xe4*\x1a1\xaey\xfeB(}y=\xf2\xbf\xe6\"\xdd\xfcMM\x86ϰ8\xb77e\x8f\xe6Q\a\xcb-i\x91\x10\xc1wyGN5\xed\xf2\xb1\xed?F\x91\xb1ᠦ1j\xc3\x05`8h*\x97\x182\x1cAZ7\xc3W\xaf_L\x9ek\xf9\x98\xf9)>\n\t\f\xae\x9cIa G\x02u!0T9\xde?\xf5\xa5;\xb1\xba\x1f\xe0\xec\xfa\xd27\xf3\x7f&t\xf7[?JR}\xecU\xc4\xef?\xf8\xf6\tV\x13\x0f;\x02$8I\xafB6\xa7\xb6v\xca\xc3\fw\xba\xf1\xcaؒ\xb9M\x94e\xdf\xff\x97\xf6\xcbI\x92\x17q\x9a\xd8=\xbf\xa4K!\xd7#\xff+\xcd\x17tI%\xc9\xc6X\x92A\xe6\x91j\xde\x0f\xd3\f\xaf\x1c\xb4{Y\x14\xc4\xfa\xe4\xb7G\x19\x1e\xcc\xf1Ѽ\xa4\x90\xe8ed\xebZ[\xd0\xe7XyJ\x8ei;v \x8e\xa5\xcb\xf0u/\x0f\xad\xd2\x11&\xc8a\x9b\x1e\xabQi\xe5G\x83Eh\x94\xaf0\xec\xd186\xe2#j?\x80\x94\xad\x98\xeaVo\xdb\xf6!|\xfd.J\xf9\xe0\xcfx\xebT\x9f>Pz a\x83qnܺf\xaa\xdbA\x14:/\xc25\xb4\xff̄\\\x92\xb2\xf2\x9d>\xe4\x02#Y\xa5>\x8cS/x5\xec\x95\xd7/\"\xe1\xe4X\xbf*\xf9)\xfc\xe7˿\xfd\xe1\xb7\xf1\xabo^\xbe\xfc\xf9\xab\xf1\xff\xfd\xe5\x0f/\xff61\xff\xf8_\xaf\xbey\xf5\x9b\xff\xe5\x0f\xaf^\xbd|\xf9\xf3\x0fo\xbf\xbb\xbd\xbe\xf8\x85\xbd\xfa\xedg^,\xef\xeco\xbf\xbd\xfc\x99^\xfc\xd2\x11ȫW\xdf|\x199\xe0\x87q\x15\xc3\x183\xae\xc7B\x8e-\xe9;\u05edn_\x9e\x1c\xa7\x8f\xc1>\xc3\xf7ަ(\xe1\xf6\xb7\xb9\x86\x9f\xa3y\xd4c\xfa\xbd\xac#E\x13I\xf5\xa7\x15s\xb5c\xf2\xa6\xb3ݴV:\xc7ϰ\xde>v\x18\xb6\xaf\x8bg\xd1S\xf9\x18\xb8\xd7s\x02&\x05\x1b\rԤnm\xbf`\a\xff\x8e\x06\xc7\xff\x1fI\x92\x8ea\xe2c\x98\xf83\t\x13\xdfXY9ƈ\x9f'F\x1c\xf9h\xcc,\xc7F)\r\x9exlQ\xf5^a\x89\xe9֚/gb\xa3\x11\x95\x8b\xbc\xc0\x16ᑅA\xbbKR&~\x01\x8c\xa9}\xa9*n\xcdHaٻ\xde\xe8,ˀq\xbb\xe4\x99A\xf92\x10I\xado\x8f\xe7\xf0\x04\t\x11]a\xb1\x8c\xd9Yۘ8\xc6_\x95&R3>\x9f\xc0O\x8b\xa00\xac\xcd_\xbb\xba\t\xc6aYd\x9a\xe5\x19u\x88P\xb5\xc6L!P\x95\x12\t\xab\xcesA\x18\x19Qڣ\xd7\xe0\x02\xf7\x9a\a\xc0\xac6\xa5c\x99\xb2\xe9_\xe5茇D\x10\x0e\x17|e\xde\x162NH\v[\xdci8\xa7\x1aWm\v\xbc\xaf}\b\x00\xfb,%\x88(\xa6\xae\x04\xa4V\x89\x18j\t:\x02\x89YՃ\xad\xccU\xaa\xc1\xd3\x1b\xc5e\x9dF\x84\xc3\xd0\xc0\xc8m#\xcbZZ\xb3\x81 \xeda\x98\x83\x8f\xe7\x10Ě\xa6Oe\x96~Z&\xe9\x13\x98\xa3\x8fg\x8a\xf62C\xfb\x98\xa0\xfb\xcc\xcfhW\xb0\x92\x1d\xbf\x16\x86\xaf\xaa\x8fa6F\xda`\xe0Z\xb0\x9c\x0ez\xe0\U0008c5ee\x01\xb0\x94r\x8d\xb1\xc8p\x8b\x1e\xad\x1eIs\x8a=\xd3\x04P\x92,\xccb\xe3\f\x98\x12\xd1\xe1\xfc\xfb\xccU\xd1֓\x7f\fE}\xd3\x16s8jݣ\xd6\xfd\xbdi]'\b\x9f\xa5\xca\xfdH\x1e)\xeb\xda\xee\xabMD\xdf\xd4vQ\x1a\xa9\xaf\x9fH\xdf\x19&t\x92\xca\xd2AS'\xe6}!\xc2g\x8e\xd1\xf1\x8d:\xabE\b\xfb|f\x99\xb8\x87\x05\x9b#\x9bex0~\x00Xk]Òp2\xb7\xbdB\xb5\xf0\xe9+\xacDDE\"Y\x1a»57\xd4L\x12\xe3\xeah\xfce\x82\xa4\xc69\x97\"˺\xb6g\xf0\xf5\x00w\x14\xde\xd0<\x13k\xd7\x12\x94\xa7p\xa3\x89Fc\xef\x86ꐂ\xac\b\xf5`\x88u]dY\xfb\xa1\xb3]Y\xcd6\xc2ʋ,\x83\xdc\x00\x9a\xc0;<\\r\x06g\xd9=Y\xef=\x16p\xf3\xba\xc2\xdd\x13#\xb8\x9c]\t}m\xf7\x855w+X\x90\x01\x10\xd9\fN1\f\xa3\xb0\xe7\xdb܄\x10|\r\x91\xe9\x80W\x7fU\x00Xc\x96\xdf3E۶\xe3}DQ\xfb¼\x13\x1d\x10CM\xf5\xa4\f\x93\xb1\x19M\xd6I\x16\xab\x95\xce\xdc\xc1\xfde?\xf8\x9a|\xaa\xb5\xd24\xc4\x01umtL\x10\x83\x99\xbe\x9a\xb9\xe0\x8a\"\x93T\xa2Z\x8e8\x00\xb0\t?\xa96\xba\x0e\x9e\xd6D\xc3\xe6\xb87\x18\xdf\nyhS\x1a\xaf=\x10d\xf5\x84d\x19nbY.i\x8aQ\xaa\xac\xeb\xda\xe3?\xbe\xcdi\x85Q\x84jO0\xf6\x9d\xd1\x03A.\bO3*M;7\x17uk@\xc7\xf2H\xc6IX#\x81\xaa\\\xc9\x04\b1\xe8\x98$B\xa6\xae\x1f\x92\xefxCd\x88\x8c\xe3Uj4\x94\xf7\xfaz\"f͡\a\u009df\"\xb9SPpͲ\xaak\x9eo\x99\xa7\xecj\x1d\b\xb3\xbb\x1d]\x8e\xba\xf6\xcfq)+\xe3\x05\xf6S>\xf9\xa2\xfa\x93\xf9\xa2\xbbj\x89\x17\x81\xae͉\x0fH\x01\xae?\xc8\x0e\xa6\x10\x10{2F\xa7\x8ag\x02\xcd\x10d#\xa7o\xa6\xb5\"ԉ\xe9\xac\x18\x01\xd5C\xb0\xe5>ĨET\\\xa8\xcc\xc2\xfd\x8cxTG\xf5\x02ى\xf5\xf6\xfe\xcbQpq\xad\xe1\xb4ވ\x99\x99ƐM\x99\x8b\xaddB \u0383\x84\x94Is$\xc9\xda\xef'\x8c\x84\xe9fkz,I!4\xbc\x1c\x9e\f_\xb9\xe4M4L7Q\xd3m8\xa3v\x8d\f\xedG\xd46J4\x83\xd82\xcf0#B\x93a\x8a\xc7CG\x82t\x1b\x1d\xb1/\x97\xa3\x91k\xe72\x02%\x06\xc1\xe0̏\x96\xc4\x1fy`a\x01\xe3J\xcb\xc2\b\x8a\x1a\x04\xc33?/\x87\xbf\rG@u\xf2\n\xee\x05\x1fj\xc3\x02\x13\xb8\x15\xe8\xe7G\xc2,\xa7\x8a-\xca8\xb5\xcd\xd6\xe8\x03\xa6Z\x98\xce֑Pq\xd9\xc6\"@\x04\xe6\x0e\\7\xedq.\x1e\xa2\xa9d\xf7y\xa0Q\xfe\x15r\xa8\xb6K8\xa6\xe62\xb6\xa2'\vJ2\xbd\x88\x1d/r\x14\x9e\xd6\xfa/\xec|\x8a\xadw\xb8\x83\x17\xaeˢ2D=\xcdھ\x8ez\xcf\xc8@e\xfd\x7fGuυ\xef\xfb\xdb\xdb\xeb\xefh\xd5\xd4<</V\x8d\xc6\xd7~#\x17\xe6TbU\xe9\xc7^\x9bp\xcf\xd2#,L\xdf\v\xa5M\x10\xc49\a<\x9c<\xfe\xa3Esێ\xab\xac\x83\xcb\xeb8^\a\xf8\xab(\xd0_\x98\x92i\xb6.\xbb\x1cb\xe3\x97\x178\xec\xd8\"[\xc6M\xe8\xe6{JR\xec%\x8cꓒ\x00\x0f\xe6\x11E\xaa6\x8eG\xa0\xe5y\xa1\xb4X\xc2\xc2M\xacc\xbb\xd4\xed\xab\xd6Z\xc7\xf1\xf9\xc4H\x8f\x8d;Ů1\x98\xfd0\x8aՍ\xef\x19\x14`\x93\xf3oo\xaf-\xee\x1d\x16\xa7\x91\xa1q\xfc!\x90ԑ\xefz\x8cb+\xcah\x90\x8c\x9b!\x1a\x01\x88\x1eY?\x1d\xd3/1Ҋu\xcc\xf4X\x1c\xf5\x80\xe8v兖K=\xb2\xf0\xd6ZZ|\x9a\xe8\t\xad\xd8y\x02\xfc\xf4)\xf6\x8b*\x89\xab_\xe3^\x18\xe8a\xb0\xf4\xb7\x96́\xf7\x9d\x0e\x888\xc0Pf\xc3)\xa6\f\x92\xc4t\xe3\v\xcd\x03\xf9\x0f.\xe6F\x1d\xe1\xd6\xeb\xb0\x16d\x8f\xc6PX3\x17\x87\x92\x1e\x1b\xa3\x1ec[\xd4#l\x8aj\x10Ֆ\xf6H\xe0\xc5rJel\xab\x01\xdfl@\xea\x06\x834\xe3\bq\x84\x06\xb8\xb2C\xf3ILoN`\xef\xabH\x88\xafq\x94\x7f\xfa\xe3\x1f\xbf\xfe\xe3\xc4\"\xc0\xc3&<\x12\xe2\xe5\xd9\xd5ٯ7\x1f\xceM\x9f\xab\xc9\xe0\x13\xd9\xffd\xb6\xd7\xd3\xd3\xfe\\rc\x00!\xd6\nE1\x84\x13\x05\x12\xbcW\xe0\xe2\xc5\xc8\x1d\xe8{T\xb9\xa7H\xb0Z\x18\xfb\xe6\x194I\xfc\xa246\xe22\xf8\x88K\x89N\xf2\x1b\xccWG(\xbe\x063\foϯ-\xa0\xca\x01\x0e\x86\x88\x8aԇd\x19_\x89l\x85LA\xe0\xf6\xfc\xda &\x86\x96\xf8\xac\x89\xa1\x9bPٚ\xeaj\xe7\xb3-:\x89\x80\x89\xe1;\x9b\x8a\xc0\xfd\xf3\x04\x0f\v`\x89\x19eL\xd2\xcb\x7fp\x94\xc3\xc1ǵ\xc0\x1f\xc9\xcb\x1f\xbe\xf3E.\x95\xc3\x1f\x05\x15ja\x826\x87?\x12\xa8\v\x13\f?\xbe.8Z\x15\x95U\xe1\xac\t\xe9\x0f6=Z\x15\xff.V\xc5\xe7\xb3\xe2E>\x98Kz\xa3E~:\x88\xe6\xfe\xe1\xb5\x05\xf1(\xb5\x01\xfe\xe4\xa1]\xe9{H\x83\x89\x88\xc2\xc4M\x8b\x1e\x1f{\x16\x8d\xa4\xbb)\xcd\b\x84\xa9\x8ad\xe1\xf3\x1cx\x1a\u05c9)\x03(r\x1bs\xf2\xa7ʅ\xa6\x12sI\xb1\xb5\xa7\xa9\xeb\xf4{\xce\r\"\xb0x\x1a\xbf\xa4:\t\x95\v\x136r\xd5\x11.\xab\xe6\x89ԯ\xd8 \x91D\xb9\x93%\xe9\x03\xb6\x9cq\x87Q\x13%8\xda\xcc%ј\bU\bL\x99\x03\xcfl\xe2KW\x130IJ\xb8\x16\xe9\xb0\xe3\x19\x98\xd5U\x1b\f\xcc%I(\xe4T2\x81Ev\x05ש\xb8ǳT懏\xdf\xde\xc1\xaf8H/\x06h\xed zUyxE(\xcdޗ\xbd}}E\x88(t\"\xaa\xfah\x87\x8fP\xfej\x90\xdbn\xd72\xcc_\x90,[\x97(\n\x95/\xb7\xfbO\x97\xa4\xd9Fv DK\x9a\x8f^\x1f\x83\xacljg\x02\xc1\xe2\x90v\xf2\x17f\xeeq\xd3B8\x17T\xf5~\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf9\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf9\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf9\x96\xdf\x1c\xcbo\x8e\xe57\xc7\xf2\x9bc\xf9ͱ\xfc\xe6X~s,\xbf\xf9\xc4\xcbo\"\x1e\xf2\x15'\xd7Xhr:\x88\x12\x98\xe1\xb5I\xb0\xb3ĕ\xab\x88Y\xc5\xe1\x9d!VC\x99T\a\xac\xd7\xfa\xf4\xfa\x9e\x19A\x87ݢTT%4\xad\xfdRB\x9bXtϠ\xfb\xc6K\xea$\x17\xf6?U\xfe\xbc\x9687\xe3\vȜ\xc7-\xa4\xe1\x19\xf3.\xd9\xf2*\xf7\x1d\x04\x1avgʣ\xad\xb2\xbeY\xf2x\xfb\xc4%LC\x1f{\xaa\xcc\xf8Se\xc5\xf7f\xc4\xfdx\xb1\xd8*\x02\xf6V6\xbc\x1aj\xb3\xadD\x04\xec\xdb\x05}\xec\x9c\xf6\xde|v=3\x1d\x01{;\x97\xbd\x95\x95\x8e\x80Z\xcfc\xb7f\xa4#`V9\xec]\xd9\xe8\b\xa0\x98\xbf~\xbaL\xf4#f\xa1\xa3\x130\xbd\x8c\xd5\xd8Xj\x949\x01\xbe\xf0\xf4v!\xa9Z\x88,\xed\xb1\x82\xbce\x9c-\x8b%\n\xb6B\xc5\xc4Ve]k\xa8\xc6\xf0:Ǭ\x9c.ń`YJ\xcdqt\x84e\xc1\xf9&\xdbDlA\x8c'\xaf\x8a$\xa14\xa5i\x15\xdc\t\x17\x91\xaf'\xe5\x9c\xcb\xd3\xf6_\x87\xf1\x19\xb6\xb3 \xdaly\xfc\xfa\x7f\a=\x19\xebUE\x95\x18\x1c./0\x15\x87\x83\xa8\xb3\"\xa3K\v\xe2\x17\xf4\xb8`\xc3S\x94\x13\xec)%\xc0\xa2\x80\b\x88{\xca\b6\n\x02\"\x80G\x97\x10\xf4Љ\xbdJ\a\xf6\x97\r n\x82A¾\x92\x812\xf9\x1f\x016\xba\\ z\xa5z\x9a2\x81\xdd%\x02\xc0\xe2b\r\xfd\xca\x03\xe2\xf5D\xff\xb2\x80\x1d9\xef\x9e'R\xf7\x89j\xf61Nz\x97\x01<\r:\xfa'\xbf\xa3\xf1\x11\x1fo\xea\x91\xf2\x8fO\xf7GZ\x89\xfdL\xd3\xd8\x14\xff\xfe\xf4~d\x10\xbeWj\xbf\a\xb3\xc4\x05\xdf#\x03\xef}\x83\xee=\x03\xee\xfbS\xf8\x91\x84{\x82@\xfb\x9e ;\xbc\x8es\x99\xdb\x03\xec}C\xe5\x8f\x1c&\x8fM\xbc\xefO\xba{+8\x86c\xa0=\xe1\x1e\x9f:\x8f\xe6\xdf8\x85\x1e\x91<\x88TŌ3\xcdH\xf6\x86fd}C\x13\xc1\xd3@\xab\xa6Aġ\x13\x01<4\xd0\x02\xb3~r\xaf}\x82\v\xe2Nȣ\xa9\xdf\xee\xe8#\xff\x81pї\xa1\xca\x1c\xd7o\xe7\xbd\xd1\xd7\xfe9\xa3\xf4\xcf\xe3\xbe\xdbM\x82\xfd\t\xff\xbd\xb8\a1Ӕ\xc3K\xc6=\xed_\x85\xeb<\xe7\xb8WњRxQv_\x7f\xe5A\x87J\xf0\xe7\x17X1!%\xa5\x9e*\x92\xe6\xc0?v(́\x9d\x15Y\x9fp\x1a\x86\xf96bi\xa1\x04\xab\x8e\xd7zm\xc6\xec5\x86IJ\xb9\xcd\xf2\xff\xfeL\x14Y\x04u\xb0\x00\xaa*g\n\x82\v\xed\xc5O\xcdR\xa6@\x88-\x85O\xedeL\x81p\x1bEO\x11%L\xcf\x1aM|\xa4\xb2\xa5\xfd%K\xb8G)\x02hT\xb9\xd2\xd1S\x8a\xf0\x946˒\x8e\x9e\xd2\xf3zJ\x9f\xba/\xa0ْ\x8aB\x7f2n\xc0\xfd\x82%\x8b\xba\xb5\xc1\x96\xd8賂/\xa1F\x1b\xd2\r\xa95\xd9\xf6\xb4\a\xd4\xfc\x1by\x0e\x11\x1c\x16\x16\xf6nj\xb2\xdaќ%\x9eJk$d\x11\xc2S\xdb\xe1\xcd\xd5ͯ?\x9e\xfd\xe5\xe2\xc7\t\\\xe0q\xae\x15Hs\x88|زf\xa22\v\xb2\u0092\x8e\x82\xb3\x7f\x16Ԫۗ\xe5[^\xf9*\xb2\x00\xa81\xe7sE\xac\x1c\xa8YT$Q~d\xca\x1c\x18e`\xa0\x85N\x1fr\x81\xa1\x9b\xb0\xc3_\x9bk\t\\ \x10L\xa9\x13\xbb\xee,\xa8\xa40g\xab G\x05aھ\x16@Ҳ\xe9\x03\n*\x1a\xe0\xd8\x17\x85LE\x11B\x0f\x84ȩF\t.\xe3R\x82\xabF\x9f\xb0BѠc\x01\xa7\x85ƒ\x92\\\xb2%\x91,[\xd7\aH\xb2\t\\\toq\xaf\xbbS\x14\xaf:\xea\u07bc\xbb\xb8\x81\xabw\xb7x\x861\xb6Z\xb2\xd56\xe6\uf044\x9aR$\x8b%r:\x813\xbe\xb6\xaf\xb1Z\x9aa/2\xa5)\x0f\x1b\xaa3&\x9ce\t/\xbe\x9a\x98\xeb\x05\xd2M\xa2\xb5a\x8b\xd1\x02 \xd6)\xe2\x8bAm\x8c\x97M3˝\x81v\x90\xa3{[-\xe8\xe0\xc9R\xaa\rQ+\xcb[\xaf\x11\xe1\x92\xe6\xf6dG\x05$\x00b9\x11K6\xa3\xea\x14\xe3\xf3\xac.\x7f\x83\xa7wpʗ]G\x18\xe6\r\xb4TV\x867Q-w\x06\xc2,\xb90\x17\xe9P\xc1\xe5\xb5g>l\x8aÔ\xb1&\x83A\xa2\xf5\x89i5\x96Ztۆ\xdf#\xf8\n\xfe\f\x0f\xf0gc\xae\xfe)\x04\xdd\xfdV\xf9\xd8u\xde\xfb\xa3\x97\u05fd(\xf5\x13*\x1d\x84\x83\xd8\xc5\xfc=\xe3i\xa0\x14\xfa\x12BM%\x9e\xa5\xeb(\x1e\x8a\xc1h\xef\n\a\xff\xc91,\x0e\xca\x1cXY\x9aBx\xf4\xe4'Ų\x80\xc3\xc3j\xa1+\xa7|\x9ag\xd5\xe2h\x83!\xa2@\u0092\xe8dQ\x15\xfe#m\xf0|I\xa5+m\x16\x0e9\x15\x18\x81r%\xae\v\xa6\x9eZ@\xff\x87\xbdkon\x1b9\xf2\xff\xf3SL\xa9R'\xebBһ\xa9T*\xf1?)\xc5\xf6\xee鲲U\x96\xec\xbd\xd4&\xb7\x19\x12CrN \x06\x87\x01(3\xd9|\xf7\xab_\xcf\x03\x00\x01\x90\x1cP\xd2:9DWuk\th\xcct\xf7\xf4k\xfa\xf18\a\xb4OBI\x8d/\x1f\x93\x83v\\n\x8a\xb7Z\xbb\xd84j\f\x86jE\xb35ֱYˠ=\xac\xf5\xbd6\xbb\x8d\x1e\xf4)\xf8-K\xb7 \xe9\xe6\x1c\xdd<Y&\x16\"CT\x1c\x12/4\xc7\x01\xddd\xb2\x8d\x9c\v\xfdl2.\xcdT\xae\xe6*>\x89\x97n,\x10\x9c\x05\x1b\u07bd\xee\xc9K\x1f\xdf܌\x11\x1b\xa6\x91ַ\xaf\xefnj7\x02\xc1\x10\xcf\xee^ߜ=\x132\xfb\x84z&\xa5\xe4\xba\t\x8b\xf8L<\xe9FO\x1c$ꓳS\x8b\xa1\xc1I\x98\xacy:\xb9\x17\xdb\x00ñ/nz`\xa6\xb9\\\xb3\xe95O\x8f\x84\x91\t\x1e\xc9/\xa4F\xce\n\x91rM\xed\xc5rk\xb5\t\xca1%7\xca\xc1\x16I\x94*\t\x7fD.\x1a\x15t\x01@;j\xed~\xfe\b\xdbPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05\xddPA7T\xd0\r\x15tC\x05݉\x15tn$\x7f\x00cՙ\xea\xb5Z\xa7\xc8O\xf9\xe0\x00\xf9\x03\x15\x96\x9fJ\x19¥\xf8\xeaJ\xdc\x1a=\x05\v\xccU\xb2\x90\xcb\"\xa3:\xae\x97f6\xfbdn66\xf1\x18\x9a\xf8ս<\x1f=\xad\xc1\x11˵\f)\xa2\xc3OY\x95v\xd3\xdb\xc8\xe9\xa5_OӮ'\xe9֔\xe7\xa8\xddx\xc5\xfe\xfbş\x7f\xf9\xd3\xe4\xe2\xf7/^\xfc\xf0\xd5\xe4w\x7f\xf9\xe5\x8b?O\xe9?\xfe\xfd\xe2\xf7\x17?\xb9\x7f\xfc\xf2\xe2\xe2ŋ\x1f\xfex\xfd\xed\xdd\xcdۿȋ\x9f~H\x8a\xf5\xbd\xf9\xd7O/~\x10o\xffr$\x90\x8b\x8b\xdf\xffb\xf43j\xac\xfa\x01\xfc\x8ex\xc5\xferf/\xea\xd7\xfc3\x9c\xa2\xc0U\xf2\xb5*\x12*\xc0\xb4\xcc\xcf<\xf3\x9bޡ\"\n\xf6\xce\xc2\xc28Ox\x12{\nHg\"\b=\x1c\xc8\xe1@\x1es ?Xn\xd9=\x92&N\xf1\x88G\xd2)\xda\xd03y\xb5`~\x8dR3\xb5\x969\xbctD\xf7y\xff\xe4R\x99\xd7\\Q+\x96({\x9bSQr\x99h\x18\b\xd9\x058\xa21S\xf9Jd\x0fRS\xbe\x18Oʘ\x02\t\x8cI$\x162\tN\xcb Ss\xfa\xaf \xaaz\xbc\x84\xd8c&\xf3-2\xf8\xc5\xe7\x00\x9f\xbc\xce\xf4\xb7\x16\fS\xf4\x1b\xeds\x9c̐\x95\xa3\xa12\x1ah\x81\xaa\xae`\x82\xa4*\x96\xf3\xedK\xb7!R\x12\xe2s\xfe2\xe0\xdb\xc7}1\xe7\xfa\xbe\xa4\xbf\x98\xc0e(\xc9\xdc\xf8\xfeS\x1b\x8b\xa4\x99o2\xb9\x91\xb1X\x8a\xb7z\xcec:\r\xafN\x90a\x97\x1d0\x83@b*M\x92g*\xd6\xeca%prQ[\x97)\nX\xa0\x9emɃS\x85֠P\xea\x16\x066\x83\x14\xc85Ky\x86Т\x05\x1f*\x12\xa9({\xa6Tls\xe2\xe3m\xb9v[\x80\x92\xa8\x1f\x13\xf1\xf0#\xbe\x1d\x1c\x9e\x8f\xf9\xd2\x17\xc6`\xa0\xfbn\xb4\xa6ﲻ\xc8\x04q\x8b@\b\xe3\xf1\x03߆.\xf7a%v\xd7'\xf5+\xf6\xf5\x05\x9dM\xae\x99\xffb\xa8\xa4\xfd\xd5\x05\xdd\x1b\xbe\xbe\xbc\xf9\xf1\xf6O\xb7?^\xbe\xb9\xbez\xd7G,\x82R\"h(ܜ\xa7|&c\x19n\x84\xd5\x0e\x06\xb2\x99\xaa\xa0H\rE\xd1\xcb(S\xa1\x89\xb1\x84\xe5\xacH\xd0ݢĴ\xaeݯ\x04\x82\xac\xb6\xbd 6[\xd4\x17\xbb\xccx\x12\x9e\xb58\xdb\xee0CV$h\xeb\x14Ƭ\xfdd\x9b\xb5\xa3C_١\xdae\x14\x89\xa8\x86\x8a\x9f)\xfb\xf2\xb5[¶\xec\xb8\xd1\x03&c7\xefo\xaf\xfe\xabN\\\x9c\x8c\x1e\xb0N0\xf6OI\x16Á9\x91\xaa\x1fL\x85\xe1@\xd7/\x87\xae\xbd\x8cVV\xea\xf3S\xee\xd3?\x14IEFɤ\x025\b(ck\x15\x89)\xbb1*Y\xe8:\xac\xf2\x1b\xa1̆\x16\xd1h\x8f\x9b \xb5'\xde2xo\x1b\x1e\xc3jɕ\xa9\x9d\v6\xb0ڳ\xa9\x16<\xd6b\xfa,z\x15\x86\xcb5\xa2F'P\xce\xc3`\x91HTn\xfd\xe5\x1e|\x8f&(\x99\x9a3\xe33W\x92\xd6j\xfa+\xd8ʺ\xab\xa8U\xa9\x1d\xa6o\xfc\xaa\xa9[U L4\xf6jW\xab\xeeS\xa1\xec\x05\xf7\x1d\x15\xd9Tۋ\\\\\xe4\x03Dl\xcd\xf5\xbd\x88h\xbcE\x8f\x8dK\x1fe0D\xf1\x9b\xbeۦ\x82-\x04ϋ\xe0\xab\x19\xb2\x86M\xb9\x80H\xf8,\x0e\r`\xf4\x94l\xc0\xcd\xfb$\xde~P*\xffƗ\xa2\x9e\xc0\xb6\xdf[\x9f\xa6~s\x01\x037\b&J)\xb0\xb6\t\x11\x8e\xc4@\xa5R\xd6q[ H\xa9\x9fS\bdEr\xa9\xbf\xcdT\x91\x9e\x80N\x9c\xb2o\xaf\xde@~\xc1\xcd\x00\xb7\x89$϶\xd4\x06 \b,cj\xb1s\xb6\x9c\x7f\xc5>\xe2\xdcٓ\x16\bԋ\x80\x05+\x12-Є\x84o\x19\x8f\xb5rn]\xb07{CY~\xd5\xf8˔\xc2s0\xdee\xc2f*_\x05B\xdc\x01G\"\xa0\xf9\x95\xd0\xd8\x1e\x90IQ2\x9fl\x14A+\xee@\r\x05\xca\xef\x05Z\x15\x8a\xb9\x88D2\x17Ӿw\xab\xbf\xf9uЛ}\x83\xe3\xc4\xe5\xefT\x02\x01r\x02\x9f_%\x91\x9cs\xa3\xe5x^\xe7\xd3Q\x8f\x9eC\xd6'\xe7T\x11M\xe2\xa3\xd0\"\xa3\x16^\b\x01\xf4!\xf5\x1f\x8b\x99\x88EnB\x16\xd4p\x8e\xe7\x82V*\xd7<x\xba;ϽjCw\xb2D\x17\x99\xb0A\xe1\x9cEJ\xf4\xc9/\xb3\x9b\xfex\xf5\x86}\xc5^`\xd7\x17\xc4\xea\xc8Q\x84\x04\xa1\\\xc2@\x98u\x89!\x17ny\x84J:\xf1,\xb8\x8b\x13\t\xe11K\x14R;W\x0e\x97\xe8n\xe1\xc2A6\xb76<\x8a\xdf\x14>]\xe2$\x10pE\xf8\xfc\xff\x11''\xa9\xbe\x8fZd'j\xbe\x8fO\xae\xf9\xfa\x87\x95 O\xea\x94\"1\xc0\xd6\"\xe7\x11\xcfy\xd88|\xfc\x14\x89\a7\x1d\x18\xf9Q\x19\xf9\xf9\xf5\xa2\x16\xdfɤ\xf8l\x92[\xf5\x89\xe7\xe0\xf6-\x01c\xf6\xf2\x04\xb2|\x16\xacp\xd24\x96\xa6E^\xed,8A\xeeHՇ\xda\xe5\xc1r:\x8d\x049\xee`\xa0\xd4CW\x8a\xec\xcaH\xad\x1bۆ3'j}ħ$\xf1C\xe1\x0f\xc7ꑎU\xff\xf0u,6\"\xb8\xfd\xe1\xce\xc9\xf8\x0e0p\xa9\xe3\xf8\x84\x80\x06\xc3d,\xe63\x11\x1b\xe3˜\x12\x9f6^2\xda\xe8\x19C\x8d\x99\x8aO-Q\xfc\xa0b\xca\x13\xe5\x1e9\x00\xfa/\x80\x1bz\xf54\xdc\xdcm\xd3\x1d\xdc\xf4\x8c&\x7fi\xb8)\x82-\xae\x06n`\xb4\xd5q\x03\xa0\xff\xf4\xb8\xe9\x19\x82\xd7b\x8eܕ\x9bL-d葬\xb3\x1c\xe6$\x18`e.\bEb\xfb\\;\xd6s\x82\xaf\x16\xbb\xa0\x03a\"\x04\x9ffj#q\x1f\xc8s\xa3\xc3\\\xa6ʿ\x95\x9f\n\x04K\xd2x\\'\xb9\u07fcڈ,\v\x9b7\xe0t Ve\xc1<\x9b\xb6Rs\x1e\xe3F\xa1\x17'4\xb8a\x17\x1c\x93.\xfa\x11\f\x17q\xd2\xd4B\xb1y^\xb0i8\xa3\xdf\xf4n\x15\x91\xa8HT\xfaX\xa2\x81\rz\xf4\v\xf7\xad\x1e ]\xa1\vLx\x97$\x14\xb9\x9c\x0f|\xaf\a\xcc\\\xd9\xe6\x7f\xae\x80\x92\x93\xa4\x17I\x84\xf4\x01D\xf7C\x8d,\xfcd\x02\xf9\"\x1b\xe1\x04\x16Rsc\x91\x9fkV.\xbc\aXwH\x1d\xb9\xc0\x05\xe0b\xbbz\x04\xba{@uv\xec\x82\x14\aD\xf7\xd9w\x8e\xbdΞQ\xc2\xdaWO;\x18g\x80Q\x9e\x86^wH\xf8\xbf{L=P\x8b\x06\xcamx\xa9\aD\xa3â)\xfb\x84`\x95\x17c<\x13\xaf؟\x13\xe6Q\xde\x03\xf4\xe4\xc0\x11\xee\x01\xd2\x1d\xa9\xc6\x11\xfe`ܳ~\xd7'6\x0f\xba\xd5ߋzCt[\xdf]\xeaǄN[x\xe2\xaa\xed/\xa4Z ;*\x9e=߹p\xe9\xc8a*c\x12\x9e\xe0\xd0\xd3\xc4y\x90I\xa4\x1e\xf4\xe3\xc4)\xbe7\xc0\x9c\x83:\x87hBS\x14\xdd?V\xc1\xe3\xb8d7\xfd\x18\xc1\nwv݀\xa2\x16\xd7<\x10\xaa\x15+\x96q\xaf\x16\xfb\x82\x01\x81\xa0;B\am\xc1\x80@\xc8\xcd\xd0\xc1\xcf\x16\fX\xae5\x7f\x9d!\xae\x97K\x1eߦb~\xa2\x1e\xf9\xf6\xfa\xf6\xb2\x0e\xb0_\xeb\xe6\a\x1a\x8a\x06\\\x03\"\xe3\xd1ZjM\xf7\x14b\x862\xfb\x1e _\xb8\x82\x9f\xa5\xccW\xc5l:W\xebJ6\xf5D˥~i\xcf\xe4\x04x\xb9\xe8\xf1\r\x99\xa0Ov\x99I!\xd01\xde\xc6\xc0\xb1\x91\x1e \xe7\x1e\x9b\xc4pT\xa5\x1f\xb9$\xc8&\xba\xdf\xf5+\xe2\xa7ր\xcfj\xb44Y\xef]\xaf\x96\x87\aد'>l\xbf\xf4JM<\xc1\xaeP\xa3\aP\xa2\x9fI\x03zVT\xfbK\xa1G\xc00\x94\x8d\x03\x05Ik\x15O0P\xd6~\xbd\xe4\x90\xed\x15O\x0f\xc0mWL\xf4\x99\xfa\xc5Q\x0f\xc8mWMU\xa5\x18N\xd5c\xefM{\x00ޯ\rY\xbf1\x00O\xa3\x11\x9fD+>\x7fت\xc7K\xb6\xc9\xd0ISTn+0*.\x1c\xa2\xa3GCd\xce\x1eC\xbeX\xa5A\x13\x8d씐w\xf2o\xf0\r\x82ng<;P\xc6\x01\xd5\xcaU\xbb\xab\xd9Q\x12!\xcc\x02\x9f'vq8\xd4\xda墾Z\xac0t\xe2Ze\x94\xcbأ\xc1Y\x96\x99\xb0]\xe5B\f\xde\xffAP\x84\xfbR\x1d\xd7V\xea\xc6\x7f\b\xa8\xbc\v[\xa5\x1d\xb8\x05K\x17\xa2ӆ\rY$\x17\v\xe1J\x8df\x02uG|-\xf2\xb0t`\x9b\xf73\x13Ki\xea?Ԃq\x88\xa1\xf3s]\xf67\n\xc1\x00U\x93Ȝ\xad\xe5re\x0e2\xe3,Vɒ\xb9\xc4\x1bL\x89f\xb8\xae\x0f\x80\xaa2\xf6\xc0\xb35F\xd2\xf2\xf9J\x80Z<aQ\x81\xe3ͨI\xf8v\xa2\xf3\xb0{OD&m4\b\x14a\xf3f\xa3\x87@JQ\x10\x7f&r\xee\x12R]^\xa9\xb3ڪ\a6\x00\xae\x83\x86\x84\xd5/\xa5!\xe106h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\rc\x83\x86\xb1A\xc3ؠal\xd006h\x18\x1b4\x8c\r\x1a\xc6\x06\x9d86H\xe7\x91L^\x8dz1TG\u07fc\xe0F\xf1\xae\xe7\x06\x92\xbf\n$\xe5\xc1&3+sB\xc8C\x0f\x00k\xeb\xbc|b\xa3\xcb\xf7\xd0\"\x1fS\xa3>SO\x13\x00\xb1}I\xaeq\b\x1atc\xa8CXM\x99L\xd8\xdb\xf7\xdf\xf8\xb3ӣ\xe1_\x9f\x8eG\xb4\x93\xf7\xc9\\\x9cL\xfa\x96ʺQp\x02\xd9<V\x98\x04\x81\x8as,\x8c\xcdW<IDl\xfd\x8f\xa0\xe4\x1e\xc4%fB$L\xa5\x02\x95ų-\xe3L\xcbd\x19\v\xc6\xf3\x9c\xcfWS\xf6\xfdJ$\xe1d\xb7\x9d\xd8\xcbUjd\xb4\xac\r\xf93\xb1\x0e끏\xe51>ϔ\xd6l]ĹL\xfd\x02\x99\x16T\xb2\xa3C\xb3\x86\x1dQ\xc1DȈ\x87E\x88\xceq\xe5\x0e\xf0ՠkKU\xed\xc5K\x1e\xda\x18p\xc4:ͷ>\xa9X\xb0\x85̂\nI\xe7\xb1$G\x80\xf6\x8b\xe4\x02tz\x8bd2\xa6\xf4\xc4\x1c9\xb0\x06\xa3!\xba\x04\x9b\xa3\xf7a\x13\xa5\xb9\xa6$\xd9\xca\"\xedG#\xa9\xad\xfd\xacC\x12\xe8\xb8\xed\x0fK\n\xaf\xc4(\xb1nD\x9f\r_\xb1}\xb9\xb2D\x8fk\xa9\xcb\f\xea\x10\v\xc9\t;\xe4\xbaza2f\xbc\xd9I,(\xca@\xe9`\xa5д\xfb'\xd6O\xc4\x06U\xb5b.\xe4&DM\xf3\x0e\xc9\xf7\xa4\x82/\x17\xd9Z&\x94\xb6|-\xb4\xe6Kq\x13tm\xd5\xe5\xd0\x01J\x85E\x82Lz$F\xe2\x04\xf8wKZ!\x8d\xbc\xb2\xe4\x00\xa0k\xb3;\x9f\x8e\xff\x90a8\x10\x891\xea\xaaL\xf7\xf4A6}ca\xd5\xee\xb6\x16\x99\xee3\x01`%\xfar\xe7\"A'\x0f\x93D0ˤX\xb0\x85Lxls\bǈ\x8c\x85Tգ\x8f&\x1aKj8\xfb*q)j\x0e+S\xf6}pY}\x9e\x15\t\xac\x14\x9f\x8cN\xd5\xear\xc1\x96\x19rA\xa0\vy\xc2~\xfd\xd5\xef~\x13\x00t\xb6\x85MJ9\x03\xb9\xcay\xec\x16\xc8b\x91,\xc1QFA\xf08$r牤=\xf5i\x0e\xa1A\xf0\u05ff\xba\x9f\xf9C\x17$\x02\x14{\x19\x89\xcd\xcb\n?Nb\xb5l\x9b\xf0x>z\xc2\x10B\xcb\x11\xa6\x81A=\x0f\xb1k\xe3\xcaV\xea\x81\xe8Z\x81\xdf\xe3\xbcY\x8b\x06\x05%*-b0̔}\xe3;9\x84\xb5\xcfiT\xc36\xb7\x0e\xb9\x13t\x8cݲ\xea\x82\xc6%\xeb\xbam\x04\xed\x9d\xca\xe4l\x90\x994\xa1=nS\xf6\r\x8f\xe3\x19\x9f\xdfߩ\xef\xd4R\xbfO\xdefYP\xebU\x873Zl\xccu\xce\xe6\xab\"\xb9\a.ʥ\xc7*$&\xa3\x8a<-rWaT!\xb6\xdf;\xe4ZX\x02\xbc1\x87\xac\xe9RY\x99\xf8,!00\x05\v\xf2H`\xf7!\xca\x1cr!VK\xbff]=ȿ\xfa\xea\u05ff5\x02$\x00\xa2\xca\xd8o\xbf\xa2\xe2\x02=6\xf6\fio\x18\x8ck\x1e\xc7\"\xeb+\x1a\xc0\xe2m\xa2\xe0I%A\xbe=\xd9\x7fy4\xd7\xf5\xee\xeeO\xe4\xb7\xca\\\x8bx16-\x1bmp)\x04\x97\xe7dZ\x9d[]\b\x97\xa3i\"M\x9f\xd4Fڨ\xb8@Õ\x8d\xec?N\xb8\x06\xc3U\xc3\xc4\x12M\x83B\\\x9aY\xac\xe6\xf7,\xb2`*9\x86V\a{\xd2MGO\x96Gٹ/\xbbc\xaa\xcadk\x9e\xa6\xc7s\xae=\x8c(\x16\xcc\xf8Cm\x9b$-\xa8\x1fV\x8f\xcd\xf5\xbf\xe108\x0e3\x86[\xf0S\x82qDGZX D\xe6\xeaqԢN\xe5\xb2Ӻ\xf9N0\\g\x0f\x81Zd\x0e\x85\xa0\xb6\xa7\x94\xea\x9f_Z\xc3l\xe2c\xe8k\x9e[?\xa1\xd7\r\x12\x95\xa8\xa6\"\xd3R\xe7\"\xc9?\x11G\xbf\x8e\xb9\\\xdb\xd0V0\xc4\xf0+\xa7\x9eh\xec\x13\xab\x9fTX;\xe8\xb5@\xe4\xf6\n\xef\x87g[\x1a\xc1J\xa3[\x02Nx\x8d\x93P\xa5m\xc0P\xe0\x85\xdcA\xf8`*\x90\xf8\xfeX\xee\xf8\x82'\x18\x01\xa7\t\xe7O%n\xea\xb2\x19;\f=\xb0tL\fğI$\x13aN\x96\xc8\x00\xe06P\x13\xa6\x81@\xab\x110tr2\x98)\xdd\x1d\x1bU@{\xeb\xa2GS9D\xe6\xed\xd2\xd8\xf9\xab\xf3\x10\xfc\x9e P\x1c\x923\x95\xf2e\x8fa\xab;\xb8\xde\x05\xc6\"4\x14X\xc3\xda\x0e\x04\x8b\x84\x83\a\xb38\xd3\xf3!\xb5PE什\xf5\x00\xa9s\x9b>`\xf5\xa9sYL\x8b\x89\x87\xe0\x9co\fCS\x05\xee\xed\x10S/\xafW\xaew\x10\xf1N%\"\xdc\bж=\x19\xda\b\x98\xea\x01\x18\x15\xd4 @&\xec\xeb\xe9\xd7_\xfd\xf3\xa8o\xdaÎ\xfa\xee\xd5b\xa9\"\x97\x9em\xf7n\xe4\xd6I\x18\xb8\xb6a\xc7rF\x96\xec7\xd9\x06\x05\x19<\x9a \xd4h9\x97\x06\x89\xbf\xa0\xe812+*\x8d\x85.Bq\xc4N\x1d\xc0\xd7\xcf\xe7\xb278\xc5\xec\xd1\xe5\xbd\xd1\xf4\x81\x10\x99\x112m\x11i\xdd\x17b\x8b\xaa\xa8\xa2\xfa,\xbc\xc3\xe5\v\xb3\x92sMC\x17/\x9e\xed8X2\xbd\xfd\x9cf'\x91\xea\xed\xe7\x94S\xdc;\xad\xd3,\x10\xa63\n\xf7Ь/\xc4\x16\x9a\xfdA\xac\xf8\xa6\x87>\xd3r-c\x9e\xc5[\x10\xfb\xd6`\x90͊\x9c\x89d#3\x95\xac\xfb\x8cZ\xdd\xf0Lb\xf2 \xcb\x045\xf3A\xb0\xe1\x17/>]~\xa0̢\vh\xce`\x98\xc2Q\xa5\xc0\xb5q\x83\xfb+\xcb=M\xb6\x9c\x9d5\x18\xd8\xe1\x05\x9c\x15\f\x1b\xba\xdc\xe1\x15\x16ú\xc8\v3\x9f\xf4\xf3<.\xb4܈g: \xfd\xbc4o\xed\xfe\v8i\xb6\xc1\xca\x1b\x19 \x1fj\x92\xe1u\x85\xe1\x1a\xddZB\xc8x\xb50F\x99Ӈ\xe3\xf6\x94\x8d \ta3N\xfd\xe5\x12\x8c4\x1bL\xb6m\xabf\xa2_\xdf\xf1]\x17\xc54\r|ްr\x18\xf7\x06p` \xef\x85p\x9d\xcd\x11|5\nd\xb3;\xf3\x9e\xed\xe1m\xe2uk\xfe\x99\xf2\xe99\x1d\xc8# 2\xdc\xc6`\x05쓈E\xa6\x9c\xd2x\xe02\xf7\x95\t2\x91\xb9g\xea㘍\x1c\x15Ӫn:zTB\x1fI\x89\xa3\x1e;D\xa6\xfd촇}\x0e|\xbd\xfb\xbb\x9d/\xcad\x1e\x17\x91x\x1d\x17:\x17\xd9\a\xa1U\x91\xb5D\xf8k\x1cr\xd5\xfe\x8e\x17(\x9a=ث\x14\xe8\x98\\d\x13=Wiˡ\xcf\xcaW\xbdMa\x17\x14\xb9\xc2B\xc4|3\xf2\xc2]\x92\x1d\x9a\b\xaaL\xb4&B%E\x1c錄\xe3\xb2d\xe79<\x05\v\xa153\xb8\xdbRwK\x83\x8b\xa6S~$\x9a*\x8f\xc3S\xe5Lǈ\xe8\xab\x05\x91\x99\xe0\x98\xff\xc2j\xed'v\xc02K9\x93g\x83\x8d\x9b\xdbE\\(\xc5%\x18W/G \x1a\xe2\xb0#\x8c\xb6\xe7\x88\x1c\x81\xa6&\xaf\xb9\xcf\a\xb1R\xf9\xf4\x0e\x8a\x1c\x87\x1c\xc6P\x939\xaa8*9\xcd>\x87\v\xe8\"\xfd\x92\x10f\u008aǡ\xcb>\xbb\x83,\x1c\x8e2\x86\xef\xcc\xf59\xa2\xf8\xba\v_\x06\x0fc\xc6u\xc9G/\xf1_P\xdeH\xc0\xa4|9\x9bx\xa62\x17ij\x8b\xee\xdb\xef\x19\x88ȵq\x11e\xa6\x13\x9e\xea\x95\xca\xf5\x94U\x0e\x03\xb7=\xc9\x15z|\xb7\xe4IV\x97g\xabIy\xb2-\x97\xe9\xae\xd7vim\xc3\xd8\rx_\x00\xadi\xd2֭\x88\xc9f\xdbK\xe9\xef\xaaO\x1a:c\"\xe7\xe6\xebi\xfd/\x88G\xc8\x18\xa9Fp\xefG\xad\x9dC\x8d\xc0\x84\xb9\x88~\xb6\x1b\x19\x15<\xaeI\x94\n'\x94\xc8D\xd0$\x91q3\x10\xc3\xe3\xf2\xed\x1aN\x99K}\x9b\x86\xe0j_$\x9cn\xb5\xe0\xf8\xd8\xe4\xd7\xe6\x13;h\xdb}\xc1`\xce\xde1\xdba^\xda\xe1Ϊa8\x99\x1de\xaaw+Q{\x8a\xe4\xc5\xe5\xbb7M\x06\xda\xc3D\x8dE^\xeeY\x88=\xd2\xee/t\xb7iM\xdf.\v\x89\xaa\"4\xd29\xef\xc5\xd6$\xcb\xf2\xc4vbu h\x16\x90m\xd8u/LZ\x8ayo:\xeaw=q/\xf6D\xfej\xdb\xc5\xf7\xdce?\xed\x1b\xbf\xf0\x97\xb6\x1e\tfXF\xd7&\xf1\xb3\xeffv\xcfIu?\x0e#G.\xdb#0\x13\xe0?C~v/\xb6\xf0́N\xf0\xd7J\xa6PJ\xfb\xda\xee\"\xe9Z-\x1c\xb6\xfd\xe0\x1d\x03ܜ\xa0\xabd\xccީ\x1c\xff\xef\xedg\xa9s}\xa0\x9f\xf8\x1b%\xf4;\x95ӳ'\xa1\xc4,\xeaH\x84\x98\x87\x89A\x13#\xdbp\xa6\f|\xbf=J5\x16~\x7f\x9d\x90)\x92\x7f\x95@\xc8؝\xfb\xc6\xe7\xda\x02w\xb5a\xe8\xeaH\xaa\xdcA\xdf\x03\xd4}\x17\xd0-*UV\xc3WǇ\xf6\xc0\x9c\tf?O\xf1z\xb38҈i\xcc\xe7\"r-\x939\x14\x05\xcf\xc5R\xce\xd9Zd{G\xa9\xa7\x90Sݤ\xdb#I\x8e\xa6m\xb7\x16r\xff;\xe4\x86܋\xf6\xf7&\xfb\xc9\xdb\xdbI\xb1\xf2\x9e\x14\\\xeb\xeey亯\xde\x1c\x90O\a\xf0S\xe3\xeb\xcaG\xad\xa2\xe5)8\xfb\xef\x10\xa7\xc4(\xff`)\x97\x99\x9e\xb2K[5\xd2\xfa\xcd\xea\xf3ֺ\xaa\x82^\xf3\x14\xe0\x81\xf3\r\x8f!\xea!8\x12&b\xd1\x19\xe6T\x8b\x86\ntv\x19\x84\xa8\xbf\xfe:\xbb\x17۳q\xed\xe4u%+\x9e]%g\xbe\xa2\xa2~\x0e\x9c\x9e1\xad\xa0\xcf\xe8ogӆ\x12l\x05\xbbW1\xee\xe1\x88\xce?y3\xef\xb5J\x16\xb1\x9c\xe7\xed\t\xbd5J\xbek\x7f\ah\x7fp\xfa\xc6ڱ,RB\xb7\xdbL.\x89ƚ\xa92w\xefh\x97\x10\x81A\xa91\xee\x9b\xd0l\x18\xb6\x85%\xb7uw\xa7\xa3}!\xdek\x88\x86\xddGDR\xacw\xb76a\xd7-Rd¾\xe12n\xfc\xf2\x83\x98S\xca\xf9\xe8\xc8s\xe07xm\x8c\xe8W\xa3>Gm\xcf1k'\x8c\xfdZ\xed\x9cU=\xbc\x9a7\xdc\xfc\x1cϖ\"oy\xd2S\x15\x04\x9a\xb2\xcbdۀ\xdaޱ\xc0ٮ\xe5\x81M}\b\xd3\xc245\x11U@\xd6\xd5\xd2H\xbe¯\xa7\xc1<m\xd1p'\xd6)\xec\xb2W!\xb8s/Q \xac\xc0Ȇv\xb4\x8cZo\xef\xbc\x15\xa6\xad\xa1\x98\xa8\x9c\xdbY\xa6v[\r\xc4ɤ\xea 4\xe0\u07b6a\xdaȭ2)3\xb7\xab>ץq\xbb\x80'\x01\x0f\xaf\x012W\x8dm\x8fa*\x84ѡ\xb7\xdb\xe1V\xd8\xfc\xcb\x0em\xbc\x1b\x06^\xc9$<\xa2\xeafq\xdc\x1b|\xd8\x02\x93Y\x99n\tC\xa8c2'{\a.X\x1d\xa85\x94\x01\x1cyڎ\xd5[\xe1\xfa\xcf6\xc9v\x00?\xc7x\x01\xbb\xba\xa9\xfd\xa9\x1d\x9c=\xb2\x8b\x16\xee\xa6\x1da`\x9d⮍\x0e&\xc7\xe9P\x97m\x0f\xc8c\x9c\xb9cHy\x84S\xf7t\x8e\xdd!\xe7\ue02a\xa9\xfe8\x1c\x06l\xe3XGo/Dl\x80\xf1^\xce\xde\x01\xb8\xa0\xeeq\x0e_\x00\x9a\x0e9~\r$\x058\x7f{\x81\xd6]\xb4P\a\xf0\x00\xe8\x1d\xe7\xf38'\xf0\x00\xcc\xfaR\x8es\x04\x0f\x80\xdcq\x13\x0f9\x83G9\x84\x01\xb4\xdf\uf0b9\xff\xedw\x0e\xf7;\x88G8\x89{\xed\xa4\xe3WZq\xb0\xba\x16z\xbc\xd3x$\x0ek\xe7ⱜ\xc7'r Ot\";aJ\xfdT\x8e\xe4Ag\xf2\b\xce\xd9\xfbggG\xbd\x1a\x1d \xed\xb9\xb7\xb4\x89\xb0\xdf*\x869z/\xbd\x1d\x96\xa1>\x197\"*\x99\xd3\xc5K\v@ְ\xff\xa6\xec*\xc7X\xac2;\xa9\xeep\xa2\xba{\n\xe3w\xccL\xa8\xbf\x1dM\xd0\n\xd3\xcb\xd2z/)a\xde\xda}\x80-\x8adn\x9f\xec\x1eG\x8e\x1a͚\x97,\x17Ն\xf7\"r:\xdf'\xf5\x8a\xe9r\xca\xfe\x9a\x8b\x84'\xf9\xe4\xef\x7fo\x85jWtf\x9f\x92\xd1\x19\xfb\xc7?\xfe\xdaZ\x10\xbc\xe7\xf8u\t\xa4\x89\xb7\x8cGGr\x81ǵ\xbbu\xfc\x86nPt?\x1f\xb8\xddY\xab\x83vfb^\xbd\xd3\xdc{\x9d\t\xd7\x14Z\x8b\xf2\xb4\"\x9b\xc6W^\xe4\xec\xc4(\xc8\xe8\x92y\xc55\x98\x8e\xc2,@\xf1y\xe7\"\xb6\xed\xa1\x9d;\xdd}g\xe7>\xd2mԹ\xe9\xdd\xc61\xcfDr\x9e\xef\xdc1\xd6\xf78\x1d\x05\xebŃ\xb2\xfc\xa0\x03tH\x01\xc9d\a\x03G`-\xf8ʻ\x15$\xf3G\xb4\rW;7\xa2\xd6Qv\x90\xbb\x04\xaf7\xdd\x1dh\xbb=\x88u\xff\xcb\xe8\v$\xc4\x1ey\x7f\xcc\xe9\xf4\xfbk\x1cKs\bG\x1d\x87\xa5D\xbdC\x18rVR\x9e\xe5r^\xc4<\xabPd\f\xc1\xe9:\x0f-c5k\xc0t\xf1\x12\xbe\x84\xe6\xccK\x8a\xba0G\tl' \x03\xbd\xba=oIju\xe3\xe7MǤ&\xdfAE4ΰK\xdaÔ\xc9\x06\xc4rz\xac\x19\x87\xb6\x12\x18\x10\x96\x18=\xef\x91 \x1e\xa8\xf7\x8b\xfb\fa\x89\x97\xebo2\x10\r\xd7݈\x8cǄ\x1b\x17\x01\xa9\xbc\x83\xdbM\a\xd1\x1b\xe3\x96H\xc0j\x03d\xc9\xf6\x8d\xd9B{\x99\xad\x93\x95R\xd2י\x88.o\xae>\x89\xac5\xdeq\x9c\xc2\xe8<*{\x8fI7\xff\xd7X\xfc\xa6e\x99\xb0\x1c5[f\xaaH'\x9e,\xa6}\n\xf2>\xce\x1ed\xb4\x14\xb9\x9e\x8a\xcf\x1c\xa9u\x98\xe5~ּ\xf6\xb7\xadD/o\xae\xd8\xc6\x01\xaeD^\xf3\x95X3\x9e\x8f\xc1\x9c*ä\x13\xb5`\xa97r\xe8\x1a\xa1\x01\x93ZD9p\xa4 ε\xe9\x1cQcq2f\xb4\xc86\x95\"o\x13jo@\xacd\xaa\x98\xf0\x19&ZJm\x1d>\xfb!\xd4\xf7\xc3\xfeM*ce\x1db\x1a\x10W\x1c\x83\xe5\xecV`\xee\xb9\xdd?\x1a_\xd1\xceީHܨ,ׯ\x0e\x90\xb7\xfetK\xd2]\x85(*\xc6\xda\xed\xa3\xed\x01\xe1\xf6\xa8n\xcf\f\xb9L\xccU\x16\xbd^\xa1=\xe8\xfe\x8d|\xa8>ٵ\t<Ҹ\xb9ف\xcaX$m7\r\xc1\xe7+RD\xde\x1e2w\"\xd1ظ\xd80\xd53\xa6\xef%\xd5y\xcfĜ\x17Z\xb4u\x92\xab]\ue517\x03v\x01箣\x9f\xa9p\x1d[eA1j\xa3\x00H\x927\xa0be\x14\xf3\xabx\xfd\x10\xee\xb4At\xb7[\"\x87\x06\xb1L\xfa\x93͐\x9a\xa1\x94άA˿\xb5\bO\x83I\x18\x85s\x8bM\xb4\xbbC8q#2L\xd9A\x1fX\xbbt\x8a\x99\xaf\x91Ee\x17\xa3U\xdb\xfe\xa3C\xd5N\xbd\xd9Ü\xb1k\x15\xa1\x10+;\xc0!\xf5\x87\xab\xe5\x1c\x9c\xe1VP.\xafy\xda Ψ3\x06n\xb8$+b\xf4&]\xb0\xff\xbc}\xffΡz\\\r\xc5X\xd5\xe8\xc34\r\x88\xf5g\x11\xf8K1x\xd4JHB-\xfekk\xf5\x98M^\xcb;\xd4t\x97a\xb5\x17\xc9\xfb\xacy\x9e\xcao!\xec\x9b\x7f\xd9A\xf1\xe5\xcd\x15=袸\xa4\"|z\xb6\xa3\x16\x9b\tp\x97G\x7f\x87\x05x\xb5\xa8\xc1k\xa90\xf0\xffd\x7f\x94ITQ\xe3\xad\xf0\xb0\xa09\xec\th\x1cZٔ}\x83<\xa1dkKS\xf3\x95̢\t\xec\xad-1\x9d\x1e\xfb\x15\xb4B$\xdd`\x9c\xc8i\xa8\xfa\xbd\x97It\x10\x9f\xb4-\x8bK@\xab\x99\xf3\xbbX\f]AW\xb5im\x05\b\x1c8j\xba^ҏ\xb4\x82n\xff\x1b\xb8\x19\x1d\x91\xc3ީ\x03\xdd\no2\xa92\xd9\xc6ԭ\x92\xa1|\x9cd]&#\x9b\xe1f\xec\x0f\xf4\"D\xa4c\x8f\xdfS\xf3kj\xb7m\xa4eQicea\x89Ŵ\xfcj[%Y\xa1\x9b\xdc\xd5\xfb$\x13ۿϺ& ְ\xf2m\xf9\xac5\xc0\xaa\x87\x98$\x1eO\xca\xc3d\xaf6:\xba3\xe2n\x11ڔ\x0e\x9as\txV-\xadux\x83\x00\xe6q\xba\xe23\x91\xcb92K\xf1\xf1\xb6\x03F\x17u[\x16aбUV\xd0\xf7\xc9\xceBe\xc2\xfeC.WU\xeaf\xec;\xf5P\xfe\xa2\x15v\x8d\x96\xa3 \x0f\xb5\x95\xbbJ|2\xd9\xcaV\xb5U\xb7\xc2e\r\xa4\xd7\x19\xee\xce\xc9\xdcs]ٿ5^: \"o\x05\xf8\xa3\v\xd3*8\xeb\xe6\x95\x19\xd59希w\xe9ӵ\xd2n\xaa\xed\xe3\xd0\xfa\x01\xee|\xa0\x03ǻ\x9e\xb7uu)\xb3\xc2#m<\xea\x00\xc9\xd8\x01\x1f\xc6\xd9\x06\xfe8l\xcf+\b\xdb\x03V&\xed\x988\xc0G\ae\xe81\xee\xdc~i\xebī\xc7Y\xeb\xdf;%\xed\x11\xf2\xe8\xd0\xeaV\xb5\xb3\xf9jt\x80\xd4;G\xb9v\xd9_\x12\xbe\xf4Y\xc6]\xf6\x00\x11\xb1V\t\xe0^\xa7|\x13K\xea\xb8\xe3\x86t\x0f\xd5\x0e\xd0\xeb$d\xc5\xea!\x00W5!w:\xaa\x8c@\xa0H\b\xc4x\t\xe3\vB\xd0ZE\x87\xad\x9ak\x15\x91U\x83\xf6';\xfc4W\xeb\x99L\xaci_Uܣ}U\xaa-ʼ\xdex\xe02MEҪF\xda2\xf5\xf03\xb1\xef\xb4\xfe郹!\x1e\x05\xe1\xf6\xa0\xb9t\xd7^\xe1\xd9*i\xed\xb3\x0e\x8b\xb1J\x96F\xc3S\x9b9X\xa64\xe8\x7f\xcd[\xee\"\x1eVh~Y^>\xf8\xde\xe9\xe0\x992\x8e\x94\x15Ib\xfel\xf9\x93Tn\x03\x1a\xa7k(\xe8t\xf2\xce\xf1\x86s\x9a\xdd\xcd\x06\xde\x1bS`\x11QHw\xe4e\xdeB\xd59O\xe6\"\x8e\xa1\xfe\xecM$^\xc66M\xf8\x00\x7f\xd0~LU\x9b\x857\xeab\x12\xdfj\xe6\xd2\x0e\x9eP\v\xf6\x15\x8b\xa4\x06\xb3[#\xdf`u:\n8\x11\x9d\x14\xb7X\xbb\xf9\xa4\x0fQ\xd4>\xd6\x156!0\x14\xf8\xf7\x11қO\xcd}R\\֕f\xb1\x17\x1b\xc9mdM\x15Q\x9a\xa9\r\n//zl\xad\xc3\xf3/\xd6\xe2о\x8au\xe9$\xd6\xf6\x84\x98\x8d'\xae\xb5\x90\x1eD&:#7\x16\t>\\\xb8\xc6\xd0v\x04\xaf\x93\xdc2\x03\x18\x10\xae\xa3\xa4v\b\xe6w\rp\x0e\x97U\xbb\xc4Eݯʥ\xa0\xf9\x05\xce\x04\xf9X\"a\x91@\x81r\x13\x9c\xbf/\xb0\x89\xc2;\xf6\x1b\"\xff\x8f\x84o=\xe7\xb1x\xa3\x1e\x92\xef\xdd$\x93\xbd\xb8\xbfm<\xdeB\a\xbf\xd07\"\x8dն\xfd\n\x1f\x91\xdaۜ\xe7bQķ¦\xe2\xd1j\xd0Y\x91\xfd\r\xb5\xe0\x99Hc9\xe7\xda\xde!\x98K'\xe7\x17dE}'\xf8\xf1\xbd\xf0\xb7\xe7%\xb4\"E`+-\xb2TiA\xb6\xb4̘\xca\xe4\x92z\x84\xbbo\xd0\xf7\xefE\xda4\x83\xac\xc1\xb0\xa1\xfa\xf4\xa9T/ݻ\x93\xf2]\x7f}>e%f\xaa\xf9\xdf\r\xa8m\xf9\xe0\xeez\xd3.<R\x0f\x8fG效\x8aX\xbc\xe3\a\x0e\xd7m\xe5A\xe7\xce\x14\x89\xfcߢ\f\x13䫲X\xdf>\xbd\x03\x91Uŋ\xafDv\x8c\x12\x99\x14\x84?\xd0\xf1p߱\x97\x90\x16\xee\x83l\xe9Y^\x05\xd88\xab\xe5\x94B{\xee\\H\xd7=.\xb5_\xed\xf4XA\vi\x82$z\x11\x99\xc5^\xb5\x99>u\xf4\xb5\xbd\xd1&\xaaj\"jOEkM;\xd5f)\xda\x1e\xbf3>\xbf\xc7\x14\bS\xa2\x1c\x8bE\x8e\x86\xcf\r\x88\x96n\x16\x87D\x0f\xdf\xf1\xcai\xba\x9a\xd3c\xfbN\xb3\a\x9eAY?\x16\x1f\xde\xcb\xf4cb\xb2v}u\xf2A\x8c6\xde\xe8\xc0hY\xd3\xdcUslj\x9c\xc1Ŷ\xf8\x97\xf0\xbf[.=\xf6\xb7\x9d\xee{mev\xe6o>\xb3\xdbH%\xb3\xd0\x1a-:q߀\xd8I\v{:\fţm\xc2\xd7\b\xa0\xc4[Ą6\x12WU\"z$\nmD&\x17\xdb\x1be\xb7\xfe\x86\xe7|/}>5\x9fo\xa3\x8e\xc2\xe5\x9d\\\x98;9\x94\x8awqhZ\xe9/\xea\xf7O\xbc\x88\x7f\xc9y\xed\xde;\x92K\x81*H[\xe1Ф\xd1l\xeb\xce\x11\xbe\x89\x02\r\xb1\xccd\xbeei\\,e\xa2}XiKfBy\x9aȘkoUF\x1c\x03;@\x9b=ɹ\xed:Q7%K붵s{_\xf2Ԙ\xae\x932eCӎ\xca\x03\x87\xe2\xf6\xda\xfd\xf6;]<\xa9\x16;'m\xe7d\xd5\xea\x13\xacv\x03R[\"\xed\xcd\xea\x05\xb7(\xab\x06\x17\x94m\x85\x1b\xb8\xadɬ\x7f\xb4p\xe9n\x9ac\xf3\x89\x1d\\>r-\xc2n\x82\xe3\xfeD\xc6=\x1e\xf7)\xf5\a>\xfbr\xb4/\xf5{(\x17\x1f\xcaŇr\xf1\xa1\\|(\x17\x1f\xca\xc5\xff\x15\xca\xc5\xd1X\xee\x1b\x95y/\xfd\xd5h\x0f\t\xbf\xdfy\xb8f\xd9\xd2\xd5\x1e\xb2\tl\xd8ݚ\xaa\xee\xd9\x1d\xb8\xac\xea\x03\xd0*\xb4I\xa0\x80M?Wk\xfc\x8dGV\xcf\xe2\x0f.\xfa:6e\x03\xb2\x05Ad\x18`¾\r'\xb9E4\x82\x10\xc67\xa9~\x87n\xbc\xda\xc6mBoT\xcdX\xeb\xff\xe9zL\xd4\xed\xc3]2b?\x8ff\x9dE\\\xacU\x82\xa0ЫCg\xec\x8d\x7f\xb4\x16\xb0\xceU\xd9@\x90\x82\xd7\x0e3\x16v\vX\xea\xbfr\xae\x19\xdfpI\x11\x16\xccB\xb7\x97(\x80\x00zEB㪍%\xe5,d\x1bRhת\x80\xd0Ʒ{1sP\xccDeT\xed0~\xcag\x8fE\x90\x7f\xa3%\xe2\x8d\xff+\x11\xe4\xc3_\xadHr\x7f}|\x04\xe8J\xd8\xf0 \x06j1ƣP\xd0\x02\xb1\xfc\xa6\xe5\x12\x17<\xfe9\x10\xd0!\xdaڴ\xee\xc4z\xbf;\xbd\xe2[!`\x87E\r\x9fm\xb7\t@g\xa1ٜ\xa7y\x91Y\xc3\x7f^d\x19\\\f;\x05\x0e\xa9\f.@hq::|\xf0m\xb7N\xa9\x12\xdc@霯\x1bii\xb5\xf5\xbcn>o\xe5Vy\xe3R\x13UF\x81\xb5\xcd\xe5{\xe0\xda7\v\x8d\xa6\x15\xc8fZk\xf5\x8aHl0\x1c8q!8\v\xbbI\xe1\xbbʽ\x91\x87\x82K\":q\xb7\x98\xccꗭG탿ѫv\xd22\x12y/\xeft\xf2\r\x05!\xf4^\x94\xd2|=k\xab\xccѿ\x15\x8a\r\xb7C\xf4\xae\x9bpgU\n\x85K\x96\"\x01R[Ό\xb5]\xc5g1/\x00\xdd\x05\x0e\x1c\xc6\x10\x86g|\x8e&\xd3\x06\xbc\xbd\x00\xf0\x99B\r\xfev\\\xaa2\xdel\x8b\xd2=\xf2\xdcN\x13\xfc \xb8V\xc9\xde\xed\x7fS}Һ#\xb44\xeb-#\t\xd8X7\"\xc9e\x19\x9eہI\xc1o|uz,i\xd2\x15\xd7\xfb\xa3\xf27x\x82\xc9\xe6q\xf3\x01\x19{<G\x87\xef\xa0'\xec\x9dxh\xfc\x0e\x9b\x17\x119\x91m\x87d®\x92\x9bL-a-6\xfed\x0fL\x83\v&\xec\xc6ݛ}\xd3vm6a\x1d\xbf~\xed\xeej\x8fƠ]\xda~$ڇJ{T&欁?\xf9\f\xa1\xda\n\x8b\x9e\xeb\x92{w\xc0\x96\x1f\x9c\xa2\x1d\x9bpQ\aY\aI\xe3Ft>\x11\x8b\x85\xcarS\xfb2\x99\xa0\x8e\xc0H\xc7\x06Tp\r\x99\x1c\xa6Q5ef9\x1fЮ\x8a\xe4\a\xf2\xb23b\xd31\x9eY\xf3-\xfcY\x99\xf0\xf9\xbc\xc0q|\xa9s\u07bc\xe6\xe8m\x8f\x91\x99i\x19\xacթ\xab\xa1\xf9\xaa\xfa\xb4\xe3\xd9\xd2b\xaa\\̒\xe1jd@\xdc\xee\x11֬Z\\\x02.x\x9bo\xd8}\xfa\xf1C\xb3q[\xafn\x1ak\xbf\U000cfe85\xd3\xcb\xcd\xe5\xabjߟ\xae\x18\x9f\xd4\xeeEP\xc8\xe61\xe6\xabL\x15˕c\xb6.\x01\xd9\n2\xc2\x04y\xe5c\xd76\x02\x97\x17YRqamL.*\x97\xda\rr\x1f\xe2:\xcc\f\\\xd1\xe3&0:|\x19\xf6\xa1\xf2\xe0\x8eVi\xb9\xa2w\xcb\xdc=\xf4\xcc\xddDyecn\"]YF\xbe\x12\xd2TБ\"w\x17\xfbH\bIX\xc7H\f{\xdfڸ\f\x86\xfb\x9a\x88\a[Oצ\x90\xc2\x15\x90\xc9h\x88\xbe\xc9\xd4\xfa\x00\xb6\xfcs\xbb\x89\xd9\x15\xbe\xb0^z\u05ed\xb2áE.)i_ǂ˗2\xc8?\x86 B\xaa\f~Q\xac!eT\"\xa6Ǌ\\]\xb3a\xf6\xee\xacn\xee\x1ci\xa5\x81\x98;@\xedG]B\xec\x97e_\x15\x89\xad\xcc9|.>\xd6\x1e\xdd\x7f2v*\x8c\xba\x92\x13|\x02A\x12\x99\xc3\xe4\xb3P\xb8\xf6w0\xe6\x9a\xd8V\xa5\x8d!N\xf1hr\u07b4\xb2le\x12rW\xcem\xd8fn\a0-\xecU\x12\x15\t\x19f\xa4\xb5\x81\x7f\xcal9j\xa7\xd7\\\xac\xb6#\x18\xae\x16\xa6\xf4+\xa2f]<\x8a\xae\xa5F\xaa\xd8\x1f\xc5V\xe37\xc8ҧ\tM\xf4\v|җ\xf14\x99\x02\x87\xf6\x16]\xed\xf2Js\x03\xc3\xff\x8fs\x827\xde*z{؈.M\xa8\xaa9틶aN\x97\xf0\x9c\xe9\xfbB6;\xd4SQ\xee\x1c\x8cx1:*\x8a\xdbɚG\xb1t3p\xea\"?{\xb7\xfb\xbd}h\x87\x8b\xb1M\xfb\xfe\xd3\xf9\rn\x81u27@\xf6%\xfb\x83\x8d\xa1}\x10<\u0080\x8b\x03\x88\xd8}\xda\tqHט\x90\x82\x88\v\x10R\xa9\x9b\xeaPx\x16\x96nF\a\xe5b\xc7FEDr\xba\x1b\xd4l@D\xf6\x8bx\xbc\b]\xa2r`\xa5\xf5\xa6\xae\x86\x95w\xf6A\xca\xe4\xf6\xf8؉\x94\xda\x18\xa5\x8c\xca e\v\\f\x03\x976\xc9Ө};\xbbdwc{NI\xe7\x12\x1d\xf2\x98\xac\xdcݗ\xab\xac.\xb2\x15(\xabQ\xaamE\xfbqj1ے\xa0յ\xf0J\x8a\x96[\xe5\xb9nmpx\x94\x90h\x94A\x06\xac\x83\x9e\xefXLg[\xc1\xa3W\x94\xb5:\xef\x1d˱\xfe{9\xc2\xf5a\xb5\xad-\v\x96\x058\xad݂*\xffG\xa9!\b\xe8Y&c\"\xe6iK\xd1V\xe0V\x8c\x8a<z3V\xa36Q\xeb \xd9\xd6:ge\xe0VOy\x9a\xea\xb3\x13\xd6\xd9\x16K<P\xb3W\xfd\x13Q\xbc\xe3\xefn٭\x7f\xee\xf49\x8e\x90W\xfbT\x99\x97\x1e\xafF\a\x11\x0e\x19c\xb1]\xba}]B\vN\xc8>i\xd5F\x83n\x8dӉ\x80\x96_\xef\xfc\xcaZu\xaf\xd8\xe6\xeb\xf2_$\xfe\fI\xec\x1fpˁ\xee\b\x15\fZ\xbdh\x7fSF\x81\xf9|.\xd2\xdc\x0e\xb1y5\xf2\xb5\xadn\xd6b\x1a\x17\x19\x8f\xed?\xe7*1W\xa8\xfa\x15\xfb\xe1/#fձ\xef\x7f\xc1~\xf8\xcb\xe8\xff\x06\x00\a\b\xa2d\x92$\x02\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[s\xdb8\xd6\xe0\xbb~\xc5Y\uf0ffo\xcab\xbak\xf6a\xcbo\xe9tz\xd7\u0557\xb8\xe2L\xe6aj\x1e \x12\x92\xb0&\x01\x0e\x00\xdaQo\xed\x7f\xdf:\xb8\xf1\"\x90\x04e\xa7\xbfL\x17\xa5Tu\x9b\x02\x0f\x0f\xce\r\xe7\x06p\xb3\xddn7\xa4f\x9f\xa9TL\xf0[ 5\xa3_4\xe5\xf8\x97\xca\x1e\xff\xa7ʘx\xf3\xf4\xfd\x8ej\xf2\xfd\xe6\x91\xf1\xe2\x16\xde5J\x8b\xea#U\xa2\x919\xfd\x91\xee\x19g\x9a\t\xbe\xa9\xa8&\x05\xd1\xe4v\x03@8\x17\x9a\xe0e\x85\x7f\x02\xe4\x82k)ʒ\xca\xed\x81\xf2\xec\xb1\xd9\xd1]\xc3ʂJ\xf3\x04\xff\xfc\xa7ﲿf\xdfm\x00rI\xcd\xed\x9fXE\x95&U}\v\xbc)\xcb\r\x00'\x15\xbd\x05\x95\x1fiєTeO\xb4\xa4RdLlTMs|\xdaA\x8a\xa6\xbe\x85\xf6\a{\x93\xc3\xc4\xce\xe2\xc1\xddo.\x95L\xe9\x9f{\x97\x7faJ\x9b\x9f겑\xa4\xec<\xcf\\U\x8c\x1f\x9a\x92\xc8\xf6\xfa\x06\xa0\x96TQ\xf9D\xff\xc6\x1f\xb9x\xe6?1Z\x16\xea\x16\xf6\xa4Tt\x03\xa0rQ\xd3[\xf8\x8dTT\xd5$\xa7\xc5\x06\xe0\x89\x94\xac0\U000f4e09\x9a\xf2\xb7\xf7w\x9f\xff\x8a\xe8U\x86\x92x\xb9\xa0*\x97\xac6\xe3\x02\x8a\xc0\x14\x10\xf8l&\tұ\x03\xf4\x91h\x90\xd4\xe0\xc25\x8e\xa8%\xddz,\v\x10\xd2\xc1\x04\xa8\xa9d\xa2`9\xfc@\xf2Ǧ\xb6\xb7\xaa\xa3h\xca\x02v\x14d\xc337\xb6\x96\xa2\xa6R3OB\xfcv\xa4&\\\x1b`z\x8dS\xb1c\xa0@9\xa1\n\xf4\x91\u0093\xbdF\vC\xbd\x8a\x80\u0603>2\xd5\xe2mH\xd2\x01\v8\x84p\x10\xbb\xffCs\x9d\xc1\x03\xd2Y*\x8fm.\xf8\x13\x958\xef\\\x1c8\xfb=@V\xa0\x85ydI4U\xba\a\x91qM%'%2\xa1\xa17@x\x01\x159\x81\xa4\xf8\fhx\a\x9a\x19\xa22\xf8UH\n\x8c\xef\xc5-\x1c\xb5\xae\xd5\xed\x9b7\a\xa6\xbd\x9e䢪\x1a\xce\xf4鍑v\xb6k\xb4\x90\xeaMA\x9fh\xf9F\xb1Ö\xc8\xfc\xc84\xcdu#\xe9\x1bR\xb3\xadA\x9c\xe3dUV\x15\xff\xddsQ]w0\xd5'\x14\x1b\xa5%\xe3\x87p\xd9\b\xf1(\xddQ\x96\xadx\xd8\xdb\xec\x14[\xf22~0T\xf9\xf8\xfe\xe1SWt\x98\xea\x80\x04G\xed\xf66\xd5\x12\x1e\t\xc5\xf8\x9eJ˸\xbd\x14\x95\x81HyQ\vƵ\xf9#/\x19\xe5}\xa2\xabfW1\x8d\x9c\xfeWC\x95F\xfed\xf0\xceX\v\x94\xb9\xa6.\x88\xa6E\x06w\x1cޑ\x8a\x96\uf222_\x9d\xecHa\xb5E\x92\xce\x13\xbek\xe4\xfc\a\xef\xbfu\xd4\n\x97\xbd1\x8ar\xc8\xeb\xf0CM\xf3\x9ej\xe0]l\xcfr\xa3\x00\xb0\x17\xb2U\xf1\x8e\xa5\x01\x18\xd7K\xfc\xee\x8cB\xbf\r6\xf8\x13\xadj\xa3\x01\xfda\x00\xa4(\x8c\xed&\xe5\xfd\b\xa8QBDf\xf5\xc3\xd8c\xa1\"\xb5\x82\xff%@\x87+F\x9f\xfd\xc0\xb3'>\xd2\x13\x8a\xc6\xd9-\xfaH\x99\xb4Ҭn\xa0d\x8f\xd4\x19\xaf_Ȏ\x96\xed\xf3\n\xe1\fu\xf7\x8b\xd4,q\x9c\xca\x06\xbf\xe1\xcaBv%\xbd\x05-\x1b\xba\x89\xcd~\xc0ݖ\xca\xfd'\xff\x11\x04\x1e\xcc5J[3OOƳ\xe7M\x93\xf5\xf9\xc8\xf2#\x10IAR^PI\vxf\xfah\xe5\x93T\x14P\xfe\xcf`\x12\xe5\xd0\xc3\x05\xcec\a\x82;\x03l\x89\xa5\xec\xbaN\v؝\xac\xe5\xf0\x9a\x90\xc1\xa7#=\x9dA\xd5\xe4\x91\xe2\u009aӂ\xf2\x9c\x82x2&\x87\x06m\xb8V \x9e\xb9\xe3k\x17\xf7\\Ԍ\x16\xb1٣\xfd\xf1\xe8\x10\xb3\"\x9dp\xb6v\x05\xc8\t\xbf֠\xa8v+\x95s!\xde\xf8\xe7mѓ8\x03i\x1e\xff\x9aR\xd5%\xe2\xed\xbcH\xf4hn\f\x7f\x87\xc5va\xc7\xe9 \xee\x9e\xe1\x03\xa00ˡ\xbeD\xa0\xc5\xcf\xe0NCN84\x8aFAv\x98\x84\x8f\x06\xa2 \xf3\xe0\x10\xe5\x1b\xbc\v4\xabhGF\x80\xb58\x90s-\u0382Gh\xef6.\x97\xbcV\x90\x97\x8d\xd2T\xb6Ozg/\xe0\x83\fk\xfbbs\x06\xd8\xf0\xd0HDf4Le\xf0#ݓ\xa6\xd4\xc1\x8b\x18\xceg/\xcaR<{Z\x9d\xcf_{T\xb3M\xa2\xc2\xe7\x84\xe7\xb4\xfc\xd8p\xce\xf8\xe1\x03\xbf'\x8d\x9a\xe6\xff\xbb\xc8\r~\x15\xa1\n\x9e\x8fT\x1f\xa9\x84\x9a4ʯ\xfa~\x16\x03\xb0\xfeᪧ\xafL\x83$܊\x10\n\x80Ҭ,\x81q\xa8\xa58H\xaaT\x06\x1f\xf0\t\xcf\xcc\xca\xc0\xe9Z\x9e\x03.\xe9^#\r1TP\xc781vB\x94\x94\xf4\x97\x02Ě\x16\x93\xf37\x13.\"3\xee\xce\x14E\xca\xc2\xca\xdc\r\xa3\xa2\x8ak\aZ\x00\xd9pO\x83t|=\x90I\x8c\x83>\x19=}'\x05\a\xfa\x05\xfd\xf5\xd6OFN=\x1f)G\x9a!\"1ٲ\xc66Y\xb0\xd4#\xab慠\x16\x8chZ\x9e\xa61쏍\x10\x978\xda@Ŕ\xc2\xf5\xe1\xc8\"\xf2\xd4c\xc13\xf1<@n :\xb5\xb9\x91r`\xfa\x1a=B\xd5T\xb4\xb8\x01I\x1c\xff\x06\xc4\xc5\x7fH\f\xc9\x0eG\r䙜\x06\n*\x1bz\x81\t\x8e\xf1\xd1[\xceI*u\xed-δ\b\x91\xb0\xb3\xb0\x8eE\x88\x9b\r\xa7@\xc4YYK\xf1\xc4\nZ\x8ci昛\x87\xdf\\T^v\xce\x7f\x1c`\xfc\xae\x1d\xeb\x91&\xe5AH\xa6\x8f\x15\xdap\\-\x03\xc0\x8e\x15\x88\xc0\x05\xd0D\xeeHYF\x8c\xa47ȅ\xb5\x9e^T:\x98\x0eل_ʛ*6\x83-\x1c~gu\xf4\x87ߕ.\xa2?\x94\xbf\xff\x8f\xe8u.\xf89\xf5'\x94\x06\xff\xb9Y|\x16eSQ\xf5I|\xa4J\xb3\x9eg\x1f\xa5\xf5\x8f\xd1\xdb\"\xaa$\xdd\x0f&\x92\x8d@\x05\x13\x179\xe6\x18w((\x1f\xfa\xd0e\t\xb5(\xe0\xc9>\a\x17\"\x87p\x8c\xc6\xe3\x12\x8f_\xfa%/\x9b\x82:\xcc}\x82G\xcdN\xf5}\xfc>\x0f\xcf\n\x9a\xf5\x9f\xf1\xff\x89\x86\x9f\x9b\x1d\x95\x9cꈓ\x8e\xff\xec\xea\xafP]\xd0W\x13\xcf\xfc\x06T\x83>\xa9\x02J\xf2\xa3Y|M\x0e\xa5#e\xe8\a\xb0\x9c\x02\xc9s\xd1p\x1d\x05\x8c^\x00f\x9e\xb6R\b\xbd\xcdI\x96K\x8d\x99\xa9=;`\x88r\x03\r/\xbd\xe83M+س\x12]\n\xc6\xcd\f\xe3\xd8\xea#\xadЂ\x97,g\xba<\x19G6L\xd7Ѡ0\xce\x13z\x95\xbbSGIb<\x9a4Y\xc9L,\xda(,\x95\x7f\x9d[Z\xd6\xf9y\xe4D\xca\x13.K\x04*\xa2\xf3cLS\x00\xbay\xbf6'`\xa5\xf5\x06$=\x10Y\x18\x02;\x03\xe9\xe8Z\x18\xf7\xccc\x1e\x85\x1b8\xae\xccؐ(\xc9\xe0n\x0f\x9c\x957\xc0E@\x16i\xed\xa1\xa1F\xb4H]D\xf0)\xf3\xeb\x82\xd5\xf8\x0f\x03:\xffLO\xde\xec>ғ_$\xa6\x91k\x19>b\x9e\xf0\x9f\tܒP\xf8\x8c#=\x12\xe6\xb6\x01\x0eP5JÑ<QCYZ\xd5\xfat3\x02\xd9'\x88T\x1b\x1ev\x01\xa1\x98\fx\x8e\xdal\x9ez\xe1T1k\xc4\xe4\xb9G\x88\xdf-F\xbb\x91룁VW[\\\xae6r{Z\x00\x8f_4\x18\xea\xf6\xb2\x89\xf9\x01DJr\xda\xcc0\xd1\xeb\xabE\x1a\r\x97\xb2)\xefmP\x8b\xd6^^բPWݴo\xf7sUк\x14\xa7\xca$\xf7H]\xab\xab\x1b\\\xc6\xf7\x16rp\xfa%\xadē\v\xfa\x8c\xc0\xf8\aE¨\xae\\\xec\xe8^\xc8\x10\x16`\xb6\xc9-c\xc1*d\xe0f\x81:[\b\xbdU\xb4&\x123\x04Q\xc05\xd1\xc7\xee\xe4\x94&\xba1Ӄ+\x9f\x99\xcb*\xc2\xc9\xc1\x93\xe7\xca\xda㫿\\\x8d\xc8\af\xb2\xeb\x92a\xfeM\x98\xe54\x10\xf1\"c\x91$n\xa1\x06\xa0nS\x99\xddނ\v\x96&\x8cc\xf4\x80\x85\v4$\x1d\xf3\x88L\x8b\x00\x05\xc3HL\xb3\x06\xa3\xcbx\x97\x11\x9bE\x12=#ωd\x8a\x8b\xbb\xa7҇gN%\xa6\xb2ө\xd4\xder\xbe\x84!a\x8ce3\x85\x04\x1c\x18\x81\n \xe9\x9eJ\x9bkڃ\xe0\xd4\xd9iE\xc1$\x88[\xe1C\xc52pL\xf8\xff\x91\xd6%\xcb\xc9\x03\xd5c^B\xd0%\x9fܰ\xae\x00\x93\x06\x884\xee\x0e:\x83B\xd2\f̴\xcd\xf8\xbd\x90\x15\xd1c\nA\x14\\\xe1\xd8\xcc\x18\x80\xab\x8ej\xb4\by\xc5\x16Ҏ\xbd2y\xe5X \x82\xdf\x1c5\xf6\xed\xfd\x9d5)\x19|\xe0\xe5)\xd0P\xec[\xf1\tz\xd2[o\xe3\x8b\x05\xaeن^f\xa5\b\xce*\xc9\x1fi\x01M\x8d\x04t~0\xc2\"\xe539)x\xa4\xb5\xfe\x06\xc5r\xb1c\\\xb4.\xb1\t\xf9U\x89~\xaa\xd8\a\n\xba\xbcܿ\xbf\xe6\x1e\x85x\x9c'\xcb\xff\xc6QmU\trSP\x86\x1d=\x92'&\xa4\x1a\x16\"\xe9\x17\x9a7\xa3\n\xa0\xa1`{\xa3\xb2\x1a\xea#Q!\xc19A\x9e9\x8f\xce\xde\x19\xffm0\x19\x17\xe3\xa3ؚٷ\x8a\xee\xd1\x06\x81Ƥ\xa6ҁ\x1dw\xa7:\x99\x0f\xa3\xa2&\xd6\xf1\xee6\x86yf-c\x12\xea\xf0\xb4\xee\x83F\xe1\xbae\x98\xf0\xe0tZL\xae1\xbdG+e\x04,(\xe3\x8dO\x96\xb2\xb8\x81\xc4o-\xd0K\xb4\x18\xec1B\u0085\xd3®\xac\x8d\xddQ:\xea\xd0NH\xe7\b}\x7f\xc1Z\x1e\xcaM\xaf`f\xac\xb3\x84\n-\xd6`\\\xdc\b\x0fL\xf1\x18\x87\xc6\xf0\x9e\x17\x1c\xa7Cء0\xf1\xfb`\x8a\xb8\xb4{\x9f\x1c-A(\x87\xa3@\x8d㒠\xcc\xfe\x8b\xecZ\x80нP\x1a\x89\xed\xec\x95w2\x86$\x8e\xd5V\xfa\x1fG\xe03\x19\x99\x94\xbf\xe9\x19\x03\xaa\xc0\xe9\xba#\xf6@\x9f0\x87\xd8\x05\x8cx\xdbd62WB<\xed\xd3\xfd\xba\xe0\xa4U\xac=a\xa5\xba\xc1\x95\xb4\x14\x18\xf6\"{0oY\xd3\xfc\xba3n\x06\xec3Ű_\x13\x89E\xedɱ3:\x11\xe1Ҁ\x1dA+|zh[\xa2\xd2\xcc@\xb46;\x83\xf7_H\xaeq\xa1G\x95\xda\xc3\xfb/47f\xe0\xbel\x0e\xccE\x85\xbbP\x9e\x9e\x9bL\xaa\xa2\xb4R2?j0\xfb\xf7_:\x86\x80\x98Y\xa0\xe1\xd4^,\x14\x90\xcd$4\xf7\xc5\xe6\x01\x9c(\xe3@\xbcgM%Ҁ\x18\x8b\x9b\x00dv\xc9|\tq:8\xa6\r\x1e\xd0靟\x1f\n0\xf5\xa0\fo\x89<4&\xf2K\x84\v\x18!9\xf2f\x9b\xa4\x1b\xa6\x1c\x91\x17س\xee\xb7b\xfc\xce<\x04\xbeO\xbccʃ\x89}\x82T\\\xc8\x00/S\x81\x05\xe1B\xbc\x1c0\xf6\xc1<\xef\xf3\x11-J\x97\x93\xe7~R*o\x003<\x18\x11\x06\xad\xb6\x15\xd5Z\x14\xd7\n\xf6L*\xdd\"\x9b\f\x93)SJ\xc86_\x89\xe3\x01\xa3\xbb\x8a\x1c\xe8m\xd2=c,1 P5\b\x1cJ\xb13!R\x9a\xd9\xc0\xaf\xa4\xa6\a\xb0[\xbdc\xb8\x8c\xd8\xf5aϾ\xf8Ɖ+I\x0f\xf4\xcb\xed\xd5\xcd&\t.@ȱ\x1a~0\x83\xa5[9\xbf%\xe9Ѧ\x1e\xa1\xce\xfa3\x02}\xad+\x89\x14I\x06J8P)\x85\xc4\x05\x9d\x8bV\xfe\xd0W5t0\xa4A\xe7On\x92\x00\xa2H\ueb4fh\x1ckt\x1a\xb1=($\xfb\xfb\xd2\xf0\x13\x8a\xfd\xaf\xf8\x8ct\xf0*Z{\xfcJ\x12\xdf\"\xf8\n\xb2\xdf\x02\x03EK\f\xf1\x13a\xa2\x17M\x9d\x8dp\x92i\xcdF@\x16{\x0f\x84rқ\f\xd5s\xb7\x8f&\n.\xef\xf30\x19\"\xf2z\x19k\xc6J+\x93u\x89\x8b\x98\x11\x92z~m\b\xe0\x9c\xa3\x9c\bԮ\r]\xbdf*(4\xb0\xd1@\xecŢ)\xf8{T\u058b&\xff\xc1\xde\x1bV\x1f\x05G\xf1\x1c\x9a\x1d\xc7ˡ\xb1\x8f\xc9\x1dPTt\xa6\x81rS\x01\xc46\xd5`M,1R\xa7\x85\xdf\xc4\bl\xbe\x80\x1d\xfbl\x8d\x1e2\x9e\xe4.\xe2\xbf-\xfcDX\xb9ID})\x1bkQ<\x18\xf5\xbf\x90\x95\xf7\xed\xfdގx\x93\xb0H\x8a_&\xbdK\xdd\xea`oއ\x05|\xc1\x9d\x03\x12\f\x01\xb5\xa1\xf3\x02\x88жn*OOW\xf32\x8e\xba\xc9\xff\xf4\xae,\x02\x8ea\xf6\xdb\xdf~\\\xb2\xc6'\x06\xa6\x13\x84y;1\xa1EP\xc1eO=\x1c\x13\xed\xb9\xe5ƕ\x15U\xba\x87\xe5(\x82E!\xeb\xa5\xe0\xb2RSI\x02hI\xb1\xbb'}A\xf4f\xc3Vw\t\x0f\xbb\x06\x16A\xb8D\x88g\xcbЉ\xaczl\vԡ\x7fx1D\x97_C:\x04\x96\xb7\x15\xb6l\xb3\x18\xdaRc\xd6~<?_H\x96 \x16\xedF\x88\xc4\xe4B\xff\xfbHO\xa6ϭ4\x95vud5\x06\xd4(\xd1\x1a\xf5\xfe\x12i\xb1\xdfϸ\x8b(\xcc֦\xd3\xee\xf8\r\xfc&4\xfe\xe7\xfd\x17\xa6\x16Z\n\x83\xafQ\x8b\x1f\x05U\xbf\tm`\xfc\xa1̳\xe4x!\xeb,\x10c8\xb8-\xeb\x80\xd8/\x06\tn\x06\x9eE\x187\xa3|\a\xc1\x18\xec\x9bI\xfb\xdeq\f7\x1d\x8fB?\x86rhb\xc6\xed\x02\xa0;\n\\\xf0\xad\xe9\xdbx%<\r\xeb1\xde\xea\xc9B\x17\xe5\v\x80\xb6\x934\x99\v\x8b\xee't\xb9\xd2\xf32\xfd\x8f\xdd>V\xe2\xc6:(\x1a\x148,\xb5i\xec%8\xb0\x1c**\x17\x84!\xed\xb7\xc6u}\xb9\xe0_\xb0j\xbeXc\x96g\xb6\xfcg\xaa\xaff\xfc3\xd6q3\xfe\xd9\x06Q\\t\xdbdO\xc5\xebRøq\xb6\xfd\x7f\t1һ\x84^\x99\xef=k\xd7Aޘ<l\r\u0095\xe5\xff\xa2\x93cT\xf5\xff-©&L\xaa\f\xde\x02n\x1d(i\x17\x8e\xcb>u\xe9\xb5\b4b\x86^\xfe\xbf\x1a\xf6DJ\x8a\x1b\x06\x05\xf6e\xd0\xd28\x86\x88\xf5У^\xe6\xdb\xd9\xe4\x03z4\xa6\x99\t\xe9q\xf5HOW7=\x8b\xb8\b$\x82\xb8\xe3W\xa1>\xda7\xd8\xde\x13]\x04R`sŕ\x81\xe3\x1a\x95:ޱ\xba\xcca\xbf@[\x16߂\x1b[D\xa3o\x93\x06\x0f\xa4\x14\xf7\xef\x88F\x87\xe2\rR\xb2\"_X\xd5T@\xaa\xd1\xde\xdd\xd8\x17\x93$\xb8y\xa8\x974\x80g´oqq\x85!\xb1I\x82\xe7\xfa\xe9K\xaa\xa9\xef]\xcb\x05W\xac\xa0\xd2'c]\"!\xb2gq\xecKL-\xb1\x91_+A\xb8\xc4xo}\x82(il\xc8F%\x8d\xee$\x116\xaf,r\xb5\xa9B\xden\x16J\x9a+^ƪ\x84\x8c?\x89\xc7Dυ\xb8\xca7\x96\xc4\xdf\xe6h\xe6\x1dB\xdfDqp\xbe\xd9`\x84:\xf1\xb6\x03z6\xd9Dذ\x80(\x7f\x92\\#R\xcbN\x1a$Ս\xe4m\xc2\x11}\xefd\x88X \x89\x15⌸\xa2\xf1\xe8n\xc9\xfbӧ#\x97ٳ\xe8v\xdc\x17\x99\x9b\xe4\xa1i\xfee-gԳ'\xa8\xf7\x92\xbej\xe7͂֯\x19\x88s\x92\x97\x14\xf1\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,k\x03\xcb\xda\xc0\xb26\xb0\xac\r,\x7ft\x03\xcb\xfcdf\xa6\x90\x80M\x92\x89\x99C6\x1cGv\xbbIЧ\xf6H\xae\xc1\xc9M\xe7\xf5DS\xbb\x19\x81i\x8f\x8a\xc2\xc8\x1a\x0f\x9d\xe1\x05{bEC\xf0|t\xa5\xf1\xfc\x19s\x16\x15\t\xb8e\x9b\x8b\x82\xad\x1e\xe6֔{\xfc\x13\xceD\xea\x0e\x9dZ/Ʀ\xbf#x\xf8\xb0=\xba\x1a\xa4=\"\xdd<\xac0>JXc'\xfd\xdb\xc0\x1d\x9b\xab\xecgT\xb3\xcd\xcb֒\xf6\xd4Q\xbf~O\x8d\x1e\xd0\xf3\xed\xd9\xcd7Xo\xedX2{\b\xf0\xdcR\xdb=\x16\x13\x13\x85\xeeU\x14\xa1\ncLwH$\xfa\xb3\xfff\x80\xb63S\xeem\a\xb8\xce=\x93\x13\x98(\xc9clj\x84\xa90\xfd\xab2\xf00\xd6n\xc0e\xb9љ\x83-\x7fvP\x98\x01l\xb6`#\x92\a\x86'2\xb9\x84\xb6\xe179\v\x86\x16d\xd1\xc3\xe9\xb2>\x91\xe3b\x1f\x87\xb1\xea\x92ݟ\xf18\x03\x92\xf0μ\xec!\x1dx:\x9aA\xd9\xd6(\\h\xe4\x13<\xe6E]3@\raݍ\xd9\xe6\x95|\xaatojH\xe1\xb9\xf1\x03=\x18\xaf\xff\x9c\xd7t\xd2\x17\xc1\x91\xaaϒ\xc009\x13\xd5W\xeb\x01\xca\xdd\xc7\xf7\xeb0\x9b\xe4\x92\xc0\xb0V3U}I\x82\xea*4\x17\xd6\\\xd2Ecq}e\xb6\xaar^#I\x84\xdc=n7e\x92\x17\xf8_\xcb\xeb&\xbd\xe9F\xab%\x91\xdaG\"l\x88\xd5H\xc6*\x1e\xc90{\x95\x91\x8b\xeb\x1c\x8b\t\xbb\xac\xa6\xd1#k\xb4\x92ួ@\xe8]\xe9\xe1\xac.0V\x8d\xd8\\\x96\xd0\x7f\x9d\x1a\x84_\xb0\xc6+\x0f\x9d\xc7&C\x8d\xd5\x1b\xa2Ճd\x88\x83*ò\x9aA\xb2}\xbeP\xe6\xe6]\xff\xfeg>bY^\x01X\x94\xf7O\x8e\xbf\x96ͭ\xe3\xab\xddn\xbeV>\x7f\x11wz\xfa\x9d\x90\xbbw\xf9\xf8\x044\x123\xf6\xe7Y\xf8\x04\xd8\xf3y\xfaa\xee=\x01h<;?\x9dqO\x00\x1b<\x8e\xd7˳'Kg\xe2@\x7f,vh\x05\x9c\x91\xb3\xe8\t\xd9\xed\xcd!\xf0\x1a\xf4\x13\xce\x19ݱ\x80\xcbDF\x98\x9aB\xb7\xe3\x94m^lɒ5d\x81\x93\x9ff\x04\x92\x0e \x9f!t\xb8w@\xe7\x10B\xaddf|(\x93\v\xe8|ǿ\xb6@;\xf7\xb9\xf3z\x16l\x19uW\xe7a\xe2A\xdd-\x0e\x7f\nF]\xa2\x0fw\xc3{_Y\x1f^\x81K\x01\x85\x7fk&\x95\xdd<\xd5\x02\x06\xf5\xf2[#\x19\xb9\x84\xd6\xdc@\xc4YN\xbd\x16Yք͚\xb0Y\x136k\xc2fMج\t\x9b5a\xb3&lք͚\xb0\xf9\xa6\x126\xf3\xfdV\t]V΅\xce6\xaf \x9b\xaf\xf9v\xa2\xd4f\a'W\xfd\x17\x14\xe1\xbb\xf7\u009eu|\xfd/\xe3ݾ%䢚\v8z\xef\x95j\xdf{t\xd5\xea\xb7\xcd\x7f\\ٗi\xe2\xff\xcfA4MlVdj)r\xaafO'H\xb2\xf0=\xa2\x9eSo\xd8u\xb8O:Z\xc0\xc7[\xd9\xe6\xf5\\\xe1W8^\x05_\xb1O\xf3\xe4ݰK\x1duד\x9a6x=\xdfd=\xdfd=\xdfd=\xdfd=\xdfd=\xdfd=\xdf\xe4k\x9fo\xb2\x1e\xf0\xf1\xa78\xe0cݯ\xf5\xe7߯\x95\x1c覣\xb05j\xb5y\xa5\xe7\xfe\xd1\a~^\x18\xcc֒\t\x89I\xec\x99xv\x06\xa2\x89v\xfb\xf1\xac\x13Ql\x95\x1e\thg`\xe2\xc85\xa0]\x03\xda5\xa0]\x03\xda5\xa0]\x03\xda5\xa0]\x03\xda5\xa0]\x03\xda5\xa0]\x03\xda5\xa0\xfd&\x03\xda\x7f\x93\x03\x00&\x9f\xe2:\x85\xdf\xde\xdf=P\xf9\xc4FZ\x85c\r\u009d[:V\xf3\xf9H\xf5\x91Z\xf5\xe8\x8c،6\"Jz`\xcaԂ\x0f\aI\x0f\x04\x83\xe9\xb7\xf7w\xa0\xa8|\xc2,`\xeb\x14\xf9\xa6\xe6)g\xcbף\xff\x8eIW\xa4\xd8\xcd\x10\x13\xb7\x12\"x\x96\x9b\x02\n\xc3\x1d\xcf\x01z\x14lh\v\xef\xbc\x14\x04\x9a\x1a(\xee\xe6f{\xc8\xcb\x06\xe7\xb0U\xb9\xa8i\x11\"cS\x99\xe6\xd7#=l\x06\xc7=)\x15\xbd\xc1rA\x17\xc7\xdeS\xdcl\x1a\xae\xa8\xbe\xe9\x0e\x8bB%\xb2C)\x93<u\xff_\xb2G\xf7\xb6\x13à\x11\x94\xb3\xcd\x0528\xbdT;l\xde\xd9\a\xfa\xbcC\xb2\x9c\r\xef\x8b\b[\x7f.\x9b\xa9dET\xa0О{[l6\xb1Χ\x83^\x87&\x1fM\xd3lq)iFn?\xa7P\x04\x1e\xfa\x14t\\p%\xddS\x89QD\xe1\x0eOh\xe5qZC:@n@5\xf9\x11\x88]\x861\xe5\x86\xeec^\x12e\x0f\xff\x88\x02\xaa\xa9Th\x10\xb8\x86'Q6\x95\xb9\x81U d\x17a\x90\xa2\xa4\xee\x04\x11Q\xc6\xe8\x0f\xb0c\xbc`\xfcp3nB\x1c\x7fǏ1\x19\x13A,\x14\xa3:\x9a\x90\xcal@S\xa2\x9a\xdd\xc1\xd2\xd7\xea\xaf&T3\xdbs\xe66嘒\xb2*\xd18\x8a};%\xfb\x7fcގ{\xb4[e\x94Ixvwx\xf4\xf7\xd6\xf4\x8cT\xb6Y\x94\xa9\x9aq`\x12I\x18_+=J\x81\xd1\xc9\xf4\xeb\x89F\x87|^\x17\x02\xf5\xfc3\"\x80a`u\x06\xe4\vj\xf5\rS\xcf\xf6\xf0\x912\x85n~lĞ\xfb\xac|\xe7\xd4\x12~\xad!?\x12~\x18\xb1\xef\x8aa\t\x1fo\xac%}b\xa2Q\xc1\xdb.\xbc\x9a\xbb\xd8Xa\xab\x9eʏ\xb4hJj\x88Yҽ\x06\xd1\xc4}0\xb1\xef\x9a\nM䎔\xe5\x8d\xdb1\x13̤C5\xbcB\fCo\xd3θ\x1fɍ裩:(MI\x91\xb9\x9c\xbc\x03\xf2\x8c\x96\x17\xe7+)\xae\x0eh\x80I@\xd8\xe4Ō\x0f\x13\x05\x1b\xe6u$\xb8\xeb\x10\x1a\x85\xda\xd0\x12\xc5\"xc\xa6\xbdo\xca\xd2]P\xd9\xe5\xd20j\x8e4\xad0Nl$\xfdt\x94T\x1dEY\xa8yɈ\xddeΈ\xc0\ns\x15y][\x04\xa2\xd3\x01\xc8\t7'4\xa2\xe2\xed:\xc6\xd7\x1c\xcf\xc4\xcc\xc1\xa4J3\xa4\x02\xc5n3s:da:?\xa2@{\xdb\xdf|J\x05\x95\xcd=\xa4\x15\x13{\x82\xdc\xc9\\7v\x90\xb32\x9eq\xc4\t٬@e\x1aR:\xb2vO\xa4f\xa4,OHEZ\\Ģ\xb9\xf2F.\x99f9)'M\xde\x19\x93\xde\r\xef2\xf2\xd4#O\xbb\xf8_)\x9aK\xaa\xd5\xd5\bd@\x89\xbe*h]\x8a\x13\x9a\x04\x95\x91\xbaVW\xbeu\xd9r\x12\x89\xd3%\xb0%\xc9(D\xb6\xef\xbcد\xf2\a\xed\xf5\x85\x00\x1f@4}r\x06G\ai\x8b\x11:\xc8\xd4\x18}fmk\x12\xbb\xe6l,~+\xf2\xc5\xce\xfen\n\x9f\x1e\xbf~\xed\xdd\xe2\x8b\x1f%\x91\a\xaa4\xf0\xa6\xda\xd9\xf7\xe2\xedg\xa8\x8a\x0f4\xba\xe3\xf7\v\x06~0\xd5*P\x10x\xc30\v}\x14fx\xaa\x83\xce\x14\x9a\xfa\x92U̙>\xa6\x15-\xf7c<\xa9\x18\xc7\xd3eoỗ\x93\x9cqM\x0fT&\x10\xfd\x9eʜr}\x01\xedݝC\x16\xd4\xf6\xf2Tvv`\xf5\x80h\x8d\xdeA \x9cY:\x82\xc1\xf3)*;|\x14\xa8\x16=\xd3\xd7\xe7\\\x8bT\x9cK\xa3Pӹg\xcf\x06\xbe\x85\xef\xbf\xfb\uefd4\xc1\xd39\x12\\\x92\xccIw\xb7\x9b\x19f߅\xa1a'\xaew\x89\xfd\x19tp\x90\x02\x8d\x8f\xb7\x947\xadc\x1c\x81\x8e\vDaF\x80\x16\a\x93[\xb9A?\xd1\xf7\x18\xa0QC^\t}l\x9fٲ\xd1=<\x0e\xd8z\x19\x16O\x8a\x87p0\x05\xcf$zD\xc1\x8b\xd7\x19\x9f\x86H\xb7Y\xfe\\\x86\xbb\xf02\xd6\x1aE\x1eObu\xaa\x80\x06\x03w\xb6\x98\vS3\r\xe5r\x031\x83{\x0f\xa8_\x1a\xbc\xfeo\xd7 \xe9ֹ֞v\xd6'\xab&\xabP\x84[\xfa\xfb'|\xdb\v\b\xe3Kyq\xc7_\x9b\x17\x0e\x87a\x90\xe3i>\x17\xe2|3Ԝ4\x1c\xb3;\xfc\xc7\xf7\xf5\xbb}gT\x93\xa7\xef\xb3\xfe/Z8\x9d5R\x1b\x81\n\x18HY\x13\xc1\x0fݳW=u\xb5\x88ƙ臍\xfb\xa9c܁\x0f\x06\x7fRf\x9b\v(<g7\x86\x1bڒ\xc4ux\xd3\xd4\xfe\x7f__\xb0\x9e\xe7\bt\xb8`\x9bڌx^\xb8\xc3\x7fnC\xfe\x92}\xfd\xdd=\xfb\x13 Sw\xf3ϱ2q\xe7\xfe\x05\xfb\xf5\xfd>\xfcI\xb80\xbbK?\xc1b\xa4\xef\xc8\xefM#\x90\xfde\xfb\xf0\x17\xec\xbe\xef犯\x81\xbbl\xcf}\"\x99R\xf6\xd7\xf7\x88\x94\xb2\xab\xde\xed`ߤ\x9d\x990\xb1\x97~t\x8f\xfcf\xf1n\xfd\xf9\x9d\xf130\xfb\xa8\xbc\xca~\xf8\vv\xc1\xcfثE\xbc\x9f[5\xd3\xeb\xa7S{\xda\x13v\xb2O.\xcfi\x98v\xf6h\x8f!\xbal\x87z\x02\r{z\x91\xbe\x1b=\xec5\x1f}\xf6\xd2=\xe8\xfd\x1d\xe6\xa3`Sv\x9e/}M\xcf\xe4~\xf3\xd4\xdd\xe4\xa3\xd0g\x97\xef\x19ə\xfc\xb9b\x98\xd0{\xb0\x05\xaf_Dnj\x8aQ\x89\xe81\xfa\xd7\xe8m}\xe7\x05#A\xe3c\xb72\x17\x01\v.\xa1|\x06+,\x9dm\xda&\x175\xc3\xe8O\xb8\xad\u07b8\xe5\xcd\x14\xebF\x12A\x8c\xc3\x00l\x06\xefD}\xf2M,\x0e\xb2\xf51+|\u008e*\xbd\xa5\xfb\xbd\x90\xda:\"\xb8\xafi,\x7f@\xf6{\x9awq\xc4-\x89G\xa2\xa2AՄ͚ѲY\xc7t\xca,T\xa20-\xba\x0fXt\x98gkw\xb4=\xaf\v\v\xf9%%O\xd8:\xd9\xd8\xc9\x06\u07fcS\x01\x88@\x86P\x15\x102\xe0\x81\t\x01\x1b\xbd3^\x88g\xdf^\xd4\xe1\x86\xd2Dj\xdc${\x10cV'\xe4'\xcc\x03\xb0\b\x8e\xedOJ\x93\xaa\x0e*h\xae\xb8\x90\x8faƚ\x93\x03\xd6\xcfQ\xbfG<\xc3\x0fn^6\x99[\x12\xa5\x1d\xday\xfb\x10\x9bN\x82G.\x9ey/\xb8\xb8\x01\x12Wa\x1cԭ'\xf2\xa2\xa3\x10.Ge\xdf\xdbb\x93\x88\xf6\xfd5*\xbb\\\x12F$I\xc8\x02\x93'!\x11\x1e\x93\x86\xf4\xe5aFh{b\xf5a\xf0\xe4N\x1d\xad\xc3x\x83_\xb7\x12\x19\xa7\xa7\b\x87\xc8\xe5\xf03ㅵ\xa4x$I\xc7\x03\xc7\x1fl**\x84\x03\xa8\xdeq_\xc4\x1b\xacA\x05TњH_\xd52\xfdb*\x83\xf7$?\xf6\aFAbIk/dE4\\\x05\xe6\xbf\xf1\xf7ᕫ\f\xe0'\x11\x1aB\x02Lu\x03\x8aUu\x19_\xe1\x1bE\xe1\xaa\x0f\xe6r9\x19Y\x12$-H\xae\x1fl%\xe4v\x8e\xb7\x1f\xbb\xa3G\n\xa4\x05\xd1į\x89#/>\xf5r`k^\xae\fc\x14,\xf8\x93\x98B\x00\x02\xe6/,~\xe0\xc6\xe7GJ\xeb\xb8\x00\x82S|\x93tC!\xa8\xa8&\x88\xc8\r\xa8nN\xc1\x14\xdevX\\͏\xec\xc9=f\xac\xc0\x8a\x15\x9a\f\xfcds\x82I\xc9\x1dEޙE\xc8\xf4\x12\x00\xf1\x90}\xfbV\x98K\x14\xa6%7-^\xc0ȱ\xb2\xa6\x17\x94\xb7\xf7w\x9f\xa9\x1cMJ\xa4+\xfd\xa4\xe3=c\x11\xa6ש3\xa9:\xc3\x1c=>e3\xd2[?\xb1n\xe9\xee\x99\x15\a\xaaUF\xbf\x10,\x11d\xb9\xa8FvN\xb8\x9c\x12\xb6\xee=y\xe0\xfaHO\xd7ݦ\x17 :\x92\xbc\x1ey/\v&g\xa9D\tp\x00\x9d\x90\x99ąqP\x8c\xb4@~\x14(\x12(}n\xe0X\x97\n\xa6\tLhE\xe1\xea/Wa4J\x16\xfaX\x9e\x00\x0eQ\xac\f\x9e\u00a0э\x13\xd8I\x88\a\xda\xe8\f\xda\xf4h.\xf8\x13\xc5\x15\x17\xfd*\x8a\xe6-<\xec\x14\xe8d\xee\x94qr\xda\x02[NJ\xf7\x820\v\xd0`\xf2Lw\xa6\xf1X\xec!o\x94\x16U@|\xee-/\x82\xd3\x17(Ĩe\xb3T\xeb%)_\xa8\x12K\xd6\xc1\x8f\xd1\xe7O\bv\xf4\x89\xdd:\xf5X=Z\x8bA\x9aN\x8dg㺵\xf0\xeb\xb6\bd\x82|R*a\xe3%W\x92&\x05\xf6\xa4Ye\x88B\xf3\x99Z?W\xe5\r*v\xaeR\xae\xe5\xc9Xu\x13\v\x85z\xce.\xbe\xda\xf5\xe8\xf4\xfa\xe2\xa08\xa9\xd5Q\xe8ϦyO\xddα\xef\xa1?>\xb6\xd8\tsV\a\xe4\xa5h\x8a\x00\x7fԏ\xc1\xba\xff\xfd\xe7\xeb^\v\xa3\vu]\xea\xcc3\xc3{\x99\xfe\xe7\x1f\xbeV\xb7\xa7\xea\xc7K\xf34\xe9\x8fw\x19`\xa3\r>\xf0\xf5і;wl3\xf5r\xcb!\xb8\xf6(\x15\xb7\xa6\xb6\r\x92\x88i|՜TI\xad\xe7[\xbe>}\xfa\xc5N\x04\x83\x88\xec\xc7F\x1ad\xb65\x91\x8a\"m\xfd\x04-%v\xb1\xc7\xe0\x17\xbb\x80J\xe1f\xff\xc3\x10\x7fI\x918\x18\x92\b\xb9x\x16\xb6\xdf\xd4\v\xa4'\u05fc\b\x7f\x8e\xdf\xd7\t\xdc;LC\x86\x8d\xca\xee\x18$\xa2\x94șq\x9b\xdd+\xbf\x98o\xe5\xca6\x8b<\x8aI\x02Ly\x13\xa3J\xafu\xf9\xe1\x89JɊsk>\x14\x800\xb0C\x1b\xb1\xc7_LQ\n\x1dq\xd7\x12\xe7\xeb\x8a\xd8\xe3\x80MΑ\xc5\x17\x05\n\xdb\x1c\\\a\x1bȆ\x83\xafԢ \xa1\x9c\xb9\xf3\x9a\xed\u07b8\xf0\x8bphl\x12\xf7ˎг7\xbb\a\xd7xיe\xbb'=\xe0\xda*\x9d\xea\xf4\xf4\x9dA\x06\x9c\x8c\xc2٘I\xb4s\xa2̘D\x02\xef\xa4\xe0\xddͮBv\xe8Y\x90SL\xc4\x1cI\x9f)\x8dl#\x9b\xae\xde \xc4\x0f\xfb\xbfS\xfa\x18\xfbu@\x8a\x1f\xc3\xe0>\x9b\x11H\x17\t\xf8\x0f\x9a\x1d2\xb8zhxANc\xeda\xb8\x18?4\xfc\xea?\xdb.IO7\xb3f\"ۑ\x00\xdco\xcbU\xd4>\tWD\x97,\xd9̼>\xcf\vĵBN\x9d\x13gF\xa9f\xd5jJ\xb1\xba]\x9b\t\xc4\xf5rfI;\x10\x83\x96D.Lr\x83\xc7Z\xba\x90v(aa\xab'\xd3]\xb2-#Мi\xd1e\xc2\xf4^i\x95\xe8\xac\x13Aw\x82\xf4$\xaf\x163s\x1a\xaf_lq\xb6\x9b\x05~Әx4\x8a~x\xe6\xb8\xf5\xc0\xf7\x19\xdfq;\x8f\xdb\xcd\x04\x15\xffvv\x9b_)c\xde\x15\x9a\xdd\xc1\xf0\x01p\f\x1d\x82\xdd\xf2\xc2a\x12\x86L\x05\x89\xcc6\v\x9c\xa61\x87)F\xd3m\x90\xe3\xdeE\xbf4lf(\xac4\xd1MOs\xa3\n\xf5`\x86ANj\xddH\x97D\xcb\x1b)\xb1\x04\x8f \xdcv\x13\xbf\x1d\xf4\x1c\xa31\v\x8a9\xcf\x04\x9e\xfd\x12\x86\xb5\x15oe\x17\x80\xe0\xc9\xc13Q\xb84\xb8\xb5\xa4C\xfc͘I\x19\xfc`\xf3g\xb7P\x10M\xb7\b{9\xd3\"ڀ\x98><\xb2\xba\xa6\xc5\xec\x1cݸ\xf3I\xe2_\x1ek;Q\xaa\x9a\x8a\x16\xd1vl\xe5\xa0t\xbcX\xa6\xa1bx`\x0e\xf6棁\xd4\x06JMbK\xfaס\x83)TLR\xe0\x1eG\x00닗\xb9ͯ\x8c#\x1c\x8dm\xe8\xde\xc2o\xf4\xf9\xec\xda{Nv\xe7&\x7f\v\xf7\x86\x10g\x97m[\xabi# \x91\xbdǣs}\nw\x98\xa3\xe0\xd4\xe4\xb4[\xf0v\xf0`\x9b\f\xb6~\xb5\xf0\xec\xa1\x13\n\xfe\x83\x9d\xa751\x85\xc3r\x9c\xe0\x7fn\x92\xd6\xe7Q\xfcǌnĆ\f.\xb9D\xcc-<}\xdf\xfee\xe6o\xf7\xfb\xba\x1f|j\xa8#B.\x10tWZ\xc3D\xf2\x9cb+\xafٹ\x85\x17\x00\x1e\x19/n\xe1\xcazEu\xd9HR\xba?s\xc1mjQ\xdd\xc2?\xfe\xb9\x01\x17\xb4\x85d$\xfc㟛\xff?\x00(\xba\x0eO\xbe\xe4\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V͎\xe36\f\xbe\xfb)\x88\xeda/\xb5\xb3\x8b\xbd\x14\xbe\x15\xd3\x16X\xb4],&۹,\xf6 \xcbt\xa2\x8e,\xb9$\x95iZ\xf4\xdd\vIv\xe28NgZ\xa0q.\xa6H~\xd4\xc7\x1f\xb3(˲P\x83y@b\xe3]\rj0\xf8\xbb\xa0\x8bo\\=~Õ\xf1\x9b\xc3\xdb\x06E\xbd-\x1e\x8dkk\xb8\v,\xbe\xbfG\xf6\x814~\x87\x9dqF\x8cwE\x8f\xa2Z%\xaa.\x00\x94s^T\x14s|\x05\xd0\xde\tyk\x91\xca\x1d\xba\xea14\xd8\x04c[\xa4\x840\xe1\x1f\xdeT\xef\xaa7\x05\x80&L\xe6\x9fL\x8f,\xaa\x1fjp\xc1\xda\x02\xc0\xa9\x1ek`\xa4\x03\x12\x8b\x92\xc0\x84\xbf\x05d\xe1\xea\x80\x16\xc9W\xc6\x17<\xa0\x8e\xc0;\xf2a\xa8\xe1|\x90\xedǠ\xf2\x85\xb6\xc9\xd56\xb9\xbaϮҩ5,?\xde\xd2\xf8ɌZ\x83\r\xa4\xecz@I\x81\xf7\x9e\xe4\xc3\x19\xb4\x04f\xca'\xc6\xed\x82U\xb4j\\\x00\f\x84\xe9\xe0\x17\xf7\xe8\xfc\x93\xfb\xc1\xa0m\xb9\x86NY\xc6\x02\x80\xb5\x1f\xb0\x86\xe4zP\x1a\xdb(\v\r\x8d\x99\x19\xe1\xb2\xd3\x1a\xfe\xfc\xab\x008(k\xda\xc4k>\xf4\x03\xbao?\xbe\x7fx\xb7\xd5{\xecS梸E\xd6d\x86\xa4\xb7vy0\f\n\xc6@A<(\xad\x91\x19t B'#&\x18\xd7y\xea\x13\xdc\xe8\x18@5>\b\xc8\x1e\xe1!\xe5d\xbcz5*\f\xe4\a$1\x13Y\xf1\x99\xd5\xe7I\xb6\x88\xf1u\xbcDց6V$r\u0088%b\xbc\xc3\x168]\x10|\a\xb27\f\x84\x89\\'\x97\xd1ſ\xef@9\xf0ͯ\xa8\xa5\x1ao\xcf\xc0{\x1fl\x1b\xcb\xf8\x80$@\xa8\xfdΙ?N\x9e9\xd2\x10!\xad\x92\xa9\x80\xa6\x9fq\x82䔍\xf4\a\xfc\x1a\x94k\xa1WG \x8c\x18\x10\xdc\xcc[R\xe1\n~\xf6\x84\x89\xc0\x1a\xf6\"\x03כ\xcd\xce\xc8ԑ\xda\xf7}pF\x8e\x9b\xd4W\xa6\t\xe2\x897-\x1e\xd0n\xd8\xecJEzo\x04\xb5\x04\u008d\x1aL\x99\x02w\xf1\xb2\\\xf5\xedW\xa7\"y=\x8bT\x8e\xb1\x9eXȸ\xddI\x9cz\xe4&\xef\xb1?r5d\xb3|\xc53\xbd\xc6\xedR\"\xee\xbf\xdf~\x82\t4\xa5`\xe6\x12F\xb6\xcff|&>\x12e\\\x87\x94\xac\xa0#\xdf'\x8f\xe8\xda\xc1\x1b\x97kI[\x83\xee\x92t\x0eMo\x84\xa7*\x8d\xf9\xa9\xe0.\xcd%h\x10\xc2\xd0*\xc1\xb6\x82\xf7\x0e\xeeT\x8f\xf6N1\xfe\xef\xb4G\x86\xb9\x8c\x94>O\xfc|\x9cN\xbf\xac\x98\xd9:\x89\xa7Y\xb7\x9a\xa1\x95\xee\xdd\x0e\xa8c\xce\"q\xd1\xd6tF\xa76\x80\xce\x13\xa85\x93\xea\xd9\x18\x92\xf6\xbf\x8ab\x9c\x119\x8e\xc5\xe4\xf0\xdd\xf3q\xac\x8d\x8a\xf8\f{\xc5x)ZD\xf31j,\x91\xad\xe9P\x1f\xb5\xc5\xec O\n|.\x88\xf8\xa0\v\xfd\x12\xaf\x84\x0f\xf8t%\xfbH>\xce\xc94\xa9\x01\x9e\xc9\xff\xf8qٙ\xe9\x13z\xeb6Y'}\xae\xe6#w6jG7@\xc1\xb9ؑ\xdeE\xf1\xc2)\\N\xe4ũ\x11\xec\xaf\xe2X\x8d\xe4\xbd\xeb|\x9c\x93\xa2\"\xa4\x92\xdc'8&u\xc4\xc8\x11]\xb9\xbb\x95\xd3\xf5Q\xf4\x02\x02\xf3?~\xf2\xff\x83a\x1c\x1d\x86p\x05\xb3L\xb1\xac\x88#ҕx\xb5c\xc6Ȃ\xb5\xaa\xb1X\x83PXZf;E\xa4\x8e\x17'\xc3TF\xe7\xe5\xa8\xf8\xa7\xb4\\\xa9\xc7\xda\x7fڣ\xbbU\xe1\xf0\xa4x\xe1q\x86\n\xcd\xf1\x96\xe1\xddi\xcb[6I\xde\x04j\x88S\xb7\x14s\xc5\xd2\v\x88X\xc9R.Օ\xed\xe0\x8a\x84\xed\\s\xea\xfd\x8b\x82\x9f\x96\x85\xeae\xe0+I]\x88F\x7f5\x1cޞ\xdfR\x0f\x95\xe3\x12\x9b\x0e\xc6[\xb4\xb3\x9b\xb3xR\xbb\x89\x8b\xf3l\x8dk\xd6 \xd8ζ\xc9X\x875\xbczu\xb1\x8b\xa6W\xed]\x9b\x16s\xae\xe1\xf3\x97\xb8\x1b\x8a'lG\n\xb8\x86\xcf_\x8a\xbf\a\x00\xad\x01\x9a\xeb\x00\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VMo\xdc6\x10\xbd\xebW\f҃/\x916A.\x85n\x81\xdb\x02A\x93\xc0\x88\x13_\x82\x1cfɑĚ\"U\xceP\xae\xfb\xeb\vR\xd4~e\xd7qQ\xd4\xeb\x8bF3\x8f\xf3\xde|\x88U]\xd7\x15N\xe6\x8e\x02\x1b\xefZ\xc0\xc9\xd0_B.=qs\xff37\xc6o\xe6\xd7[\x12|]\xdd\x1b\xa7[\xb8\x8e,~\xfcD\xeccP\xf4\vu\xc6\x191\xdeU#\tj\x14l+\x00t\xce\v&3\xa7G\x00\xe5\x9d\x04o-\x85\xba'\xd7\xdc\xc7-m\xa3\xb1\x9aB>a=\x7f~ռi^U\x00*P\x0e\xfflFb\xc1qj\xc1Ek+\x00\x87#\xb50{\x1bGb\x87\x13\x0f^\xacWٛ\x9b\x99,\x05\xdf\x18_\xf1D*\x9d\xdd\a\x1f\xa7\x16\xf6/\x16\x88\x92\xd7\xc2\xe9.\xa3\xdd\x16\xb4\xf7\x05-;X\xc3\xf2\xfb\x13N\xef\rKv\x9cl\fh/f\x96}ظ>Z\f\x97\xbc*\x80)\x10S\x98鋻w\xfe\xc1\xfdf\xc8jn\xa1C\xcbT\x01\xb0\xf2\x13\xb5\xf0\x11G\xe2\t\x15\xe9\n`FktNf\xe1\xe4'roo\xdeݽ\xb9U\x03\x8d\xb9\x1eɬ\x89U0S\xf6\xbb@\x06\f\x03\u009a\r<\f\x14\b\xee\xb2r\xc0\xe2\x03qI\xbc@\x02\xac\f\xb8)\xa6)\xf8\x89\x82\x98U\xe0\xf4;谝\xed$\x9f\xab\x94\xf0\xe2\x03:\xf5\x141\xc8@0/6\xd2\xc0\x99\f\xf8\x0ed0\f\x81\xb2RN\xf6\xa5Z\x7f\xbe\x03t\xe0\xb7\x7f\x90\x92\x06n\x93\x9a\x81\x81\a\x1f\xadN\x8d8S\x10\b\xa4|\xef\xcc\xdf;d\x06\xf1\xf9H\x8bB,G\x88\xc6\t\x05\x876I\x1d\xe9%\xa0\xd30\xe2#\x04Jg@t\ahم\x1b\xf8\xe0\x03\x81q\x9doa\x10\x99\xb8\xddlz#\xebL)?\x8e\xd1\x19y\xdc\xe4\xc90\xdb(>\xf0F\xd3Lvæ\xaf1\xa8\xc1\b)\x89\x8168\x99:'\xee\x12YnF\xfdS(\x03\xc8W\a\x99\xcacj\x0e\x96`\\\xbf3\xe7\x16\xbf\xa8{\xea\xed\xa5\xecK\xd8Bq/\xafq}V\xe5ӯ\xb7\x9fa=4\x97\xe0\x00\x12\x8a\xda\xfb0\xde\v\x9f\x842\xae\xa3\x90\xa3\xa0\v~̈\xe4\xf4䍓\xfc\xa0\xac!w,:\xc7\xedh$U\xfa\xcfH,\xa9>\r\\\xe7\xcd\x02[\x828i\x14\xd2\r\xbcsp\x8d#\xd9kd\xfa\xdfeO\ns\x9d$\xfd\xb1\xf0\x87\vq\xfdK\xf1mQkg^W\xd5\xd9\n\x9d\x9f\xd4ۉ\xd4Ѡ$\fә2\xb9\x9d\x0f\x80\a\x88\xb0N\xf1y\xb4ux/\rp\xd9\xe0\x9d\xe9\x8fm\x00\xa8u\xde\xfeho.\xc4]\x94\xe7\f\xd7k\xef:ӧvL\x04\xa6\xe0g\xa3)\xd4+\xb7\x92C\f\x85dލMu\xee\xac\x13\x85\v\xb1\f\xd7>\x95\xc1MqJ9\xa4\xbe\\\x83\x96\xbdCe\xfd\xe5e\x88=5ճx\xa6\x0e6\x81\x8e\xa6\xb0\xdeAW?ȝ\x05%\x1e\x89\xfa\x9c\xfe\xc8A\x85۶\xf4\x88\x8a!\x90\x93\x82\b\xbe;\xc0\x04\xc0\xff\xde#\x16Y\xeev_\xa3\xf4\xed~R\xec\xf7߹\xaf\xb2' \x90d8\x14\xbddt\x02\t\a\x1f,\xe4\xf5kH\xfa\xb48\x9d\x0f#J\vim\xd4\t\xfb\xe4}\xba_\xe0\xd6R\v\x12\"=\xaf\xb2iș\xb1\x7f\x9a\xe7\x87\xc5'\x91\xc35\x00p룜\xa3\xb7\xa3s\xc5'\xa0k/\xbc\x04\x8ej\x00dx\x18\x1e\xc1\xc8\x15Ct8\xa3\xc9\xf97\xcfM}\x1a\x90\x9fN\xfc|'ܤ\xb8]\xa5LG\xeaQYZ\xe0Ҝ\x1c/\x9e\x7f\xb5|\xd2?\xb98\x9efU\xc3ە\xdewo\xbe8\xbc\xf0\xee\x02\xf33Svb*\xb7\x8e\x16\xe6\xd7\xfb\xa7<\x82\xf5z\xffL/\x00\xf2UM\x1ftLY\fŲ\x1f]T\x8a&!\xfd\xf1\xf4\xea\xf9\xe2\xc5\xd1\xed1?*\uf5ad\xca-|\xfd\x96n}\xe9\xee\xa5\xcb\xfd\x88[\xf8\xfa\xad\xfag\x00\x811\xe2=\xbb\v\x00\x00"),
 }
 
 var CRDs = crds()
@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"sync"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// maxBackupHookResults is the most hook results that are recorded in a
+// backup's status.
+const maxBackupHookResults = 100
+
+// BackupHookResults collects the results of the hooks run during a backup.
+// It's safe for concurrent use.
+type BackupHookResults struct {
+	lock   sync.Mutex
+	status velerov1api.HookStatus
+}
+
+// add records the result of a hook. Once the maximum number of results has
+// been recorded, a failed hook's result replaces the earliest result of a
+// hook that succeeded, if there is one, and other results are dropped.
+func (r *BackupHookResults) add(result velerov1api.BackupHookResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.status.HooksAttempted++
+	if result.Error != "" {
+		r.status.HooksFailed++
+	}
+
+	if len(r.status.Results) < maxBackupHookResults {
+		r.status.Results = append(r.status.Results, result)
+		return
+	}
+
+	r.status.ResultsOmitted++
+	if result.Error == "" {
+		return
+	}
+	for i, recorded := range r.status.Results {
+		if recorded.Error == "" {
+			r.status.Results = append(append(r.status.Results[:i:i], r.status.Results[i+1:]...), result)
+			return
+		}
+	}
+}
+
+// Status returns the backup's hook status, or nil if no hooks were run.
+func (r *BackupHookResults) Status() *velerov1api.HookStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.status.HooksAttempted == 0 {
+		return nil
+	}
+	return r.status.DeepCopy()
+}
@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestHandleHooksRecordsResults(t *testing.T) {
+	item := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "v1",
+		"kind": "Pod",
+		"metadata": {
+			"namespace": "ns",
+			"name": "name"
+		}
+	}`)
+	hooks := []ResourceHook{
+		{
+			Name: "hook1",
+			Pre: []velerov1api.BackupResourceHook{
+				{Exec: &velerov1api.ExecHook{Container: "1a", Command: []string{"pre-1a"}, OnError: velerov1api.HookErrorModeContinue}},
+				{Exec: &velerov1api.ExecHook{Container: "1b", Command: []string{"pre-1b"}, OnError: velerov1api.HookErrorModeFail}},
+			},
+		},
+	}
+
+	podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+	defer podCommandExecutor.AssertExpectations(t)
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "hook1", hooks[0].Pre[0].Exec).Return(errors.New("exit code 1"))
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "hook1", hooks[0].Pre[1].Exec).Return(nil)
+
+	results := new(BackupHookResults)
+	h := &DefaultItemHookHandler{
+		PodCommandExecutor: podCommandExecutor,
+		Results:            results,
+	}
+	require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, item, hooks, PhasePre))
+
+	status := results.Status()
+	require.NotNil(t, status)
+	assert.Equal(t, 2, status.HooksAttempted)
+	assert.Equal(t, 1, status.HooksFailed)
+	require.Len(t, status.Results, 2)
+	// the timings vary, so only their presence is checked.
+	for i := range status.Results {
+		assert.NotNil(t, status.Results[i].StartTimestamp)
+		status.Results[i].StartTimestamp = nil
+		status.Results[i].Duration.Duration = 0
+	}
+	assert.Equal(t, []velerov1api.BackupHookResult{
+		{
+			Name:      "hook1",
+			Phase:     velerov1api.BackupHookPhasePre,
+			Namespace: "ns",
+			Pod:       "name",
+			Container: "1a",
+			Command:   []string{"pre-1a"},
+			OnError:   velerov1api.HookErrorModeContinue,
+			Error:     "exit code 1",
+		},
+		{
+			Name:      "hook1",
+			Phase:     velerov1api.BackupHookPhasePre,
+			Namespace: "ns",
+			Pod:       "name",
+			Container: "1b",
+			Command:   []string{"pre-1b"},
+			OnError:   velerov1api.HookErrorModeFail,
+		},
+	}, status.Results)
+}
+
+func TestBackupHookResultsAreBounded(t *testing.T) {
+	results := new(BackupHookResults)
+	assert.Nil(t, results.Status())
+
+	for i := 0; i < maxBackupHookResults; i++ {
+		results.add(velerov1api.BackupHookResult{Name: fmt.Sprintf("succeeded-%d", i)})
+	}
+	results.add(velerov1api.BackupHookResult{Name: "failed-1", Error: "error"})
+	results.add(velerov1api.BackupHookResult{Name: "succeeded-extra"})
+
+	status := results.Status()
+	assert.Equal(t, maxBackupHookResults+2, status.HooksAttempted)
+	assert.Equal(t, 1, status.HooksFailed)
+	assert.Equal(t, 2, status.ResultsOmitted)
+	require.Len(t, status.Results, maxBackupHookResults)
+
+	// the failed hook's result replaces the earliest successful one.
+	assert.Equal(t, "succeeded-1", status.Results[0].Name)
+	assert.Equal(t, "failed-1", status.Results[maxBackupHookResults-1].Name)
+}
@@ -0,0 +1,250 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+)
+
+// BackupHookActionGetter gets the BackupHookAction plugins that backup-level
+// plugin hooks invoke.
+type BackupHookActionGetter interface {
+	GetBackupHookAction(name string) (velero.BackupHookAction, error)
+}
+
+// ValidateBackupLevelHooks returns the errors in a backup's backup-level hook
+// specs.
+func ValidateBackupLevelHooks(specs []velerov1api.BackupLevelHookSpec) []error {
+	var errs []error
+	for _, spec := range specs {
+		for i, hook := range spec.PreHooks {
+			if err := validateBackupLevelHook(hook); err != nil {
+				errs = append(errs, errors.Wrapf(err, "backup-level hook %s: pre hook %d", spec.Name, i))
+			}
+		}
+		for i, hook := range spec.PostHooks {
+			if err := validateBackupLevelHook(hook); err != nil {
+				errs = append(errs, errors.Wrapf(err, "backup-level hook %s: post hook %d", spec.Name, i))
+			}
+		}
+	}
+	return errs
+}
+
+func validateBackupLevelHook(hook velerov1api.BackupLevelHook) error {
+	switch {
+	case hook.Exec != nil && hook.Plugin == nil:
+		if hook.Exec.Namespace == "" {
+			return errors.New("exec.namespace is required")
+		}
+		if hook.Exec.PodSelector == nil {
+			return errors.New("exec.podSelector is required")
+		}
+		if _, err := metav1.LabelSelectorAsSelector(hook.Exec.PodSelector); err != nil {
+			return errors.Wrap(err, "invalid exec.podSelector")
+		}
+		if len(hook.Exec.Command) == 0 {
+			return errors.New("exec.command is required")
+		}
+	case hook.Plugin != nil && hook.Exec == nil:
+		if hook.Plugin.Name == "" {
+			return errors.New("plugin.name is required")
+		}
+	default:
+		return errors.New("exactly one of exec and plugin must be specified")
+	}
+	return nil
+}
+
+// BackupLevelHookHandler runs a backup's backup-level hooks, which are run
+// once per backup rather than for each matching pod. A handler is used for
+// a single backup, since it tracks which specs' pre hooks were started so
+// that only their post hooks are run.
+type BackupLevelHookHandler struct {
+	PodCommandExecutor podexec.PodCommandExecutor
+	PodsGetter         corev1client.PodsGetter
+	ActionGetter       BackupHookActionGetter
+
+	// Results, if non-nil, records the result of each hook that's run.
+	Results *BackupHookResults
+
+	preHooksStarted int
+}
+
+// HandleHooks runs the hooks of a phase of each of the backup's backup-level
+// hook specs, in order. Every failed hook is logged as an error. For the pre
+// phase, the first hook that fails with an onError of Fail stops the
+// remaining pre hooks and its error is returned. For the post phase, only
+// the post hooks of the specs whose pre hooks were started are run, a hook
+// that fails with an onError of Fail only stops the remaining post hooks of
+// its own spec, and the first such error is returned.
+func (h *BackupLevelHookHandler) HandleHooks(log logrus.FieldLogger, backup *velerov1api.Backup, phase hookPhase) error {
+	specs := backup.Spec.Hooks.Backup
+	if phase == PhasePost {
+		specs = specs[:h.preHooksStarted]
+	}
+
+	var postErr error
+	for i, spec := range specs {
+		hooks := spec.PostHooks
+		if phase == PhasePre {
+			h.preHooksStarted = i + 1
+			hooks = spec.PreHooks
+		}
+
+		for _, hook := range hooks {
+			hookLog := log.WithFields(
+				logrus.Fields{
+					"hookSource": "backupSpec",
+					"hookName":   spec.Name,
+					"hookPhase":  phase,
+				},
+			)
+
+			onError, err := h.executeHook(hookLog, backup, spec.Name, hook, phase)
+			if err == nil {
+				continue
+			}
+
+			hookLog.WithError(err).Error("Error executing backup-level hook")
+			if onError != velerov1api.HookErrorModeFail {
+				continue
+			}
+			if phase == PhasePre {
+				return errors.Wrapf(err, "error executing pre hook of backup-level hook %s", spec.Name)
+			}
+			if postErr == nil {
+				postErr = errors.Wrapf(err, "error executing post hook of backup-level hook %s", spec.Name)
+			}
+			break
+		}
+	}
+
+	return postErr
+}
+
+// executeHook runs a backup-level hook, recording its result if the handler
+// records results, and returns how its error should be handled.
+func (h *BackupLevelHookHandler) executeHook(log logrus.FieldLogger, backup *velerov1api.Backup, hookName string, hook velerov1api.BackupLevelHook, phase hookPhase) (velerov1api.HookErrorMode, error) {
+	result := velerov1api.BackupHookResult{
+		Name:           hookName,
+		Phase:          velerov1api.BackupHookPhase(phase),
+		OnError:        velerov1api.HookErrorModeFail,
+		StartTimestamp: &metav1.Time{Time: time.Now()},
+	}
+
+	var err error
+	switch {
+	case hook.Exec != nil && hook.Plugin == nil:
+		// the executor fills in the hook's defaults, so it's given a copy
+		// to leave the backup's spec as it is.
+		execHook := hook.Exec.ExecHook.DeepCopy()
+		result.Namespace = hook.Exec.Namespace
+		result.Command = execHook.Command
+		if execHook.OnError == velerov1api.HookErrorModeContinue {
+			result.OnError = velerov1api.HookErrorModeContinue
+		}
+
+		var pod *corev1api.Pod
+		if pod, err = h.getHookPod(hook.Exec); err == nil {
+			result.Pod = pod.Name
+			err = h.executePodCommand(log.WithField("hookType", "exec"), pod, hookName, execHook)
+			result.Container = execHook.Container
+		}
+	case hook.Plugin != nil && hook.Exec == nil:
+		result.Plugin = hook.Plugin.Name
+		if hook.Plugin.OnError == velerov1api.HookErrorModeContinue {
+			result.OnError = velerov1api.HookErrorModeContinue
+		}
+
+		log.WithFields(logrus.Fields{"hookType": "plugin", "hookPlugin": hook.Plugin.Name}).Info("Running backup-level plugin hook")
+		err = h.executePlugin(backup, hook.Plugin.Name, phase)
+	default:
+		err = errors.New("exactly one of exec and plugin must be specified")
+	}
+
+	result.Duration = metav1.Duration{Duration: time.Since(result.StartTimestamp.Time)}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	if h.Results != nil {
+		h.Results.add(result)
+	}
+
+	return result.OnError, err
+}
+
+// getHookPod returns the first running pod, by name, that matches an exec
+// hook's pod selector.
+func (h *BackupLevelHookHandler) getHookPod(hook *velerov1api.BackupLevelExecHook) (*corev1api.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(hook.PodSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pod selector")
+	}
+
+	pods, err := h.PodsGetter.Pods(hook.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing pods in namespace %s", hook.Namespace)
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1api.PodRunning && pod.DeletionTimestamp == nil {
+			return pod, nil
+		}
+	}
+
+	return nil, errors.Errorf("no running pod in namespace %s matches pod selector %s", hook.Namespace, selector)
+}
+
+func (h *BackupLevelHookHandler) executePodCommand(log logrus.FieldLogger, pod *corev1api.Pod, hookName string, hook *velerov1api.ExecHook) error {
+	podMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return h.PodCommandExecutor.ExecutePodCommand(log, podMap, pod.Namespace, pod.Name, hookName, hook)
+}
+
+func (h *BackupLevelHookHandler) executePlugin(backup *velerov1api.Backup, name string, phase hookPhase) error {
+	if h.ActionGetter == nil {
+		return errors.Errorf("backup hook action %s isn't available", name)
+	}
+
+	action, err := h.ActionGetter.GetBackupHookAction(name)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup hook action %s", name)
+	}
+
+	return action.Execute(backup, velerov1api.BackupHookPhase(phase))
+}
@@ -0,0 +1,218 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hook
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+type fakeBackupHookActionGetter map[string]velero.BackupHookAction
+
+func (g fakeBackupHookActionGetter) GetBackupHookAction(name string) (velero.BackupHookAction, error) {
+	action, ok := g[name]
+	if !ok {
+		return nil, errors.Errorf("plugin %s not found", name)
+	}
+	return action, nil
+}
+
+func TestBackupLevelHookHandlerPodSelection(t *testing.T) {
+	pods := []runtime.Object{
+		builder.ForPod("ns-1", "db-c").ObjectMeta(builder.WithLabels("app", "db")).Phase(corev1api.PodRunning).Result(),
+		builder.ForPod("ns-1", "db-a").ObjectMeta(builder.WithLabels("app", "db")).Phase(corev1api.PodPending).Result(),
+		builder.ForPod("ns-1", "db-b").ObjectMeta(builder.WithLabels("app", "db")).Phase(corev1api.PodRunning).Result(),
+		builder.ForPod("ns-1", "web").ObjectMeta(builder.WithLabels("app", "web")).Phase(corev1api.PodRunning).Result(),
+	}
+
+	execHook := func(app string) *velerov1api.BackupLevelExecHook {
+		return &velerov1api.BackupLevelExecHook{
+			Namespace:   "ns-1",
+			PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			ExecHook: velerov1api.ExecHook{
+				Command: []string{"flush"},
+				OnError: velerov1api.HookErrorModeContinue,
+			},
+		}
+	}
+
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	backup.Spec.Hooks.Backup = []velerov1api.BackupLevelHookSpec{
+		{
+			Name:     "flush",
+			PreHooks: []velerov1api.BackupLevelHook{{Exec: execHook("db")}, {Exec: execHook("cache")}},
+		},
+	}
+
+	podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+	defer podCommandExecutor.AssertExpectations(t)
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "db-b", "flush", &execHook("db").ExecHook).Return(nil)
+
+	results := new(BackupHookResults)
+	h := &BackupLevelHookHandler{
+		PodCommandExecutor: podCommandExecutor,
+		PodsGetter:         fake.NewSimpleClientset(pods...).CoreV1(),
+		Results:            results,
+	}
+
+	// the first running pod by name is used, and a hook that no running pod
+	// matches fails without stopping the backup since it continues on errors.
+	require.NoError(t, h.HandleHooks(velerotest.NewLogger(), backup, PhasePre))
+
+	status := results.Status()
+	require.NotNil(t, status)
+	assert.Equal(t, 2, status.HooksAttempted)
+	assert.Equal(t, 1, status.HooksFailed)
+	require.Len(t, status.Results, 2)
+	assert.Equal(t, "db-b", status.Results[0].Pod)
+	assert.Empty(t, status.Results[0].Error)
+	assert.Equal(t, "", status.Results[1].Pod)
+	assert.Equal(t, "no running pod in namespace ns-1 matches pod selector app=cache", status.Results[1].Error)
+
+	// the backup's spec isn't modified by the executor's defaults.
+	assert.Equal(t, execHook("db"), backup.Spec.Hooks.Backup[0].PreHooks[0].Exec)
+}
+
+func TestBackupLevelHookHandlerErrorModes(t *testing.T) {
+	pluginHook := func(name string, onError velerov1api.HookErrorMode) velerov1api.BackupLevelHook {
+		return velerov1api.BackupLevelHook{Plugin: &velerov1api.BackupPluginHook{Name: name, OnError: onError}}
+	}
+
+	tests := []struct {
+		name             string
+		specs            []velerov1api.BackupLevelHookSpec
+		failing          map[string]bool
+		expectedPre      []string
+		expectedPost     []string
+		expectedPreErr   string
+		expectedPostErr  string
+		expectedAttempts int
+	}{
+		{
+			name: "hooks are run in order",
+			specs: []velerov1api.BackupLevelHookSpec{
+				{Name: "a", PreHooks: []velerov1api.BackupLevelHook{pluginHook("a-pre", "")}, PostHooks: []velerov1api.BackupLevelHook{pluginHook("a-post", "")}},
+				{Name: "b", PreHooks: []velerov1api.BackupLevelHook{pluginHook("b-pre", "")}, PostHooks: []velerov1api.BackupLevelHook{pluginHook("b-post", "")}},
+			},
+			expectedPre:  []string{"a-pre", "b-pre"},
+			expectedPost: []string{"a-post", "b-post"},
+		},
+		{
+			name: "failed pre hook that continues doesn't stop the other hooks",
+			specs: []velerov1api.BackupLevelHookSpec{
+				{Name: "a", PreHooks: []velerov1api.BackupLevelHook{pluginHook("a-pre-1", velerov1api.HookErrorModeContinue), pluginHook("a-pre-2", "")}},
+			},
+			failing:     map[string]bool{"a-pre-1": true},
+			expectedPre: []string{"a-pre-1", "a-pre-2"},
+		},
+		{
+			name: "failed pre hook stops the remaining pre hooks, and only the post hooks of the started specs are run",
+			specs: []velerov1api.BackupLevelHookSpec{
+				{Name: "a", PreHooks: []velerov1api.BackupLevelHook{pluginHook("a-pre", "")}, PostHooks: []velerov1api.BackupLevelHook{pluginHook("a-post", "")}},
+				{Name: "b", PreHooks: []velerov1api.BackupLevelHook{pluginHook("b-pre-1", velerov1api.HookErrorModeFail), pluginHook("b-pre-2", "")}, PostHooks: []velerov1api.BackupLevelHook{pluginHook("b-post", "")}},
+				{Name: "c", PreHooks: []velerov1api.BackupLevelHook{pluginHook("c-pre", "")}, PostHooks: []velerov1api.BackupLevelHook{pluginHook("c-post", "")}},
+			},
+			failing:        map[string]bool{"b-pre-1": true},
+			expectedPre:    []string{"a-pre", "b-pre-1"},
+			expectedPost:   []string{"a-post", "b-post"},
+			expectedPreErr: "error executing pre hook of backup-level hook b: b-pre-1 failed",
+		},
+		{
+			name: "failed post hook only stops its own spec's post hooks",
+			specs: []velerov1api.BackupLevelHookSpec{
+				{Name: "a", PostHooks: []velerov1api.BackupLevelHook{pluginHook("a-post-1", ""), pluginHook("a-post-2", "")}},
+				{Name: "b", PostHooks: []velerov1api.BackupLevelHook{pluginHook("b-post", "")}},
+			},
+			failing:         map[string]bool{"a-post-1": true},
+			expectedPost:    []string{"a-post-1", "b-post"},
+			expectedPostErr: "error executing post hook of backup-level hook a: a-post-1 failed",
+		},
+		{
+			name: "hook with both exec and plugin fails",
+			specs: []velerov1api.BackupLevelHookSpec{
+				{Name: "a", PreHooks: []velerov1api.BackupLevelHook{{Exec: &velerov1api.BackupLevelExecHook{}, Plugin: &velerov1api.BackupPluginHook{Name: "a-pre"}}}},
+			},
+			expectedPreErr:   "error executing pre hook of backup-level hook a: exactly one of exec and plugin must be specified",
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup("velero", "backup-1").Result()
+			backup.Spec.Hooks.Backup = tc.specs
+
+			var invoked []string
+			getter := fakeBackupHookActionGetter{}
+			for _, spec := range tc.specs {
+				for _, hook := range append(append([]velerov1api.BackupLevelHook(nil), spec.PreHooks...), spec.PostHooks...) {
+					name := hook.Plugin.Name
+					var err error
+					if tc.failing[name] {
+						err = errors.Errorf("%s failed", name)
+					}
+
+					action := new(mocks.BackupHookAction)
+					action.On("Execute", backup, mock.Anything).Run(func(mock.Arguments) { invoked = append(invoked, name) }).Return(err)
+					getter[name] = action
+				}
+			}
+
+			results := new(BackupHookResults)
+			h := &BackupLevelHookHandler{ActionGetter: getter, Results: results}
+
+			err := h.HandleHooks(velerotest.NewLogger(), backup, PhasePre)
+			if tc.expectedPreErr != "" {
+				assert.EqualError(t, err, tc.expectedPreErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedPre, invoked)
+
+			invoked = nil
+			err = h.HandleHooks(velerotest.NewLogger(), backup, PhasePost)
+			if tc.expectedPostErr != "" {
+				assert.EqualError(t, err, tc.expectedPostErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedPost, invoked)
+
+			expectedAttempts := tc.expectedAttempts
+			if expectedAttempts == 0 {
+				expectedAttempts = len(tc.expectedPre) + len(tc.expectedPost)
+			}
+			status := results.Status()
+			require.NotNil(t, status)
+			assert.Equal(t, expectedAttempts, status.HooksAttempted)
+		})
+	}
+}
@@ -50,21 +50,25 @@ const (
 
 const (
 	// Backup hook annotations
-	podBackupHookContainerAnnotationKey = "hook.backup.velero.io/container"
-	podBackupHookCommandAnnotationKey   = "hook.backup.velero.io/command"
-	podBackupHookOnErrorAnnotationKey   = "hook.backup.velero.io/on-error"
-	podBackupHookTimeoutAnnotationKey   = "hook.backup.velero.io/timeout"
+	podBackupHookContainerAnnotationKey                = "hook.backup.velero.io/container"
+	podBackupHookContainerImageAnnotationKey           = "hook.backup.velero.io/container-image"
+	podBackupHookContainerImageFirstMatchAnnotationKey = "hook.backup.velero.io/container-image-first-match"
+	podBackupHookCommandAnnotationKey                  = "hook.backup.velero.io/command"
+	podBackupHookOnErrorAnnotationKey                  = "hook.backup.velero.io/on-error"
+	podBackupHookTimeoutAnnotationKey                  = "hook.backup.velero.io/timeout"
 
 	// Restore hook annotations
-	podRestoreHookContainerAnnotationKey            = "post.hook.restore.velero.io/container"
-	podRestoreHookCommandAnnotationKey              = "post.hook.restore.velero.io/command"
-	podRestoreHookOnErrorAnnotationKey              = "post.hook.restore.velero.io/on-error"
-	podRestoreHookTimeoutAnnotationKey              = "post.hook.restore.velero.io/exec-timeout"
-	podRestoreHookWaitTimeoutAnnotationKey          = "post.hook.restore.velero.io/wait-timeout"
-	podRestoreHookInitContainerImageAnnotationKey   = "init.hook.restore.velero.io/container-image"
-	podRestoreHookInitContainerNameAnnotationKey    = "init.hook.restore.velero.io/container-name"
-	podRestoreHookInitContainerCommandAnnotationKey = "init.hook.restore.velero.io/command"
-	podRestoreHookInitContainerTimeoutAnnotationKey = "init.hook.restore.velero.io/timeout"
+	podRestoreHookContainerAnnotationKey                = "post.hook.restore.velero.io/container"
+	podRestoreHookContainerImageAnnotationKey           = "post.hook.restore.velero.io/container-image"
+	podRestoreHookContainerImageFirstMatchAnnotationKey = "post.hook.restore.velero.io/container-image-first-match"
+	podRestoreHookCommandAnnotationKey                  = "post.hook.restore.velero.io/command"
+	podRestoreHookOnErrorAnnotationKey                  = "post.hook.restore.velero.io/on-error"
+	podRestoreHookTimeoutAnnotationKey                  = "post.hook.restore.velero.io/exec-timeout"
+	podRestoreHookWaitTimeoutAnnotationKey              = "post.hook.restore.velero.io/wait-timeout"
+	podRestoreHookInitContainerImageAnnotationKey       = "init.hook.restore.velero.io/container-image"
+	podRestoreHookInitContainerNameAnnotationKey        = "init.hook.restore.velero.io/container-name"
+	podRestoreHookInitContainerCommandAnnotationKey     = "init.hook.restore.velero.io/command"
+	podRestoreHookInitContainerTimeoutAnnotationKey     = "init.hook.restore.velero.io/timeout"
 )
 
 // ItemHookHandler invokes hooks for an item.
@@ -139,10 +143,11 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 		// pod did not have the annotations appropriate for restore hooks
 		// running applicable ResourceRestoreHooks supplied.
 		namespace := metadata.GetNamespace()
+		annotations := labels.Set(metadata.GetAnnotations())
 		labels := labels.Set(metadata.GetLabels())
 
 		for _, rh := range resourceRestoreHooks {
-			if !rh.Selector.applicableTo(groupResource, namespace, labels) {
+			if !rh.Selector.applicableTo(groupResource, namespace, labels, annotations) {
 				continue
 			}
 			for _, hook := range rh.RestoreHooks {
@@ -166,6 +171,9 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+
+	// Results, if non-nil, records the result of each hook that's run.
+	Results *BackupHookResults
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
@@ -202,7 +210,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 				"hookPhase":  phase,
 			},
 		)
-		if err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); err != nil {
+		if err := h.executePodCommand(hookLog, obj, namespace, name, "<from-annotation>", hookFromAnnotations, phase); err != nil {
 			hookLog.WithError(err).Error("Error executing hook")
 			if hookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
 				return err
@@ -212,10 +220,11 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		return nil
 	}
 
+	annotations := labels.Set(metadata.GetAnnotations())
 	labels := labels.Set(metadata.GetLabels())
 	// Otherwise, check for hooks defined in the backup spec.
 	for _, resourceHook := range resourceHooks {
-		if !resourceHook.Selector.applicableTo(groupResource, namespace, labels) {
+		if !resourceHook.Selector.applicableTo(groupResource, namespace, labels, annotations) {
 			continue
 		}
 
@@ -235,7 +244,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 							"hookPhase":  phase,
 						},
 					)
-					err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, hook.Exec)
+					err := h.executePodCommand(hookLog, obj, namespace, name, resourceHook.Name, hook.Exec, phase)
 					if err != nil {
 						hookLog.WithError(err).Error("Error executing hook")
 						if hook.Exec.OnError == velerov1api.HookErrorModeFail {
@@ -250,6 +259,45 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	return nil
 }
 
+// executePodCommand runs an exec hook in a pod, recording its result if the
+// handler records results.
+func (h *DefaultItemHookHandler) executePodCommand(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	namespace, name, hookName string,
+	hook *velerov1api.ExecHook,
+	phase hookPhase,
+) error {
+	start := time.Now()
+	err := h.PodCommandExecutor.ExecutePodCommand(log, obj.UnstructuredContent(), namespace, name, hookName, hook)
+	if h.Results == nil {
+		return err
+	}
+
+	// the executor fills in the hook's defaults, such as its container, and
+	// the caller only fails the item if the hook's error mode is Fail.
+	result := velerov1api.BackupHookResult{
+		Name:           hookName,
+		Phase:          velerov1api.BackupHookPhase(phase),
+		Namespace:      namespace,
+		Pod:            name,
+		Container:      hook.Container,
+		Command:        hook.Command,
+		OnError:        velerov1api.HookErrorModeContinue,
+		StartTimestamp: &metav1.Time{Time: start},
+		Duration:       metav1.Duration{Duration: time.Since(start)},
+	}
+	if hook.OnError == velerov1api.HookErrorModeFail {
+		result.OnError = velerov1api.HookErrorModeFail
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	h.Results.add(result)
+
+	return err
+}
+
 func phasedKey(phase hookPhase, key string) string {
 	if phase != "" {
 		return fmt.Sprintf("%v.%v", phase, key)
@@ -271,6 +319,8 @@ func getPodExecHookFromAnnotations(annotations map[string]string, phase hookPhas
 	}
 
 	container := getHookAnnotation(annotations, podBackupHookContainerAnnotationKey, phase)
+	containerImage := getHookAnnotation(annotations, podBackupHookContainerImageAnnotationKey, phase)
+	containerImageFirstMatch := getHookAnnotation(annotations, podBackupHookContainerImageFirstMatchAnnotationKey, phase) == "true"
 
 	onError := velerov1api.HookErrorMode(getHookAnnotation(annotations, podBackupHookOnErrorAnnotationKey, phase))
 	if onError != velerov1api.HookErrorModeContinue && onError != velerov1api.HookErrorModeFail {
@@ -288,10 +338,12 @@ func getPodExecHookFromAnnotations(annotations map[string]string, phase hookPhas
 	}
 
 	return &velerov1api.ExecHook{
-		Container: container,
-		Command:   parseStringToCommand(commandValue),
-		OnError:   onError,
-		Timeout:   metav1.Duration{Duration: timeout},
+		Container:                container,
+		ContainerImage:           containerImage,
+		ContainerImageFirstMatch: containerImageFirstMatch,
+		Command:                  parseStringToCommand(commandValue),
+		OnError:                  onError,
+		Timeout:                  metav1.Duration{Duration: timeout},
 	}
 }
 
@@ -312,6 +364,9 @@ type ResourceHookSelector struct {
 	Namespaces    *collections.IncludesExcludes
 	Resources     *collections.IncludesExcludes
 	LabelSelector labels.Selector
+	// AnnotationSelector matches the annotations of resources the way
+	// LabelSelector matches their labels.
+	AnnotationSelector labels.Selector
 }
 
 // ResourceHook is a hook for a given resource.
@@ -322,7 +377,7 @@ type ResourceHook struct {
 	Post     []velerov1api.BackupResourceHook
 }
 
-func (r ResourceHookSelector) applicableTo(groupResource schema.GroupResource, namespace string, labels labels.Set) bool {
+func (r ResourceHookSelector) applicableTo(groupResource schema.GroupResource, namespace string, labels, annotations labels.Set) bool {
 	if r.Namespaces != nil && !r.Namespaces.ShouldInclude(namespace) {
 		return false
 	}
@@ -332,6 +387,9 @@ func (r ResourceHookSelector) applicableTo(groupResource schema.GroupResource, n
 	if r.LabelSelector != nil && !r.LabelSelector.Matches(labels) {
 		return false
 	}
+	if r.AnnotationSelector != nil && !r.AnnotationSelector.Matches(annotations) {
+		return false
+	}
 	return true
 }
 
@@ -402,6 +460,13 @@ func GetRestoreHooksFromSpec(hooksSpec *velerov1api.RestoreHooks) ([]ResourceRes
 			}
 			rh.Selector.LabelSelector = ls
 		}
+		if rs.AnnotationSelector != nil {
+			as, err := metav1.LabelSelectorAsSelector(rs.AnnotationSelector)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			rh.Selector.AnnotationSelector = as
+		}
 		restoreHooks = append(restoreHooks, rh)
 	}
 
@@ -417,6 +482,8 @@ func getPodExecRestoreHookFromAnnotations(annotations map[string]string, log log
 	}
 
 	container := annotations[podRestoreHookContainerAnnotationKey]
+	containerImage := annotations[podRestoreHookContainerImageAnnotationKey]
+	containerImageFirstMatch := annotations[podRestoreHookContainerImageFirstMatchAnnotationKey] == "true"
 
 	onError := velerov1api.HookErrorMode(annotations[podRestoreHookOnErrorAnnotationKey])
 	if onError != velerov1api.HookErrorModeContinue && onError != velerov1api.HookErrorModeFail {
@@ -444,11 +511,13 @@ func getPodExecRestoreHookFromAnnotations(annotations map[string]string, log log
 	}
 
 	return &velerov1api.ExecRestoreHook{
-		Container:   container,
-		Command:     parseStringToCommand(commandValue),
-		OnError:     onError,
-		ExecTimeout: metav1.Duration{Duration: execTimeout},
-		WaitTimeout: metav1.Duration{Duration: waitTimeout},
+		Container:                container,
+		ContainerImage:           containerImage,
+		ContainerImageFirstMatch: containerImageFirstMatch,
+		Command:                  parseStringToCommand(commandValue),
+		OnError:                  onError,
+		ExecTimeout:              metav1.Duration{Duration: execTimeout},
+		WaitTimeout:              metav1.Duration{Duration: waitTimeout},
 	}
 }
 
@@ -478,9 +547,8 @@ func GroupRestoreExecHooks(
 	}
 	hookFromAnnotation := getPodExecRestoreHookFromAnnotations(metadata.GetAnnotations(), log)
 	if hookFromAnnotation != nil {
-		// default to first container in pod if unset
-		if hookFromAnnotation.Container == "" {
-			hookFromAnnotation.Container = pod.Spec.Containers[0].Name
+		if err := setRestoreHookContainer(pod, hookFromAnnotation); err != nil {
+			return nil, errors.Wrap(err, "error selecting container for hook <from-annotation>")
 		}
 		byContainer[hookFromAnnotation.Container] = []PodExecRestoreHook{
 			{
@@ -494,9 +562,10 @@ func GroupRestoreExecHooks(
 
 	// No hook found on pod's annotations so check for applicable hooks from the restore spec
 	labels := metadata.GetLabels()
+	annotations := metadata.GetAnnotations()
 	namespace := metadata.GetNamespace()
 	for _, rrh := range resourceRestoreHooks {
-		if !rrh.Selector.applicableTo(kuberesource.Pods, namespace, labels) {
+		if !rrh.Selector.applicableTo(kuberesource.Pods, namespace, labels, annotations) {
 			continue
 		}
 		for _, rh := range rrh.RestoreHooks {
@@ -508,9 +577,9 @@ func GroupRestoreExecHooks(
 				Hook:       *rh.Exec,
 				HookSource: "backupSpec",
 			}
-			// default to first container in pod if unset, without mutating resource restore hook
-			if named.Hook.Container == "" {
-				named.Hook.Container = pod.Spec.Containers[0].Name
+			// named.Hook is a copy, so this doesn't mutate the resource restore hook
+			if err := setRestoreHookContainer(pod, &named.Hook); err != nil {
+				return nil, errors.Wrapf(err, "error selecting container for hook %s", rrh.Name)
 			}
 			byContainer[named.Hook.Container] = append(byContainer[named.Hook.Container], named)
 		}
@@ -518,3 +587,19 @@ func GroupRestoreExecHooks(
 
 	return byContainer, nil
 }
+
+// setRestoreHookContainer sets the container a restore exec hook runs in to
+// the one whose image matches its containerImage, if it's set, or else
+// defaults it to the pod's first container.
+func setRestoreHookContainer(pod *corev1api.Pod, hook *velerov1api.ExecRestoreHook) error {
+	if hook.ContainerImage != "" {
+		container, err := podexec.ContainerForImage(pod, hook.ContainerImage, hook.ContainerImageFirstMatch)
+		if err != nil {
+			return err
+		}
+		hook.Container = container
+	} else if hook.Container == "" {
+		hook.Container = pod.Spec.Containers[0].Name
+	}
+	return nil
+}
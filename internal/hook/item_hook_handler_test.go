@@ -88,6 +88,9 @@ func TestHandleHooksSkips(t *testing.T) {
 						"name": "foo",
 						"labels": {
 							"color": "blue"
+						},
+						"annotations": {
+							"example.com/quiesce": "false"
 						}
 					}
 				}
@@ -106,6 +109,20 @@ func TestHandleHooksSkips(t *testing.T) {
 					Name:     "label selector mismatch",
 					Selector: ResourceHookSelector{LabelSelector: parseLabelSelectorOrDie("color=green")},
 				},
+				{
+					Name: "annotation selector mismatch",
+					Selector: ResourceHookSelector{
+						LabelSelector:      parseLabelSelectorOrDie("color=blue"),
+						AnnotationSelector: parseLabelSelectorOrDie("example.com/quiesce=true"),
+					},
+					Pre: []velerov1api.BackupResourceHook{
+						{
+							Exec: &velerov1api.ExecHook{
+								Command: []string{"/usr/bin/fsfreeze"},
+							},
+						},
+					},
+				},
 				{
 					Name: "missing exec hook",
 					Pre: []velerov1api.BackupResourceHook{
@@ -599,6 +616,19 @@ func TestGetPodExecHookFromAnnotations(t *testing.T) {
 					Command:   []string{"/usr/bin/foo"},
 				},
 			},
+			{
+				name: "use the specified container image",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookContainerImageAnnotationKey):           "*/mysql:*",
+					phasedKey(phase, podBackupHookContainerImageFirstMatchAnnotationKey): "true",
+					phasedKey(phase, podBackupHookCommandAnnotationKey):                  "/usr/bin/foo",
+				},
+				expectedHook: &velerov1api.ExecHook{
+					ContainerImage:           "*/mysql:*",
+					ContainerImageFirstMatch: true,
+					Command:                  []string{"/usr/bin/foo"},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -619,9 +649,11 @@ func TestResourceHookApplicableTo(t *testing.T) {
 		includedResources  []string
 		excludedResources  []string
 		labelSelector      string
+		annotationSelector string
 		namespace          string
 		resource           schema.GroupResource
 		labels             labels.Set
+		annotations        labels.Set
 		expected           bool
 	}{
 		{
@@ -680,6 +712,32 @@ func TestResourceHookApplicableTo(t *testing.T) {
 			labels:        labels.Set{"a": "c"},
 			expected:      false,
 		},
+		{
+			name:               "annotation selector matches",
+			annotationSelector: "a=b",
+			annotations:        labels.Set{"a": "b"},
+			expected:           true,
+		},
+		{
+			name:               "annotation selector with exists matches any value",
+			annotationSelector: "a",
+			annotations:        labels.Set{"a": "anything"},
+			expected:           true,
+		},
+		{
+			name:               "label selector matches but annotation selector doesn't",
+			labelSelector:      "a=b",
+			annotationSelector: "a=b",
+			labels:             labels.Set{"a": "b"},
+			annotations:        labels.Set{"a": "c"},
+			expected:           false,
+		},
+		{
+			name:               "annotation selector doesn't match missing annotation",
+			annotationSelector: "a",
+			labels:             labels.Set{"a": "b"},
+			expected:           false,
+		},
 	}
 
 	for _, test := range tests {
@@ -695,8 +753,13 @@ func TestResourceHookApplicableTo(t *testing.T) {
 				require.NoError(t, err)
 				h.Selector.LabelSelector = selector
 			}
+			if test.annotationSelector != "" {
+				selector, err := labels.Parse(test.annotationSelector)
+				require.NoError(t, err)
+				h.Selector.AnnotationSelector = selector
+			}
 
-			result := h.Selector.applicableTo(test.resource, test.namespace, test.labels)
+			result := h.Selector.applicableTo(test.resource, test.namespace, test.labels, test.annotations)
 			assert.Equal(t, test.expected, result)
 		})
 	}
@@ -710,6 +773,14 @@ func parseLabelSelectorOrDie(s string) labels.Selector {
 	return ret
 }
 
+func labelSelectorAsSelectorOrDie(s *metav1.LabelSelector) labels.Selector {
+	ret, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
 func TestGetPodExecRestoreHookFromAnnotations(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -792,6 +863,19 @@ func TestGetPodExecRestoreHookFromAnnotations(t *testing.T) {
 				Container: "my-app",
 			},
 		},
+		{
+			name: "container image should be in returned hook when set in annotation",
+			inputAnnotations: map[string]string{
+				podRestoreHookCommandAnnotationKey:                  "/usr/bin/foo",
+				podRestoreHookContainerImageAnnotationKey:           "*/my-app:*",
+				podRestoreHookContainerImageFirstMatchAnnotationKey: "true",
+			},
+			expected: &velerov1api.ExecRestoreHook{
+				Command:                  []string{"/usr/bin/foo"},
+				ContainerImage:           "*/my-app:*",
+				ContainerImageFirstMatch: true,
+			},
+		},
 		{
 			name: "bad exec timeout should be discarded",
 			inputAnnotations: map[string]string{
@@ -873,6 +957,33 @@ func TestGroupRestoreExecHooks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                 "should select the container whose image matches the annotation",
+			resourceRestoreHooks: nil,
+			pod: builder.ForPod("default", "my-pod").
+				ObjectMeta(builder.WithAnnotations(
+					podRestoreHookCommandAnnotationKey, "/usr/bin/foo",
+					podRestoreHookContainerImageAnnotationKey, "*/my-app:*",
+				)).
+				Containers(
+					&corev1api.Container{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.9.0"},
+					&corev1api.Container{Name: "app-5d8f", Image: "registry.example.com/my-app:1.0"},
+				).
+				Result(),
+			expected: map[string][]PodExecRestoreHook{
+				"app-5d8f": {
+					{
+						HookName:   "<from-annotation>",
+						HookSource: "annotation",
+						Hook: velerov1api.ExecRestoreHook{
+							Container:      "app-5d8f",
+							ContainerImage: "*/my-app:*",
+							Command:        []string{"/usr/bin/foo"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:                 "should default to first pod container when not set in annotation",
 			resourceRestoreHooks: nil,
@@ -1191,6 +1302,29 @@ func TestGroupRestoreExecHooks(t *testing.T) {
 	}
 }
 
+func TestGroupRestoreExecHooksContainerImageErrors(t *testing.T) {
+	resourceRestoreHooks := []ResourceRestoreHook{
+		{
+			Name:     "hook1",
+			Selector: ResourceHookSelector{},
+			RestoreHooks: []velerov1api.RestoreResourceHook{
+				{
+					Exec: &velerov1api.ExecRestoreHook{
+						ContainerImage: "*/my-app:*",
+						Command:        []string{"/usr/bin/foo"},
+					},
+				},
+			},
+		},
+	}
+	pod := builder.ForPod("default", "my-pod").
+		Containers(&corev1api.Container{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.9.0"}).
+		Result()
+
+	_, err := GroupRestoreExecHooks(resourceRestoreHooks, pod, velerotest.NewLogger())
+	assert.EqualError(t, err, `error selecting container for hook hook1: no container's image matches "*/my-app:*"`)
+}
+
 func TestGetInitRestoreHookFromAnnotations(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -1381,6 +1515,29 @@ func TestGetRestoreHooksFromSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should return hooks with the label and annotation selectors of the hookSpec",
+			hookSpec: &velerov1api.RestoreHooks{
+				Resources: []velerov1api.RestoreResourceHookSpec{
+					{
+						Name:               "h1",
+						LabelSelector:      &metav1.LabelSelector{MatchLabels: map[string]string{"app": "mysql"}},
+						AnnotationSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"example.com/quiesce": "true"}},
+					},
+				},
+			},
+			expected: []ResourceRestoreHook{
+				{
+					Name: "h1",
+					Selector: ResourceHookSelector{
+						Namespaces:         collections.NewIncludesExcludes(),
+						Resources:          collections.NewIncludesExcludes().Includes(kuberesource.Pods.Resource),
+						LabelSelector:      labelSelectorAsSelectorOrDie(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "mysql"}}),
+						AnnotationSelector: labelSelectorAsSelectorOrDie(&metav1.LabelSelector{MatchLabels: map[string]string{"example.com/quiesce": "true"}}),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
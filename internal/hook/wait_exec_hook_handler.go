@@ -29,16 +29,21 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
+// WaitExecHookHandler executes post-restore exec hooks in a pod's containers once they are
+// running, or once they are also ready for hooks with WaitForReady set. Hooks that fail or are
+// never executed are returned as warnings if their OnError mode is Continue, or as errors if it
+// is Fail.
 type WaitExecHookHandler interface {
 	HandleHooks(
 		ctx context.Context,
 		log logrus.FieldLogger,
 		pod *v1.Pod,
 		byContainer map[string][]PodExecRestoreHook,
-	) []error
+	) (warnings []error, errs []error)
 }
 
 type ListWatchFactory interface {
@@ -67,9 +72,9 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	log logrus.FieldLogger,
 	pod *v1.Pod,
 	byContainer map[string][]PodExecRestoreHook,
-) []error {
+) ([]error, []error) {
 	if pod == nil {
-		return nil
+		return nil, nil
 	}
 
 	// If hooks are defined for a container that does not exist in the pod log a warning and discard
@@ -82,7 +87,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 		}
 	}
 	if len(byContainer) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	// Every hook in every container can have its own wait timeout. Rather than setting up separate
@@ -98,11 +103,13 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	}
 	waitStart := time.Now()
 
-	var errors []error
+	var warnings, errors []error
 
 	// The first time this handler is called after a container starts running it will execute all
-	// pending hooks for that container. Subsequent invocations of this handler will never execute
-	// hooks in that container. It uses the byContainer map to keep track of which containers have
+	// pending hooks for that container, up to the first hook that waits for the container to be
+	// ready if it isn't yet. Those are executed once the container is observed ready. Once all of a
+	// container's hooks are executed, invocations of this handler will never execute hooks in that
+	// container. It uses the byContainer map to keep track of which containers have
 	// not yet been observed to be running. It relies on the Informer not to be called concurrently.
 	// When a container is observed running and its hooks are executed, the container is deleted
 	// from the byContainer map. When the map is empty the watch is ended.
@@ -130,6 +137,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				podLog.Infof("Container %s is not running: post-restore hooks will not yet be executed", containerName)
 				continue
 			}
+			ready := isContainerReady(newPod, containerName)
 			podMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newPod)
 			if err != nil {
 				podLog.WithError(err).Error("error unstructuring pod")
@@ -137,10 +145,22 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				return
 			}
 
-			// Sequentially run all hooks for the ready container. The container's hooks are not
+			// Sequentially run all hooks for the running container. The container's hooks are not
 			// removed from the byContainer map until all have completed so that if one fails
-			// remaining unexecuted hooks can be handled by the outer function.
+			// remaining unexecuted hooks can be handled by the outer function. A hook that waits
+			// for the container to be ready, and the hooks after it, are left for a later
+			// invocation if the container isn't ready yet.
+			waiting := false
 			for i, hook := range hooks {
+				if hook.executed {
+					continue
+				}
+				if boolptr.IsSetToTrue(hook.Hook.WaitForReady) && !ready {
+					podLog.Infof("Container %s is not ready: post-restore hook %s will not yet be executed", containerName, hook.HookName)
+					waiting = true
+					break
+				}
+
 				// This indicates to the outer function not to handle this hook as unexecuted in
 				// case of terminating before deleting this container's slice of hooks from the
 				// byContainer map.
@@ -162,6 +182,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 						cancel()
 						return
 					}
+					warnings = append(warnings, err)
 				}
 				eh := &velerov1api.ExecHook{
 					Container: hook.Hook.Container,
@@ -171,14 +192,18 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				}
 				if err := e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); err != nil {
 					hookLog.WithError(err).Error("Error executing hook")
+					err = fmt.Errorf("Hook %s in container %s in pod %s failed: %v", hook.HookName, hook.Hook.Container, kube.NamespaceAndName(pod), err)
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 						errors = append(errors, err)
 						cancel()
 						return
 					}
+					warnings = append(warnings, err)
 				}
 			}
-			delete(byContainer, containerName)
+			if !waiting {
+				delete(byContainer, containerName)
+			}
 		}
 		if len(byContainer) == 0 {
 			cancel()
@@ -206,7 +231,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	// be deleted, a hook with OnError mode Fail could fail, or it may timeout waiting for
 	// containers to become ready.
 	// Each unexecuted hook is logged as an error but only hooks with OnError mode Fail return
-	// an error from this function.
+	// an error from this function; the rest are returned as warnings.
 	for _, hooks := range byContainer {
 		for _, hook := range hooks {
 			if hook.executed {
@@ -223,11 +248,13 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 			hookLog.Error(err)
 			if hook.Hook.OnError == velerov1api.HookErrorModeFail {
 				errors = append(errors, err)
+				continue
 			}
+			warnings = append(warnings, err)
 		}
 	}
 
-	return errors
+	return warnings, errors
 }
 
 func podHasContainer(pod *v1.Pod, containerName string) bool {
@@ -257,6 +284,22 @@ func isContainerRunning(pod *v1.Pod, containerName string) bool {
 	return false
 }
 
+// isContainerReady returns true if the named container in the pod is running and has passed
+// its readiness checks.
+func isContainerReady(pod *v1.Pod, containerName string) bool {
+	if pod == nil {
+		return false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name != containerName {
+			continue
+		}
+		return cs.State.Running != nil && cs.Ready
+	}
+
+	return false
+}
+
 // maxHookWait returns 0 to mean wait indefinitely. Any hook without a wait timeout will cause this
 // function to return 0.
 func maxHookWait(byContainer map[string][]PodExecRestoreHook) time.Duration {
@@ -35,6 +35,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 type fakeListWatchFactory struct {
@@ -66,6 +67,7 @@ func TestWaitExecHandleHooks(t *testing.T) {
 		groupResource      string
 		byContainer        map[string][]PodExecRestoreHook
 		expectedExecutions []expectedExecution
+		expectedWarnings   []error
 		expectedErrors     []error
 		// changes represents the states of the pod over time. It can be used to test a container
 		// becoming ready at some point after it is first observed by the controller.
@@ -208,10 +210,10 @@ func TestWaitExecHandleHooks(t *testing.T) {
 						Result(),
 				},
 			},
-			expectedErrors: []error{errors.New("pod hook error")},
+			expectedErrors: []error{errors.New("Hook <from-annotation> in container container1 in pod default/my-pod failed: pod hook error")},
 		},
 		{
-			name: "should return no error when hook from annotation fails with on error mode continue",
+			name: "should return a warning when hook from annotation fails with on error mode continue",
 			initialPod: builder.ForPod("default", "my-pod").
 				ObjectMeta(builder.WithAnnotations(
 					podRestoreHookCommandAnnotationKey, "/usr/bin/foo",
@@ -277,7 +279,8 @@ func TestWaitExecHandleHooks(t *testing.T) {
 						Result(),
 				},
 			},
-			expectedErrors: nil,
+			expectedWarnings: []error{errors.New("Hook <from-annotation> in container container1 in pod default/my-pod failed: pod hook error")},
+			expectedErrors:   nil,
 		},
 		{
 			name: "should return no error when hook from annotation executes after 10ms wait for container to start",
@@ -421,7 +424,7 @@ func TestWaitExecHandleHooks(t *testing.T) {
 			},
 		},
 		{
-			name:          "should return no error when spec hook with wait timeout expires with OnError mode Continue",
+			name:          "should return a warning when spec hook with wait timeout expires with OnError mode Continue",
 			groupResource: "pods",
 			initialPod: builder.ForPod("default", "my-pod").
 				Containers(&v1.Container{
@@ -434,7 +437,8 @@ func TestWaitExecHandleHooks(t *testing.T) {
 					},
 				}).
 				Result(),
-			expectedErrors: nil,
+			expectedWarnings: []error{errors.New("Hook my-hook-1 in container container1 in pod default/my-pod not executed: context deadline exceeded")},
+			expectedErrors:   nil,
 			byContainer: map[string][]PodExecRestoreHook{
 				"container1": {
 					{
@@ -514,9 +518,10 @@ func TestWaitExecHandleHooks(t *testing.T) {
 			sharedHooksContextTimeout: time.Millisecond,
 		},
 		{
-			name:           "should return no error when shared hooks context is canceled before spec hook with OnError mode Continue executes",
-			expectedErrors: nil,
-			groupResource:  "pods",
+			name:             "should return a warning when shared hooks context is canceled before spec hook with OnError mode Continue executes",
+			expectedWarnings: []error{errors.New("Hook my-hook-1 in container container1 in pod default/my-pod not executed: context deadline exceeded")},
+			expectedErrors:   nil,
+			groupResource:    "pods",
 			initialPod: builder.ForPod("default", "my-pod").
 				Containers(&v1.Container{
 					Name: "container1",
@@ -705,6 +710,185 @@ func TestWaitExecHandleHooks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "should execute spec hook once container is running but not ready",
+			groupResource: "pods",
+			initialPod: builder.ForPod("default", "my-pod").
+				Containers(&v1.Container{
+					Name: "container1",
+				}).
+				ContainerStatuses(&v1.ContainerStatus{
+					Name: "container1",
+					State: v1.ContainerState{
+						Running: &v1.ContainerStateRunning{},
+					},
+				}).
+				Result(),
+			expectedErrors: nil,
+			byContainer: map[string][]PodExecRestoreHook{
+				"container1": {
+					{
+						HookName:   "my-hook-1",
+						HookSource: "backupSpec",
+						Hook: velerov1api.ExecRestoreHook{
+							Container: "container1",
+							Command:   []string{"/usr/bin/foo"},
+						},
+					},
+				},
+			},
+			expectedExecutions: []expectedExecution{
+				{
+					name: "my-hook-1",
+					hook: &velerov1api.ExecHook{
+						Container: "container1",
+						Command:   []string{"/usr/bin/foo"},
+					},
+					error: nil,
+					pod: builder.ForPod("default", "my-pod").
+						Containers(&v1.Container{
+							Name: "container1",
+						}).
+						ContainerStatuses(&v1.ContainerStatus{
+							Name: "container1",
+							State: v1.ContainerState{
+								Running: &v1.ContainerStateRunning{},
+							},
+						}).
+						Result(),
+				},
+			},
+		},
+		{
+			name:          "should execute spec hook with wait for ready set after container running but not ready becomes ready",
+			groupResource: "pods",
+			initialPod: builder.ForPod("default", "my-pod").
+				Containers(&v1.Container{
+					Name: "container1",
+				}).
+				ContainerStatuses(&v1.ContainerStatus{
+					Name: "container1",
+					State: v1.ContainerState{
+						Running: &v1.ContainerStateRunning{},
+					},
+				}).
+				Result(),
+			expectedErrors: nil,
+			byContainer: map[string][]PodExecRestoreHook{
+				"container1": {
+					{
+						HookName:   "my-hook-1",
+						HookSource: "backupSpec",
+						Hook: velerov1api.ExecRestoreHook{
+							Container: "container1",
+							Command:   []string{"/usr/bin/foo"},
+						},
+					},
+					{
+						HookName:   "my-hook-2",
+						HookSource: "backupSpec",
+						Hook: velerov1api.ExecRestoreHook{
+							Container:    "container1",
+							Command:      []string{"/usr/bin/bar"},
+							WaitForReady: boolptr.True(),
+						},
+					},
+				},
+			},
+			expectedExecutions: []expectedExecution{
+				{
+					name: "my-hook-1",
+					hook: &velerov1api.ExecHook{
+						Container: "container1",
+						Command:   []string{"/usr/bin/foo"},
+					},
+					error: nil,
+					pod: builder.ForPod("default", "my-pod").
+						Containers(&v1.Container{
+							Name: "container1",
+						}).
+						ContainerStatuses(&v1.ContainerStatus{
+							Name: "container1",
+							State: v1.ContainerState{
+								Running: &v1.ContainerStateRunning{},
+							},
+						}).
+						Result(),
+				},
+				{
+					name: "my-hook-2",
+					hook: &velerov1api.ExecHook{
+						Container: "container1",
+						Command:   []string{"/usr/bin/bar"},
+					},
+					error: nil,
+					pod: builder.ForPod("default", "my-pod").
+						ObjectMeta(builder.WithResourceVersion("2")).
+						Containers(&v1.Container{
+							Name: "container1",
+						}).
+						ContainerStatuses(&v1.ContainerStatus{
+							Name:  "container1",
+							Ready: true,
+							State: v1.ContainerState{
+								Running: &v1.ContainerStateRunning{},
+							},
+						}).
+						Result(),
+				},
+			},
+			changes: []change{
+				{
+					wait: 10 * time.Millisecond,
+					updated: builder.ForPod("default", "my-pod").
+						ObjectMeta(builder.WithResourceVersion("2")).
+						Containers(&v1.Container{
+							Name: "container1",
+						}).
+						ContainerStatuses(&v1.ContainerStatus{
+							Name:  "container1",
+							Ready: true,
+							State: v1.ContainerState{
+								Running: &v1.ContainerStateRunning{},
+							},
+						}).
+						Result(),
+				},
+			},
+		},
+		{
+			name:          "should return a warning when container never becomes ready before spec hook with wait for ready set and wait timeout expires",
+			groupResource: "pods",
+			initialPod: builder.ForPod("default", "my-pod").
+				Containers(&v1.Container{
+					Name: "container1",
+				}).
+				ContainerStatuses(&v1.ContainerStatus{
+					Name: "container1",
+					State: v1.ContainerState{
+						Running: &v1.ContainerStateRunning{},
+					},
+				}).
+				Result(),
+			expectedWarnings: []error{errors.New("Hook my-hook-1 in container container1 in pod default/my-pod not executed: context deadline exceeded")},
+			expectedErrors:   nil,
+			byContainer: map[string][]PodExecRestoreHook{
+				"container1": {
+					{
+						HookName:   "my-hook-1",
+						HookSource: "backupSpec",
+						Hook: velerov1api.ExecRestoreHook{
+							Container:    "container1",
+							Command:      []string{"/usr/bin/foo"},
+							OnError:      velerov1api.HookErrorModeContinue,
+							WaitTimeout:  metav1.Duration{time.Millisecond},
+							WaitForReady: boolptr.True(),
+						},
+					},
+				},
+			},
+			expectedExecutions: []expectedExecution{},
+		},
 	}
 
 	for _, test := range tests {
@@ -741,7 +925,12 @@ func TestWaitExecHandleHooks(t *testing.T) {
 				ctx, _ = context.WithTimeout(ctx, test.sharedHooksContextTimeout)
 			}
 
-			errs := h.HandleHooks(ctx, velerotest.NewLogger(), test.initialPod, test.byContainer)
+			warnings, errs := h.HandleHooks(ctx, velerotest.NewLogger(), test.initialPod, test.byContainer)
+
+			require.Len(t, warnings, len(test.expectedWarnings))
+			for i, ew := range test.expectedWarnings {
+				assert.EqualError(t, warnings[i], ew.Error())
+			}
 
 			// for i, ee := range test.expectedErrors {
 			require.Len(t, errs, len(test.expectedErrors))
@@ -860,6 +1049,63 @@ func TestIsContainerRunning(t *testing.T) {
 	}
 }
 
+func TestIsContainerReady(t *testing.T) {
+	tests := []struct {
+		name      string
+		pod       *v1.Pod
+		container string
+		expect    bool
+	}{
+		{
+			name:      "should return true when running and ready",
+			container: "container1",
+			expect:    true,
+			pod: builder.ForPod("default", "my-pod").
+				ContainerStatuses(&v1.ContainerStatus{
+					Name:  "container1",
+					Ready: true,
+					State: v1.ContainerState{
+						Running: &v1.ContainerStateRunning{},
+					},
+				}).
+				Result(),
+		},
+		{
+			name:      "should return false when running but not ready",
+			container: "container1",
+			expect:    false,
+			pod: builder.ForPod("default", "my-pod").
+				ContainerStatuses(&v1.ContainerStatus{
+					Name: "container1",
+					State: v1.ContainerState{
+						Running: &v1.ContainerStateRunning{},
+					},
+				}).
+				Result(),
+		},
+		{
+			name:      "should return false when ready but waiting",
+			container: "container1",
+			expect:    false,
+			pod: builder.ForPod("default", "my-pod").
+				ContainerStatuses(&v1.ContainerStatus{
+					Name:  "container1",
+					Ready: true,
+					State: v1.ContainerState{
+						Waiting: &v1.ContainerStateWaiting{},
+					},
+				}).
+				Result(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := isContainerReady(test.pod, test.container)
+			assert.Equal(t, actual, test.expect)
+		})
+	}
+}
+
 func TestMaxHookWait(t *testing.T) {
 	tests := []struct {
 		name        string
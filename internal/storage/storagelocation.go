@@ -35,6 +35,12 @@ type DefaultBackupLocationInfo struct {
 	StorageLocation string
 	// ServerValidationFrequency is the server default validation frequency for all backup storage locations
 	ServerValidationFrequency time.Duration
+	// ValidationMaxFailures is the number of consecutive failed validations after which a backup storage location is marked unavailable
+	ValidationMaxFailures int
+	// ValidationBackoffBase is the delay before revalidating a backup storage location after its first failed validation
+	ValidationBackoffBase time.Duration
+	// ValidationBackoffCap is the maximum delay between revalidations of a failing backup storage location
+	ValidationBackoffCap time.Duration
 }
 
 // IsReadyToValidate calculates if a given backup storage location is ready to be validated.
@@ -77,6 +83,41 @@ func IsReadyToValidate(bslValidationFrequency *metav1.Duration, lastValidationTi
 	return true
 }
 
+// ValidationBackoff returns the delay before revalidating a backup storage location that has failed
+// validation consecutiveFailures times in a row. The delay starts at base and doubles with each
+// additional failure, up to cap. If cap is not positive, the delay is always base.
+func ValidationBackoff(consecutiveFailures int, base, cap time.Duration) time.Duration {
+	if consecutiveFailures <= 0 || base <= 0 {
+		return 0
+	}
+
+	if cap <= 0 {
+		return base
+	}
+
+	backoff := base
+	for i := 1; i < consecutiveFailures && backoff < cap; i++ {
+		backoff *= 2
+	}
+
+	if backoff > cap {
+		return cap
+	}
+	return backoff
+}
+
+// IsReadyToRetryValidation calculates if a backup storage location which has failed validation
+// consecutiveFailures times in a row is ready to be validated again, i.e. the backoff delay has
+// elapsed since its last validation.
+func IsReadyToRetryValidation(consecutiveFailures int, lastValidationTime *metav1.Time, base, cap time.Duration) bool {
+	if lastValidationTime == nil {
+		return true
+	}
+
+	nextValidation := lastValidationTime.Add(ValidationBackoff(consecutiveFailures, base, cap))
+	return !time.Now().UTC().Before(nextValidation)
+}
+
 // ListBackupStorageLocations verifies if there are any backup storage locations.
 // For all purposes, if either there is an error while attempting to fetch items or
 // if there are no items an error would be returned since the functioning of the system
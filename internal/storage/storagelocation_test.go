@@ -126,6 +126,98 @@ func TestIsReadyToValidate(t *testing.T) {
 	}
 }
 
+func TestValidationBackoff(t *testing.T) {
+	tests := []struct {
+		name                string
+		consecutiveFailures int
+		base, cap           time.Duration
+		want                time.Duration
+	}{
+		{
+			name:                "no failures has no backoff",
+			consecutiveFailures: 0,
+			base:                10 * time.Second,
+			cap:                 time.Minute,
+			want:                0,
+		},
+		{
+			name:                "first failure backs off by the base",
+			consecutiveFailures: 1,
+			base:                10 * time.Second,
+			cap:                 time.Minute,
+			want:                10 * time.Second,
+		},
+		{
+			name:                "backoff doubles with each failure",
+			consecutiveFailures: 3,
+			base:                10 * time.Second,
+			cap:                 time.Minute,
+			want:                40 * time.Second,
+		},
+		{
+			name:                "backoff is limited to the cap",
+			consecutiveFailures: 4,
+			base:                10 * time.Second,
+			cap:                 time.Minute,
+			want:                time.Minute,
+		},
+		{
+			name:                "backoff is limited to the cap for many failures",
+			consecutiveFailures: 1000,
+			base:                10 * time.Second,
+			cap:                 time.Minute,
+			want:                time.Minute,
+		},
+		{
+			name:                "backoff is the base when the cap is not set",
+			consecutiveFailures: 5,
+			base:                10 * time.Second,
+			want:                10 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(ValidationBackoff(tt.consecutiveFailures, tt.base, tt.cap)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestIsReadyToRetryValidation(t *testing.T) {
+	tests := []struct {
+		name                string
+		consecutiveFailures int
+		lastValidationTime  *metav1.Time
+		ready               bool
+	}{
+		{
+			name:                "retry when the location has never been validated",
+			consecutiveFailures: 1,
+			ready:               true,
+		},
+		{
+			name:                "don't retry before the backoff has elapsed",
+			consecutiveFailures: 2,
+			lastValidationTime:  &metav1.Time{Time: time.Now().Add(-15 * time.Second)},
+			ready:               false,
+		},
+		{
+			name:                "retry once the backoff has elapsed",
+			consecutiveFailures: 2,
+			lastValidationTime:  &metav1.Time{Time: time.Now().Add(-25 * time.Second)},
+			ready:               true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(IsReadyToRetryValidation(tt.consecutiveFailures, tt.lastValidationTime, 10*time.Second, time.Minute)).To(BeIdenticalTo(tt.ready))
+		})
+	}
+}
+
 func TestListBackupStorageLocations(t *testing.T) {
 	tests := []struct {
 		name            string
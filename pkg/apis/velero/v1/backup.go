@@ -53,6 +53,74 @@ type BackupSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// ResourceLabelSelectors maps group-resources, such as "secrets" or
+	// "deployments.apps", to label selectors that the resource's objects must
+	// also match to be added to the backup. Resources without an entry are
+	// only filtered by LabelSelector.
+	// +optional
+	// +nullable
+	ResourceLabelSelectors map[string]string `json:"resourceLabelSelectors,omitempty"`
+
+	// ExcludedAnnotation excludes objects carrying a matching annotation
+	// from the backup, regardless of the included and excluded namespaces
+	// and resources. If nil, no objects are excluded by annotation.
+	// +optional
+	// +nullable
+	ExcludedAnnotation *AnnotationMatch `json:"excludedAnnotation,omitempty"`
+
+	// ItemFilter filters objects by their group, resource, namespace and
+	// name together, in addition to the other filters. If nil, objects
+	// aren't filtered this way.
+	// +optional
+	// +nullable
+	ItemFilter *ItemFilter `json:"itemFilter,omitempty"`
+
+	// ExcludeDefaultResources excludes the objects that Kubernetes creates
+	// on its own, such as each namespace's default service account and
+	// kube-root-ca.crt config map, unless the item filter includes them
+	// explicitly. The objects excluded are set by the server.
+	// +optional
+	// +nullable
+	ExcludeDefaultResources *bool `json:"excludeDefaultResources,omitempty"`
+
+	// ExcludedOwnerKinds excludes objects that have an owner reference of
+	// one of these kinds, such as pods owned by ReplicaSets that are
+	// recreated by their owners on restore. Kinds are formatted as
+	// "Kind.group", such as "ReplicaSet.apps", or "Kind" for the core API
+	// group. Only objects of included resources are excluded, and pods
+	// with volumes backed up by restic are always kept.
+	// +optional
+	// +nullable
+	ExcludedOwnerKinds []string `json:"excludedOwnerKinds,omitempty"`
+
+	// ModifiedSince, if set, leaves out the objects that weren't created or
+	// modified in this window before the backup started, going by their
+	// creation timestamp and the times of their managed fields. Objects
+	// whose last modification time isn't known are included, as are
+	// namespaces and additional items returned by plugins.
+	// +optional
+	// +nullable
+	ModifiedSince *metav1.Duration `json:"modifiedSince,omitempty"`
+
+	// ExcludedFields maps group-resources, such as "pods" or
+	// "deployments.apps", to fields that are removed from the resource's
+	// objects before they're added to the backup. Fields are dot-separated
+	// paths, such as "status" or "metadata.managedFields". The "*" key applies
+	// to all resources.
+	// +optional
+	// +nullable
+	ExcludedFields map[string][]string `json:"excludedFields,omitempty"`
+
+	// ResourceAPIVersions maps group-resources, such as
+	// "widgets.example.com", to the API versions they're backed up at in
+	// addition to their preferred version, so that restores can choose the
+	// version to apply. The "*" version backs a resource up at every version
+	// it's served at. Items are converted to each version by the API server,
+	// which calls the conversion webhook of custom resources that have one.
+	// +optional
+	// +nullable
+	ResourceAPIVersions map[string][]string `json:"resourceAPIVersions,omitempty"`
+
 	// SnapshotVolumes specifies whether to take cloud snapshots
 	// of any PV's referenced in the set of objects included
 	// in the Backup.
@@ -71,6 +139,26 @@ type BackupSpec struct {
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
 
+	// IncludeRelatedClusterResources specifies whether the cluster-scoped
+	// resources referenced by the backed up namespaced resources, such as
+	// the storage class of a persistent volume claim or the cluster role of
+	// a role binding, should be included in the backup when
+	// IncludeClusterResources is unset and only some namespaces are
+	// backed up.
+	// +optional
+	// +nullable
+	IncludeRelatedClusterResources *bool `json:"includeRelatedClusterResources,omitempty"`
+
+	// IncludeAPIServices specifies whether the APIServices that register
+	// aggregated API servers should be included in the backup. When true,
+	// the APIServices whose service is in an included namespace are backed
+	// up even if cluster-scoped resources aren't. When false, no APIServices
+	// are backed up. When unset, APIServices are included or excluded like
+	// any other cluster-scoped resource.
+	// +optional
+	// +nullable
+	IncludeAPIServices *bool `json:"includeAPIServices,omitempty"`
+
 	// Hooks represent custom behaviors that should be executed at different phases of the backup.
 	// +optional
 	Hooks BackupHooks `json:"hooks,omitempty"`
@@ -79,6 +167,13 @@ type BackupSpec struct {
 	// +optional
 	StorageLocation string `json:"storageLocation,omitempty"`
 
+	// MirrorStorageLocations is a list of names of additional BackupStorageLocations
+	// that the backup is copied to after it's stored in StorageLocation. Copying the
+	// backup to them is best-effort, and doesn't affect the backup's phase.
+	// +optional
+	// +nullable
+	MirrorStorageLocations []string `json:"mirrorStorageLocations,omitempty"`
+
 	// VolumeSnapshotLocations is a list containing names of VolumeSnapshotLocations associated with this backup.
 	// +optional
 	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
@@ -95,6 +190,123 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	OrderedResources map[string]string `json:"orderedResources,omitempty"`
+
+	// Compression is the algorithm used to compress the backup tarball.
+	// Defaults to the server's default backup compression.
+	// +optional
+	Compression CompressionAlgorithm `json:"compression,omitempty"`
+
+	// Incremental specifies whether the items that haven't changed since
+	// the previous completed backup of the same schedule are left out of
+	// the backup tarball, which references that backup's contents for them
+	// instead. Backups that weren't created by a schedule, or whose schedule
+	// has no usable previous backup, are full backups.
+	// +optional
+	// +nullable
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// RedactSecrets specifies whether the data values of the backed up
+	// secrets are replaced with a placeholder, keeping their keys and
+	// metadata, so the backup can be archived without them. Secrets can't
+	// be restored from a backup whose secrets are redacted.
+	// +optional
+	// +nullable
+	RedactSecrets *bool `json:"redactSecrets,omitempty"`
+
+	// ItemFailureThresholds set how many of the backup's items can fail to
+	// be backed up for it to still be Completed, and the resources whose
+	// items fail the backup if they fail. If nil, any error makes the
+	// backup PartiallyFailed.
+	// +optional
+	// +nullable
+	ItemFailureThresholds *ItemFailureThresholds `json:"itemFailureThresholds,omitempty"`
+}
+
+// ItemFailureThresholds set how a backup's phase depends on the items that
+// failed to be backed up. A backup whose only errors are those of its failed
+// items is Completed if its failed items are within both thresholds that are
+// set, and PartiallyFailed otherwise. Errors that aren't those of an item
+// always make the backup PartiallyFailed.
+type ItemFailureThresholds struct {
+	// MaxFailedItems is the largest number of failed items for which the
+	// backup is Completed. If nil, the number of failed items isn't limited
+	// by itself.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	MaxFailedItems *int `json:"maxFailedItems,omitempty"`
+
+	// MaxFailedItemsPercent is the largest percentage of the backup's
+	// attempted items that can fail for the backup to be Completed. If nil,
+	// the percentage of failed items isn't limited by itself.
+	// +optional
+	// +nullable
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxFailedItemsPercent *int `json:"maxFailedItemsPercent,omitempty"`
+
+	// CriticalResources are the resources, such as "secrets" or
+	// "deployments.apps", whose items make the backup Failed if any of them
+	// fails to be backed up, whatever the thresholds.
+	// +optional
+	// +nullable
+	CriticalResources []string `json:"criticalResources,omitempty"`
+}
+
+// AnnotationMatch matches objects by one of their annotations.
+type AnnotationMatch struct {
+	// Key is the key of the annotation.
+	Key string `json:"key"`
+
+	// Value is the value the annotation must have. If empty, objects
+	// with the annotation match regardless of its value.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// Matches returns whether annotations contain an annotation matching m.
+// A nil AnnotationMatch matches nothing.
+func (m *AnnotationMatch) Matches(annotations map[string]string) bool {
+	if m == nil {
+		return false
+	}
+
+	value, ok := annotations[m.Key]
+	if !ok {
+		return false
+	}
+	return m.Value == "" || value == m.Value
+}
+
+// String returns m formatted as "key=value", or as "key" if m matches any
+// value.
+func (m *AnnotationMatch) String() string {
+	if m.Value == "" {
+		return m.Key
+	}
+	return m.Key + "=" + m.Value
+}
+
+// ItemFilter includes and excludes objects by patterns matched against
+// their item keys, of the form "<group>/<resource>:<namespace>/<name>".
+// The group is "core" for the core API group, and the namespace is empty
+// for cluster-scoped objects, e.g. "core/configmaps:kube-system/coredns"
+// or "rbac.authorization.k8s.io/clusterroles:/admin". Patterns are globs,
+// in which '*' also matches '/' and ':', or regular expressions if they're
+// prefixed with "regex:".
+type ItemFilter struct {
+	// IncludedItems are patterns of the item keys of objects to include.
+	// If empty, all objects are included.
+	// +optional
+	// +nullable
+	IncludedItems []string `json:"includedItems,omitempty"`
+
+	// ExcludedItems are patterns of the item keys of objects to exclude.
+	// Patterns prefixed with '!' re-include objects that match another
+	// exclude.
+	// +optional
+	// +nullable
+	ExcludedItems []string `json:"excludedItems,omitempty"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -103,6 +315,70 @@ type BackupHooks struct {
 	// +optional
 	// +nullable
 	Resources []BackupResourceHookSpec `json:"resources,omitempty"`
+
+	// Backup are hooks that are executed once per backup, rather than for
+	// each matching pod. Their pre hooks are executed before any of the
+	// backup's items are backed up, and their post hooks after all of them
+	// have been.
+	// +optional
+	// +nullable
+	Backup []BackupLevelHookSpec `json:"backup,omitempty"`
+}
+
+// BackupLevelHookSpec defines one or more BackupLevelHooks that are executed
+// once per backup.
+type BackupLevelHookSpec struct {
+	// Name is the name of this hook.
+	Name string `json:"name"`
+
+	// PreHooks is a list of BackupLevelHooks to execute before any of the
+	// backup's items are backed up.
+	// +optional
+	PreHooks []BackupLevelHook `json:"pre,omitempty"`
+
+	// PostHooks is a list of BackupLevelHooks to execute after all of the
+	// backup's items are backed up. They're executed even if the backup is
+	// canceled or one of its pre hooks fails, as long as this spec's pre
+	// hooks were started.
+	// +optional
+	PostHooks []BackupLevelHook `json:"post,omitempty"`
+}
+
+// BackupLevelHook defines a backup-level hook. Exactly one of Exec and
+// Plugin must be specified.
+type BackupLevelHook struct {
+	// Exec defines a hook that executes a command in a container of a pod.
+	// +optional
+	// +nullable
+	Exec *BackupLevelExecHook `json:"exec,omitempty"`
+
+	// Plugin defines a hook that invokes a BackupHookAction plugin.
+	// +optional
+	// +nullable
+	Plugin *BackupPluginHook `json:"plugin,omitempty"`
+}
+
+// BackupLevelExecHook is an ExecHook that's executed in the first running pod,
+// by name, that matches its pod selector.
+type BackupLevelExecHook struct {
+	// Namespace is the namespace of the pod the command is executed in.
+	Namespace string `json:"namespace"`
+
+	// PodSelector selects the pod the command is executed in.
+	PodSelector *metav1.LabelSelector `json:"podSelector"`
+
+	ExecHook `json:",inline"`
+}
+
+// BackupPluginHook is a hook that invokes a BackupHookAction plugin.
+type BackupPluginHook struct {
+	// Name is the name of the BackupHookAction plugin.
+	Name string `json:"name"`
+
+	// OnError specifies how Velero should behave if the plugin returns an
+	// error. If it's not specified, the hook fails the backup.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
 }
 
 // BackupResourceHookSpec defines one or more BackupResourceHooks that should be executed based on
@@ -138,6 +414,15 @@ type BackupResourceHookSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// AnnotationSelector, if specified, filters the resources to which this hook spec applies by
+	// their annotations, the same way LabelSelector does by their labels: its matchLabels select
+	// resources whose annotations have the given values, and a matchExpressions requirement with
+	// the Exists operator selects resources that have an annotation with any value. The values
+	// must be valid label values.
+	// +optional
+	// +nullable
+	AnnotationSelector *metav1.LabelSelector `json:"annotationSelector,omitempty"`
+
 	// PreHooks is a list of BackupResourceHooks to execute prior to storing the item in the backup.
 	// These are executed before any "additional items" from item actions are processed.
 	// +optional
@@ -162,6 +447,18 @@ type ExecHook struct {
 	// +optional
 	Container string `json:"container,omitempty"`
 
+	// ContainerImage is a glob, or a regular expression if it's prefixed with "regex:", matching the
+	// image of the container in the pod where the command should be executed. It takes precedence over
+	// Container, and it's an error if no container's image matches or if more than one does, unless
+	// ContainerImageFirstMatch is true.
+	// +optional
+	ContainerImage string `json:"containerImage,omitempty"`
+
+	// ContainerImageFirstMatch selects the first of the pod's containers whose image matches
+	// ContainerImage when more than one does.
+	// +optional
+	ContainerImageFirstMatch bool `json:"containerImageFirstMatch,omitempty"`
+
 	// Command is the command and arguments to execute.
 	// +kubebuilder:validation:MinItems=1
 	Command []string `json:"command"`
@@ -190,9 +487,27 @@ const (
 	HookErrorModeFail HookErrorMode = "Fail"
 )
 
+// CompressionAlgorithm is the algorithm used to compress a backup tarball.
+// +kubebuilder:validation:Enum=gzip;zstd;lz4;none
+type CompressionAlgorithm string
+
+const (
+	// CompressionAlgorithmGzip compresses the backup tarball with gzip.
+	CompressionAlgorithmGzip CompressionAlgorithm = "gzip"
+
+	// CompressionAlgorithmZstd compresses the backup tarball with zstd.
+	CompressionAlgorithmZstd CompressionAlgorithm = "zstd"
+
+	// CompressionAlgorithmLZ4 compresses the backup tarball with lz4.
+	CompressionAlgorithmLZ4 CompressionAlgorithm = "lz4"
+
+	// CompressionAlgorithmNone leaves the backup tarball uncompressed.
+	CompressionAlgorithmNone CompressionAlgorithm = "none"
+)
+
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Cancelled;Deleting
 type BackupPhase string
 
 const (
@@ -219,6 +534,11 @@ const (
 	// prevented it from completing successfully.
 	BackupPhaseFailed BackupPhase = "Failed"
 
+	// BackupPhaseCancelled means the backup's cancellation was requested
+	// while it was in progress, so it stopped before backing up all of its
+	// items.
+	BackupPhaseCancelled BackupPhase = "Cancelled"
+
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
@@ -234,6 +554,11 @@ type BackupStatus struct {
 	// +optional
 	FormatVersion string `json:"formatVersion,omitempty"`
 
+	// CompressionAlgorithm is the algorithm the backup tarball was compressed
+	// with. Backups without it were compressed with gzip.
+	// +optional
+	CompressionAlgorithm CompressionAlgorithm `json:"compressionAlgorithm,omitempty"`
+
 	// Expiration is when this Backup is eligible for garbage-collection.
 	// +optional
 	// +nullable
@@ -249,6 +574,17 @@ type BackupStatus struct {
 	// +nullable
 	ValidationErrors []string `json:"validationErrors,omitempty"`
 
+	// ContentsChecksum is the checksum of the backup's contents tarball,
+	// in the form sha256:<hex>, recorded before it's uploaded.
+	// +optional
+	ContentsChecksum string `json:"contentsChecksum,omitempty"`
+
+	// Encrypted is true if the backup's contents tarball was encrypted with
+	// its storage location's encryption key when it was uploaded. The
+	// contents of an encrypted backup must be encrypted when they're read.
+	// +optional
+	Encrypted bool `json:"encrypted,omitempty"`
+
 	// StartTimestamp records the time a backup was started.
 	// Separate from CreationTimestamp, since that value changes
 	// on restores.
@@ -275,6 +611,13 @@ type BackupStatus struct {
 	// +optional
 	VolumeSnapshotsCompleted int `json:"volumeSnapshotsCompleted,omitempty"`
 
+	// PodVolumeBackupSize is the size of the data backed up by the backup's
+	// restic pod volume backups. Volume snapshots aren't included, since
+	// their size isn't known to Velero.
+	// +optional
+	// +nullable
+	PodVolumeBackupSize *PodVolumeBackupSize `json:"podVolumeBackupSize,omitempty"`
+
 	// Warnings is a count of all warning messages that were generated during
 	// execution of the backup. The actual warnings are in the backup's log
 	// file in object storage.
@@ -293,6 +636,246 @@ type BackupStatus struct {
 	// +optional
 	// +nullable
 	Progress *BackupProgress `json:"progress,omitempty"`
+
+	// FilteredItems counts the items that Velero evaluated and left out of
+	// the backup because of each of its filters.
+	// +optional
+	// +nullable
+	FilteredItems *BackupFilteredItems `json:"filteredItems,omitempty"`
+
+	// MirrorStatuses records whether the backup was copied to each of its
+	// mirror storage locations.
+	// +optional
+	// +nullable
+	MirrorStatuses []BackupMirrorStatus `json:"mirrorStatuses,omitempty"`
+
+	// ArchivedNamespaces maps each namespace in the backup that was renamed by
+	// a namespace mapper plugin to the name it's recorded under in the backup
+	// tarball. Restores reverse it, unless their namespace mapping maps the
+	// archived name elsewhere.
+	// +optional
+	// +nullable
+	ArchivedNamespaces map[string]string `json:"archivedNamespaces,omitempty"`
+
+	// BaseBackup is the name of the backup an incremental backup was
+	// compared against. The items that hadn't changed since are stored in
+	// the contents of the base backup, or of the backups it's based on in
+	// turn. It's empty for full backups.
+	// +optional
+	BaseBackup string `json:"baseBackup,omitempty"`
+
+	// HookStatus contains the results of the backup hooks that were run
+	// during the backup.
+	// +optional
+	// +nullable
+	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// ItemFailures summarizes the items that failed to be backed up. It's
+	// only set if any did.
+	// +optional
+	// +nullable
+	ItemFailures *BackupItemFailures `json:"itemFailures,omitempty"`
+}
+
+// BackupItemFailures summarizes the items that failed to be backed up, which
+// are listed in the backup's item status manifest in object storage.
+type BackupItemFailures struct {
+	// FailedItems is the number of items that failed to be backed up.
+	// +optional
+	FailedItems int `json:"failedItems,omitempty"`
+
+	// AttemptedItems is the number of items that Velero tried to back up,
+	// including the ones that failed.
+	// +optional
+	AttemptedItems int `json:"attemptedItems,omitempty"`
+
+	// FailedPercent is the percentage of the attempted items that failed,
+	// with two decimal places, such as "1.25".
+	// +optional
+	FailedPercent string `json:"failedPercent,omitempty"`
+
+	// CriticalFailedItems is the number of failed items of the backup's
+	// critical resources.
+	// +optional
+	CriticalFailedItems int `json:"criticalFailedItems,omitempty"`
+}
+
+// HookStatus summarizes the backup hooks that were run during a backup.
+type HookStatus struct {
+	// HooksAttempted is the number of hooks that were run.
+	// +optional
+	HooksAttempted int `json:"hooksAttempted,omitempty"`
+
+	// HooksFailed is the number of hooks that failed.
+	// +optional
+	HooksFailed int `json:"hooksFailed,omitempty"`
+
+	// Results are the results of the hooks, in the order they were run. At
+	// most 100 are recorded, to keep the backup's size bounded. Once there
+	// are that many, the results of hooks that succeeded are dropped first.
+	// +optional
+	// +nullable
+	Results []BackupHookResult `json:"results,omitempty"`
+
+	// ResultsOmitted is the number of hooks whose results were dropped from
+	// Results.
+	// +optional
+	ResultsOmitted int `json:"resultsOmitted,omitempty"`
+}
+
+// BackupHookPhase is the phase of an item's backup, or of the backup itself
+// for backup-level hooks, that a hook is run in.
+// +kubebuilder:validation:Enum=pre;post
+type BackupHookPhase string
+
+const (
+	// BackupHookPhasePre means the hook is run before the item is backed up.
+	BackupHookPhasePre BackupHookPhase = "pre"
+
+	// BackupHookPhasePost means the hook is run after the item is backed up.
+	BackupHookPhasePost BackupHookPhase = "post"
+)
+
+// BackupHookResult is the result of running a backup hook.
+type BackupHookResult struct {
+	// Name is the name of the hook's resource or backup-level hook spec,
+	// or <from-annotation> for hooks specified by the pod's annotations.
+	Name string `json:"name"`
+
+	// Phase is whether the hook was run before or after the pod, or for
+	// backup-level hooks the backup's items, was backed up.
+	Phase BackupHookPhase `json:"phase"`
+
+	// Namespace is the namespace of the pod the hook was run in. It's empty
+	// for plugin hooks.
+	Namespace string `json:"namespace"`
+
+	// Pod is the name of the pod the hook was run in. It's empty for plugin
+	// hooks, and for backup-level exec hooks that no running pod matched.
+	Pod string `json:"pod"`
+
+	// Plugin is the name of the BackupHookAction plugin a backup-level
+	// plugin hook invoked.
+	// +optional
+	Plugin string `json:"plugin,omitempty"`
+
+	// Container is the container the hook's command was run in.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Command is the command that was run.
+	// +optional
+	// +nullable
+	Command []string `json:"command,omitempty"`
+
+	// OnError is how an error from the hook was handled: with Fail, the pod
+	// wasn't backed up, and with Continue, the error was only logged.
+	OnError HookErrorMode `json:"onError"`
+
+	// StartTimestamp is when the hook started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// Duration is how long the hook took to run.
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// Error is the error the hook failed with. It's empty if the hook
+	// succeeded.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// BackupMirrorPhase is a string representation of whether a backup was
+// copied to a mirror storage location.
+// +kubebuilder:validation:Enum=Completed;Failed
+type BackupMirrorPhase string
+
+const (
+	// BackupMirrorPhaseCompleted means the backup was copied to the mirror
+	// storage location.
+	BackupMirrorPhaseCompleted BackupMirrorPhase = "Completed"
+
+	// BackupMirrorPhaseFailed means the backup couldn't be copied to the
+	// mirror storage location.
+	BackupMirrorPhaseFailed BackupMirrorPhase = "Failed"
+)
+
+// BackupMirrorStatus is the status of a backup's copy in one of its mirror
+// storage locations.
+type BackupMirrorStatus struct {
+	// StorageLocation is the name of the mirror BackupStorageLocation.
+	StorageLocation string `json:"storageLocation"`
+
+	// Phase is whether the backup was copied to the storage location.
+	Phase BackupMirrorPhase `json:"phase"`
+
+	// Message is the error that the backup couldn't be copied because of,
+	// if it wasn't.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Encrypted is true if the backup's contents tarball was encrypted with
+	// the storage location's encryption key when it was copied there.
+	// +optional
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// PodVolumeBackupSize is the size of the data backed up by a backup's pod
+// volume backups.
+type PodVolumeBackupSize struct {
+	// LogicalBytes is the total size of the backed up pod volumes.
+	// +optional
+	LogicalBytes int64 `json:"logicalBytes,omitempty"`
+
+	// RepositoryBytesAdded is the number of bytes the pod volume backups
+	// added to their restic repositories, after deduplication. It's less than
+	// LogicalBytes when data was already in the repositories.
+	// +optional
+	RepositoryBytesAdded int64 `json:"repositoryBytesAdded,omitempty"`
+}
+
+// BackupFilteredItems counts the items that were left out of a backup by
+// each of its filters. Items that were never retrieved, such as those in
+// namespaces or of resources that weren't listed at all, or that the API
+// server didn't return because they don't match the label selector, aren't
+// counted.
+type BackupFilteredItems struct {
+	// Resources is the number of items left out because their resource is
+	// excluded, such as additional items returned by plugins.
+	// +optional
+	Resources int `json:"resources,omitempty"`
+
+	// Namespaces is the number of items left out because their namespace,
+	// or for namespaces themselves their name, is excluded.
+	// +optional
+	Namespaces int `json:"namespaces,omitempty"`
+
+	// LabelSelector is the number of items left out because they don't
+	// match the backup's label selector.
+	// +optional
+	LabelSelector int `json:"labelSelector,omitempty"`
+
+	// ItemFilter is the number of items left out by the backup's item
+	// filter.
+	// +optional
+	ItemFilter int `json:"itemFilter,omitempty"`
+
+	// OwnerKind is the number of items left out because they have an owner
+	// of an excluded kind.
+	// +optional
+	OwnerKind int `json:"ownerKind,omitempty"`
+
+	// DefaultResources is the number of items left out because they're
+	// default resources that Kubernetes creates on its own.
+	// +optional
+	DefaultResources int `json:"defaultResources,omitempty"`
+
+	// ModifiedSince is the number of items left out because they weren't
+	// created or modified in the backup's modifiedSince window.
+	// +optional
+	ModifiedSince int `json:"modifiedSince,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
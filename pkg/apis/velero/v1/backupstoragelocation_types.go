@@ -35,6 +35,12 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	Credential *corev1api.SecretKeySelector `json:"credential,omitempty"`
 
+	// EncryptionKey selects the key in a Secret used to encrypt backup contents with AES-256-GCM
+	// before they're uploaded to object storage. The key must be exactly 32 bytes.
+	// +optional
+	// +nullable
+	EncryptionKey *corev1api.SecretKeySelector `json:"encryptionKey,omitempty"`
+
 	StorageType `json:",inline"`
 
 	// Default indicates this location is the default backup storage location.
@@ -54,6 +60,103 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// DeletePolicy defines whether and when to delete the backups in the object storage that
+	// have no Backup in the cluster.
+	// +optional
+	// +nullable
+	DeletePolicy *BackupStorageLocationDeletePolicy `json:"deletePolicy,omitempty"`
+
+	// RequestPolicy defines the timeout and retries of the requests made to the
+	// location's object store plugin.
+	// +optional
+	// +nullable
+	RequestPolicy *BackupStorageLocationRequestPolicy `json:"requestPolicy,omitempty"`
+}
+
+// BackupStorageLocationDeletePolicy defines the cleanup of orphaned backups, which are the
+// backups in a location's object storage that have no Backup in the cluster.
+type BackupStorageLocationDeletePolicy struct {
+	// OrphanCleanup enables deleting orphaned backups from the object storage once they've
+	// been orphaned for the grace period. Only the backups of the server's cluster are
+	// considered. Defaults to false.
+	// +optional
+	OrphanCleanup bool `json:"orphanCleanup,omitempty"`
+
+	// GracePeriod is how long a backup must stay orphaned before it's deleted. Defaults to 24h.
+	// +optional
+	// +nullable
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// DryRun reports orphaned backups with events on the location without deleting them.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// BackupStorageLocationRequestPolicy defines the timeout and retries of the requests made
+// to a location's object store plugin, for object stores that are slower or less reliable
+// than the plugin's own defaults allow for.
+type BackupStorageLocationRequestPolicy struct {
+	// Timeout is how long each request can take before it fails. It doesn't include reading
+	// the content of downloaded objects. Must be positive if set. Defaults to no timeout.
+	// +optional
+	// +nullable
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Retries is how many times a failed request is retried. Uploads are only retried if they
+	// failed without timing out. Must not be negative. Defaults to 0.
+	// +optional
+	Retries int `json:"retries,omitempty"`
+}
+
+// BackupStorageLocationAccessCheck is the result of a check that a location's credentials and
+// permissions allow uploading, downloading, listing and deleting objects.
+type BackupStorageLocationAccessCheck struct {
+	// RequestedAt is the value of the velero.io/access-check-requested annotation that the
+	// check was run for, so that whoever requested it can tell that it has run.
+	// +optional
+	RequestedAt string `json:"requestedAt,omitempty"`
+
+	// Time is when the check was run.
+	// +optional
+	// +nullable
+	Time *metav1.Time `json:"time,omitempty"`
+
+	// Operations are the results of the check's operations, in the order they were run.
+	// +optional
+	// +nullable
+	Operations []BackupStorageLocationAccessCheckOperation `json:"operations,omitempty"`
+}
+
+// BackupStorageLocationAccessCheckOperation is the result of one of the operations of an
+// access check.
+type BackupStorageLocationAccessCheckOperation struct {
+	// Operation is the object store operation, Put, Get, List or Delete.
+	Operation string `json:"operation"`
+
+	// Succeeded is whether the operation succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// Message is the error the operation failed with.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupStorageLocationStorageUsage is the space used by the backups in a location, of all
+// clusters.
+type BackupStorageLocationStorageUsage struct {
+	// Bytes is the total size of the objects of the location's backups.
+	// +optional
+	Bytes int64 `json:"bytes,omitempty"`
+
+	// Objects is the number of objects of the location's backups.
+	// +optional
+	Objects int `json:"objects,omitempty"`
+
+	// LastUpdatedTime is when the usage was measured.
+	// +optional
+	// +nullable
+	LastUpdatedTime *metav1.Time `json:"lastUpdatedTime,omitempty"`
 }
 
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
@@ -74,6 +177,23 @@ type BackupStorageLocationStatus struct {
 	// +nullable
 	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
 
+	// ConsecutiveFailures is the number of times in a row that validation of the
+	// backup storage location has failed. It is reset by a successful validation.
+	// +optional
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+
+	// LastAccessCheck is the result of the location's most recent access check, which is
+	// run when the location is annotated with velero.io/access-check-requested.
+	// +optional
+	// +nullable
+	LastAccessCheck *BackupStorageLocationAccessCheck `json:"lastAccessCheck,omitempty"`
+
+	// StorageUsage is the space used by the location's backups when it was last measured,
+	// which is done at the server's storage usage refresh interval.
+	// +optional
+	// +nullable
+	StorageUsage *BackupStorageLocationStorageUsage `json:"storageUsage,omitempty"`
+
 	// LastSyncedRevision is the value of the `metadata/revision` file in the backup
 	// storage location the last time the BSL's contents were synced into the cluster.
 	//
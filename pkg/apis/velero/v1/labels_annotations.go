@@ -47,6 +47,14 @@ const (
 	// location of a backup.
 	StorageLocationLabel = "velero.io/storage-location"
 
+	// ClusterNameLabel is the label key used to identify the cluster that
+	// created a backup, when the server was given a cluster name.
+	ClusterNameLabel = "velero.io/cluster-name"
+
+	// GCHoldLabel is the label key used to keep a backup from being
+	// garbage-collected while it's set to "true", even once it's expired.
+	GCHoldLabel = "velero.io/gc-hold"
+
 	// ResticVolumeNamespaceLabel is the label key used to identify which
 	// namespace a restic repository stores pod volume backups for.
 	ResticVolumeNamespaceLabel = "velero.io/volume-namespace"
@@ -62,4 +70,41 @@ const (
 	// SourceClusterK8sMajorVersionAnnotation is the label key used to identify the k8s
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
+
+	// ExpirationOverrideAnnotation is the annotation key used to keep a
+	// backup past its status.expiration, until the RFC 3339 time it's set to.
+	ExpirationOverrideAnnotation = "velero.io/expiration-override"
+
+	// ExpirationOverrideRequestedByAnnotation is the annotation key used to
+	// record who set a backup's expiration override.
+	ExpirationOverrideRequestedByAnnotation = "velero.io/expiration-override-requested-by"
+
+	// ExpirationOverrideRequestedAtAnnotation is the annotation key used to
+	// record when a backup's expiration override was set, as an RFC 3339 time.
+	ExpirationOverrideRequestedAtAnnotation = "velero.io/expiration-override-requested-at"
+
+	// ExpirationOverrideReasonAnnotation is the annotation key used to record
+	// why a backup's expiration override was set.
+	ExpirationOverrideReasonAnnotation = "velero.io/expiration-override-reason"
+
+	// MaintenanceRequestedAnnotation is the annotation key used to request
+	// that a restic repository's maintenance run as soon as possible, rather
+	// than when it's next due. It's removed once maintenance has run.
+	MaintenanceRequestedAnnotation = "velero.io/maintenance-requested"
+
+	// CancelRequestedAnnotation is the annotation key used to request that
+	// an in-progress backup or restore stop backing up or restoring items.
+	// Its value is ignored. The backup or restore's phase is Cancelled once
+	// it stops.
+	CancelRequestedAnnotation = "velero.io/cancel-requested"
+
+	// AccessCheckRequestedAnnotation is the annotation key used to request
+	// that a backup storage location's access check be run. It's removed
+	// once the check has run, and its value is recorded in the result.
+	AccessCheckRequestedAnnotation = "velero.io/access-check-requested"
+
+	// RedactedSecretAnnotation is the annotation key added to the secrets
+	// whose data values were replaced with a placeholder when they were
+	// backed up. Restoring them fails, since their values are gone.
+	RedactedSecretAnnotation = "velero.io/redacted-secret"
 )
@@ -44,8 +44,29 @@ type PodVolumeBackupSpec struct {
 	// volume backup as tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// VolumeMode is how the volume's data is backed up: either the files
+	// of its filesystem, or the contents of its raw block device. If empty,
+	// its files are backed up.
+	// +optional
+	VolumeMode PodVolumeMode `json:"volumeMode,omitempty"`
 }
 
+// PodVolumeMode is how a pod volume's data is backed up and restored.
+// +kubebuilder:validation:Enum=Filesystem;Block
+type PodVolumeMode string
+
+const (
+	// PodVolumeModeFilesystem means the files of the volume's mounted
+	// filesystem are backed up and restored.
+	PodVolumeModeFilesystem PodVolumeMode = "Filesystem"
+
+	// PodVolumeModeBlock means the contents of the volume's raw block
+	// device are backed up as a single stream, and written back to the
+	// device when restored.
+	PodVolumeModeBlock PodVolumeMode = "Block"
+)
+
 // PodVolumeBackupPhase represents the lifecycle phase of a PodVolumeBackup.
 // +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
 type PodVolumeBackupPhase string
@@ -96,6 +117,18 @@ type PodVolumeBackupStatus struct {
 	// about the backup operation.
 	// +optional
 	Progress PodVolumeOperationProgress `json:"progress,omitempty"`
+
+	// RepositoryBytesAdded is the number of bytes the backup added to the
+	// restic repository, after deduplication.
+	// +optional
+	RepositoryBytesAdded int64 `json:"repositoryBytesAdded,omitempty"`
+
+	// DataDigests are the digests of the volume's data computed by the
+	// backup data integrity plugins once it was backed up, keyed by plugin
+	// name.
+	// +optional
+	// +nullable
+	DataDigests map[string]string `json:"dataDigests,omitempty"`
 }
 
 // +genclient
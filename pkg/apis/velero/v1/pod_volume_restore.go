@@ -38,6 +38,18 @@ type PodVolumeRestoreSpec struct {
 
 	// SnapshotID is the ID of the volume snapshot to be restored.
 	SnapshotID string `json:"snapshotID"`
+
+	// DataDigests are the digests, keyed by backup data integrity plugin
+	// name, that the volume's data is verified against once it's restored.
+	// If empty, the data isn't verified.
+	// +optional
+	// +nullable
+	DataDigests map[string]string `json:"dataDigests,omitempty"`
+
+	// VolumeMode is how the volume's data was backed up, and so how it's
+	// restored. If empty, the volume's files are restored.
+	// +optional
+	VolumeMode PodVolumeMode `json:"volumeMode,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
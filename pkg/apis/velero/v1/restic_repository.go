@@ -36,6 +36,11 @@ type ResticRepositorySpec struct {
 
 	// MaintenanceFrequency is how often maintenance should be run.
 	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// MaintenanceSchedule is a Cron expression defining when maintenance
+	// should be run. If it's set, it's used instead of MaintenanceFrequency.
+	// +optional
+	MaintenanceSchedule string `json:"maintenanceSchedule,omitempty"`
 }
 
 // ResticRepositoryPhase represents the lifecycle phase of a ResticRepository.
@@ -48,6 +53,20 @@ const (
 	ResticRepositoryPhaseNotReady ResticRepositoryPhase = "NotReady"
 )
 
+// ResticRepositoryMaintenanceResult is the result of running maintenance on
+// a ResticRepository.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type ResticRepositoryMaintenanceResult string
+
+const (
+	ResticRepositoryMaintenanceResultSucceeded ResticRepositoryMaintenanceResult = "Succeeded"
+	ResticRepositoryMaintenanceResultFailed    ResticRepositoryMaintenanceResult = "Failed"
+)
+
+// ResticRepositoryConditionMaintenanceSucceeded is the type of the condition
+// recording whether the last maintenance of a ResticRepository succeeded.
+const ResticRepositoryConditionMaintenanceSucceeded = "MaintenanceSucceeded"
+
 // ResticRepositoryStatus is the current status of a ResticRepository.
 type ResticRepositoryStatus struct {
 	// Phase is the current state of the ResticRepository.
@@ -62,6 +81,20 @@ type ResticRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// LastMaintenanceDuration is how long the last maintenance took.
+	// +optional
+	// +nullable
+	LastMaintenanceDuration *metav1.Duration `json:"lastMaintenanceDuration,omitempty"`
+
+	// LastMaintenanceResult is the result of the last maintenance.
+	// +optional
+	LastMaintenanceResult ResticRepositoryMaintenanceResult `json:"lastMaintenanceResult,omitempty"`
+
+	// Conditions are the current conditions of the ResticRepository.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +genclient
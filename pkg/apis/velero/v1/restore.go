@@ -57,6 +57,16 @@ type RestoreSpec struct {
 	// +nullable
 	ExcludedResources []string `json:"excludedResources,omitempty"`
 
+	// NamespaceResourceFilters are resources to include in or exclude from
+	// the restore for particular namespaces, keyed by a glob matched against
+	// the names of the namespaces in the backup. They're applied after
+	// IncludedResources and ExcludedResources, which take precedence, so
+	// they can only restore fewer resources from a namespace. If several
+	// globs match a namespace, a resource must be included by all of them.
+	// +optional
+	// +nullable
+	NamespaceResourceFilters map[string]NamespaceResourceFilter `json:"namespaceResourceFilters,omitempty"`
+
 	// NamespaceMapping is a map of source namespace names
 	// to target namespace names to restore into. Any source
 	// namespaces not included in the map will be restored into
@@ -64,6 +74,14 @@ type RestoreSpec struct {
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
+	// NamespaceMappingTemplate computes target namespace names from the
+	// labels and annotations of the source namespaces in the backup. Source
+	// namespaces not matched by the template's selector fall back to
+	// NamespaceMapping, or to namespaces of the same name.
+	// +optional
+	// +nullable
+	NamespaceMappingTemplate *NamespaceMappingTemplate `json:"namespaceMappingTemplate,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when restoring individual objects from the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -71,6 +89,43 @@ type RestoreSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// ExcludedAnnotation excludes objects carrying a matching annotation
+	// in the backup from the restore, regardless of the included and
+	// excluded namespaces and resources. If nil, no objects are excluded
+	// by annotation.
+	// +optional
+	// +nullable
+	ExcludedAnnotation *AnnotationMatch `json:"excludedAnnotation,omitempty"`
+
+	// IncludedVolumes is a slice of the persistent volume claims in the
+	// backup, as namespace/name, whose data is restored from pod volume
+	// backups and volume snapshots. Namespaces are the ones in the backup,
+	// before any namespace mapping. If empty, all volumes are included.
+	// +optional
+	// +nullable
+	IncludedVolumes []string `json:"includedVolumes,omitempty"`
+
+	// ExcludedVolumes is a slice of the persistent volume claims in the
+	// backup, as namespace/name, whose data is not restored.
+	// +optional
+	// +nullable
+	ExcludedVolumes []string `json:"excludedVolumes,omitempty"`
+
+	// VolumeSelector restricts the volumes whose data is restored to those
+	// of the persistent volume claims whose labels in the backup match it.
+	// If nil, volumes aren't filtered by label.
+	// +optional
+	// +nullable
+	VolumeSelector *metav1.LabelSelector `json:"volumeSelector,omitempty"`
+
+	// SkipUnselectedVolumes specifies whether the persistent volume claims
+	// of volumes that IncludedVolumes, ExcludedVolumes and VolumeSelector
+	// don't select are left out of the restore. If false or nil, they're
+	// restored empty, to be dynamically provisioned.
+	// +optional
+	// +nullable
+	SkipUnselectedVolumes *bool `json:"skipUnselectedVolumes,omitempty"`
+
 	// RestorePVs specifies whether to restore all included
 	// PVs from snapshot (via the cloudprovider).
 	// +optional
@@ -89,11 +144,279 @@ type RestoreSpec struct {
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
 
+	// DryRun specifies whether the restore should walk the full item
+	// pipeline, including restore item actions, without creating or
+	// patching anything in the cluster. A summary of the items that would
+	// have been created, updated or skipped is stored in the restore's
+	// results file. Note that restore item actions are still executed
+	// during a dry run.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// RecordChanges specifies whether to record what the restore did with
+	// each item that it created, patched or skipped because it already
+	// existed in the restore's results file, including, for patched items,
+	// a merge patch of what changed. The patches are bounded in size.
+	// Recording changes has an overhead on restores of many items, so it
+	// defaults to false.
+	// +optional
+	// +nullable
+	RecordChanges *bool `json:"recordChanges,omitempty"`
+
+	// ScaleDownWorkloads specifies whether restored Deployments and
+	// StatefulSets are scaled to zero replicas, so that they don't run until
+	// they're scaled up on purpose. Their original replicas are kept in the
+	// velero.io/original-replicas annotation. Workloads that already exist
+	// in the cluster aren't scaled down.
+	// +optional
+	// +nullable
+	ScaleDownWorkloads *bool `json:"scaleDownWorkloads,omitempty"`
+
+	// ResourcePriorities overrides the order in which resources are
+	// restored. If nil, the server's default resource priorities are used.
+	// +optional
+	// +nullable
+	ResourcePriorities *RestoreResourcePriorities `json:"resourcePriorities,omitempty"`
+
+	// ResourceTimeout is how long each blocking call made while restoring
+	// a single item, such as running a restore item action or creating or
+	// patching the item, can take before it's cancelled and the item is
+	// recorded as failed. If nil, the server's default is used. A value of
+	// 0 disables the timeout.
+	// +optional
+	// +nullable
+	ResourceTimeout *metav1.Duration `json:"resourceTimeout,omitempty"`
+
+	// Resume specifies whether to skip the items that were already restored
+	// by the most recent failed or partially failed restore of the same
+	// backup. Items that have since been deleted from the cluster are
+	// restored again.
+	// +optional
+	// +nullable
+	Resume *bool `json:"resume,omitempty"`
+
+	// ResourceModifier references a ConfigMap in the restore's namespace
+	// with rules of JSON patches, merge patches and strategic merge patches
+	// to apply to the items they match before they're restored.
+	// +optional
+	// +nullable
+	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
+	// NamespaceConflictPolicy is what the restore does with the namespaces
+	// it restores into that already exist in the cluster. Defaults to Merge.
+	// +optional
+	NamespaceConflictPolicy NamespaceConflictPolicy `json:"namespaceConflictPolicy,omitempty"`
+
+	// ExistingResourcePolicy is what the restore does with the items it
+	// restores that already exist in the cluster. Defaults to none.
+	// +optional
+	ExistingResourcePolicy ExistingResourcePolicy `json:"existingResourcePolicy,omitempty"`
+
+	// VerifyPodVolumeData specifies whether to verify the data of the pod
+	// volumes restored with restic against the digests computed by backup
+	// data integrity plugins when they were backed up. A volume that fails
+	// verification is recorded as a restore error.
+	// +optional
+	// +nullable
+	VerifyPodVolumeData *bool `json:"verifyPodVolumeData,omitempty"`
+
+	// SkipFailedBackupItems specifies whether the items that the backup
+	// recorded as having failed to be backed up are left out of the
+	// restore. If false or nil, they're restored with a warning.
+	// +optional
+	// +nullable
+	SkipFailedBackupItems *bool `json:"skipFailedBackupItems,omitempty"`
+
+	// GenerateNameOnConflict is a slice of resources whose items are created
+	// with a name generated from their original name, rather than skipped,
+	// if an item with their name already exists in the cluster. Only jobs
+	// and pods, which nothing else refers to by name, are supported.
+	// +optional
+	// +nullable
+	GenerateNameOnConflict []string `json:"generateNameOnConflict,omitempty"`
+
+	// PreferredAPIVersions maps group-resources, such as
+	// "widgets.example.com", to the API versions to restore them at, in order
+	// of preference. The first version that's both in the backup and served
+	// by the cluster is restored. If none is, the version is chosen as if the
+	// resource had no preferred versions.
+	// +optional
+	// +nullable
+	PreferredAPIVersions map[string][]string `json:"preferredAPIVersions,omitempty"`
+
+	// WaitForWorkloads specifies which types of restored workloads the
+	// restore waits for to become ready, and for how long, once its items
+	// have been restored. Workloads that don't become ready in time are
+	// recorded as warnings. If nil, the restore doesn't wait.
+	// +optional
+	// +nullable
+	WaitForWorkloads *WorkloadReadinessWait `json:"waitForWorkloads,omitempty"`
+
 	// Hooks represent custom behaviors that should be executed during or post restore.
 	// +optional
 	Hooks RestoreHooks `json:"hooks,omitempty"`
 }
 
+// ResourcePrioritiesMode is how a restore's high priority resources combine
+// with the server's default resource priorities.
+// +kubebuilder:validation:Enum=Append;Replace
+type ResourcePrioritiesMode string
+
+const (
+	// ResourcePrioritiesModeAppend restores the server's default resource
+	// priorities first, followed by the restore's high priority resources.
+	ResourcePrioritiesModeAppend ResourcePrioritiesMode = "Append"
+
+	// ResourcePrioritiesModeReplace restores the restore's high priority
+	// resources instead of the server's default resource priorities.
+	ResourcePrioritiesModeReplace ResourcePrioritiesMode = "Replace"
+)
+
+// NamespaceConflictPolicy is what a restore does with the namespaces it
+// restores into that already exist in the cluster.
+// +kubebuilder:validation:Enum=Merge;Fail;Recreate
+type NamespaceConflictPolicy string
+
+const (
+	// NamespaceConflictPolicyMerge restores items into existing namespaces,
+	// alongside the items already in them.
+	NamespaceConflictPolicyMerge NamespaceConflictPolicy = "Merge"
+
+	// NamespaceConflictPolicyFail fails the restore, before anything is
+	// restored, if any of the namespaces it restores into exist.
+	NamespaceConflictPolicyFail NamespaceConflictPolicy = "Fail"
+
+	// NamespaceConflictPolicyRecreate deletes the existing namespaces the
+	// restore restores into, and everything in them, before anything is
+	// restored. It's only allowed for restores that explicitly list their
+	// included namespaces.
+	NamespaceConflictPolicyRecreate NamespaceConflictPolicy = "Recreate"
+)
+
+// ExistingResourcePolicy is what a restore does with the items it restores
+// that already exist in the cluster.
+// +kubebuilder:validation:Enum=none;updateIfChanged;addMissingKeys;overwriteKeys
+type ExistingResourcePolicy string
+
+const (
+	// ExistingResourcePolicyNone leaves existing items as they are, with a
+	// warning if they're different than the backed up version.
+	ExistingResourcePolicyNone ExistingResourcePolicy = "none"
+
+	// ExistingResourcePolicyUpdateIfChanged patches existing items that are
+	// different than the backed up version, and leaves the rest unchanged.
+	ExistingResourcePolicyUpdateIfChanged ExistingResourcePolicy = "updateIfChanged"
+
+	// ExistingResourcePolicyAddMissingKeys adds the backed up keys that
+	// existing ConfigMaps and Secrets don't have to them, keeping the values
+	// of the keys they already have. Existing items of other types are left
+	// as they are, as with none.
+	ExistingResourcePolicyAddMissingKeys ExistingResourcePolicy = "addMissingKeys"
+
+	// ExistingResourcePolicyOverwriteKeys sets the backed up keys of existing
+	// ConfigMaps and Secrets to their backed up values, keeping the keys that
+	// were added since the backup. Existing items of other types are left as
+	// they are, as with none.
+	ExistingResourcePolicyOverwriteKeys ExistingResourcePolicy = "overwriteKeys"
+)
+
+// WorkloadReadinessWait is how long a restore waits for each type of
+// restored workload to become ready. Workloads whose type has no timeout
+// aren't waited for.
+type WorkloadReadinessWait struct {
+	// Deployments is how long to wait for each restored deployment's
+	// available replicas to reach its desired replicas.
+	// +optional
+	// +nullable
+	Deployments *metav1.Duration `json:"deployments,omitempty"`
+
+	// StatefulSets is how long to wait for each restored stateful set's
+	// ready replicas to reach its desired replicas.
+	// +optional
+	// +nullable
+	StatefulSets *metav1.Duration `json:"statefulSets,omitempty"`
+
+	// DaemonSets is how long to wait for each restored daemon set's
+	// available pods to reach its desired number of scheduled pods.
+	// +optional
+	// +nullable
+	DaemonSets *metav1.Duration `json:"daemonSets,omitempty"`
+}
+
+// RestoreResourcePriorities is the order in which a restore restores
+// resources. Custom resource definitions are always restored first, and
+// resources in neither list are restored alphabetically, after the high
+// priority resources and before the low priority ones.
+type RestoreResourcePriorities struct {
+	// Mode is how HighPriorities combine with the server's default
+	// resource priorities. Defaults to Append.
+	// +optional
+	Mode ResourcePrioritiesMode `json:"mode,omitempty"`
+
+	// HighPriorities is a list of resources to restore, in order, before
+	// any resource not in the list.
+	// +optional
+	// +nullable
+	HighPriorities []string `json:"highPriorities,omitempty"`
+
+	// LowPriorities is a list of resources to restore, in order, after
+	// all other resources.
+	// +optional
+	// +nullable
+	LowPriorities []string `json:"lowPriorities,omitempty"`
+
+	// GroupOrders order the resources of an API group relative to each
+	// other, which are otherwise restored in alphabetical order. They
+	// don't change when the resources in HighPriorities or LowPriorities
+	// are restored.
+	// +optional
+	// +nullable
+	GroupOrders []ResourceGroupOrder `json:"groupOrders,omitempty"`
+}
+
+// ResourceGroupOrder is the order in which the resources of an API group are
+// restored. The group's resources that it doesn't list are restored after
+// the ones it does, in alphabetical order.
+type ResourceGroupOrder struct {
+	// Resources are resources of the same API group, such as
+	// "widgets.example.com", in the order they're restored in.
+	Resources []string `json:"resources"`
+}
+
+// NamespaceMappingTemplate is a Go template used to compute the target
+// namespace for a source namespace in the backup.
+type NamespaceMappingTemplate struct {
+	// Selector restricts the template to source namespaces whose labels
+	// match it. If nil, the template applies to every source namespace.
+	// +optional
+	// +nullable
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Template is a Go text/template rendered once per source namespace.
+	// It can reference the namespace's .Name, .Labels and .Annotations,
+	// and the .Label and .Annotation functions, which fail the restore
+	// if the requested key is not set, e.g. `tenant-{{ .Label "tenant-id" }}`.
+	Template string `json:"template"`
+}
+
+// NamespaceResourceFilter is a set of resources to include in or exclude
+// from restoring the namespaces that it applies to.
+type NamespaceResourceFilter struct {
+	// IncludedResources is a slice of resource names to restore from the
+	// namespaces. If empty, all of the resources that the restore includes
+	// are restored.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources is a slice of resource names that aren't restored
+	// from the namespaces.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+}
+
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
 type RestoreHooks struct {
 	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
@@ -132,6 +455,15 @@ type RestoreResourceHookSpec struct {
 	// +nullable
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// AnnotationSelector, if specified, filters the resources to which this hook spec applies by
+	// their annotations, the same way LabelSelector does by their labels: its matchLabels select
+	// resources whose annotations have the given values, and a matchExpressions requirement with
+	// the Exists operator selects resources that have an annotation with any value. The values
+	// must be valid label values.
+	// +optional
+	// +nullable
+	AnnotationSelector *metav1.LabelSelector `json:"annotationSelector,omitempty"`
+
 	// PostHooks is a list of RestoreResourceHooks to execute during and after restoring a resource.
 	// +optional
 	PostHooks []RestoreResourceHook `json:"postHooks,omitempty"`
@@ -153,6 +485,18 @@ type ExecRestoreHook struct {
 	// +optional
 	Container string `json:"container,omitempty"`
 
+	// ContainerImage is a glob, or a regular expression if it's prefixed with "regex:", matching the
+	// image of the container in the pod where the command should be executed. It takes precedence over
+	// Container, and it's an error if no container's image matches or if more than one does, unless
+	// ContainerImageFirstMatch is true.
+	// +optional
+	ContainerImage string `json:"containerImage,omitempty"`
+
+	// ContainerImageFirstMatch selects the first of the pod's containers whose image matches
+	// ContainerImage when more than one does.
+	// +optional
+	ContainerImageFirstMatch bool `json:"containerImageFirstMatch,omitempty"`
+
 	// Command is the command and arguments to execute from within a container after a pod has been restored.
 	// +kubebuilder:validation:MinItems=1
 	Command []string `json:"command"`
@@ -170,6 +514,13 @@ type ExecRestoreHook struct {
 	// before attempting to run the command.
 	// +optional
 	WaitTimeout metav1.Duration `json:"waitTimeout,omitempty"`
+
+	// WaitForReady specifies whether Velero should wait for the container to pass its readiness
+	// checks, and not only to be running, before attempting to run the command. If false or nil,
+	// the command runs once the container is running.
+	// +optional
+	// +nullable
+	WaitForReady *bool `json:"waitForReady,omitempty"`
 }
 
 // InitRestoreHook is a hook that adds an init container to a PodSpec to run commands before the
@@ -186,7 +537,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Cancelled
 type RestorePhase string
 
 const (
@@ -212,8 +563,44 @@ const (
 	// RestorePhaseFailed means the restore was unable to execute.
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
+
+	// RestorePhaseCancelled means the restore's cancellation was requested
+	// while it was in progress, so it stopped before restoring all of its
+	// items. The items it restored before then are left in the cluster.
+	RestorePhaseCancelled RestorePhase = "Cancelled"
 )
 
+// WorkloadReadinessStatus is the result of waiting for a restore's
+// workloads to become ready.
+type WorkloadReadinessStatus struct {
+	// Ready is the number of restored workloads that became ready.
+	// +optional
+	Ready int `json:"ready,omitempty"`
+
+	// NotReady lists the restored workloads that didn't become ready
+	// before their timeout.
+	// +optional
+	// +nullable
+	NotReady []NotReadyWorkload `json:"notReady,omitempty"`
+}
+
+// NotReadyWorkload is a restored workload that didn't become ready.
+type NotReadyWorkload struct {
+	// Resource is the workload's resource, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// Namespace is the workload's namespace.
+	Namespace string `json:"namespace"`
+
+	// Name is the workload's name.
+	Name string `json:"name"`
+
+	// Reason describes why the workload wasn't ready when its timeout
+	// elapsed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
 // RestoreStatus captures the current status of a Velero restore
 type RestoreStatus struct {
 	// Phase is the current state of the Restore
@@ -236,10 +623,36 @@ type RestoreStatus struct {
 	// +optional
 	Errors int `json:"errors,omitempty"`
 
+	// RenamedItems is a count of the items that were restored with a
+	// generated name because their name was already taken in the cluster.
+	// Their original and new names are stored in object storage.
+	// +optional
+	RenamedItems int `json:"renamedItems,omitempty"`
+
+	// UnchangedItems is a count of the items that already existed in the
+	// cluster and were the same as the backed up version, so weren't
+	// patched. It's only counted for restores whose existing resource
+	// policy is updateIfChanged, or addMissingKeys or overwriteKeys for
+	// ConfigMaps and Secrets, and the items are stored in object storage.
+	// +optional
+	UnchangedItems int `json:"unchangedItems,omitempty"`
+
+	// WorkloadReadiness is the result of waiting for the restored
+	// workloads to become ready, if the restore's spec.waitForWorkloads
+	// is set.
+	// +optional
+	// +nullable
+	WorkloadReadiness *WorkloadReadinessStatus `json:"workloadReadiness,omitempty"`
+
 	// FailureReason is an error that caused the entire restore to fail.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
 
+	// ResumedFrom is the name of the restore whose already restored items
+	// were skipped by this restore, if it's resuming one.
+	// +optional
+	ResumedFrom string `json:"resumedFrom,omitempty"`
+
 	// StartTimestamp records the time the restore operation was started.
 	// The server's time is used for StartTimestamps
 	// +optional
@@ -275,6 +688,10 @@ type RestoreProgress struct {
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Restore status such as InProgress/Completed"
+// +kubebuilder:printcolumn:name="Items Restored",type="integer",JSONPath=".status.progress.itemsRestored",description="Number of items restored so far"
+// +kubebuilder:printcolumn:name="Total Items",type="integer",JSONPath=".status.progress.totalItems",description="Estimated total number of items to be restored"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Restore is a Velero resource that represents the application of
 // resources from a Velero backup to a target Kubernetes cluster.
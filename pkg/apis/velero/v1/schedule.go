@@ -38,11 +38,79 @@ type ScheduleSpec struct {
 	// +optional
 	// +nullable
 	UseOwnerReferencesInBackup *bool `json:"useOwnerReferencesInBackup,omitempty"`
+
+	// TTLOverrides is a list of TTLs to use instead of the template's
+	// TTL for backups run at matching times. The first matching
+	// override is used.
+	// +optional
+	TTLOverrides []ScheduleTTLOverride `json:"ttlOverrides,omitempty"`
+
+	// Paused specifies whether the schedule is paused. Paused schedules
+	// don't run backups.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// CancelRunningOnPause specifies whether pausing the schedule cancels
+	// the backups it ran that are still in progress. Otherwise they're
+	// left to finish.
+	// +optional
+	CancelRunningOnPause bool `json:"cancelRunningOnPause,omitempty"`
+
+	// SkipImmediately specifies whether a backup missed while the schedule
+	// was paused is skipped when it's resumed, rather than run right away.
+	// Defaults to true.
+	// +optional
+	// +nullable
+	SkipImmediately *bool `json:"skipImmediately,omitempty"`
+
+	// BackupNameTemplate is a Go template that the names of the backups
+	// created by this Schedule are rendered from. It can use the
+	// schedule's name as .ScheduleName, the time the backup is created as
+	// .Timestamp, the server's cluster name as .ClusterName and the
+	// schedule's labels as .Labels. Defaults to the schedule's name
+	// followed by the timestamp.
+	// +optional
+	BackupNameTemplate string `json:"backupNameTemplate,omitempty"`
+
+	// BackupLabelTemplates maps Go templates of label keys to Go templates
+	// of their values, which are rendered with the same data as
+	// BackupNameTemplate onto the backups created by this Schedule. They
+	// take precedence over the schedule's own labels, which are copied to
+	// its backups as they are, and can't set the velero.io/schedule-name
+	// label.
+	// +optional
+	// +nullable
+	BackupLabelTemplates map[string]string `json:"backupLabelTemplates,omitempty"`
+
+	// BackupAnnotationTemplates maps Go templates of annotation keys to Go
+	// templates of their values, like BackupLabelTemplates does for labels.
+	// +optional
+	// +nullable
+	BackupAnnotationTemplates map[string]string `json:"backupAnnotationTemplates,omitempty"`
+}
+
+// ScheduleTTLOverride defines a TTL for the backups a schedule runs at
+// times matching either a Cron expression or a list of days of the week.
+type ScheduleTTLOverride struct {
+	// Schedule is a Cron expression. Backups whose scheduled run
+	// time matches it are created with the override's TTL.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// DaysOfWeek is a list of days of the week (e.g. "Sunday" or
+	// "Sun"). Backups scheduled to run on one of these days are
+	// created with the override's TTL.
+	// +optional
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+
+	// TTL is a time.Duration-parseable string describing how long
+	// matching Backups should be retained for.
+	TTL metav1.Duration `json:"ttl"`
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
 // of a Velero schedule
-// +kubebuilder:validation:Enum=New;Enabled;FailedValidation
+// +kubebuilder:validation:Enum=New;Enabled;Paused;FailedValidation
 type SchedulePhase string
 
 const (
@@ -54,6 +122,10 @@ const (
 	// will now be triggering backups according to the schedule spec.
 	SchedulePhaseEnabled SchedulePhase = "Enabled"
 
+	// SchedulePhasePaused means the schedule has been validated but
+	// is paused, so won't trigger backups until it's resumed.
+	SchedulePhasePaused SchedulePhase = "Paused"
+
 	// SchedulePhaseFailedValidation means the schedule has failed
 	// the controller's validations and therefore will not trigger backups.
 	SchedulePhaseFailedValidation SchedulePhase = "FailedValidation"
@@ -71,6 +143,12 @@ type ScheduleStatus struct {
 	// +nullable
 	LastBackup *metav1.Time `json:"lastBackup,omitempty"`
 
+	// LastSkipped is the last time the schedule was resumed and
+	// skipped the backup it missed while it was paused.
+	// +optional
+	// +nullable
+	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
@@ -73,4 +73,15 @@ const (
 type VolumeSnapshotLocationStatus struct {
 	// +optional
 	Phase VolumeSnapshotLocationPhase `json:"phase,omitempty"`
+
+	// Message is a message about the volume snapshot location's status,
+	// such as why it's unavailable.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastValidationTime is the last time the volume snapshot location was
+	// validated.
+	// +optional
+	// +nullable
+	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
 }
@@ -26,6 +26,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnnotationMatch) DeepCopyInto(out *AnnotationMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnnotationMatch.
+func (in *AnnotationMatch) DeepCopy() *AnnotationMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AnnotationMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -54,6 +69,48 @@ func (in *Backup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupFilteredItems) DeepCopyInto(out *BackupFilteredItems) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupFilteredItems.
+func (in *BackupFilteredItems) DeepCopy() *BackupFilteredItems {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupFilteredItems)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupHookResult) DeepCopyInto(out *BackupHookResult) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupHookResult.
+func (in *BackupHookResult) DeepCopy() *BackupHookResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupHookResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 	*out = *in
@@ -64,6 +121,13 @@ func (in *BackupHooks) DeepCopyInto(out *BackupHooks) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = make([]BackupLevelHookSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -77,6 +141,100 @@ func (in *BackupHooks) DeepCopy() *BackupHooks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupItemFailures) DeepCopyInto(out *BackupItemFailures) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupItemFailures.
+func (in *BackupItemFailures) DeepCopy() *BackupItemFailures {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupItemFailures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupLevelExecHook) DeepCopyInto(out *BackupLevelExecHook) {
+	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ExecHook.DeepCopyInto(&out.ExecHook)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupLevelExecHook.
+func (in *BackupLevelExecHook) DeepCopy() *BackupLevelExecHook {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupLevelExecHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupLevelHook) DeepCopyInto(out *BackupLevelHook) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(BackupLevelExecHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(BackupPluginHook)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupLevelHook.
+func (in *BackupLevelHook) DeepCopy() *BackupLevelHook {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupLevelHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupLevelHookSpec) DeepCopyInto(out *BackupLevelHookSpec) {
+	*out = *in
+	if in.PreHooks != nil {
+		in, out := &in.PreHooks, &out.PreHooks
+		*out = make([]BackupLevelHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostHooks != nil {
+		in, out := &in.PostHooks, &out.PostHooks
+		*out = make([]BackupLevelHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupLevelHookSpec.
+func (in *BackupLevelHookSpec) DeepCopy() *BackupLevelHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupLevelHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupList) DeepCopyInto(out *BackupList) {
 	*out = *in
@@ -110,6 +268,38 @@ func (in *BackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupMirrorStatus) DeepCopyInto(out *BackupMirrorStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupMirrorStatus.
+func (in *BackupMirrorStatus) DeepCopy() *BackupMirrorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupMirrorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupPluginHook) DeepCopyInto(out *BackupPluginHook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupPluginHook.
+func (in *BackupPluginHook) DeepCopy() *BackupPluginHook {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupPluginHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupProgress) DeepCopyInto(out *BackupProgress) {
 	*out = *in
@@ -175,6 +365,11 @@ func (in *BackupResourceHookSpec) DeepCopyInto(out *BackupResourceHookSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AnnotationSelector != nil {
+		in, out := &in.AnnotationSelector, &out.AnnotationSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PreHooks != nil {
 		in, out := &in.PreHooks, &out.PreHooks
 		*out = make([]BackupResourceHook, len(*in))
@@ -230,6 +425,68 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceLabelSelectors != nil {
+		in, out := &in.ResourceLabelSelectors, &out.ResourceLabelSelectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExcludedAnnotation != nil {
+		in, out := &in.ExcludedAnnotation, &out.ExcludedAnnotation
+		*out = new(AnnotationMatch)
+		**out = **in
+	}
+	if in.ItemFilter != nil {
+		in, out := &in.ItemFilter, &out.ItemFilter
+		*out = new(ItemFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcludeDefaultResources != nil {
+		in, out := &in.ExcludeDefaultResources, &out.ExcludeDefaultResources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExcludedOwnerKinds != nil {
+		in, out := &in.ExcludedOwnerKinds, &out.ExcludedOwnerKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ModifiedSince != nil {
+		in, out := &in.ModifiedSince, &out.ModifiedSince
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExcludedFields != nil {
+		in, out := &in.ExcludedFields, &out.ExcludedFields
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResourceAPIVersions != nil {
+		in, out := &in.ResourceAPIVersions, &out.ResourceAPIVersions
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.SnapshotVolumes != nil {
 		in, out := &in.SnapshotVolumes, &out.SnapshotVolumes
 		*out = new(bool)
@@ -241,7 +498,22 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IncludeRelatedClusterResources != nil {
+		in, out := &in.IncludeRelatedClusterResources, &out.IncludeRelatedClusterResources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeAPIServices != nil {
+		in, out := &in.IncludeAPIServices, &out.IncludeAPIServices
+		*out = new(bool)
+		**out = **in
+	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.MirrorStorageLocations != nil {
+		in, out := &in.MirrorStorageLocations, &out.MirrorStorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.VolumeSnapshotLocations != nil {
 		in, out := &in.VolumeSnapshotLocations, &out.VolumeSnapshotLocations
 		*out = make([]string, len(*in))
@@ -259,6 +531,21 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Incremental != nil {
+		in, out := &in.Incremental, &out.Incremental
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RedactSecrets != nil {
+		in, out := &in.RedactSecrets, &out.RedactSecrets
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ItemFailureThresholds != nil {
+		in, out := &in.ItemFailureThresholds, &out.ItemFailureThresholds
+		*out = new(ItemFailureThresholds)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -292,11 +579,43 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.PodVolumeBackupSize != nil {
+		in, out := &in.PodVolumeBackupSize, &out.PodVolumeBackupSize
+		*out = new(PodVolumeBackupSize)
+		**out = **in
+	}
 	if in.Progress != nil {
 		in, out := &in.Progress, &out.Progress
 		*out = new(BackupProgress)
 		**out = **in
 	}
+	if in.FilteredItems != nil {
+		in, out := &in.FilteredItems, &out.FilteredItems
+		*out = new(BackupFilteredItems)
+		**out = **in
+	}
+	if in.MirrorStatuses != nil {
+		in, out := &in.MirrorStatuses, &out.MirrorStatuses
+		*out = make([]BackupMirrorStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArchivedNamespaces != nil {
+		in, out := &in.ArchivedNamespaces, &out.ArchivedNamespaces
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HookStatus != nil {
+		in, out := &in.HookStatus, &out.HookStatus
+		*out = new(HookStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ItemFailures != nil {
+		in, out := &in.ItemFailures, &out.ItemFailures
+		*out = new(BackupItemFailures)
+		**out = **in
+	}
 	return
 }
 
@@ -338,6 +657,68 @@ func (in *BackupStorageLocation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationAccessCheck) DeepCopyInto(out *BackupStorageLocationAccessCheck) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]BackupStorageLocationAccessCheckOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationAccessCheck.
+func (in *BackupStorageLocationAccessCheck) DeepCopy() *BackupStorageLocationAccessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationAccessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationAccessCheckOperation) DeepCopyInto(out *BackupStorageLocationAccessCheckOperation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationAccessCheckOperation.
+func (in *BackupStorageLocationAccessCheckOperation) DeepCopy() *BackupStorageLocationAccessCheckOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationAccessCheckOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationDeletePolicy) DeepCopyInto(out *BackupStorageLocationDeletePolicy) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationDeletePolicy.
+func (in *BackupStorageLocationDeletePolicy) DeepCopy() *BackupStorageLocationDeletePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationDeletePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStorageLocationList) DeepCopyInto(out *BackupStorageLocationList) {
 	*out = *in
@@ -371,6 +752,27 @@ func (in *BackupStorageLocationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationRequestPolicy) DeepCopyInto(out *BackupStorageLocationRequestPolicy) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationRequestPolicy.
+func (in *BackupStorageLocationRequestPolicy) DeepCopy() *BackupStorageLocationRequestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationRequestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec) {
 	*out = *in
@@ -386,6 +788,11 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EncryptionKey != nil {
+		in, out := &in.EncryptionKey, &out.EncryptionKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.StorageType.DeepCopyInto(&out.StorageType)
 	if in.BackupSyncPeriod != nil {
 		in, out := &in.BackupSyncPeriod, &out.BackupSyncPeriod
@@ -397,6 +804,16 @@ func (in *BackupStorageLocationSpec) DeepCopyInto(out *BackupStorageLocationSpec
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.DeletePolicy != nil {
+		in, out := &in.DeletePolicy, &out.DeletePolicy
+		*out = new(BackupStorageLocationDeletePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestPolicy != nil {
+		in, out := &in.RequestPolicy, &out.RequestPolicy
+		*out = new(BackupStorageLocationRequestPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -421,6 +838,16 @@ func (in *BackupStorageLocationStatus) DeepCopyInto(out *BackupStorageLocationSt
 		in, out := &in.LastValidationTime, &out.LastValidationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastAccessCheck != nil {
+		in, out := &in.LastAccessCheck, &out.LastAccessCheck
+		*out = new(BackupStorageLocationAccessCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageUsage != nil {
+		in, out := &in.StorageUsage, &out.StorageUsage
+		*out = new(BackupStorageLocationStorageUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -434,6 +861,26 @@ func (in *BackupStorageLocationStatus) DeepCopy() *BackupStorageLocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorageLocationStorageUsage) DeepCopyInto(out *BackupStorageLocationStorageUsage) {
+	*out = *in
+	if in.LastUpdatedTime != nil {
+		in, out := &in.LastUpdatedTime, &out.LastUpdatedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorageLocationStorageUsage.
+func (in *BackupStorageLocationStorageUsage) DeepCopy() *BackupStorageLocationStorageUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorageLocationStorageUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteBackupRequest) DeepCopyInto(out *DeleteBackupRequest) {
 	*out = *in
@@ -620,97 +1067,245 @@ func (in *DownloadRequestStatus) DeepCopyInto(out *DownloadRequestStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadRequestStatus.
-func (in *DownloadRequestStatus) DeepCopy() *DownloadRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadRequestStatus.
+func (in *DownloadRequestStatus) DeepCopy() *DownloadRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownloadTarget) DeepCopyInto(out *DownloadTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadTarget.
+func (in *DownloadTarget) DeepCopy() *DownloadTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecHook) DeepCopyInto(out *ExecHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecHook.
+func (in *ExecHook) DeepCopy() *ExecHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecRestoreHook) DeepCopyInto(out *ExecRestoreHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.ExecTimeout = in.ExecTimeout
+	out.WaitTimeout = in.WaitTimeout
+	if in.WaitForReady != nil {
+		in, out := &in.WaitForReady, &out.WaitForReady
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecRestoreHook.
+func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookStatus) DeepCopyInto(out *HookStatus) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]BackupHookResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookStatus.
+func (in *HookStatus) DeepCopy() *HookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
+	*out = *in
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitRestoreHook.
+func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(InitRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ItemFailureThresholds) DeepCopyInto(out *ItemFailureThresholds) {
+	*out = *in
+	if in.MaxFailedItems != nil {
+		in, out := &in.MaxFailedItems, &out.MaxFailedItems
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxFailedItemsPercent != nil {
+		in, out := &in.MaxFailedItemsPercent, &out.MaxFailedItemsPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.CriticalResources != nil {
+		in, out := &in.CriticalResources, &out.CriticalResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemFailureThresholds.
+func (in *ItemFailureThresholds) DeepCopy() *ItemFailureThresholds {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadRequestStatus)
+	out := new(ItemFailureThresholds)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DownloadTarget) DeepCopyInto(out *DownloadTarget) {
+func (in *ItemFilter) DeepCopyInto(out *ItemFilter) {
 	*out = *in
+	if in.IncludedItems != nil {
+		in, out := &in.IncludedItems, &out.IncludedItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedItems != nil {
+		in, out := &in.ExcludedItems, &out.ExcludedItems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadTarget.
-func (in *DownloadTarget) DeepCopy() *DownloadTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ItemFilter.
+func (in *ItemFilter) DeepCopy() *ItemFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(DownloadTarget)
+	out := new(ItemFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecHook) DeepCopyInto(out *ExecHook) {
+func (in *NamespaceMappingTemplate) DeepCopyInto(out *NamespaceMappingTemplate) {
 	*out = *in
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	out.Timeout = in.Timeout
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecHook.
-func (in *ExecHook) DeepCopy() *ExecHook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMappingTemplate.
+func (in *NamespaceMappingTemplate) DeepCopy() *NamespaceMappingTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecHook)
+	out := new(NamespaceMappingTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecRestoreHook) DeepCopyInto(out *ExecRestoreHook) {
+func (in *NamespaceResourceFilter) DeepCopyInto(out *NamespaceResourceFilter) {
 	*out = *in
-	if in.Command != nil {
-		in, out := &in.Command, &out.Command
+	if in.IncludedResources != nil {
+		in, out := &in.IncludedResources, &out.IncludedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedResources != nil {
+		in, out := &in.ExcludedResources, &out.ExcludedResources
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	out.ExecTimeout = in.ExecTimeout
-	out.WaitTimeout = in.WaitTimeout
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecRestoreHook.
-func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceFilter.
+func (in *NamespaceResourceFilter) DeepCopy() *NamespaceResourceFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecRestoreHook)
+	out := new(NamespaceResourceFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InitRestoreHook) DeepCopyInto(out *InitRestoreHook) {
+func (in *NotReadyWorkload) DeepCopyInto(out *NotReadyWorkload) {
 	*out = *in
-	if in.InitContainers != nil {
-		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]corev1.Container, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.Timeout = in.Timeout
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitRestoreHook.
-func (in *InitRestoreHook) DeepCopy() *InitRestoreHook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotReadyWorkload.
+func (in *NotReadyWorkload) DeepCopy() *NotReadyWorkload {
 	if in == nil {
 		return nil
 	}
-	out := new(InitRestoreHook)
+	out := new(NotReadyWorkload)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -813,6 +1408,22 @@ func (in *PodVolumeBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodVolumeBackupSize) DeepCopyInto(out *PodVolumeBackupSize) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumeBackupSize.
+func (in *PodVolumeBackupSize) DeepCopy() *PodVolumeBackupSize {
+	if in == nil {
+		return nil
+	}
+	out := new(PodVolumeBackupSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodVolumeBackupSpec) DeepCopyInto(out *PodVolumeBackupSpec) {
 	*out = *in
@@ -849,6 +1460,13 @@ func (in *PodVolumeBackupStatus) DeepCopyInto(out *PodVolumeBackupStatus) {
 		*out = (*in).DeepCopy()
 	}
 	out.Progress = in.Progress
+	if in.DataDigests != nil {
+		in, out := &in.DataDigests, &out.DataDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -883,7 +1501,7 @@ func (in *PodVolumeRestore) DeepCopyInto(out *PodVolumeRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -943,6 +1561,13 @@ func (in *PodVolumeRestoreList) DeepCopyObject() runtime.Object {
 func (in *PodVolumeRestoreSpec) DeepCopyInto(out *PodVolumeRestoreSpec) {
 	*out = *in
 	out.Pod = in.Pod
+	if in.DataDigests != nil {
+		in, out := &in.DataDigests, &out.DataDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -981,6 +1606,27 @@ func (in *PodVolumeRestoreStatus) DeepCopy() *PodVolumeRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceGroupOrder) DeepCopyInto(out *ResourceGroupOrder) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroupOrder.
+func (in *ResourceGroupOrder) DeepCopy() *ResourceGroupOrder {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceGroupOrder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResticRepository) DeepCopyInto(out *ResticRepository) {
 	*out = *in
@@ -1066,6 +1712,18 @@ func (in *ResticRepositoryStatus) DeepCopyInto(out *ResticRepositoryStatus) {
 		in, out := &in.LastMaintenanceTime, &out.LastMaintenanceTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastMaintenanceDuration != nil {
+		in, out := &in.LastMaintenanceDuration, &out.LastMaintenanceDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1233,6 +1891,11 @@ func (in *RestoreResourceHookSpec) DeepCopyInto(out *RestoreResourceHookSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AnnotationSelector != nil {
+		in, out := &in.AnnotationSelector, &out.AnnotationSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PostHooks != nil {
 		in, out := &in.PostHooks, &out.PostHooks
 		*out = make([]RestoreResourceHook, len(*in))
@@ -1253,6 +1916,38 @@ func (in *RestoreResourceHookSpec) DeepCopy() *RestoreResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreResourcePriorities) DeepCopyInto(out *RestoreResourcePriorities) {
+	*out = *in
+	if in.HighPriorities != nil {
+		in, out := &in.HighPriorities, &out.HighPriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LowPriorities != nil {
+		in, out := &in.LowPriorities, &out.LowPriorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupOrders != nil {
+		in, out := &in.GroupOrders, &out.GroupOrders
+		*out = make([]ResourceGroupOrder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResourcePriorities.
+func (in *RestoreResourcePriorities) DeepCopy() *RestoreResourcePriorities {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreResourcePriorities)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
@@ -1276,6 +1971,13 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NamespaceResourceFilters != nil {
+		in, out := &in.NamespaceResourceFilters, &out.NamespaceResourceFilters
+		*out = make(map[string]NamespaceResourceFilter, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.NamespaceMapping != nil {
 		in, out := &in.NamespaceMapping, &out.NamespaceMapping
 		*out = make(map[string]string, len(*in))
@@ -1283,11 +1985,41 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.NamespaceMappingTemplate != nil {
+		in, out := &in.NamespaceMappingTemplate, &out.NamespaceMappingTemplate
+		*out = new(NamespaceMappingTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExcludedAnnotation != nil {
+		in, out := &in.ExcludedAnnotation, &out.ExcludedAnnotation
+		*out = new(AnnotationMatch)
+		**out = **in
+	}
+	if in.IncludedVolumes != nil {
+		in, out := &in.IncludedVolumes, &out.IncludedVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedVolumes != nil {
+		in, out := &in.ExcludedVolumes, &out.ExcludedVolumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipUnselectedVolumes != nil {
+		in, out := &in.SkipUnselectedVolumes, &out.SkipUnselectedVolumes
+		*out = new(bool)
+		**out = **in
+	}
 	if in.RestorePVs != nil {
 		in, out := &in.RestorePVs, &out.RestorePVs
 		*out = new(bool)
@@ -1303,6 +2035,76 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecordChanges != nil {
+		in, out := &in.RecordChanges, &out.RecordChanges
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScaleDownWorkloads != nil {
+		in, out := &in.ScaleDownWorkloads, &out.ScaleDownWorkloads
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourcePriorities != nil {
+		in, out := &in.ResourcePriorities, &out.ResourcePriorities
+		*out = new(RestoreResourcePriorities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceTimeout != nil {
+		in, out := &in.ResourceTimeout, &out.ResourceTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Resume != nil {
+		in, out := &in.Resume, &out.Resume
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceModifier != nil {
+		in, out := &in.ResourceModifier, &out.ResourceModifier
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerifyPodVolumeData != nil {
+		in, out := &in.VerifyPodVolumeData, &out.VerifyPodVolumeData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipFailedBackupItems != nil {
+		in, out := &in.SkipFailedBackupItems, &out.SkipFailedBackupItems
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GenerateNameOnConflict != nil {
+		in, out := &in.GenerateNameOnConflict, &out.GenerateNameOnConflict
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferredAPIVersions != nil {
+		in, out := &in.PreferredAPIVersions, &out.PreferredAPIVersions
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.WaitForWorkloads != nil {
+		in, out := &in.WaitForWorkloads, &out.WaitForWorkloads
+		*out = new(WorkloadReadinessWait)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
 	return
 }
@@ -1325,6 +2127,11 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkloadReadiness != nil {
+		in, out := &in.WorkloadReadiness, &out.WorkloadReadiness
+		*out = new(WorkloadReadinessStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StartTimestamp != nil {
 		in, out := &in.StartTimestamp, &out.StartTimestamp
 		*out = (*in).DeepCopy()
@@ -1421,6 +2228,32 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TTLOverrides != nil {
+		in, out := &in.TTLOverrides, &out.TTLOverrides
+		*out = make([]ScheduleTTLOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SkipImmediately != nil {
+		in, out := &in.SkipImmediately, &out.SkipImmediately
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BackupLabelTemplates != nil {
+		in, out := &in.BackupLabelTemplates, &out.BackupLabelTemplates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackupAnnotationTemplates != nil {
+		in, out := &in.BackupAnnotationTemplates, &out.BackupAnnotationTemplates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1441,6 +2274,10 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.LastBackup, &out.LastBackup
 		*out = (*in).DeepCopy()
 	}
+	if in.LastSkipped != nil {
+		in, out := &in.LastSkipped, &out.LastSkipped
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -1459,6 +2296,28 @@ func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleTTLOverride) DeepCopyInto(out *ScheduleTTLOverride) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.TTL = in.TTL
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleTTLOverride.
+func (in *ScheduleTTLOverride) DeepCopy() *ScheduleTTLOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleTTLOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerStatusRequest) DeepCopyInto(out *ServerStatusRequest) {
 	*out = *in
@@ -1588,7 +2447,7 @@ func (in *VolumeSnapshotLocation) DeepCopyInto(out *VolumeSnapshotLocation) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1669,6 +2528,10 @@ func (in *VolumeSnapshotLocationSpec) DeepCopy() *VolumeSnapshotLocationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotLocationStatus) DeepCopyInto(out *VolumeSnapshotLocationStatus) {
 	*out = *in
+	if in.LastValidationTime != nil {
+		in, out := &in.LastValidationTime, &out.LastValidationTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1681,3 +2544,55 @@ func (in *VolumeSnapshotLocationStatus) DeepCopy() *VolumeSnapshotLocationStatus
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadReadinessStatus) DeepCopyInto(out *WorkloadReadinessStatus) {
+	*out = *in
+	if in.NotReady != nil {
+		in, out := &in.NotReady, &out.NotReady
+		*out = make([]NotReadyWorkload, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadReadinessStatus.
+func (in *WorkloadReadinessStatus) DeepCopy() *WorkloadReadinessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadReadinessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadReadinessWait) DeepCopyInto(out *WorkloadReadinessWait) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.StatefulSets != nil {
+		in, out := &in.StatefulSets, &out.StatefulSets
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DaemonSets != nil {
+		in, out := &in.DaemonSets, &out.DaemonSets
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadReadinessWait.
+func (in *WorkloadReadinessWait) DeepCopy() *WorkloadReadinessWait {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadReadinessWait)
+	in.DeepCopyInto(out)
+	return out
+}
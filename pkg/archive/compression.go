@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// CompressionAlgorithms are the algorithms a backup tarball can be
+// compressed with.
+var CompressionAlgorithms = []velerov1api.CompressionAlgorithm{
+	velerov1api.CompressionAlgorithmGzip,
+	velerov1api.CompressionAlgorithmZstd,
+	velerov1api.CompressionAlgorithmLZ4,
+	velerov1api.CompressionAlgorithmNone,
+}
+
+// CompressionAlgorithmNames returns the names of CompressionAlgorithms, for
+// use in flags.
+func CompressionAlgorithmNames() []string {
+	var names []string
+	for _, a := range CompressionAlgorithms {
+		names = append(names, string(a))
+	}
+	return names
+}
+
+// IsValidCompressionAlgorithm returns whether algorithm is one of
+// CompressionAlgorithms. An empty algorithm is valid, and means gzip.
+func IsValidCompressionAlgorithm(algorithm velerov1api.CompressionAlgorithm) bool {
+	if algorithm == "" {
+		return true
+	}
+	for _, a := range CompressionAlgorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// NewCompressionWriter returns a writer that compresses data written to it
+// with algorithm and writes it to w. An empty algorithm means gzip, which
+// backups were always compressed with before the algorithm was selectable.
+// The returned writer must be closed to flush the compressed data; closing
+// it doesn't close w.
+func NewCompressionWriter(algorithm velerov1api.CompressionAlgorithm, w io.Writer) (io.WriteCloser, error) {
+	switch algorithm {
+	case "", velerov1api.CompressionAlgorithmGzip:
+		return gzip.NewWriter(w), nil
+	case velerov1api.CompressionAlgorithmZstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating zstd writer")
+		}
+		return zw, nil
+	case velerov1api.CompressionAlgorithmLZ4:
+		return lz4.NewWriter(w), nil
+	case velerov1api.CompressionAlgorithmNone:
+		return nopWriteCloser{w}, nil
+	default:
+		return nil, errors.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+}
+
+// NewDecompressionReader returns a reader of the data read from r,
+// decompressed with algorithm. An empty algorithm means gzip. Closing the
+// returned reader doesn't close r.
+func NewDecompressionReader(algorithm velerov1api.CompressionAlgorithm, r io.Reader) (io.ReadCloser, error) {
+	switch algorithm {
+	case "", velerov1api.CompressionAlgorithmGzip:
+		gzr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating gzip reader")
+		}
+		return gzr, nil
+	case velerov1api.CompressionAlgorithmZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating zstd reader")
+		}
+		return zr.IOReadCloser(), nil
+	case velerov1api.CompressionAlgorithmLZ4:
+		return ioutil.NopCloser(lz4.NewReader(r)), nil
+	case velerov1api.CompressionAlgorithmNone:
+		return ioutil.NopCloser(r), nil
+	default:
+		return nil, errors.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+}
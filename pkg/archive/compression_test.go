@@ -0,0 +1,85 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("velero backup data "), 1000)
+
+	for _, algorithm := range append(CompressionAlgorithms, "") {
+		t.Run(string(algorithm), func(t *testing.T) {
+			buf := new(bytes.Buffer)
+
+			w, err := NewCompressionWriter(algorithm, buf)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			r, err := NewDecompressionReader(algorithm, buf)
+			require.NoError(t, err)
+			defer r.Close()
+
+			res, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, res)
+		})
+	}
+}
+
+func TestDecompressionReaderReadsLegacyGzip(t *testing.T) {
+	data := []byte("velero backup data")
+
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	_, err := gzw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gzw.Close())
+
+	// backups from before the compression algorithm was recorded have an
+	// empty algorithm, and were compressed with gzip.
+	r, err := NewDecompressionReader("", buf)
+	require.NoError(t, err)
+	defer r.Close()
+
+	res, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+}
+
+func TestUnsupportedCompressionAlgorithm(t *testing.T) {
+	_, err := NewCompressionWriter("bzip2", new(bytes.Buffer))
+	assert.EqualError(t, err, `unsupported compression algorithm "bzip2"`)
+
+	_, err = NewDecompressionReader("bzip2", new(bytes.Buffer))
+	assert.EqualError(t, err, `unsupported compression algorithm "bzip2"`)
+
+	assert.False(t, IsValidCompressionAlgorithm("bzip2"))
+	assert.True(t, IsValidCompressionAlgorithm(""))
+	assert.True(t, IsValidCompressionAlgorithm(velerov1api.CompressionAlgorithmZstd))
+}
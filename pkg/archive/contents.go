@@ -0,0 +1,162 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ContentsItem is an item in a backup's contents tarball.
+type ContentsItem struct {
+	GroupResource string
+	Namespace     string
+	Name          string
+	// Digest is a SHA-256 digest of the item, excluding the fields the
+	// cluster sets on every object, so that items only have the same digest
+	// if they're the same object with the same content.
+	Digest string
+}
+
+// volatileMetadataFields are set by the cluster on every object, and change
+// without the object changing.
+var volatileMetadataFields = []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"}
+
+// ReadBackupContents reads the items in a backup contents tarball compressed
+// with the given algorithm, without extracting it. An empty algorithm means
+// gzip. Items are only read from the resources directory's unversioned paths,
+// which contain each item once, in its preferred API version.
+func ReadBackupContents(src io.Reader, algorithm velerov1api.CompressionAlgorithm) ([]ContentsItem, error) {
+	r, err := NewDecompressionReader(algorithm, src)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var items []ContentsItem
+	tarRdr := tar.NewReader(r)
+	for {
+		header, err := tarRdr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tarball")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		item, ok := parseItemPath(header.Name)
+		if !ok {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tarRdr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", header.Name)
+		}
+		if item.Digest, err = itemDigest(data); err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", header.Name)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// ContentsSize returns the total size of the files in a backup contents
+// tarball compressed with the given algorithm, which is how much space
+// extracting it takes. Only the tarball's headers are read, but it still has
+// to be decompressed in full to find them.
+func ContentsSize(src io.Reader, algorithm velerov1api.CompressionAlgorithm) (int64, error) {
+	r, err := NewDecompressionReader(algorithm, src)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	var size int64
+	tarRdr := tar.NewReader(r)
+	for {
+		header, err := tarRdr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "error reading tarball")
+		}
+		if header.Typeflag == tar.TypeReg {
+			size += header.Size
+		}
+	}
+
+	return size, nil
+}
+
+// parseItemPath returns the item whose unversioned path in a backup tarball
+// is path, such as resources/pods/namespaces/ns-1/pod-1.json, or false if it
+// isn't one.
+func parseItemPath(path string) (ContentsItem, bool) {
+	parts := strings.Split(path, "/")
+	if len(parts) < 4 || parts[0] != velerov1api.ResourcesDir || !strings.HasSuffix(parts[len(parts)-1], ".json") {
+		return ContentsItem{}, false
+	}
+	name := strings.TrimSuffix(parts[len(parts)-1], ".json")
+
+	switch {
+	case len(parts) == 4 && parts[2] == velerov1api.ClusterScopedDir:
+		return ContentsItem{GroupResource: parts[1], Name: name}, true
+	case len(parts) == 5 && parts[2] == velerov1api.NamespaceScopedDir:
+		return ContentsItem{GroupResource: parts[1], Namespace: parts[3], Name: name}, true
+	default:
+		return ContentsItem{}, false
+	}
+}
+
+func itemDigest(data []byte) (string, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, field := range volatileMetadataFields {
+			delete(metadata, field)
+		}
+	}
+	delete(obj, "status")
+
+	// maps are encoded with sorted keys, so equal items encode the same.
+	encoded, err := json.Marshal(obj)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// newTarball returns a tarball of files, compressed with algorithm.
+func newTarball(t *testing.T, algorithm velerov1api.CompressionAlgorithm, files map[string]string) *bytes.Buffer {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	w, err := NewCompressionWriter(algorithm, buf)
+	require.NoError(t, err)
+
+	tw := tar.NewWriter(w)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(data)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, w.Close())
+
+	return buf
+}
+
+func TestReadBackupContents(t *testing.T) {
+	pod := `{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-1"}, "spec": {"nodeName": "node-1"}}`
+	tarball := newTarball(t, velerov1api.CompressionAlgorithmZstd, map[string]string{
+		"metadata/version":                                                  "1",
+		"resources/pods/namespaces/ns-1/pod-1.json":                         pod,
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json":     pod,
+		"resources/persistentvolumes/cluster/pv-1.json":                     `{"apiVersion": "v1", "kind": "PersistentVolume", "metadata": {"name": "pv-1"}}`,
+		"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json": `{"apiVersion": "v1", "kind": "PersistentVolume", "metadata": {"name": "pv-1"}}`,
+	})
+
+	items, err := ReadBackupContents(tarball, velerov1api.CompressionAlgorithmZstd)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	byName := map[string]ContentsItem{}
+	for _, item := range items {
+		assert.NotEmpty(t, item.Digest)
+		byName[item.Name] = item
+	}
+	assert.Equal(t, "pods", byName["pod-1"].GroupResource)
+	assert.Equal(t, "ns-1", byName["pod-1"].Namespace)
+	assert.Equal(t, "persistentvolumes", byName["pv-1"].GroupResource)
+	assert.Equal(t, "", byName["pv-1"].Namespace)
+
+	_, err = ReadBackupContents(newTarball(t, "", map[string]string{"resources/pods/namespaces/ns-1/pod-1.json": "not json"}), "")
+	assert.Error(t, err)
+}
+
+func TestContentsSize(t *testing.T) {
+	for _, algorithm := range append(CompressionAlgorithms, "") {
+		t.Run(string(algorithm), func(t *testing.T) {
+			tarball := newTarball(t, algorithm, map[string]string{
+				"metadata/version":                          "1",
+				"resources/pods/namespaces/ns-1/pod-1.json": `{"apiVersion": "v1", "kind": "Pod"}`,
+			})
+
+			size, err := ContentsSize(tarball, algorithm)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len("1")+len(`{"apiVersion": "v1", "kind": "Pod"}`)), size)
+		})
+	}
+
+	_, err := ContentsSize(bytes.NewReader([]byte("not a tarball")), velerov1api.CompressionAlgorithmGzip)
+	assert.Error(t, err)
+}
+
+func TestItemDigest(t *testing.T) {
+	digest := func(data string) string {
+		t.Helper()
+		res, err := itemDigest([]byte(data))
+		require.NoError(t, err)
+		return res
+	}
+
+	pod := digest(`{"kind": "Pod", "metadata": {"name": "pod-1", "uid": "1", "resourceVersion": "10"}, "spec": {"nodeName": "node-1"}}`)
+
+	// fields the cluster sets on every object are ignored, and so is the
+	// order of fields.
+	assert.Equal(t, pod, digest(`{"spec": {"nodeName": "node-1"}, "metadata": {"resourceVersion": "20", "name": "pod-1", "uid": "2"}, "kind": "Pod", "status": {"phase": "Running"}}`))
+
+	assert.NotEqual(t, pod, digest(`{"kind": "Pod", "metadata": {"name": "pod-1"}, "spec": {"nodeName": "node-2"}}`))
+	assert.NotEqual(t, pod, digest(`{"kind": "Pod", "metadata": {"name": "pod-1", "labels": {"app": "web"}}, "spec": {"nodeName": "node-1"}}`))
+}
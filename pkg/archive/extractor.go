@@ -18,12 +18,12 @@ package archive
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"io"
 	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -41,16 +41,40 @@ func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
 	}
 }
 
-// UnzipAndExtractBackup extracts a reader on a gzipped tarball to a local temp directory
-func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
-	gzr, err := gzip.NewReader(src)
+// UnzipAndExtractBackup extracts a reader on a tarball compressed with the given
+// algorithm to a local temp directory. An empty algorithm means gzip.
+func (e *Extractor) UnzipAndExtractBackup(src io.Reader, algorithm velerov1api.CompressionAlgorithm) (string, error) {
+	r, err := NewDecompressionReader(algorithm, src)
 	if err != nil {
-		e.log.Infof("error creating gzip reader: %v", err)
+		e.log.Infof("error creating decompression reader: %v", err)
 		return "", err
 	}
-	defer gzr.Close()
+	defer r.Close()
 
-	return e.readBackup(tar.NewReader(gzr))
+	return e.readBackup(tar.NewReader(r))
+}
+
+// ExtractBackupFiles extracts the given files of a tarball compressed with
+// the given algorithm to dir, alongside whatever it already contains. It's
+// used to add the unchanged items of an incremental backup, which are in the
+// tarballs of the backups it's based on.
+func (e *Extractor) ExtractBackupFiles(src io.Reader, algorithm velerov1api.CompressionAlgorithm, dir string, files []string) error {
+	r, err := NewDecompressionReader(algorithm, src)
+	if err != nil {
+		e.log.Infof("error creating decompression reader: %v", err)
+		return err
+	}
+	defer r.Close()
+
+	wanted := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		wanted[filepath.Clean(file)] = struct{}{}
+	}
+
+	return e.extractTar(tar.NewReader(r), dir, func(name string) bool {
+		_, ok := wanted[filepath.Clean(name)]
+		return ok
+	})
 }
 
 func (e *Extractor) writeFile(target string, tarRdr *tar.Reader) error {
@@ -73,6 +97,15 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		return "", err
 	}
 
+	if err := e.extractTar(tarRdr, dir, nil); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// extractTar extracts the entries of a tarball for which include returns
+// true to dir, or all of them if include is nil.
+func (e *Extractor) extractTar(tarRdr *tar.Reader, dir string, include func(name string) bool) error {
 	for {
 		header, err := tarRdr.Next()
 
@@ -81,7 +114,11 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		}
 		if err != nil {
 			e.log.Infof("error reading tar: %v", err)
-			return "", err
+			return err
+		}
+
+		if include != nil && !include(header.Name) {
+			continue
 		}
 
 		target := filepath.Join(dir, header.Name)
@@ -91,7 +128,7 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 			err := e.fs.MkdirAll(target, header.FileInfo().Mode())
 			if err != nil {
 				e.log.Infof("mkdirall error: %v", err)
-				return "", err
+				return err
 			}
 
 		case tar.TypeReg:
@@ -99,16 +136,16 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 			err := e.fs.MkdirAll(filepath.Dir(target), header.FileInfo().Mode())
 			if err != nil {
 				e.log.Infof("mkdirall error: %v", err)
-				return "", err
+				return err
 			}
 
 			// create the file
 			if err := e.writeFile(target, tarRdr); err != nil {
 				e.log.Infof("error copying: %v", err)
-				return "", err
+				return err
 			}
 		}
 	}
 
-	return dir, nil
+	return nil
 }
@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// aggregatedAPIService is an APIService that registers an aggregated API
+// server, which serves its group version instead of the Kubernetes API
+// server.
+type aggregatedAPIService struct {
+	name      string
+	available bool
+	message   string
+}
+
+// getAggregatedAPIServices returns the cluster's aggregated API services,
+// keyed by the group version they serve. It returns nil if APIServices
+// can't be listed, in which case every group version is treated as if the
+// Kubernetes API server serves it.
+func getAggregatedAPIServices(log logrus.FieldLogger, discoveryHelper discovery.Helper, dynamicFactory client.DynamicFactory) map[schema.GroupVersion]aggregatedAPIService {
+	gvr, resource, err := discoveryHelper.ResourceFor(kuberesource.APIServices.WithVersion(""))
+	if err != nil {
+		log.WithError(err).Debug("Unable to find APIServices, not checking for aggregated API servers")
+		return nil
+	}
+
+	apiServiceClient, err := dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, "")
+	if err != nil {
+		log.WithError(err).Warn("Error getting APIService client, not checking for aggregated API servers")
+		return nil
+	}
+
+	list, err := apiServiceClient.List(metav1.ListOptions{})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Warn("Error listing APIServices, not checking for aggregated API servers")
+		return nil
+	}
+
+	return aggregatedAPIServicesFrom(list.Items)
+}
+
+func aggregatedAPIServicesFrom(items []unstructured.Unstructured) map[schema.GroupVersion]aggregatedAPIService {
+	services := make(map[schema.GroupVersion]aggregatedAPIService)
+	for i := range items {
+		item := &items[i]
+		if !isAggregatedAPIService(item) {
+			continue
+		}
+
+		group, _, _ := unstructured.NestedString(item.Object, "spec", "group")
+		version, _, _ := unstructured.NestedString(item.Object, "spec", "version")
+
+		// an APIService without an Available condition hasn't been checked
+		// yet, so its group version is listed as usual.
+		service := aggregatedAPIService{name: item.GetName(), available: true}
+		conditions, _, _ := unstructured.NestedSlice(item.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok || condition["type"] != "Available" {
+				continue
+			}
+			service.available = condition["status"] == "True"
+			service.message, _ = condition["message"].(string)
+		}
+
+		services[schema.GroupVersion{Group: group, Version: version}] = service
+	}
+	return services
+}
+
+// isAggregatedAPIService returns whether an APIService registers an
+// aggregated API server. APIServices without a service are for the group
+// versions the Kubernetes API server serves itself, and are created and
+// managed by it.
+func isAggregatedAPIService(item *unstructured.Unstructured) bool {
+	service, found, _ := unstructured.NestedFieldNoCopy(item.Object, "spec", "service")
+	return found && service != nil
+}
+
+// includeAPIService returns whether an APIService is backed up when
+// backup.spec.includeAPIServices is true, which backs up only the APIServices
+// whose aggregated API server's service is in an included namespace.
+func includeAPIService(item *unstructured.Unstructured, namespaces *collections.IncludesExcludes) (bool, string) {
+	if !isAggregatedAPIService(item) {
+		return false, "it's served by the Kubernetes API server"
+	}
+
+	namespace, _, _ := unstructured.NestedString(item.Object, "spec", "service", "namespace")
+	if !namespaces.ShouldInclude(namespace) {
+		return false, fmt.Sprintf("its service's namespace %s is excluded", namespace)
+	}
+	return true, ""
+}
@@ -0,0 +1,58 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestAggregatedAPIServicesFrom(t *testing.T) {
+	items := []unstructured.Unstructured{
+		*velerotest.UnstructuredOrDie(`{
+			"apiVersion": "apiregistration.k8s.io/v1",
+			"kind": "APIService",
+			"metadata": {"name": "v1.apps"},
+			"spec": {"group": "apps", "version": "v1", "service": null},
+			"status": {"conditions": [{"type": "Available", "status": "True"}]}
+		}`),
+		*velerotest.UnstructuredOrDie(`{
+			"apiVersion": "apiregistration.k8s.io/v1",
+			"kind": "APIService",
+			"metadata": {"name": "v1beta1.metrics.k8s.io"},
+			"spec": {"group": "metrics.k8s.io", "version": "v1beta1", "service": {"namespace": "kube-system", "name": "metrics-server"}},
+			"status": {"conditions": [{"type": "Available", "status": "False", "message": "failing or missing response"}]}
+		}`),
+		*velerotest.UnstructuredOrDie(`{
+			"apiVersion": "apiregistration.k8s.io/v1",
+			"kind": "APIService",
+			"metadata": {"name": "v1alpha1.example.com"},
+			"spec": {"group": "example.com", "version": "v1alpha1", "service": {"namespace": "ns-1", "name": "example-api"}}
+		}`),
+	}
+
+	want := map[schema.GroupVersion]aggregatedAPIService{
+		{Group: "metrics.k8s.io", Version: "v1beta1"}: {name: "v1beta1.metrics.k8s.io", available: false, message: "failing or missing response"},
+		{Group: "example.com", Version: "v1alpha1"}:   {name: "v1alpha1.example.com", available: true},
+	}
+	assert.Equal(t, want, aggregatedAPIServicesFrom(items))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
+)
+
+// allAPIVersions is the version of a backup's resource API versions that
+// backs a resource up at every version it's served at.
+const allAPIVersions = "*"
+
+// ValidateResourceAPIVersions returns an error if versions aren't either API
+// versions, such as v1beta1, or only "*".
+func ValidateResourceAPIVersions(versions []string) error {
+	if len(versions) == 0 {
+		return errors.New("at least one version is required")
+	}
+
+	for _, version := range versions {
+		if version == allAPIVersions {
+			if len(versions) > 1 {
+				return errors.New("* can't be combined with other versions")
+			}
+			continue
+		}
+		if version == "" || strings.ContainsAny(version, "/*., ") {
+			return errors.Errorf("version %q must be an API version, such as v1beta1, or *", version)
+		}
+	}
+
+	return nil
+}
+
+// getResourceAPIVersions resolves the keys of a backup's resource API
+// versions to the group-resources they refer to.
+func getResourceAPIVersions(discoveryHelper discovery.Helper, resourceAPIVersions map[string][]string) (map[schema.GroupResource][]string, error) {
+	if len(resourceAPIVersions) == 0 {
+		return nil, nil
+	}
+
+	resolved := make(map[schema.GroupResource][]string, len(resourceAPIVersions))
+	for resource, versions := range resourceAPIVersions {
+		if err := ValidateResourceAPIVersions(versions); err != nil {
+			return nil, errors.Wrapf(err, "invalid API versions for resource %s", resource)
+		}
+
+		gvr, _, err := discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve resource %s of API versions", resource)
+		}
+		resolved[gvr.GroupResource()] = versions
+	}
+
+	return resolved, nil
+}
+
+// getAdditionalVersionItems collects the items of a resource at the API
+// versions the backup requests for it, other than the version it was
+// discovered at. Failing to get them doesn't fail the backup, since the
+// resource's items are still backed up at that version.
+func (r *itemCollector) getAdditionalVersionItems(log logrus.FieldLogger, gv schema.GroupVersion, resource metav1.APIResource) []*kubernetesResource {
+	// all versions of every resource are discovered when the feature flag
+	// is enabled.
+	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
+		return nil
+	}
+
+	requested, ok := r.backupRequest.ResourceAPIVersions[gv.WithResource(resource.Name).GroupResource()]
+	if !ok {
+		return nil
+	}
+
+	allVersions := len(requested) == 1 && requested[0] == allAPIVersions
+	versions := requested
+	if allVersions {
+		versions = nil
+		for _, group := range r.discoveryHelper.APIGroups() {
+			if group.Name != gv.Group {
+				continue
+			}
+			for _, version := range group.Versions {
+				versions = append(versions, version.Version)
+			}
+		}
+	}
+
+	var items []*kubernetesResource
+	for _, version := range versions {
+		if version == gv.Version {
+			continue
+		}
+
+		versionGV := schema.GroupVersion{Group: gv.Group, Version: version}
+		versionLog := log.WithField("apiVersion", versionGV.String())
+
+		versionResource, found, err := r.resourceForGroupVersion(versionGV, resource.Name)
+		if err != nil {
+			versionLog.WithError(err).Warn("Unable to discover the resources served at API version")
+			continue
+		}
+		if !found {
+			// a group's versions needn't all serve each of its resources.
+			if !allVersions {
+				versionLog.Warn("Skipping API version because the resource isn't served at it")
+			}
+			continue
+		}
+
+		versionItems, err := r.getResourceItems(versionLog, versionGV, versionResource)
+		if err != nil {
+			versionLog.WithError(err).Error("Error getting items for resource at API version")
+			continue
+		}
+		items = append(items, versionItems...)
+	}
+
+	return items
+}
+
+// resourceForGroupVersion returns the resource named name that's served at
+// an API group version, if it's served there.
+func (r *itemCollector) resourceForGroupVersion(gv schema.GroupVersion, name string) (metav1.APIResource, bool, error) {
+	resourceList, err := r.discoveryHelper.ResourcesForGroupVersion(gv.String())
+	if err != nil {
+		return metav1.APIResource{}, false, err
+	}
+
+	for _, resource := range resourceList.APIResources {
+		if resource.Name == name {
+			return resource, true, nil
+		}
+	}
+	return metav1.APIResource{}, false, nil
+}
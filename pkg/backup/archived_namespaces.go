@@ -0,0 +1,132 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// archivedNamespace returns the name namespace is recorded under in the
+// backup tarball, asking the backup's namespace mappers the first time the
+// namespace is seen. Every mapping is recorded in the backup's status so
+// that restores can reverse it, and two namespaces can't be recorded under
+// the same name.
+func (r *Request) archivedNamespace(namespace string) (string, error) {
+	if len(r.NamespaceMappers) == 0 || namespace == "" {
+		return namespace, nil
+	}
+	if archived, ok := r.Status.ArchivedNamespaces[namespace]; ok {
+		return archived, nil
+	}
+
+	archived := namespace
+	for _, mapper := range r.NamespaceMappers {
+		mapped, err := mapper.MapNamespace(archived, r.Backup)
+		if err != nil {
+			return "", errors.Wrapf(err, "error mapping namespace %s", namespace)
+		}
+		archived = mapped
+	}
+
+	if errs := validation.IsDNS1123Label(archived); len(errs) > 0 {
+		return "", errors.Errorf("namespace %s is mapped to invalid namespace name %q: %s", namespace, archived, strings.Join(errs, "; "))
+	}
+	for source, other := range r.Status.ArchivedNamespaces {
+		if other == archived {
+			return "", errors.Errorf("namespaces %s and %s are both mapped to %s", source, namespace, archived)
+		}
+	}
+
+	if r.Status.ArchivedNamespaces == nil {
+		r.Status.ArchivedNamespaces = make(map[string]string)
+	}
+	r.Status.ArchivedNamespaces[namespace] = archived
+	return archived, nil
+}
+
+// archiveNamespaces renames the namespaces an item is in and refers to, so
+// that references between items in the backup tarball stay consistent: a
+// namespace's own name, a persistent volume's claim, and the subjects of
+// role bindings and cluster role bindings. Owner references need no change,
+// since owners are always in the same namespace.
+func (r *Request) archiveNamespaces(obj runtime.Unstructured, groupResource schema.GroupResource) error {
+	if len(r.NamespaceMappers) == 0 {
+		return nil
+	}
+
+	item := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+
+	if namespace := item.GetNamespace(); namespace != "" {
+		archived, err := r.archivedNamespace(namespace)
+		if err != nil {
+			return err
+		}
+		item.SetNamespace(archived)
+	}
+
+	switch groupResource {
+	case kuberesource.Namespaces:
+		archived, err := r.archivedNamespace(item.GetName())
+		if err != nil {
+			return err
+		}
+		item.SetName(archived)
+	case kuberesource.PersistentVolumes:
+		if err := r.archiveNestedNamespace(item.Object, "spec", "claimRef", "namespace"); err != nil {
+			return err
+		}
+	case kuberesource.RoleBindings, kuberesource.ClusterRoleBindings:
+		subjects, found, _ := unstructured.NestedSlice(item.Object, "subjects")
+		if !found {
+			break
+		}
+		for _, subject := range subjects {
+			if subject, ok := subject.(map[string]interface{}); ok {
+				if err := r.archiveNestedNamespace(subject, "namespace"); err != nil {
+					return err
+				}
+			}
+		}
+		if err := unstructured.SetNestedSlice(item.Object, subjects, "subjects"); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// archiveNestedNamespace renames the namespace at fields of obj, if it's set.
+func (r *Request) archiveNestedNamespace(obj map[string]interface{}, fields ...string) error {
+	namespace, _, _ := unstructured.NestedString(obj, fields...)
+	if namespace == "" {
+		return nil
+	}
+
+	archived, err := r.archivedNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(unstructured.SetNestedField(obj, archived, fields...))
+}
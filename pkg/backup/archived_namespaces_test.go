@@ -0,0 +1,149 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// prefixNamespaceMapper maps every namespace to its name with a prefix.
+type prefixNamespaceMapper struct {
+	prefix string
+}
+
+func (m *prefixNamespaceMapper) MapNamespace(namespace string, _ *velerov1api.Backup) (string, error) {
+	return m.prefix + namespace, nil
+}
+
+// staticNamespaceMapper maps every namespace to the same name.
+type staticNamespaceMapper struct {
+	namespace string
+}
+
+func (m *staticNamespaceMapper) MapNamespace(string, *velerov1api.Backup) (string, error) {
+	return m.namespace, nil
+}
+
+func TestArchiveNamespaces(t *testing.T) {
+	req := &Request{
+		Backup:           builder.ForBackup("velero", "backup-1").Result(),
+		NamespaceMappers: []velero.NamespaceMapper{&prefixNamespaceMapper{prefix: "a-"}, &prefixNamespaceMapper{prefix: "b-"}},
+	}
+
+	pod := velerotest.UnstructuredOrDie(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-1"}}`)
+	require.NoError(t, req.archiveNamespaces(pod, kuberesource.Pods))
+	assert.Equal(t, "b-a-ns-1", pod.GetNamespace())
+
+	namespace := velerotest.UnstructuredOrDie(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "ns-1"}}`)
+	require.NoError(t, req.archiveNamespaces(namespace, kuberesource.Namespaces))
+	assert.Equal(t, "b-a-ns-1", namespace.GetName())
+
+	pv := velerotest.UnstructuredOrDie(`{
+		"apiVersion": "v1",
+		"kind": "PersistentVolume",
+		"metadata": {"name": "pv-1"},
+		"spec": {"claimRef": {"namespace": "ns-2", "name": "pvc-1"}}
+	}`)
+	require.NoError(t, req.archiveNamespaces(pv, kuberesource.PersistentVolumes))
+	assert.Equal(t, velerotest.UnstructuredOrDie(`{
+		"apiVersion": "v1",
+		"kind": "PersistentVolume",
+		"metadata": {"name": "pv-1"},
+		"spec": {"claimRef": {"namespace": "b-a-ns-2", "name": "pvc-1"}}
+	}`), pv)
+
+	binding := velerotest.UnstructuredOrDie(`{
+		"apiVersion": "rbac.authorization.k8s.io/v1",
+		"kind": "ClusterRoleBinding",
+		"metadata": {"name": "crb-1"},
+		"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "view"},
+		"subjects": [{"kind": "ServiceAccount", "namespace": "ns-1", "name": "sa-1"}, {"kind": "User", "name": "jane"}]
+	}`)
+	require.NoError(t, req.archiveNamespaces(binding, kuberesource.ClusterRoleBindings))
+	assert.Equal(t, velerotest.UnstructuredOrDie(`{
+		"apiVersion": "rbac.authorization.k8s.io/v1",
+		"kind": "ClusterRoleBinding",
+		"metadata": {"name": "crb-1"},
+		"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "view"},
+		"subjects": [{"kind": "ServiceAccount", "namespace": "b-a-ns-1", "name": "sa-1"}, {"kind": "User", "name": "jane"}]
+	}`), binding)
+
+	assert.Equal(t, map[string]string{"ns-1": "b-a-ns-1", "ns-2": "b-a-ns-2"}, req.Status.ArchivedNamespaces)
+}
+
+func TestArchivedNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappers  []velero.NamespaceMapper
+		expected []string
+		wantErr  string
+	}{
+		{
+			name:     "namespaces aren't mapped without mappers",
+			expected: []string{"ns-1", "ns-2"},
+		},
+		{
+			name:    "mapping to an invalid name fails",
+			mappers: []velero.NamespaceMapper{&prefixNamespaceMapper{prefix: strings.Repeat("a", 63)}},
+			wantErr: "namespace ns-1 is mapped to invalid namespace name",
+		},
+		{
+			name:     "mapping two namespaces to the same name fails",
+			mappers:  []velero.NamespaceMapper{&staticNamespaceMapper{namespace: "archived-1"}},
+			expected: []string{"archived-1"},
+			wantErr:  "namespaces ns-1 and ns-2 are both mapped to archived-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &Request{
+				Backup:           builder.ForBackup("velero", "backup-1").Result(),
+				NamespaceMappers: tc.mappers,
+			}
+
+			var got []string
+			var err error
+			for _, namespace := range []string{"ns-1", "ns-2"} {
+				var archived string
+				if archived, err = req.archivedNamespace(namespace); err != nil {
+					break
+				}
+				got = append(got, archived)
+			}
+
+			assert.Equal(t, tc.expected, got)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, req.Status.ArchivedNamespaces)
+			}
+		})
+	}
+}
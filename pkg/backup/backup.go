@@ -18,7 +18,6 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -26,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -41,10 +41,12 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -59,11 +61,15 @@ const BackupVersion = 1
 // BackupFormatVersion is the current backup version for Velero, including major, minor, and patch.
 const BackupFormatVersion = "1.1.0"
 
+// ErrCanceled is the cause of the error returned by Backup when the backup
+// stopped because its cancellation was requested.
+var ErrCanceled = errors.New("backup was canceled")
+
 // Backupper performs backups.
 type Backupper interface {
 	// Backup takes a backup using the specification in the velerov1api.Backup and writes backup and log data
 	// to the given writers.
-	Backup(logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
+	Backup(logger logrus.FieldLogger, backup *Request, backupFile io.Writer, actions []velero.BackupItemAction, itemBlockActions []velero.ItemBlockAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error
 }
 
 // kubernetesBackupper implements Backupper.
@@ -74,6 +80,16 @@ type kubernetesBackupper struct {
 	resticBackupperFactory restic.BackupperFactory
 	resticTimeout          time.Duration
 	defaultVolumesToRestic bool
+	volumeSnapshotWorkers  int
+	// metrics records per-item backup durations. It's nil if the metric
+	// is disabled.
+	metrics *metrics.ServerMetrics
+	// resourceDenylist lists the resources that no backup includes,
+	// whatever its included resources.
+	resourceDenylist []string
+	// defaultResourcePatterns match the default resources that backups can
+	// leave out. If nil, DefaultResourcePatterns are used.
+	defaultResourcePatterns []string
 }
 
 type resolvedAction struct {
@@ -84,6 +100,14 @@ type resolvedAction struct {
 	selector                  labels.Selector
 }
 
+type resolvedItemBlockAction struct {
+	velero.ItemBlockAction
+
+	resourceIncludesExcludes  *collections.IncludesExcludes
+	namespaceIncludesExcludes *collections.IncludesExcludes
+	selector                  labels.Selector
+}
+
 func (i *itemKey) String() string {
 	return fmt.Sprintf("resource=%s,namespace=%s,name=%s", i.resource, i.namespace, i.name)
 }
@@ -108,14 +132,22 @@ func NewKubernetesBackupper(
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
 	defaultVolumesToRestic bool,
+	volumeSnapshotWorkers int,
+	metrics *metrics.ServerMetrics,
+	resourceDenylist []string,
+	defaultResourcePatterns []string,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
-		backupClient:           backupClient,
-		client:                 client,
-		podCommandExecutor:     podCommandExecutor,
-		resticBackupperFactory: resticBackupperFactory,
-		resticTimeout:          resticTimeout,
-		defaultVolumesToRestic: defaultVolumesToRestic,
+		backupClient:            backupClient,
+		client:                  client,
+		podCommandExecutor:      podCommandExecutor,
+		resticBackupperFactory:  resticBackupperFactory,
+		resticTimeout:           resticTimeout,
+		defaultVolumesToRestic:  defaultVolumesToRestic,
+		volumeSnapshotWorkers:   volumeSnapshotWorkers,
+		metrics:                 metrics,
+		resourceDenylist:        resourceDenylist,
+		defaultResourcePatterns: defaultResourcePatterns,
 	}, nil
 }
 
@@ -128,14 +160,9 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 			return nil, err
 		}
 
-		resources := collections.GetResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
-		namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
-
-		selector := labels.Everything()
-		if resourceSelector.LabelSelector != "" {
-			if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
-				return nil, err
-			}
+		resources, namespaces, selector, err := resolveResourceSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
 		}
 
 		res := resolvedAction{
@@ -151,12 +178,98 @@ func resolveActions(actions []velero.BackupItemAction, helper discovery.Helper)
 	return resolved, nil
 }
 
+func resolveItemBlockActions(actions []velero.ItemBlockAction, helper discovery.Helper) ([]resolvedItemBlockAction, error) {
+	var resolved []resolvedItemBlockAction
+
+	for _, action := range actions {
+		resourceSelector, err := action.AppliesTo()
+		if err != nil {
+			return nil, err
+		}
+
+		resources, namespaces, selector, err := resolveResourceSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
+		}
+
+		res := resolvedItemBlockAction{
+			ItemBlockAction:           action,
+			resourceIncludesExcludes:  resources,
+			namespaceIncludesExcludes: namespaces,
+			selector:                  selector,
+		}
+
+		resolved = append(resolved, res)
+	}
+
+	return resolved, nil
+}
+
+// resolveResourceSelector returns the resources, namespaces and labels that a
+// plugin's resource selector matches.
+func resolveResourceSelector(resourceSelector velero.ResourceSelector, helper discovery.Helper) (*collections.IncludesExcludes, *collections.IncludesExcludes, labels.Selector, error) {
+	resources := collections.GetResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
+	namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
+
+	selector := labels.Everything()
+	if resourceSelector.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return resources, namespaces, selector, nil
+}
+
+// appliesTo returns whether the item block action should be invoked for an item
+// of the given resource, namespace and labels.
+func (a *resolvedItemBlockAction) appliesTo(groupResource schema.GroupResource, namespace string, itemLabels map[string]string) bool {
+	if !a.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+		return false
+	}
+
+	if namespace != "" && !a.namespaceIncludesExcludes.ShouldInclude(namespace) {
+		return false
+	}
+
+	if namespace == "" && !a.namespaceIncludesExcludes.IncludeEverything() {
+		return false
+	}
+
+	return a.selector.Matches(labels.Set(itemLabels))
+}
+
 // getNamespaceIncludesExcludes returns an IncludesExcludes list containing which namespaces to
 // include and exclude from the backup.
 func getNamespaceIncludesExcludes(backup *velerov1api.Backup) *collections.IncludesExcludes {
 	return collections.NewIncludesExcludes().Includes(backup.Spec.IncludedNamespaces...).Excludes(backup.Spec.ExcludedNamespaces...)
 }
 
+// getResourceLabelSelectors resolves the keys of a backup's resource label
+// selectors to group-resources and parses their selectors. A key that can't be
+// resolved is an error, since ignoring it would back up all of the resource's
+// items rather than only the selected ones.
+func getResourceLabelSelectors(discoveryHelper discovery.Helper, resourceSelectors map[string]string) (map[schema.GroupResource]labels.Selector, error) {
+	selectors := make(map[schema.GroupResource]labels.Selector, len(resourceSelectors))
+
+	for resource, selector := range resourceSelectors {
+		gvr, _, err := discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve resource %s of resource label selector", resource)
+		}
+
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid label selector for resource %s", resource)
+		}
+
+		selectors[gvr.GroupResource()] = parsed
+	}
+
+	return selectors, nil
+}
+
 func getResourceHooks(hookSpecs []velerov1api.BackupResourceHookSpec, discoveryHelper discovery.Helper) ([]hook.ResourceHook, error) {
 	resourceHooks := make([]hook.ResourceHook, 0, len(hookSpecs))
 
@@ -191,6 +304,14 @@ func getResourceHook(hookSpec velerov1api.BackupResourceHookSpec, discoveryHelpe
 		h.Selector.LabelSelector = labelSelector
 	}
 
+	if hookSpec.AnnotationSelector != nil {
+		annotationSelector, err := metav1.LabelSelectorAsSelector(hookSpec.AnnotationSelector)
+		if err != nil {
+			return hook.ResourceHook{}, errors.WithStack(err)
+		}
+		h.Selector.AnnotationSelector = annotationSelector
+	}
+
 	return h, nil
 }
 
@@ -198,12 +319,12 @@ type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 }
 
-// Backup backs up the items specified in the Backup, placing them in a gzip-compressed tar file
-// written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
+// Backup backs up the items specified in the Backup, placing them in a tar file compressed with
+// the backup's Status.CompressionAlgorithm and written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
 // to the backup log.
-func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
+func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemAction, itemBlockActions []velero.ItemBlockAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
 	// NOTE: This requires that the BackupStorageLocation must always be named exactly as the target cluster.
 	clusterName := backupRequest.StorageLocation.Name
 	clientSet, dynamicClient, err := kube.NewClusterClients(context.Background(), kb.client, kbclient.ObjectKey{
@@ -220,10 +341,13 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 
 	dynamicFactory := client.NewDynamicFactory(dynamicClient)
 
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressedData, err := archive.NewCompressionWriter(backupRequest.Status.CompressionAlgorithm, backupFile)
+	if err != nil {
+		return err
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
+	tw := tar.NewWriter(compressedData)
 	defer tw.Close()
 
 	log.Info("Writing backup version file")
@@ -235,9 +359,69 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	log.Infof("Including namespaces: %s", backupRequest.NamespaceIncludesExcludes.IncludesString())
 	log.Infof("Excluding namespaces: %s", backupRequest.NamespaceIncludesExcludes.ExcludesString())
 
-	backupRequest.ResourceIncludesExcludes = collections.GetResourceIncludesExcludes(discoveryHelper, backupRequest.Spec.IncludedResources, backupRequest.Spec.ExcludedResources)
+	var unresolvedResources []string
+	backupRequest.ResourceIncludesExcludes, unresolvedResources = collections.GetResourceIncludesExcludesWithUnresolved(discoveryHelper, backupRequest.Spec.IncludedResources, backupRequest.Spec.ExcludedResources)
+	for _, resource := range unresolvedResources {
+		log.Warnf("Unable to resolve included resource %s, it will be matched as-is", resource)
+	}
 	log.Infof("Including resources: %s", backupRequest.ResourceIncludesExcludes.IncludesString())
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
+
+	// the server's denylist is resolved against each backup's cluster, and
+	// takes precedence over the backup's own includes and re-includes.
+	if len(kb.resourceDenylist) > 0 {
+		denied := collections.GetResourceIncludesExcludes(discoveryHelper, nil, kb.resourceDenylist).GetExcludes()
+		backupRequest.ResourceIncludesExcludes.Denies(denied...)
+		log.Infof("Excluding resources denied by the server: %s", strings.Join(denied, ", "))
+	}
+
+	if filter := backupRequest.Spec.ItemFilter; filter != nil {
+		backupRequest.ItemIncludesExcludes = collections.NewIncludesExcludes().Includes(filter.IncludedItems...).Excludes(filter.ExcludedItems...)
+		log.Infof("Including items: %s", backupRequest.ItemIncludesExcludes.IncludesString())
+		log.Infof("Excluding items: %s", backupRequest.ItemIncludesExcludes.ExcludesString())
+	}
+
+	defaultResourcePatterns := kb.defaultResourcePatterns
+	if defaultResourcePatterns == nil {
+		defaultResourcePatterns = DefaultResourcePatterns
+	}
+	backupRequest.DefaultResources = getDefaultResources(&backupRequest.Spec, defaultResourcePatterns)
+	if backupRequest.DefaultResources != nil {
+		log.Infof("Excluding default resources: %s", backupRequest.DefaultResources.IncludesString())
+	}
+
+	backupRequest.CriticalResources = getCriticalResources(discoveryHelper, backupRequest.Spec.ItemFailureThresholds)
+	if backupRequest.CriticalResources != nil {
+		log.Infof("Critical resources: %s", backupRequest.CriticalResources.IncludesString())
+	}
+
+	backupRequest.ExcludedOwnerKinds = getExcludedOwnerKinds(backupRequest.Spec.ExcludedOwnerKinds)
+	if len(backupRequest.ExcludedOwnerKinds) > 0 {
+		log.Infof("Excluding items owned by: %s", strings.Join(backupRequest.Spec.ExcludedOwnerKinds, ", "))
+	}
+
+	backupRequest.ModifiedSince = getModifiedSince(backupRequest.Backup, time.Now())
+	if !backupRequest.ModifiedSince.IsZero() {
+		log.Infof("Excluding items not modified since %s", backupRequest.ModifiedSince.UTC().Format(time.RFC3339))
+	}
+
+	backupRequest.ResourceLabelSelectors, err = getResourceLabelSelectors(discoveryHelper, backupRequest.Spec.ResourceLabelSelectors)
+	if err != nil {
+		return err
+	}
+
+	backupRequest.ExcludedFields, err = getExcludedFields(discoveryHelper, backupRequest.Spec.ExcludedFields)
+	if err != nil {
+		return err
+	}
+
+	backupRequest.ResourceAPIVersions, err = getResourceAPIVersions(discoveryHelper, backupRequest.Spec.ResourceAPIVersions)
+	if err != nil {
+		return err
+	}
+	for groupResource, versions := range backupRequest.ResourceAPIVersions {
+		log.Infof("Backing up %s at API versions %s in addition to its preferred version", groupResource, strings.Join(versions, ", "))
+	}
 	log.Infof("Backing up all pod volumes using restic: %t", *backupRequest.Backup.Spec.DefaultVolumesToRestic)
 
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, discoveryHelper)
@@ -250,7 +434,13 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		return err
 	}
 
+	backupRequest.ResolvedItemBlockActions, err = resolveItemBlockActions(itemBlockActions, discoveryHelper)
+	if err != nil {
+		return err
+	}
+
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
+	backupRequest.indexBaseItems()
 
 	podVolumeTimeout := kb.resticTimeout
 	if val := backupRequest.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
@@ -281,6 +471,23 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	}
 	defer os.RemoveAll(tempDir)
 
+	// backup-level pre hooks are run before any items are collected, and if
+	// one of them fails the backup, no items are backed up. Every return
+	// after they've started must run the post hooks.
+	hookResults := new(hook.BackupHookResults)
+	backupLevelHookHandler := &hook.BackupLevelHookHandler{
+		PodCommandExecutor: kb.podCommandExecutor,
+		PodsGetter:         clientSet.CoreV1(),
+		ActionGetter:       backupRequest.BackupHookActions,
+		Results:            hookResults,
+	}
+	if err := backupLevelHookHandler.HandleHooks(log, backupRequest.Backup, hook.PhasePre); err != nil {
+		log.Error("A backup-level pre hook failed, no items will be backed up")
+		backupLevelHookHandler.HandleHooks(log, backupRequest.Backup, hook.PhasePost)
+		backupRequest.Status.HookStatus = hookResults.Status()
+		return err
+	}
+
 	collector := &itemCollector{
 		log:                   log,
 		backupRequest:         backupRequest,
@@ -309,9 +516,17 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		itemHookHandler: &hook.DefaultItemHookHandler{
 			PodCommandExecutor: kb.podCommandExecutor,
+			Results:            hookResults,
 		},
 	}
 
+	// with a single worker, volume snapshots are created serially as each
+	// persistent volume is backed up.
+	if kb.volumeSnapshotWorkers > 1 {
+		log.Infof("Creating volume snapshots with %d concurrent workers", kb.volumeSnapshotWorkers)
+		itemBackupper.volumeSnapshotPool = newVolumeSnapshotPool(kb.volumeSnapshotWorkers)
+	}
+
 	// helper struct to send current progress between the main
 	// backup loop and the gouroutine that periodically patches
 	// the backup CR with progress updates
@@ -360,59 +575,93 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 
 	backedUpGroupResources := map[schema.GroupResource]bool{}
 	totalItems := len(items)
+	itemsProcessed := 0
 
+	itemIndexes := make(map[itemKey]int, len(items))
 	for i, item := range items {
-		log.WithFields(map[string]interface{}{
-			"progress":  "",
-			"resource":  item.groupResource.String(),
-			"namespace": item.namespace,
-			"name":      item.name,
-		}).Infof("Processing item")
-
-		// use an anonymous func so we can defer-close/remove the file
-		// as soon as we're done with it
-		func() {
-			var unstructured unstructured.Unstructured
-
-			f, err := os.Open(item.path)
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error opening file containing item")
-				return
-			}
-			defer f.Close()
-			defer os.Remove(f.Name())
+		itemIndexes[itemKey{resource: item.groupResource.String(), namespace: item.namespace, name: item.name}] = i
+	}
+	inBlock := make([]bool, len(items))
 
-			if err := json.NewDecoder(f).Decode(&unstructured); err != nil {
-				log.WithError(errors.WithStack(err)).Error("Error decoding JSON from file")
-				return
-			}
+	var canceled bool
+	for i := range items {
+		if inBlock[i] {
+			continue
+		}
 
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
-				backedUpGroupResources[item.groupResource] = true
+		if backupRequest.Canceled != nil && backupRequest.Canceled() {
+			log.Warnf("Backup was canceled after processing %d items out of an estimated total of %d", itemsProcessed, totalItems)
+			canceled = true
+			break
+		}
+
+		// items returned by item block actions are backed up together with the
+		// item that referenced them, before moving on to the next collected item.
+		block := kb.getItemBlock(log, backupRequest, items, i, itemIndexes, inBlock)
+		snapshotMark := itemBackupper.volumeSnapshotMark()
+		for _, blockItem := range block {
+			item := items[blockItem.index]
+
+			log.WithFields(map[string]interface{}{
+				"progress":  "",
+				"resource":  item.groupResource.String(),
+				"namespace": item.namespace,
+				"name":      item.name,
+			}).Infof("Processing item")
+
+			if blockItem.obj != nil {
+				start := time.Now()
+				if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, blockItem.obj, item.preferredGVR); backedUp {
+					backedUpGroupResources[item.groupResource] = true
+				}
+				if kb.metrics != nil {
+					kb.metrics.RegisterBackupItemDuration(item.groupResource.String(), time.Since(start).Seconds())
+				}
 			}
-		}()
 
-		// updated total is computed as "how many items we've backed up so far, plus
-		// how many items we know of that are remaining"
-		totalItems = len(backupRequest.BackedUpItems) + (len(items) - (i + 1))
+			itemsProcessed++
+
+			// updated total is computed as "how many items we've backed up so far, plus
+			// how many items we know of that are remaining"
+			totalItems = len(backupRequest.BackedUpItems) + (len(items) - itemsProcessed)
 
-		// send a progress update
-		update <- progressUpdate{
-			totalItems:    totalItems,
-			itemsBackedUp: len(backupRequest.BackedUpItems),
+			// send a progress update
+			update <- progressUpdate{
+				totalItems:    totalItems,
+				itemsBackedUp: len(backupRequest.BackedUpItems),
+			}
+
+			log.WithFields(map[string]interface{}{
+				"progress":  "",
+				"resource":  item.groupResource.String(),
+				"namespace": item.namespace,
+				"name":      item.name,
+			}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
 		}
 
-		log.WithFields(map[string]interface{}{
-			"progress":  "",
-			"resource":  item.groupResource.String(),
-			"namespace": item.namespace,
-			"name":      item.name,
-		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
+		// an item block is only done once its volumes' snapshots have been
+		// taken, as they would be if snapshots weren't created concurrently.
+		if len(block) > 1 {
+			itemBackupper.waitForVolumeSnapshots(log, snapshotMark)
+		}
 	}
 
 	// no more progress updates will be sent on the 'update' channel
 	quit <- struct{}{}
 
+	// wait for any concurrently created volume snapshots, recording them in the
+	// order they were submitted so the backup's snapshot list is deterministic.
+	if itemBackupper.volumeSnapshotPool != nil {
+		log.Info("Waiting for all volume snapshots to complete")
+		for _, task := range itemBackupper.volumeSnapshotPool.wait() {
+			backupRequest.VolumeSnapshots = append(backupRequest.VolumeSnapshots, task.snapshot)
+			if task.err != nil {
+				task.log.WithError(task.err).Error("Error taking snapshot of persistent volume")
+			}
+		}
+		log.Info("Done waiting for all volume snapshots to complete")
+	}
+
 	// back up CRD for resource if found. We should only need to do this if we've backed up at least
 	// one item for the resource and IncludeClusterResources is nil. If IncludeClusterResources is false
 	// we don't want to back it up, and if it's true it will already be included.
@@ -422,6 +671,10 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		}
 	}
 
+	// backup-level post hooks are run even if the backup was canceled.
+	postHookErr := backupLevelHookHandler.HandleHooks(log, backupRequest.Backup, hook.PhasePost)
+	backupRequest.Status.HookStatus = hookResults.Status()
+
 	// do a final update on progress since we may have just added some CRDs and may not have updated
 	// for the last few processed items.
 	backupRequest.Status.Progress.TotalItems = len(backupRequest.BackedUpItems)
@@ -434,7 +687,90 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 
 	log.WithField("progress", "").Infof("Backed up a total of %d items", len(backupRequest.BackedUpItems))
 
-	return nil
+	if canceled {
+		return errors.WithStack(ErrCanceled)
+	}
+	return postHookErr
+}
+
+// itemBlockEntry is a collected item that is part of an item block, along with
+// its decoded contents. obj is nil if the item could not be read.
+type itemBlockEntry struct {
+	index int
+	obj   *unstructured.Unstructured
+}
+
+// getItemBlock returns the collected item at index start, followed by all collected items
+// that the resolved item block actions report as related to it (directly or transitively).
+// Related items that were not collected for the backup are ignored. Every returned item is
+// marked in inBlock so it won't be processed again.
+func (kb *kubernetesBackupper) getItemBlock(log logrus.FieldLogger, backupRequest *Request, items []*kubernetesResource, start int, itemIndexes map[itemKey]int, inBlock []bool) []itemBlockEntry {
+	inBlock[start] = true
+	block := []itemBlockEntry{{index: start}}
+
+	for i := 0; i < len(block); i++ {
+		item := items[block[i].index]
+
+		obj, err := readItem(item)
+		if err != nil {
+			log.WithError(err).WithFields(map[string]interface{}{
+				"resource":  item.groupResource.String(),
+				"namespace": item.namespace,
+				"name":      item.name,
+			}).Error("Error reading item")
+			continue
+		}
+		block[i].obj = obj
+
+		for _, action := range backupRequest.ResolvedItemBlockActions {
+			if !action.appliesTo(item.groupResource, item.namespace, obj.GetLabels()) {
+				continue
+			}
+
+			relatedItems, err := action.GetRelatedItems(obj, backupRequest.Backup)
+			if err != nil {
+				log.WithError(errors.WithStack(err)).WithFields(map[string]interface{}{
+					"resource":  item.groupResource.String(),
+					"namespace": item.namespace,
+					"name":      item.name,
+				}).Error("Error getting related items from item block action")
+				continue
+			}
+
+			for _, related := range relatedItems {
+				index, ok := itemIndexes[itemKey{resource: related.GroupResource.String(), namespace: related.Namespace, name: related.Name}]
+				if !ok {
+					log.Debugf("Skipping related item %s %s/%s because it was not collected for the backup", related.GroupResource.String(), related.Namespace, related.Name)
+					continue
+				}
+				if inBlock[index] {
+					continue
+				}
+
+				inBlock[index] = true
+				block = append(block, itemBlockEntry{index: index})
+			}
+		}
+	}
+
+	return block
+}
+
+// readItem decodes the collected item from its temp file and removes the file.
+func readItem(item *kubernetesResource) (*unstructured.Unstructured, error) {
+	f, err := os.Open(item.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error opening file containing item")
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	var obj unstructured.Unstructured
+	if err := json.NewDecoder(f).Decode(&obj); err != nil {
+		return nil, errors.Wrap(err, "error decoding JSON from file")
+	}
+
+	return &obj, nil
 }
 
 func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
@@ -446,11 +782,13 @@ func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.Grou
 		for _, err = range aggregate.Errors() {
 			log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
 		}
+		itemBackupper.backupRequest.ItemErrors += len(aggregate.Errors())
 
 		return false
 	}
 	if err != nil {
 		log.WithError(err).WithField("name", unstructured.GetName()).Error("Error backing up item")
+		itemBackupper.backupRequest.ItemErrors++
 		return false
 	}
 	return backedUpItem
@@ -0,0 +1,172 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+const (
+	// requiredLabelsKey is the policy config map key listing the labels that
+	// every backup must have.
+	requiredLabelsKey = "requiredLabels"
+
+	// restrictedNamespacesKey is the policy config map key listing the
+	// namespaces that can't be backed up during the restricted hours.
+	restrictedNamespacesKey = "restrictedNamespaces"
+
+	// restrictedHoursKey is the policy config map key holding the UTC time
+	// range, as HH:MM-HH:MM, during which the restricted namespaces can't be
+	// backed up. If it isn't set, they can't be backed up at any time.
+	restrictedHoursKey = "restrictedHours"
+)
+
+// BackupPolicyValidator rejects backups that don't meet the policy in the
+// plugin's config map.
+type BackupPolicyValidator struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+	clock           clock.Clock
+}
+
+// NewBackupPolicyValidator is the constructor for BackupPolicyValidator.
+func NewBackupPolicyValidator(logger logrus.FieldLogger, configMapClient corev1client.ConfigMapInterface) *BackupPolicyValidator {
+	return &BackupPolicyValidator{
+		logger:          logger,
+		configMapClient: configMapClient,
+		clock:           clock.RealClock{},
+	}
+}
+
+// Validate rejects the backup if it's missing any of the policy's required
+// labels, or if it includes any of the policy's restricted namespaces during
+// the restricted hours.
+func (v *BackupPolicyValidator) Validate(backup *velerov1api.Backup) (velero.BackupValidationResult, error) {
+	v.logger.Info("Executing BackupPolicyValidator")
+	defer v.logger.Info("Done executing BackupPolicyValidator")
+
+	config, err := framework.GetPluginConfig(framework.PluginKindBackupValidator, "velero.io/backup-policy", v.configMapClient)
+	if err != nil {
+		return velero.BackupValidationResult{}, err
+	}
+
+	if config == nil || len(config.Data) == 0 {
+		v.logger.Debug("No backup policy found")
+		return velero.BackupValidationResult{Accepted: true}, nil
+	}
+
+	var violations []string
+
+	var missing []string
+	for _, label := range splitPolicyList(config.Data[requiredLabelsKey]) {
+		if _, ok := backup.Labels[label]; !ok {
+			missing = append(missing, label)
+		}
+	}
+	if len(missing) > 0 {
+		violations = append(violations, fmt.Sprintf("backup is missing required labels: %s", strings.Join(missing, ", ")))
+	}
+
+	if restricted := restrictedNamespacesIncluded(backup, splitPolicyList(config.Data[restrictedNamespacesKey])); len(restricted) > 0 {
+		hours := strings.TrimSpace(config.Data[restrictedHoursKey])
+		if hours == "" {
+			violations = append(violations, fmt.Sprintf("backups of namespaces %s aren't allowed", strings.Join(restricted, ", ")))
+		} else {
+			start, end, err := parseRestrictedHours(hours)
+			if err != nil {
+				return velero.BackupValidationResult{}, errors.Wrapf(err, "invalid %s in backup policy", restrictedHoursKey)
+			}
+			if inRestrictedHours(v.clock.Now().UTC(), start, end) {
+				violations = append(violations, fmt.Sprintf("backups of namespaces %s aren't allowed during %s UTC", strings.Join(restricted, ", "), hours))
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return velero.BackupValidationResult{Message: strings.Join(violations, "; ")}, nil
+	}
+	return velero.BackupValidationResult{Accepted: true}, nil
+}
+
+// splitPolicyList splits a comma-separated policy value, dropping empty items.
+func splitPolicyList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// restrictedNamespacesIncluded returns the restricted namespaces that the
+// backup's included and excluded namespaces select.
+func restrictedNamespacesIncluded(backup *velerov1api.Backup, restricted []string) []string {
+	namespaces := collections.NewIncludesExcludes().
+		Includes(backup.Spec.IncludedNamespaces...).
+		Excludes(backup.Spec.ExcludedNamespaces...)
+
+	var included []string
+	for _, namespace := range restricted {
+		if namespaces.ShouldInclude(namespace) {
+			included = append(included, namespace)
+		}
+	}
+	return included
+}
+
+// parseRestrictedHours parses a HH:MM-HH:MM time range into the minutes after
+// midnight of its start and end.
+func parseRestrictedHours(hours string) (int, int, error) {
+	parts := strings.Split(hours, "-")
+	if len(parts) != 2 {
+		return 0, 0, errors.Errorf("%q must be a time range like 09:00-17:00", hours)
+	}
+
+	var minutes [2]int
+	for i, part := range parts {
+		t, err := time.Parse("15:04", strings.TrimSpace(part))
+		if err != nil {
+			return 0, 0, errors.Errorf("%q must be a time range like 09:00-17:00", hours)
+		}
+		minutes[i] = t.Hour()*60 + t.Minute()
+	}
+	return minutes[0], minutes[1], nil
+}
+
+// inRestrictedHours returns whether now is within the range from start to end,
+// in minutes after midnight. A range whose end is before its start spans
+// midnight.
+func inRestrictedHours(now time.Time, start, end int) bool {
+	minute := now.Hour()*60 + now.Minute()
+	if start <= end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestBackupPolicyValidatorValidate(t *testing.T) {
+	policy := func(data ...string) *corev1api.ConfigMap {
+		return builder.ForConfigMap("velero", "backup-policy").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", "velero.io/backup-policy", "BackupValidator")).
+			Data(data...).
+			Result()
+	}
+
+	// 10:30 UTC
+	now := time.Date(2021, 3, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		configMap *corev1api.ConfigMap
+		backup    *velerov1api.Backup
+		want      velero.BackupValidationResult
+		wantErr   string
+	}{
+		{
+			name:   "backup is accepted when there's no policy",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			want:   velero.BackupValidationResult{Accepted: true},
+		},
+		{
+			name:      "backup with the required labels is accepted",
+			configMap: policy("requiredLabels", "team, env"),
+			backup:    builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels("team", "a", "env", "prod")).Result(),
+			want:      velero.BackupValidationResult{Accepted: true},
+		},
+		{
+			name:      "backup missing required labels is rejected",
+			configMap: policy("requiredLabels", "team,env,owner"),
+			backup:    builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels("env", "prod")).Result(),
+			want:      velero.BackupValidationResult{Message: "backup is missing required labels: team, owner"},
+		},
+		{
+			name:      "backup of all namespaces is rejected when namespaces are always restricted",
+			configMap: policy("restrictedNamespaces", "prod-db,payments"),
+			backup:    builder.ForBackup("velero", "backup-1").Result(),
+			want:      velero.BackupValidationResult{Message: "backups of namespaces prod-db, payments aren't allowed"},
+		},
+		{
+			name:      "backup that excludes the restricted namespaces is accepted",
+			configMap: policy("restrictedNamespaces", "prod-db,payments"),
+			backup:    builder.ForBackup("velero", "backup-1").ExcludedNamespaces("prod-db", "payments").Result(),
+			want:      velero.BackupValidationResult{Accepted: true},
+		},
+		{
+			name:      "backup including a restricted namespace during the restricted hours is rejected",
+			configMap: policy("restrictedNamespaces", "prod-db,payments", "restrictedHours", "09:00-17:00"),
+			backup:    builder.ForBackup("velero", "backup-1").IncludedNamespaces("payments", "web").Result(),
+			want:      velero.BackupValidationResult{Message: "backups of namespaces payments aren't allowed during 09:00-17:00 UTC"},
+		},
+		{
+			name:      "backup including a restricted namespace outside the restricted hours is accepted",
+			configMap: policy("restrictedNamespaces", "prod-db", "restrictedHours", "11:00-17:00"),
+			backup:    builder.ForBackup("velero", "backup-1").IncludedNamespaces("prod-db").Result(),
+			want:      velero.BackupValidationResult{Accepted: true},
+		},
+		{
+			name:      "restricted hours can span midnight",
+			configMap: policy("restrictedNamespaces", "prod-db", "restrictedHours", "22:00-11:00"),
+			backup:    builder.ForBackup("velero", "backup-1").IncludedNamespaces("prod-db").Result(),
+			want:      velero.BackupValidationResult{Message: "backups of namespaces prod-db aren't allowed during 22:00-11:00 UTC"},
+		},
+		{
+			name:      "every violation is reported",
+			configMap: policy("requiredLabels", "team", "restrictedNamespaces", "prod-db"),
+			backup:    builder.ForBackup("velero", "backup-1").Result(),
+			want:      velero.BackupValidationResult{Message: "backup is missing required labels: team; backups of namespaces prod-db aren't allowed"},
+		},
+		{
+			name:      "invalid restricted hours are an error",
+			configMap: policy("restrictedNamespaces", "prod-db", "restrictedHours", "9am-5pm"),
+			backup:    builder.ForBackup("velero", "backup-1").Result(),
+			wantErr:   `invalid restrictedHours in backup policy: "9am-5pm" must be a time range like 09:00-17:00`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			v := NewBackupPolicyValidator(velerotest.NewLogger(), clientset.CoreV1().ConfigMaps("velero"))
+			v.clock = clock.NewFakeClock(now)
+
+			res, err := v.Validate(tc.backup)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
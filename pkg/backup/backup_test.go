@@ -27,6 +27,7 @@ import (
 	"io/ioutil"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -45,6 +46,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/itemindex"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -85,7 +88,7 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 	// go through BackedUpItems after the backup to assemble the list of files we
 	// expect to see in the tarball and compare to see if they match
@@ -141,13 +144,274 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 		h.addItems(t, resource)
 	}
 
-	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+	h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 	require.NotNil(t, req.Status.Progress)
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.TotalItems)
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.ItemsBackedUp)
 }
 
+// TestBackupIsCanceled verifies that a backup stops backing up items once
+// its cancellation is requested, and fails.
+func TestBackupIsCanceled(t *testing.T) {
+	h := newHarness(t)
+
+	var checks int
+	req := &Request{
+		Backup: defaultBackup().Result(),
+		// cancel the backup after its first item.
+		Canceled: func() bool {
+			checks++
+			return checks > 1
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	err := h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+	assert.Equal(t, ErrCanceled, errors.Cause(err))
+	assert.Len(t, req.BackedUpItems, 1)
+}
+
+type backupHookActionFunc func(*velerov1.Backup, velerov1.BackupHookPhase) error
+
+func (f backupHookActionFunc) Execute(backup *velerov1.Backup, phase velerov1.BackupHookPhase) error {
+	return f(backup, phase)
+}
+
+type backupHookActionGetter map[string]velero.BackupHookAction
+
+func (g backupHookActionGetter) GetBackupHookAction(name string) (velero.BackupHookAction, error) {
+	return g[name], nil
+}
+
+// TestBackupLevelHooks verifies that a backup's backup-level pre hooks are
+// run before any of its items are backed up and its post hooks after all of
+// them, and that a failed pre hook fails the backup without backing up any
+// items but still runs the post hooks.
+func TestBackupLevelHooks(t *testing.T) {
+	tests := []struct {
+		name           string
+		preErr         error
+		wantErr        string
+		wantCalls      []string
+		wantBackedUp   int
+		wantHookFailed int
+	}{
+		{
+			name:         "hooks run around the backup's items",
+			wantCalls:    []string{"pre: 0 items", "post: 2 items"},
+			wantBackedUp: 2,
+		},
+		{
+			name:           "failed pre hook fails the backup without backing up items",
+			preErr:         errors.New("maintenance mode unavailable"),
+			wantErr:        "error executing pre hook of backup-level hook maintenance: maintenance mode unavailable",
+			wantCalls:      []string{"pre: 0 items", "post: 0 items"},
+			wantHookFailed: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.addItems(t, test.Pods(
+				builder.ForPod("foo", "bar").Result(),
+				builder.ForPod("zoo", "raz").Result(),
+			))
+
+			req := &Request{Backup: defaultBackup().Result()}
+			req.Spec.Hooks.Backup = []velerov1.BackupLevelHookSpec{
+				{
+					Name:      "maintenance",
+					PreHooks:  []velerov1.BackupLevelHook{{Plugin: &velerov1.BackupPluginHook{Name: "example.io/maintenance"}}},
+					PostHooks: []velerov1.BackupLevelHook{{Plugin: &velerov1.BackupPluginHook{Name: "example.io/maintenance"}}},
+				},
+			}
+
+			var calls []string
+			req.BackupHookActions = backupHookActionGetter{
+				"example.io/maintenance": backupHookActionFunc(func(_ *velerov1.Backup, phase velerov1.BackupHookPhase) error {
+					calls = append(calls, fmt.Sprintf("%s: %d items", phase, len(req.BackedUpItems)))
+					if phase == velerov1.BackupHookPhasePre {
+						return tc.preErr
+					}
+					return nil
+				}),
+			}
+
+			err := h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), nil, nil, nil)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.wantCalls, calls)
+			assert.Len(t, req.BackedUpItems, tc.wantBackedUp)
+			require.NotNil(t, req.Status.HookStatus)
+			assert.Equal(t, 2, req.Status.HookStatus.HooksAttempted)
+			assert.Equal(t, tc.wantHookFailed, req.Status.HookStatus.HooksFailed)
+		})
+	}
+}
+
+// TestBackupFilteredItemsAreCounted verifies that the items a backup's
+// filters leave out are counted in its status.filteredItems, and that
+// backups that don't leave any out don't have it.
+func TestBackupFilteredItemsAreCounted(t *testing.T) {
+	h := newHarness(t)
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("foo", "owned").ObjectMeta(builder.WithOwnerReference("apps/v1", "ReplicaSet", "rs-1")).Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+	h.addItems(t, test.Deployments(
+		builder.ForDeployment("zoo", "raz").Result(),
+	))
+
+	req := &Request{Backup: defaultBackup().Result()}
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), nil, nil, nil))
+	assert.Nil(t, req.Status.FilteredItems)
+
+	// items are listed across all namespaces, so the ones in the excluded
+	// namespace are evaluated and left out.
+	req = &Request{Backup: defaultBackup().ExcludedNamespaces("zoo").Result()}
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), nil, nil, nil))
+	assert.Equal(t, &velerov1.BackupFilteredItems{Namespaces: 2}, req.Status.FilteredItems)
+	assert.Len(t, req.BackedUpItems, 2)
+
+	req = &Request{Backup: defaultBackup().ExcludedOwnerKinds("ReplicaSet.apps").Result()}
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), nil, nil, nil))
+	assert.Equal(t, &velerov1.BackupFilteredItems{OwnerKind: 1}, req.Status.FilteredItems)
+}
+
+func TestBackupResourceDenylist(t *testing.T) {
+	h := newHarness(t)
+	h.backupper.resourceDenylist = []string{"deployments"}
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+	))
+	h.addItems(t, test.Deployments(
+		builder.ForDeployment("foo", "bar").Result(),
+	))
+
+	// the denylist is resolved like the backup's own filters, and wins
+	// over the backup's includes and re-includes.
+	req := &Request{Backup: defaultBackup().IncludedResources("pods", "deployments.apps").ExcludedResources("!deployments.apps").Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	assert.Equal(t, []string{"deployments.apps"}, req.ResourceIncludesExcludes.GetDenied())
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/foo/bar.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+	)
+}
+
+func TestBackupFailedItemsAreRecorded(t *testing.T) {
+	h := newHarness(t)
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	actions := []velero.BackupItemAction{
+		&pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+				if item.(*unstructured.Unstructured).GetName() == "bar" {
+					return nil, nil, errors.New("action failed")
+				}
+				return item, nil, nil
+			},
+		},
+	}
+
+	req := &Request{Backup: defaultBackup().Result()}
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), actions, nil, nil))
+	assert.Equal(t, &itemstatus.Manifest{
+		Items: []itemstatus.Item{
+			{
+				Resource:  "pods",
+				Namespace: "foo",
+				Name:      "bar",
+				Errors:    []string{"error executing custom action (groupResource=pods, namespace=foo, name=bar): action failed"},
+			},
+		},
+	}, req.ItemStatusManifest())
+}
+
+func TestIncrementalBackupReusesUnchangedItems(t *testing.T) {
+	h := newHarness(t)
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").ObjectMeta(builder.WithResourceVersion("1")).Result(),
+		builder.ForPod("zoo", "raz").ObjectMeta(builder.WithResourceVersion("3")).Result(),
+	))
+
+	req := &Request{
+		Backup: defaultBackup().Result(),
+		BaseItemIndex: &itemindex.Index{
+			Items: []itemindex.Item{
+				{
+					Resource:        "v1/Pod",
+					Namespace:       "foo",
+					Name:            "bar",
+					ResourceVersion: "1",
+					Backup:          "backup-0",
+					Files:           []string{"resources/pods/v1-preferredversion/namespaces/foo/bar.json", "resources/pods/namespaces/foo/bar.json"},
+				},
+				{
+					Resource:        "v1/Pod",
+					Namespace:       "zoo",
+					Name:            "raz",
+					ResourceVersion: "2",
+					Backup:          "backup-0",
+					Files:           []string{"resources/pods/v1-preferredversion/namespaces/zoo/raz.json", "resources/pods/namespaces/zoo/raz.json"},
+				},
+			},
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+	// only the pod whose resource version changed is written to the tarball.
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/zoo/raz.json",
+		"resources/pods/v1-preferredversion/namespaces/zoo/raz.json",
+	)
+
+	index := req.ItemIndex()
+	sort.Slice(index.Items, func(i, j int) bool { return index.Items[i].Name < index.Items[j].Name })
+	assert.Equal(t, &itemindex.Index{
+		Items: []itemindex.Item{
+			{
+				Resource:        "v1/Pod",
+				Namespace:       "foo",
+				Name:            "bar",
+				ResourceVersion: "1",
+				Backup:          "backup-0",
+				Files:           []string{"resources/pods/v1-preferredversion/namespaces/foo/bar.json", "resources/pods/namespaces/foo/bar.json"},
+			},
+			{
+				Resource:        "v1/Pod",
+				Namespace:       "zoo",
+				Name:            "raz",
+				ResourceVersion: "3",
+				Backup:          req.Name,
+				Files:           []string{"resources/pods/v1-preferredversion/namespaces/zoo/raz.json", "resources/pods/namespaces/zoo/raz.json"},
+			},
+		},
+	}, index)
+}
+
 // TestBackupResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -384,6 +648,110 @@ func TestBackupResourceFiltering(t *testing.T) {
 				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
 			},
 		},
+		{
+			name: "resource label selector only filters items of that resource",
+			backup: defaultBackup().
+				ResourceLabelSelectors(map[string]string{"pods": "backup=true"}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+					builder.ForPod("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/namespaces/zoo/raz.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
+			},
+		},
+		{
+			name: "resource label selector is ANDed with the backup's label selector",
+			backup: defaultBackup().
+				LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).
+				ResourceLabelSelectors(map[string]string{"pods": "backup=true"}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithLabels("a", "b", "backup", "true")).Result(),
+					builder.ForPod("foo", "baz").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForPod("zoo", "raz").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
+		{
+			name: "resource label selector for namespaces filters included namespaces",
+			backup: defaultBackup().
+				IncludedNamespaces("ns-1", "ns-2").
+				ResourceLabelSelectors(map[string]string{"namespaces": "backup=true"}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Namespaces(
+					builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+					builder.ForNamespace("ns-2").Result(),
+				),
+			},
+			want: []string{
+				"resources/namespaces/cluster/ns-1.json",
+				"resources/namespaces/v1-preferredversion/cluster/ns-1.json",
+			},
+		},
+		{
+			name: "resources with the backup's excluded annotation are not included",
+			backup: defaultBackup().
+				ExcludedAnnotation(&velerov1.AnnotationMatch{Key: "backup.velero.io/exclude", Value: "true"}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithAnnotations("backup.velero.io/exclude", "true")).Result(),
+					builder.ForPod("zoo", "raz").ObjectMeta(builder.WithAnnotations("backup.velero.io/exclude", "false")).Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("bar").Result(),
+					builder.ForPersistentVolume("baz").ObjectMeta(builder.WithAnnotations("backup.velero.io/exclude", "true")).Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/zoo/raz.json",
+				"resources/persistentvolumes/cluster/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/zoo/raz.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
+			},
+		},
+		{
+			name: "resources with the backup's excluded annotation key are not included when no value is specified",
+			backup: defaultBackup().
+				ExcludedAnnotation(&velerov1.AnnotationMatch{Key: "backup.velero.io/exclude"}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(builder.WithAnnotations("backup.velero.io/exclude", "true")).Result(),
+					builder.ForPod("zoo", "raz").ObjectMeta(builder.WithAnnotations("backup.velero.io/exclude", "false")).Result(),
+					builder.ForPod("zoo", "baz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/zoo/baz.json",
+				"resources/pods/v1-preferredversion/namespaces/zoo/baz.json",
+			},
+		},
 		{
 			name: "resources with velero.io/exclude-from-backup label specified but not 'true' are included",
 			backup: defaultBackup().
@@ -728,6 +1096,168 @@ func TestBackupResourceFiltering(t *testing.T) {
 				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
 			},
 		},
+		{
+			name: "item filter excludes items by resource and namespace together",
+			backup: defaultBackup().
+				ItemFilter(&velerov1.ItemFilter{ExcludedItems: []string{"core/pods:zoo/*", "apps/deployments:foo/*"}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+					builder.ForPod("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/namespaces/zoo/raz.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
+			},
+		},
+		{
+			name: "item filter includes only matching cluster-scoped items",
+			backup: defaultBackup().
+				ItemFilter(&velerov1.ItemFilter{IncludedItems: []string{"core/persistentvolumes:/bar", "core/pods:*"}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("bar").Result(),
+					builder.ForPersistentVolume("baz").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/persistentvolumes/cluster/bar.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
+			},
+		},
+		{
+			name: "default resources are left out unless the item filter includes them explicitly",
+			backup: defaultBackup().
+				ExcludeDefaultResources(true).
+				ItemFilter(&velerov1.ItemFilter{IncludedItems: []string{"*", "core/serviceaccounts:foo/*"}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(
+					builder.ForServiceAccount("foo", "default").Result(),
+					builder.ForServiceAccount("zoo", "default").Result(),
+					builder.ForServiceAccount("zoo", "app").Result(),
+				),
+				test.Secrets(
+					builder.ForSecret("zoo", "default-token-abcde").Result(),
+					builder.ForSecret("zoo", "app-token-abcde").Result(),
+				),
+			},
+			want: []string{
+				"resources/serviceaccounts/namespaces/foo/default.json",
+				"resources/serviceaccounts/v1-preferredversion/namespaces/foo/default.json",
+				"resources/serviceaccounts/namespaces/zoo/app.json",
+				"resources/serviceaccounts/v1-preferredversion/namespaces/zoo/app.json",
+				"resources/secrets/namespaces/zoo/app-token-abcde.json",
+				"resources/secrets/v1-preferredversion/namespaces/zoo/app-token-abcde.json",
+			},
+		},
+		{
+			name: "items not modified in the modifiedSince window are left out unless their modification time is unknown",
+			backup: defaultBackup().
+				ModifiedSince(&metav1.Duration{Duration: 24 * time.Hour}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Secrets(
+					withManagedFieldsTime(builder.ForSecret("foo", "old").Result(), time.Now().Add(-48*time.Hour)),
+					withManagedFieldsTime(builder.ForSecret("foo", "new").Result(), time.Now().Add(-time.Hour)),
+					builder.ForSecret("foo", "unknown").Result(),
+				),
+			},
+			want: []string{
+				"resources/secrets/namespaces/foo/new.json",
+				"resources/secrets/v1-preferredversion/namespaces/foo/new.json",
+				"resources/secrets/namespaces/foo/unknown.json",
+				"resources/secrets/v1-preferredversion/namespaces/foo/unknown.json",
+			},
+		},
+		{
+			name: "excluded owner kinds leave out owned items and keep standalone ones",
+			backup: defaultBackup().
+				ExcludedOwnerKinds("ReplicaSet.apps", "Deployment.apps").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar-1234").ObjectMeta(builder.WithOwnerReference("apps/v1", "ReplicaSet", "bar-12")).Result(),
+					builder.ForPod("foo", "standalone").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/standalone.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/standalone.json",
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
+		{
+			name: "excluded owner kinds only match owners of the same group, case-insensitively",
+			backup: defaultBackup().
+				ExcludedOwnerKinds("job.batch").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "job-pod").ObjectMeta(builder.WithOwnerReference("batch/v1", "Job", "job")).Result(),
+					builder.ForPod("foo", "custom-job-pod").ObjectMeta(builder.WithOwnerReference("example.com/v1", "Job", "job")).Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/custom-job-pod.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/custom-job-pod.json",
+			},
+		},
+		{
+			name: "excluded owner kinds keep owned pods with restic volumes",
+			backup: defaultBackup().
+				ExcludedOwnerKinds("ReplicaSet.apps").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").ObjectMeta(
+						builder.WithOwnerReference("apps/v1", "ReplicaSet", "bar"),
+						builder.WithAnnotations("backup.velero.io/backup-volumes", "data"),
+					).Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
+		{
+			name: "excluded owner kinds don't re-include excluded resources",
+			backup: defaultBackup().
+				ExcludedResources("pods").
+				ExcludedOwnerKinds("ReplicaSet.apps").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "standalone").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+				),
+			},
+			want: []string{
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -742,7 +1272,7 @@ func TestBackupResourceFiltering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -848,60 +1378,273 @@ func TestCRDInclusion(t *testing.T) {
 				),
 			},
 			want: []string{
-				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/volumesnapshotlocations.velero.io.json",
-				"resources/volumesnapshotlocations.velero.io/namespaces/foo/vsl-1.json",
-				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/volumesnapshotlocations.velero.io.json",
-				"resources/volumesnapshotlocations.velero.io/v1-preferredversion/namespaces/foo/vsl-1.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/volumesnapshotlocations.velero.io.json",
+				"resources/volumesnapshotlocations.velero.io/namespaces/foo/vsl-1.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/volumesnapshotlocations.velero.io.json",
+				"resources/volumesnapshotlocations.velero.io/v1-preferredversion/namespaces/foo/vsl-1.json",
+			},
+		},
+		{
+			name: "include cluster resources=false excludes all CRDs when backing up selected namespaces",
+			backup: defaultBackup().
+				IncludeClusterResources(false).
+				IncludedNamespaces("foo").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.CRDs(
+					builder.ForCustomResourceDefinition("backups.velero.io").Result(),
+					builder.ForCustomResourceDefinition("volumesnapshotlocations.velero.io").Result(),
+					builder.ForCustomResourceDefinition("test.velero.io").Result(),
+				),
+				test.VSLs(
+					builder.ForVolumeSnapshotLocation("foo", "vsl-1").Result(),
+				),
+			},
+			want: []string{
+				"resources/volumesnapshotlocations.velero.io/namespaces/foo/vsl-1.json",
+				"resources/volumesnapshotlocations.velero.io/v1-preferredversion/namespaces/foo/vsl-1.json",
+			},
+		},
+		{
+			name: "include cluster resources=true includes all CRDs when backing up selected namespaces",
+			backup: defaultBackup().
+				IncludeClusterResources(true).
+				IncludedNamespaces("foo").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.CRDs(
+					builder.ForCustomResourceDefinition("backups.velero.io").Result(),
+					builder.ForCustomResourceDefinition("volumesnapshotlocations.velero.io").Result(),
+					builder.ForCustomResourceDefinition("test.velero.io").Result(),
+				),
+				test.VSLs(
+					builder.ForVolumeSnapshotLocation("foo", "vsl-1").Result(),
+				),
+			},
+			want: []string{
+				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/backups.velero.io.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/volumesnapshotlocations.velero.io.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/test.velero.io.json",
+				"resources/volumesnapshotlocations.velero.io/namespaces/foo/vsl-1.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/backups.velero.io.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/volumesnapshotlocations.velero.io.json",
+				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/test.velero.io.json",
+				"resources/volumesnapshotlocations.velero.io/v1-preferredversion/namespaces/foo/vsl-1.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
+// TestAPIServiceInclusion verifies that backup.spec.includeAPIServices includes
+// or excludes APIServices, and that resources served by unavailable aggregated
+// API servers are skipped.
+func TestAPIServiceInclusion(t *testing.T) {
+	apiService := func(name, service, available string) *unstructured.Unstructured {
+		gv := strings.SplitN(name, ".", 2)
+		return test.UnstructuredOrDie(fmt.Sprintf(`{
+			"apiVersion": "apiregistration.k8s.io/v1",
+			"kind": "APIService",
+			"metadata": {"name": %q},
+			"spec": {"group": %q, "version": %q%s},
+			"status": {"conditions": [{"type": "Available", "status": %q}]}
+		}`, name, gv[1], gv[0], service, available))
+	}
+	apiServices := func() *test.APIResource {
+		return test.APIServices(
+			apiService("v1.apps", "", "True"),
+			apiService("v1beta1.metrics.k8s.io", `, "service": {"namespace": "kube-system", "name": "metrics-server"}`, "True"),
+			apiService("v1alpha1.example.com", `, "service": {"namespace": "ns-1", "name": "example-api"}`, "True"),
+		)
+	}
+
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		want         []string
+	}{
+		{
+			name:         "include API services=auto includes all APIServices when running a full-cluster backup",
+			backup:       defaultBackup().Result(),
+			apiResources: []*test.APIResource{apiServices()},
+			want: []string{
+				"resources/apiservices.apiregistration.k8s.io/cluster/v1.apps.json",
+				"resources/apiservices.apiregistration.k8s.io/cluster/v1beta1.metrics.k8s.io.json",
+				"resources/apiservices.apiregistration.k8s.io/cluster/v1alpha1.example.com.json",
+				"resources/apiservices.apiregistration.k8s.io/v1-preferredversion/cluster/v1.apps.json",
+				"resources/apiservices.apiregistration.k8s.io/v1-preferredversion/cluster/v1beta1.metrics.k8s.io.json",
+				"resources/apiservices.apiregistration.k8s.io/v1-preferredversion/cluster/v1alpha1.example.com.json",
+			},
+		},
+		{
+			name: "include API services=false excludes all APIServices even when including cluster resources",
+			backup: defaultBackup().
+				IncludeClusterResources(true).
+				IncludeAPIServices(false).
+				Result(),
+			apiResources: []*test.APIResource{apiServices()},
+		},
+		{
+			name: "include API services=true includes aggregated APIServices whose service is in an included namespace",
+			backup: defaultBackup().
+				IncludedNamespaces("ns-1").
+				IncludeAPIServices(true).
+				Result(),
+			apiResources: []*test.APIResource{apiServices()},
+			want: []string{
+				"resources/apiservices.apiregistration.k8s.io/cluster/v1alpha1.example.com.json",
+				"resources/apiservices.apiregistration.k8s.io/v1-preferredversion/cluster/v1alpha1.example.com.json",
 			},
 		},
 		{
-			name: "include cluster resources=false excludes all CRDs when backing up selected namespaces",
+			name: "include API services=true includes aggregated APIServices even when excluding cluster resources",
 			backup: defaultBackup().
 				IncludeClusterResources(false).
-				IncludedNamespaces("foo").
+				IncludeAPIServices(true).
 				Result(),
-			apiResources: []*test.APIResource{
-				test.CRDs(
-					builder.ForCustomResourceDefinition("backups.velero.io").Result(),
-					builder.ForCustomResourceDefinition("volumesnapshotlocations.velero.io").Result(),
-					builder.ForCustomResourceDefinition("test.velero.io").Result(),
-				),
-				test.VSLs(
-					builder.ForVolumeSnapshotLocation("foo", "vsl-1").Result(),
-				),
-			},
+			apiResources: []*test.APIResource{apiServices()},
 			want: []string{
-				"resources/volumesnapshotlocations.velero.io/namespaces/foo/vsl-1.json",
-				"resources/volumesnapshotlocations.velero.io/v1-preferredversion/namespaces/foo/vsl-1.json",
+				"resources/apiservices.apiregistration.k8s.io/cluster/v1beta1.metrics.k8s.io.json",
+				"resources/apiservices.apiregistration.k8s.io/cluster/v1alpha1.example.com.json",
+				"resources/apiservices.apiregistration.k8s.io/v1-preferredversion/cluster/v1beta1.metrics.k8s.io.json",
+				"resources/apiservices.apiregistration.k8s.io/v1-preferredversion/cluster/v1alpha1.example.com.json",
 			},
 		},
 		{
-			name: "include cluster resources=true includes all CRDs when backing up selected namespaces",
+			name: "resources served by an unavailable aggregated API server are skipped",
 			backup: defaultBackup().
-				IncludeClusterResources(true).
-				IncludedNamespaces("foo").
+				IncludeClusterResources(false).
 				Result(),
 			apiResources: []*test.APIResource{
-				test.CRDs(
-					builder.ForCustomResourceDefinition("backups.velero.io").Result(),
-					builder.ForCustomResourceDefinition("volumesnapshotlocations.velero.io").Result(),
-					builder.ForCustomResourceDefinition("test.velero.io").Result(),
+				test.APIServices(
+					apiService("v1alpha1.example.com", `, "service": {"namespace": "ns-1", "name": "example-api"}`, "False"),
 				),
-				test.VSLs(
-					builder.ForVolumeSnapshotLocation("foo", "vsl-1").Result(),
+				{
+					Group:      "example.com",
+					Version:    "v1alpha1",
+					Name:       "widgets",
+					Namespaced: true,
+					Items: []metav1.Object{
+						test.UnstructuredOrDie(`{"apiVersion": "example.com/v1alpha1", "kind": "Widget", "metadata": {"namespace": "ns-1", "name": "widget-1"}}`),
+					},
+				},
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
 				),
 			},
 			want: []string{
-				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/backups.velero.io.json",
-				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/volumesnapshotlocations.velero.io.json",
-				"resources/customresourcedefinitions.apiextensions.k8s.io/cluster/test.velero.io.json",
-				"resources/volumesnapshotlocations.velero.io/namespaces/foo/vsl-1.json",
-				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/backups.velero.io.json",
-				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/volumesnapshotlocations.velero.io.json",
-				"resources/customresourcedefinitions.apiextensions.k8s.io/v1beta1-preferredversion/cluster/test.velero.io.json",
-				"resources/volumesnapshotlocations.velero.io/v1-preferredversion/namespaces/foo/vsl-1.json",
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
+// TestBackupResourceAPIVersions verifies that backup.spec.resourceAPIVersions
+// backs resources up at the requested API versions in addition to their
+// preferred version.
+func TestBackupResourceAPIVersions(t *testing.T) {
+	widgets := func(version string) *test.APIResource {
+		return &test.APIResource{
+			Group:      "example.com",
+			Version:    version,
+			Name:       "widgets",
+			Namespaced: true,
+			Items: []metav1.Object{
+				test.UnstructuredOrDie(fmt.Sprintf(`{"apiVersion": "example.com/%s", "kind": "Widget", "metadata": {"namespace": "ns-1", "name": "widget-1"}}`, version)),
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		want         []string
+	}{
+		{
+			name:         "resources are only backed up at their preferred version by default",
+			backup:       defaultBackup().Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2")},
+			want: []string{
+				"resources/widgets.example.com/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v1-preferredversion/namespaces/ns-1/widget-1.json",
+			},
+		},
+		{
+			name:         "resources are backed up at the requested versions",
+			backup:       defaultBackup().ResourceAPIVersions(map[string][]string{"widgets.example.com": {"v2"}}).Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2"), widgets("v3")},
+			want: []string{
+				"resources/widgets.example.com/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v1-preferredversion/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v2/namespaces/ns-1/widget-1.json",
+			},
+		},
+		{
+			name:         "resources are backed up at all versions they're served at",
+			backup:       defaultBackup().ResourceAPIVersions(map[string][]string{"widgets": {"*"}}).Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2"), widgets("v3")},
+			want: []string{
+				"resources/widgets.example.com/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v1-preferredversion/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v2/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v3/namespaces/ns-1/widget-1.json",
+			},
+		},
+		{
+			name:         "requested versions that aren't served are skipped",
+			backup:       defaultBackup().ResourceAPIVersions(map[string][]string{"widgets.example.com": {"v1", "v2beta1"}}).Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2")},
+			want: []string{
+				"resources/widgets.example.com/namespaces/ns-1/widget-1.json",
+				"resources/widgets.example.com/v1-preferredversion/namespaces/ns-1/widget-1.json",
 			},
 		},
+		{
+			name: "requested versions of resources that aren't included are skipped",
+			backup: defaultBackup().
+				ExcludedResources("widgets.example.com").
+				ResourceAPIVersions(map[string][]string{"widgets.example.com": {"v2"}}).
+				Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2")},
+		},
 	}
 
 	for _, tc := range tests {
@@ -916,7 +1659,7 @@ func TestCRDInclusion(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -970,6 +1713,26 @@ func TestBackupResourceCohabitation(t *testing.T) {
 				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
 			},
 		},
+		{
+			name: "resource label selector for either API group applies to cohabitating resources",
+			backup: defaultBackup().
+				ResourceLabelSelectors(map[string]string{"deployments.extensions": "backup=true"}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.ExtensionsDeployments(
+					builder.ForDeployment("foo", "bar").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").ObjectMeta(builder.WithLabels("backup", "true")).Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -984,7 +1747,7 @@ func TestBackupResourceCohabitation(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
 		})
@@ -1007,7 +1770,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	h.addItems(t, test.Deployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 	h.addItems(t, test.ExtensionsDeployments(builder.ForDeployment("ns-1", "deploy-1").Result()))
 
-	h.backupper.Backup(h.log, backup1, backup1File, nil, nil)
+	h.backupper.Backup(h.log, backup1, backup1File, nil, nil, nil)
 
 	assertTarballContents(t, backup1File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 
@@ -1017,7 +1780,7 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	}
 	backup2File := bytes.NewBuffer([]byte{})
 
-	h.backupper.Backup(h.log, backup2, backup2File, nil, nil)
+	h.backupper.Backup(h.log, backup2, backup2File, nil, nil, nil)
 
 	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 }
@@ -1069,7 +1832,7 @@ func TestBackupResourceOrdering(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+			h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
 
 			assertTarballOrdering(t, backupFile, "pods", "persistentvolumeclaims", "persistentvolumes")
 		})
@@ -1312,7 +2075,7 @@ func TestBackupActionsRunForCorrectItems(t *testing.T) {
 				actions = append(actions, action)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, actions, nil, nil)
 			assert.NoError(t, err)
 
 			for action, want := range tc.actions {
@@ -1385,7 +2148,7 @@ func TestBackupWithInvalidActions(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil))
+			assert.Error(t, h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil))
 		})
 	}
 }
@@ -1516,7 +2279,7 @@ func TestBackupActionModifications(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
 			assert.NoError(t, err)
 
 			assertTarballFileContents(t, backupFile, tc.want)
@@ -1664,94 +2427,248 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 			apiResources: []*test.APIResource{
 				test.Pods(
 					builder.ForPod("ns-1", "pod-1").Result(),
-					builder.ForPod("ns-2", "pod-2").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				),
+			},
+			actions: []velero.BackupItemAction{
+				&pluggableAction{
+					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+						additionalItems := []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-2"},
+						}
+
+						return item, additionalItems, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
+			},
+		},
+		{
+			name:   "additional items with the velero.io/exclude-from-backup label are not backed up",
+			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("velero.io/exclude-from-backup", "true")).Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				),
+			},
+			actions: []velero.BackupItemAction{
+				&pluggableAction{
+					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+						additionalItems := []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-2"},
+						}
+
+						return item, additionalItems, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/persistentvolumes/cluster/pv-2.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/pv-2.json",
+			},
+		},
+
+		{
+			name:   "if additional items aren't found in the API, they're skipped and the original item is still backed up",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+					builder.ForPod("ns-3", "pod-3").Result(),
+				),
+			},
+			actions: []velero.BackupItemAction{
+				&pluggableAction{
+					selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
+					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+						additionalItems := []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.Pods, Namespace: "ns-4", Name: "pod-4"},
+							{GroupResource: kuberesource.Pods, Namespace: "ns-5", Name: "pod-5"},
+						}
+
+						return item, additionalItems, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"resources/pods/namespaces/ns-3/pod-3.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-3/pod-3.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil, nil)
+			assert.NoError(t, err)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
+// TestBackupItemBlockActions runs backups with item block actions and verifies that
+// related items are backed up immediately after the item that references them.
+func TestBackupItemBlockActions(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		actions      []velero.ItemBlockAction
+		want         []string
+	}{
+		{
+			name:   "related items are backed up together with the referencing item",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-2", "pvc-2").Result(),
+				),
+			},
+			actions: []velero.ItemBlockAction{
+				&pluggableItemBlockAction{
+					selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						metadata, err := meta.Accessor(item)
+						if err != nil {
+							return nil, err
+						}
+
+						if metadata.GetName() != "pod-1" {
+							return nil, nil
+						}
+
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+						}, nil
+					},
+				},
+			},
+			want: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/persistentvolumeclaims/namespaces/ns-1/pvc-1.json",
+				"resources/pods/namespaces/ns-2/pod-2.json",
+				"resources/persistentvolumeclaims/namespaces/ns-2/pvc-2.json",
+			},
+		},
+		{
+			name:   "related items of related items are included in the block",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
 				),
-				test.PVs(
-					builder.ForPersistentVolume("pv-1").Result(),
-					builder.ForPersistentVolume("pv-2").Result(),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+				),
+				test.Secrets(
+					builder.ForSecret("ns-1", "secret-1").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
-				&pluggableAction{
-					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
-						additionalItems := []velero.ResourceIdentifier{
-							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
-							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-2"},
-						}
-
-						return item, additionalItems, nil
+			actions: []velero.ItemBlockAction{
+				&pluggableItemBlockAction{
+					selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+						}, nil
+					},
+				},
+				&pluggableItemBlockAction{
+					selector: velero.ResourceSelector{IncludedResources: []string{"persistentvolumeclaims"}},
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.Secrets, Namespace: "ns-1", Name: "secret-1"},
+						}, nil
 					},
 				},
 			},
 			want: []string{
 				"resources/pods/namespaces/ns-1/pod-1.json",
-				"resources/pods/namespaces/ns-2/pod-2.json",
-				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
-				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
+				"resources/persistentvolumeclaims/namespaces/ns-1/pvc-1.json",
+				"resources/secrets/namespaces/ns-1/secret-1.json",
+				"resources/pods/namespaces/ns-1/pod-2.json",
 			},
 		},
 		{
-			name:   "additional items with the velero.io/exclude-from-backup label are not backed up",
-			backup: defaultBackup().IncludedNamespaces("ns-1").Result(),
+			name:   "related items that were not collected for the backup are ignored",
+			backup: defaultBackup().IncludedResources("pods").Result(),
 			apiResources: []*test.APIResource{
 				test.Pods(
 					builder.ForPod("ns-1", "pod-1").Result(),
 				),
-				test.PVs(
-					builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("velero.io/exclude-from-backup", "true")).Result(),
-					builder.ForPersistentVolume("pv-2").Result(),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
-				&pluggableAction{
-					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
-						additionalItems := []velero.ResourceIdentifier{
-							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
-							{GroupResource: kuberesource.PersistentVolumes, Name: "pv-2"},
-						}
-
-						return item, additionalItems, nil
+			actions: []velero.ItemBlockAction{
+				&pluggableItemBlockAction{
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return []velero.ResourceIdentifier{
+							{GroupResource: kuberesource.PersistentVolumeClaims, Namespace: "ns-1", Name: "pvc-1"},
+						}, nil
 					},
 				},
 			},
 			want: []string{
 				"resources/pods/namespaces/ns-1/pod-1.json",
-				"resources/persistentvolumes/cluster/pv-2.json",
-				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
-				"resources/persistentvolumes/v1-preferredversion/cluster/pv-2.json",
 			},
 		},
-
 		{
-			name:   "if additional items aren't found in the API, they're skipped and the original item is still backed up",
+			name:   "errors from item block actions are logged and the item is still backed up",
 			backup: defaultBackup().Result(),
 			apiResources: []*test.APIResource{
 				test.Pods(
 					builder.ForPod("ns-1", "pod-1").Result(),
-					builder.ForPod("ns-2", "pod-2").Result(),
-					builder.ForPod("ns-3", "pod-3").Result(),
 				),
 			},
-			actions: []velero.BackupItemAction{
-				&pluggableAction{
-					selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-1"}},
-					executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
-						additionalItems := []velero.ResourceIdentifier{
-							{GroupResource: kuberesource.Pods, Namespace: "ns-4", Name: "pod-4"},
-							{GroupResource: kuberesource.Pods, Namespace: "ns-5", Name: "pod-5"},
-						}
-
-						return item, additionalItems, nil
+			actions: []velero.ItemBlockAction{
+				&pluggableItemBlockAction{
+					getRelatedItemsFunc: func(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+						return nil, errors.New("related items error")
 					},
 				},
 			},
 			want: []string{
 				"resources/pods/namespaces/ns-1/pod-1.json",
-				"resources/pods/namespaces/ns-2/pod-2.json",
-				"resources/pods/namespaces/ns-3/pod-3.json",
-				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
-				"resources/pods/v1-preferredversion/namespaces/ns-2/pod-2.json",
-				"resources/pods/v1-preferredversion/namespaces/ns-3/pod-3.json",
 			},
 		},
 	}
@@ -1768,10 +2685,10 @@ func TestBackupActionAdditionalItems(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, req, backupFile, tc.actions, nil)
+			err := h.backupper.Backup(h.log, req, backupFile, nil, tc.actions, nil)
 			assert.NoError(t, err)
 
-			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+			assertTarballItemOrder(t, backupFile, tc.want...)
 		})
 	}
 }
@@ -2212,7 +3129,7 @@ func TestBackupWithSnapshots(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			err := h.backupper.Backup(h.log, tc.req, backupFile, nil, tc.snapshotterGetter)
+			err := h.backupper.Backup(h.log, tc.req, backupFile, nil, nil, tc.snapshotterGetter)
 			assert.NoError(t, err)
 
 			assert.Equal(t, tc.want, tc.req.VolumeSnapshots)
@@ -2220,6 +3137,191 @@ func TestBackupWithSnapshots(t *testing.T) {
 	}
 }
 
+// slowVolumeSnapshotter is a fakeVolumeSnapshotter whose CreateSnapshot calls
+// take a fixed amount of time, and which records the highest number of
+// concurrent CreateSnapshot calls it has seen and how many have returned.
+type slowVolumeSnapshotter struct {
+	*fakeVolumeSnapshotter
+
+	delay       time.Duration
+	lock        sync.Mutex
+	inFlight    int
+	maxInFlight int
+	returned    int
+}
+
+func (vs *slowVolumeSnapshotter) CreateSnapshot(volumeID, volumeAZ string, tags map[string]string) (string, error) {
+	vs.lock.Lock()
+	vs.inFlight++
+	if vs.inFlight > vs.maxInFlight {
+		vs.maxInFlight = vs.inFlight
+	}
+	vs.lock.Unlock()
+
+	time.Sleep(vs.delay)
+
+	vs.lock.Lock()
+	vs.inFlight--
+	vs.returned++
+	vs.lock.Unlock()
+
+	return vs.fakeVolumeSnapshotter.CreateSnapshot(volumeID, volumeAZ, tags)
+}
+
+// TestBackupWithConcurrentSnapshots runs backups with different numbers of volume
+// snapshot workers and verifies that snapshots are created concurrently when
+// more than one worker is configured, that the backup's snapshots are recorded
+// in a deterministic order, and that a failure to snapshot one volume doesn't
+// affect the others.
+func TestBackupWithConcurrentSnapshots(t *testing.T) {
+	tests := []struct {
+		name            string
+		workers         int
+		wantMaxInFlight func(t *testing.T, maxInFlight int)
+	}{
+		{
+			name:    "a single worker creates snapshots serially",
+			workers: 1,
+			wantMaxInFlight: func(t *testing.T, maxInFlight int) {
+				assert.Equal(t, 1, maxInFlight)
+			},
+		},
+		{
+			name:    "multiple workers create snapshots concurrently",
+			workers: 4,
+			wantMaxInFlight: func(t *testing.T, maxInFlight int) {
+				assert.True(t, maxInFlight > 1, "expected concurrent CreateSnapshot calls, got at most %d", maxInFlight)
+				assert.True(t, maxInFlight <= 4, "expected at most 4 concurrent CreateSnapshot calls, got %d", maxInFlight)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				backupFile = bytes.NewBuffer([]byte{})
+				req        = &Request{
+					Backup: defaultBackup().Result(),
+					SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+						newSnapshotLocation("velero", "default", "default"),
+					},
+				}
+				snapshotter = &slowVolumeSnapshotter{
+					fakeVolumeSnapshotter: new(fakeVolumeSnapshotter).
+						WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+						WithVolume("pv-2", "vol-2", "", "type-2", 100, true).
+						WithVolume("pv-3", "vol-3", "", "type-3", 100, false).
+						WithVolume("pv-4", "vol-4", "", "type-4", 100, false),
+					delay: 100 * time.Millisecond,
+				}
+			)
+
+			h.backupper.volumeSnapshotWorkers = tc.workers
+			h.addItems(t, test.PVs(
+				builder.ForPersistentVolume("pv-1").Result(),
+				builder.ForPersistentVolume("pv-2").Result(),
+				builder.ForPersistentVolume("pv-3").Result(),
+				builder.ForPersistentVolume("pv-4").Result(),
+			))
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, nil, map[string]velero.VolumeSnapshotter{"default": snapshotter})
+			require.NoError(t, err)
+
+			tc.wantMaxInFlight(t, snapshotter.maxInFlight)
+
+			require.Len(t, req.VolumeSnapshots, 4)
+			for i, snapshot := range req.VolumeSnapshots {
+				assert.Equal(t, fmt.Sprintf("pv-%d", i+1), snapshot.Spec.PersistentVolumeName)
+				assert.Equal(t, fmt.Sprintf("vol-%d", i+1), snapshot.Spec.ProviderVolumeID)
+
+				if snapshot.Spec.PersistentVolumeName == "pv-2" {
+					assert.Equal(t, volume.SnapshotPhaseFailed, snapshot.Status.Phase)
+					assert.Empty(t, snapshot.Status.ProviderSnapshotID)
+				} else {
+					assert.Equal(t, volume.SnapshotPhaseCompleted, snapshot.Status.Phase)
+					assert.Equal(t, snapshot.Spec.ProviderVolumeID+"-snapshot", snapshot.Status.ProviderSnapshotID)
+				}
+			}
+		})
+	}
+}
+
+// TestBackupWithConcurrentSnapshotsWaitsBeforePostHooks runs a backup of a pod whose
+// persistent volumes are backed up as its additional items with concurrent volume snapshot
+// workers, and verifies that the pod's post hooks only run once its volumes' snapshots
+// have been created.
+func TestBackupWithConcurrentSnapshotsWaitsBeforePostHooks(t *testing.T) {
+	var (
+		h          = newHarness(t)
+		backupFile = bytes.NewBuffer([]byte{})
+		req        = &Request{
+			Backup: defaultBackup().
+				Hooks(velerov1.BackupHooks{
+					Resources: []velerov1.BackupResourceHookSpec{
+						{
+							Name: "hook-1",
+							PostHooks: []velerov1.BackupResourceHook{
+								{
+									Exec: &velerov1.ExecHook{
+										Command: []string{"fsfreeze", "--unfreeze", "/data"},
+									},
+								},
+							},
+						},
+					},
+				}).
+				Result(),
+			SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+				newSnapshotLocation("velero", "default", "default"),
+			},
+		}
+		snapshotter = &slowVolumeSnapshotter{
+			fakeVolumeSnapshotter: new(fakeVolumeSnapshotter).
+				WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+				WithVolume("pv-2", "vol-2", "", "type-2", 100, false),
+			delay: 100 * time.Millisecond,
+		}
+		podCommandExecutor = new(testutil.MockPodCommandExecutor)
+		action             = &pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+				return item, []velero.ResourceIdentifier{
+					{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"},
+					{GroupResource: kuberesource.PersistentVolumes, Name: "pv-2"},
+				}, nil
+			},
+		}
+		snapshotsAtPostHook int
+	)
+
+	h.backupper.volumeSnapshotWorkers = 4
+	h.backupper.podCommandExecutor = podCommandExecutor
+	defer podCommandExecutor.AssertExpectations(t)
+
+	podCommandExecutor.On("ExecutePodCommand", mock.Anything, mock.Anything, "ns-1", "pod-1", "hook-1", mock.Anything).
+		Run(func(mock.Arguments) {
+			snapshotter.lock.Lock()
+			defer snapshotter.lock.Unlock()
+			snapshotsAtPostHook = snapshotter.returned
+		}).
+		Return(nil)
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+	))
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").Result(),
+		builder.ForPersistentVolume("pv-2").Result(),
+	))
+
+	err := h.backupper.Backup(h.log, req, backupFile, []velero.BackupItemAction{action}, nil, map[string]velero.VolumeSnapshotter{"default": snapshotter})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, snapshotsAtPostHook)
+	require.Len(t, req.VolumeSnapshots, 2)
+}
+
 // TestBackupWithInvalidHooks runs backups with invalid hook specifications and verifies
 // that an error is returned.
 func TestBackupWithInvalidHooks(t *testing.T) {
@@ -2270,7 +3372,151 @@ func TestBackupWithInvalidHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			assert.EqualError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil), tc.want.Error())
+			assert.EqualError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil), tc.want.Error())
+		})
+	}
+}
+
+func TestBackupWithInvalidResourceLabelSelectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  *velerov1.Backup
+		wantErr string
+	}{
+		{
+			name: "resource label selector for a resource that can't be resolved causes backup to fail",
+			backup: defaultBackup().
+				ResourceLabelSelectors(map[string]string{"foos": "backup=true"}).
+				Result(),
+			wantErr: "unable to resolve resource foos of resource label selector",
+		},
+		{
+			name: "resource label selector that can't be parsed causes backup to fail",
+			backup: defaultBackup().
+				ResourceLabelSelectors(map[string]string{"pods": "=invalid-selector"}).
+				Result(),
+			wantErr: "invalid label selector for resource pods",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+// TestBackupExcludedFields runs backups with excluded fields and verifies that the fields
+// are removed from the items in the backup tarball.
+func TestBackupExcludedFields(t *testing.T) {
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		want         map[string]unstructuredObject
+	}{
+		{
+			name: "fields excluded for a resource are only removed from its items",
+			backup: defaultBackup().
+				ExcludedFields(map[string][]string{"pods": {"spec.nodeName"}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").NodeName("node-1").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "web")).Result(),
+				),
+			},
+			want: map[string]unstructuredObject{
+				"resources/pods/namespaces/ns-1/pod-1.json":                toUnstructuredOrFail(t, builder.ForPod("ns-1", "pod-1").Result()),
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json": toUnstructuredOrFail(t, builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "web")).Result()),
+			},
+		},
+		{
+			name: "fields excluded for all resources are removed from every item",
+			backup: defaultBackup().
+				ExcludedFields(map[string][]string{"*": {"metadata.labels"}, "pods": {"spec.nodeName"}}).
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "web")).NodeName("node-1").Result(),
+				),
+				test.Deployments(
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithLabels("app", "web")).Result(),
+				),
+			},
+			want: map[string]unstructuredObject{
+				"resources/pods/namespaces/ns-1/pod-1.json":                toUnstructuredOrFail(t, builder.ForPod("ns-1", "pod-1").Result()),
+				"resources/deployments.apps/namespaces/ns-1/deploy-1.json": toUnstructuredOrFail(t, builder.ForDeployment("ns-1", "deploy-1").Result()),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
+
+			assertTarballFileContents(t, backupFile, tc.want)
+		})
+	}
+}
+
+func TestBackupWithInvalidExcludedFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  *velerov1.Backup
+		wantErr string
+	}{
+		{
+			name: "excluded fields for a resource that can't be resolved cause backup to fail",
+			backup: defaultBackup().
+				ExcludedFields(map[string][]string{"foos": {"status"}}).
+				Result(),
+			wantErr: "unable to resolve resource foos of excluded fields",
+		},
+		{
+			name: "excluded field that's needed to restore items causes backup to fail",
+			backup: defaultBackup().
+				ExcludedFields(map[string][]string{"pods": {"metadata.name"}}).
+				Result(),
+			wantErr: "invalid excluded field \"metadata.name\" for resource pods",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+
+			err := h.backupper.Backup(h.log, req, backupFile, nil, nil, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
 		})
 	}
 }
@@ -2533,7 +3779,7 @@ func TestBackupWithHooks(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, nil))
 
 			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
 		})
@@ -2658,7 +3904,7 @@ func TestBackupWithRestic(t *testing.T) {
 				h.addItems(t, resource)
 			}
 
-			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, tc.snapshotterGetter))
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil, tc.snapshotterGetter))
 
 			assert.Equal(t, tc.want, req.PodVolumeBackups)
 
@@ -2687,6 +3933,23 @@ func (a *pluggableAction) AppliesTo() (velero.ResourceSelector, error) {
 	return a.selector, nil
 }
 
+type pluggableItemBlockAction struct {
+	selector            velero.ResourceSelector
+	getRelatedItemsFunc func(runtime.Unstructured, *velerov1.Backup) ([]velero.ResourceIdentifier, error)
+}
+
+func (a *pluggableItemBlockAction) GetRelatedItems(item runtime.Unstructured, backup *velerov1.Backup) ([]velero.ResourceIdentifier, error) {
+	if a.getRelatedItemsFunc == nil {
+		return nil, nil
+	}
+
+	return a.getRelatedItemsFunc(item, backup)
+}
+
+func (a *pluggableItemBlockAction) AppliesTo() (velero.ResourceSelector, error) {
+	return a.selector, nil
+}
+
 type harness struct {
 	*test.APIServer
 	backupper *kubernetesBackupper
@@ -2790,6 +4053,34 @@ func assertTarballContents(t *testing.T, backupFile io.Reader, items ...string)
 	assert.Equal(t, items, files)
 }
 
+// assertTarballItemOrder verifies that the backup tarball contains exactly the given
+// resource files, in the given order. Preferred-version copies and metadata are ignored.
+func assertTarballItemOrder(t *testing.T, backupFile io.Reader, items ...string) {
+	t.Helper()
+
+	gzr, err := gzip.NewReader(backupFile)
+	require.NoError(t, err)
+
+	r := tar.NewReader(gzr)
+
+	var files []string
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		if !strings.HasPrefix(hdr.Name, "resources/") || strings.Contains(hdr.Name, "-preferredversion/") {
+			continue
+		}
+
+		files = append(files, hdr.Name)
+	}
+
+	assert.Equal(t, items, files)
+}
+
 // unstructuredObject is a type alias to improve readability.
 type unstructuredObject map[string]interface{}
 
@@ -2892,3 +4183,10 @@ func assertTarballOrdering(t *testing.T, backupFile io.Reader, orderedResources
 		lastSeen = current
 	}
 }
+
+// withManagedFieldsTime sets an object's managed fields to a single entry
+// last updated at t.
+func withManagedFieldsTime(obj *corev1.Secret, t time.Time) *corev1.Secret {
+	obj.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: t}}}
+	return obj
+}
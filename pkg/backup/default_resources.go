@@ -0,0 +1,99 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// DefaultResourcePatterns match the objects that Kubernetes creates on its
+// own, which backups with spec.excludeDefaultResources leave out since
+// they're recreated on restore, and restoring them only causes conflicts.
+// They're item filter patterns, matching each namespace's default service
+// account, its token secret and its kube-root-ca.crt config map, and the
+// kubernetes service in the default namespace.
+var DefaultResourcePatterns = []string{
+	"core/serviceaccounts:*/default",
+	"core/secrets:*/default-token-*",
+	"core/configmaps:*/kube-root-ca.crt",
+	"core/services:default/kubernetes",
+}
+
+// DefaultResourcesKey is the key of the server's default resources config
+// map that lists the patterns used instead of DefaultResourcePatterns.
+const DefaultResourcesKey = "patterns"
+
+// GetDefaultResourcePatterns returns the item filter patterns listed,
+// separated by commas or newlines, in the patterns key of the server's
+// default resources config map. Re-includes prefixed with '!' aren't
+// allowed in it.
+func GetDefaultResourcePatterns(configMap *corev1api.ConfigMap) ([]string, error) {
+	value, ok := configMap.Data[DefaultResourcesKey]
+	if !ok {
+		return nil, errors.Errorf("config map %s/%s has no %s key", configMap.Namespace, configMap.Name, DefaultResourcesKey)
+	}
+
+	var patterns []string
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		item = strings.TrimSpace(item)
+		switch {
+		case item == "":
+			continue
+		case strings.HasPrefix(item, "!"):
+			return nil, errors.Errorf("config map %s/%s can't re-include %s", configMap.Namespace, configMap.Name, item)
+		}
+		patterns = append(patterns, item)
+	}
+
+	if errs := collections.ValidateItemKeyPatterns(patterns); len(errs) > 0 {
+		return nil, errors.Wrapf(kerrors.NewAggregate(errs), "config map %s/%s has invalid patterns", configMap.Namespace, configMap.Name)
+	}
+	return patterns, nil
+}
+
+// getDefaultResources returns the filter matching the default resources a
+// backup leaves out, or nil if it doesn't leave them out.
+func getDefaultResources(spec *velerov1api.BackupSpec, patterns []string) *collections.IncludesExcludes {
+	if !boolptr.IsSetToTrue(spec.ExcludeDefaultResources) || len(patterns) == 0 {
+		return nil
+	}
+	return collections.NewIncludesExcludes().Includes(patterns...)
+}
+
+// isDefaultResource returns whether an object is a default resource that
+// the backup leaves out. Default resources are kept if the backup's item
+// filter includes them explicitly, by a pattern other than '*'.
+func (r *Request) isDefaultResource(groupResource schema.GroupResource, namespace, name string) bool {
+	if r.DefaultResources == nil {
+		return false
+	}
+
+	key := collections.ItemKey(groupResource, namespace, name)
+	if !r.DefaultResources.MatchesIncludePattern(key) {
+		return false
+	}
+	return r.ItemIncludesExcludes == nil || !r.ItemIncludesExcludes.MatchesIncludePattern(key)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func TestGetDefaultResourcePatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "patterns separated by commas and newlines are returned",
+			data: map[string]string{"patterns": "core/serviceaccounts:*/default,\ncore/configmaps:*/kube-root-ca.crt\n\n"},
+			want: []string{"core/serviceaccounts:*/default", "core/configmaps:*/kube-root-ca.crt"},
+		},
+		{
+			name: "empty patterns key returns no patterns",
+			data: map[string]string{"patterns": ""},
+		},
+		{
+			name:    "missing patterns key is an error",
+			data:    map[string]string{"resources": ""},
+			wantErr: "config map velero/defaults has no patterns key",
+		},
+		{
+			name:    "re-include is rejected",
+			data:    map[string]string{"patterns": "core/serviceaccounts:*/*,!core/serviceaccounts:*/default"},
+			wantErr: "config map velero/defaults can't re-include !core/serviceaccounts:*/default",
+		},
+		{
+			name:    "pattern that isn't an item key is rejected",
+			data:    map[string]string{"patterns": "serviceaccounts"},
+			wantErr: `config map velero/defaults has invalid patterns: pattern "serviceaccounts" must be formatted as <group>/<resource>:<namespace>/<name>`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			configMap := &corev1api.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "defaults"},
+				Data:       tc.data,
+			}
+
+			patterns, err := GetDefaultResourcePatterns(configMap)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, patterns)
+		})
+	}
+}
+
+func TestIsDefaultResource(t *testing.T) {
+	tests := []struct {
+		name      string
+		backup    *velerov1api.Backup
+		patterns  []string
+		namespace string
+		item      string
+		want      bool
+	}{
+		{
+			name:      "default resources aren't excluded unless the backup excludes them",
+			backup:    builder.ForBackup("velero", "backup-1").Result(),
+			patterns:  DefaultResourcePatterns,
+			namespace: "ns-1",
+			item:      "default",
+		},
+		{
+			name:      "backup excludes each namespace's default service account",
+			backup:    builder.ForBackup("velero", "backup-1").ExcludeDefaultResources(true).Result(),
+			patterns:  DefaultResourcePatterns,
+			namespace: "ns-1",
+			item:      "default",
+			want:      true,
+		},
+		{
+			name:      "other service accounts aren't default resources",
+			backup:    builder.ForBackup("velero", "backup-1").ExcludeDefaultResources(true).Result(),
+			patterns:  DefaultResourcePatterns,
+			namespace: "ns-1",
+			item:      "app",
+		},
+		{
+			name: "item filter's wildcard doesn't include default resources explicitly",
+			backup: builder.ForBackup("velero", "backup-1").
+				ExcludeDefaultResources(true).
+				ItemFilter(&velerov1api.ItemFilter{IncludedItems: []string{"*"}}).
+				Result(),
+			patterns:  DefaultResourcePatterns,
+			namespace: "ns-1",
+			item:      "default",
+			want:      true,
+		},
+		{
+			name: "default resources included explicitly by the item filter are kept",
+			backup: builder.ForBackup("velero", "backup-1").
+				ExcludeDefaultResources(true).
+				ItemFilter(&velerov1api.ItemFilter{IncludedItems: []string{"core/serviceaccounts:ns-1/*"}}).
+				Result(),
+			patterns:  DefaultResourcePatterns,
+			namespace: "ns-1",
+			item:      "default",
+		},
+		{
+			name:      "no patterns means no default resources",
+			backup:    builder.ForBackup("velero", "backup-1").ExcludeDefaultResources(true).Result(),
+			patterns:  []string{},
+			namespace: "ns-1",
+			item:      "default",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &Request{Backup: tc.backup}
+			req.DefaultResources = getDefaultResources(&req.Spec, tc.patterns)
+			if filter := req.Spec.ItemFilter; filter != nil {
+				req.ItemIncludesExcludes = collections.NewIncludesExcludes().Includes(filter.IncludedItems...).Excludes(filter.ExcludedItems...)
+			}
+
+			assert.Equal(t, tc.want, req.isDefaultResource(kuberesource.ServiceAccounts, tc.namespace, tc.item))
+		})
+	}
+}
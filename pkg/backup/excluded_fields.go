@@ -0,0 +1,89 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+)
+
+// allResources is the key of a backup's excluded fields that applies to every
+// resource.
+const allResources = "*"
+
+// requiredFields can't be excluded from backed-up items, since they're needed
+// to restore them.
+var requiredFields = []string{"apiVersion", "kind", "metadata.name", "metadata.namespace"}
+
+// ValidateExcludedField returns an error if field isn't a dot-separated path
+// to an object field, or if it's needed to restore the object.
+func ValidateExcludedField(field string) error {
+	for _, segment := range strings.Split(field, ".") {
+		if segment == "" {
+			return errors.New("field must be a dot-separated path, such as metadata.managedFields")
+		}
+	}
+
+	for _, required := range requiredFields {
+		if required == field || strings.HasPrefix(required, field+".") {
+			return errors.Errorf("%s is needed to restore items and can't be excluded", required)
+		}
+	}
+
+	return nil
+}
+
+// getExcludedFields resolves the keys of a backup's excluded fields to the
+// group-resources they refer to, and splits the fields into their paths.
+func getExcludedFields(discoveryHelper discovery.Helper, excludedFields map[string][]string) (map[schema.GroupResource][][]string, error) {
+	resolved := make(map[schema.GroupResource][][]string, len(excludedFields))
+
+	for resource, fields := range excludedFields {
+		groupResource := schema.GroupResource{Resource: allResources}
+		if resource != allResources {
+			gvr, _, err := discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to resolve resource %s of excluded fields", resource)
+			}
+			groupResource = gvr.GroupResource()
+		}
+
+		for _, field := range fields {
+			if err := ValidateExcludedField(field); err != nil {
+				return nil, errors.Wrapf(err, "invalid excluded field %q for resource %s", field, resource)
+			}
+			resolved[groupResource] = append(resolved[groupResource], strings.Split(field, "."))
+		}
+	}
+
+	return resolved, nil
+}
+
+// removeExcludedFields removes the fields excluded for groupResource, and for
+// all resources, from an item's content.
+func removeExcludedFields(content map[string]interface{}, groupResource schema.GroupResource, excludedFields map[schema.GroupResource][][]string) {
+	for _, key := range []schema.GroupResource{groupResource, {Resource: allResources}} {
+		for _, path := range excludedFields[key] {
+			unstructured.RemoveNestedField(content, path...)
+		}
+	}
+}
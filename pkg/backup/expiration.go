@@ -0,0 +1,118 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ExpirationOverride is a request to keep a backup past its
+// status.expiration, recorded in the backup's annotations.
+type ExpirationOverride struct {
+	Expiration  time.Time
+	RequestedBy string
+	RequestedAt time.Time
+	Reason      string
+}
+
+// GetExpirationOverride returns a backup's expiration override, or nil if it
+// doesn't have one. Its requester is whatever the client recorded, and isn't
+// verified.
+func GetExpirationOverride(backup *velerov1api.Backup) (*ExpirationOverride, error) {
+	value, ok := backup.Annotations[velerov1api.ExpirationOverrideAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Errorf("invalid %s annotation %q, must be an RFC 3339 time", velerov1api.ExpirationOverrideAnnotation, value)
+	}
+
+	// the override is checked here, rather than only by velero backup
+	// extend, since anyone who can update the backup can set the
+	// annotations directly: it must say when it was requested, and it must
+	// have been in the future then.
+	requestedAtValue := backup.Annotations[velerov1api.ExpirationOverrideRequestedAtAnnotation]
+	requestedAt, err := time.Parse(time.RFC3339, requestedAtValue)
+	if err != nil {
+		return nil, errors.Errorf("invalid %s annotation %q, must be an RFC 3339 time", velerov1api.ExpirationOverrideRequestedAtAnnotation, requestedAtValue)
+	}
+	if !expiration.After(requestedAt) {
+		return nil, errors.Errorf("expiration override %s must be later than when it was requested, %s", value, requestedAtValue)
+	}
+
+	return &ExpirationOverride{
+		Expiration:  expiration,
+		RequestedBy: backup.Annotations[velerov1api.ExpirationOverrideRequestedByAnnotation],
+		RequestedAt: requestedAt,
+		Reason:      backup.Annotations[velerov1api.ExpirationOverrideReasonAnnotation],
+	}, nil
+}
+
+// GetExpiration returns when a backup expires: its expiration override, if
+// it has one that's later than its status.expiration, or otherwise its
+// status.expiration, which is nil until the backup's been processed.
+// Overrides only extend a backup's expiration, and an invalid one is
+// returned as an error along with status.expiration.
+func GetExpiration(backup *velerov1api.Backup) (*metav1.Time, error) {
+	expiration := backup.Status.Expiration
+
+	override, err := GetExpirationOverride(backup)
+	if err != nil || override == nil {
+		return expiration, err
+	}
+
+	if expiration != nil && !override.Expiration.After(expiration.Time) {
+		return expiration, nil
+	}
+	return &metav1.Time{Time: override.Expiration}, nil
+}
+
+// HasGCHold returns whether a backup is kept from being garbage-collected by
+// its GC hold label.
+func HasGCHold(backup *velerov1api.Backup) bool {
+	return backup.Labels[velerov1api.GCHoldLabel] == "true"
+}
+
+// ExpirationOverrideAnnotations validates an expiration override for a
+// backup, and returns the annotations that set it. The override's
+// RequestedAt is set to now, and its expiration must be in the future and
+// later than the backup's current expiration.
+func ExpirationOverrideAnnotations(backup *velerov1api.Backup, override ExpirationOverride, now time.Time) (map[string]string, error) {
+	if !override.Expiration.After(now) {
+		return nil, errors.Errorf("expiration %s must be in the future", override.Expiration.Format(time.RFC3339))
+	}
+
+	// an invalid existing override is replaced, so its error is ignored.
+	current, _ := GetExpiration(backup)
+	if current != nil && !override.Expiration.After(current.Time) {
+		return nil, errors.Errorf("expiration %s must be later than the backup's current expiration %s", override.Expiration.Format(time.RFC3339), current.Time.Format(time.RFC3339))
+	}
+
+	return map[string]string{
+		velerov1api.ExpirationOverrideAnnotation:            override.Expiration.UTC().Format(time.RFC3339),
+		velerov1api.ExpirationOverrideRequestedByAnnotation: override.RequestedBy,
+		velerov1api.ExpirationOverrideRequestedAtAnnotation: now.UTC().Format(time.RFC3339),
+		velerov1api.ExpirationOverrideReasonAnnotation:      override.Reason,
+	}, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetExpiration(t *testing.T) {
+	expiration := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		backup  *velerov1api.Backup
+		want    *metav1.Time
+		wantErr string
+	}{
+		{
+			name:   "backup without an override expires at its status.expiration",
+			backup: builder.ForBackup("velero", "backup-1").Expiration(expiration).Result(),
+			want:   &metav1.Time{Time: expiration},
+		},
+		{
+			name:   "unprocessed backup has no expiration",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+		},
+		{
+			name: "later override extends the expiration",
+			backup: builder.ForBackup("velero", "backup-1").Expiration(expiration).
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.ExpirationOverrideAnnotation, "2021-07-01T00:00:00Z",
+					velerov1api.ExpirationOverrideRequestedAtAnnotation, "2021-04-01T00:00:00Z",
+				)).
+				Result(),
+			want: &metav1.Time{Time: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "earlier override doesn't shorten the expiration",
+			backup: builder.ForBackup("velero", "backup-1").Expiration(expiration).
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.ExpirationOverrideAnnotation, "2021-05-01T00:00:00Z",
+					velerov1api.ExpirationOverrideRequestedAtAnnotation, "2021-04-01T00:00:00Z",
+				)).
+				Result(),
+			want: &metav1.Time{Time: expiration},
+		},
+		{
+			name: "invalid override is an error",
+			backup: builder.ForBackup("velero", "backup-1").Expiration(expiration).
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.ExpirationOverrideAnnotation, "next week",
+					velerov1api.ExpirationOverrideRequestedAtAnnotation, "2021-04-01T00:00:00Z",
+				)).
+				Result(),
+			want:    &metav1.Time{Time: expiration},
+			wantErr: `invalid velero.io/expiration-override annotation "next week", must be an RFC 3339 time`,
+		},
+		{
+			name: "override without a requested-at time is an error",
+			backup: builder.ForBackup("velero", "backup-1").Expiration(expiration).
+				ObjectMeta(builder.WithAnnotations(velerov1api.ExpirationOverrideAnnotation, "2021-07-01T00:00:00Z")).
+				Result(),
+			want:    &metav1.Time{Time: expiration},
+			wantErr: `invalid velero.io/expiration-override-requested-at annotation "", must be an RFC 3339 time`,
+		},
+		{
+			name: "override that had passed when it was requested is an error",
+			backup: builder.ForBackup("velero", "backup-1").Expiration(expiration).
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.ExpirationOverrideAnnotation, "2021-07-01T00:00:00Z",
+					velerov1api.ExpirationOverrideRequestedAtAnnotation, "2021-08-01T00:00:00Z",
+				)).
+				Result(),
+			want:    &metav1.Time{Time: expiration},
+			wantErr: "expiration override 2021-07-01T00:00:00Z must be later than when it was requested, 2021-08-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GetExpiration(tc.backup)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.want == nil {
+				assert.Nil(t, got)
+			} else {
+				require.NotNil(t, got)
+				assert.True(t, tc.want.Equal(got), "want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestExpirationOverrideAnnotations(t *testing.T) {
+	now := time.Date(2021, 5, 15, 12, 0, 0, 0, time.UTC)
+	backup := builder.ForBackup("velero", "backup-1").Expiration(now.Add(24 * time.Hour)).Result()
+
+	annotations, err := ExpirationOverrideAnnotations(backup, ExpirationOverride{
+		Expiration:  now.Add(30 * 24 * time.Hour),
+		RequestedBy: "jane",
+		Reason:      "incident 42",
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		velerov1api.ExpirationOverrideAnnotation:            "2021-06-14T12:00:00Z",
+		velerov1api.ExpirationOverrideRequestedByAnnotation: "jane",
+		velerov1api.ExpirationOverrideRequestedAtAnnotation: "2021-05-15T12:00:00Z",
+		velerov1api.ExpirationOverrideReasonAnnotation:      "incident 42",
+	}, annotations)
+
+	_, err = ExpirationOverrideAnnotations(backup, ExpirationOverride{Expiration: now.Add(-time.Hour)}, now)
+	assert.EqualError(t, err, "expiration 2021-05-15T11:00:00Z must be in the future")
+
+	_, err = ExpirationOverrideAnnotations(backup, ExpirationOverride{Expiration: now.Add(time.Hour)}, now)
+	assert.EqualError(t, err, "expiration 2021-05-15T13:00:00Z must be later than the backup's current expiration 2021-05-16T12:00:00Z")
+}
+
+func TestHasGCHold(t *testing.T) {
+	assert.False(t, HasGCHold(builder.ForBackup("velero", "backup-1").Result()))
+	assert.False(t, HasGCHold(builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(velerov1api.GCHoldLabel, "false")).Result()))
+	assert.True(t, HasGCHold(builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels(velerov1api.GCHoldLabel, "true")).Result()))
+}
@@ -57,13 +57,18 @@ type itemBackupper struct {
 
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
+
+	// volumeSnapshotPool, if non-nil, creates volume snapshots concurrently.
+	// Otherwise, they're created serially as each persistent volume is
+	// backed up.
+	volumeSnapshotPool *volumeSnapshotPool
 }
 
 // backupItem backs up an individual item to tarWriter. The item may be excluded based on the
 // namespaces IncludesExcludes list.
 // In addition to the error return, backupItem also returns a bool indicating whether the item
 // was actually backed up.
-func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource) (bool, error) {
+func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstructured, groupResource schema.GroupResource, preferredGVR schema.GroupVersionResource) (backedUp bool, err error) {
 	metadata, err := meta.Accessor(obj)
 	if err != nil {
 		return false, err
@@ -71,6 +76,7 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 
 	namespace := metadata.GetNamespace()
 	name := metadata.GetName()
+	resourceVersion := metadata.GetResourceVersion()
 
 	log := logger.WithField("name", name)
 	log = log.WithField("resource", groupResource.String())
@@ -84,20 +90,47 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 
 	// NOTE: we have to re-check namespace & resource includes/excludes because it's possible that
 	// backupItem can be invoked by a custom action.
-	if namespace != "" && !ib.backupRequest.NamespaceIncludesExcludes.ShouldInclude(namespace) {
-		log.Info("Excluding item because namespace is excluded")
-		return false, nil
+	if namespace != "" {
+		if include, reason := ib.backupRequest.NamespaceIncludesExcludes.ShouldIncludeWithReason(namespace); !include {
+			log.WithField("reason", reason).Info("Excluding item because namespace is excluded")
+			ib.backupRequest.filteredItems().Namespaces++
+			return false, nil
+		}
 	}
 
 	// NOTE: we specifically allow namespaces to be backed up even if IncludeClusterResources is
 	// false.
-	if namespace == "" && groupResource != kuberesource.Namespaces && ib.backupRequest.Spec.IncludeClusterResources != nil && !*ib.backupRequest.Spec.IncludeClusterResources {
+	// APIServices are included or excluded by backup.spec.includeAPIServices when it's set.
+	if groupResource == kuberesource.APIServices && ib.backupRequest.Spec.IncludeAPIServices != nil {
+		if !*ib.backupRequest.Spec.IncludeAPIServices {
+			log.Info("Excluding item because backup.spec.includeAPIServices is false")
+			return false, nil
+		}
+	} else if namespace == "" && groupResource != kuberesource.Namespaces && ib.backupRequest.Spec.IncludeClusterResources != nil && !*ib.backupRequest.Spec.IncludeClusterResources {
 		log.Info("Excluding item because resource is cluster-scoped and backup.spec.includeClusterResources is false")
 		return false, nil
 	}
 
-	if !ib.backupRequest.ResourceIncludesExcludes.ShouldInclude(groupResource.String()) {
-		log.Info("Excluding item because resource is excluded")
+	if include, reason := ib.backupRequest.ResourceIncludesExcludes.ShouldIncludeWithReason(groupResource.String()); !include {
+		log.WithField("reason", reason).Info("Excluding item because resource is excluded")
+		ib.backupRequest.filteredItems().Resources++
+		return false, nil
+	}
+
+	if include, reason := ib.backupRequest.shouldIncludeItem(groupResource, namespace, name); !include {
+		log.WithField("reason", reason).Info("Excluding item because it's excluded by the item filter")
+		ib.backupRequest.filteredItems().ItemFilter++
+		return false, nil
+	}
+
+	if ib.backupRequest.isDefaultResource(groupResource, namespace, name) {
+		log.Info("Excluding item because it's a default resource")
+		ib.backupRequest.filteredItems().DefaultResources++
+		return false, nil
+	}
+
+	if excluded := ib.backupRequest.Spec.ExcludedAnnotation; excluded.Matches(metadata.GetAnnotations()) {
+		log.WithField("reason", fmt.Sprintf("item has annotation %s", excluded)).Info("Excluding item because it has an excluded annotation")
 		return false, nil
 	}
 
@@ -119,14 +152,20 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	}
 	ib.backupRequest.BackedUpItems[key] = struct{}{}
 
+	// the item's namespace and name are captured now, since actions and
+	// namespace mappers can change them.
+	defer func(namespace, name string) {
+		if err != nil {
+			ib.backupRequest.recordFailedItem(groupResource, namespace, name, err)
+		}
+	}(namespace, name)
+
 	log.Info("Backing up item")
 
-	// Because we don't want to use hooks.
-	//
-	//log.Debug("Executing pre hooks")
-	//if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePre); err != nil {
-	//	return false, err
-	//}
+	log.Debug("Executing pre hooks")
+	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePre); err != nil {
+		return false, err
+	}
 
 	var (
 		backupErrs            []error
@@ -170,17 +209,22 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	// Used on filepath to backup up all groups and versions
 	version := resourceVersion(obj)
 
+	// the snapshots of the volumes backed up as the item's additional items
+	// are queued while its actions run.
+	snapshotMark := ib.volumeSnapshotMark()
+
 	updatedObj, err := ib.executeActions(log, obj, groupResource, name, namespace, metadata)
 	if err != nil {
 		backupErrs = append(backupErrs, err)
 
 		// if there was an error running actions, execute post hooks and return
-		// Because we don't want to use hooks.
-		//
-		//log.Debug("Executing post hooks")
-		//if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
-		//	backupErrs = append(backupErrs, err)
-		//}
+		if groupResource == kuberesource.Pods {
+			ib.waitForVolumeSnapshots(log, snapshotMark)
+		}
+		log.Debug("Executing post hooks")
+		if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+			backupErrs = append(backupErrs, err)
+		}
 
 		return false, kubeerrs.NewAggregate(backupErrs)
 	}
@@ -207,12 +251,16 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		backupErrs = append(backupErrs, errs...)
 	}
 
-	// Because we don't want to use hooks.
-	//
-	//log.Debug("Executing post hooks")
-	//if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
-	//	backupErrs = append(backupErrs, err)
-	//}
+	// a pod's post hooks, such as unfreezing its file systems, must only run
+	// once its volumes' snapshots have been taken.
+	if groupResource == kuberesource.Pods {
+		ib.waitForVolumeSnapshots(log, snapshotMark)
+	}
+
+	log.Debug("Executing post hooks")
+	if err := ib.itemHookHandler.HandleHooks(log, groupResource, obj, ib.backupRequest.ResourceHooks, hook.PhasePost); err != nil {
+		backupErrs = append(backupErrs, err)
+	}
 
 	if len(backupErrs) != 0 {
 		return false, kubeerrs.NewAggregate(backupErrs)
@@ -221,7 +269,16 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	// Getting the preferred group version of this resource
 	preferredVersion := preferredGVR.Version
 
-	var filePath string
+	// namespaces are renamed after actions, snapshots and pod volume backups,
+	// which need the item's real namespace.
+	if err := ib.backupRequest.archiveNamespaces(obj, groupResource); err != nil {
+		return false, err
+	}
+	if metadata, err = meta.Accessor(obj); err != nil {
+		return false, errors.WithStack(err)
+	}
+	name = metadata.GetName()
+	namespace = metadata.GetNamespace()
 
 	// API Group version is now part of path of backup as a subdirectory
 	// it will add a prefix to subdirectory name for the preferred version
@@ -231,44 +288,34 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		versionPath = version + velerov1api.PreferredVersionDir
 	}
 
-	if namespace != "" {
-		filePath = filepath.Join(velerov1api.ResourcesDir, groupResource.String(), versionPath, velerov1api.NamespaceScopedDir, namespace, name+".json")
-	} else {
-		filePath = filepath.Join(velerov1api.ResourcesDir, groupResource.String(), versionPath, velerov1api.ClusterScopedDir, name+".json")
-	}
+	files := []string{itemFilePath(groupResource, versionPath, namespace, name)}
 
-	itemBytes, err := json.Marshal(obj.UnstructuredContent())
-	if err != nil {
-		return false, errors.WithStack(err)
-	}
+	// backing up the preferred version backup without API Group version on path -  this is for backward compatibility
 
-	hdr := &tar.Header{
-		Name:     filePath,
-		Size:     int64(len(itemBytes)),
-		Typeflag: tar.TypeReg,
-		Mode:     0755,
-		ModTime:  time.Now(),
+	log.Debugf("Resource %s/%s, version= %s, preferredVersion=%s", groupResource.String(), name, version, preferredVersion)
+	if version == preferredVersion {
+		files = append(files, itemFilePath(groupResource, "", namespace, name))
 	}
 
-	if err := ib.tarWriter.WriteHeader(hdr); err != nil {
-		return false, errors.WithStack(err)
+	// actions, snapshots and pod volume backups still run for items that
+	// haven't changed, since their data and additional items may have.
+	if base, ok := ib.backupRequest.unchangedItem(key, resourceVersion, files); ok {
+		log.Debugf("Item hasn't changed since backup %s, not writing it to the tarball", base)
+		ib.backupRequest.indexItem(key, resourceVersion, base, files)
+		return true, nil
 	}
 
-	if _, err := ib.tarWriter.Write(itemBytes); err != nil {
+	// excluded fields are removed last, so that actions and snapshots still
+	// see the whole item.
+	removeExcludedFields(obj.UnstructuredContent(), groupResource, ib.backupRequest.ExcludedFields)
+
+	itemBytes, err := json.Marshal(obj.UnstructuredContent())
+	if err != nil {
 		return false, errors.WithStack(err)
 	}
 
-	// backing up the preferred version backup without API Group version on path -  this is for backward compatibility
-
-	log.Debugf("Resource %s/%s, version= %s, preferredVersion=%s", groupResource.String(), name, version, preferredVersion)
-	if version == preferredVersion {
-		if namespace != "" {
-			filePath = filepath.Join(velerov1api.ResourcesDir, groupResource.String(), velerov1api.NamespaceScopedDir, namespace, name+".json")
-		} else {
-			filePath = filepath.Join(velerov1api.ResourcesDir, groupResource.String(), velerov1api.ClusterScopedDir, name+".json")
-		}
-
-		hdr = &tar.Header{
+	for _, filePath := range files {
+		hdr := &tar.Header{
 			Name:     filePath,
 			Size:     int64(len(itemBytes)),
 			Typeflag: tar.TypeReg,
@@ -285,9 +332,19 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		}
 	}
 
+	ib.backupRequest.indexItem(key, resourceVersion, ib.backupRequest.Name, files)
 	return true, nil
 }
 
+// itemFilePath returns the path of an item's file in the backup tarball,
+// under versionPath if it isn't empty.
+func itemFilePath(groupResource schema.GroupResource, versionPath, namespace, name string) string {
+	if namespace != "" {
+		return filepath.Join(velerov1api.ResourcesDir, groupResource.String(), versionPath, velerov1api.NamespaceScopedDir, namespace, name+".json")
+	}
+	return filepath.Join(velerov1api.ResourcesDir, groupResource.String(), versionPath, velerov1api.ClusterScopedDir, name+".json")
+}
+
 // backupPodVolumes triggers restic backups of the specified pod volumes, and returns a list of PodVolumeBackups
 // for volumes that were successfully backed up, and a slice of any errors that were encountered.
 func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api.Pod, volumes []string) ([]*velerov1api.PodVolumeBackup, []error) {
@@ -493,22 +550,67 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		return errors.WithMessage(err, "error getting volume info")
 	}
 
-	log.Info("Snapshotting persistent volume")
 	snapshot := volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, volumeType, pvFailureDomainZone, location, iops)
+	ib.backupRequest.startSnapshotting()
+
+	// The snapshot is recorded in the backup request once it's been created
+	// by the pool, so a failure is reported against this volume without
+	// failing the backup of the persistent volume itself.
+	if ib.volumeSnapshotPool != nil {
+		log.Info("Queueing snapshot of persistent volume")
+		ib.volumeSnapshotPool.submit(&volumeSnapshotTask{
+			snapshot:          snapshot,
+			volumeSnapshotter: volumeSnapshotter,
+			tags:              tags,
+			log:               log,
+		})
+		return nil
+	}
 
-	var errs []error
+	err = createVolumeSnapshot(volumeSnapshotter, snapshot, tags, log)
+	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+
+	return err
+}
+
+// volumeSnapshotMark returns a marker for the volume snapshots queued so far,
+// so that the ones queued after it can be waited on with
+// waitForVolumeSnapshots.
+func (ib *itemBackupper) volumeSnapshotMark() int {
+	if ib.volumeSnapshotPool == nil {
+		return 0
+	}
+	return ib.volumeSnapshotPool.mark()
+}
+
+// waitForVolumeSnapshots blocks until the volume snapshots queued since mark
+// was returned by volumeSnapshotMark have been created. Volume snapshots
+// that aren't queued are created before their persistent volumes' backups
+// return, so there's nothing to wait for without a pool.
+func (ib *itemBackupper) waitForVolumeSnapshots(log logrus.FieldLogger, mark int) {
+	if ib.volumeSnapshotPool == nil {
+		return
+	}
+
+	tasks := ib.volumeSnapshotPool.waitSince(mark)
+	if len(tasks) > 0 {
+		log.Infof("Waited for %d volume snapshots to complete", len(tasks))
+	}
+}
+
+// createVolumeSnapshot creates a snapshot of the snapshot's provider volume and
+// updates its status with the result.
+func createVolumeSnapshot(volumeSnapshotter velero.VolumeSnapshotter, snapshot *volume.Snapshot, tags map[string]string, log logrus.FieldLogger) error {
+	log.Info("Snapshotting persistent volume")
 	snapshotID, err := volumeSnapshotter.CreateSnapshot(snapshot.Spec.ProviderVolumeID, snapshot.Spec.VolumeAZ, tags)
 	if err != nil {
-		errs = append(errs, errors.Wrap(err, "error taking snapshot of volume"))
 		snapshot.Status.Phase = volume.SnapshotPhaseFailed
-	} else {
-		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
-		snapshot.Status.ProviderSnapshotID = snapshotID
+		return errors.Wrap(err, "error taking snapshot of volume")
 	}
-	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
 
-	// nil errors are automatically removed
-	return kubeerrs.NewAggregate(errs)
+	snapshot.Status.Phase = volume.SnapshotPhaseCompleted
+	snapshot.Status.ProviderSnapshotID = snapshotID
+	return nil
 }
 
 func volumeSnapshot(backup *velerov1api.Backup, volumeName, volumeID, volumeType, az, location string, iops *int64) *volume.Snapshot {
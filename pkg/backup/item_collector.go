@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -45,6 +46,7 @@ type itemCollector struct {
 	dynamicFactory        client.DynamicFactory
 	cohabitatingResources map[string]*cohabitatingResource
 	dir                   string
+	aggregatedAPIServices map[schema.GroupVersion]aggregatedAPIService
 }
 
 type kubernetesResource struct {
@@ -55,6 +57,8 @@ type kubernetesResource struct {
 
 // getAllItems gets all relevant items from all API groups.
 func (r *itemCollector) getAllItems() []*kubernetesResource {
+	r.aggregatedAPIServices = getAggregatedAPIServices(r.log, r.discoveryHelper, r.dynamicFactory)
+
 	var resources []*kubernetesResource
 	for _, group := range r.discoveryHelper.Resources() {
 		groupItems, err := r.getGroupItems(r.log, group)
@@ -95,6 +99,7 @@ func (r *itemCollector) getGroupItems(log logrus.FieldLogger, group *metav1.APIR
 		}
 
 		items = append(items, resourceItems...)
+		items = append(items, r.getAdditionalVersionItems(log, gv, resource)...)
 	}
 
 	return items, nil
@@ -177,9 +182,17 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		return nil, errors.WithStack(err)
 	}
 
+	// APIServices are included or excluded by the IncludeAPIServices setting when it's set, rather
+	// than IncludeClusterResources.
+	explicitAPIServices := gr == kuberesource.APIServices && r.backupRequest.Spec.IncludeAPIServices != nil
+	if explicitAPIServices && !*r.backupRequest.Spec.IncludeAPIServices {
+		log.Info("Skipping resource because backup.spec.includeAPIServices is false")
+		return nil, nil
+	}
+
 	// If the resource we are backing up is NOT namespaces, and it is cluster-scoped, check to see if
 	// we should include it based on the IncludeClusterResources setting.
-	if gr != kuberesource.Namespaces && clusterScoped {
+	if gr != kuberesource.Namespaces && clusterScoped && !explicitAPIServices {
 		if r.backupRequest.Spec.IncludeClusterResources == nil {
 			if !r.backupRequest.NamespaceIncludesExcludes.IncludeEverything() {
 				// when IncludeClusterResources == nil (auto), only directly
@@ -198,8 +211,8 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		}
 	}
 
-	if !r.backupRequest.ResourceIncludesExcludes.ShouldInclude(gr.String()) {
-		log.Infof("Skipping resource because it's excluded")
+	if include, reason := r.backupRequest.ResourceIncludesExcludes.ShouldIncludeWithReason(gr.String()); !include {
+		log.WithField("reason", reason).Infof("Skipping resource because it's excluded")
 		return nil, nil
 	}
 
@@ -216,6 +229,20 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		cohabitator.seen = true
 	}
 
+	aggregatedAPIService, aggregated := r.aggregatedAPIServices[gv]
+	if aggregated && !aggregatedAPIService.available {
+		log.WithFields(logrus.Fields{
+			"apiService": aggregatedAPIService.name,
+			"reason":     aggregatedAPIService.message,
+		}).Warn("Skipping resource because the aggregated API server serving it is unavailable")
+		return nil, nil
+	}
+
+	labelSelector, err := r.labelSelectorFor(gr)
+	if err != nil {
+		return nil, err
+	}
+
 	namespacesToList := getNamespacesToList(r.backupRequest.NamespaceIncludesExcludes)
 
 	// Check if we're backing up namespaces, and only certain ones
@@ -224,15 +251,6 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		if err != nil {
 			log.WithError(err).Error("Error getting dynamic client")
 		} else {
-			var labelSelector labels.Selector
-			if r.backupRequest.Spec.LabelSelector != nil {
-				labelSelector, err = metav1.LabelSelectorAsSelector(r.backupRequest.Spec.LabelSelector)
-				if err != nil {
-					// This should never happen...
-					return nil, errors.Wrap(err, "invalid label selector")
-				}
-			}
-
 			var items []*kubernetesResource
 			for _, ns := range namespacesToList {
 				log = log.WithField("namespace", ns)
@@ -244,8 +262,15 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 				}
 
 				labels := labels.Set(unstructured.GetLabels())
-				if labelSelector != nil && !labelSelector.Matches(labels) {
-					log.Info("Skipping namespace because it does not match the backup's label selector")
+				if !labelSelector.Matches(labels) {
+					log.Info("Skipping namespace because it does not match the backup's label selectors")
+					r.backupRequest.filteredItems().LabelSelector++
+					continue
+				}
+
+				if include, reason := r.backupRequest.shouldIncludeItem(gr, "", ns); !include {
+					log.WithField("reason", reason).Info("Skipping namespace because it's excluded by the item filter")
+					r.backupRequest.filteredItems().ItemFilter++
 					continue
 				}
 
@@ -283,14 +308,15 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 			continue
 		}
 
-		var labelSelector string
-		if selector := r.backupRequest.Spec.LabelSelector; selector != nil {
-			labelSelector = metav1.FormatLabelSelector(selector)
-		}
-
 		log.Info("Listing items")
-		unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector})
+		unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector.String()})
 		if err != nil {
+			// aggregated API servers can fail without the Kubernetes API server
+			// noticing, so failing to list their resources doesn't fail the backup.
+			if aggregated {
+				log.WithError(errors.WithStack(err)).WithField("apiService", aggregatedAPIService.name).Warn("Error listing items served by an aggregated API server")
+				continue
+			}
 			log.WithError(errors.WithStack(err)).Error("Error listing items")
 			continue
 		}
@@ -300,8 +326,42 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		for i := range unstructuredList.Items {
 			item := &unstructuredList.Items[i]
 
-			if gr == kuberesource.Namespaces && !r.backupRequest.NamespaceIncludesExcludes.ShouldInclude(item.GetName()) {
-				log.WithField("name", item.GetName()).Info("Skipping namespace because it's excluded")
+			if gr == kuberesource.Namespaces {
+				if include, reason := r.backupRequest.NamespaceIncludesExcludes.ShouldIncludeWithReason(item.GetName()); !include {
+					log.WithFields(logrus.Fields{"name": item.GetName(), "reason": reason}).Info("Skipping namespace because it's excluded")
+					r.backupRequest.filteredItems().Namespaces++
+					continue
+				}
+			}
+
+			if explicitAPIServices {
+				if include, reason := includeAPIService(item, r.backupRequest.NamespaceIncludesExcludes); !include {
+					log.WithFields(logrus.Fields{"name": item.GetName(), "reason": reason}).Info("Skipping APIService")
+					continue
+				}
+			}
+
+			if include, reason := r.backupRequest.shouldIncludeItem(gr, item.GetNamespace(), item.GetName()); !include {
+				log.WithFields(logrus.Fields{"name": item.GetName(), "reason": reason}).Info("Skipping item because it's excluded by the item filter")
+				r.backupRequest.filteredItems().ItemFilter++
+				continue
+			}
+
+			if r.backupRequest.isDefaultResource(gr, item.GetNamespace(), item.GetName()) {
+				log.WithField("name", item.GetName()).Info("Skipping item because it's a default resource")
+				r.backupRequest.filteredItems().DefaultResources++
+				continue
+			}
+
+			if last, before := r.backupRequest.modifiedBefore(item); before {
+				log.WithFields(logrus.Fields{"name": item.GetName(), "lastModified": last.UTC().Format(time.RFC3339)}).Info("Skipping item because it wasn't modified in the backup's modifiedSince window")
+				r.backupRequest.filteredItems().ModifiedSince++
+				continue
+			}
+
+			if owner, excluded := r.backupRequest.excludedOwner(gr, item); excluded {
+				log.WithFields(logrus.Fields{"name": item.GetName(), "owner": owner.Kind + "/" + owner.Name}).Info("Skipping item because its owner's kind is excluded")
+				r.backupRequest.filteredItems().OwnerKind++
 				continue
 			}
 
@@ -327,6 +387,38 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 	return items, nil
 }
 
+// labelSelectorFor returns the label selector that items of the given
+// group-resource must match: the backup's label selector, ANDed with the
+// resource's own label selector if it has one.
+func (r *itemCollector) labelSelectorFor(gr schema.GroupResource) (labels.Selector, error) {
+	selector := labels.Everything()
+	if r.backupRequest.Spec.LabelSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(r.backupRequest.Spec.LabelSelector)
+		if err != nil {
+			// This should never happen...
+			return nil, errors.Wrap(err, "invalid label selector")
+		}
+	}
+
+	resourceSelector, ok := r.backupRequest.ResourceLabelSelectors[gr]
+	if !ok {
+		// cohabitating resources are only listed from one of their API groups,
+		// so a selector for either group applies.
+		if cohabitator, found := r.cohabitatingResources[gr.Resource]; found {
+			if resourceSelector, ok = r.backupRequest.ResourceLabelSelectors[cohabitator.groupResource1]; !ok {
+				resourceSelector, ok = r.backupRequest.ResourceLabelSelectors[cohabitator.groupResource2]
+			}
+		}
+	}
+	if !ok {
+		return selector, nil
+	}
+
+	requirements, _ := resourceSelector.Requirements()
+	return selector.Add(requirements...), nil
+}
+
 func (r *itemCollector) writeToFile(item *unstructured.Unstructured) (string, error) {
 	f, err := ioutil.TempFile(r.dir, "")
 	if err != nil {
@@ -0,0 +1,107 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ValidateItemFailureThresholds returns the errors in a backup's item
+// failure thresholds.
+func ValidateItemFailureThresholds(thresholds *velerov1api.ItemFailureThresholds) []error {
+	if thresholds == nil {
+		return nil
+	}
+
+	var errs []error
+	if thresholds.MaxFailedItems != nil && *thresholds.MaxFailedItems < 0 {
+		errs = append(errs, errors.Errorf("maxFailedItems must not be negative, got %d", *thresholds.MaxFailedItems))
+	}
+	if thresholds.MaxFailedItemsPercent != nil && (*thresholds.MaxFailedItemsPercent < 0 || *thresholds.MaxFailedItemsPercent > 100) {
+		errs = append(errs, errors.Errorf("maxFailedItemsPercent must be between 0 and 100, got %d", *thresholds.MaxFailedItemsPercent))
+	}
+	for _, resource := range thresholds.CriticalResources {
+		if resource == "" {
+			errs = append(errs, errors.New("critical resources must not be empty"))
+		}
+	}
+	return errs
+}
+
+// getCriticalResources resolves a backup's critical resources against the
+// cluster, returning nil if it doesn't have any.
+func getCriticalResources(helper discovery.Helper, thresholds *velerov1api.ItemFailureThresholds) *collections.IncludesExcludes {
+	if thresholds == nil || len(thresholds.CriticalResources) == 0 {
+		return nil
+	}
+	return collections.GetResourceIncludesExcludes(helper, thresholds.CriticalResources, nil)
+}
+
+// ItemFailures summarizes the items that failed to be backed up, returning
+// nil if none did.
+func (r *Request) ItemFailures() *velerov1api.BackupItemFailures {
+	if len(r.FailedItems) == 0 {
+		return nil
+	}
+
+	failures := &velerov1api.BackupItemFailures{
+		FailedItems:    len(r.FailedItems),
+		AttemptedItems: len(r.BackedUpItems),
+	}
+	if failures.AttemptedItems > 0 {
+		failures.FailedPercent = fmt.Sprintf("%.2f", 100*float64(failures.FailedItems)/float64(failures.AttemptedItems))
+	}
+	if r.CriticalResources != nil {
+		for _, item := range r.FailedItems {
+			if r.CriticalResources.ShouldInclude(item.Resource) {
+				failures.CriticalFailedItems++
+			}
+		}
+	}
+	return failures
+}
+
+// ItemFailuresWithinThresholds returns whether a backup with errorCount
+// logged errors is within its item failure thresholds, meaning that all of
+// its errors are those of its failed items, and its failed items are within
+// every threshold that's set. Backups without thresholds never are.
+func (r *Request) ItemFailuresWithinThresholds(errorCount int) bool {
+	thresholds := r.Spec.ItemFailureThresholds
+	if thresholds == nil || (thresholds.MaxFailedItems == nil && thresholds.MaxFailedItemsPercent == nil) {
+		return false
+	}
+	if errorCount > r.ItemErrors {
+		return false
+	}
+
+	failed, attempted := len(r.FailedItems), len(r.BackedUpItems)
+	if thresholds.MaxFailedItems != nil && failed > *thresholds.MaxFailedItems {
+		return false
+	}
+	// the percentage is compared as failed/attempted <= max/100, without
+	// rounding.
+	if thresholds.MaxFailedItemsPercent != nil && 100*failed > *thresholds.MaxFailedItemsPercent*attempted {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// newThresholdsRequest returns a request for a backup with thresholds that
+// attempted 200 items.
+func newThresholdsRequest(thresholds *velerov1api.ItemFailureThresholds) *Request {
+	req := &Request{
+		Backup:        builder.ForBackup("velero", "backup-1").ItemFailureThresholds(thresholds).Result(),
+		BackedUpItems: map[itemKey]struct{}{},
+	}
+	for i := 0; i < 200; i++ {
+		req.BackedUpItems[itemKey{resource: "pods", namespace: "ns-1", name: fmt.Sprintf("pod-%d", i)}] = struct{}{}
+	}
+	return req
+}
+
+func TestRequestItemFailures(t *testing.T) {
+	req := newThresholdsRequest(&velerov1api.ItemFailureThresholds{CriticalResources: []string{"secrets"}})
+	assert.Nil(t, req.ItemFailures())
+
+	req.CriticalResources = collections.NewIncludesExcludes().Includes("secrets")
+	req.recordFailedItem(kuberesource.Pods, "ns-1", "pod-1", errors.New("error 1"))
+	req.recordFailedItem(kuberesource.Pods, "ns-1", "pod-2", errors.New("error 2"))
+	req.recordFailedItem(kuberesource.Secrets, "ns-1", "secret-1", errors.New("error 3"))
+
+	assert.Equal(t, &velerov1api.BackupItemFailures{
+		FailedItems:         3,
+		AttemptedItems:      200,
+		FailedPercent:       "1.50",
+		CriticalFailedItems: 1,
+	}, req.ItemFailures())
+}
+
+func TestRequestItemFailuresWithinThresholds(t *testing.T) {
+	two, one := 2, 1
+
+	tests := []struct {
+		name        string
+		thresholds  *velerov1api.ItemFailureThresholds
+		failedItems int
+		itemErrors  int
+		errorCount  int
+		want        bool
+	}{
+		{
+			name:        "backup without thresholds isn't within them",
+			failedItems: 1,
+			itemErrors:  1,
+			errorCount:  1,
+		},
+		{
+			name:        "thresholds with only critical resources aren't within them",
+			thresholds:  &velerov1api.ItemFailureThresholds{CriticalResources: []string{"secrets"}},
+			failedItems: 1,
+			itemErrors:  1,
+			errorCount:  1,
+		},
+		{
+			name:        "failed items up to the max are within the thresholds",
+			thresholds:  &velerov1api.ItemFailureThresholds{MaxFailedItems: &two},
+			failedItems: 2,
+			itemErrors:  3,
+			errorCount:  3,
+			want:        true,
+		},
+		{
+			name:        "failed items over the max aren't within the thresholds",
+			thresholds:  &velerov1api.ItemFailureThresholds{MaxFailedItems: &two},
+			failedItems: 3,
+			itemErrors:  3,
+			errorCount:  3,
+		},
+		{
+			name:        "failed percentage up to the max is within the thresholds",
+			thresholds:  &velerov1api.ItemFailureThresholds{MaxFailedItemsPercent: &one},
+			failedItems: 2,
+			itemErrors:  2,
+			errorCount:  2,
+			want:        true,
+		},
+		{
+			name:        "failed percentage over the max isn't within the thresholds",
+			thresholds:  &velerov1api.ItemFailureThresholds{MaxFailedItemsPercent: &one},
+			failedItems: 3,
+			itemErrors:  3,
+			errorCount:  3,
+		},
+		{
+			name:        "both thresholds must be met",
+			thresholds:  &velerov1api.ItemFailureThresholds{MaxFailedItems: &two, MaxFailedItemsPercent: &one},
+			failedItems: 3,
+			itemErrors:  3,
+			errorCount:  3,
+		},
+		{
+			name:        "errors that aren't those of items aren't within the thresholds",
+			thresholds:  &velerov1api.ItemFailureThresholds{MaxFailedItems: &two},
+			failedItems: 1,
+			itemErrors:  1,
+			errorCount:  2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := newThresholdsRequest(tc.thresholds)
+			for i := 0; i < tc.failedItems; i++ {
+				req.recordFailedItem(kuberesource.Pods, "ns-1", fmt.Sprintf("pod-%d", i), errors.New("error"))
+			}
+			req.ItemErrors = tc.itemErrors
+
+			assert.Equal(t, tc.want, req.ItemFailuresWithinThresholds(tc.errorCount))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// getModifiedSince returns the time before which objects weren't modified
+// in a backup's modifiedSince window, which starts that long before the
+// backup started, or the zero time if the backup doesn't have a window.
+func getModifiedSince(backup *velerov1api.Backup, now time.Time) time.Time {
+	if backup.Spec.ModifiedSince == nil || backup.Spec.ModifiedSince.Duration <= 0 {
+		return time.Time{}
+	}
+
+	start := now
+	if backup.Status.StartTimestamp != nil {
+		start = backup.Status.StartTimestamp.Time
+	}
+	return start.Add(-backup.Spec.ModifiedSince.Duration)
+}
+
+// lastModified returns the latest of an object's creation timestamp and the
+// times of its managed fields, and whether it's known. It isn't known for
+// objects without managed fields that have a time, since their creation
+// timestamp doesn't say whether they were modified since.
+func lastModified(item *unstructured.Unstructured) (time.Time, bool) {
+	var last time.Time
+	for _, entry := range item.GetManagedFields() {
+		if entry.Time != nil && entry.Time.After(last) {
+			last = entry.Time.Time
+		}
+	}
+	if last.IsZero() {
+		return time.Time{}, false
+	}
+
+	if created := item.GetCreationTimestamp(); created.After(last) {
+		last = created.Time
+	}
+	return last, true
+}
+
+// modifiedBefore returns whether an object was last modified before the
+// backup's modifiedSince window, along with the time it was. Objects whose
+// last modification time isn't known are never left out.
+func (r *Request) modifiedBefore(item *unstructured.Unstructured) (time.Time, bool) {
+	if r.ModifiedSince.IsZero() {
+		return time.Time{}, false
+	}
+
+	last, known := lastModified(item)
+	if !known {
+		return time.Time{}, false
+	}
+	return last, last.Before(r.ModifiedSince)
+}
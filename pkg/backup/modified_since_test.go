@@ -0,0 +1,132 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestGetModifiedSince(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	started := time.Date(2021, 6, 1, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		window *metav1.Duration
+		start  *time.Time
+		want   time.Time
+	}{
+		{
+			name: "backup without a window has no cutoff",
+		},
+		{
+			name:   "zero window has no cutoff",
+			window: &metav1.Duration{},
+		},
+		{
+			name:   "window starts before the backup's start timestamp",
+			window: &metav1.Duration{Duration: 6 * time.Hour},
+			start:  &started,
+			want:   started.Add(-6 * time.Hour),
+		},
+		{
+			name:   "window starts before now if the backup hasn't started",
+			window: &metav1.Duration{Duration: 6 * time.Hour},
+			want:   now.Add(-6 * time.Hour),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := builder.ForBackup("velero", "backup-1").ModifiedSince(tc.window).Result()
+			if tc.start != nil {
+				backup.Status.StartTimestamp = &metav1.Time{Time: *tc.start}
+			}
+			assert.Equal(t, tc.want, getModifiedSince(backup, now))
+		})
+	}
+}
+
+func TestModifiedBefore(t *testing.T) {
+	cutoff := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	item := func(created time.Time, managed ...time.Time) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"}}
+		obj.SetName("cm-1")
+		obj.SetCreationTimestamp(metav1.Time{Time: created})
+		var entries []metav1.ManagedFieldsEntry
+		for i := range managed {
+			entries = append(entries, metav1.ManagedFieldsEntry{Manager: "kubectl", Time: &metav1.Time{Time: managed[i]}})
+		}
+		obj.SetManagedFields(entries)
+		return obj
+	}
+
+	tests := []struct {
+		name          string
+		modifiedSince time.Time
+		item          *unstructured.Unstructured
+		wantLast      time.Time
+		wantBefore    bool
+	}{
+		{
+			name:          "items aren't left out without a window",
+			modifiedSince: time.Time{},
+			item:          item(cutoff.Add(-time.Hour), cutoff.Add(-time.Hour)),
+		},
+		{
+			name:          "item last modified before the window is left out",
+			modifiedSince: cutoff,
+			item:          item(cutoff.Add(-3*time.Hour), cutoff.Add(-2*time.Hour), cutoff.Add(-time.Hour)),
+			wantLast:      cutoff.Add(-time.Hour),
+			wantBefore:    true,
+		},
+		{
+			name:          "item with a managed field modified in the window is kept",
+			modifiedSince: cutoff,
+			item:          item(cutoff.Add(-3*time.Hour), cutoff.Add(-2*time.Hour), cutoff.Add(time.Hour)),
+			wantLast:      cutoff.Add(time.Hour),
+		},
+		{
+			name:          "item created in the window is kept",
+			modifiedSince: cutoff,
+			item:          item(cutoff.Add(time.Hour), cutoff.Add(-time.Hour)),
+			wantLast:      cutoff.Add(time.Hour),
+		},
+		{
+			name:          "item without managed fields is kept whatever its creation timestamp",
+			modifiedSince: cutoff,
+			item:          item(cutoff.Add(-time.Hour)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Request{ModifiedSince: tc.modifiedSince}
+			last, before := r.modifiedBefore(tc.item)
+			assert.Equal(t, tc.wantBefore, before)
+			assert.True(t, tc.wantLast.Equal(last), "want last modified %s, got %s", tc.wantLast, last)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// DefaultExcludedOwnerKinds are the kinds of the built-in controllers that
+// recreate the objects they own, so that their objects are recreated on
+// restore too.
+var DefaultExcludedOwnerKinds = []string{
+	"ReplicaSet.apps",
+	"Deployment.apps",
+	"DaemonSet.apps",
+	"StatefulSet.apps",
+	"Job.batch",
+	"CronJob.batch",
+}
+
+// ValidateExcludedOwnerKind returns an error if kind isn't formatted as
+// "Kind.group" or "Kind".
+func ValidateExcludedOwnerKind(kind string) error {
+	if kind == "" || strings.HasPrefix(kind, ".") || strings.HasSuffix(kind, ".") || strings.ContainsAny(kind, "/* ") {
+		return errors.Errorf("owner kind %q must be formatted as Kind.group, such as ReplicaSet.apps, or as Kind for the core API group", kind)
+	}
+	return nil
+}
+
+// getExcludedOwnerKinds returns the set of a backup's excluded owner kinds,
+// as lowercase schema.GroupKind strings so that they're matched
+// case-insensitively.
+func getExcludedOwnerKinds(kinds []string) map[string]struct{} {
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	excluded := make(map[string]struct{}, len(kinds))
+	for _, kind := range kinds {
+		excluded[strings.ToLower(schema.ParseGroupKind(kind).String())] = struct{}{}
+	}
+	return excluded
+}
+
+// excludedOwner returns the first owner reference of an item whose kind is
+// excluded by the backup, if any. Pods with volumes that are backed up by
+// restic aren't excluded, since their volumes' data would be lost.
+func (r *Request) excludedOwner(groupResource schema.GroupResource, item *unstructured.Unstructured) (metav1.OwnerReference, bool) {
+	if len(r.ExcludedOwnerKinds) == 0 {
+		return metav1.OwnerReference{}, false
+	}
+
+	for _, owner := range item.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil {
+			continue
+		}
+		if _, ok := r.ExcludedOwnerKinds[strings.ToLower(gv.WithKind(owner.Kind).GroupKind().String())]; !ok {
+			continue
+		}
+
+		if groupResource == kuberesource.Pods && r.hasResticVolumes(item) {
+			return metav1.OwnerReference{}, false
+		}
+		return owner, true
+	}
+
+	return metav1.OwnerReference{}, false
+}
+
+func (r *Request) hasResticVolumes(item *unstructured.Unstructured) bool {
+	pod := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pod); err != nil {
+		// keep pods that can't be checked.
+		return true
+	}
+	return len(restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(r.Spec.DefaultVolumesToRestic))) > 0
+}
@@ -0,0 +1,93 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// redactedSecretValue replaces the data values of redacted secrets, base64
+// encoded like the rest of a secret's data. It's the same for every value,
+// since even a hash of a short or guessable value would give it away.
+const redactedSecretValue = "cmVkYWN0ZWQ=" // "redacted"
+
+// lastAppliedConfigAnnotation holds the configuration last applied with
+// kubectl apply, which includes the data values of secrets created that way.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// RedactSecretsAction is a backup item action that replaces the data values
+// of secrets with a placeholder when the backup redacts secrets.
+type RedactSecretsAction struct {
+	log logrus.FieldLogger
+}
+
+// NewRedactSecretsAction creates a new ItemAction for secrets.
+func NewRedactSecretsAction(logger logrus.FieldLogger) *RedactSecretsAction {
+	return &RedactSecretsAction{log: logger}
+}
+
+// AppliesTo returns a ResourceSelector that applies only to secrets.
+func (a *RedactSecretsAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{kuberesource.Secrets.String()},
+	}, nil
+}
+
+// Execute replaces each of the secret's data values with a placeholder if the
+// backup redacts secrets, keeping its keys, type and metadata. The redacted
+// secret is annotated so that restores of it fail rather than creating a
+// secret with the placeholder values.
+func (a *RedactSecretsAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if !boolptr.IsSetToTrue(backup.Spec.RedactSecrets) {
+		return item, nil, nil
+	}
+
+	obj := &unstructured.Unstructured{Object: item.UnstructuredContent()}
+	a.log.Infof("Redacting the data values of secret %s/%s", obj.GetNamespace(), obj.GetName())
+
+	data, _, err := unstructured.NestedMap(obj.Object, "data")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error getting secret data")
+	}
+	for key := range data {
+		data[key] = redactedSecretValue
+	}
+	if data != nil {
+		if err := unstructured.SetNestedMap(obj.Object, data, "data"); err != nil {
+			return nil, nil, errors.Wrap(err, "error setting secret data")
+		}
+	}
+	unstructured.RemoveNestedField(obj.Object, "stringData")
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	delete(annotations, lastAppliedConfigAnnotation)
+	annotations[v1.RedactedSecretAnnotation] = "true"
+	obj.SetAnnotations(annotations)
+
+	return obj, nil, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRedactSecretsActionExecute(t *testing.T) {
+	tests := []struct {
+		name     string
+		backup   *velerov1api.Backup
+		item     *unstructured.Unstructured
+		expected *unstructured.Unstructured
+	}{
+		{
+			name:   "secret isn't redacted unless the backup redacts secrets",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Secret",
+				"metadata": {"namespace": "ns-1", "name": "secret-1"},
+				"data": {"password": "aHVudGVyMg=="}
+			}
+			`),
+			expected: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Secret",
+				"metadata": {"namespace": "ns-1", "name": "secret-1"},
+				"data": {"password": "aHVudGVyMg=="}
+			}
+			`),
+		},
+		{
+			name:   "secret's data values are replaced and its keys and metadata are kept",
+			backup: builder.ForBackup("velero", "backup-1").RedactSecrets(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Secret",
+				"metadata": {
+					"namespace": "ns-1",
+					"name": "secret-1",
+					"labels": {"app": "db"},
+					"annotations": {
+						"kubectl.kubernetes.io/last-applied-configuration": "{\"data\":{\"password\":\"aHVudGVyMg==\"}}",
+						"owner": "team-a"
+					}
+				},
+				"type": "Opaque",
+				"data": {"username": "YWRtaW4=", "password": "aHVudGVyMg=="}
+			}
+			`),
+			expected: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Secret",
+				"metadata": {
+					"namespace": "ns-1",
+					"name": "secret-1",
+					"labels": {"app": "db"},
+					"annotations": {"owner": "team-a", "velero.io/redacted-secret": "true"}
+				},
+				"type": "Opaque",
+				"data": {"username": "cmVkYWN0ZWQ=", "password": "cmVkYWN0ZWQ="}
+			}
+			`),
+		},
+		{
+			name:   "secret without data is annotated",
+			backup: builder.ForBackup("velero", "backup-1").RedactSecrets(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Secret",
+				"metadata": {"namespace": "ns-1", "name": "secret-1"}
+			}
+			`),
+			expected: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Secret",
+				"metadata": {"namespace": "ns-1", "name": "secret-1", "annotations": {"velero.io/redacted-secret": "true"}}
+			}
+			`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewRedactSecretsAction(velerotest.NewLogger())
+
+			updated, additionalItems, err := a.Execute(tc.item, tc.backup)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, updated)
+			assert.Empty(t, additionalItems)
+		})
+	}
+}
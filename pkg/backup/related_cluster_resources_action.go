@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// relatedResourceResolver returns the cluster-scoped resources that a
+// namespaced item references.
+type relatedResourceResolver func(item runtime.Unstructured) ([]velero.ResourceIdentifier, error)
+
+// relatedResourceResolvers are the resolvers of the namespaced resources whose
+// references to cluster-scoped resources are followed, by group/kind.
+var relatedResourceResolvers = map[schema.GroupKind]relatedResourceResolver{
+	{Group: "", Kind: "Pod"}:                       podRelatedResources,
+	{Group: "", Kind: "PersistentVolumeClaim"}:     pvcRelatedResources,
+	{Group: rbacv1.GroupName, Kind: "RoleBinding"}: roleBindingRelatedResources,
+}
+
+// RelatedClusterResourcesAction implements ItemAction.
+type RelatedClusterResourcesAction struct {
+	log logrus.FieldLogger
+}
+
+// NewRelatedClusterResourcesAction creates a new ItemAction that adds the
+// cluster-scoped resources referenced by namespaced resources to the backup.
+func NewRelatedClusterResourcesAction(logger logrus.FieldLogger) *RelatedClusterResourcesAction {
+	return &RelatedClusterResourcesAction{log: logger}
+}
+
+// AppliesTo returns a ResourceSelector that applies to pods, persistent volume
+// claims and role bindings.
+func (a *RelatedClusterResourcesAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{
+			kuberesource.Pods.String(),
+			kuberesource.PersistentVolumeClaims.String(),
+			kuberesource.RoleBindings.String(),
+		},
+	}, nil
+}
+
+// Execute returns the cluster-scoped resources referenced by the item as
+// additional items if the backup includes related cluster resources. The
+// persistent volume of a persistent volume claim isn't returned, since the
+// PV action always adds it.
+func (a *RelatedClusterResourcesAction) Execute(item runtime.Unstructured, backup *v1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if !boolptr.IsSetToTrue(backup.Spec.IncludeRelatedClusterResources) {
+		return item, nil, nil
+	}
+
+	resolver, ok := relatedResourceResolvers[item.GetObjectKind().GroupVersionKind().GroupKind()]
+	if !ok {
+		return item, nil, nil
+	}
+
+	a.log.Info("Executing RelatedClusterResourcesAction")
+	defer a.log.Info("Done executing RelatedClusterResourcesAction")
+
+	additionalItems, err := resolver(item)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, additionalItem := range additionalItems {
+		a.log.Infof("Adding %s %s to additionalItems", additionalItem.GroupResource, additionalItem.Name)
+	}
+
+	return item, additionalItems, nil
+}
+
+func podRelatedResources(item runtime.Unstructured) ([]velero.ResourceIdentifier, error) {
+	pod := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pod); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var related []velero.ResourceIdentifier
+	if pod.Spec.PriorityClassName != "" {
+		related = append(related, velero.ResourceIdentifier{GroupResource: kuberesource.PriorityClasses, Name: pod.Spec.PriorityClassName})
+	}
+	if pod.Spec.RuntimeClassName != nil && *pod.Spec.RuntimeClassName != "" {
+		related = append(related, velero.ResourceIdentifier{GroupResource: kuberesource.RuntimeClasses, Name: *pod.Spec.RuntimeClassName})
+	}
+
+	return related, nil
+}
+
+func pvcRelatedResources(item runtime.Unstructured) ([]velero.ResourceIdentifier, error) {
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), pvc); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// the beta annotation takes precedence over the field, matching the
+	// persistent volume controller.
+	storageClass := pvc.Annotations[corev1api.BetaStorageClassAnnotation]
+	if storageClass == "" && pvc.Spec.StorageClassName != nil {
+		storageClass = *pvc.Spec.StorageClassName
+	}
+	if storageClass == "" {
+		return nil, nil
+	}
+
+	return []velero.ResourceIdentifier{{GroupResource: kuberesource.StorageClasses, Name: storageClass}}, nil
+}
+
+func roleBindingRelatedResources(item runtime.Unstructured) ([]velero.ResourceIdentifier, error) {
+	roleBinding := new(rbacv1.RoleBinding)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), roleBinding); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if roleBinding.RoleRef.APIGroup != rbacv1.GroupName || roleBinding.RoleRef.Kind != "ClusterRole" {
+		return nil, nil
+	}
+
+	return []velero.ResourceIdentifier{{GroupResource: kuberesource.ClusterRoles, Name: roleBinding.RoleRef.Name}}, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRelatedClusterResourcesActionExecute(t *testing.T) {
+	tests := []struct {
+		name     string
+		backup   *velerov1api.Backup
+		item     runtime.Unstructured
+		expected []velero.ResourceIdentifier
+	}{
+		{
+			name:   "related cluster resources aren't returned unless the backup includes them",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Pod",
+				"metadata": {"namespace": "ns-1", "name": "pod-1"},
+				"spec": {"priorityClassName": "high"}
+			}
+			`),
+		},
+		{
+			name:   "pod returns its priority class and runtime class",
+			backup: builder.ForBackup("velero", "backup-1").IncludeRelatedClusterResources(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Pod",
+				"metadata": {"namespace": "ns-1", "name": "pod-1"},
+				"spec": {"priorityClassName": "high", "runtimeClassName": "gvisor"}
+			}
+			`),
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.PriorityClasses, Name: "high"},
+				{GroupResource: kuberesource.RuntimeClasses, Name: "gvisor"},
+			},
+		},
+		{
+			name:   "pod without priority class or runtime class returns nothing",
+			backup: builder.ForBackup("velero", "backup-1").IncludeRelatedClusterResources(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "Pod",
+				"metadata": {"namespace": "ns-1", "name": "pod-1"}
+			}
+			`),
+		},
+		{
+			name:   "persistent volume claim returns its storage class",
+			backup: builder.ForBackup("velero", "backup-1").IncludeRelatedClusterResources(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "PersistentVolumeClaim",
+				"metadata": {"namespace": "ns-1", "name": "pvc-1"},
+				"spec": {"storageClassName": "gp2", "volumeName": "pv-1"}
+			}
+			`),
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.StorageClasses, Name: "gp2"},
+			},
+		},
+		{
+			name:   "persistent volume claim's beta storage class annotation takes precedence",
+			backup: builder.ForBackup("velero", "backup-1").IncludeRelatedClusterResources(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "v1",
+				"kind": "PersistentVolumeClaim",
+				"metadata": {
+					"namespace": "ns-1",
+					"name": "pvc-1",
+					"annotations": {"volume.beta.kubernetes.io/storage-class": "standard"}
+				},
+				"spec": {"storageClassName": "gp2"}
+			}
+			`),
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.StorageClasses, Name: "standard"},
+			},
+		},
+		{
+			name:   "role binding returns its cluster role",
+			backup: builder.ForBackup("velero", "backup-1").IncludeRelatedClusterResources(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind": "RoleBinding",
+				"metadata": {"namespace": "ns-1", "name": "rb-1"},
+				"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "view"}
+			}
+			`),
+			expected: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.ClusterRoles, Name: "view"},
+			},
+		},
+		{
+			name:   "role binding to a role returns nothing",
+			backup: builder.ForBackup("velero", "backup-1").IncludeRelatedClusterResources(true).Result(),
+			item: velerotest.UnstructuredOrDie(`
+			{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind": "RoleBinding",
+				"metadata": {"namespace": "ns-1", "name": "rb-1"},
+				"roleRef": {"apiGroup": "rbac.authorization.k8s.io", "kind": "Role", "name": "editor"}
+			}
+			`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewRelatedClusterResourcesAction(velerotest.NewLogger())
+
+			updated, additionalItems, err := a.Execute(tc.item, tc.backup)
+			require.NoError(t, err)
+			assert.Equal(t, tc.item, updated)
+			assert.Equal(t, tc.expected, additionalItems)
+		})
+	}
+}
@@ -18,10 +18,20 @@ package backup
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemindex"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -38,15 +48,153 @@ type Request struct {
 	*velerov1api.Backup
 
 	StorageLocation           *velerov1api.BackupStorageLocation
+	MirrorLocations           []*velerov1api.BackupStorageLocation
 	SnapshotLocations         []*velerov1api.VolumeSnapshotLocation
 	NamespaceIncludesExcludes *collections.IncludesExcludes
 	ResourceIncludesExcludes  *collections.IncludesExcludes
+	ItemIncludesExcludes      *collections.IncludesExcludes
+	DefaultResources          *collections.IncludesExcludes
+	CriticalResources         *collections.IncludesExcludes
+	ResourceLabelSelectors    map[schema.GroupResource]labels.Selector
+	ExcludedFields            map[schema.GroupResource][][]string
+	ExcludedOwnerKinds        map[string]struct{}
+	ModifiedSince             time.Time
+	ResourceAPIVersions       map[schema.GroupResource][]string
 	ResourceHooks             []hook.ResourceHook
 	ResolvedActions           []resolvedAction
+	ResolvedItemBlockActions  []resolvedItemBlockAction
+	NamespaceMappers          []velero.NamespaceMapper
+
+	// BackupHookActions gets the plugins that the backup's backup-level
+	// plugin hooks invoke.
+	BackupHookActions hook.BackupHookActionGetter
+
+	// Canceled reports whether the backup's cancellation has been requested,
+	// in which case no more items are backed up. If it's nil, the backup
+	// can't be canceled.
+	Canceled func() bool
+
+	// SnapshottingStarted, if non-nil, is called before the backup's first
+	// volume snapshot is taken.
+	SnapshottingStarted func()
+	snapshotting        bool
 
 	VolumeSnapshots  []*volume.Snapshot
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	BackedUpItems    map[itemKey]struct{}
+
+	// FailedItems are the items that failed to be backed up, under their
+	// namespaces and names in the cluster.
+	FailedItems []itemstatus.Item
+
+	// ItemErrors is the number of errors logged for the items that failed
+	// to be backed up.
+	ItemErrors int
+
+	// BaseItemIndex, if non-nil, is the item index of the backup an
+	// incremental backup is based on. The items it lists that have the same
+	// resource version and files aren't written to the tarball again.
+	BaseItemIndex *itemindex.Index
+
+	// IndexedItems are the items in the backup, and the backups whose
+	// tarballs contain them.
+	IndexedItems []itemindex.Item
+
+	baseItems map[itemKey]itemindex.Item
+}
+
+// indexBaseItems keys the items in the base backup's item index the same way
+// as the request's backed up items.
+func (r *Request) indexBaseItems() {
+	r.baseItems = make(map[itemKey]itemindex.Item)
+	if r.BaseItemIndex == nil {
+		return
+	}
+	for _, item := range r.BaseItemIndex.Items {
+		r.baseItems[itemKey{resource: item.Resource, namespace: item.Namespace, name: item.Name}] = item
+	}
+}
+
+// unchangedItem returns the name of the backup whose tarball contains an
+// item, if the item hasn't changed since the base backup and would be
+// written to the same files.
+func (r *Request) unchangedItem(key itemKey, resourceVersion string, files []string) (string, bool) {
+	base, ok := r.baseItems[key]
+	if !ok || resourceVersion == "" || base.ResourceVersion != resourceVersion || !reflect.DeepEqual(base.Files, files) {
+		return "", false
+	}
+	return base.Backup, true
+}
+
+// indexItem adds an item to the request's indexed items.
+func (r *Request) indexItem(key itemKey, resourceVersion, backup string, files []string) {
+	r.IndexedItems = append(r.IndexedItems, itemindex.Item{
+		Resource:        key.resource,
+		Namespace:       key.namespace,
+		Name:            key.name,
+		ResourceVersion: resourceVersion,
+		Backup:          backup,
+		Files:           files,
+	})
+}
+
+// ItemIndex returns the index of the items in the backup.
+func (r *Request) ItemIndex() *itemindex.Index {
+	return &itemindex.Index{Items: append([]itemindex.Item{}, r.IndexedItems...)}
+}
+
+// recordFailedItem adds an item that failed to be backed up to the
+// request's failed items.
+func (r *Request) recordFailedItem(groupResource schema.GroupResource, namespace, name string, err error) {
+	item := itemstatus.Item{
+		Resource:  groupResource.String(),
+		Namespace: namespace,
+		Name:      name,
+	}
+	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
+		for _, err := range kubeerrs.Flatten(aggregate).Errors() {
+			item.Errors = append(item.Errors, err.Error())
+		}
+	} else {
+		item.Errors = []string{err.Error()}
+	}
+	r.FailedItems = append(r.FailedItems, item)
+}
+
+// ItemStatusManifest returns the manifest of the items that failed to be
+// backed up, under the names they're recorded under in the backup tarball.
+func (r *Request) ItemStatusManifest() *itemstatus.Manifest {
+	manifest := &itemstatus.Manifest{Items: []itemstatus.Item{}}
+	for _, item := range r.FailedItems {
+		if archived, ok := r.Status.ArchivedNamespaces[item.Namespace]; ok {
+			item.Namespace = archived
+		}
+		if archived, ok := r.Status.ArchivedNamespaces[item.Name]; ok && item.Resource == kuberesource.Namespaces.String() {
+			item.Name = archived
+		}
+		manifest.Items = append(manifest.Items, item)
+	}
+	return manifest
+}
+
+// filteredItems returns the backup's counts of items left out by its
+// filters, initializing them on first use so that backups that don't
+// filter out any items don't have them.
+func (r *Request) filteredItems() *velerov1api.BackupFilteredItems {
+	if r.Status.FilteredItems == nil {
+		r.Status.FilteredItems = new(velerov1api.BackupFilteredItems)
+	}
+	return r.Status.FilteredItems
+}
+
+// shouldIncludeItem returns whether an object is included by the backup's
+// item filter, along with the reason. Every object is included if the
+// backup doesn't have one.
+func (r *Request) shouldIncludeItem(groupResource schema.GroupResource, namespace, name string) (bool, string) {
+	if r.ItemIncludesExcludes == nil {
+		return true, "backup has no item filter"
+	}
+	return r.ItemIncludesExcludes.ShouldIncludeWithReason(collections.ItemKey(groupResource, namespace, name))
 }
 
 // BackupResourceList returns the list of backed up resources grouped by the API
@@ -54,9 +202,19 @@ type Request struct {
 func (r *Request) BackupResourceList() map[string][]string {
 	resources := map[string][]string{}
 	for i := range r.BackedUpItems {
-		entry := i.name
-		if i.namespace != "" {
-			entry = fmt.Sprintf("%s/%s", i.namespace, i.name)
+		// items are listed under the names they're recorded under in the
+		// backup tarball.
+		namespace, name := i.namespace, i.name
+		if archived, ok := r.Status.ArchivedNamespaces[namespace]; ok {
+			namespace = archived
+		}
+		if archived, ok := r.Status.ArchivedNamespaces[name]; ok && i.resource == "v1/Namespace" {
+			name = archived
+		}
+
+		entry := name
+		if namespace != "" {
+			entry = fmt.Sprintf("%s/%s", namespace, name)
 		}
 		resources[i.resource] = append(resources[i.resource], entry)
 	}
@@ -68,3 +226,14 @@ func (r *Request) BackupResourceList() map[string][]string {
 
 	return resources
 }
+
+// startSnapshotting calls SnapshottingStarted the first time it's called.
+func (r *Request) startSnapshotting() {
+	if r.snapshotting {
+		return
+	}
+	r.snapshotting = true
+	if r.SnapshottingStarted != nil {
+		r.SnapshottingStarted()
+	}
+}
@@ -19,7 +19,13 @@ package backup
 import (
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 )
 
 func TestRequest_BackupResourceList(t *testing.T) {
@@ -80,3 +86,26 @@ func TestRequest_BackupResourceListEntriesSorted(t *testing.T) {
 		"v1/Pod": {"ns1/pod1", "ns2/pod2"},
 	}, req.BackupResourceList())
 }
+
+func TestRequest_ItemStatusManifest(t *testing.T) {
+	req := Request{Backup: &velerov1api.Backup{}}
+	assert.Equal(t, &itemstatus.Manifest{Items: []itemstatus.Item{}}, req.ItemStatusManifest())
+
+	req.Status.ArchivedNamespaces = map[string]string{"ns-1": "archived-ns-1"}
+	req.recordFailedItem(kuberesource.Pods, "ns-1", "pod-1", kubeerrs.NewAggregate([]error{
+		errors.New("error 1"),
+		kubeerrs.NewAggregate([]error{errors.New("error 2"), errors.New("error 3")}),
+	}))
+	req.recordFailedItem(kuberesource.Namespaces, "", "ns-1", errors.New("error 4"))
+	req.recordFailedItem(kuberesource.PersistentVolumes, "", "pv-1", errors.New("error 5"))
+
+	// items are listed under the names they're recorded under in the backup
+	// tarball.
+	assert.Equal(t, &itemstatus.Manifest{
+		Items: []itemstatus.Item{
+			{Resource: "pods", Namespace: "archived-ns-1", Name: "pod-1", Errors: []string{"error 1", "error 2", "error 3"}},
+			{Resource: "namespaces", Name: "archived-ns-1", Errors: []string{"error 4"}},
+			{Resource: "persistentvolumes", Name: "pv-1", Errors: []string{"error 5"}},
+		},
+	}, req.ItemStatusManifest())
+}
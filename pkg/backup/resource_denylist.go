@@ -0,0 +1,54 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+)
+
+// ResourceDenylistKey is the key of the server's resource denylist config
+// map that lists the resources no backup can include.
+const ResourceDenylistKey = "resources"
+
+// GetResourceDenylist returns the resources listed, separated by commas or
+// newlines, in the resources key of the server's resource denylist config
+// map. Since the denylist can't be overridden, neither '*' nor re-includes
+// prefixed with '!' are allowed in it.
+func GetResourceDenylist(configMap *corev1api.ConfigMap) ([]string, error) {
+	value, ok := configMap.Data[ResourceDenylistKey]
+	if !ok {
+		return nil, errors.Errorf("config map %s/%s has no %s key", configMap.Namespace, configMap.Name, ResourceDenylistKey)
+	}
+
+	var resources []string
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		item = strings.TrimSpace(item)
+		switch {
+		case item == "":
+			continue
+		case item == "*":
+			return nil, errors.Errorf("config map %s/%s can't deny '*'", configMap.Namespace, configMap.Name)
+		case strings.HasPrefix(item, "!"):
+			return nil, errors.Errorf("config map %s/%s can't re-include %s", configMap.Namespace, configMap.Name, item)
+		}
+		resources = append(resources, item)
+	}
+	return resources, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetResourceDenylist(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "resources separated by commas and newlines are returned",
+			data: map[string]string{"resources": "events, events.events.k8s.io\nendpointslices.discovery.k8s.io,\n\nleases.coordination.k8s.io\n"},
+			want: []string{"events", "events.events.k8s.io", "endpointslices.discovery.k8s.io", "leases.coordination.k8s.io"},
+		},
+		{
+			name:    "missing resources key is an error",
+			data:    map[string]string{"events": ""},
+			wantErr: "config map velero/denylist has no resources key",
+		},
+		{
+			name:    "wildcard is rejected",
+			data:    map[string]string{"resources": "events,*"},
+			wantErr: "config map velero/denylist can't deny '*'",
+		},
+		{
+			name:    "re-include is rejected",
+			data:    map[string]string{"resources": "*.apps,!deployments.apps"},
+			wantErr: "config map velero/denylist can't re-include !deployments.apps",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			configMap := &corev1api.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "denylist"},
+				Data:       tc.data,
+			}
+
+			resources, err := GetResourceDenylist(configMap)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resources)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// volumeSnapshotTask is a persistent volume snapshot whose creation has been
+// submitted to a volumeSnapshotPool.
+type volumeSnapshotTask struct {
+	snapshot          *volume.Snapshot
+	volumeSnapshotter velero.VolumeSnapshotter
+	tags              map[string]string
+	log               logrus.FieldLogger
+
+	// done is closed once the snapshot has been created or has failed.
+	done chan struct{}
+
+	// err is the error returned from creating the snapshot, if any. It's
+	// only safe to read once done is closed.
+	err error
+}
+
+// volumeSnapshotPool creates volume snapshots on a bounded number of
+// concurrent workers.
+type volumeSnapshotPool struct {
+	tasks []*volumeSnapshotTask
+	queue chan *volumeSnapshotTask
+	wg    sync.WaitGroup
+}
+
+// newVolumeSnapshotPool returns a volumeSnapshotPool that creates up to
+// workers snapshots at a time. The pool must be waited on to release its
+// workers.
+func newVolumeSnapshotPool(workers int) *volumeSnapshotPool {
+	p := &volumeSnapshotPool{
+		queue: make(chan *volumeSnapshotTask),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer p.wg.Done()
+
+			for task := range p.queue {
+				task.err = createVolumeSnapshot(task.volumeSnapshotter, task.snapshot, task.tags, task.log)
+				close(task.done)
+			}
+		}()
+	}
+
+	return p
+}
+
+// submit queues a snapshot for creation, blocking until a worker is free.
+// It must not be called concurrently, or after wait.
+func (p *volumeSnapshotPool) submit(task *volumeSnapshotTask) {
+	task.done = make(chan struct{})
+	p.tasks = append(p.tasks, task)
+	p.queue <- task
+}
+
+// mark returns a marker for the snapshots submitted so far, so that the
+// ones submitted after it can be waited on with waitSince.
+func (p *volumeSnapshotPool) mark() int {
+	return len(p.tasks)
+}
+
+// waitSince blocks until the snapshots submitted since mark was called have
+// been created, and returns their tasks in the order they were submitted.
+// Like submit, it must not be called concurrently, or after wait.
+func (p *volumeSnapshotPool) waitSince(mark int) []*volumeSnapshotTask {
+	tasks := p.tasks[mark:]
+	for _, task := range tasks {
+		<-task.done
+	}
+
+	return tasks
+}
+
+// wait blocks until all submitted snapshots have been created, and returns
+// their tasks in the order they were submitted.
+func (p *volumeSnapshotPool) wait() []*volumeSnapshotTask {
+	close(p.queue)
+	p.wg.Wait()
+
+	return p.tasks
+}
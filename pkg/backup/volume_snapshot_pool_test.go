@@ -0,0 +1,68 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestVolumeSnapshotPoolWaitSince(t *testing.T) {
+	snapshotter := &slowVolumeSnapshotter{
+		fakeVolumeSnapshotter: new(fakeVolumeSnapshotter).
+			WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+			WithVolume("pv-2", "vol-2", "", "type-2", 100, false).
+			WithVolume("pv-3", "vol-3", "", "type-3", 100, false),
+		delay: 50 * time.Millisecond,
+	}
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	task := func(pv, volumeID string) *volumeSnapshotTask {
+		return &volumeSnapshotTask{
+			snapshot:          volumeSnapshot(backup, pv, volumeID, "", "", "default", nil),
+			volumeSnapshotter: snapshotter,
+			log:               test.NewLogger(),
+		}
+	}
+
+	pool := newVolumeSnapshotPool(2)
+
+	pool.submit(task("pv-1", "vol-1"))
+	mark := pool.mark()
+	pool.submit(task("pv-2", "vol-2"))
+	pool.submit(task("pv-3", "vol-3"))
+
+	tasks := pool.waitSince(mark)
+	require.Len(t, tasks, 2)
+	for i, task := range tasks {
+		assert.Equal(t, []string{"pv-2", "pv-3"}[i], task.snapshot.Spec.PersistentVolumeName)
+		assert.Equal(t, volume.SnapshotPhaseCompleted, task.snapshot.Status.Phase)
+		assert.NoError(t, task.err)
+	}
+
+	// waiting since the latest mark doesn't wait for anything.
+	assert.Empty(t, pool.waitSince(pool.mark()))
+
+	assert.Len(t, pool.wait(), 3)
+	assert.Equal(t, 3, snapshotter.returned)
+}
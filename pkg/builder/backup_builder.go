@@ -135,12 +135,72 @@ func (b *BackupBuilder) IncludeClusterResources(val bool) *BackupBuilder {
 	return b
 }
 
+// IncludeRelatedClusterResources sets the Backup's "include related cluster resources" flag.
+func (b *BackupBuilder) IncludeRelatedClusterResources(val bool) *BackupBuilder {
+	b.object.Spec.IncludeRelatedClusterResources = &val
+	return b
+}
+
+// IncludeAPIServices sets the Backup's "include API services" flag.
+func (b *BackupBuilder) IncludeAPIServices(val bool) *BackupBuilder {
+	b.object.Spec.IncludeAPIServices = &val
+	return b
+}
+
 // LabelSelector sets the Backup's label selector.
 func (b *BackupBuilder) LabelSelector(selector *metav1.LabelSelector) *BackupBuilder {
 	b.object.Spec.LabelSelector = selector
 	return b
 }
 
+// ResourceLabelSelectors sets the Backup's resource label selectors.
+func (b *BackupBuilder) ResourceLabelSelectors(selectors map[string]string) *BackupBuilder {
+	b.object.Spec.ResourceLabelSelectors = selectors
+	return b
+}
+
+// ExcludedFields sets the Backup's excluded fields.
+func (b *BackupBuilder) ExcludedFields(fields map[string][]string) *BackupBuilder {
+	b.object.Spec.ExcludedFields = fields
+	return b
+}
+
+// ResourceAPIVersions sets the Backup's resource API versions.
+func (b *BackupBuilder) ResourceAPIVersions(versions map[string][]string) *BackupBuilder {
+	b.object.Spec.ResourceAPIVersions = versions
+	return b
+}
+
+// ExcludedAnnotation sets the Backup's excluded annotation.
+func (b *BackupBuilder) ExcludedAnnotation(match *velerov1api.AnnotationMatch) *BackupBuilder {
+	b.object.Spec.ExcludedAnnotation = match
+	return b
+}
+
+// ItemFilter sets the Backup's item filter.
+func (b *BackupBuilder) ItemFilter(filter *velerov1api.ItemFilter) *BackupBuilder {
+	b.object.Spec.ItemFilter = filter
+	return b
+}
+
+// ExcludeDefaultResources sets the Backup's "exclude default resources" flag.
+func (b *BackupBuilder) ExcludeDefaultResources(val bool) *BackupBuilder {
+	b.object.Spec.ExcludeDefaultResources = &val
+	return b
+}
+
+// ExcludedOwnerKinds sets the Backup's excluded owner kinds.
+func (b *BackupBuilder) ExcludedOwnerKinds(kinds ...string) *BackupBuilder {
+	b.object.Spec.ExcludedOwnerKinds = kinds
+	return b
+}
+
+// ModifiedSince sets the Backup's modifiedSince window.
+func (b *BackupBuilder) ModifiedSince(window *metav1.Duration) *BackupBuilder {
+	b.object.Spec.ModifiedSince = window
+	return b
+}
+
 // SnapshotVolumes sets the Backup's "snapshot volumes" flag.
 func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotVolumes = &val
@@ -153,6 +213,42 @@ func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
 	return b
 }
 
+// Compression sets the Backup's compression algorithm.
+func (b *BackupBuilder) Compression(algorithm velerov1api.CompressionAlgorithm) *BackupBuilder {
+	b.object.Spec.Compression = algorithm
+	return b
+}
+
+// Incremental sets the Backup's "Incremental" flag.
+func (b *BackupBuilder) Incremental(val bool) *BackupBuilder {
+	b.object.Spec.Incremental = &val
+	return b
+}
+
+// RedactSecrets sets the Backup's "RedactSecrets" flag.
+func (b *BackupBuilder) RedactSecrets(val bool) *BackupBuilder {
+	b.object.Spec.RedactSecrets = &val
+	return b
+}
+
+// ItemFailureThresholds sets the Backup's item failure thresholds.
+func (b *BackupBuilder) ItemFailureThresholds(thresholds *velerov1api.ItemFailureThresholds) *BackupBuilder {
+	b.object.Spec.ItemFailureThresholds = thresholds
+	return b
+}
+
+// BaseBackup sets the name of the backup that the Backup is based on.
+func (b *BackupBuilder) BaseBackup(name string) *BackupBuilder {
+	b.object.Status.BaseBackup = name
+	return b
+}
+
+// FormatVersion sets the Backup's format version.
+func (b *BackupBuilder) FormatVersion(version string) *BackupBuilder {
+	b.object.Status.FormatVersion = version
+	return b
+}
+
 // Phase sets the Backup's phase.
 func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	b.object.Status.Phase = phase
@@ -165,6 +261,18 @@ func (b *BackupBuilder) StorageLocation(location string) *BackupBuilder {
 	return b
 }
 
+// MirrorStorageLocations sets the Backup's mirror storage locations.
+func (b *BackupBuilder) MirrorStorageLocations(locations ...string) *BackupBuilder {
+	b.object.Spec.MirrorStorageLocations = locations
+	return b
+}
+
+// MirrorStatuses sets the Backup's mirror statuses.
+func (b *BackupBuilder) MirrorStatuses(statuses ...velerov1api.BackupMirrorStatus) *BackupBuilder {
+	b.object.Status.MirrorStatuses = statuses
+	return b
+}
+
 // VolumeSnapshotLocations sets the Backup's volume snapshot locations.
 func (b *BackupBuilder) VolumeSnapshotLocations(locations ...string) *BackupBuilder {
 	b.object.Spec.VolumeSnapshotLocations = locations
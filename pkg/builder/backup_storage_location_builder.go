@@ -123,8 +123,32 @@ func (b *BackupStorageLocationBuilder) Phase(phase velerov1api.BackupStorageLoca
 	return b
 }
 
+// ConsecutiveFailures sets the BackupStorageLocation's count of consecutive failed validations.
+func (b *BackupStorageLocationBuilder) ConsecutiveFailures(failures int) *BackupStorageLocationBuilder {
+	b.object.Status.ConsecutiveFailures = failures
+	return b
+}
+
 // Credential sets the BackupStorageLocation's credential selector.
 func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
 	b.object.Spec.Credential = selector
 	return b
 }
+
+// EncryptionKey sets the BackupStorageLocation's encryption key selector.
+func (b *BackupStorageLocationBuilder) EncryptionKey(selector *corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
+	b.object.Spec.EncryptionKey = selector
+	return b
+}
+
+// DeletePolicy sets the BackupStorageLocation's delete policy.
+func (b *BackupStorageLocationBuilder) DeletePolicy(policy *velerov1api.BackupStorageLocationDeletePolicy) *BackupStorageLocationBuilder {
+	b.object.Spec.DeletePolicy = policy
+	return b
+}
+
+// RequestPolicy sets the BackupStorageLocation's request policy.
+func (b *BackupStorageLocationBuilder) RequestPolicy(policy *velerov1api.BackupStorageLocationRequestPolicy) *BackupStorageLocationBuilder {
+	b.object.Spec.RequestPolicy = policy
+	return b
+}
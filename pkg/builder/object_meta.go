@@ -148,6 +148,18 @@ func WithUID(val string) func(obj metav1.Object) {
 	}
 }
 
+// WithOwnerReference is a functional option that adds an owner reference of
+// the specified API version, kind and name to an object.
+func WithOwnerReference(apiVersion, kind, name string) func(obj metav1.Object) {
+	return func(obj metav1.Object) {
+		obj.SetOwnerReferences(append(obj.GetOwnerReferences(), metav1.OwnerReference{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+		}))
+	}
+}
+
 // WithGenerateName is a functional option that applies the specified generate name to an object.
 func WithGenerateName(val string) func(obj metav1.Object) {
 	return func(obj metav1.Object) {
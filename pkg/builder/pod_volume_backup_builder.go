@@ -86,3 +86,19 @@ func (b *PodVolumeBackupBuilder) Volume(volume string) *PodVolumeBackupBuilder {
 	b.object.Spec.Volume = volume
 	return b
 }
+
+// VolumeMode sets the PodVolumeBackup's volume mode.
+func (b *PodVolumeBackupBuilder) VolumeMode(mode velerov1api.PodVolumeMode) *PodVolumeBackupBuilder {
+	b.object.Spec.VolumeMode = mode
+	return b
+}
+
+// DataDigest sets the digest of the PodVolumeBackup's data computed by a
+// backup data integrity plugin.
+func (b *PodVolumeBackupBuilder) DataDigest(plugin, digest string) *PodVolumeBackupBuilder {
+	if b.object.Status.DataDigests == nil {
+		b.object.Status.DataDigests = make(map[string]string)
+	}
+	b.object.Status.DataDigests[plugin] = digest
+	return b
+}
@@ -19,6 +19,7 @@ package builder
 import (
 	"time"
 
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -107,6 +108,70 @@ func (b *RestoreBuilder) LabelSelector(selector *metav1.LabelSelector) *RestoreB
 	return b
 }
 
+// ExcludedAnnotation sets the Restore's excluded annotation.
+func (b *RestoreBuilder) ExcludedAnnotation(match *velerov1api.AnnotationMatch) *RestoreBuilder {
+	b.object.Spec.ExcludedAnnotation = match
+	return b
+}
+
+// IncludedVolumes appends to the Restore's included volumes.
+func (b *RestoreBuilder) IncludedVolumes(volumes ...string) *RestoreBuilder {
+	b.object.Spec.IncludedVolumes = append(b.object.Spec.IncludedVolumes, volumes...)
+	return b
+}
+
+// ExcludedVolumes appends to the Restore's excluded volumes.
+func (b *RestoreBuilder) ExcludedVolumes(volumes ...string) *RestoreBuilder {
+	b.object.Spec.ExcludedVolumes = append(b.object.Spec.ExcludedVolumes, volumes...)
+	return b
+}
+
+// VolumeSelector sets the Restore's volume selector.
+func (b *RestoreBuilder) VolumeSelector(selector *metav1.LabelSelector) *RestoreBuilder {
+	b.object.Spec.VolumeSelector = selector
+	return b
+}
+
+// SkipUnselectedVolumes sets the Restore's skip unselected volumes flag.
+func (b *RestoreBuilder) SkipUnselectedVolumes(val bool) *RestoreBuilder {
+	b.object.Spec.SkipUnselectedVolumes = &val
+	return b
+}
+
+// ResourcePriorities sets the Restore's resource priorities.
+func (b *RestoreBuilder) ResourcePriorities(mode velerov1api.ResourcePrioritiesMode, high, low []string) *RestoreBuilder {
+	b.object.Spec.ResourcePriorities = &velerov1api.RestoreResourcePriorities{
+		Mode:           mode,
+		HighPriorities: high,
+		LowPriorities:  low,
+	}
+	return b
+}
+
+// ResourceTimeout sets the Restore's resource timeout.
+func (b *RestoreBuilder) ResourceTimeout(timeout time.Duration) *RestoreBuilder {
+	b.object.Spec.ResourceTimeout = &metav1.Duration{Duration: timeout}
+	return b
+}
+
+// ResourceModifier sets the Restore's resource modifier reference.
+func (b *RestoreBuilder) ResourceModifier(kind, name string) *RestoreBuilder {
+	b.object.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{Kind: kind, Name: name}
+	return b
+}
+
+// NamespaceConflictPolicy sets the Restore's namespace conflict policy.
+func (b *RestoreBuilder) NamespaceConflictPolicy(policy velerov1api.NamespaceConflictPolicy) *RestoreBuilder {
+	b.object.Spec.NamespaceConflictPolicy = policy
+	return b
+}
+
+// ExistingResourcePolicy sets the Restore's existing resource policy.
+func (b *RestoreBuilder) ExistingResourcePolicy(policy velerov1api.ExistingResourcePolicy) *RestoreBuilder {
+	b.object.Spec.ExistingResourcePolicy = policy
+	return b
+}
+
 // NamespaceMappings sets the Restore's namespace mappings.
 func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	if b.object.Spec.NamespaceMapping == nil {
@@ -124,6 +189,15 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// NamespaceMappingTemplate sets the Restore's namespace mapping template.
+func (b *RestoreBuilder) NamespaceMappingTemplate(selector *metav1.LabelSelector, template string) *RestoreBuilder {
+	b.object.Spec.NamespaceMappingTemplate = &velerov1api.NamespaceMappingTemplate{
+		Selector: selector,
+		Template: template,
+	}
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
@@ -136,6 +210,63 @@ func (b *RestoreBuilder) RestorePVs(val bool) *RestoreBuilder {
 	return b
 }
 
+// DryRun sets the Restore's dry run flag.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
+
+// ScaleDownWorkloads sets the Restore's scale down workloads flag.
+func (b *RestoreBuilder) ScaleDownWorkloads(val bool) *RestoreBuilder {
+	b.object.Spec.ScaleDownWorkloads = &val
+	return b
+}
+
+// RecordChanges sets the Restore's record changes flag.
+func (b *RestoreBuilder) RecordChanges(val bool) *RestoreBuilder {
+	b.object.Spec.RecordChanges = &val
+	return b
+}
+
+// Resume sets the Restore's resume flag.
+func (b *RestoreBuilder) Resume(val bool) *RestoreBuilder {
+	b.object.Spec.Resume = &val
+	return b
+}
+
+// ResumedFrom sets the name of the restore the Restore is resuming.
+func (b *RestoreBuilder) ResumedFrom(name string) *RestoreBuilder {
+	b.object.Status.ResumedFrom = name
+	return b
+}
+
+// SkipFailedBackupItems sets the Restore's skip failed backup items flag.
+func (b *RestoreBuilder) SkipFailedBackupItems(val bool) *RestoreBuilder {
+	b.object.Spec.SkipFailedBackupItems = &val
+	return b
+}
+
+// GenerateNameOnConflict sets the resources whose items the Restore creates
+// with a generated name when their name is taken.
+func (b *RestoreBuilder) GenerateNameOnConflict(resources ...string) *RestoreBuilder {
+	b.object.Spec.GenerateNameOnConflict = append(b.object.Spec.GenerateNameOnConflict, resources...)
+	return b
+}
+
+// PreferredAPIVersions sets the API versions the Restore prefers to restore
+// resources at.
+func (b *RestoreBuilder) PreferredAPIVersions(versions map[string][]string) *RestoreBuilder {
+	b.object.Spec.PreferredAPIVersions = versions
+	return b
+}
+
+// WaitForWorkloads sets how long the Restore waits for its restored
+// workloads of each type to become ready.
+func (b *RestoreBuilder) WaitForWorkloads(wait *velerov1api.WorkloadReadinessWait) *RestoreBuilder {
+	b.object.Spec.WaitForWorkloads = wait
+	return b
+}
+
 // PreserveNodePorts sets the Restore's preserved NodePorts.
 func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	b.object.Spec.PreserveNodePorts = &val
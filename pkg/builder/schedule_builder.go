@@ -84,6 +84,42 @@ func (b *ScheduleBuilder) LastBackupTime(val string) *ScheduleBuilder {
 	return b
 }
 
+// TTLOverrides appends to the Schedule's TTL overrides.
+func (b *ScheduleBuilder) TTLOverrides(overrides ...velerov1api.ScheduleTTLOverride) *ScheduleBuilder {
+	b.object.Spec.TTLOverrides = append(b.object.Spec.TTLOverrides, overrides...)
+	return b
+}
+
+// Paused sets the Schedule's paused field.
+func (b *ScheduleBuilder) Paused(val bool) *ScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
+
+// SkipImmediately sets the Schedule's skipImmediately field.
+func (b *ScheduleBuilder) SkipImmediately(val bool) *ScheduleBuilder {
+	b.object.Spec.SkipImmediately = &val
+	return b
+}
+
+// BackupNameTemplate sets the Schedule's backup name template.
+func (b *ScheduleBuilder) BackupNameTemplate(val string) *ScheduleBuilder {
+	b.object.Spec.BackupNameTemplate = val
+	return b
+}
+
+// BackupLabelTemplates sets the Schedule's backup label templates.
+func (b *ScheduleBuilder) BackupLabelTemplates(templates map[string]string) *ScheduleBuilder {
+	b.object.Spec.BackupLabelTemplates = templates
+	return b
+}
+
+// BackupAnnotationTemplates sets the Schedule's backup annotation templates.
+func (b *ScheduleBuilder) BackupAnnotationTemplates(templates map[string]string) *ScheduleBuilder {
+	b.object.Spec.BackupAnnotationTemplates = templates
+	return b
+}
+
 // Template sets the Schedule's template.
 func (b *ScheduleBuilder) Template(spec velerov1api.BackupSpec) *ScheduleBuilder {
 	b.object.Spec.Template = spec
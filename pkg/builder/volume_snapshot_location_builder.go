@@ -17,6 +17,8 @@ limitations under the License.
 package builder
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -62,3 +64,21 @@ func (b *VolumeSnapshotLocationBuilder) Provider(name string) *VolumeSnapshotLoc
 	b.object.Spec.Provider = name
 	return b
 }
+
+// Phase sets the VolumeSnapshotLocation's status phase.
+func (b *VolumeSnapshotLocationBuilder) Phase(phase velerov1api.VolumeSnapshotLocationPhase) *VolumeSnapshotLocationBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// Message sets the VolumeSnapshotLocation's status message.
+func (b *VolumeSnapshotLocationBuilder) Message(message string) *VolumeSnapshotLocationBuilder {
+	b.object.Status.Message = message
+	return b
+}
+
+// LastValidationTime sets the VolumeSnapshotLocation's last validated time.
+func (b *VolumeSnapshotLocationBuilder) LastValidationTime(lastValidated time.Time) *VolumeSnapshotLocationBuilder {
+	b.object.Status.LastValidationTime = &metav1.Time{Time: lastValidated}
+	return b
+}
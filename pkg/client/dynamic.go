@@ -53,8 +53,8 @@ func (f *dynamicFactory) ClientForGroupVersionResource(gv schema.GroupVersion, r
 
 // Creator creates an object.
 type Creator interface {
-	// Create creates an object.
-	Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	// Create creates an object. The request is cancelled when ctx is done.
+	Create(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
 }
 
 // Lister lists objects.
@@ -78,8 +78,8 @@ type Getter interface {
 // Patcher patches an object.
 type Patcher interface {
 	//Patch patches the named object using the provided patch bytes, which are expected to be in JSON merge patch format. The patched object is returned.
-
-	Patch(name string, data []byte) (*unstructured.Unstructured, error)
+	// The request is cancelled when ctx is done.
+	Patch(ctx context.Context, name string, data []byte) (*unstructured.Unstructured, error)
 }
 
 // Deletor deletes an object.
@@ -106,8 +106,8 @@ type dynamicResourceClient struct {
 
 var _ Dynamic = &dynamicResourceClient{}
 
-func (d *dynamicResourceClient) Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
-	return d.resourceClient.Create(context.TODO(), obj, metav1.CreateOptions{})
+func (d *dynamicResourceClient) Create(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return d.resourceClient.Create(ctx, obj, metav1.CreateOptions{})
 }
 
 func (d *dynamicResourceClient) List(options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
@@ -122,8 +122,8 @@ func (d *dynamicResourceClient) Get(name string, opts metav1.GetOptions) (*unstr
 	return d.resourceClient.Get(context.TODO(), name, opts)
 }
 
-func (d *dynamicResourceClient) Patch(name string, data []byte) (*unstructured.Unstructured, error) {
-	return d.resourceClient.Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{})
+func (d *dynamicResourceClient) Patch(ctx context.Context, name string, data []byte) (*unstructured.Unstructured, error) {
+	return d.resourceClient.Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
 }
 
 func (d *dynamicResourceClient) Delete(name string, opts metav1.DeleteOptions) error {
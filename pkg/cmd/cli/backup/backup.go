@@ -35,7 +35,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
+		NewDiffCommand(f),
+		NewExtendCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewCancelCommand(f, "cancel"),
 	)
 
 	return c
@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewCancelCommand creates and returns a new cobra command for canceling backups.
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s NAME [NAME...]", use),
+		Short: "Cancel backups that are in progress",
+		Long: `Cancel backups that are in progress, by requesting their cancellation with the
+velero.io/cancel-requested annotation. A canceled backup doesn't back up any more
+items, and its phase is Cancelled once it stops. Backups that have finished aren't changed.`,
+		Example: `  # Cancel a backup named "backup-1".
+  velero backup cancel backup-1`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+
+			var errs []error
+			for _, name := range args {
+				backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					errs = append(errs, errors.WithStack(err))
+					continue
+				}
+
+				switch backup.Status.Phase {
+				case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+				default:
+					fmt.Printf("Backup %s has already finished, its phase is %s.\n", name, backup.Status.Phase)
+					continue
+				}
+
+				patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:""}}}`, velerov1api.CancelRequestedAnnotation))
+				if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+					errs = append(errs, errors.Wrapf(err, "error canceling backup %s", name))
+					continue
+				}
+				fmt.Printf("Request to cancel backup %s submitted successfully.\n", name)
+			}
+
+			cmd.CheckError(kubeerrs.NewAggregate(errs))
+		},
+	}
+
+	return c
+}
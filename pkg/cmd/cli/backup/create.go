@@ -30,6 +30,8 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
@@ -82,33 +84,60 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name                    string
-	TTL                     time.Duration
-	SnapshotVolumes         flag.OptionalBool
-	DefaultVolumesToRestic  flag.OptionalBool
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	Labels                  flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	StorageLocation         string
-	SnapshotLocations       []string
-	FromSchedule            string
-	OrderedResources        string
+	Name                           string
+	TTL                            time.Duration
+	SnapshotVolumes                flag.OptionalBool
+	DefaultVolumesToRestic         flag.OptionalBool
+	IncludeNamespaces              flag.StringArray
+	ExcludeNamespaces              flag.StringArray
+	IncludeResources               flag.StringArray
+	ExcludeResources               flag.StringArray
+	IncludeItems                   flag.StringArray
+	ExcludeItems                   flag.StringArray
+	ExcludeOwnerKinds              flag.StringArray
+	ExcludeOwnedResources          bool
+	Labels                         flag.Map
+	Selector                       flag.LabelSelector
+	ExcludeAnnotation              flag.AnnotationMatch
+	IncludeClusterResources        flag.OptionalBool
+	IncludeRelatedClusterResources flag.OptionalBool
+	IncludeAPIServices             flag.OptionalBool
+	Incremental                    flag.OptionalBool
+	RedactSecrets                  flag.OptionalBool
+	ExcludeDefaultResources        flag.OptionalBool
+	ModifiedSince                  time.Duration
+	MaxFailedItems                 int
+	MaxFailedItemsPercent          int
+	CriticalResources              flag.StringArray
+	Wait                           bool
+	StorageLocation                string
+	MirrorStorageLocations         []string
+	SnapshotLocations              []string
+	FromSchedule                   string
+	OrderedResources               string
+	ResourceSelectors              string
+	ExcludeFields                  string
+	ResourceAPIVersions            string
+	Compression                    *flag.Enum
 
 	client veleroclient.Interface
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		TTL:                     DefaultBackupTTL,
-		IncludeNamespaces:       flag.NewStringArray("*"),
-		Labels:                  flag.NewMap(),
-		SnapshotVolumes:         flag.NewOptionalBool(nil),
-		IncludeClusterResources: flag.NewOptionalBool(nil),
+		TTL:                            DefaultBackupTTL,
+		IncludeNamespaces:              flag.NewStringArray("*"),
+		Labels:                         flag.NewMap(),
+		SnapshotVolumes:                flag.NewOptionalBool(nil),
+		IncludeClusterResources:        flag.NewOptionalBool(nil),
+		IncludeRelatedClusterResources: flag.NewOptionalBool(nil),
+		IncludeAPIServices:             flag.NewOptionalBool(nil),
+		Incremental:                    flag.NewOptionalBool(nil),
+		RedactSecrets:                  flag.NewOptionalBool(nil),
+		ExcludeDefaultResources:        flag.NewOptionalBool(nil),
+		MaxFailedItems:                 -1,
+		MaxFailedItemsPercent:          -1,
+		Compression:                    flag.NewEnum("", archive.CompressionAlgorithmNames()...),
 	}
 }
 
@@ -118,11 +147,25 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the backup.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the backup, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the backup, formatted as resource.group, such as storageclasses.storage.k8s.io.")
+	flags.Var(&o.IncludeItems, "include-items", "Items to include in the backup, formatted as group/resource:namespace/name, with 'core' as the group of the core API group and an empty namespace for cluster-scoped items, such as core/configmaps:kube-system/*. Items must also be included by the other filters.")
+	flags.Var(&o.ExcludeItems, "exclude-items", "Items to exclude from the backup, formatted as for --include-items.")
+	flags.Var(&o.ExcludeOwnerKinds, "exclude-owner-kinds", "Kinds of owners whose owned items are excluded from the backup, formatted as Kind.group, such as ReplicaSet.apps, or as Kind for the core API group. Pods with volumes backed up by restic are kept.")
+	flags.BoolVar(&o.ExcludeOwnedResources, "exclude-owned-resources", o.ExcludeOwnedResources, fmt.Sprintf("Exclude the items owned by the built-in controllers that recreate them, %s, in addition to --exclude-owner-kinds.", strings.Join(pkgbackup.DefaultExcludedOwnerKinds, ", ")))
+	flags.DurationVar(&o.ModifiedSince, "modified-since", o.ModifiedSince, "Only back up the items created or modified in this long before the backup starts, such as 6h, going by their creation timestamp and the times of their managed fields. Items whose last modification time isn't known are backed up. Optional.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup.")
 	flags.StringVar(&o.StorageLocation, "storage-location", "", "Location in which to store the backup.")
+	flags.StringSliceVar(&o.MirrorStorageLocations, "mirror-storage-locations", o.MirrorStorageLocations, "List of additional locations to copy the backup to after it's stored in its storage location.")
 	flags.StringSliceVar(&o.SnapshotLocations, "volume-snapshot-locations", o.SnapshotLocations, "List of locations (at most one per provider) where volume snapshots should be stored.")
 	flags.VarP(&o.Selector, "selector", "l", "Only back up resources matching this label selector.")
+	flags.Var(&o.ExcludeAnnotation, "exclude-annotation", "Don't back up resources with this annotation, formatted as key=value, or as key to match any value.")
 	flags.StringVar(&o.OrderedResources, "ordered-resources", "", "Mapping Kinds to an ordered list of specific resources of that Kind.  Resource names are separated by commas and their names are in format 'namespace/resourcename'. For cluster scope resource, simply use resource name. Key-value pairs in the mapping are separated by semi-colon.  Example: 'pods=ns1/pod1,ns1/pod2;persistentvolumeclaims=ns1/pvc4,ns1/pvc8'.  Optional.")
+	flags.StringVar(&o.ResourceSelectors, "resource-selectors", "", "Mapping resources to label selectors that their items must also match to be backed up, in addition to --selector. Resources are formatted as resource.group, such as deployments.apps, and are separated from their selector by a colon. Entries in the mapping are separated by semi-colon.  Example: 'secrets:backup=true;deployments.apps:tier in (frontend,backend)'.  Optional.")
+	flags.StringVar(&o.ExcludeFields, "exclude-fields", "", "Mapping resources to fields that are removed from their items before they're backed up. Resources are formatted as resource.group, such as deployments.apps, or '*' for all resources, and are separated from their comma-separated fields by a colon. Fields are dot-separated paths, such as metadata.managedFields. Entries in the mapping are separated by semi-colon.  Example: 'pods:status;*:metadata.managedFields'.  Optional.")
+	flags.StringVar(&o.ResourceAPIVersions, "resource-api-versions", "", "Mapping resources to the API versions they're backed up at in addition to their preferred version. Resources are formatted as resource.group, such as widgets.example.com, and are separated from their comma-separated versions, or '*' for all the versions they're served at, by a colon. Entries in the mapping are separated by semi-colon.  Example: 'widgets.example.com:v1beta1;gadgets.example.com:*'.  Optional.")
+	flags.IntVar(&o.MaxFailedItems, "max-failed-items", o.MaxFailedItems, "The largest number of items that can fail to be backed up for the backup to be Completed rather than PartiallyFailed. Optional, any failed item makes the backup PartiallyFailed if neither --max-failed-items nor --max-failed-items-percent is set.")
+	flags.IntVar(&o.MaxFailedItemsPercent, "max-failed-items-percent", o.MaxFailedItemsPercent, "The largest percentage of the attempted items that can fail to be backed up for the backup to be Completed rather than PartiallyFailed, from 0 to 100. Optional.")
+	flags.Var(&o.CriticalResources, "critical-resources", "Resources whose items make the backup Failed if any of them fails to be backed up, formatted as resource.group, such as deployments.apps.")
+	flags.Var(o.Compression, "compression", fmt.Sprintf("The algorithm to compress the backup tarball with. Valid values are %s. Optional, defaults to the server's default backup compression.", strings.Join(o.Compression.AllowedValues(), ", ")))
 	f := flags.VarPF(&o.SnapshotVolumes, "snapshot-volumes", "", "Take snapshots of PersistentVolumes as part of the backup.")
 	// this allows the user to just specify "--snapshot-volumes" as shorthand for "--snapshot-volumes=true"
 	// like a normal bool flag
@@ -131,8 +174,23 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup")
 	f.NoOptDefVal = "true"
 
+	f = flags.VarPF(&o.IncludeRelatedClusterResources, "include-related-cluster-resources", "", "Include the cluster-scoped resources referenced by the backed up namespaced resources, such as storage classes and cluster roles, when only some namespaces are backed up and --include-cluster-resources isn't set")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.IncludeAPIServices, "include-api-services", "", "Include the APIServices that register aggregated API servers whose service is in an included namespace, even when cluster-scoped resources aren't included. Set to false to exclude all APIServices")
+	f.NoOptDefVal = "true"
+
 	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
 	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.Incremental, "incremental", "", "Only write the items that changed since the schedule's previous backup to the backup tarball. Only applies to backups created by a schedule")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.RedactSecrets, "redact-secrets", "", "Replace the data values of the backed up secrets with a placeholder, keeping their keys and metadata. Secrets can't be restored from the backup")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.ExcludeDefaultResources, "exclude-default-resources", "", "Exclude the objects that Kubernetes creates on its own, such as each namespace's default service account, unless --include-items includes them")
+	f.NoOptDefVal = "true"
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -162,6 +220,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return fmt.Errorf("A backup name is required, unless you are creating based on a schedule.")
 	}
 
+	if o.ModifiedSince < 0 {
+		return fmt.Errorf("--modified-since must not be negative")
+	}
+
 	if o.StorageLocation != "" {
 		location := &velerov1api.BackupStorageLocation{}
 		if err := client.Get(context.Background(), kbclient.ObjectKey{
@@ -172,6 +234,16 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		}
 	}
 
+	for _, loc := range o.MirrorStorageLocations {
+		location := &velerov1api.BackupStorageLocation{}
+		if err := client.Get(context.Background(), kbclient.ObjectKey{
+			Namespace: f.Namespace(),
+			Name:      loc,
+		}, location); err != nil {
+			return err
+		}
+	}
+
 	for _, loc := range o.SnapshotLocations {
 		if _, err := o.client.VeleroV1().VolumeSnapshotLocations(f.Namespace()).Get(context.TODO(), loc, metav1.GetOptions{}); err != nil {
 			return err
@@ -306,6 +378,115 @@ func parseOrderedResources(orderMapStr string) (map[string]string, error) {
 	return orderedResources, nil
 }
 
+// ParseResourceLabelSelectors converts to map of resources to the label selector that
+// their items must match. Resources are separated from their selector by a colon, and
+// entries in the mapping are separated by semi-colon.
+// Ex: 'secrets:backup=true;deployments.apps:tier in (frontend,backend)'.
+func ParseResourceLabelSelectors(selectorMapStr string) (map[string]string, error) {
+	resourceSelectors := make(map[string]string)
+	for _, entry := range strings.Split(selectorMapStr, ";") {
+		kv := strings.SplitN(entry, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("Invalid ResourceSelectors '%s'.", entry)
+		}
+		resource := strings.TrimSpace(kv[0])
+		selector := strings.TrimSpace(kv[1])
+		if resource == "" || selector == "" {
+			return nil, fmt.Errorf("Invalid ResourceSelectors '%s'.", entry)
+		}
+		resourceSelectors[resource] = selector
+	}
+	return resourceSelectors, nil
+}
+
+// ParseExcludedFields converts to map of resources to the fields that are removed from
+// their items, in the format parsed by parseResourceLists.
+// Ex: 'pods:status,metadata.managedFields;*:metadata.managedFields'.
+func ParseExcludedFields(fieldMapStr string) (map[string][]string, error) {
+	return parseResourceLists(fieldMapStr, "ExcludeFields")
+}
+
+// ParseResourceAPIVersions converts to map of resources to API versions, in the
+// format parsed by parseResourceLists.
+// Ex: 'widgets.example.com:v1beta1,v2;gadgets.example.com:*'.
+func ParseResourceAPIVersions(versionMapStr string) (map[string][]string, error) {
+	return parseResourceLists(versionMapStr, "API versions")
+}
+
+// parseResourceLists converts to map of resources to lists of values. Resources are
+// separated from their comma-separated values by a colon, and entries in the mapping
+// are separated by semi-colon. Invalid entries are reported as the given kind of value.
+func parseResourceLists(mapStr, kind string) (map[string][]string, error) {
+	resourceLists := make(map[string][]string)
+	for _, entry := range strings.Split(mapStr, ";") {
+		kv := strings.SplitN(entry, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("Invalid %s '%s'.", kind, entry)
+		}
+		resource := strings.TrimSpace(kv[0])
+		if resource == "" {
+			return nil, fmt.Errorf("Invalid %s '%s'.", kind, entry)
+		}
+		for _, value := range strings.Split(kv[1], ",") {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, fmt.Errorf("Invalid %s '%s'.", kind, entry)
+			}
+			resourceLists[resource] = append(resourceLists[resource], value)
+		}
+	}
+	return resourceLists, nil
+}
+
+// ItemFilter returns the item filter of the --include-items and
+// --exclude-items flags, or nil if neither is set.
+func (o *CreateOptions) ItemFilter() *velerov1api.ItemFilter {
+	if len(o.IncludeItems) == 0 && len(o.ExcludeItems) == 0 {
+		return nil
+	}
+	return &velerov1api.ItemFilter{
+		IncludedItems: o.IncludeItems,
+		ExcludedItems: o.ExcludeItems,
+	}
+}
+
+// ExcludedOwnerKinds returns the owner kinds of the --exclude-owner-kinds
+// flag, along with the default ones if --exclude-owned-resources is set.
+func (o *CreateOptions) ExcludedOwnerKinds() []string {
+	var kinds []string
+	if o.ExcludeOwnedResources {
+		kinds = append(kinds, pkgbackup.DefaultExcludedOwnerKinds...)
+	}
+	return append(kinds, o.ExcludeOwnerKinds...)
+}
+
+// ModifiedSinceDuration returns the window of the --modified-since flag, or
+// nil if it isn't set.
+func (o *CreateOptions) ModifiedSinceDuration() *metav1.Duration {
+	if o.ModifiedSince <= 0 {
+		return nil
+	}
+	return &metav1.Duration{Duration: o.ModifiedSince}
+}
+
+// ItemFailureThresholds returns the item failure thresholds of the
+// --max-failed-items, --max-failed-items-percent and --critical-resources
+// flags, or nil if none of them is set.
+func (o *CreateOptions) ItemFailureThresholds() *velerov1api.ItemFailureThresholds {
+	if o.MaxFailedItems < 0 && o.MaxFailedItemsPercent < 0 && len(o.CriticalResources) == 0 {
+		return nil
+	}
+
+	thresholds := &velerov1api.ItemFailureThresholds{CriticalResources: o.CriticalResources}
+	if o.MaxFailedItems >= 0 {
+		thresholds.MaxFailedItems = &o.MaxFailedItems
+	}
+	if o.MaxFailedItemsPercent >= 0 {
+		thresholds.MaxFailedItemsPercent = &o.MaxFailedItemsPercent
+	}
+	return thresholds
+}
+
 func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, error) {
 	var backupBuilder *builder.BackupBuilder
 
@@ -326,9 +507,16 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			IncludedResources(o.IncludeResources...).
 			ExcludedResources(o.ExcludeResources...).
 			LabelSelector(o.Selector.LabelSelector).
+			ExcludedAnnotation(o.ExcludeAnnotation.AnnotationMatch).
+			ItemFilter(o.ItemFilter()).
+			ExcludedOwnerKinds(o.ExcludedOwnerKinds()...).
+			ModifiedSince(o.ModifiedSinceDuration()).
+			ItemFailureThresholds(o.ItemFailureThresholds()).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
-			VolumeSnapshotLocations(o.SnapshotLocations...)
+			MirrorStorageLocations(o.MirrorStorageLocations...).
+			VolumeSnapshotLocations(o.SnapshotLocations...).
+			Compression(velerov1api.CompressionAlgorithm(o.Compression.String()))
 		if len(o.OrderedResources) > 0 {
 			orders, err := parseOrderedResources(o.OrderedResources)
 			if err != nil {
@@ -336,6 +524,27 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			}
 			backupBuilder.OrderedResources(orders)
 		}
+		if len(o.ResourceSelectors) > 0 {
+			selectors, err := ParseResourceLabelSelectors(o.ResourceSelectors)
+			if err != nil {
+				return nil, err
+			}
+			backupBuilder.ResourceLabelSelectors(selectors)
+		}
+		if len(o.ExcludeFields) > 0 {
+			fields, err := ParseExcludedFields(o.ExcludeFields)
+			if err != nil {
+				return nil, err
+			}
+			backupBuilder.ExcludedFields(fields)
+		}
+		if len(o.ResourceAPIVersions) > 0 {
+			versions, err := ParseResourceAPIVersions(o.ResourceAPIVersions)
+			if err != nil {
+				return nil, err
+			}
+			backupBuilder.ResourceAPIVersions(versions)
+		}
 
 		if o.SnapshotVolumes.Value != nil {
 			backupBuilder.SnapshotVolumes(*o.SnapshotVolumes.Value)
@@ -343,9 +552,24 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
+		if o.IncludeRelatedClusterResources.Value != nil {
+			backupBuilder.IncludeRelatedClusterResources(*o.IncludeRelatedClusterResources.Value)
+		}
+		if o.IncludeAPIServices.Value != nil {
+			backupBuilder.IncludeAPIServices(*o.IncludeAPIServices.Value)
+		}
 		if o.DefaultVolumesToRestic.Value != nil {
 			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
 		}
+		if o.Incremental.Value != nil {
+			backupBuilder.Incremental(*o.Incremental.Value)
+		}
+		if o.RedactSecrets.Value != nil {
+			backupBuilder.RedactSecrets(*o.RedactSecrets.Value)
+		}
+		if o.ExcludeDefaultResources.Value != nil {
+			backupBuilder.ExcludeDefaultResources(*o.ExcludeDefaultResources.Value)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
@@ -19,6 +19,7 @@ package backup
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,6 +58,27 @@ func TestCreateOptions_BuildBackup(t *testing.T) {
 	}, backup.Spec.OrderedResources)
 }
 
+func TestCreateOptions_ItemFailureThresholds(t *testing.T) {
+	o := NewCreateOptions()
+	assert.Nil(t, o.ItemFailureThresholds())
+
+	o.MaxFailedItemsPercent = 0
+	o.CriticalResources.Set("secrets,deployments.apps")
+	maxFailedItemsPercent := 0
+	assert.Equal(t, &velerov1api.ItemFailureThresholds{
+		MaxFailedItemsPercent: &maxFailedItemsPercent,
+		CriticalResources:     []string{"secrets", "deployments.apps"},
+	}, o.ItemFailureThresholds())
+}
+
+func TestCreateOptions_ModifiedSinceDuration(t *testing.T) {
+	o := NewCreateOptions()
+	assert.Nil(t, o.ModifiedSinceDuration())
+
+	o.ModifiedSince = 6 * time.Hour
+	assert.Equal(t, &metav1.Duration{Duration: 6 * time.Hour}, o.ModifiedSinceDuration())
+}
+
 func TestCreateOptions_BuildBackupFromSchedule(t *testing.T) {
 	o := NewCreateOptions()
 	o.FromSchedule = "test"
@@ -122,3 +144,48 @@ func TestCreateOptions_OrderedResources(t *testing.T) {
 	assert.Equal(t, orderedResources, expectedMixedResources)
 
 }
+
+func TestParseResourceLabelSelectors(t *testing.T) {
+	selectors, err := ParseResourceLabelSelectors("secrets:backup=true ; deployments.apps: tier in (frontend,backend)")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"secrets":          "backup=true",
+		"deployments.apps": "tier in (frontend,backend)",
+	}, selectors)
+
+	_, err = ParseResourceLabelSelectors("secrets=backup=true")
+	assert.Error(t, err)
+
+	_, err = ParseResourceLabelSelectors("secrets:")
+	assert.Error(t, err)
+}
+
+func TestParseExcludedFields(t *testing.T) {
+	fields, err := ParseExcludedFields("pods:status, metadata.managedFields ; *:metadata.managedFields")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"pods": {"status", "metadata.managedFields"},
+		"*":    {"metadata.managedFields"},
+	}, fields)
+
+	_, err = ParseExcludedFields("pods=status")
+	assert.Error(t, err)
+
+	_, err = ParseExcludedFields("pods:status,")
+	assert.Error(t, err)
+}
+
+func TestParseResourceAPIVersions(t *testing.T) {
+	versions, err := ParseResourceAPIVersions("widgets.example.com:v1beta1, v2 ; gadgets.example.com:*")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"widgets.example.com": {"v1beta1", "v2"},
+		"gadgets.example.com": {"*"},
+	}, versions)
+
+	_, err = ParseResourceAPIVersions("widgets.example.com=v2")
+	assert.Error(t, err)
+
+	_, err = ParseResourceAPIVersions(":v2")
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -41,6 +42,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		listOptions           metav1.ListOptions
 		details               bool
 		insecureSkipTLSVerify bool
+		outputFormat          = "text"
 	)
 
 	config, err := client.LoadConfig()
@@ -53,6 +55,12 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 		Use:   use + " [NAME1] [NAME2] [NAME...]",
 		Short: "Describe backups",
 		Run: func(c *cobra.Command, args []string) {
+			switch outputFormat {
+			case "text", "json", "yaml":
+			default:
+				cmd.CheckError(errors.Errorf("invalid output format %q - valid values are 'text', 'json', and 'yaml'", outputFormat))
+			}
+
 			veleroClient, err := f.Client()
 			cmd.CheckError(err)
 
@@ -73,6 +81,7 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 			}
 
 			first := true
+			descriptions := make([]*output.BackupDescription, 0, len(backups.Items))
 			for _, backup := range backups.Items {
 				deleteRequestListOptions := pkgbackup.NewDeleteBackupRequestListOptions(backup.Name, string(backup.UID))
 				deleteRequestList, err := veleroClient.VeleroV1().DeleteBackupRequests(f.Namespace()).List(context.TODO(), deleteRequestListOptions)
@@ -102,7 +111,13 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					}
 				}
 
-				s := output.DescribeBackup(context.Background(), kbClient, &backup, deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, veleroClient, insecureSkipTLSVerify, caCertFile)
+				description := output.NewBackupDescription(context.Background(), kbClient, &backup, deleteRequestList.Items, podVolumeBackupList.Items, vscList.Items, details, insecureSkipTLSVerify, caCertFile)
+				if outputFormat != "text" {
+					descriptions = append(descriptions, description)
+					continue
+				}
+
+				s := output.DescribeBackupDescription(description)
 				if first {
 					first = false
 					fmt.Print(s)
@@ -111,11 +126,24 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 				}
 			}
 			cmd.CheckError(err)
+
+			if outputFormat != "text" {
+				// a single backup is printed by itself rather than in a list
+				var toEncode interface{} = descriptions
+				if len(descriptions) == 1 {
+					toEncode = descriptions[0]
+				}
+
+				encoded, err := output.EncodeDescription(toEncode, outputFormat)
+				cmd.CheckError(err)
+				fmt.Println(string(encoded))
+			}
 		},
 	}
 
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 	c.Flags().BoolVar(&details, "details", details, "Display additional detail in the command output.")
+	c.Flags().StringVarP(&outputFormat, "output", "o", outputFormat, "Output display format. Valid formats are 'text', 'json', and 'yaml'. The 'json' and 'yaml' formats include everything the 'text' format shows.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
 	return c
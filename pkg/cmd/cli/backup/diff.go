@@ -0,0 +1,322 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+)
+
+func NewDiffCommand(f client.Factory) *cobra.Command {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+	o := NewDiffOptions()
+	o.caCertFile = config.CACertFile()
+
+	c := &cobra.Command{
+		Use:   "diff BACKUP_A BACKUP_B",
+		Short: "Compare the contents of two backups",
+		Long: `Compare the contents of two backups, reporting the items that are only in BACKUP_B (added),
+the items that are only in BACKUP_A (removed), and the items whose contents differ (changed),
+for each resource. Fields that the cluster sets on every object, such as resourceVersion and status,
+are ignored when comparing items. The backups can be stored in different backup storage locations.`,
+		Example: `  # Compare the number of items of each resource in two backups.
+  velero backup diff backup-1 backup-2
+
+  # List the items that differ, as JSON.
+  velero backup diff backup-1 backup-2 --details -o json`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type DiffOptions struct {
+	From                  string
+	To                    string
+	Details               bool
+	Output                string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	caCertFile            string
+}
+
+func NewDiffOptions() *DiffOptions {
+	return &DiffOptions{
+		Output:  "text",
+		Timeout: time.Minute,
+	}
+}
+
+func (o *DiffOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Details, "details", o.Details, "List the items that were added, removed, and changed, rather than only counting them.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format. Valid values are 'text' and 'json'.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download requests.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.caCertFile, "cacert", o.caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *DiffOptions) Complete(args []string) error {
+	o.From = args[0]
+	o.To = args[1]
+	return nil
+}
+
+func (o *DiffOptions) Validate() error {
+	switch o.Output {
+	case "text", "json":
+		return nil
+	default:
+		return errors.Errorf("invalid output format %q - valid values are 'text' and 'json'", o.Output)
+	}
+}
+
+func (o *DiffOptions) Run(c *cobra.Command, f client.Factory) error {
+	fromItems, err := o.readBackupContents(f, o.From)
+	if err != nil {
+		return err
+	}
+	toItems, err := o.readBackupContents(f, o.To)
+	if err != nil {
+		return err
+	}
+
+	diff := DiffBackupContents(o.From, o.To, fromItems, toItems, o.Details)
+
+	if o.Output == "json" {
+		encoded, err := output.EncodeDescription(diff, o.Output)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(encoded))
+		return nil
+	}
+
+	return printBackupDiff(os.Stdout, diff)
+}
+
+// readBackupContents downloads a backup's contents tarball to a temp file,
+// from whichever storage location the backup is in, and reads its items.
+func (o *DiffOptions) readBackupContents(f client.Factory, name string) ([]archive.ContentsItem, error) {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return nil, err
+	}
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the contents of encrypted backups are downloaded as they're stored,
+	// and can't be decrypted without the location's encryption key.
+	location, err := veleroClient.VeleroV1().BackupStorageLocations(f.Namespace()).Get(context.TODO(), backup.Spec.StorageLocation, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location of backup %s", name)
+	}
+	if location.Spec.EncryptionKey != nil {
+		return nil, errors.Errorf("backup %s is encrypted by backup storage location %s and can't be compared", name, location.Name)
+	}
+
+	tmpFile, err := ioutil.TempFile("", fmt.Sprintf("velero-backup-diff-%s-", name))
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp file")
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	if err := downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), name, velerov1api.DownloadTargetKindBackupContents, tmpFile, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			return nil, errors.Errorf("contents of backup %s not found", name)
+		}
+		return nil, errors.Wrapf(err, "error downloading contents of backup %s", name)
+	}
+
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items, err := archive.ReadBackupContents(tmpFile, backup.Status.CompressionAlgorithm)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading contents of backup %s", name)
+	}
+	return items, nil
+}
+
+// BackupDiff is the difference between the contents of two backups.
+type BackupDiff struct {
+	From      string         `json:"from"`
+	To        string         `json:"to"`
+	Resources []ResourceDiff `json:"resources"`
+}
+
+// ResourceDiff is the difference between the items of a group-resource in
+// two backups. The added, removed, and changed items are only listed if
+// details were requested.
+type ResourceDiff struct {
+	GroupResource string   `json:"groupResource"`
+	FromCount     int      `json:"fromCount"`
+	ToCount       int      `json:"toCount"`
+	AddedCount    int      `json:"addedCount"`
+	RemovedCount  int      `json:"removedCount"`
+	ChangedCount  int      `json:"changedCount"`
+	Added         []string `json:"added,omitempty"`
+	Removed       []string `json:"removed,omitempty"`
+	Changed       []string `json:"changed,omitempty"`
+}
+
+// DiffBackupContents compares the items of two backups, returning the
+// group-resources whose items differ, sorted by name. Items are identified
+// by namespace/name, or name for cluster-scoped items.
+func DiffBackupContents(from, to string, fromItems, toItems []archive.ContentsItem, details bool) *BackupDiff {
+	index := func(items []archive.ContentsItem) map[string]map[string]string {
+		res := make(map[string]map[string]string)
+		for _, item := range items {
+			if res[item.GroupResource] == nil {
+				res[item.GroupResource] = make(map[string]string)
+			}
+			key := item.Name
+			if item.Namespace != "" {
+				key = item.Namespace + "/" + item.Name
+			}
+			res[item.GroupResource][key] = item.Digest
+		}
+		return res
+	}
+	fromIndex, toIndex := index(fromItems), index(toItems)
+
+	groupResources := make([]string, 0, len(fromIndex)+len(toIndex))
+	for groupResource := range fromIndex {
+		groupResources = append(groupResources, groupResource)
+	}
+	for groupResource := range toIndex {
+		if _, ok := fromIndex[groupResource]; !ok {
+			groupResources = append(groupResources, groupResource)
+		}
+	}
+	sort.Strings(groupResources)
+
+	diff := &BackupDiff{From: from, To: to, Resources: []ResourceDiff{}}
+	for _, groupResource := range groupResources {
+		fromResource, toResource := fromIndex[groupResource], toIndex[groupResource]
+
+		var added, removed, changed []string
+		for key, digest := range toResource {
+			fromDigest, ok := fromResource[key]
+			switch {
+			case !ok:
+				added = append(added, key)
+			case fromDigest != digest:
+				changed = append(changed, key)
+			}
+		}
+		for key := range fromResource {
+			if _, ok := toResource[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+
+		if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+			continue
+		}
+
+		resourceDiff := ResourceDiff{
+			GroupResource: groupResource,
+			FromCount:     len(fromResource),
+			ToCount:       len(toResource),
+			AddedCount:    len(added),
+			RemovedCount:  len(removed),
+			ChangedCount:  len(changed),
+		}
+		if details {
+			sort.Strings(added)
+			sort.Strings(removed)
+			sort.Strings(changed)
+			resourceDiff.Added, resourceDiff.Removed, resourceDiff.Changed = added, removed, changed
+		}
+		diff.Resources = append(diff.Resources, resourceDiff)
+	}
+
+	return diff
+}
+
+func printBackupDiff(w io.Writer, diff *BackupDiff) error {
+	if len(diff.Resources) == 0 {
+		_, err := fmt.Fprintf(w, "Backups %s and %s have the same contents.\n", diff.From, diff.To)
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "RESOURCE\t%s\t%s\tADDED\tREMOVED\tCHANGED\n", strings.ToUpper(diff.From), strings.ToUpper(diff.To))
+	for _, r := range diff.Resources {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\n", r.GroupResource, r.FromCount, r.ToCount, r.AddedCount, r.RemovedCount, r.ChangedCount)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, r := range diff.Resources {
+		if len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n%s:\n", r.GroupResource)
+		for _, key := range r.Added {
+			fmt.Fprintf(w, "  + %s\n", key)
+		}
+		for _, key := range r.Removed {
+			fmt.Fprintf(w, "  - %s\n", key)
+		}
+		for _, key := range r.Changed {
+			fmt.Fprintf(w, "  ~ %s\n", key)
+		}
+	}
+
+	return nil
+}
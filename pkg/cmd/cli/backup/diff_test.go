@@ -0,0 +1,86 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+func TestDiffBackupContents(t *testing.T) {
+	fromItems := []archive.ContentsItem{
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Digest: "a"},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Digest: "b"},
+		{GroupResource: "secrets", Namespace: "ns-1", Name: "secret-1", Digest: "c"},
+		{GroupResource: "persistentvolumes", Name: "pv-1", Digest: "d"},
+	}
+	toItems := []archive.ContentsItem{
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Digest: "changed"},
+		{GroupResource: "pods", Namespace: "ns-1", Name: "pod-3", Digest: "e"},
+		{GroupResource: "deployments.apps", Namespace: "ns-1", Name: "deploy-1", Digest: "f"},
+		{GroupResource: "persistentvolumes", Name: "pv-1", Digest: "d"},
+	}
+
+	diff := DiffBackupContents("backup-1", "backup-2", fromItems, toItems, true)
+	assert.Equal(t, &BackupDiff{
+		From: "backup-1",
+		To:   "backup-2",
+		Resources: []ResourceDiff{
+			{GroupResource: "deployments.apps", ToCount: 1, AddedCount: 1, Added: []string{"ns-1/deploy-1"}},
+			{GroupResource: "pods", FromCount: 2, ToCount: 2, AddedCount: 1, RemovedCount: 1, ChangedCount: 1, Added: []string{"ns-1/pod-3"}, Removed: []string{"ns-1/pod-2"}, Changed: []string{"ns-1/pod-1"}},
+			{GroupResource: "secrets", FromCount: 1, RemovedCount: 1, Removed: []string{"ns-1/secret-1"}},
+		},
+	}, diff)
+
+	// without details, only the counts are reported.
+	diff = DiffBackupContents("backup-1", "backup-2", fromItems, toItems, false)
+	require.Len(t, diff.Resources, 3)
+	assert.Equal(t, ResourceDiff{GroupResource: "pods", FromCount: 2, ToCount: 2, AddedCount: 1, RemovedCount: 1, ChangedCount: 1}, diff.Resources[1])
+
+	diff = DiffBackupContents("backup-1", "backup-2", fromItems, fromItems, true)
+	assert.Empty(t, diff.Resources)
+}
+
+func TestPrintBackupDiff(t *testing.T) {
+	diff := &BackupDiff{
+		From: "backup-1",
+		To:   "backup-2",
+		Resources: []ResourceDiff{
+			{GroupResource: "pods", FromCount: 2, ToCount: 2, AddedCount: 1, RemovedCount: 1, ChangedCount: 1, Added: []string{"ns-1/pod-3"}, Removed: []string{"ns-1/pod-2"}, Changed: []string{"ns-1/pod-1"}},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, printBackupDiff(buf, diff))
+	assert.Equal(t, `RESOURCE  BACKUP-1  BACKUP-2  ADDED  REMOVED  CHANGED
+pods      2         2         1      1        1
+
+pods:
+  + ns-1/pod-3
+  - ns-1/pod-2
+  ~ ns-1/pod-1
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, printBackupDiff(buf, &BackupDiff{From: "backup-1", To: "backup-2"}))
+	assert.Equal(t, "Backups backup-1 and backup-2 have the same contents.\n", buf.String())
+}
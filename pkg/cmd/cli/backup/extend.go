@@ -0,0 +1,163 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/user"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewExtendCommand(f client.Factory) *cobra.Command {
+	o := NewExtendOptions()
+
+	c := &cobra.Command{
+		Use:   "extend NAME",
+		Short: "Keep a backup past its expiration",
+		Long: `Keep a backup past its expiration, by setting an expiration override on it that the garbage
+collector honors instead of the backup's status.expiration. The new expiration must be in the future,
+and later than the backup's current expiration. Who requested the override, when, and why are recorded
+in the backup's annotations.
+
+This is a client-side convenience for setting those annotations: the server doesn't check who set them,
+so the requester is whatever this command records, and anyone allowed to update backups can set or change
+an override directly. Use Kubernetes RBAC on backups to control who can extend them.`,
+		Example: `  # Keep a backup for 30 more days from now.
+  velero backup extend backup-1 --ttl 720h --reason "needed for the incident review"
+
+  # Keep a backup until a specific time.
+  velero backup extend backup-1 --expiration 2026-12-31T00:00:00Z`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type ExtendOptions struct {
+	Name        string
+	TTL         time.Duration
+	Expiration  string
+	Reason      string
+	RequestedBy string
+}
+
+func NewExtendOptions() *ExtendOptions {
+	return &ExtendOptions{}
+}
+
+func (o *ExtendOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.TTL, "ttl", o.TTL, "How long from now to keep the backup.")
+	flags.StringVar(&o.Expiration, "expiration", o.Expiration, "When the backup expires, as an RFC 3339 time such as 2026-12-31T00:00:00Z.")
+	flags.StringVar(&o.Reason, "reason", o.Reason, "Why the backup is being kept, recorded in its annotations.")
+	flags.StringVar(&o.RequestedBy, "requested-by", o.RequestedBy, "Who is requesting the extension, recorded in the backup's annotations as given and not verified. Defaults to the current OS user.")
+}
+
+func (o *ExtendOptions) Complete(args []string) error {
+	o.Name = args[0]
+
+	if o.RequestedBy == "" {
+		if current, err := user.Current(); err == nil {
+			o.RequestedBy = current.Username
+		}
+	}
+
+	return nil
+}
+
+func (o *ExtendOptions) Validate() error {
+	if (o.TTL == 0) == (o.Expiration == "") {
+		return errors.New("exactly one of --ttl and --expiration must be specified")
+	}
+	if o.TTL < 0 {
+		return errors.New("--ttl must be positive")
+	}
+	if o.Expiration != "" {
+		if _, err := time.Parse(time.RFC3339, o.Expiration); err != nil {
+			return errors.Errorf("invalid --expiration %q, must be an RFC 3339 time such as 2026-12-31T00:00:00Z", o.Expiration)
+		}
+	}
+	return nil
+}
+
+func (o *ExtendOptions) Run(c *cobra.Command, f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if backup.DeletionTimestamp != nil {
+		return errors.Errorf("backup %s is being deleted", o.Name)
+	}
+
+	now := time.Now()
+	expiration := now.Add(o.TTL)
+	if o.Expiration != "" {
+		// validated in Validate
+		expiration, _ = time.Parse(time.RFC3339, o.Expiration)
+	}
+
+	annotations, err := pkgbackup.ExpirationOverrideAnnotations(backup, pkgbackup.ExpirationOverride{
+		Expiration:  expiration,
+		RequestedBy: o.RequestedBy,
+		Reason:      o.Reason,
+	}, now)
+	if err != nil {
+		return err
+	}
+
+	// the annotations are merged into the backup's, so that other changes to
+	// the backup made since it was read aren't overwritten.
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Patch(context.TODO(), o.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "error extending backup %s", o.Name)
+	}
+
+	fmt.Printf("Backup %s now expires at %s.\n", o.Name, annotations[velerov1api.ExpirationOverrideAnnotation])
+	return nil
+}
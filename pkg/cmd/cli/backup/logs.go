@@ -17,19 +17,23 @@ limitations under the License.
 package backup
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 )
 
 func NewLogsCommand(f client.Factory) *cobra.Command {
@@ -41,6 +45,8 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 	timeout := time.Minute
 	insecureSkipTLSVerify := false
 	caCertFile := config.CACertFile()
+	follow := false
+	followInterval := 10 * time.Second
 
 	c := &cobra.Command{
 		Use:   "logs BACKUP",
@@ -63,8 +69,17 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 			}
 
 			switch backup.Status.Phase {
-			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
+			case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseCancelled:
 				// terminal phases, do nothing.
+			case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+				if !follow {
+					cmd.Exit("Logs for backup %q are not available until it's finished processing. Please wait "+
+						"until the backup has a phase of Completed or Failed and try again, or use --follow.", backupName)
+				}
+
+				err = followLogs(veleroClient, kbClient, f.Namespace(), backupName, os.Stdout, followInterval, timeout, insecureSkipTLSVerify, caCertFile)
+				cmd.CheckError(err)
+				return
 			default:
 				cmd.Exit("Logs for backup %q are not available until it's finished processing. Please wait "+
 					"until the backup has a phase of Completed or Failed and try again.", backupName)
@@ -78,5 +93,44 @@ func NewLogsCommand(f client.Factory) *cobra.Command {
 	c.Flags().DurationVar(&timeout, "timeout", timeout, "How long to wait to receive logs.")
 	c.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", insecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
 	c.Flags().StringVar(&caCertFile, "cacert", caCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+	c.Flags().BoolVarP(&follow, "follow", "f", follow, "If the backup is running, stream its logs until it finishes. The logs are only as current as the last time the server uploaded them, see the server's --backup-log-flush-interval flag.")
+	c.Flags().DurationVar(&followInterval, "follow-interval", followInterval, "How often to check for new logs when using --follow.")
 	return c
 }
+
+// followLogs writes the logs of a running backup to w as they're uploaded to
+// object storage, until the backup finishes and its complete log has been
+// written.
+func followLogs(veleroClient clientset.Interface, kbClient kbclient.Client, namespace, backupName string, w io.Writer, interval, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+	// the log is downloaded in full each time, so only what's been added to
+	// it since it was last downloaded is written.
+	var written int
+	for {
+		backup, err := veleroClient.VeleroV1().Backups(namespace).Get(context.TODO(), backupName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		// the complete log is uploaded before the backup's phase is updated,
+		// so once the backup has finished, the next download is the last one.
+		finished := backup.Status.Phase != velerov1api.BackupPhaseNew && backup.Status.Phase != velerov1api.BackupPhaseInProgress
+
+		buf := new(bytes.Buffer)
+		err = downloadrequest.StreamPartial(context.Background(), kbClient, namespace, backupName, velerov1api.DownloadTargetKindBackupLog, buf, timeout, insecureSkipTLSVerify, caCertFile)
+		switch {
+		case err == downloadrequest.ErrNotFound && !finished:
+			// the backup's log hasn't been uploaded yet.
+		case err != nil:
+			return err
+		case buf.Len() > written:
+			if _, err := w.Write(buf.Bytes()[written:]); err != nil {
+				return err
+			}
+			written = buf.Len()
+		}
+
+		if finished {
+			return nil
+		}
+		time.Sleep(interval)
+	}
+}
@@ -30,6 +30,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 	}
 
 	c.AddCommand(
+		NewCheckCommand(f, "check"),
 		NewCreateCommand(f, "create"),
 		NewDeleteCommand(f, "delete"),
 		NewGetCommand(f, "get"),
@@ -0,0 +1,143 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewCheckCommand creates and returns a new cobra command for checking a
+// backup storage location's access.
+func NewCheckCommand(f client.Factory, use string) *cobra.Command {
+	o := NewCheckOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Check a backup storage location's credentials and permissions",
+		Long: `Check that a backup storage location's credentials and permissions allow uploading,
+downloading, listing and deleting objects. The Velero server uploads a uniquely named test
+object to the location's metadata directory, downloads it, lists it and deletes it, and
+records the result of each operation in the location's status.lastAccessCheck. The test
+object is deleted even if the other operations fail. Read-only locations are never written
+to, so only listing them is checked.
+
+The command exits with an error if any of the operations failed.`,
+		Example: `  # Check the access of a backup storage location named "default".
+  velero backup-location check default`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// CheckOptions are the options of the backup-location check command.
+type CheckOptions struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func NewCheckOptions() *CheckOptions {
+	return &CheckOptions{
+		Timeout: time.Minute,
+	}
+}
+
+func (o *CheckOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the Velero server to run the check.")
+}
+
+func (o *CheckOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *CheckOptions) Run(c *cobra.Command, f client.Factory) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	locations := veleroClient.VeleroV1().BackupStorageLocations(f.Namespace())
+
+	// the request's value is recorded in the check's result, which tells
+	// this check apart from earlier ones.
+	requestedAt := time.Now().UTC().Format(time.RFC3339Nano)
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, velerov1api.AccessCheckRequestedAnnotation, requestedAt))
+	if _, err := locations.Patch(context.TODO(), o.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "error requesting the access check of backup storage location %s", o.Name)
+	}
+	fmt.Printf("Checking the access of backup storage location %s.\n", o.Name)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	timeout := time.After(o.Timeout)
+
+	for {
+		select {
+		case <-timeout:
+			return errors.Errorf("timed out waiting for the access check of backup storage location %s, check that the Velero server is running", o.Name)
+		case <-ticker.C:
+			location, err := locations.Get(context.TODO(), o.Name, metav1.GetOptions{})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			check := location.Status.LastAccessCheck
+			if check == nil || check.RequestedAt != requestedAt {
+				continue
+			}
+			return printAccessCheck(check)
+		}
+	}
+}
+
+// printAccessCheck prints the result of each of an access check's
+// operations, returning an error if any failed.
+func printAccessCheck(check *velerov1api.BackupStorageLocationAccessCheck) error {
+	var failed []string
+	for _, operation := range check.Operations {
+		if operation.Succeeded {
+			fmt.Printf("\t%s:\tsucceeded\n", operation.Operation)
+			continue
+		}
+		fmt.Printf("\t%s:\tfailed: %s\n", operation.Operation, operation.Message)
+		failed = append(failed, operation.Operation)
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("access check failed for operations: %v", failed)
+	}
+	fmt.Println("Access check succeeded.")
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -65,6 +66,7 @@ type CreateOptions struct {
 	Provider                              string
 	Bucket                                string
 	Credential                            flag.Map
+	EncryptionKey                         flag.Map
 	DefaultBackupStorageLocation          bool
 	Prefix                                string
 	BackupSyncPeriod, ValidationFrequency time.Duration
@@ -72,12 +74,17 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	OrphanCleanup, OrphanCleanupDryRun    bool
+	OrphanCleanupGracePeriod              time.Duration
+	RequestTimeout                        time.Duration
+	RequestRetries                        int
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Credential: flag.NewMap(),
-		Config:     flag.NewMap(),
+		Credential:    flag.NewMap(),
+		EncryptionKey: flag.NewMap(),
+		Config:        flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
@@ -90,6 +97,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Provider, "provider", o.Provider, "Name of the backup storage provider (e.g. aws, azure, gcp).")
 	flags.StringVar(&o.Bucket, "bucket", o.Bucket, "Name of the object storage bucket where backups should be stored.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.Var(&o.EncryptionKey, "encryption-key", "The 32-byte key used to encrypt backup contents with AES-256-GCM before they're uploaded, as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Only the contents tarballs are encrypted; backup metadata, logs and other files are uploaded in plaintext. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
@@ -102,6 +110,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.BoolVar(&o.OrphanCleanup, "orphan-cleanup", o.OrphanCleanup, "Delete the backups in the backup storage location that have had no Backup in the cluster for the grace period. Optional.")
+	flags.DurationVar(&o.OrphanCleanupGracePeriod, "orphan-cleanup-grace-period", o.OrphanCleanupGracePeriod, "How long a backup must have no Backup in the cluster before it's deleted with --orphan-cleanup. Optional. Default 24 hours.")
+	flags.BoolVar(&o.OrphanCleanupDryRun, "orphan-cleanup-dry-run", o.OrphanCleanupDryRun, "Only report the backups that --orphan-cleanup would delete, with events on the backup storage location. Optional.")
+	flags.DurationVar(&o.RequestTimeout, "request-timeout", o.RequestTimeout, "How long each request to the object store can take before it fails. Optional. Default: no timeout.")
+	flags.IntVar(&o.RequestRetries, "request-retries", o.RequestRetries, "How many times a failed request to the object store is retried. Optional. Default: 0.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -125,6 +138,26 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if len(o.EncryptionKey.Data()) > 1 {
+		return errors.New("--encryption-key can only contain 1 key/value pair")
+	}
+
+	if !o.OrphanCleanup && (c.Flags().Changed("orphan-cleanup-grace-period") || o.OrphanCleanupDryRun) {
+		return errors.New("--orphan-cleanup-grace-period and --orphan-cleanup-dry-run require --orphan-cleanup")
+	}
+
+	if o.OrphanCleanupGracePeriod < 0 {
+		return errors.New("--orphan-cleanup-grace-period must be non-negative")
+	}
+
+	if c.Flags().Changed("request-timeout") && o.RequestTimeout <= 0 {
+		return errors.New("--request-timeout must be positive")
+	}
+
+	if o.RequestRetries < 0 {
+		return errors.New("--request-retries must be non-negative")
+	}
+
 	return nil
 }
 
@@ -163,6 +196,33 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		break
 	}
 
+	var encryptionKey *corev1api.SecretKeySelector
+	for name, key := range o.EncryptionKey.Data() {
+		encryptionKey = builder.ForSecretKeySelector(name, key).Result()
+		break
+	}
+
+	var deletePolicy *velerov1api.BackupStorageLocationDeletePolicy
+	if o.OrphanCleanup {
+		deletePolicy = &velerov1api.BackupStorageLocationDeletePolicy{
+			OrphanCleanup: true,
+			DryRun:        o.OrphanCleanupDryRun,
+		}
+		if c.Flags().Changed("orphan-cleanup-grace-period") {
+			deletePolicy.GracePeriod = &metav1.Duration{Duration: o.OrphanCleanupGracePeriod}
+		}
+	}
+
+	var requestPolicy *velerov1api.BackupStorageLocationRequestPolicy
+	if c.Flags().Changed("request-timeout") || o.RequestRetries > 0 {
+		requestPolicy = &velerov1api.BackupStorageLocationRequestPolicy{
+			Retries: o.RequestRetries,
+		}
+		if c.Flags().Changed("request-timeout") {
+			requestPolicy.Timeout = &metav1.Duration{Duration: o.RequestTimeout}
+		}
+	}
+
 	backupStorageLocation := &velerov1api.BackupStorageLocation{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -180,10 +240,13 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			},
 			Config:              o.Config.Data(),
 			Credential:          builder.ForSecretKeySelector(secretName, secretKey).Result(),
+			EncryptionKey:       encryptionKey,
 			Default:             o.DefaultBackupStorageLocation,
 			AccessMode:          velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
 			BackupSyncPeriod:    backupSyncPeriod,
 			ValidationFrequency: validationFrequency,
+			DeletePolicy:        deletePolicy,
+			RequestPolicy:       requestPolicy,
 		},
 	}
 
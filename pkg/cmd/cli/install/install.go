@@ -71,6 +71,7 @@ type InstallOptions struct {
 	CACertFile                        string
 	Features                          string
 	DefaultVolumesToRestic            bool
+	ResticBlockVolumes                bool
 }
 
 // BindFlags adds command line values to the options struct.
@@ -105,6 +106,7 @@ func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.StringVar(&o.Features, "features", o.Features, "Comma separated list of Velero feature flags to be set on the Velero deployment and the restic daemonset, if restic is enabled")
 	flags.BoolVar(&o.DefaultVolumesToRestic, "default-volumes-to-restic", o.DefaultVolumesToRestic, "Bool flag to configure Velero server to use restic by default to backup all pod volumes on all backups. Optional.")
+	flags.BoolVar(&o.ResticBlockVolumes, "restic-block-volumes", o.ResticBlockVolumes, "Run the restic daemonset in privileged mode with the host's /dev and kubelet plugins directories mounted, so that it can back up and restore raw block volumes. Optional.")
 }
 
 // NewInstallOptions instantiates a new, default InstallOptions struct.
@@ -187,6 +189,7 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		CACertData:                        caCertData,
 		Features:                          strings.Split(o.Features, ","),
 		DefaultVolumesToRestic:            o.DefaultVolumesToRestic,
+		ResticBlockVolumes:                o.ResticBlockVolumes,
 	}, nil
 }
 
@@ -375,6 +378,10 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 		return errors.New("--use-restic is required when using --default-volumes-to-restic")
 	}
 
+	if o.ResticBlockVolumes && !o.UseRestic {
+		return errors.New("--use-restic is required when using --restic-block-volumes")
+	}
+
 	switch {
 	case o.SecretFile == "" && !o.NoSecret:
 		return errors.New("One of --secret-file or --no-secret is required")
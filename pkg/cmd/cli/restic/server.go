@@ -50,6 +50,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
@@ -68,11 +69,27 @@ const (
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// defaultPodVolumeBackupConcurrency is how many pod volume backups
+	// are run at once on each node by default.
+	defaultPodVolumeBackupConcurrency = 1
+
+	// defaultPluginDir is where the restic server looks for plugins by
+	// default, the same directory as the Velero server.
+	defaultPluginDir = "/plugins"
+
+	// maxPodVolumeBackupConcurrency caps the pod volume backups run at
+	// once on each node, since each restic backup holds a file descriptor
+	// for every file it's reading and a misconfigured value could exhaust
+	// the restic server's file descriptors.
+	maxPodVolumeBackupConcurrency = 16
 )
 
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	podVolumeBackupConcurrency := defaultPodVolumeBackupConcurrency
+	pluginDir := defaultPluginDir
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -87,7 +104,10 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
-			s, err := newResticServer(logger, f, defaultMetricsAddress)
+			workers, err := podVolumeBackupWorkers(podVolumeBackupConcurrency, logger)
+			cmd.CheckError(err)
+
+			s, err := newResticServer(logger, f, defaultMetricsAddress, workers, pluginDir, logLevel)
 			cmd.CheckError(err)
 
 			s.run()
@@ -96,6 +116,8 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().StringVar(&pluginDir, "plugin-dir", pluginDir, "Directory containing Velero plugins. Only backup data integrity plugins are used by the restic server.")
+	command.Flags().IntVar(&podVolumeBackupConcurrency, "pod-volume-backup-concurrency", podVolumeBackupConcurrency, fmt.Sprintf("How many pod volume backups to run at once on this node, up to %d.", maxPodVolumeBackupConcurrency))
 
 	return command
 }
@@ -114,9 +136,29 @@ type resticServer struct {
 	metrics               *metrics.ServerMetrics
 	metricsAddress        string
 	namespace             string
+	pluginRegistry        clientmgmt.Registry
+	logLevel              logrus.Level
+
+	podVolumeBackupConcurrency int
+}
+
+// podVolumeBackupWorkers returns how many workers the pod volume backup
+// controller runs for the requested concurrency, which is capped at
+// maxPodVolumeBackupConcurrency.
+func podVolumeBackupWorkers(concurrency int, logger logrus.FieldLogger) (int, error) {
+	if concurrency < 1 {
+		return 0, errors.Errorf("invalid pod volume backup concurrency %d: must be at least 1", concurrency)
+	}
+
+	if concurrency > maxPodVolumeBackupConcurrency {
+		logger.Warnf("Pod volume backup concurrency %d is higher than the maximum, using %d", concurrency, maxPodVolumeBackupConcurrency)
+		return maxPodVolumeBackupConcurrency, nil
+	}
+
+	return concurrency, nil
 }
 
-func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string) (*resticServer, error) {
+func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string, podVolumeBackupConcurrency int, pluginDir string, logLevel logrus.Level) (*resticServer, error) {
 
 	kubeClient, err := factory.KubeClient()
 	if err != nil {
@@ -140,6 +182,11 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		},
 	)
 
+	pluginRegistry := clientmgmt.NewRegistry(pluginDir, logger, logLevel)
+	if err := pluginRegistry.DiscoverPlugins(); err != nil {
+		return nil, err
+	}
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	clientConfig, err := factory.ClientConfig()
@@ -171,6 +218,10 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		mgr:                   mgr,
 		metricsAddress:        metricAddress,
 		namespace:             factory.Namespace(),
+		pluginRegistry:        pluginRegistry,
+		logLevel:              logLevel,
+
+		podVolumeBackupConcurrency: podVolumeBackupConcurrency,
 	}
 
 	if err := s.validatePodVolumesHostPath(); err != nil {
@@ -218,6 +269,7 @@ func (s *resticServer) run() {
 		s.mgr.GetClient(),
 		os.Getenv("NODE_NAME"),
 		credentialFileStore,
+		s.newPluginManager,
 	)
 
 	restoreController := controller.NewPodVolumeRestoreController(
@@ -230,6 +282,7 @@ func (s *resticServer) run() {
 		s.mgr.GetClient(),
 		os.Getenv("NODE_NAME"),
 		credentialFileStore,
+		s.newPluginManager,
 	)
 
 	go s.veleroInformerFactory.Start(s.ctx.Done())
@@ -243,7 +296,7 @@ func (s *resticServer) run() {
 
 	// Adding the controllers to the manager will register them as a (runtime-controller) runnable,
 	// so the manager will ensure the cache is started and ready before all controller are started
-	s.mgr.Add(managercontroller.Runnable(backupController, 1))
+	s.mgr.Add(managercontroller.Runnable(backupController, s.podVolumeBackupConcurrency))
 	s.mgr.Add(managercontroller.Runnable(restoreController, 1))
 
 	s.logger.Info("Controllers starting...")
@@ -253,6 +306,12 @@ func (s *resticServer) run() {
 	}
 }
 
+// newPluginManager returns a plugin manager for the plugins in the restic
+// server's plugin directory.
+func (s *resticServer) newPluginManager(logger logrus.FieldLogger) clientmgmt.Manager {
+	return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+}
+
 // validatePodVolumesHostPath validates that the pod volumes path contains a
 // directory for each Pod running on this node
 func (s *resticServer) validatePodVolumesHostPath() error {
@@ -109,3 +109,45 @@ func Test_validatePodVolumesHostPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_podVolumeBackupWorkers(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		want        int
+		wantErr     bool
+	}{
+		{
+			name:        "the default concurrency runs one worker",
+			concurrency: defaultPodVolumeBackupConcurrency,
+			want:        1,
+		},
+		{
+			name:        "a concurrency up to the maximum is used as-is",
+			concurrency: maxPodVolumeBackupConcurrency,
+			want:        maxPodVolumeBackupConcurrency,
+		},
+		{
+			name:        "a concurrency above the maximum is capped",
+			concurrency: 1000,
+			want:        maxPodVolumeBackupConcurrency,
+		},
+		{
+			name:        "a concurrency below 1 is invalid",
+			concurrency: 0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := podVolumeBackupWorkers(tt.concurrency, testutil.NewLogger())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewCancelCommand creates and returns a new cobra command for canceling restores.
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s NAME [NAME...]", use),
+		Short: "Cancel restores that are in progress",
+		Long: `Cancel restores that are in progress, by requesting their cancellation with the
+velero.io/cancel-requested annotation. A canceled restore doesn't restore any more
+items, and its phase is Cancelled once it stops. Restores that have finished aren't changed.`,
+		Example: `  # Cancel a restore named "restore-1".
+  velero restore cancel restore-1`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			veleroClient, err := f.Client()
+			cmd.CheckError(err)
+
+			var errs []error
+			for _, name := range args {
+				restore, err := veleroClient.VeleroV1().Restores(f.Namespace()).Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					errs = append(errs, errors.WithStack(err))
+					continue
+				}
+
+				switch restore.Status.Phase {
+				case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress:
+				default:
+					fmt.Printf("Restore %s has already finished, its phase is %s.\n", name, restore.Status.Phase)
+					continue
+				}
+
+				patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:""}}}`, velerov1api.CancelRequestedAnnotation))
+				if _, err := veleroClient.VeleroV1().Restores(f.Namespace()).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+					errs = append(errs, errors.Wrapf(err, "error canceling restore %s", name))
+					continue
+				}
+				fmt.Printf("Request to cancel restore %s submitted successfully.\n", name)
+			}
+
+			cmd.CheckError(kubeerrs.NewAggregate(errs))
+		},
+	}
+
+	return c
+}
@@ -20,17 +20,20 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
@@ -57,7 +60,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Create a restore of backup "backup-3" that only restores the data of persistent volume claim "data" in namespace "app".
+  velero restore create --from-backup backup-3 --include-volumes app/data`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -74,21 +80,46 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	BackupName              string
-	ScheduleName            string
-	RestoreName             string
-	RestoreVolumes          flag.OptionalBool
-	PreserveNodePorts       flag.OptionalBool
-	Labels                  flag.Map
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	NamespaceMappings       flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	AllowPartiallyFailed    flag.OptionalBool
+	BackupName                string
+	ScheduleName              string
+	RestoreName               string
+	RestoreVolumes            flag.OptionalBool
+	PreserveNodePorts         flag.OptionalBool
+	Labels                    flag.Map
+	IncludeNamespaces         flag.StringArray
+	ExcludeNamespaces         flag.StringArray
+	IncludeResources          flag.StringArray
+	ExcludeResources          flag.StringArray
+	NamespaceMappings         flag.Map
+	Selector                  flag.LabelSelector
+	ExcludeAnnotation         flag.AnnotationMatch
+	IncludeVolumes            flag.StringArray
+	ExcludeVolumes            flag.StringArray
+	VolumeSelector            flag.LabelSelector
+	SkipUnselectedVolumes     flag.OptionalBool
+	IncludeClusterResources   flag.OptionalBool
+	Wait                      bool
+	AllowPartiallyFailed      flag.OptionalBool
+	DryRun                    flag.OptionalBool
+	RecordChanges             flag.OptionalBool
+	ScaleDownWorkloads        flag.OptionalBool
+	Resume                    flag.OptionalBool
+	VerifyPodVolumeData       flag.OptionalBool
+	SkipFailedBackupItems     flag.OptionalBool
+	GenerateNameOnConflict    flag.StringArray
+	PreferredAPIVersions      string
+	ResourcePriorities        flag.StringArray
+	LowPriorityResources      flag.StringArray
+	ReplacePriorities         bool
+	GroupResourceOrders       []string
+	ResourceTimeout           time.Duration
+	WaitForDeployments        time.Duration
+	WaitForStatefulSets       time.Duration
+	WaitForDaemonSets         time.Duration
+	ResourceModifierConfigMap string
+	NamespaceConflictPolicy   string
+	ConfirmNamespaceRecreate  bool
+	ExistingResourcePolicy    string
 
 	client veleroclient.Interface
 }
@@ -101,6 +132,13 @@ func NewCreateOptions() *CreateOptions {
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		PreserveNodePorts:       flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		DryRun:                  flag.NewOptionalBool(nil),
+		RecordChanges:           flag.NewOptionalBool(nil),
+		ScaleDownWorkloads:      flag.NewOptionalBool(nil),
+		Resume:                  flag.NewOptionalBool(nil),
+		SkipUnselectedVolumes:   flag.NewOptionalBool(nil),
+		VerifyPodVolumeData:     flag.NewOptionalBool(nil),
+		SkipFailedBackupItems:   flag.NewOptionalBool(nil),
 	}
 }
 
@@ -114,7 +152,14 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
-	f := flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
+	flags.Var(&o.ExcludeAnnotation, "exclude-annotation", "Don't restore resources with this annotation, formatted as key=value, or as key to match any value.")
+	flags.Var(&o.IncludeVolumes, "include-volumes", "Persistent volume claims in the backup, formatted as namespace/name, whose data to restore (use '*' for all volumes).")
+	flags.Var(&o.ExcludeVolumes, "exclude-volumes", "Persistent volume claims in the backup, formatted as namespace/name, whose data not to restore.")
+	flags.Var(&o.VolumeSelector, "volume-selector", "Only restore the data of persistent volume claims matching this label selector.")
+	f := flags.VarPF(&o.SkipUnselectedVolumes, "skip-unselected-volumes", "", "Skip the persistent volume claims whose data isn't restored, instead of restoring them empty.")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.RestoreVolumes, "restore-volumes", "", "Whether to restore volumes from snapshots.")
 	// this allows the user to just specify "--restore-volumes" as shorthand for "--restore-volumes=true"
 	// like a normal bool flag
 	f.NoOptDefVal = "true"
@@ -130,6 +175,45 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "If using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = "true"
 
+	f = flags.VarPF(&o.DryRun, "dry-run", "", "Walk through the restore, including restore item action plugins, without making any changes to the cluster. A summary of what would be restored is stored in the restore's results file.")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.ScaleDownWorkloads, "scale-down-workloads", "", "Scale restored Deployments and StatefulSets to zero replicas, keeping their original replicas in the velero.io/original-replicas annotation, so that they don't run until they're scaled up.")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.RecordChanges, "record-changes", "", "Record what the restore did with each item that it created, patched or skipped because it already existed, including what changed in patched items. The changes are shown by 'velero restore describe --show-changes'.")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.Resume, "resume", "", "Skip the resources that were already restored by the most recent failed or partially failed restore of the same backup, unless they've since been deleted from the cluster.")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.VerifyPodVolumeData, "verify-pod-volume-data", "", "Verify the restored restic pod volume data against the digests computed by backup data integrity plugins when it was backed up.")
+	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.SkipFailedBackupItems, "skip-failed-backup-items", "", "Skip the resources that failed to be backed up, instead of restoring them with a warning.")
+	f.NoOptDefVal = "true"
+
+	flags.Var(&o.GenerateNameOnConflict, "generate-name-on-conflict", "Resources whose items to restore with a generated name when their name is already taken in the cluster. Only jobs and pods are supported.")
+
+	flags.StringVar(&o.PreferredAPIVersions, "preferred-api-versions", "", "Mapping resources to the API versions to restore them at, in order of preference, when the backup stored them at several versions. Resources are formatted as resource.group, such as widgets.example.com, and are separated from their comma-separated versions by a colon. Entries in the mapping are separated by semi-colon.  Example: 'widgets.example.com:v2,v1beta1'.  Optional.")
+
+	flags.Var(&o.ResourcePriorities, "resource-priorities", "Resources to restore, in order, after the server's resource priorities and before any resource not listed.")
+	flags.Var(&o.LowPriorityResources, "low-priority-resources", "Resources to restore, in order, after all other resources.")
+	flags.StringArrayVar(&o.GroupResourceOrders, "group-resource-order", o.GroupResourceOrders, "Comma-separated resources of the same API group to restore in order relative to each other, such as widgets.example.com,gadgets.example.com. Can be specified once per API group.")
+	flags.BoolVar(&o.ReplacePriorities, "replace-resource-priorities", o.ReplacePriorities, "Restore the resources in --resource-priorities instead of the server's resource priorities.")
+
+	flags.DurationVar(&o.ResourceTimeout, "resource-timeout", o.ResourceTimeout, "How long each call made while restoring a single resource can take before the resource is recorded as failed. Defaults to the server's resource timeout, and 0 disables the timeout.")
+
+	flags.DurationVar(&o.WaitForDeployments, "wait-for-deployments", o.WaitForDeployments, "How long to wait, once the resources have been restored, for each restored deployment's available replicas to reach its desired replicas. Deployments aren't waited for if it's not set.")
+	flags.DurationVar(&o.WaitForStatefulSets, "wait-for-statefulsets", o.WaitForStatefulSets, "How long to wait, once the resources have been restored, for each restored stateful set's ready replicas to reach its desired replicas. Stateful sets aren't waited for if it's not set.")
+	flags.DurationVar(&o.WaitForDaemonSets, "wait-for-daemonsets", o.WaitForDaemonSets, "How long to wait, once the resources have been restored, for each restored daemon set's available pods to reach its desired number of scheduled pods. Daemon sets aren't waited for if it's not set.")
+
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "ConfigMap in the Velero namespace with rules of patches to apply to the restored resources before they're created.")
+
+	flags.StringVar(&o.NamespaceConflictPolicy, "namespace-conflict-policy", "", "What to do with the namespaces being restored into that already exist in the cluster. Valid values are Merge (the default), Fail and Recreate, which deletes them first.")
+	flags.BoolVar(&o.ConfirmNamespaceRecreate, "confirm-namespace-recreate", o.ConfirmNamespaceRecreate, "Confirm that the existing namespaces being restored into, and everything in them, will be deleted. Required by --namespace-conflict-policy=Recreate.")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "What to do with the items being restored that already exist in the cluster. Valid values are none (the default), which leaves them as they are, updateIfChanged, which patches the ones that are different than the backed up version, and addMissingKeys and overwriteKeys, which merge the backed up keys of ConfigMaps and Secrets into the existing ones, keeping or overwriting the values of the keys they already have.")
+
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
 }
 
@@ -167,6 +251,23 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return err
 	}
 
+	switch api.NamespaceConflictPolicy(o.NamespaceConflictPolicy) {
+	case "", api.NamespaceConflictPolicyMerge, api.NamespaceConflictPolicyFail:
+	case api.NamespaceConflictPolicyRecreate:
+		if !o.ConfirmNamespaceRecreate && !boolptr.IsSetToTrue(o.DryRun.Value) {
+			return errors.New("--namespace-conflict-policy=Recreate deletes the existing namespaces being restored into, confirm it with --confirm-namespace-recreate")
+		}
+	default:
+		return errors.Errorf("invalid namespace conflict policy %q, must be one of Merge, Fail or Recreate", o.NamespaceConflictPolicy)
+	}
+
+	switch api.ExistingResourcePolicy(o.ExistingResourcePolicy) {
+	case "", api.ExistingResourcePolicyNone, api.ExistingResourcePolicyUpdateIfChanged,
+		api.ExistingResourcePolicyAddMissingKeys, api.ExistingResourcePolicyOverwriteKeys:
+	default:
+		return errors.Errorf("invalid existing resource policy %q, must be one of none, updateIfChanged, addMissingKeys or overwriteKeys", o.ExistingResourcePolicy)
+	}
+
 	if o.client == nil {
 		// This should never happen
 		return errors.New("Velero client is not set; unable to proceed")
@@ -266,12 +367,74 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			ExcludedResources:       o.ExcludeResources,
 			NamespaceMapping:        o.NamespaceMappings.Data(),
 			LabelSelector:           o.Selector.LabelSelector,
+			ExcludedAnnotation:      o.ExcludeAnnotation.AnnotationMatch,
+			IncludedVolumes:         o.IncludeVolumes,
+			ExcludedVolumes:         o.ExcludeVolumes,
+			VolumeSelector:          o.VolumeSelector.LabelSelector,
+			SkipUnselectedVolumes:   o.SkipUnselectedVolumes.Value,
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			DryRun:                  o.DryRun.Value,
+			RecordChanges:           o.RecordChanges.Value,
+			ScaleDownWorkloads:      o.ScaleDownWorkloads.Value,
+			Resume:                  o.Resume.Value,
+			VerifyPodVolumeData:     o.VerifyPodVolumeData.Value,
+			SkipFailedBackupItems:   o.SkipFailedBackupItems.Value,
+			GenerateNameOnConflict:  o.GenerateNameOnConflict,
+			NamespaceConflictPolicy: api.NamespaceConflictPolicy(o.NamespaceConflictPolicy),
+			ExistingResourcePolicy:  api.ExistingResourcePolicy(o.ExistingResourcePolicy),
 		},
 	}
 
+	if len(o.PreferredAPIVersions) > 0 {
+		preferredAPIVersions, err := backup.ParseResourceAPIVersions(o.PreferredAPIVersions)
+		if err != nil {
+			return err
+		}
+		restore.Spec.PreferredAPIVersions = preferredAPIVersions
+	}
+
+	if len(o.ResourcePriorities) > 0 || len(o.LowPriorityResources) > 0 || o.ReplacePriorities || len(o.GroupResourceOrders) > 0 {
+		restore.Spec.ResourcePriorities = &api.RestoreResourcePriorities{
+			HighPriorities: o.ResourcePriorities,
+			LowPriorities:  o.LowPriorityResources,
+		}
+		if o.ReplacePriorities {
+			restore.Spec.ResourcePriorities.Mode = api.ResourcePrioritiesModeReplace
+		}
+		for _, order := range o.GroupResourceOrders {
+			restore.Spec.ResourcePriorities.GroupOrders = append(restore.Spec.ResourcePriorities.GroupOrders, api.ResourceGroupOrder{
+				Resources: strings.Split(order, ","),
+			})
+		}
+	}
+
+	if c.Flags().Changed("resource-timeout") {
+		restore.Spec.ResourceTimeout = &metav1.Duration{Duration: o.ResourceTimeout}
+	}
+
+	if flags := c.Flags(); flags.Changed("wait-for-deployments") || flags.Changed("wait-for-statefulsets") || flags.Changed("wait-for-daemonsets") {
+		wait := new(api.WorkloadReadinessWait)
+		if flags.Changed("wait-for-deployments") {
+			wait.Deployments = &metav1.Duration{Duration: o.WaitForDeployments}
+		}
+		if flags.Changed("wait-for-statefulsets") {
+			wait.StatefulSets = &metav1.Duration{Duration: o.WaitForStatefulSets}
+		}
+		if flags.Changed("wait-for-daemonsets") {
+			wait.DaemonSets = &metav1.Duration{Duration: o.WaitForDaemonSets}
+		}
+		restore.Spec.WaitForWorkloads = wait
+	}
+
+	if o.ResourceModifierConfigMap != "" {
+		restore.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.ResourceModifierConfigMap,
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
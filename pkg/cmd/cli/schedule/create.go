@@ -29,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 )
 
@@ -82,13 +83,18 @@ type CreateOptions struct {
 	BackupOptions              *backup.CreateOptions
 	Schedule                   string
 	UseOwnerReferencesInBackup bool
+	BackupNameTemplate         string
+	BackupLabelTemplates       flag.Map
+	BackupAnnotationTemplates  flag.Map
 
 	labelSelector *metav1.LabelSelector
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		BackupOptions: backup.NewCreateOptions(),
+		BackupOptions:             backup.NewCreateOptions(),
+		BackupLabelTemplates:      flag.NewMap().WithEntryDelimiter(";"),
+		BackupAnnotationTemplates: flag.NewMap().WithEntryDelimiter(";"),
 	}
 }
 
@@ -96,6 +102,9 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule")
+	flags.StringVar(&o.BackupNameTemplate, "backup-name-template", o.BackupNameTemplate, "A Go template for the names of the backups created by this Schedule, which can use .ScheduleName, .Timestamp, .ClusterName and .Labels. Defaults to the schedule's name followed by the timestamp.")
+	flags.Var(&o.BackupLabelTemplates, "backup-label-templates", "Labels to set on the backups created by this Schedule, whose keys and values are Go templates with the same data as --backup-name-template. Entries are formatted as key=value and separated by semi-colons.  Example: 'date={{ .Timestamp.Format \"2006-01-02\" }};example.com/schedule={{ .ScheduleName }}'.")
+	flags.Var(&o.BackupAnnotationTemplates, "backup-annotation-templates", "Annotations to set on the backups created by this Schedule, formatted as for --backup-label-templates.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -116,6 +125,30 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
+	var resourceSelectors map[string]string
+	if len(o.BackupOptions.ResourceSelectors) > 0 {
+		resourceSelectors, err = backup.ParseResourceLabelSelectors(o.BackupOptions.ResourceSelectors)
+		if err != nil {
+			return err
+		}
+	}
+
+	var excludedFields map[string][]string
+	if len(o.BackupOptions.ExcludeFields) > 0 {
+		excludedFields, err = backup.ParseExcludedFields(o.BackupOptions.ExcludeFields)
+		if err != nil {
+			return err
+		}
+	}
+
+	var resourceAPIVersions map[string][]string
+	if len(o.BackupOptions.ResourceAPIVersions) > 0 {
+		resourceAPIVersions, err = backup.ParseResourceAPIVersions(o.BackupOptions.ResourceAPIVersions)
+		if err != nil {
+			return err
+		}
+	}
+
 	schedule := &api.Schedule{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -124,20 +157,38 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
-				IncludedNamespaces:      o.BackupOptions.IncludeNamespaces,
-				ExcludedNamespaces:      o.BackupOptions.ExcludeNamespaces,
-				IncludedResources:       o.BackupOptions.IncludeResources,
-				ExcludedResources:       o.BackupOptions.ExcludeResources,
-				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
-				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
-				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
-				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
-				StorageLocation:         o.BackupOptions.StorageLocation,
-				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
-				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
+				IncludedNamespaces:             o.BackupOptions.IncludeNamespaces,
+				ExcludedNamespaces:             o.BackupOptions.ExcludeNamespaces,
+				IncludedResources:              o.BackupOptions.IncludeResources,
+				ExcludedResources:              o.BackupOptions.ExcludeResources,
+				IncludeClusterResources:        o.BackupOptions.IncludeClusterResources.Value,
+				IncludeRelatedClusterResources: o.BackupOptions.IncludeRelatedClusterResources.Value,
+				IncludeAPIServices:             o.BackupOptions.IncludeAPIServices.Value,
+				LabelSelector:                  o.BackupOptions.Selector.LabelSelector,
+				ResourceLabelSelectors:         resourceSelectors,
+				ExcludedAnnotation:             o.BackupOptions.ExcludeAnnotation.AnnotationMatch,
+				ItemFilter:                     o.BackupOptions.ItemFilter(),
+				ExcludeDefaultResources:        o.BackupOptions.ExcludeDefaultResources.Value,
+				ExcludedOwnerKinds:             o.BackupOptions.ExcludedOwnerKinds(),
+				ModifiedSince:                  o.BackupOptions.ModifiedSinceDuration(),
+				ExcludedFields:                 excludedFields,
+				ResourceAPIVersions:            resourceAPIVersions,
+				SnapshotVolumes:                o.BackupOptions.SnapshotVolumes.Value,
+				TTL:                            metav1.Duration{Duration: o.BackupOptions.TTL},
+				StorageLocation:                o.BackupOptions.StorageLocation,
+				MirrorStorageLocations:         o.BackupOptions.MirrorStorageLocations,
+				VolumeSnapshotLocations:        o.BackupOptions.SnapshotLocations,
+				DefaultVolumesToRestic:         o.BackupOptions.DefaultVolumesToRestic.Value,
+				Compression:                    api.CompressionAlgorithm(o.BackupOptions.Compression.String()),
+				Incremental:                    o.BackupOptions.Incremental.Value,
+				RedactSecrets:                  o.BackupOptions.RedactSecrets.Value,
+				ItemFailureThresholds:          o.BackupOptions.ItemFailureThresholds(),
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
+			BackupNameTemplate:         o.BackupNameTemplate,
+			BackupLabelTemplates:       o.BackupLabelTemplates.Data(),
+			BackupAnnotationTemplates:  o.BackupAnnotationTemplates.Data(),
 		},
 	}
 
@@ -0,0 +1,98 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewPauseCommand creates and returns a new cobra command for pausing schedules.
+func NewPauseCommand(f client.Factory, use string) *cobra.Command {
+	var cancelRunning bool
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s NAME", use),
+		Short: "Pause a schedule",
+		Long: `Pause a schedule, so that it doesn't run backups until it's resumed. The backups it ran that are
+still in progress are left to finish, unless --cancel-running is specified.`,
+		Example: `  # Pause a schedule named "schedule-1".
+  velero schedule pause schedule-1
+
+  # Pause a schedule named "schedule-1" and cancel its running backups.
+  velero schedule pause schedule-1 --cancel-running`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(setPaused(f, args[0], map[string]interface{}{
+				"paused":               true,
+				"cancelRunningOnPause": cancelRunning,
+			}))
+			fmt.Printf("Schedule %s paused.\n", args[0])
+		},
+	}
+
+	c.Flags().BoolVar(&cancelRunning, "cancel-running", cancelRunning, "Cancel the schedule's backups that are still in progress. Their phase is Cancelled once they stop.")
+	return c
+}
+
+// NewResumeCommand creates and returns a new cobra command for resuming paused schedules.
+func NewResumeCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s NAME", use),
+		Short: "Resume a paused schedule",
+		Long: `Resume a paused schedule. The backup it missed while it was paused is skipped, and it next runs
+when its schedule is due, unless its spec.skipImmediately is false, in which case it runs a backup right away.`,
+		Example: `  # Resume a schedule named "schedule-1".
+  velero schedule resume schedule-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(setPaused(f, args[0], map[string]interface{}{
+				"paused": false,
+			}))
+			fmt.Printf("Schedule %s resumed.\n", args[0])
+		},
+	}
+
+	return c
+}
+
+// setPaused patches a schedule's spec with the given fields.
+func setPaused(f client.Factory, name string, spec map[string]interface{}) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := veleroClient.VeleroV1().Schedules(f.Namespace()).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "error updating schedule %s", name)
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package plugin
 
 import (
+	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/client/v4/clientset/versioned"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/vmware-tanzu/velero/pkg/backup"
@@ -38,6 +39,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterBackupItemAction("velero.io/pod", newPodBackupItemAction).
 				RegisterBackupItemAction("velero.io/service-account", newServiceAccountBackupItemAction(f)).
 				RegisterBackupItemAction("velero.io/crd-remap-version", newRemapCRDVersionAction(f)).
+				RegisterBackupItemAction("velero.io/related-cluster-resources", newRelatedClusterResourcesBackupItemAction).
+				RegisterBackupItemAction("velero.io/redact-secrets", newRedactSecretsBackupItemAction).
 				RegisterRestoreItemAction("velero.io/job", newJobRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/pod", newPodRestoreItemAction).
 				// We don't want to leverage the restic features for our use case (disaster recovery without restore of
@@ -50,11 +53,19 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterRestoreItemAction("velero.io/service-account", newServiceAccountRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/add-pvc-from-pod", newAddPVCFromPodRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/add-pv-from-pvc", newAddPVFromPVCRestoreItemAction).
+				RegisterRestoreItemAction("velero.io/pv-claim-ref", newPVClaimRefRestoreItemAction(f)).
 				RegisterRestoreItemAction("velero.io/change-storage-class", newChangeStorageClassRestoreItemAction(f)).
+				RegisterRestoreItemAction("velero.io/change-volume-snapshot-class", newChangeVolumeSnapshotClassRestoreItemAction(f)).
 				RegisterRestoreItemAction("velero.io/role-bindings", newRoleBindingItemAction).
 				RegisterRestoreItemAction("velero.io/cluster-role-bindings", newClusterRoleBindingItemAction).
 				RegisterRestoreItemAction("velero.io/crd-preserve-fields", newCRDV1PreserveUnknownFieldsItemAction).
 				RegisterRestoreItemAction("velero.io/change-pvc-node-selector", newChangePVCNodeSelectorItemAction(f)).
+				RegisterRestoreItemAction("velero.io/change-image-registry", newChangeImageRegistryItemAction(f)).
+				RegisterRestoreItemAction("velero.io/webhook-ca-bundle", newWebhookCABundleItemAction(f)).
+				RegisterRestoreItemAction("velero.io/merge-keys", newMergeKeysItemAction(f)).
+				RegisterRestoreItemAction("velero.io/resource-quota", newResourceQuotaItemAction(f)).
+				RegisterRestoreItemAction("velero.io/scale-down-workloads", newScaleDownWorkloadsItemAction).
+				RegisterBackupValidator("velero.io/backup-policy", newBackupPolicyValidator(f)).
 				Serve()
 		},
 	}
@@ -72,6 +83,14 @@ func newPodBackupItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return backup.NewPodAction(logger), nil
 }
 
+func newRelatedClusterResourcesBackupItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return backup.NewRelatedClusterResourcesAction(logger), nil
+}
+
+func newRedactSecretsBackupItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return backup.NewRedactSecretsAction(logger), nil
+}
+
 func newServiceAccountBackupItemAction(f client.Factory) veleroplugin.HandlerInitializer {
 	return func(logger logrus.FieldLogger) (interface{}, error) {
 		// TODO(ncdc): consider a k8s style WantsKubernetesClientSet initialization approach
@@ -154,6 +173,21 @@ func newAddPVFromPVCRestoreItemAction(logger logrus.FieldLogger) (interface{}, e
 	return restore.NewAddPVFromPVCAction(logger), nil
 }
 
+func newPVClaimRefRestoreItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewPVClaimRefAction(logger, client.CoreV1().ConfigMaps(f.Namespace())), nil
+	}
+}
+
+func newScaleDownWorkloadsItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return restore.NewScaleDownWorkloadsAction(logger), nil
+}
+
 func newCRDV1PreserveUnknownFieldsItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewCRDV1PreserveUnknownFieldsAction(logger), nil
 }
@@ -173,6 +207,30 @@ func newChangeStorageClassRestoreItemAction(f client.Factory) veleroplugin.Handl
 	}
 }
 
+func newChangeVolumeSnapshotClassRestoreItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		clientConfig, err := f.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		snapshotClient, err := snapshotv1beta1client.NewForConfig(clientConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewChangeVolumeSnapshotClassAction(
+			logger,
+			client.CoreV1().ConfigMaps(f.Namespace()),
+			snapshotClient.SnapshotV1beta1().VolumeSnapshotClasses(),
+		), nil
+	}
+}
+
 func newRoleBindingItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewRoleBindingAction(logger), nil
 }
@@ -195,3 +253,64 @@ func newChangePVCNodeSelectorItemAction(f client.Factory) veleroplugin.HandlerIn
 		), nil
 	}
 }
+
+func newChangeImageRegistryItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewChangeImageRegistryAction(
+			logger,
+			client.CoreV1().ConfigMaps(f.Namespace()),
+		), nil
+	}
+}
+
+func newWebhookCABundleItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewWebhookCABundleAction(
+			logger,
+			client.CoreV1().ConfigMaps(f.Namespace()),
+		), nil
+	}
+}
+
+func newMergeKeysItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewMergeKeysAction(logger, client.CoreV1(), client.CoreV1()), nil
+	}
+}
+
+func newResourceQuotaItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return restore.NewResourceQuotaAction(logger, client.CoreV1().ConfigMaps(f.Namespace())), nil
+	}
+}
+
+func newBackupPolicyValidator(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return backup.NewBackupPolicyValidator(logger, client.CoreV1().ConfigMaps(f.Namespace())), nil
+	}
+}
@@ -34,10 +34,12 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -78,6 +80,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/storage"
 	"github.com/vmware-tanzu/velero/internal/util/managercontroller"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 )
 
 const (
@@ -86,8 +89,20 @@ const (
 
 	defaultBackupSyncPeriod           = time.Minute
 	defaultStoreValidationFrequency   = time.Minute
+	defaultStoreValidationBackoffBase = 10 * time.Second
+	defaultStoreValidationBackoffCap  = 5 * time.Minute
+	defaultStoreValidationMaxFailures = 1
+	defaultStorageUsageInterval       = time.Hour
 	defaultPodVolumeOperationTimeout  = 240 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
+	defaultRestoreResourceTimeout     = 10 * time.Minute
+	defaultCRDEstablishTimeout        = time.Minute
+	defaultRestoreItemRetries         = 3
+	defaultRestoreItemRetryBackoff    = time.Second
+	defaultBackupLogFlushInterval     = time.Minute
+
+	// the default frequency at which volume snapshot locations are validated
+	defaultSnapshotLocationValidationFrequency = time.Minute
 
 	// server's client default qps and burst
 	defaultClientQPS   float32 = 20.0
@@ -96,8 +111,15 @@ const (
 	defaultProfilerAddress = "localhost:6060"
 
 	defaultControllerWorkers = 1
+
+	// the default number of volume snapshots created concurrently during a backup
+	defaultVolumeSnapshotWorkers = 1
+	// the default number of items of a resource restored concurrently
+	defaultRestoreItemWorkers = 1
 	// the default TTL for a backup
 	defaultBackupTTL = 30 * 24 * time.Hour
+	// the default algorithm backup tarballs are compressed with
+	defaultBackupCompression = velerov1api.CompressionAlgorithmZstd
 
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
@@ -108,7 +130,7 @@ type serverConfig struct {
 	// TODO(2.0) Deprecate defaultBackupLocation
 	pluginDir, metricsAddress, defaultBackupLocation                        string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
-	defaultBackupTTL, storeValidationFrequency                              time.Duration
+	defaultBackupTTL, storeValidationFrequency, restoreResourceTimeout      time.Duration
 	restoreResourcePriorities                                               []string
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
@@ -119,6 +141,28 @@ type serverConfig struct {
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
 	defaultVolumesToRestic                                                  bool
+	storeValidationBackoffBase, storeValidationBackoffCap                   time.Duration
+	storeValidationMaxFailures                                              int
+	storageUsageInterval                                                    time.Duration
+	volumeSnapshotWorkers                                                   int
+	defaultBackupCompression                                                *flag.Enum
+	disableBackupItemDurationMetric                                         bool
+	clusterName                                                             string
+	syncAllClusterBackups                                                   bool
+	backupSyncPrefix                                                        string
+	backupSyncLabelSelector                                                 string
+	snapshotLocationValidationFrequency                                     time.Duration
+	crdEstablishTimeout                                                     time.Duration
+	restoreItemRetries                                                      int
+	restoreItemRetryBackoff                                                 time.Duration
+	restoreItemWorkers                                                      int
+	verifyBackupUpload                                                      bool
+	backupLogFlushInterval                                                  time.Duration
+	objectStoreBandwidthLimit                                               int64
+	streamRestoreBackups                                                    bool
+	restoreMemoryLimit                                                      int64
+	resourceDenylistConfigMap                                               string
+	defaultResourcesConfigMap                                               string
 }
 
 type controllerRunInfo struct {
@@ -131,22 +175,35 @@ func NewCommand(f client.Factory) *cobra.Command {
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(":")
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
-			pluginDir:                         "/plugins",
-			metricsAddress:                    defaultMetricsAddress,
-			defaultBackupLocation:             "default",
-			defaultVolumeSnapshotLocations:    make(map[string]string),
-			backupSyncPeriod:                  defaultBackupSyncPeriod,
-			defaultBackupTTL:                  defaultBackupTTL,
-			storeValidationFrequency:          defaultStoreValidationFrequency,
-			podVolumeOperationTimeout:         defaultPodVolumeOperationTimeout,
-			restoreResourcePriorities:         defaultRestorePriorities,
-			clientQPS:                         defaultClientQPS,
-			clientBurst:                       defaultClientBurst,
-			profilerAddress:                   defaultProfilerAddress,
-			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
-			formatFlag:                        logging.NewFormatFlag(),
-			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
-			defaultVolumesToRestic:            restic.DefaultVolumesToRestic,
+			pluginDir:                           "/plugins",
+			metricsAddress:                      defaultMetricsAddress,
+			defaultBackupLocation:               "default",
+			defaultVolumeSnapshotLocations:      make(map[string]string),
+			backupSyncPeriod:                    defaultBackupSyncPeriod,
+			defaultBackupTTL:                    defaultBackupTTL,
+			storeValidationFrequency:            defaultStoreValidationFrequency,
+			storeValidationBackoffBase:          defaultStoreValidationBackoffBase,
+			storeValidationBackoffCap:           defaultStoreValidationBackoffCap,
+			storeValidationMaxFailures:          defaultStoreValidationMaxFailures,
+			storageUsageInterval:                defaultStorageUsageInterval,
+			snapshotLocationValidationFrequency: defaultSnapshotLocationValidationFrequency,
+			podVolumeOperationTimeout:           defaultPodVolumeOperationTimeout,
+			restoreResourcePriorities:           defaultRestorePriorities,
+			clientQPS:                           defaultClientQPS,
+			clientBurst:                         defaultClientBurst,
+			profilerAddress:                     defaultProfilerAddress,
+			resourceTerminatingTimeout:          defaultResourceTerminatingTimeout,
+			restoreResourceTimeout:              defaultRestoreResourceTimeout,
+			crdEstablishTimeout:                 defaultCRDEstablishTimeout,
+			restoreItemRetries:                  defaultRestoreItemRetries,
+			restoreItemRetryBackoff:             defaultRestoreItemRetryBackoff,
+			restoreItemWorkers:                  defaultRestoreItemWorkers,
+			backupLogFlushInterval:              defaultBackupLogFlushInterval,
+			formatFlag:                          logging.NewFormatFlag(),
+			defaultResticMaintenanceFrequency:   restic.DefaultMaintenanceFrequency,
+			defaultVolumesToRestic:              restic.DefaultVolumesToRestic,
+			volumeSnapshotWorkers:               defaultVolumeSnapshotWorkers,
+			defaultBackupCompression:            flag.NewEnum(string(defaultBackupCompression), archive.CompressionAlgorithmNames()...),
 		}
 	)
 
@@ -202,14 +259,38 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().StringSliceVar(&config.restoreResourcePriorities, "restore-resource-priorities", config.restoreResourcePriorities, "Desired order of resource restores; any resource not in the list will be restored alphabetically after the prioritized resources.")
 	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "Name of the default backup storage location. DEPRECATED: this flag will be removed in v2.0. Use \"velero backup-location set --default\" instead.")
 	command.Flags().DurationVar(&config.storeValidationFrequency, "store-validation-frequency", config.storeValidationFrequency, "How often to verify if the storage is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
+	command.Flags().IntVar(&config.storeValidationMaxFailures, "store-validation-max-failures", config.storeValidationMaxFailures, "How many consecutive times validating a backup storage location must fail before it is marked unavailable.")
+	command.Flags().DurationVar(&config.storeValidationBackoffBase, "store-validation-backoff-base", config.storeValidationBackoffBase, "How long to wait before revalidating a backup storage location after its first failed validation. The wait doubles with each further consecutive failure.")
+	command.Flags().DurationVar(&config.storeValidationBackoffCap, "store-validation-backoff-cap", config.storeValidationBackoffCap, "The longest to wait between revalidations of a failing backup storage location.")
+	command.Flags().DurationVar(&config.storageUsageInterval, "storage-usage-refresh-interval", config.storageUsageInterval, "How often to measure the space used by the backups in each available backup storage location, which lists all of their objects. Set this to `0s` to disable measuring it.")
+	command.Flags().DurationVar(&config.snapshotLocationValidationFrequency, "snapshot-location-validation-frequency", config.snapshotLocationValidationFrequency, "How often to verify if volume snapshot locations are valid. Optional. Set this to `0s` to only verify them once on startup. Default 1 minute.")
 	command.Flags().Var(&volumeSnapshotLocations, "default-volume-snapshot-locations", "List of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
 	command.Flags().Float32Var(&config.clientQPS, "client-qps", config.clientQPS, "Maximum number of requests per second by the server to the Kubernetes API once the burst limit has been reached.")
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "Maximum number of requests by the server to the Kubernetes API in a short period of time.")
 	command.Flags().StringVar(&config.profilerAddress, "profiler-address", config.profilerAddress, "The address to expose the pprof profiler.")
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes and namespaces to terminate during a restore before timing out.")
+	command.Flags().DurationVar(&config.restoreResourceTimeout, "resource-timeout", config.restoreResourceTimeout, "How long each call made while restoring a single resource, such as running a restore item action or creating the resource, can take before it's cancelled and the resource is recorded as failed. Set to 0 to disable. Restores can override this with spec.resourceTimeout.")
+	command.Flags().BoolVar(&config.verifyBackupUpload, "verify-backup-upload", config.verifyBackupUpload, "Read each backup's metadata and contents back from object storage after uploading them, and mark the backup FailedValidation if the contents don't match the checksum recorded in its metadata. This doubles the data transferred to and from object storage for each backup.")
+	command.Flags().Int64Var(&config.objectStoreBandwidthLimit, "object-store-bandwidth-limit", config.objectStoreBandwidthLimit, "The maximum combined bandwidth, in bytes per second, of this server's uploads to and downloads from object storage. 0 means unlimited.")
+	command.Flags().BoolVar(&config.streamRestoreBackups, "stream-restore-backups", config.streamRestoreBackups, "Extract the backups being restored as they're downloaded from object storage, instead of downloading them to a temp file first, so that only one copy of a backup is kept on local disk.")
+	command.Flags().Int64Var(&config.restoreMemoryLimit, "restore-memory-limit", config.restoreMemoryLimit, "With --stream-restore-backups, the largest size, in bytes, of a backup's extracted contents that are kept in memory rather than on local disk. Backups are downloaded twice when it's set, once to measure their contents. 0 means contents are always extracted to disk.")
+	command.Flags().DurationVar(&config.backupLogFlushInterval, "backup-log-flush-interval", config.backupLogFlushInterval, "How often to upload the log of a running backup to object storage, so that it can be followed with 'velero backup logs --follow'. Set to 0 to only upload the log when the backup finishes.")
+	command.Flags().DurationVar(&config.crdEstablishTimeout, "crd-establish-timeout", config.crdEstablishTimeout, "How long to wait for a restored custom resource definition to become established. Its custom resources are recorded as failed if it doesn't become established in time.")
+	command.Flags().IntVar(&config.restoreItemRetries, "restore-item-retries", config.restoreItemRetries, "How many times to retry creating or patching a resource during a restore when it fails with a conflict, too many requests or a server error. Set to 0 to disable.")
+	command.Flags().DurationVar(&config.restoreItemRetryBackoff, "restore-item-retry-backoff", config.restoreItemRetryBackoff, "How long to wait before the first retry of creating or patching a resource during a restore. The wait doubles after each retry.")
+	command.Flags().IntVar(&config.restoreItemWorkers, "restore-item-workers", config.restoreItemWorkers, "How many items of a resource to restore concurrently. Namespaces, custom resource definitions, persistent volumes and persistent volume claims are always restored serially. The default of 1 restores all items serially.")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "How often 'restic prune' is run for restic repositories by default.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
+	command.Flags().IntVar(&config.volumeSnapshotWorkers, "volume-snapshot-workers", config.volumeSnapshotWorkers, "How many volume snapshots to create concurrently during a backup. The default of 1 creates snapshots serially.")
+	command.Flags().BoolVar(&config.disableBackupItemDurationMetric, "disable-backup-item-duration-metric", config.disableBackupItemDurationMetric, "Disable the velero_backup_item_duration_seconds metric, which has a series for each group-resource backed up.")
+	command.Flags().StringVar(&config.clusterName, "cluster-name", config.clusterName, "Name identifying this cluster. Backups are tagged with it and stored under their own prefix in backup storage locations, and only backups tagged with it are synced into the cluster. Optional.")
+	command.Flags().BoolVar(&config.syncAllClusterBackups, "sync-all-cluster-backups", config.syncAllClusterBackups, "Sync the backups of all clusters from backup storage locations into the cluster, rather than only the backups tagged with --cluster-name.")
+	command.Flags().StringVar(&config.backupSyncPrefix, "backup-sync-prefix", config.backupSyncPrefix, "Only sync the backups whose names start with this prefix from backup storage locations into the cluster. Other backups are ignored, and aren't deleted from the cluster when they're deleted from their locations. Optional.")
+	command.Flags().StringVar(&config.backupSyncLabelSelector, "backup-sync-label-selector", config.backupSyncLabelSelector, "Only sync the backups whose labels match this label selector from backup storage locations into the cluster. Other backups are ignored, and aren't deleted from the cluster when they're deleted from their locations. Optional.")
+	command.Flags().StringVar(&config.resourceDenylistConfigMap, "resource-denylist-configmap", config.resourceDenylistConfigMap, "Name of a config map in the server's namespace whose resources key lists, separated by commas or newlines, the resources that no backup includes, whatever its included resources. It's read on startup. Optional.")
+	command.Flags().StringVar(&config.defaultResourcesConfigMap, "default-resources-configmap", config.defaultResourcesConfigMap, "Name of a config map in the server's namespace whose patterns key lists, separated by commas or newlines, the item filter patterns of the default resources that backups with excludeDefaultResources leave out, instead of the built-in ones. It's read on startup. Optional.")
+	command.Flags().Var(config.defaultBackupCompression, "default-backup-compression", fmt.Sprintf("The algorithm to compress backup tarballs with when a backup doesn't specify one. Valid values are %s.", strings.Join(config.defaultBackupCompression.AllowedValues(), ", ")))
 
 	return command
 }
@@ -236,6 +317,15 @@ type server struct {
 	config                              serverConfig
 	mgr                                 manager.Manager
 	credentialFileStore                 credentials.FileStore
+	credentialSecretStore               credentials.SecretStore
+	// resourceDenylist lists the resources that no backup includes, as
+	// read from the resource denylist config map on startup.
+	resourceDenylist []string
+	// defaultResourcePatterns match the default resources that backups can
+	// leave out, as read from the default resources config map on startup.
+	// If nil, the built-in patterns are used.
+	defaultResourcePatterns []string
+	backupSyncFilter        controller.BackupSyncFilter
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -249,6 +339,19 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 	}
 	f.SetClientBurst(config.clientBurst)
 
+	if errs := validation.IsValidLabelValue(config.clusterName); len(errs) > 0 {
+		return nil, errors.Errorf("cluster-name %q is invalid: %s", config.clusterName, strings.Join(errs, "; "))
+	}
+
+	backupSyncFilter := controller.BackupSyncFilter{Prefix: config.backupSyncPrefix}
+	if config.backupSyncLabelSelector != "" {
+		selector, err := labels.Parse(config.backupSyncLabelSelector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "backup-sync-label-selector %q is invalid", config.backupSyncLabelSelector)
+		}
+		backupSyncFilter.LabelSelector = selector
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
@@ -335,6 +438,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		config:                              config,
 		mgr:                                 mgr,
 		credentialFileStore:                 credentialFileStore,
+		credentialSecretStore:               credentials.NewNamespacedSecretStore(mgr.GetClient(), f.Namespace()),
+		backupSyncFilter:                    backupSyncFilter,
 	}
 
 	return s, nil
@@ -362,6 +467,14 @@ func (s *server) run() error {
 		return err
 	}
 
+	if err := s.initResourceDenylist(); err != nil {
+		return err
+	}
+
+	if err := s.initDefaultResourcePatterns(); err != nil {
+		return err
+	}
+
 	// We don't need the restic features for our use case.
 	//if err := s.initRestic(); err != nil {
 	//	return err
@@ -387,6 +500,51 @@ func (s *server) namespaceExists(namespace string) error {
 	return nil
 }
 
+// initResourceDenylist reads the resources that no backup includes from the
+// server's resource denylist config map, if there is one.
+func (s *server) initResourceDenylist() error {
+	if s.config.resourceDenylistConfigMap == "" {
+		return nil
+	}
+
+	configMap, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(s.ctx, s.config.resourceDenylistConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error getting resource denylist config map %s/%s", s.namespace, s.config.resourceDenylistConfigMap)
+	}
+
+	if s.resourceDenylist, err = backup.GetResourceDenylist(configMap); err != nil {
+		return err
+	}
+
+	s.logger.WithField("resources", strings.Join(s.resourceDenylist, ", ")).Info("Resources denied for all backups")
+	return nil
+}
+
+// initDefaultResourcePatterns reads the patterns matching the default
+// resources that backups can leave out from the default resources config
+// map, if there is one.
+func (s *server) initDefaultResourcePatterns() error {
+	if s.config.defaultResourcesConfigMap == "" {
+		return nil
+	}
+
+	configMap, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(s.ctx, s.config.defaultResourcesConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error getting default resources config map %s/%s", s.namespace, s.config.defaultResourcesConfigMap)
+	}
+
+	patterns, err := backup.GetDefaultResourcePatterns(configMap)
+	if err != nil {
+		return err
+	}
+	// an empty list means no resources are default resources, rather than
+	// the built-in patterns.
+	s.defaultResourcePatterns = append([]string{}, patterns...)
+
+	s.logger.WithField("patterns", strings.Join(s.defaultResourcePatterns, ", ")).Info("Default resources that backups can exclude")
+	return nil
+}
+
 // initDiscoveryHelper instantiates the server's discovery helper and spawns a
 // goroutine to call Refresh() every 5 minutes.
 func (s *server) initDiscoveryHelper() error {
@@ -450,29 +608,32 @@ func (s *server) veleroResourcesExist() error {
 	return nil
 }
 
-// - Custom Resource Definitions come before Custom Resource so that they can be
-//   restored with their corresponding CRD.
-// - Namespaces go second because all namespaced resources depend on them.
-// - Storage Classes are needed to create PVs and PVCs correctly.
-// - VolumeSnapshotClasses  are needed to provision volumes using volumesnapshots
-// - VolumeSnapshotContents are needed as they contain the handle to the volume snapshot in the
-//	 storage provider
-// - VolumeSnapshots are needed to create PVCs using the VolumeSnapshot as their data source.
-// - PVs go before PVCs because PVCs depend on them.
-// - PVCs go before pods or controllers so they can be mounted as volumes.
-// - Secrets and config maps go before pods or controllers so they can be mounted
-// 	 as volumes.
-// - Service accounts go before pods or controllers so pods can use them.
-// - Limit ranges go before pods or controllers so pods can use them.
-// - Pods go before controllers so they can be explicitly restored and potentially
-//	 have restic restores run before controllers adopt the pods.
-// - Replica sets go before deployments/other controllers so they can be explicitly
-//	 restored and be adopted by controllers.
-// - CAPI Clusters come before ClusterResourceSets because failing to do so means the CAPI controller-manager will panic.
-//	 Both Clusters and ClusterResourceSets need to come before ClusterResourceSetBinding in order to properly restore workload clusters.
-//   See https://github.com/kubernetes-sigs/cluster-api/issues/4105
+//   - Custom Resource Definitions come before Custom Resource so that they can be
+//     restored with their corresponding CRD.
+//   - APIServices come before the resources served by the aggregated API servers they
+//     register, which can't be restored until the group version's APIService exists.
+//   - Namespaces go next because all namespaced resources depend on them.
+//   - Storage Classes are needed to create PVs and PVCs correctly.
+//   - VolumeSnapshotClasses  are needed to provision volumes using volumesnapshots
+//   - VolumeSnapshotContents are needed as they contain the handle to the volume snapshot in the
+//     storage provider
+//   - VolumeSnapshots are needed to create PVCs using the VolumeSnapshot as their data source.
+//   - PVs go before PVCs because PVCs depend on them.
+//   - PVCs go before pods or controllers so they can be mounted as volumes.
+//   - Secrets and config maps go before pods or controllers so they can be mounted
+//     as volumes.
+//   - Service accounts go before pods or controllers so pods can use them.
+//   - Limit ranges go before pods or controllers so pods can use them.
+//   - Pods go before controllers so they can be explicitly restored and potentially
+//     have restic restores run before controllers adopt the pods.
+//   - Replica sets go before deployments/other controllers so they can be explicitly
+//     restored and be adopted by controllers.
+//   - CAPI Clusters come before ClusterResourceSets because failing to do so means the CAPI controller-manager will panic.
+//     Both Clusters and ClusterResourceSets need to come before ClusterResourceSetBinding in order to properly restore workload clusters.
+//     See https://github.com/kubernetes-sigs/cluster-api/issues/4105
 var defaultRestorePriorities = []string{
 	"customresourcedefinitions",
+	"apiservices.apiregistration.k8s.io",
 	"namespaces",
 	"storageclasses",
 	"volumesnapshotclass.snapshot.storage.k8s.io",
@@ -577,7 +738,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
 	}
 
-	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
+	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore, s.credentialSecretStore, s.config.objectStoreBandwidthLimit)
 
 	csiVSLister, csiVSCLister := s.getCSISnapshotListers()
 
@@ -593,8 +754,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.csiSnapshotClient,
 			s.kubeClient,
 			s.config.defaultBackupLocation,
+			s.config.clusterName,
+			s.config.syncAllClusterBackups,
+			s.backupSyncFilter,
 			newPluginManager,
 			backupStoreGetter,
+			s.metrics,
 			s.logger,
 		)
 
@@ -607,6 +772,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	backupTracker := controller.NewBackupTracker()
 
 	backupControllerRunInfo := func() controllerRunInfo {
+		// a nil metrics disables the per-item backup duration metric
+		var backupItemMetrics *metrics.ServerMetrics
+		if !s.config.disableBackupItemDurationMetric {
+			backupItemMetrics = s.metrics
+		}
+
 		backupper, err := backup.NewKubernetesBackupper(
 			s.veleroClient.VeleroV1(),
 			s.discoveryHelper,
@@ -616,6 +787,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.defaultVolumesToRestic,
+			s.config.volumeSnapshotWorkers,
+			backupItemMetrics,
+			s.resourceDenylist,
+			s.defaultResourcePatterns,
 		)
 		cmd.CheckError(err)
 
@@ -630,8 +805,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupTracker,
 			s.mgr.GetClient(),
 			s.config.defaultBackupLocation,
+			s.config.clusterName,
 			s.config.defaultVolumesToRestic,
 			s.config.defaultBackupTTL,
+			velerov1api.CompressionAlgorithm(s.config.defaultBackupCompression.String()),
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
 			defaultVolumeSnapshotLocations,
 			s.metrics,
@@ -639,6 +816,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			csiVSLister,
 			csiVSCLister,
 			backupStoreGetter,
+			s.config.verifyBackupUpload,
+			s.config.backupLogFlushInterval,
+			s.mgr.GetEventRecorderFor(controller.Backup),
 		)
 
 		return controllerRunInfo{
@@ -651,6 +831,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		scheduleController := controller.NewScheduleController(
 			// Empty namespace so that the controller is able to retrieve Schedules from any namespace.
 			"",
+			s.config.clusterName,
 			s.veleroClient.VeleroV1(),
 			s.veleroClient.VeleroV1(),
 			s.sharedInformerFactory.Velero().V1().Schedules(),
@@ -718,9 +899,12 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.resourceTerminatingTimeout,
+			s.config.restoreResourceTimeout,
+			s.config.crdEstablishTimeout,
+			s.config.restoreItemRetries,
+			s.config.restoreItemRetryBackoff,
+			s.config.restoreItemWorkers,
 			s.logger,
-			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
-			s.kubeClient.CoreV1().RESTClient(),
 		)
 		cmd.CheckError(err)
 
@@ -740,6 +924,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			backupStoreGetter,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			s.mgr.GetEventRecorderFor(controller.Restore),
+			s.config.streamRestoreBackups,
+			s.config.restoreMemoryLimit,
 		)
 
 		return controllerRunInfo{
@@ -756,6 +943,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.mgr.GetClient(),
 			s.resticManager,
 			s.config.defaultResticMaintenanceFrequency,
+			s.metrics,
 		)
 
 		return controllerRunInfo{
@@ -830,15 +1018,35 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
 			StorageLocation:           s.config.defaultBackupLocation,
 			ServerValidationFrequency: s.config.storeValidationFrequency,
+			ValidationMaxFailures:     s.config.storeValidationMaxFailures,
+			ValidationBackoffBase:     s.config.storeValidationBackoffBase,
+			ValidationBackoffCap:      s.config.storeValidationBackoffCap,
 		},
-		NewPluginManager:  newPluginManager,
-		BackupStoreGetter: backupStoreGetter,
-		Log:               s.logger,
+		NewPluginManager:     newPluginManager,
+		BackupStoreGetter:    backupStoreGetter,
+		ClusterName:          s.config.clusterName,
+		SyncFilter:           s.backupSyncFilter,
+		EventRecorder:        s.mgr.GetEventRecorderFor(controller.BackupStorageLocation),
+		StorageUsageInterval: s.config.storageUsageInterval,
+		Metrics:              s.metrics,
+		Log:                  s.logger,
 	}
 	if err := bslr.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocation)
 	}
 
+	vslr := controller.VolumeSnapshotLocationReconciler{
+		Ctx:                 s.ctx,
+		Client:              s.mgr.GetClient(),
+		Scheme:              s.mgr.GetScheme(),
+		ValidationFrequency: s.config.snapshotLocationValidationFrequency,
+		NewPluginManager:    newPluginManager,
+		Log:                 s.logger,
+	}
+	if err := vslr.SetupWithManager(s.mgr); err != nil {
+		s.logger.Fatal(err, "unable to create controller", "controller", controller.VolumeSnapshotLocation)
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.ServerStatusRequest]; ok {
 		r := controller.ServerStatusRequestReconciler{
 			Scheme:         s.mgr.GetScheme(),
@@ -41,6 +41,17 @@ import (
 var ErrNotFound = errors.New("file not found")
 
 func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+	return stream(ctx, kbClient, namespace, name, kind, w, timeout, insecureSkipTLSVerify, caCertFile, false)
+}
+
+// StreamPartial is like Stream, but for a log that's still being written: the
+// log in object storage isn't a complete gzip stream until it's finished, so
+// its being truncated isn't an error.
+func StreamPartial(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+	return stream(ctx, kbClient, namespace, name, kind, w, timeout, insecureSkipTLSVerify, caCertFile, true)
+}
+
+func stream(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string, partial bool) error {
 	reqName := fmt.Sprintf("%s-%s", name, time.Now().Format("20060102150405"))
 	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
 
@@ -151,5 +162,8 @@ func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name strin
 	}
 
 	_, err = io.Copy(w, reader)
+	if partial && err == io.ErrUnexpectedEOF {
+		return nil
+	}
 	return err
 }
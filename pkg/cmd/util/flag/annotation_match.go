@@ -0,0 +1,63 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flag
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// AnnotationMatch is a Cobra-compatible wrapper for defining
+// a flag that matches objects by an annotation, formatted as
+// "key=value", or "key" to match any value.
+type AnnotationMatch struct {
+	AnnotationMatch *velerov1api.AnnotationMatch
+}
+
+// String returns a string representation of the annotation
+// match flag.
+func (am *AnnotationMatch) String() string {
+	if am.AnnotationMatch == nil {
+		return ""
+	}
+	return am.AnnotationMatch.String()
+}
+
+// Set parses the provided string and assigns the result
+// to the annotation match receiver. It returns an error if
+// the string has no key.
+func (am *AnnotationMatch) Set(s string) error {
+	parts := strings.SplitN(s, "=", 2)
+	if parts[0] == "" {
+		return errors.Errorf("annotation %q must have a key", s)
+	}
+
+	am.AnnotationMatch = &velerov1api.AnnotationMatch{Key: parts[0]}
+	if len(parts) == 2 {
+		am.AnnotationMatch.Value = parts[1]
+	}
+	return nil
+}
+
+// Type returns a string representation of the
+// AnnotationMatch type.
+func (am *AnnotationMatch) Type() string {
+	return "annotation"
+}
@@ -17,9 +17,7 @@ limitations under the License.
 package output
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -32,10 +30,8 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
-	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
-	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
-	"github.com/vmware-tanzu/velero/pkg/volume"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 // DescribeBackup describes a backup in human-readable format.
@@ -51,64 +47,67 @@ func DescribeBackup(
 	insecureSkipTLSVerify bool,
 	caCertFile string,
 ) string {
+	return DescribeBackupDescription(NewBackupDescription(ctx, kbClient, backup, deleteRequests, podVolumeBackups, volumeSnapshotContents, details, insecureSkipTLSVerify, caCertFile))
+}
+
+// DescribeBackupDescription describes a backup's description in human-readable format.
+func DescribeBackupDescription(desc *BackupDescription) string {
 	return Describe(func(d *Describer) {
-		d.DescribeMetadata(backup.ObjectMeta)
+		d.DescribeMetadata(desc.Metadata)
 
 		d.Println()
-		phase := backup.Status.Phase
-		if phase == "" {
-			phase = velerov1api.BackupPhaseNew
-		}
+		phase := desc.Phase
 		phaseString := string(phase)
 		switch phase {
 		case velerov1api.BackupPhaseFailedValidation, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed:
 			phaseString = color.RedString(phaseString)
 		case velerov1api.BackupPhaseCompleted:
 			phaseString = color.GreenString(phaseString)
+		case velerov1api.BackupPhaseCancelled:
+			phaseString = color.YellowString(phaseString)
 		case velerov1api.BackupPhaseDeleting:
 		case velerov1api.BackupPhaseInProgress:
 		case velerov1api.BackupPhaseNew:
 		}
 
 		logsNote := ""
-		if backup.Status.Phase == velerov1api.BackupPhaseFailed || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
-			logsNote = fmt.Sprintf(" (run `velero backup logs %s` for more information)", backup.Name)
+		if phase == velerov1api.BackupPhaseFailed || phase == velerov1api.BackupPhasePartiallyFailed || phase == velerov1api.BackupPhaseCancelled {
+			logsNote = fmt.Sprintf(" (run `velero backup logs %s` for more information)", desc.Metadata.Name)
 		}
 
 		d.Printf("Phase:\t%s%s\n", phaseString, logsNote)
 
-		status := backup.Status
-		if len(status.ValidationErrors) > 0 {
+		if len(desc.ValidationErrors) > 0 {
 			d.Println()
 			d.Printf("Validation errors:")
-			for _, ve := range status.ValidationErrors {
+			for _, ve := range desc.ValidationErrors {
 				d.Printf("\t%s\n", color.RedString(ve))
 			}
 		}
 
 		d.Println()
-		d.Printf("Errors:\t%d\n", status.Errors)
-		d.Printf("Warnings:\t%d\n", status.Warnings)
+		d.Printf("Errors:\t%d\n", desc.Errors)
+		d.Printf("Warnings:\t%d\n", desc.Warnings)
 
 		d.Println()
-		DescribeBackupSpec(d, backup.Spec)
+		DescribeBackupSpec(d, desc.Spec)
 
 		d.Println()
-		DescribeBackupStatus(ctx, kbClient, d, backup, details, veleroClient, insecureSkipTLSVerify, caCertFile)
+		DescribeBackupStatus(d, desc)
 
-		if len(deleteRequests) > 0 {
+		if len(desc.DeletionAttempts) > 0 {
 			d.Println()
-			DescribeDeleteBackupRequests(d, deleteRequests)
+			DescribeDeleteBackupRequests(d, desc.DeletionAttempts)
 		}
 
-		if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		if desc.CSIVolumeSnapshots != nil {
 			d.Println()
-			DescribeCSIVolumeSnapshots(d, details, volumeSnapshotContents)
+			DescribeCSIVolumeSnapshots(d, desc.Details, desc.CSIVolumeSnapshots.Snapshots)
 		}
 
-		if len(podVolumeBackups) > 0 {
+		if len(desc.PodVolumeBackups) > 0 {
 			d.Println()
-			DescribePodVolumeBackups(d, podVolumeBackups, details)
+			DescribePodVolumeBackups(d, desc.PodVolumeBackups, desc.Details)
 		}
 
 	})
@@ -148,6 +147,12 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("\tExcluded:\t%s\n", s)
 
 	d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(spec.IncludeClusterResources, "excluded", "included", "auto"))
+	if spec.IncludeRelatedClusterResources != nil {
+		d.Printf("\tRelated cluster-scoped:\t%s\n", BoolPointerString(spec.IncludeRelatedClusterResources, "excluded", "included", ""))
+	}
+	if spec.IncludeAPIServices != nil {
+		d.Printf("\tAPI services:\t%s\n", BoolPointerString(spec.IncludeAPIServices, "excluded", "included", ""))
+	}
 
 	d.Println()
 	s = "<none>"
@@ -156,8 +161,112 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 	d.Printf("Label selector:\t%s\n", s)
 
+	if len(spec.ResourceLabelSelectors) > 0 {
+		d.Println()
+		d.Printf("Resource label selectors:\n")
+		resources := make([]string, 0, len(spec.ResourceLabelSelectors))
+		for resource := range spec.ResourceLabelSelectors {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+		for _, resource := range resources {
+			d.Printf("\t%s:\t%s\n", resource, spec.ResourceLabelSelectors[resource])
+		}
+	}
+
+	if len(spec.ExcludedFields) > 0 {
+		d.Println()
+		d.Printf("Excluded fields:\n")
+		resources := make([]string, 0, len(spec.ExcludedFields))
+		for resource := range spec.ExcludedFields {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+		for _, resource := range resources {
+			d.Printf("\t%s:\t%s\n", resource, strings.Join(spec.ExcludedFields[resource], ", "))
+		}
+	}
+
+	if len(spec.ResourceAPIVersions) > 0 {
+		d.Println()
+		d.Printf("Additional API versions:\n")
+		resources := make([]string, 0, len(spec.ResourceAPIVersions))
+		for resource := range spec.ResourceAPIVersions {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+		for _, resource := range resources {
+			d.Printf("\t%s:\t%s\n", resource, strings.Join(spec.ResourceAPIVersions[resource], ", "))
+		}
+	}
+
+	if boolptr.IsSetToTrue(spec.RedactSecrets) {
+		d.Println()
+		d.Printf("Secret values:\tredacted\n")
+	}
+
+	d.Println()
+	s = "<none>"
+	if spec.ExcludedAnnotation != nil {
+		s = spec.ExcludedAnnotation.String()
+	}
+	d.Printf("Excluded annotation:\t%s\n", s)
+
+	if filter := spec.ItemFilter; filter != nil {
+		d.Println()
+		d.Printf("Item filter:\n")
+		s = "*"
+		if len(filter.IncludedItems) > 0 {
+			s = strings.Join(filter.IncludedItems, ", ")
+		}
+		d.Printf("\tIncluded:\t%s\n", s)
+		s = "<none>"
+		if len(filter.ExcludedItems) > 0 {
+			s = strings.Join(filter.ExcludedItems, ", ")
+		}
+		d.Printf("\tExcluded:\t%s\n", s)
+	}
+
+	if boolptr.IsSetToTrue(spec.ExcludeDefaultResources) {
+		d.Println()
+		d.Printf("Default resources:\texcluded\n")
+	}
+
+	if len(spec.ExcludedOwnerKinds) > 0 {
+		d.Println()
+		d.Printf("Excluded owner kinds:\t%s\n", strings.Join(spec.ExcludedOwnerKinds, ", "))
+	}
+
+	if spec.ModifiedSince != nil {
+		d.Println()
+		d.Printf("Modified since:\t%s before the backup started\n", spec.ModifiedSince.Duration)
+	}
+
+	if thresholds := spec.ItemFailureThresholds; thresholds != nil {
+		d.Println()
+		d.Printf("Item failure thresholds:\n")
+		s := "<none>"
+		if thresholds.MaxFailedItems != nil {
+			s = fmt.Sprintf("%d", *thresholds.MaxFailedItems)
+		}
+		d.Printf("\tMax failed items:\t%s\n", s)
+		s = "<none>"
+		if thresholds.MaxFailedItemsPercent != nil {
+			s = fmt.Sprintf("%d%%", *thresholds.MaxFailedItemsPercent)
+		}
+		d.Printf("\tMax failed items percent:\t%s\n", s)
+		s = "<none>"
+		if len(thresholds.CriticalResources) > 0 {
+			s = strings.Join(thresholds.CriticalResources, ", ")
+		}
+		d.Printf("\tCritical resources:\t%s\n", s)
+	}
+
 	d.Println()
 	d.Printf("Storage Location:\t%s\n", spec.StorageLocation)
+	if len(spec.MirrorStorageLocations) > 0 {
+		d.Printf("Mirror Storage Locations:\t%s\n", strings.Join(spec.MirrorStorageLocations, ", "))
+	}
 
 	d.Println()
 	d.Printf("Velero-Native Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
@@ -166,11 +275,13 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
 
 	d.Println()
-	if len(spec.Hooks.Resources) == 0 {
+	if len(spec.Hooks.Resources) == 0 && len(spec.Hooks.Backup) == 0 {
 		d.Printf("Hooks:\t<none>\n")
 	} else {
 		d.Printf("Hooks:\n")
-		d.Printf("\tResources:\n")
+		if len(spec.Hooks.Resources) > 0 {
+			d.Printf("\tResources:\n")
+		}
 		for _, backupResourceHookSpec := range spec.Hooks.Resources {
 			d.Printf("\t\t%s:\n", backupResourceHookSpec.Name)
 			d.Printf("\t\t\tNamespaces:\n")
@@ -232,6 +343,19 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 				}
 			}
 		}
+
+		if len(spec.Hooks.Backup) > 0 {
+			d.Printf("\tBackup:\n")
+		}
+		for _, backupLevelHookSpec := range spec.Hooks.Backup {
+			d.Printf("\t\t%s:\n", backupLevelHookSpec.Name)
+			for _, hook := range backupLevelHookSpec.PreHooks {
+				describeBackupLevelHook(d, "Pre", hook)
+			}
+			for _, hook := range backupLevelHookSpec.PostHooks {
+				describeBackupLevelHook(d, "Post", hook)
+			}
+		}
 	}
 
 	if spec.OrderedResources != nil {
@@ -244,71 +368,154 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 }
 
-// DescribeBackupStatus describes a backup status in human-readable format.
-func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertPath string) {
-	status := backup.Status
-
+// DescribeBackupStatus describes a backup's status in human-readable format.
+func DescribeBackupStatus(d *Describer, desc *BackupDescription) {
 	// Status.Version has been deprecated, use Status.FormatVersion
-	d.Printf("Backup Format Version:\t%s\n", status.FormatVersion)
+	d.Printf("Backup Format Version:\t%s\n", desc.FormatVersion)
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
-	if status.StartTimestamp == nil || status.StartTimestamp.Time.IsZero() {
+	if desc.StartTimestamp == nil || desc.StartTimestamp.Time.IsZero() {
 		d.Printf("Started:\t%s\n", "<n/a>")
 	} else {
-		d.Printf("Started:\t%s\n", status.StartTimestamp.Time)
+		d.Printf("Started:\t%s\n", desc.StartTimestamp.Time)
 	}
-	if status.CompletionTimestamp == nil || status.CompletionTimestamp.Time.IsZero() {
+	if desc.CompletionTimestamp == nil || desc.CompletionTimestamp.Time.IsZero() {
 		d.Printf("Completed:\t%s\n", "<n/a>")
 	} else {
-		d.Printf("Completed:\t%s\n", status.CompletionTimestamp.Time)
+		d.Printf("Completed:\t%s\n", desc.CompletionTimestamp.Time)
 	}
 
 	d.Println()
 	// Expiration can't be 0, it is always set to a 30-day default. It can be nil
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
-	d.Printf("Expiration:\t%s\n", status.Expiration)
+	d.Printf("Expiration:\t%s\n", desc.Expiration)
+	if override := desc.ExpirationOverride; override != nil {
+		if override.Expiration == nil {
+			d.Printf("Expiration override:\t<ignored: %s>\n", override.Error)
+		} else if override.Error != "" {
+			d.Printf("Expiration override:\t%s <ignored: %s>\n", override.Expiration, override.Error)
+		} else {
+			d.Printf("Expiration override:\t%s\n", override.Expiration)
+		}
+		if override.RequestedBy != "" {
+			d.Printf("\tRequested by:\t%s\n", override.RequestedBy)
+		}
+		if override.RequestedAt != nil {
+			d.Printf("\tRequested at:\t%s\n", override.RequestedAt)
+		}
+		if override.Reason != "" {
+			d.Printf("\tReason:\t%s\n", override.Reason)
+		}
+	}
+	if desc.GCHold {
+		d.Printf("GC hold:\ttrue (not garbage-collected when expired)\n")
+	}
 	d.Println()
 
-	if backup.Status.Progress != nil {
-		if backup.Status.Phase == velerov1api.BackupPhaseInProgress {
-			d.Printf("Estimated total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
-			d.Printf("Items backed up so far:\t%d\n", backup.Status.Progress.ItemsBackedUp)
+	if desc.CompressionAlgorithm != "" {
+		d.Printf("Compression:\t%s\n", desc.CompressionAlgorithm)
+		d.Println()
+	}
+
+	if desc.BaseBackup != "" {
+		d.Printf("Base Backup:\t%s\n", desc.BaseBackup)
+		d.Println()
+	}
+
+	if desc.HookStatus != nil {
+		describeHookStatus(d, desc.HookStatus)
+		d.Println()
+	}
+
+	if len(desc.MirrorStatuses) > 0 {
+		d.Printf("Mirrors:\n")
+		for _, mirror := range desc.MirrorStatuses {
+			if mirror.Message != "" {
+				d.Printf("\t%s:\t%s (%s)\n", mirror.StorageLocation, mirror.Phase, mirror.Message)
+			} else {
+				d.Printf("\t%s:\t%s\n", mirror.StorageLocation, mirror.Phase)
+			}
+		}
+		d.Println()
+	}
+
+	if len(desc.ArchivedNamespaces) > 0 {
+		var namespaces []string
+		for namespace := range desc.ArchivedNamespaces {
+			namespaces = append(namespaces, namespace)
+		}
+		sort.Strings(namespaces)
+
+		d.Printf("Archived namespaces:\n")
+		for _, namespace := range namespaces {
+			d.Printf("\t%s:\t%s\n", namespace, desc.ArchivedNamespaces[namespace])
+		}
+		d.Println()
+	}
+
+	if desc.Progress != nil {
+		if desc.Phase == velerov1api.BackupPhaseInProgress {
+			d.Printf("Estimated total items to be backed up:\t%d\n", desc.Progress.TotalItems)
+			d.Printf("Items backed up so far:\t%d\n", desc.Progress.ItemsBackedUp)
 		} else {
-			d.Printf("Total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
-			d.Printf("Items backed up:\t%d\n", backup.Status.Progress.ItemsBackedUp)
+			d.Printf("Total items to be backed up:\t%d\n", desc.Progress.TotalItems)
+			d.Printf("Items backed up:\t%d\n", desc.Progress.ItemsBackedUp)
 		}
 
 		d.Println()
 	}
 
-	if details {
-		describeBackupResourceList(ctx, kbClient, d, backup, insecureSkipTLSVerify, caCertPath)
+	if filtered := desc.FilteredItems; filtered != nil {
+		d.Printf("Items Filtered Out:\n")
+		d.Printf("\tBy resource:\t%d\n", filtered.Resources)
+		d.Printf("\tBy namespace:\t%d\n", filtered.Namespaces)
+		d.Printf("\tBy label selector:\t%d\n", filtered.LabelSelector)
+		d.Printf("\tBy item filter:\t%d\n", filtered.ItemFilter)
+		d.Printf("\tBy owner kind:\t%d\n", filtered.OwnerKind)
+		d.Printf("\tAs default resources:\t%d\n", filtered.DefaultResources)
+		d.Printf("\tBy modification time:\t%d\n", filtered.ModifiedSince)
 		d.Println()
 	}
 
-	if status.VolumeSnapshotsAttempted > 0 {
-		if !details {
-			d.Printf("Velero-Native Snapshots:\t%d of %d snapshots completed successfully (specify --details for more information)\n", status.VolumeSnapshotsCompleted, status.VolumeSnapshotsAttempted)
-			return
+	if failures := desc.ItemFailures; failures != nil {
+		d.Printf("Failed Items:\n")
+		d.Printf("\tFailed:\t%d of %d attempted (%s%%)\n", failures.FailedItems, failures.AttemptedItems, failures.FailedPercent)
+		d.Printf("\tOf critical resources:\t%d\n", failures.CriticalFailedItems)
+		d.Println()
+	}
+
+	if size := desc.PodVolumeBackupSize; size != nil {
+		d.Printf("Restic Backup Size:\n")
+		d.Printf("\tLogical (bytes):\t%d\n", size.LogicalBytes)
+		d.Printf("\tAdded to repositories (bytes):\t%d\n", size.RepositoryBytesAdded)
+		if desc.VolumeSnapshots.Attempted > 0 || desc.CSIVolumeSnapshots != nil {
+			d.Printf("\tVolume snapshots:\t<not included, their size isn't known to Velero>\n")
 		}
+		d.Println()
+	}
+
+	if desc.ResourceList != nil {
+		describeBackupResourceList(d, desc.ResourceList)
+		d.Println()
+	}
 
-		buf := new(bytes.Buffer)
-		if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeSnapshots, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
-			d.Printf("Velero-Native Snapshots:\t<error getting snapshot info: %v>\n", err)
+	snapshots := desc.VolumeSnapshots
+	if snapshots.Attempted > 0 {
+		if !desc.Details {
+			d.Printf("Velero-Native Snapshots:\t%d of %d snapshots completed successfully (specify --details for more information)\n", snapshots.Completed, snapshots.Attempted)
 			return
 		}
 
-		var snapshots []*volume.Snapshot
-		if err := json.NewDecoder(buf).Decode(&snapshots); err != nil {
-			d.Printf("Velero-Native Snapshots:\t<error reading snapshot info: %v>\n", err)
+		if snapshots.Error != "" {
+			d.Printf("Velero-Native Snapshots:\t<%s>\n", snapshots.Error)
 			return
 		}
 
 		d.Printf("Velero-Native Snapshots:\n")
-		for _, snap := range snapshots {
-			describeSnapshot(d, snap.Spec.PersistentVolumeName, snap.Status.ProviderSnapshotID, snap.Spec.VolumeType, snap.Spec.VolumeAZ, snap.Spec.VolumeIOPS)
+		for _, snap := range snapshots.Snapshots {
+			describeSnapshot(d, snap.PersistentVolumeName, snap.SnapshotID, snap.VolumeType, snap.AvailabilityZone, snap.IOPS)
 		}
 		return
 	}
@@ -316,27 +523,13 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	d.Printf("Velero-Native Snapshots: <none included>\n")
 }
 
-func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
-	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
-		if err == downloadrequest.ErrNotFound {
-			// the backup resource list could be missing if (other reasons may exist as well):
-			//	- the backup was taken prior to v1.1; or
-			//	- the backup hasn't completed yet; or
-			//	- there was an error uploading the file; or
-			//	- the file was manually deleted after upload
-			d.Println("Resource List:\t<backup resource list not found>")
-		} else {
-			d.Printf("Resource List:\t<error getting backup resource list: %v>\n", err)
-		}
+func describeBackupResourceList(d *Describer, list *BackupResourceListDescription) {
+	if list.Error != "" {
+		d.Printf("Resource List:\t<%s>\n", list.Error)
 		return
 	}
 
-	var resourceList map[string][]string
-	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
-		d.Printf("Resource List:\t<error reading backup resource list: %v>\n", err)
-		return
-	}
+	resourceList := list.Resources
 
 	d.Println("Resource List:")
 
@@ -365,7 +558,7 @@ func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ str
 }
 
 // DescribeDeleteBackupRequests describes delete backup requests in human-readable format.
-func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBackupRequest) {
+func DescribeDeleteBackupRequests(d *Describer, requests []DeletionAttemptDescription) {
 	d.Printf("Deletion Attempts")
 	if count := failedDeletionCount(requests); count > 0 {
 		d.Printf(" (%d failed)", count)
@@ -380,20 +573,20 @@ func DescribeDeleteBackupRequests(d *Describer, requests []velerov1api.DeleteBac
 			d.Println()
 		}
 
-		d.Printf("\t%s: %s\n", req.CreationTimestamp.String(), req.Status.Phase)
-		if len(req.Status.Errors) > 0 {
+		d.Printf("\t%s: %s\n", req.CreationTimestamp.String(), req.Phase)
+		if len(req.Errors) > 0 {
 			d.Printf("\tErrors:\n")
-			for _, err := range req.Status.Errors {
+			for _, err := range req.Errors {
 				d.Printf("\t\t%s\n", err)
 			}
 		}
 	}
 }
 
-func failedDeletionCount(requests []velerov1api.DeleteBackupRequest) int {
+func failedDeletionCount(requests []DeletionAttemptDescription) int {
 	var count int
 	for _, req := range requests {
-		if req.Status.Phase == velerov1api.DeleteBackupRequestPhaseProcessed && len(req.Status.Errors) > 0 {
+		if req.Phase == velerov1api.DeleteBackupRequestPhaseProcessed && len(req.Errors) > 0 {
 			count++
 		}
 	}
@@ -401,7 +594,7 @@ func failedDeletionCount(requests []velerov1api.DeleteBackupRequest) int {
 }
 
 // DescribePodVolumeBackups describes pod volume backups in human-readable format.
-func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBackup, details bool) {
+func DescribePodVolumeBackups(d *Describer, backups []PodVolumeBackupDescription, details bool) {
 	if details {
 		d.Printf("Restic Backups:\n")
 	} else {
@@ -432,7 +625,7 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 		backupsByPod := new(volumesByPod)
 
 		for _, backup := range backupsByPhase[phase] {
-			backupsByPod.Add(backup.Spec.Pod.Namespace, backup.Spec.Pod.Name, backup.Spec.Volume, phase, backup.Status.Progress)
+			backupsByPod.Add(backup.Namespace, backup.Pod, backup.Volume, phase, backup.Progress)
 		}
 
 		d.Printf("\t%s:\n", phase)
@@ -445,8 +638,8 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 	}
 }
 
-func groupByPhase(backups []velerov1api.PodVolumeBackup) map[string][]velerov1api.PodVolumeBackup {
-	backupsByPhase := make(map[string][]velerov1api.PodVolumeBackup)
+func groupByPhase(backups []PodVolumeBackupDescription) map[string][]PodVolumeBackupDescription {
+	backupsByPhase := make(map[string][]PodVolumeBackupDescription)
 
 	phaseToGroup := map[velerov1api.PodVolumeBackupPhase]string{
 		velerov1api.PodVolumeBackupPhaseCompleted:  string(velerov1api.PodVolumeBackupPhaseCompleted),
@@ -457,7 +650,7 @@ func groupByPhase(backups []velerov1api.PodVolumeBackup) map[string][]velerov1ap
 	}
 
 	for _, backup := range backups {
-		group := phaseToGroup[backup.Status.Phase]
+		group := phaseToGroup[backup.Phase]
 		backupsByPhase[group] = append(backupsByPhase[group], backup)
 	}
 
@@ -513,11 +706,7 @@ func (v *volumesByPod) Sorted() []*podVolumeGroup {
 	return v.volumesByPodSlice
 }
 
-func DescribeCSIVolumeSnapshots(d *Describer, details bool, volumeSnapshotContents []snapshotv1beta1api.VolumeSnapshotContent) {
-	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
-		return
-	}
-
+func DescribeCSIVolumeSnapshots(d *Describer, details bool, volumeSnapshotContents []CSIVolumeSnapshotDescription) {
 	if len(volumeSnapshotContents) == 0 {
 		d.Printf("CSI Volume Snapshots: <none included>\n")
 		return
@@ -535,23 +724,74 @@ func DescribeCSIVolumeSnapshots(d *Describer, details bool, volumeSnapshotConten
 	}
 }
 
-func DescribeVSC(d *Describer, details bool, vsc snapshotv1beta1api.VolumeSnapshotContent) {
-	if vsc.Status == nil {
-		d.Printf("Volume Snapshot Content %s cannot be described because its status is nil\n", vsc.Name)
+func DescribeVSC(d *Describer, details bool, vsc CSIVolumeSnapshotDescription) {
+	if !vsc.HasStatus {
+		d.Printf("Volume Snapshot Content %s cannot be described because its status is nil\n", vsc.SnapshotContentName)
 		return
 	}
 
-	d.Printf("Snapshot Content Name: %s\n", vsc.Name)
+	d.Printf("Snapshot Content Name: %s\n", vsc.SnapshotContentName)
 
-	if vsc.Status.SnapshotHandle != nil {
-		d.Printf("\tStorage Snapshot ID: %s\n", *vsc.Status.SnapshotHandle)
+	if vsc.StorageSnapshotID != nil {
+		d.Printf("\tStorage Snapshot ID: %s\n", *vsc.StorageSnapshotID)
 	}
 
-	if vsc.Status.RestoreSize != nil {
-		d.Printf("\tSnapshot Size (bytes): %d\n", *vsc.Status.RestoreSize)
+	if vsc.SnapshotSizeBytes != nil {
+		d.Printf("\tSnapshot Size (bytes): %d\n", *vsc.SnapshotSizeBytes)
 	}
 
-	if vsc.Status.ReadyToUse != nil {
-		d.Printf("\tReady to use: %t\n", *vsc.Status.ReadyToUse)
+	if vsc.ReadyToUse != nil {
+		d.Printf("\tReady to use: %t\n", *vsc.ReadyToUse)
+	}
+}
+
+// describeBackupLevelHook describes a backup-level hook of a backup's spec.
+func describeBackupLevelHook(d *Describer, phase string, hook velerov1api.BackupLevelHook) {
+	d.Println()
+	switch {
+	case hook.Exec != nil:
+		d.Printf("\t\t\t%s Exec Hook:\n", phase)
+		d.Printf("\t\t\t\tNamespace:\t%s\n", hook.Exec.Namespace)
+		d.Printf("\t\t\t\tPod selector:\t%s\n", metav1.FormatLabelSelector(hook.Exec.PodSelector))
+		d.Printf("\t\t\t\tContainer:\t%s\n", hook.Exec.Container)
+		d.Printf("\t\t\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
+		d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
+		d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
+	case hook.Plugin != nil:
+		d.Printf("\t\t\t%s Plugin Hook:\n", phase)
+		d.Printf("\t\t\t\tPlugin:\t%s\n", hook.Plugin.Name)
+		d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Plugin.OnError)
+	}
+}
+
+// describeHookStatus describes the results of a backup's hooks.
+func describeHookStatus(d *Describer, status *velerov1api.HookStatus) {
+	d.Printf("Hooks:\n")
+	d.Printf("\tAttempted:\t%d\n", status.HooksAttempted)
+	d.Printf("\tFailed:\t%d\n", status.HooksFailed)
+	if status.ResultsOmitted > 0 {
+		d.Printf("\tResults omitted:\t%d\n", status.ResultsOmitted)
+	}
+
+	for _, result := range status.Results {
+		d.Printf("\t%s hook %s:\n", result.Phase, result.Name)
+		if result.Plugin != "" {
+			d.Printf("\t\tPlugin:\t%s\n", result.Plugin)
+		} else {
+			d.Printf("\t\tPod:\t%s/%s\n", result.Namespace, result.Pod)
+		}
+		if result.Container != "" {
+			d.Printf("\t\tContainer:\t%s\n", result.Container)
+		}
+		if len(result.Command) > 0 {
+			d.Printf("\t\tCommand:\t%s\n", strings.Join(result.Command, " "))
+		}
+		d.Printf("\t\tOn error:\t%s\n", result.OnError)
+		d.Printf("\t\tDuration:\t%s\n", result.Duration.Duration)
+		if result.Error == "" {
+			d.Printf("\t\tResult:\tSucceeded\n")
+		} else {
+			d.Printf("\t\tResult:\tFailed: %s\n", result.Error)
+		}
 	}
 }
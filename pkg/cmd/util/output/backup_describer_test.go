@@ -0,0 +1,276 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestDescribeBackupStatus(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		want   string
+	}{
+		{
+			name: "completed backup with native snapshots and no recorded compression algorithm",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup("velero", "backup-1").
+					Phase(velerov1api.BackupPhaseCompleted).
+					Expiration(start.Add(30 * 24 * time.Hour)).
+					Result()
+				backup.Status.FormatVersion = "1.1.0"
+				backup.Status.VolumeSnapshotsAttempted = 2
+				backup.Status.VolumeSnapshotsCompleted = 1
+				return backup
+			}(),
+			want: "Backup Format Version:  1.1.0\n" +
+				"\n" +
+				"Started:    <n/a>\n" +
+				"Completed:  <n/a>\n" +
+				"\n" +
+				"Expiration:  2021-01-31 00:00:00 +0000 UTC\n" +
+				"\n" +
+				"Compression:  gzip\n" +
+				"\n" +
+				"Velero-Native Snapshots:  1 of 2 snapshots completed successfully (specify --details for more information)\n",
+		},
+		{
+			name: "in-progress backup",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup("velero", "backup-1").
+					Phase(velerov1api.BackupPhaseInProgress).
+					StartTimestamp(start).
+					Expiration(start.Add(30 * 24 * time.Hour)).
+					Result()
+				backup.Status.FormatVersion = "1.1.0"
+				backup.Status.CompressionAlgorithm = velerov1api.CompressionAlgorithmGzip
+				backup.Status.Progress = &velerov1api.BackupProgress{TotalItems: 10, ItemsBackedUp: 5}
+				return backup
+			}(),
+			want: "Backup Format Version:  1.1.0\n" +
+				"\n" +
+				"Started:    2021-01-01 00:00:00 +0000 UTC\n" +
+				"Completed:  <n/a>\n" +
+				"\n" +
+				"Expiration:  2021-01-31 00:00:00 +0000 UTC\n" +
+				"\n" +
+				"Compression:  gzip\n" +
+				"\n" +
+				"Estimated total items to be backed up:  10\n" +
+				"Items backed up so far:                 5\n" +
+				"\n" +
+				"Velero-Native Snapshots: <none included>\n",
+		},
+		{
+			name: "backup with an expiration override",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup("velero", "backup-1").
+					ObjectMeta(builder.WithAnnotations(
+						velerov1api.ExpirationOverrideAnnotation, "2021-03-01T00:00:00Z",
+						velerov1api.ExpirationOverrideRequestedByAnnotation, "jane",
+						velerov1api.ExpirationOverrideRequestedAtAnnotation, "2021-01-30T12:00:00Z",
+						velerov1api.ExpirationOverrideReasonAnnotation, "incident review",
+					)).
+					Phase(velerov1api.BackupPhaseCompleted).
+					Expiration(start.Add(30 * 24 * time.Hour)).
+					Result()
+				backup.Status.FormatVersion = "1.1.0"
+				backup.Status.CompressionAlgorithm = velerov1api.CompressionAlgorithmGzip
+				return backup
+			}(),
+			want: "Backup Format Version:  1.1.0\n" +
+				"\n" +
+				"Started:    <n/a>\n" +
+				"Completed:  <n/a>\n" +
+				"\n" +
+				"Expiration:           2021-01-31 00:00:00 +0000 UTC\n" +
+				"Expiration override:  2021-03-01 00:00:00 +0000 UTC\n" +
+				"                      Requested by:  jane\n" +
+				"                      Requested at:  2021-01-30 12:00:00 +0000 UTC\n" +
+				"                      Reason:        incident review\n" +
+				"\n" +
+				"Compression:  gzip\n" +
+				"\n" +
+				"Velero-Native Snapshots: <none included>\n",
+		},
+		{
+			name: "backup with hook results",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup("velero", "backup-1").
+					Phase(velerov1api.BackupPhaseCompleted).
+					Expiration(start.Add(30 * 24 * time.Hour)).
+					Result()
+				backup.Status.FormatVersion = "1.1.0"
+				backup.Status.CompressionAlgorithm = velerov1api.CompressionAlgorithmGzip
+				backup.Status.HookStatus = &velerov1api.HookStatus{
+					HooksAttempted: 2,
+					HooksFailed:    1,
+					Results: []velerov1api.BackupHookResult{
+						{
+							Name:      "<from-annotation>",
+							Phase:     velerov1api.BackupHookPhasePre,
+							Namespace: "ns-1",
+							Pod:       "pod-1",
+							Container: "app",
+							Command:   []string{"/sbin/fsfreeze", "--freeze", "/data"},
+							OnError:   velerov1api.HookErrorModeFail,
+							Duration:  metav1.Duration{Duration: 1500 * time.Millisecond},
+						},
+						{
+							Name:      "unfreeze",
+							Phase:     velerov1api.BackupHookPhasePost,
+							Namespace: "ns-1",
+							Pod:       "pod-1",
+							OnError:   velerov1api.HookErrorModeContinue,
+							Duration:  metav1.Duration{Duration: 2 * time.Second},
+							Error:     "command terminated with exit code 1",
+						},
+					},
+				}
+				return backup
+			}(),
+			want: "Backup Format Version:  1.1.0\n" +
+				"\n" +
+				"Started:    <n/a>\n" +
+				"Completed:  <n/a>\n" +
+				"\n" +
+				"Expiration:  2021-01-31 00:00:00 +0000 UTC\n" +
+				"\n" +
+				"Compression:  gzip\n" +
+				"\n" +
+				"Hooks:\n" +
+				"  Attempted:  2\n" +
+				"  Failed:     1\n" +
+				"  pre hook <from-annotation>:\n" +
+				"    Pod:        ns-1/pod-1\n" +
+				"    Container:  app\n" +
+				"    Command:    /sbin/fsfreeze --freeze /data\n" +
+				"    On error:   Fail\n" +
+				"    Duration:   1.5s\n" +
+				"    Result:     Succeeded\n" +
+				"  post hook unfreeze:\n" +
+				"    Pod:       ns-1/pod-1\n" +
+				"    On error:  Continue\n" +
+				"    Duration:  2s\n" +
+				"    Result:    Failed: command terminated with exit code 1\n" +
+				"\n" +
+				"Velero-Native Snapshots: <none included>\n",
+		},
+		{
+			name: "backup with failed items",
+			backup: func() *velerov1api.Backup {
+				backup := builder.ForBackup("velero", "backup-1").
+					Phase(velerov1api.BackupPhaseCompleted).
+					Expiration(start.Add(30 * 24 * time.Hour)).
+					Result()
+				backup.Status.FormatVersion = "1.1.0"
+				backup.Status.CompressionAlgorithm = velerov1api.CompressionAlgorithmGzip
+				backup.Status.ItemFailures = &velerov1api.BackupItemFailures{
+					FailedItems:    3,
+					AttemptedItems: 200,
+					FailedPercent:  "1.50",
+				}
+				return backup
+			}(),
+			want: "Backup Format Version:  1.1.0\n" +
+				"\n" +
+				"Started:    <n/a>\n" +
+				"Completed:  <n/a>\n" +
+				"\n" +
+				"Expiration:  2021-01-31 00:00:00 +0000 UTC\n" +
+				"\n" +
+				"Compression:  gzip\n" +
+				"\n" +
+				"Failed Items:\n" +
+				"  Failed:                 3 of 200 attempted (1.50%)\n" +
+				"  Of critical resources:  0\n" +
+				"\n" +
+				"Velero-Native Snapshots: <none included>\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			desc := NewBackupDescription(context.Background(), nil, tc.backup, nil, nil, nil, false, false, "")
+
+			got := Describe(func(d *Describer) {
+				DescribeBackupStatus(d, desc)
+			})
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestEncodeBackupDescription(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").
+		ObjectMeta(builder.WithLabels("velero.io/storage-location", "default")).
+		IncludedNamespaces("ns-1").
+		Phase(velerov1api.BackupPhasePartiallyFailed).
+		Result()
+	backup.Status.Errors = 1
+	backup.Status.VolumeSnapshotsAttempted = 1
+
+	deleteRequests := []velerov1api.DeleteBackupRequest{
+		{
+			Status: velerov1api.DeleteBackupRequestStatus{
+				Phase:  velerov1api.DeleteBackupRequestPhaseProcessed,
+				Errors: []string{"error deleting snapshot"},
+			},
+		},
+	}
+	podVolumeBackups := []velerov1api.PodVolumeBackup{
+		*builder.ForPodVolumeBackup("velero", "pvb-1").PodNamespace("ns-1").PodName("pod-1").Volume("data").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+	}
+
+	desc := NewBackupDescription(context.Background(), nil, backup, deleteRequests, podVolumeBackups, nil, false, false, "")
+
+	encoded, err := EncodeDescription(desc, "json")
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &got))
+
+	assert.Equal(t, map[string]interface{}{"velero.io/storage-location": "default"}, got["metadata"].(map[string]interface{})["labels"])
+	assert.Equal(t, "PartiallyFailed", got["phase"])
+	assert.Equal(t, float64(1), got["errors"])
+	assert.Equal(t, []interface{}{"ns-1"}, got["spec"].(map[string]interface{})["includedNamespaces"])
+	assert.Equal(t, map[string]interface{}{"attempted": float64(1), "completed": float64(0)}, got["volumeSnapshots"])
+	assert.Equal(t, []interface{}{"error deleting snapshot"}, got["deletionAttempts"].([]interface{})[0].(map[string]interface{})["errors"])
+	assert.Equal(t, "data", got["podVolumeBackups"].([]interface{})[0].(map[string]interface{})["volume"])
+	assert.NotContains(t, got, "resourceList")
+	assert.NotContains(t, got, "csiVolumeSnapshots")
+
+	encoded, err = EncodeDescription(desc, "yaml")
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), "\nphase: PartiallyFailed\n")
+
+	_, err = EncodeDescription(desc, "table")
+	assert.EqualError(t, err, `unsupported output format "table"; valid values are 'json' and 'yaml'`)
+}
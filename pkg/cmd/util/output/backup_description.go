@@ -0,0 +1,335 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// BackupDescription is everything `velero backup describe` shows about a backup,
+// so it can be rendered either as human-readable text or encoded as JSON or YAML.
+type BackupDescription struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+
+	// Details is whether the description includes the data that's only
+	// fetched when details are requested.
+	Details bool `json:"details"`
+
+	Phase            velerov1api.BackupPhase `json:"phase"`
+	ValidationErrors []string                `json:"validationErrors,omitempty"`
+	Errors           int                     `json:"errors"`
+	Warnings         int                     `json:"warnings"`
+
+	Spec velerov1api.BackupSpec `json:"spec"`
+
+	FormatVersion        string                           `json:"formatVersion,omitempty"`
+	StartTimestamp       *metav1.Time                     `json:"startTimestamp,omitempty"`
+	CompletionTimestamp  *metav1.Time                     `json:"completionTimestamp,omitempty"`
+	Expiration           *metav1.Time                     `json:"expiration,omitempty"`
+	ExpirationOverride   *ExpirationOverrideDescription   `json:"expirationOverride,omitempty"`
+	GCHold               bool                             `json:"gcHold,omitempty"`
+	CompressionAlgorithm velerov1api.CompressionAlgorithm `json:"compressionAlgorithm,omitempty"`
+	Progress             *velerov1api.BackupProgress      `json:"progress,omitempty"`
+	MirrorStatuses       []velerov1api.BackupMirrorStatus `json:"mirrorStatuses,omitempty"`
+	ArchivedNamespaces   map[string]string                `json:"archivedNamespaces,omitempty"`
+	BaseBackup           string                           `json:"baseBackup,omitempty"`
+	HookStatus           *velerov1api.HookStatus          `json:"hookStatus,omitempty"`
+	FilteredItems        *velerov1api.BackupFilteredItems `json:"filteredItems,omitempty"`
+	ItemFailures         *velerov1api.BackupItemFailures  `json:"itemFailures,omitempty"`
+	PodVolumeBackupSize  *velerov1api.PodVolumeBackupSize `json:"podVolumeBackupSize,omitempty"`
+
+	// ResourceList is nil if details weren't requested.
+	ResourceList *BackupResourceListDescription `json:"resourceList,omitempty"`
+
+	VolumeSnapshots BackupVolumeSnapshotsDescription `json:"volumeSnapshots"`
+
+	DeletionAttempts []DeletionAttemptDescription `json:"deletionAttempts,omitempty"`
+
+	// CSIVolumeSnapshots is nil if the CSI feature isn't enabled.
+	CSIVolumeSnapshots *CSIVolumeSnapshotsDescription `json:"csiVolumeSnapshots,omitempty"`
+
+	PodVolumeBackups []PodVolumeBackupDescription `json:"podVolumeBackups,omitempty"`
+}
+
+// ExpirationOverrideDescription is a request to keep a backup past its
+// expiration, set in its annotations.
+type ExpirationOverrideDescription struct {
+	Expiration  *metav1.Time `json:"expiration,omitempty"`
+	RequestedBy string       `json:"requestedBy,omitempty"`
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+	Reason      string       `json:"reason,omitempty"`
+
+	// Error is why the override is ignored, if it is.
+	Error string `json:"error,omitempty"`
+}
+
+// BackupResourceListDescription is the list of items in a backup, fetched from
+// object storage.
+type BackupResourceListDescription struct {
+	// Resources lists the "namespace/name" of the backed-up items of each
+	// group-version-kind.
+	Resources map[string][]string `json:"resources,omitempty"`
+
+	// Error is why the resource list couldn't be fetched, if it couldn't.
+	Error string `json:"error,omitempty"`
+}
+
+// BackupVolumeSnapshotsDescription describes the Velero-native volume snapshots
+// taken for a backup.
+type BackupVolumeSnapshotsDescription struct {
+	Attempted int `json:"attempted"`
+	Completed int `json:"completed"`
+
+	// Snapshots is only fetched from object storage if details were requested.
+	Snapshots []VolumeSnapshotDescription `json:"snapshots,omitempty"`
+
+	// Error is why the snapshots couldn't be fetched, if they couldn't.
+	Error string `json:"error,omitempty"`
+}
+
+// VolumeSnapshotDescription describes a Velero-native snapshot of a persistent volume.
+type VolumeSnapshotDescription struct {
+	PersistentVolumeName string `json:"persistentVolumeName"`
+	SnapshotID           string `json:"snapshotID"`
+	VolumeType           string `json:"volumeType"`
+	AvailabilityZone     string `json:"availabilityZone"`
+	IOPS                 *int64 `json:"iops,omitempty"`
+}
+
+// DeletionAttemptDescription describes a request to delete a backup.
+type DeletionAttemptDescription struct {
+	CreationTimestamp metav1.Time                          `json:"creationTimestamp"`
+	Phase             velerov1api.DeleteBackupRequestPhase `json:"phase"`
+	Errors            []string                             `json:"errors,omitempty"`
+}
+
+// CSIVolumeSnapshotsDescription describes the CSI volume snapshots taken for a backup.
+type CSIVolumeSnapshotsDescription struct {
+	Snapshots []CSIVolumeSnapshotDescription `json:"snapshots"`
+}
+
+// CSIVolumeSnapshotDescription describes a CSI VolumeSnapshotContent created for a backup.
+type CSIVolumeSnapshotDescription struct {
+	SnapshotContentName string `json:"snapshotContentName"`
+
+	// HasStatus is false if the VolumeSnapshotContent has no status yet, in
+	// which case none of the following fields are set.
+	HasStatus         bool    `json:"hasStatus"`
+	StorageSnapshotID *string `json:"storageSnapshotID,omitempty"`
+	SnapshotSizeBytes *int64  `json:"snapshotSizeBytes,omitempty"`
+	ReadyToUse        *bool   `json:"readyToUse,omitempty"`
+}
+
+// PodVolumeBackupDescription describes a restic backup of a pod volume.
+type PodVolumeBackupDescription struct {
+	Namespace string                                 `json:"namespace"`
+	Pod       string                                 `json:"pod"`
+	Volume    string                                 `json:"volume"`
+	Phase     velerov1api.PodVolumeBackupPhase       `json:"phase,omitempty"`
+	Progress  velerov1api.PodVolumeOperationProgress `json:"progress"`
+}
+
+// NewBackupDescription gathers the description of a backup. The backup's resource list
+// and Velero-native volume snapshots are only fetched from object storage if details
+// are requested.
+func NewBackupDescription(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	backup *velerov1api.Backup,
+	deleteRequests []velerov1api.DeleteBackupRequest,
+	podVolumeBackups []velerov1api.PodVolumeBackup,
+	volumeSnapshotContents []snapshotv1beta1api.VolumeSnapshotContent,
+	details bool,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+) *BackupDescription {
+	status := backup.Status
+
+	desc := &BackupDescription{
+		Metadata: metav1.ObjectMeta{
+			Name:              backup.Name,
+			Namespace:         backup.Namespace,
+			UID:               backup.UID,
+			CreationTimestamp: backup.CreationTimestamp,
+			Labels:            backup.Labels,
+			Annotations:       backup.Annotations,
+		},
+		Details:             details,
+		Phase:               status.Phase,
+		ValidationErrors:    status.ValidationErrors,
+		Errors:              status.Errors,
+		Warnings:            status.Warnings,
+		Spec:                backup.Spec,
+		FormatVersion:       status.FormatVersion,
+		StartTimestamp:      status.StartTimestamp,
+		CompletionTimestamp: status.CompletionTimestamp,
+		Expiration:          status.Expiration,
+		Progress:            status.Progress,
+		MirrorStatuses:      status.MirrorStatuses,
+		ArchivedNamespaces:  status.ArchivedNamespaces,
+		BaseBackup:          status.BaseBackup,
+		HookStatus:          status.HookStatus,
+		FilteredItems:       status.FilteredItems,
+		ItemFailures:        status.ItemFailures,
+		PodVolumeBackupSize: status.PodVolumeBackupSize,
+		VolumeSnapshots: BackupVolumeSnapshotsDescription{
+			Attempted: status.VolumeSnapshotsAttempted,
+			Completed: status.VolumeSnapshotsCompleted,
+		},
+	}
+
+	if desc.Phase == "" {
+		desc.Phase = velerov1api.BackupPhaseNew
+	}
+
+	desc.ExpirationOverride = getExpirationOverride(backup)
+	desc.GCHold = pkgbackup.HasGCHold(backup)
+
+	desc.CompressionAlgorithm = status.CompressionAlgorithm
+	// backups processed before the compression algorithm was recorded
+	// were compressed with gzip.
+	if desc.CompressionAlgorithm == "" && status.FormatVersion != "" {
+		desc.CompressionAlgorithm = velerov1api.CompressionAlgorithmGzip
+	}
+
+	if details {
+		desc.ResourceList = getBackupResourceList(ctx, kbClient, backup, insecureSkipTLSVerify, caCertFile)
+
+		if status.VolumeSnapshotsAttempted > 0 {
+			desc.VolumeSnapshots.Snapshots, desc.VolumeSnapshots.Error = getBackupVolumeSnapshots(ctx, kbClient, backup, insecureSkipTLSVerify, caCertFile)
+		}
+	}
+
+	for _, req := range deleteRequests {
+		desc.DeletionAttempts = append(desc.DeletionAttempts, DeletionAttemptDescription{
+			CreationTimestamp: req.CreationTimestamp,
+			Phase:             req.Status.Phase,
+			Errors:            req.Status.Errors,
+		})
+	}
+
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		desc.CSIVolumeSnapshots = &CSIVolumeSnapshotsDescription{
+			Snapshots: []CSIVolumeSnapshotDescription{},
+		}
+		for _, vsc := range volumeSnapshotContents {
+			snapshot := CSIVolumeSnapshotDescription{SnapshotContentName: vsc.Name}
+			if vsc.Status != nil {
+				snapshot.HasStatus = true
+				snapshot.StorageSnapshotID = vsc.Status.SnapshotHandle
+				snapshot.SnapshotSizeBytes = vsc.Status.RestoreSize
+				snapshot.ReadyToUse = vsc.Status.ReadyToUse
+			}
+			desc.CSIVolumeSnapshots.Snapshots = append(desc.CSIVolumeSnapshots.Snapshots, snapshot)
+		}
+	}
+
+	for _, pvb := range podVolumeBackups {
+		desc.PodVolumeBackups = append(desc.PodVolumeBackups, PodVolumeBackupDescription{
+			Namespace: pvb.Spec.Pod.Namespace,
+			Pod:       pvb.Spec.Pod.Name,
+			Volume:    pvb.Spec.Volume,
+			Phase:     pvb.Status.Phase,
+			Progress:  pvb.Status.Progress,
+		})
+	}
+
+	return desc
+}
+
+func getBackupResourceList(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) *BackupResourceListDescription {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			// the backup resource list could be missing if (other reasons may exist as well):
+			//	- the backup was taken prior to v1.1; or
+			//	- the backup hasn't completed yet; or
+			//	- there was an error uploading the file; or
+			//	- the file was manually deleted after upload
+			return &BackupResourceListDescription{Error: "backup resource list not found"}
+		}
+		return &BackupResourceListDescription{Error: fmt.Sprintf("error getting backup resource list: %v", err)}
+	}
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		return &BackupResourceListDescription{Error: fmt.Sprintf("error reading backup resource list: %v", err)}
+	}
+
+	return &BackupResourceListDescription{Resources: resourceList}
+}
+
+func getBackupVolumeSnapshots(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) ([]VolumeSnapshotDescription, string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeSnapshots, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		return nil, fmt.Sprintf("error getting snapshot info: %v", err)
+	}
+
+	var snapshots []*volume.Snapshot
+	if err := json.NewDecoder(buf).Decode(&snapshots); err != nil {
+		return nil, fmt.Sprintf("error reading snapshot info: %v", err)
+	}
+
+	descs := make([]VolumeSnapshotDescription, 0, len(snapshots))
+	for _, snap := range snapshots {
+		descs = append(descs, VolumeSnapshotDescription{
+			PersistentVolumeName: snap.Spec.PersistentVolumeName,
+			SnapshotID:           snap.Status.ProviderSnapshotID,
+			VolumeType:           snap.Spec.VolumeType,
+			AvailabilityZone:     snap.Spec.VolumeAZ,
+			IOPS:                 snap.Spec.VolumeIOPS,
+		})
+	}
+
+	return descs, ""
+}
+
+func getExpirationOverride(backup *velerov1api.Backup) *ExpirationOverrideDescription {
+	override, err := pkgbackup.GetExpirationOverride(backup)
+	if err != nil {
+		return &ExpirationOverrideDescription{Error: err.Error()}
+	}
+	if override == nil {
+		return nil
+	}
+
+	desc := &ExpirationOverrideDescription{
+		Expiration:  &metav1.Time{Time: override.Expiration},
+		RequestedBy: override.RequestedBy,
+		Reason:      override.Reason,
+	}
+	if !override.RequestedAt.IsZero() {
+		desc.RequestedAt = &metav1.Time{Time: override.RequestedAt}
+	}
+	if backup.Status.Expiration != nil && !override.Expiration.After(backup.Status.Expiration.Time) {
+		desc.Error = "the override isn't later than the backup's expiration"
+	}
+	return desc
+}
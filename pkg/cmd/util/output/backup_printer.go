@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/duration"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 )
 
 var (
@@ -85,8 +86,10 @@ func printBackup(backup *velerov1api.Backup) []metav1.TableRow {
 	}
 
 	var expiration time.Time
-	if backup.Status.Expiration != nil {
-		expiration = backup.Status.Expiration.Time
+	// an invalid expiration override is ignored, as the garbage collector
+	// ignores it.
+	if backupExpiration, _ := pkgbackup.GetExpiration(backup); backupExpiration != nil {
+		expiration = backupExpiration.Time
 	}
 	if expiration.IsZero() && backup.Spec.TTL.Duration > 0 {
 		expiration = backup.CreationTimestamp.Add(backup.Spec.TTL.Duration)
@@ -100,13 +103,18 @@ func printBackup(backup *velerov1api.Backup) []metav1.TableRow {
 		status = "Deleting"
 	}
 
+	expires := humanReadableTimeFromNow(expiration)
+	if pkgbackup.HasGCHold(backup) {
+		expires += " (held)"
+	}
+
 	row.Cells = append(row.Cells,
 		backup.Name,
 		status,
 		backup.Status.Errors,
 		backup.Status.Warnings,
 		backup.Status.StartTimestamp,
-		humanReadableTimeFromNow(expiration),
+		expires,
 		backup.Spec.StorageLocation,
 		metav1.FormatLabelSelector(backup.Spec.LabelSelector),
 	)
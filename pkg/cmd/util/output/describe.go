@@ -18,13 +18,16 @@ package output
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/fatih/color"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 type Describer struct {
@@ -119,3 +122,18 @@ func BoolPointerString(b *bool, falseString, trueString, nilString string) strin
 	}
 	return falseString
 }
+
+// EncodeDescription encodes a description, such as a BackupDescription, as JSON
+// or YAML.
+func EncodeDescription(desc interface{}, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		encoded, err := json.MarshalIndent(desc, "", "  ")
+		return encoded, errors.WithStack(err)
+	case "yaml":
+		encoded, err := yaml.Marshal(desc)
+		return encoded, errors.WithStack(err)
+	}
+
+	return nil, errors.Errorf("unsupported output format %q; valid values are 'json' and 'yaml'", format)
+}
@@ -35,7 +35,7 @@ import (
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 )
 
-func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
+func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, podVolumeRestores []velerov1api.PodVolumeRestore, details, showChanges bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool, caCertFile string) string {
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(restore.ObjectMeta)
 
@@ -50,10 +50,12 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
 			phaseString = color.RedString(phaseString)
+		case velerov1api.RestorePhaseCancelled:
+			phaseString = color.YellowString(phaseString)
 		}
 
 		resultsNote := ""
-		if phase == velerov1api.RestorePhaseFailed || phase == velerov1api.RestorePhasePartiallyFailed {
+		if phase == velerov1api.RestorePhaseFailed || phase == velerov1api.RestorePhasePartiallyFailed || phase == velerov1api.RestorePhaseCancelled {
 			resultsNote = fmt.Sprintf(" (run 'velero restore logs %s' for more information)", restore.Name)
 		}
 
@@ -62,6 +64,9 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			if restore.Status.Phase == velerov1api.RestorePhaseInProgress {
 				d.Printf("Estimated total items to be restored:\t%d\n", restore.Status.Progress.TotalItems)
 				d.Printf("Items restored so far:\t%d\n", restore.Status.Progress.ItemsRestored)
+				if restore.Status.Progress.TotalItems > 0 {
+					d.Printf("Percent complete:\t%d%%\n", 100*restore.Status.Progress.ItemsRestored/restore.Status.Progress.TotalItems)
+				}
 			} else {
 				d.Printf("Total items to be restored:\t%d\n", restore.Status.Progress.TotalItems)
 				d.Printf("Items restored:\t%d\n", restore.Status.Progress.ItemsRestored)
@@ -90,10 +95,27 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			}
 		}
 
-		describeRestoreResults(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+		describeRestoreResults(ctx, kbClient, d, restore, showChanges, insecureSkipTLSVerify, caCertFile)
+
+		if readiness := restore.Status.WorkloadReadiness; readiness != nil {
+			d.Println()
+			d.Printf("Workload readiness:\n")
+			d.Printf("\tReady:\t%d\n", readiness.Ready)
+			if len(readiness.NotReady) == 0 {
+				d.Printf("\tNot ready:\t<none>\n")
+			} else {
+				d.Printf("\tNot ready:\n")
+				for _, workload := range readiness.NotReady {
+					d.Printf("\t\t%s %s/%s:\t%s\n", workload.Resource, workload.Namespace, workload.Name, workload.Reason)
+				}
+			}
+		}
 
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
+		if restore.Status.ResumedFrom != "" {
+			d.Printf("Resumed from:\t%s\n", restore.Status.ResumedFrom)
+		}
 
 		d.Println()
 		d.Printf("Namespaces:\n")
@@ -130,9 +152,41 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 
 		d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(restore.Spec.IncludeClusterResources, "excluded", "included", "auto"))
 
+		if len(restore.Spec.NamespaceResourceFilters) > 0 {
+			d.Println()
+			d.Printf("Namespace resource filters:\n")
+			patterns := make([]string, 0, len(restore.Spec.NamespaceResourceFilters))
+			for pattern := range restore.Spec.NamespaceResourceFilters {
+				patterns = append(patterns, pattern)
+			}
+			sort.Strings(patterns)
+			for _, pattern := range patterns {
+				filter := restore.Spec.NamespaceResourceFilters[pattern]
+				included, excluded := "*", "<none>"
+				if len(filter.IncludedResources) > 0 {
+					included = strings.Join(filter.IncludedResources, ", ")
+				}
+				if len(filter.ExcludedResources) > 0 {
+					excluded = strings.Join(filter.ExcludedResources, ", ")
+				}
+				d.Printf("\t%s:\tincluded: %s, excluded: %s\n", pattern, included, excluded)
+			}
+		}
+
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
 
+		if mappingTemplate := restore.Spec.NamespaceMappingTemplate; mappingTemplate != nil {
+			d.Println()
+			d.Printf("Namespace mapping template:\n")
+			s = "<none>"
+			if mappingTemplate.Selector != nil {
+				s = metav1.FormatLabelSelector(mappingTemplate.Selector)
+			}
+			d.Printf("\tSelector:\t%s\n", s)
+			d.Printf("\tTemplate:\t%s\n", mappingTemplate.Template)
+		}
+
 		d.Println()
 		s = "<none>"
 		if restore.Spec.LabelSelector != nil {
@@ -140,9 +194,37 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		}
 		d.Printf("Label selector:\t%s\n", s)
 
+		d.Println()
+		s = "<none>"
+		if restore.Spec.ExcludedAnnotation != nil {
+			s = restore.Spec.ExcludedAnnotation.String()
+		}
+		d.Printf("Excluded annotation:\t%s\n", s)
+
 		d.Println()
 		d.Printf("Restore PVs:\t%s\n", BoolPointerString(restore.Spec.RestorePVs, "false", "true", "auto"))
 
+		if len(restore.Spec.IncludedVolumes) > 0 || len(restore.Spec.ExcludedVolumes) > 0 || restore.Spec.VolumeSelector != nil {
+			d.Println()
+			d.Printf("Volumes:\n")
+			s = "*"
+			if len(restore.Spec.IncludedVolumes) > 0 {
+				s = strings.Join(restore.Spec.IncludedVolumes, ", ")
+			}
+			d.Printf("\tIncluded:\t%s\n", s)
+			s = "<none>"
+			if len(restore.Spec.ExcludedVolumes) > 0 {
+				s = strings.Join(restore.Spec.ExcludedVolumes, ", ")
+			}
+			d.Printf("\tExcluded:\t%s\n", s)
+			s = "<none>"
+			if restore.Spec.VolumeSelector != nil {
+				s = metav1.FormatLabelSelector(restore.Spec.VolumeSelector)
+			}
+			d.Printf("\tSelector:\t%s\n", s)
+			d.Printf("\tUnselected claims:\t%s\n", BoolPointerString(restore.Spec.SkipUnselectedVolumes, "restored empty", "skipped", "restored empty"))
+		}
+
 		if len(podVolumeRestores) > 0 {
 			d.Println()
 			describePodVolumeRestores(d, podVolumeRestores, details)
@@ -151,11 +233,90 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		d.Println()
+		d.Printf("Scale down workloads:\t%s\n", BoolPointerString(restore.Spec.ScaleDownWorkloads, "false", "true", "false"))
+
+		d.Println()
+		d.Printf("Dry run:\t%s\n", BoolPointerString(restore.Spec.DryRun, "false", "true", "false"))
+
+		if restore.Spec.ResourceTimeout != nil {
+			d.Printf("Resource timeout:\t%s\n", restore.Spec.ResourceTimeout.Duration)
+		}
+
+		if ref := restore.Spec.ResourceModifier; ref != nil {
+			d.Printf("Resource modifiers:\t%s/%s\n", ref.Kind, ref.Name)
+		}
+
+		if policy := restore.Spec.NamespaceConflictPolicy; policy != "" {
+			d.Printf("Namespace conflict policy:\t%s\n", policy)
+		}
+
+		if policy := restore.Spec.ExistingResourcePolicy; policy != "" {
+			d.Printf("Existing resource policy:\t%s\n", policy)
+		}
+
+		if len(restore.Spec.GenerateNameOnConflict) > 0 {
+			d.Printf("Generate name on conflict:\t%s\n", strings.Join(restore.Spec.GenerateNameOnConflict, ", "))
+		}
+
+		if len(restore.Spec.PreferredAPIVersions) > 0 {
+			d.Println()
+			d.Printf("Preferred API versions:\n")
+			resources := make([]string, 0, len(restore.Spec.PreferredAPIVersions))
+			for resource := range restore.Spec.PreferredAPIVersions {
+				resources = append(resources, resource)
+			}
+			sort.Strings(resources)
+			for _, resource := range resources {
+				d.Printf("\t%s:\t%s\n", resource, strings.Join(restore.Spec.PreferredAPIVersions[resource], ", "))
+			}
+		}
+
+		if wait := restore.Spec.WaitForWorkloads; wait != nil {
+			d.Println()
+			d.Printf("Wait for workloads:\n")
+			d.Printf("\tDeployments:\t%s\n", workloadTimeoutString(wait.Deployments))
+			d.Printf("\tStatefulSets:\t%s\n", workloadTimeoutString(wait.StatefulSets))
+			d.Printf("\tDaemonSets:\t%s\n", workloadTimeoutString(wait.DaemonSets))
+		}
+
+		if priorities := restore.Spec.ResourcePriorities; priorities != nil {
+			d.Println()
+			mode := priorities.Mode
+			if mode == "" {
+				mode = velerov1api.ResourcePrioritiesModeAppend
+			}
+			d.Printf("Resource priorities:\n")
+			d.Printf("\tMode:\t%s\n", mode)
+			s := "<none>"
+			if len(priorities.HighPriorities) > 0 {
+				s = strings.Join(priorities.HighPriorities, ", ")
+			}
+			d.Printf("\tHigh priority:\t%s\n", s)
+			s = "<none>"
+			if len(priorities.LowPriorities) > 0 {
+				s = strings.Join(priorities.LowPriorities, ", ")
+			}
+			d.Printf("\tLow priority:\t%s\n", s)
+			for _, order := range priorities.GroupOrders {
+				d.Printf("\tGroup order:\t%s\n", strings.Join(order.Resources, ", "))
+			}
+		}
+
 	})
 }
 
-func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
-	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
+// workloadTimeoutString returns a workload readiness timeout, or "<not
+// waited for>" if it's unset.
+func workloadTimeoutString(timeout *metav1.Duration) string {
+	if timeout == nil {
+		return "<not waited for>"
+	}
+	return timeout.Duration.String()
+}
+
+func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, showChanges, insecureSkipTLSVerify bool, caCertPath string) {
+	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 && restore.Status.RenamedItems == 0 && restore.Status.UnchangedItems == 0 && !showChanges {
 		return
 	}
 
@@ -167,7 +328,7 @@ func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *De
 		return
 	}
 
-	if err := json.NewDecoder(&buf).Decode(&resultMap); err != nil {
+	if err := json.Unmarshal(buf.Bytes(), &resultMap); err != nil {
 		d.Printf("Warnings:\t<error decoding warnings: %v>\n\nErrors:\t<error decoding errors: %v>\n", err, err)
 		return
 	}
@@ -180,6 +341,70 @@ func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *De
 		d.Println()
 		describeRestoreResult(d, "Errors", resultMap["errors"])
 	}
+	if timedOut, ok := resultMap["timedOut"]; ok {
+		d.Println()
+		describeRestoreResult(d, "Timed out items", timedOut)
+	}
+	if renamed, ok := resultMap["renamed"]; ok {
+		d.Println()
+		describeRestoreResult(d, "Renamed items", renamed)
+	}
+	if unchanged, ok := resultMap["unchanged"]; ok {
+		d.Println()
+		describeRestoreResult(d, "Unchanged items", unchanged)
+	}
+	if showChanges {
+		d.Println()
+		describeRestoreChanges(d, buf.Bytes())
+	}
+}
+
+// describeRestoreChanges describes what a restore did with each item that it
+// created, or that already existed in the cluster, if it recorded them in
+// its results.
+func describeRestoreChanges(d *Describer, results []byte) {
+	var res struct {
+		Changes *pkgrestore.ChangeSummary `json:"changes"`
+	}
+	if err := json.Unmarshal(results, &res); err != nil {
+		d.Printf("Changes:\t<error decoding changes: %v>\n", err)
+		return
+	}
+	changes := res.Changes
+	if changes == nil {
+		d.Printf("Changes:\t<not recorded, create the restore with --record-changes to record them>\n")
+		return
+	}
+
+	d.Printf("Changes:\n")
+	d.Printf("\tCreated:\t%d\n", changes.Created)
+	d.Printf("\tUpdated:\t%d\n", changes.Updated)
+	d.Printf("\tSkipped:\t%d\n", changes.Skipped)
+	if len(changes.Items) == 0 {
+		return
+	}
+
+	d.Printf("\tItems:\n")
+	for _, item := range changes.Items {
+		name := item.Name
+		if item.Namespace != "" {
+			name = item.Namespace + "/" + item.Name
+		}
+		d.Printf("\t\t%s %s:\t%s\n", item.Resource, name, item.Action)
+		if item.Reason != "" {
+			d.Printf("\t\t\tReason:\t%s\n", item.Reason)
+		}
+		if item.Diff != "" {
+			truncated := ""
+			if item.DiffTruncated {
+				truncated = " <truncated>"
+			}
+			d.Printf("\t\t\tDiff:\t%s%s\n", item.Diff, truncated)
+		}
+	}
+	if changes.ItemsTruncated {
+		d.Printf("\t\t<only the first %d items were recorded>\n", len(changes.Items))
+	}
 }
 
 func describeRestoreResult(d *Describer, name string, result pkgrestore.Result) {
@@ -40,6 +40,8 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 			phaseString = color.GreenString(phaseString)
 		case v1.SchedulePhaseFailedValidation:
 			phaseString = color.RedString(phaseString)
+		case v1.SchedulePhasePaused:
+			phaseString = color.YellowString(phaseString)
 		}
 		d.Printf("Phase:\t%s\n", phaseString)
 
@@ -62,6 +64,16 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	d.Printf("Paused:\t%t\n", spec.Paused)
+	if spec.BackupNameTemplate != "" {
+		d.Printf("Backup name template:\t%s\n", spec.BackupNameTemplate)
+	}
+	if len(spec.BackupLabelTemplates) > 0 {
+		d.DescribeMap("Backup label templates", spec.BackupLabelTemplates)
+	}
+	if len(spec.BackupAnnotationTemplates) > 0 {
+		d.DescribeMap("Backup annotation templates", spec.BackupAnnotationTemplates)
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -76,4 +88,8 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+
+	if status.LastSkipped != nil && !status.LastSkipped.Time.IsZero() {
+		d.Printf("Last Skipped:\t%v\n", status.LastSkipped.Time)
+	}
 }
@@ -29,6 +29,9 @@ var (
 		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Provider"},
+		{Name: "Phase"},
+		{Name: "Last Validated"},
+		{Name: "Message"},
 	}
 )
 
@@ -46,9 +49,22 @@ func printVolumeSnapshotLocation(location *v1.VolumeSnapshotLocation) []metav1.T
 		Object: runtime.RawExtension{Object: location},
 	}
 
+	status := location.Status.Phase
+	if status == "" {
+		status = "Unknown"
+	}
+
+	lastValidated := "Unknown"
+	if location.Status.LastValidationTime != nil {
+		lastValidated = location.Status.LastValidationTime.String()
+	}
+
 	row.Cells = append(row.Cells,
 		location.Name,
 		location.Spec.Provider,
+		status,
+		lastValidated,
+		location.Status.Message,
 	)
 
 	return []metav1.TableRow{row}
@@ -20,11 +20,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -36,13 +41,18 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 	snapshotv1beta1listers "github.com/kubernetes-csi/external-snapshotter/client/v4/listers/volumesnapshot/v1beta1"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
@@ -75,8 +85,10 @@ type backupController struct {
 	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
 	backupTracker               BackupTracker
 	defaultBackupLocation       string
+	clusterName                 string
 	defaultVolumesToRestic      bool
 	defaultBackupTTL            time.Duration
+	defaultBackupCompression    velerov1api.CompressionAlgorithm
 	snapshotLocationLister      velerov1listers.VolumeSnapshotLocationLister
 	defaultSnapshotLocations    map[string]string
 	metrics                     *metrics.ServerMetrics
@@ -84,6 +96,9 @@ type backupController struct {
 	formatFlag                  logging.Format
 	volumeSnapshotLister        snapshotv1beta1listers.VolumeSnapshotLister
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister
+	verifyBackupUpload          bool
+	backupLogFlushInterval      time.Duration
+	events                      *lifecycleEventRecorder
 }
 
 func NewBackupController(
@@ -97,8 +112,10 @@ func NewBackupController(
 	backupTracker BackupTracker,
 	kbClient kbclient.Client,
 	defaultBackupLocation string,
+	clusterName string,
 	defaultVolumesToRestic bool,
 	defaultBackupTTL time.Duration,
+	defaultBackupCompression velerov1api.CompressionAlgorithm,
 	volumeSnapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	defaultSnapshotLocations map[string]string,
 	metrics *metrics.ServerMetrics,
@@ -106,6 +123,9 @@ func NewBackupController(
 	volumeSnapshotLister snapshotv1beta1listers.VolumeSnapshotLister,
 	volumeSnapshotContentLister snapshotv1beta1listers.VolumeSnapshotContentLister,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	verifyBackupUpload bool,
+	backupLogFlushInterval time.Duration,
+	eventRecorder record.EventRecorder,
 ) Interface {
 	c := &backupController{
 		genericController:           newGenericController(Backup, logger),
@@ -119,8 +139,10 @@ func NewBackupController(
 		backupTracker:               backupTracker,
 		kbClient:                    kbClient,
 		defaultBackupLocation:       defaultBackupLocation,
+		clusterName:                 clusterName,
 		defaultVolumesToRestic:      defaultVolumesToRestic,
 		defaultBackupTTL:            defaultBackupTTL,
+		defaultBackupCompression:    defaultBackupCompression,
 		snapshotLocationLister:      volumeSnapshotLocationLister,
 		defaultSnapshotLocations:    defaultSnapshotLocations,
 		metrics:                     metrics,
@@ -128,6 +150,9 @@ func NewBackupController(
 		volumeSnapshotLister:        volumeSnapshotLister,
 		volumeSnapshotContentLister: volumeSnapshotContentLister,
 		backupStoreGetter:           backupStoreGetter,
+		verifyBackupUpload:          verifyBackupUpload,
+		backupLogFlushInterval:      backupLogFlushInterval,
+		events:                      newLifecycleEventRecorder(eventRecorder),
 	}
 
 	c.syncHandler = c.processBackup
@@ -242,6 +267,13 @@ func (c *backupController) processBackup(key string) error {
 	log.Debug("Preparing backup request")
 	request := c.prepareBackupRequest(original)
 
+	// backup validator plugins only see backups that passed Velero's own
+	// validation, so that they can rely on the spec being valid.
+	if len(request.Status.ValidationErrors) == 0 {
+		log.Debug("Running backup validators")
+		request.Status.ValidationErrors = c.runBackupValidators(request.Backup, log)
+	}
+
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
@@ -259,9 +291,12 @@ func (c *backupController) processBackup(key string) error {
 	request.Backup = updatedBackup.DeepCopy()
 
 	if request.Status.Phase == velerov1api.BackupPhaseFailedValidation {
+		c.events.record(request.Backup, LifecycleReasonFailedValidation, "Backup failed validation: %s", strings.Join(request.Status.ValidationErrors, "; "))
 		return nil
 	}
 
+	c.events.record(request.Backup, LifecycleReasonStarted, "Backup started, storing it in backup storage location %s", request.Spec.StorageLocation)
+
 	c.backupTracker.Add(request.Namespace, request.Name)
 	defer c.backupTracker.Delete(request.Namespace, request.Name)
 
@@ -271,7 +306,8 @@ func (c *backupController) processBackup(key string) error {
 	c.metrics.RegisterBackupAttempt(backupScheduleName)
 
 	// execution & upload of backup
-	if err := c.runBackup(request); err != nil {
+	err = c.runBackup(request)
+	if err != nil {
 		// even though runBackup sets the backup's phase prior
 		// to uploading artifacts to object storage, we have to
 		// check for an error again here and update the phase if
@@ -292,6 +328,7 @@ func (c *backupController) processBackup(key string) error {
 	case velerov1api.BackupPhaseFailedValidation:
 		c.metrics.RegisterBackupValidationFailure(backupScheduleName)
 	}
+	c.recordBackupCompletion(request, err)
 
 	log.Debug("Updating backup's final status")
 	if _, err := patchBackup(original, request.Backup, c.client); err != nil {
@@ -301,6 +338,27 @@ func (c *backupController) processBackup(key string) error {
 	return nil
 }
 
+// recordBackupCompletion records the event for a backup's final phase. err is
+// the error that failed the backup, if any.
+func (c *backupController) recordBackupCompletion(backup *pkgbackup.Request, err error) {
+	duration := lifecycleDuration(backup.Status.StartTimestamp, c.clock.Now())
+
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted:
+		c.events.record(backup.Backup, LifecycleReasonCompleted, "Backup completed in %v: %d items backed up, %d of %d volume snapshots completed, %d warnings",
+			duration, len(backup.BackedUpItems), backup.Status.VolumeSnapshotsCompleted, backup.Status.VolumeSnapshotsAttempted, backup.Status.Warnings)
+	case velerov1api.BackupPhasePartiallyFailed:
+		c.events.record(backup.Backup, LifecycleReasonPartiallyFailed, "Backup partially failed in %v: %d items backed up, %d errors, %d warnings",
+			duration, len(backup.BackedUpItems), backup.Status.Errors, backup.Status.Warnings)
+	case velerov1api.BackupPhaseFailed:
+		c.events.record(backup.Backup, LifecycleReasonFailed, "Backup failed after %v: %v", duration, err)
+	case velerov1api.BackupPhaseFailedValidation:
+		c.events.record(backup.Backup, LifecycleReasonFailedValidation, "Backup failed validation: %s", strings.Join(backup.Status.ValidationErrors, "; "))
+	case velerov1api.BackupPhaseCancelled:
+		c.events.record(backup.Backup, LifecycleReasonCancelled, "Backup cancelled after %v: %d items backed up", duration, len(backup.BackedUpItems))
+	}
+}
+
 func patchBackup(original, updated *velerov1api.Backup, client velerov1client.BackupsGetter) (*velerov1api.Backup, error) {
 	origBytes, err := json.Marshal(original)
 	if err != nil {
@@ -348,6 +406,16 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Spec.DefaultVolumesToRestic = &c.defaultVolumesToRestic
 	}
 
+	if request.Spec.Compression == "" {
+		request.Spec.Compression = c.defaultBackupCompression
+	}
+	if archive.IsValidCompressionAlgorithm(request.Spec.Compression) {
+		// record the algorithm so the tarball can be decompressed when restoring
+		request.Status.CompressionAlgorithm = request.Spec.Compression
+	} else {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("invalid compression algorithm %q, must be one of %v", request.Spec.Compression, archive.CompressionAlgorithms))
+	}
+
 	// find which storage location to use
 	var serverSpecified bool
 	if request.Spec.StorageLocation == "" {
@@ -392,12 +460,25 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		}
 	}
 
+	// get the mirror storage locations, and store their BackupStorageLocation API objs on the request
+	if locations, errs := c.validateAndGetMirrorLocations(request.Backup); len(errs) > 0 {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, errs...)
+	} else {
+		request.MirrorLocations = locations
+	}
+
 	// add the storage location as a label for easy filtering later.
 	if request.Labels == nil {
 		request.Labels = make(map[string]string)
 	}
 	request.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(request.Spec.StorageLocation)
 
+	// tag the backup with the cluster that created it, so its files are stored
+	// under the cluster's own prefix in the backup storage location.
+	if c.clusterName != "" {
+		request.Labels[velerov1api.ClusterNameLabel] = c.clusterName
+	}
+
 	// validate and get the backup's VolumeSnapshotLocations, and store the
 	// VolumeSnapshotLocation API objs on the request
 	if locs, errs := c.validateAndGetSnapshotLocations(request.Backup); len(errs) > 0 {
@@ -428,9 +509,112 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the item filter
+	if filter := request.Spec.ItemFilter; filter != nil {
+		errs := collections.ValidateIncludesExcludes(filter.IncludedItems, filter.ExcludedItems)
+		errs = append(errs, collections.ValidateItemKeyPatterns(filter.IncludedItems)...)
+		errs = append(errs, collections.ValidateItemKeyPatterns(filter.ExcludedItems)...)
+		for _, err := range errs {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid item filter: %v", err))
+		}
+	}
+
+	// validate the item failure thresholds
+	for _, err := range pkgbackup.ValidateItemFailureThresholds(request.Spec.ItemFailureThresholds) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid item failure thresholds: %v", err))
+	}
+
+	// validate the excluded owner kinds
+	for _, kind := range request.Spec.ExcludedOwnerKinds {
+		if err := pkgbackup.ValidateExcludedOwnerKind(kind); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid excluded owner kind: %v", err))
+		}
+	}
+
+	// validate the resource label selectors. Their resources are resolved
+	// against the target cluster once the backup runs.
+	resources := make([]string, 0, len(request.Spec.ResourceLabelSelectors))
+	for resource := range request.Spec.ResourceLabelSelectors {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		if _, err := labels.Parse(request.Spec.ResourceLabelSelectors[resource]); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid label selector for resource %s: %v", resource, err))
+		}
+	}
+
+	// validate the excluded fields
+	resources = make([]string, 0, len(request.Spec.ExcludedFields))
+	for resource := range request.Spec.ExcludedFields {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		for _, field := range request.Spec.ExcludedFields[resource] {
+			if err := pkgbackup.ValidateExcludedField(field); err != nil {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid excluded field %q for resource %s: %v", field, resource, err))
+			}
+		}
+	}
+
+	// validate the resource API versions
+	resources = make([]string, 0, len(request.Spec.ResourceAPIVersions))
+	for resource := range request.Spec.ResourceAPIVersions {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		if err := pkgbackup.ValidateResourceAPIVersions(request.Spec.ResourceAPIVersions[resource]); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid API versions for resource %s: %v", resource, err))
+		}
+	}
+
+	// validate the backup-level hooks
+	for _, err := range hook.ValidateBackupLevelHooks(request.Spec.Hooks.Backup) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid backup-level hook: %v", err))
+	}
+
+	// warn about included/excluded patterns that shadow each other
+	log := c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+	for _, warning := range collections.ValidateIncludesExcludesOverlap(request.Spec.IncludedResources, request.Spec.ExcludedResources) {
+		log.Warnf("Overlapping included/excluded resource lists: %v", warning)
+	}
+	for _, warning := range collections.ValidateIncludesExcludesOverlap(request.Spec.IncludedNamespaces, request.Spec.ExcludedNamespaces) {
+		log.Warnf("Overlapping included/excluded namespace lists: %v", warning)
+	}
+
 	return request
 }
 
+// runBackupValidators invokes every backup validator plugin for a backup, and
+// returns the reasons each one that rejected the backup gave. A validator that
+// fails to run rejects the backup, so that policies can't be bypassed by
+// breaking the plugin that enforces them.
+func (c *backupController) runBackupValidators(backup *velerov1api.Backup, log logrus.FieldLogger) []string {
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	validators, err := pluginManager.GetBackupValidators()
+	if err != nil {
+		return []string{fmt.Sprintf("error getting backup validators: %v", err)}
+	}
+
+	var errs []string
+	for _, validator := range validators {
+		result, err := validator.Validate(backup)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error running backup validator: %v", err))
+			continue
+		}
+		if !result.Accepted {
+			errs = append(errs, fmt.Sprintf("backup rejected by backup validator: %s", result.Message))
+		}
+	}
+
+	return errs
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 // - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -460,6 +644,13 @@ func (c *backupController) validateAndGetSnapshotLocations(backup *velerov1api.B
 			continue
 		}
 
+		// fail fast rather than when the first snapshot is taken in a location
+		// that's known not to be usable
+		if location.Status.Phase == velerov1api.VolumeSnapshotLocationPhaseUnavailable {
+			errors = append(errors, fmt.Sprintf("volume snapshot location %s is unavailable: %s", locationName, location.Status.Message))
+			continue
+		}
+
 		// ensure we end up with exactly 1 location *per provider*
 		if providerLocation, ok := providerLocations[location.Spec.Provider]; ok {
 			// if > 1 location name per provider as in ["aws-us-east-1" | "aws-us-west-1"] (same provider, multiple names)
@@ -542,11 +733,12 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	// close multiple times. If we get an error closing this, there's not really anything we can do about it.
 	defer gzippedLogFile.Close()
 	defer closeAndRemoveFile(logFile, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)))
+	logWriter := &backupLogWriter{gzip: gzippedLogFile, file: logFile}
 
 	// Log the backup to both a backup log file and to stdout. This will help see what happened if the upload of the
 	// backup log failed for whatever reason.
 	logger := logging.DefaultLogger(c.backupLogLevel, c.formatFlag)
-	logger.Out = io.MultiWriter(os.Stdout, gzippedLogFile)
+	logger.Out = io.MultiWriter(os.Stdout, logWriter)
 
 	logCounter := logging.NewLogCounterHook()
 	logger.Hooks.Add(logCounter)
@@ -564,17 +756,37 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	pluginManager := c.newPluginManager(backupLog)
 	defer pluginManager.CleanupClients()
 
+	// the backup's spec didn't name these locations, so the backup isn't failed
+	// just because one of them is unavailable.
+	for _, location := range backup.SnapshotLocations {
+		if location.Status.Phase == velerov1api.VolumeSnapshotLocationPhaseUnavailable {
+			backupLog.Warnf("Volume snapshot location %s is unavailable, snapshots taken in it may fail: %s", location.Name, location.Status.Message)
+		}
+	}
+
 	backupLog.Info("Getting backup item actions")
 	actions, err := pluginManager.GetBackupItemActions()
 	if err != nil {
 		return err
 	}
 
+	backupLog.Info("Getting item block actions")
+	itemBlockActions, err := pluginManager.GetItemBlockActions()
+	if err != nil {
+		return err
+	}
+
+	backupLog.Info("Getting namespace mappers")
+	if backup.NamespaceMappers, err = pluginManager.GetNamespaceMappers(); err != nil {
+		return err
+	}
+
 	backupLog.Info("Setting up backup store to check for backup existence")
 	backupStore, err := c.backupStoreGetter.Get(backup.StorageLocation, pluginManager, backupLog)
 	if err != nil {
 		return err
 	}
+	backupStore = persistence.BackupStoreForBackup(backupStore, backup.Backup)
 
 	exists, err := backupStore.BackupExists(backup.StorageLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name)
 	if exists || err != nil {
@@ -586,8 +798,35 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	if boolptr.IsSetToTrue(backup.Spec.Incremental) {
+		c.setBaseBackup(backup, backupStore, backupLog)
+	}
+
+	backup.BackupHookActions = pluginManager
+
+	backup.Canceled = func() bool {
+		current, err := c.lister.Backups(backup.Namespace).Get(backup.Name)
+		if err != nil {
+			return false
+		}
+		_, canceled := current.Annotations[velerov1api.CancelRequestedAnnotation]
+		return canceled
+	}
+
+	backup.SnapshottingStarted = func() {
+		c.events.record(backup.Backup, LifecycleReasonSnapshotting, "Taking volume snapshots, %d items backed up so far", len(backup.BackedUpItems))
+	}
+
+	stopFlushingLog := c.flushBackupLogPeriodically(backup.Backup, logWriter, backupStore)
+
 	var fatalErrs []error
-	if err := c.backupper.Backup(backupLog, backup, backupFile, actions, pluginManager); err != nil {
+	var canceled bool
+	if err := c.backupper.Backup(backupLog, backup, backupFile, actions, itemBlockActions, pluginManager); errors.Cause(err) == pkgbackup.ErrCanceled {
+		// a canceled backup is still uploaded, so that its log can be read
+		// and its volume snapshots are deleted along with it.
+		backupLog.Warn("Backup was canceled, uploading the items backed up so far")
+		canceled = true
+	} else if err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
 
@@ -626,15 +865,30 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 			backup.Status.VolumeSnapshotsCompleted++
 		}
 	}
+	backup.Status.PodVolumeBackupSize = podVolumeBackupSize(backup.PodVolumeBackups)
 
 	recordBackupMetrics(backupLog, backup.Backup, backupFile, c.metrics)
 
-	if err := gzippedLogFile.Close(); err != nil {
+	// Mark the backup as encrypted if its location encrypts the contents
+	// tarball, so that its contents must be decrypted when they're read.
+	backup.Status.Encrypted = backup.StorageLocation.Spec.EncryptionKey != nil
+
+	// Record the contents checksum before serializing and uploading, so
+	// that the metadata in object storage has it.
+	if checksum, err := contentsChecksum(backupFile); err != nil {
+		backupLog.WithError(err).Error("Error computing checksum of backup contents")
+	} else {
+		backup.Status.ContentsChecksum = checksum
+	}
+
+	stopFlushingLog()
+	if err := logWriter.Close(); err != nil {
 		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("error closing gzippedLogFile")
 	}
 
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
+	backup.Status.ItemFailures = backup.ItemFailures()
 
 	// Assign finalize phase as close to end as possible so that any errors
 	// logged to backupLog are captured. This is done before uploading the
@@ -643,7 +897,14 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	switch {
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
-	case logCounter.GetCount(logrus.ErrorLevel) > 0:
+	case canceled:
+		backup.Status.Phase = velerov1api.BackupPhaseCancelled
+	case backup.Status.ItemFailures != nil && backup.Status.ItemFailures.CriticalFailedItems > 0:
+		// the backup's log has been closed, so this is only logged by the
+		// server.
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Errorf("Backup failed because %d items of its critical resources failed to be backed up", backup.Status.ItemFailures.CriticalFailedItems)
+		backup.Status.Phase = velerov1api.BackupPhaseFailed
+	case backup.Status.Errors > 0 && !backup.ItemFailuresWithinThresholds(backup.Status.Errors):
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 	default:
 		backup.Status.Phase = velerov1api.BackupPhaseCompleted
@@ -656,11 +917,37 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	if err != nil {
 		return err
 	}
+	backupStore = persistence.BackupStoreForBackup(backupStore, backup.Backup)
 
-	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
+	c.events.record(backup.Backup, LifecycleReasonUploading, "Uploading backup of %d items to backup storage location %s", len(backup.BackedUpItems), backup.StorageLocation.Name)
+	if errs := persistBackup(backup, backup.Status.Encrypted, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents); len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
 	}
 
+	// Read the uploaded backup back from object storage to make sure it's
+	// restorable. The metadata was uploaded with the phase set above, so if
+	// verification fails it's uploaded again with the failure, so that the
+	// backup isn't synced back as completed.
+	if c.verifyBackupUpload && len(fatalErrs) == 0 && !canceled {
+		c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Verifying backup in object storage")
+		if err := verifyPersistedBackup(backupStore, backup.Backup); err != nil {
+			c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("Backup failed verification")
+			backup.Status.Phase = velerov1api.BackupPhaseFailedValidation
+			backup.Status.ValidationErrors = append(backup.Status.ValidationErrors, err.Error())
+
+			if err := persistBackupMetadata(backupStore, backup.Backup); err != nil {
+				fatalErrs = append(fatalErrs, errors.Wrap(err, "error uploading metadata of backup that failed verification"))
+			}
+		}
+	}
+
+	// Copy the backup to its mirror storage locations once it's been stored
+	// in its storage location. Mirrors are best-effort, so their failures are
+	// recorded in the backup's mirror statuses rather than failing it.
+	if len(backup.MirrorLocations) > 0 && len(fatalErrs) == 0 && !canceled && backup.Status.Phase != velerov1api.BackupPhaseFailedValidation {
+		backup.Status.MirrorStatuses = c.persistBackupToMirrors(backup, backupFile, logFile, pluginManager, volumeSnapshots, volumeSnapshotContents)
+	}
+
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
 
 	// if we return a non-nil error, the calling function will update
@@ -668,6 +955,125 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// validateAndGetMirrorLocations gets the backup's mirror storage locations,
+// which must exist, be writable and be distinct from its storage location
+// and each other.
+func (c *backupController) validateAndGetMirrorLocations(backup *velerov1api.Backup) ([]*velerov1api.BackupStorageLocation, []string) {
+	var (
+		locations []*velerov1api.BackupStorageLocation
+		errs      []string
+	)
+
+	seen := sets.NewString(backup.Spec.StorageLocation)
+	for _, name := range backup.Spec.MirrorStorageLocations {
+		if seen.Has(name) {
+			errs = append(errs, fmt.Sprintf("mirror storage location %s must be distinct from the backup's storage location and other mirror storage locations", name))
+			continue
+		}
+		seen.Insert(name)
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: name}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Sprintf("mirror storage location %s doesn't exist", name))
+			} else {
+				errs = append(errs, fmt.Sprintf("error getting mirror storage location %s: %v", name, err))
+			}
+			continue
+		}
+
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			errs = append(errs, fmt.Sprintf("backup can't be copied to mirror storage location %s because it's currently in read-only mode", name))
+			continue
+		}
+
+		locations = append(locations, location)
+	}
+
+	return locations, errs
+}
+
+// persistBackupToMirrors copies the backup to each of its mirror storage
+// locations, returning whether it was copied to each.
+func (c *backupController) persistBackupToMirrors(
+	backup *pkgbackup.Request,
+	backupFile, logFile *os.File,
+	pluginManager clientmgmt.Manager,
+	volumeSnapshots []*snapshotv1beta1api.VolumeSnapshot,
+	volumeSnapshotContents []*snapshotv1beta1api.VolumeSnapshotContent,
+) []velerov1api.BackupMirrorStatus {
+	var statuses []velerov1api.BackupMirrorStatus
+	for _, location := range backup.MirrorLocations {
+		log := c.logger.WithFields(logrus.Fields{
+			Backup:                  kubeutil.NamespaceAndName(backup),
+			"mirrorStorageLocation": location.Name,
+		})
+
+		status := velerov1api.BackupMirrorStatus{
+			StorageLocation: location.Name,
+			Phase:           velerov1api.BackupMirrorPhaseCompleted,
+			Encrypted:       location.Spec.EncryptionKey != nil,
+		}
+
+		log.Info("Copying backup to mirror storage location")
+		if err := persistBackupToMirror(backup, location, backupFile, logFile, c.backupStoreGetter, pluginManager, log, volumeSnapshots, volumeSnapshotContents); err != nil {
+			log.WithError(err).Error("Error copying backup to mirror storage location")
+			status.Phase = velerov1api.BackupMirrorPhaseFailed
+			status.Message = err.Error()
+			status.Encrypted = false
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+func persistBackupToMirror(
+	backup *pkgbackup.Request,
+	location *velerov1api.BackupStorageLocation,
+	backupFile, logFile *os.File,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	pluginManager clientmgmt.Manager,
+	log logrus.FieldLogger,
+	volumeSnapshots []*snapshotv1beta1api.VolumeSnapshot,
+	volumeSnapshotContents []*snapshotv1beta1api.VolumeSnapshotContent,
+) error {
+	backupStore, err := backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return err
+	}
+	backupStore = persistence.BackupStoreForBackup(backupStore, backup.Backup)
+
+	// don't overwrite another backup with the same name.
+	exists, err := backupStore.BackupExists(location.Spec.StorageType.ObjectStorage.Bucket, backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error checking if backup already exists in object storage")
+	}
+	if exists {
+		return errors.New("backup already exists in object storage")
+	}
+
+	return kerrors.NewAggregate(persistBackup(backup, location.Spec.EncryptionKey != nil, backupFile, logFile, backupStore, log, volumeSnapshots, volumeSnapshotContents))
+}
+
+// podVolumeBackupSize returns the total size of the data backed up by the
+// completed pod volume backups, or nil if none completed.
+func podVolumeBackupSize(podVolumeBackups []*velerov1api.PodVolumeBackup) *velerov1api.PodVolumeBackupSize {
+	var size *velerov1api.PodVolumeBackupSize
+	for _, pvb := range podVolumeBackups {
+		if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted {
+			continue
+		}
+		if size == nil {
+			size = new(velerov1api.PodVolumeBackupSize)
+		}
+		size.LogicalBytes += pvb.Status.Progress.TotalBytes
+		size.RepositoryBytesAdded += pvb.Status.RepositoryBytesAdded
+	}
+	return size
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -685,9 +1091,20 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 	serverMetrics.RegisterVolumeSnapshotAttempts(backupScheduleName, backup.Status.VolumeSnapshotsAttempted)
 	serverMetrics.RegisterVolumeSnapshotSuccesses(backupScheduleName, backup.Status.VolumeSnapshotsCompleted)
 	serverMetrics.RegisterVolumeSnapshotFailures(backupScheduleName, backup.Status.VolumeSnapshotsAttempted-backup.Status.VolumeSnapshotsCompleted)
+
+	if filtered := backup.Status.FilteredItems; filtered != nil {
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "resource", filtered.Resources)
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "namespace", filtered.Namespaces)
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "label_selector", filtered.LabelSelector)
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "item_filter", filtered.ItemFilter)
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "owner_kind", filtered.OwnerKind)
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "default_resources", filtered.DefaultResources)
+		serverMetrics.RegisterBackupItemsFiltered(backupScheduleName, "modified_since", filtered.ModifiedSince)
+	}
 }
 
 func persistBackup(backup *pkgbackup.Request,
+	encrypted bool,
 	backupContents, backupLog *os.File,
 	backupStore persistence.BackupStore,
 	log logrus.FieldLogger,
@@ -697,7 +1114,15 @@ func persistBackup(backup *pkgbackup.Request,
 	persistErrs := []error{}
 	backupJSON := new(bytes.Buffer)
 
-	if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
+	// the metadata records whether the contents are encrypted in the
+	// location they're uploaded to, which for a mirror may differ from the
+	// backup's storage location.
+	metadata := backup.Backup
+	if metadata.Status.Encrypted != encrypted {
+		metadata = metadata.DeepCopy()
+		metadata.Status.Encrypted = encrypted
+	}
+	if err := encode.EncodeTo(metadata, "json", backupJSON); err != nil {
 		persistErrs = append(persistErrs, errors.Wrap(err, "error encoding backup"))
 	}
 
@@ -727,12 +1152,24 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	itemStatus, errs := encodeToJSONGzip(backup.ItemStatusManifest(), "backup item status")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
+	itemIndex, errs := encodeToJSONGzip(backup.ItemIndex(), "backup item index")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
 		backupContents = nil
 		nativeVolumeSnapshots = nil
 		backupResourceList = nil
+		itemStatus = nil
+		itemIndex = nil
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
 	}
@@ -745,6 +1182,8 @@ func persistBackup(backup *pkgbackup.Request,
 		PodVolumeBackups:          podVolumeBackups,
 		VolumeSnapshots:           nativeVolumeSnapshots,
 		BackupResourceList:        backupResourceList,
+		ItemStatus:                itemStatus,
+		ItemIndex:                 itemIndex,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 	}
@@ -755,6 +1194,144 @@ func persistBackup(backup *pkgbackup.Request,
 	return persistErrs
 }
 
+// backupLogWriter serializes writes to a backup's gzipped log file, so that
+// the log can be flushed and uploaded while the backup is running.
+type backupLogWriter struct {
+	mu   sync.Mutex
+	gzip *gzip.Writer
+	file *os.File
+}
+
+func (w *backupLogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.gzip.Write(p)
+}
+
+func (w *backupLogWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.gzip.Close()
+}
+
+// flushedContents flushes the log written so far and returns the contents of
+// the log file, which are a valid but unterminated gzip stream until the log
+// is closed.
+func (w *backupLogWriter) flushedContents() ([]byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.gzip.Flush(); err != nil {
+		return nil, errors.Wrap(err, "error flushing backup log")
+	}
+
+	data, err := ioutil.ReadFile(w.file.Name())
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading backup log")
+	}
+	return data, nil
+}
+
+// flushBackupLogPeriodically uploads the log of a running backup to object
+// storage every backupLogFlushInterval, so that it can be followed, until the
+// returned function is called. The complete log is uploaded with the rest of
+// the backup when it finishes.
+func (c *backupController) flushBackupLogPeriodically(backup *velerov1api.Backup, logWriter *backupLogWriter, backupStore persistence.BackupStore) func() {
+	if c.backupLogFlushInterval <= 0 {
+		return func() {}
+	}
+
+	// errors are logged to the server's log rather than the backup's, so that
+	// they're not counted as warnings of the backup.
+	log := c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wait.Until(func() {
+			if err := flushBackupLog(backup.Name, logWriter, backupStore); err != nil {
+				log.WithError(err).Warn("Error uploading log of running backup")
+			}
+		}, c.backupLogFlushInterval, stop)
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+func flushBackupLog(backupName string, logWriter *backupLogWriter, backupStore persistence.BackupStore) error {
+	data, err := logWriter.flushedContents()
+	if err != nil {
+		return err
+	}
+	return backupStore.PutBackupLog(backupName, bytes.NewReader(data))
+}
+
+// contentsChecksum returns the SHA-256 checksum of a backup's contents
+// tarball, read from the beginning if it's seekable, as sha256:<hex>.
+func contentsChecksum(contents io.Reader) (string, error) {
+	if seeker, ok := contents.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return "", errors.WithStack(err)
+		}
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, contents); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// verifyPersistedBackup reads a backup's metadata and contents back from
+// object storage and checks that the contents have the checksum that the
+// backup recorded.
+func verifyPersistedBackup(backupStore persistence.BackupStore, backup *velerov1api.Backup) error {
+	if backup.Status.ContentsChecksum == "" {
+		return errors.New("backup has no contents checksum to verify against")
+	}
+
+	metadata, err := backupStore.GetBackupMetadata(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error reading backup metadata from object storage")
+	}
+	if metadata.Status.ContentsChecksum != backup.Status.ContentsChecksum {
+		return errors.Errorf("backup metadata in object storage has contents checksum %q, expected %q", metadata.Status.ContentsChecksum, backup.Status.ContentsChecksum)
+	}
+
+	contents, err := backupStore.GetBackupContents(backup.Name, backup.Status.Encrypted)
+	if err != nil {
+		return errors.Wrap(err, "error reading backup contents from object storage")
+	}
+	defer contents.Close()
+
+	checksum, err := contentsChecksum(contents)
+	if err != nil {
+		return errors.Wrap(err, "error reading backup contents from object storage")
+	}
+	if checksum != backup.Status.ContentsChecksum {
+		return errors.Errorf("backup contents in object storage have checksum %q, expected %q", checksum, backup.Status.ContentsChecksum)
+	}
+
+	return nil
+}
+
+// persistBackupMetadata replaces the metadata of a backup in object storage
+// with the backup's current state.
+func persistBackupMetadata(backupStore persistence.BackupStore, backup *velerov1api.Backup) error {
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+	return backupStore.PutBackupMetadata(backup.Name, backupJSON)
+}
+
 func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	if file == nil {
 		log.Debug("Skipping removal of file due to nil file pointer")
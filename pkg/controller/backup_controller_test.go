@@ -18,9 +18,13 @@ package controller
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 
 	"sort"
 	"strings"
@@ -49,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -58,8 +63,8 @@ type fakeBackupper struct {
 	mock.Mock
 }
 
-func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
-	args := b.Called(logger, backup, backupFile, actions, volumeSnapshotterGetter)
+func (b *fakeBackupper) Backup(logger logrus.FieldLogger, backup *pkgbackup.Request, backupFile io.Writer, actions []velero.BackupItemAction, itemBlockActions []velero.ItemBlockAction, volumeSnapshotterGetter pkgbackup.VolumeSnapshotterGetter) error {
+	args := b.Called(logger, backup, backupFile, actions, itemBlockActions, volumeSnapshotterGetter)
 	return args.Error(0)
 }
 
@@ -134,6 +139,7 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 
 func TestProcessBackupValidationFailures(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Result()
+	negativeMaxFailedItems, invalidMaxFailedItemsPercent := -1, 101
 
 	tests := []struct {
 		name           string
@@ -153,6 +159,75 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"Invalid included/excluded namespace lists: excludes list cannot contain an item in the includes list: foo"},
 		},
+		{
+			name:           "invalid resource label selector fails validation",
+			backup:         defaultBackup().ResourceLabelSelectors(map[string]string{"secrets": "=invalid-selector", "configmaps": "backup=true"}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"Invalid label selector for resource secrets: found '=', expected: identifier"},
+		},
+		{
+			name:           "invalid excluded fields fail validation",
+			backup:         defaultBackup().ExcludedFields(map[string][]string{"pods": {"status", "metadata"}, "*": {"spec..foo"}}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid excluded field \"spec..foo\" for resource *: field must be a dot-separated path, such as metadata.managedFields",
+				"Invalid excluded field \"metadata\" for resource pods: metadata.name is needed to restore items and can't be excluded",
+			},
+		},
+		{
+			name:           "invalid resource API versions fail validation",
+			backup:         defaultBackup().ResourceAPIVersions(map[string][]string{"widgets.example.com": {"v1", "*"}, "gadgets.example.com": {"example.com/v2"}}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid API versions for resource gadgets.example.com: version \"example.com/v2\" must be an API version, such as v1beta1, or *",
+				"Invalid API versions for resource widgets.example.com: * can't be combined with other versions",
+			},
+		},
+		{
+			name:           "invalid excluded owner kinds fail validation",
+			backup:         defaultBackup().ExcludedOwnerKinds("ReplicaSet.apps", "apps/ReplicaSet", ".apps").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid excluded owner kind: owner kind \"apps/ReplicaSet\" must be formatted as Kind.group, such as ReplicaSet.apps, or as Kind for the core API group",
+				"Invalid excluded owner kind: owner kind \".apps\" must be formatted as Kind.group, such as ReplicaSet.apps, or as Kind for the core API group",
+			},
+		},
+		{
+			name: "invalid item failure thresholds fail validation",
+			backup: defaultBackup().ItemFailureThresholds(&velerov1api.ItemFailureThresholds{
+				MaxFailedItems:        &negativeMaxFailedItems,
+				MaxFailedItemsPercent: &invalidMaxFailedItemsPercent,
+				CriticalResources:     []string{"secrets", ""},
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid item failure thresholds: maxFailedItems must not be negative, got -1",
+				"Invalid item failure thresholds: maxFailedItemsPercent must be between 0 and 100, got 101",
+				"Invalid item failure thresholds: critical resources must not be empty",
+			},
+		},
+		{
+			name: "invalid backup-level hooks fail validation",
+			backup: defaultBackup().Hooks(velerov1api.BackupHooks{
+				Backup: []velerov1api.BackupLevelHookSpec{
+					{
+						Name: "maintenance",
+						PreHooks: []velerov1api.BackupLevelHook{
+							{Plugin: &velerov1api.BackupPluginHook{Name: "example.io/maintenance"}},
+							{},
+						},
+						PostHooks: []velerov1api.BackupLevelHook{
+							{Exec: &velerov1api.BackupLevelExecHook{Namespace: "db", PodSelector: &metav1.LabelSelector{}}},
+						},
+					},
+				},
+			}).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs: []string{
+				"Invalid backup-level hook: backup-level hook maintenance: pre hook 1: exactly one of exec and plugin must be specified",
+				"Invalid backup-level hook: backup-level hook maintenance: post hook 0: exec.command is required",
+			},
+		},
 		{
 			name:         "non-existent backup location fails validation",
 			backup:       defaultBackup().StorageLocation("nonexistent").Result(),
@@ -164,6 +239,24 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:           "invalid compression algorithm fails validation",
+			backup:         defaultBackup().Compression("bzip2").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"invalid compression algorithm \"bzip2\", must be one of [gzip zstd lz4 none]"},
+		},
+		{
+			name:           "non-existent mirror storage location fails validation",
+			backup:         defaultBackup().MirrorStorageLocations("nonexistent").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"mirror storage location nonexistent doesn't exist"},
+		},
+		{
+			name:           "mirror storage location that's the backup's storage location fails validation",
+			backup:         defaultBackup().MirrorStorageLocations("loc-1").Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"mirror storage location loc-1 must be distinct from the backup's storage location and other mirror storage locations"},
+		},
 	}
 
 	for _, test := range tests {
@@ -335,6 +428,64 @@ func TestDefaultBackupTTL(t *testing.T) {
 	}
 }
 
+func TestDefaultBackupCompression(t *testing.T) {
+	tests := []struct {
+		name                     string
+		backup                   *velerov1api.Backup
+		defaultBackupCompression velerov1api.CompressionAlgorithm
+		expectedCompression      velerov1api.CompressionAlgorithm
+	}{
+		{
+			name:                     "backup with no compression specified uses the server default",
+			backup:                   defaultBackup().Result(),
+			defaultBackupCompression: velerov1api.CompressionAlgorithmZstd,
+			expectedCompression:      velerov1api.CompressionAlgorithmZstd,
+		},
+		{
+			name:                     "backup with compression specified overrides the server default",
+			backup:                   defaultBackup().Compression(velerov1api.CompressionAlgorithmLZ4).Result(),
+			defaultBackupCompression: velerov1api.CompressionAlgorithmZstd,
+			expectedCompression:      velerov1api.CompressionAlgorithmLZ4,
+		},
+		{
+			name:                "backup with no compression specified and no server default is left empty",
+			backup:              defaultBackup().Result(),
+			expectedCompression: "",
+		},
+	}
+
+	for _, test := range tests {
+		formatFlag := logging.FormatText
+		var (
+			clientset       = fake.NewSimpleClientset(test.backup)
+			fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+			logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+			sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		)
+
+		t.Run(test.name, func(t *testing.T) {
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:        newGenericController("backup-test", logger),
+				discoveryHelper:          discoveryHelper,
+				kbClient:                 fakeClient,
+				snapshotLocationLister:   sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupCompression: test.defaultBackupCompression,
+				clock:                    &clock.RealClock{},
+				formatFlag:               formatFlag,
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			assert.NotNil(t, res)
+			assert.Equal(t, test.expectedCompression, res.Spec.Compression)
+			assert.Equal(t, test.expectedCompression, res.Status.CompressionAlgorithm)
+		})
+	}
+}
+
 func TestProcessBackupCompletions(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").Result()
 
@@ -343,6 +494,9 @@ func TestProcessBackupCompletions(t *testing.T) {
 	now = now.Local()
 	timestamp := metav1.NewTime(now)
 
+	// the fake backupper doesn't write any contents
+	emptyContentsChecksum := "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
 	tests := []struct {
 		name                   string
 		backup                 *velerov1api.Backup
@@ -381,6 +535,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -417,6 +572,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -456,6 +612,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -493,6 +650,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					Expiration:          &metav1.Time{now.Add(10 * time.Minute)},
@@ -530,6 +688,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -568,6 +727,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -606,6 +766,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -644,6 +805,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -682,6 +844,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
+					ContentsChecksum:    emptyContentsChecksum,
 					Version:             1,
 					FormatVersion:       "1.1.0",
 					StartTimestamp:      &timestamp,
@@ -824,8 +987,11 @@ func TestProcessBackupCompletions(t *testing.T) {
 			}
 
 			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("GetItemBlockActions").Return(nil, nil)
+			pluginManager.On("GetNamespaceMappers").Return(nil, nil)
+			pluginManager.On("GetBackupValidators").Return(nil, nil)
 			pluginManager.On("CleanupClients").Return(nil)
-			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).Return(nil)
+			backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), []velero.ItemBlockAction(nil), pluginManager).Return(nil)
 			backupStore.On("BackupExists", test.backupLocation.Spec.StorageType.ObjectStorage.Bucket, test.backup.Name).Return(test.backupExists, test.existenceCheckError)
 
 			// Ensure we have a CompletionTimestamp when uploading and that the backup name matches the backup in the object store.
@@ -858,6 +1024,304 @@ func TestProcessBackupCompletions(t *testing.T) {
 	}
 }
 
+func TestVerifyPersistedBackup(t *testing.T) {
+	checksum, err := contentsChecksum(strings.NewReader("contents"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		metadataChecksum string
+		metadataErr      error
+		contents         string
+		contentsErr      error
+		wantErr          string
+	}{
+		{
+			name:             "matching metadata and contents pass verification",
+			metadataChecksum: checksum,
+			contents:         "contents",
+		},
+		{
+			name:        "unreadable metadata fails verification",
+			metadataErr: errors.New("access denied"),
+			wantErr:     "error reading backup metadata from object storage: access denied",
+		},
+		{
+			name:             "metadata with a different checksum fails verification",
+			metadataChecksum: "sha256:0000",
+			wantErr:          fmt.Sprintf(`backup metadata in object storage has contents checksum "sha256:0000", expected %q`, checksum),
+		},
+		{
+			name:             "unreadable contents fail verification",
+			metadataChecksum: checksum,
+			contentsErr:      errors.New("no such key"),
+			wantErr:          "error reading backup contents from object storage: no such key",
+		},
+		{
+			name:             "corrupted contents fail verification",
+			metadataChecksum: checksum,
+			contents:         "corrupted",
+			wantErr:          fmt.Sprintf(`backup contents in object storage have checksum "sha256:3dbb3963d11aa418de8b61f846c3dbd5af43b40d252842adb823f90936fe6920", expected %q`, checksum),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := defaultBackup().Result()
+			backup.Status.ContentsChecksum = checksum
+
+			metadata := defaultBackup().Result()
+			metadata.Status.ContentsChecksum = test.metadataChecksum
+
+			backupStore := new(persistencemocks.BackupStore)
+			backupStore.On("GetBackupMetadata", backup.Name).Return(metadata, test.metadataErr)
+			backupStore.On("GetBackupContents", backup.Name, false).Return(ioutil.NopCloser(strings.NewReader(test.contents)), test.contentsErr)
+
+			err := verifyPersistedBackup(backupStore, backup)
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestProcessBackupVerificationFailureUpdatesMetadata(t *testing.T) {
+	backupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Bucket("store-1").Result()
+	backup := defaultBackup().Result()
+
+	// the fake backupper doesn't write any contents
+	emptyContentsChecksum := "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	var (
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+		pluginManager   = new(pluginmocks.Manager)
+		backupStore     = new(persistencemocks.BackupStore)
+		backupper       = new(fakeBackupper)
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+		apiServer       = velerotest.NewAPIServer(t)
+	)
+	apiServer.DiscoveryClient.FakedServerVersion = &version.Info{Major: "1", Minor: "16", GitVersion: "v1.16.4"}
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		discoveryHelper:        discoveryHelper,
+		client:                 clientset.VeleroV1(),
+		lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+		kbClient:               fakeClient,
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  backupLocation.Name,
+		backupTracker:          NewBackupTracker(),
+		metrics:                metrics.NewServerMetrics(),
+		clock:                  clock.NewFakeClock(time.Now()),
+		newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+		backupper:              backupper,
+		formatFlag:             logging.FormatText,
+		verifyBackupUpload:     true,
+	}
+
+	pluginManager.On("GetBackupItemActions").Return(nil, nil)
+	pluginManager.On("GetItemBlockActions").Return(nil, nil)
+	pluginManager.On("GetNamespaceMappers").Return(nil, nil)
+	pluginManager.On("GetBackupValidators").Return(nil, nil)
+	pluginManager.On("CleanupClients").Return(nil)
+	backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), []velero.ItemBlockAction(nil), pluginManager).Return(nil)
+	backupStore.On("BackupExists", backupLocation.Spec.StorageType.ObjectStorage.Bucket, backup.Name).Return(false, nil)
+	backupStore.On("PutBackup", mock.Anything).Return(nil)
+
+	// the uploaded contents don't match the checksum in the metadata.
+	metadata := defaultBackup().Result()
+	metadata.Status.ContentsChecksum = emptyContentsChecksum
+	backupStore.On("GetBackupMetadata", backup.Name).Return(metadata, nil)
+	backupStore.On("GetBackupContents", backup.Name, false).Return(ioutil.NopCloser(strings.NewReader("corrupted")), nil)
+
+	var stored velerov1api.Backup
+	backupStore.On("PutBackupMetadata", backup.Name, mock.Anything).Return(func(_ string, metadata io.Reader) error {
+		return json.NewDecoder(metadata).Decode(&stored)
+	})
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	require.NoError(t, fakeClient.Create(context.Background(), backupLocation))
+
+	require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+	res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseFailedValidation, res.Status.Phase)
+
+	// the metadata in object storage records the failure too, so that the
+	// backup isn't synced back as completed.
+	backupStore.AssertCalled(t, "PutBackupMetadata", backup.Name, mock.Anything)
+	assert.Equal(t, velerov1api.BackupPhaseFailedValidation, stored.Status.Phase)
+	require.Len(t, stored.Status.ValidationErrors, 1)
+	assert.Contains(t, stored.Status.ValidationErrors[0], "backup contents in object storage have checksum")
+	assert.Equal(t, emptyContentsChecksum, stored.Status.ContentsChecksum)
+}
+
+func TestPersistBackupToMirrors(t *testing.T) {
+	var (
+		mirror1     = builder.ForBackupStorageLocation("velero", "mirror-1").Bucket("bucket-1").Result()
+		mirror2     = builder.ForBackupStorageLocation("velero", "mirror-2").Bucket("bucket-2").Result()
+		mirror3     = builder.ForBackupStorageLocation("velero", "mirror-3").Bucket("bucket-3").Result()
+		backupStore = map[string]*persistencemocks.BackupStore{
+			"mirror-1": new(persistencemocks.BackupStore),
+			"mirror-2": new(persistencemocks.BackupStore),
+			"mirror-3": new(persistencemocks.BackupStore),
+		}
+	)
+
+	backupStore["mirror-1"].On("BackupExists", "bucket-1", "backup-1").Return(false, nil)
+	backupStore["mirror-1"].On("PutBackup", mock.Anything).Return(nil)
+	backupStore["mirror-2"].On("BackupExists", "bucket-2", "backup-1").Return(true, nil)
+	backupStore["mirror-3"].On("BackupExists", "bucket-3", "backup-1").Return(false, nil)
+	backupStore["mirror-3"].On("PutBackup", mock.Anything).Return(errors.New("access denied"))
+
+	c := &backupController{
+		genericController: newGenericController("backup-test", velerotest.NewLogger()),
+		backupStoreGetter: NewFakeObjectBackupStoreGetter(backupStore),
+	}
+
+	request := &pkgbackup.Request{
+		Backup:          defaultBackup().MirrorStorageLocations("mirror-1", "mirror-2", "mirror-3").Result(),
+		MirrorLocations: []*velerov1api.BackupStorageLocation{mirror1, mirror2, mirror3},
+	}
+
+	statuses := c.persistBackupToMirrors(request, nil, nil, nil, nil, nil)
+
+	assert.Equal(t, []velerov1api.BackupMirrorStatus{
+		{StorageLocation: "mirror-1", Phase: velerov1api.BackupMirrorPhaseCompleted},
+		{StorageLocation: "mirror-2", Phase: velerov1api.BackupMirrorPhaseFailed, Message: "backup already exists in object storage"},
+		{StorageLocation: "mirror-3", Phase: velerov1api.BackupMirrorPhaseFailed, Message: "access denied"},
+	}, statuses)
+
+	for _, store := range backupStore {
+		store.AssertExpectations(t)
+	}
+}
+
+func TestRunBackupValidators(t *testing.T) {
+	backup := defaultBackup().Result()
+
+	accepting := new(providermocks.BackupValidator)
+	accepting.On("Validate", backup).Return(velero.BackupValidationResult{Accepted: true}, nil)
+	rejecting := new(providermocks.BackupValidator)
+	rejecting.On("Validate", backup).Return(velero.BackupValidationResult{Message: "backup is missing required label team"}, nil)
+	failing := new(providermocks.BackupValidator)
+	failing.On("Validate", backup).Return(velero.BackupValidationResult{}, errors.New("policy unavailable"))
+
+	tests := []struct {
+		name         string
+		validators   []velero.BackupValidator
+		getErr       error
+		expectedErrs []string
+	}{
+		{
+			name: "no validators accepts the backup",
+		},
+		{
+			name:       "backup accepted by every validator has no errors",
+			validators: []velero.BackupValidator{accepting},
+		},
+		{
+			name:       "every rejection and error is returned",
+			validators: []velero.BackupValidator{rejecting, accepting, failing},
+			expectedErrs: []string{
+				"backup rejected by backup validator: backup is missing required label team",
+				"error running backup validator: policy unavailable",
+			},
+		},
+		{
+			name:         "error getting validators rejects the backup",
+			getErr:       errors.New("plugin not found"),
+			expectedErrs: []string{"error getting backup validators: plugin not found"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pluginManager := new(pluginmocks.Manager)
+			defer pluginManager.AssertExpectations(t)
+			pluginManager.On("GetBackupValidators").Return(test.validators, test.getErr)
+			pluginManager.On("CleanupClients").Return(nil)
+
+			c := &backupController{
+				genericController: newGenericController("backup-test", velerotest.NewLogger()),
+				newPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			}
+
+			assert.Equal(t, test.expectedErrs, c.runBackupValidators(backup, c.logger))
+		})
+	}
+}
+
+func TestFlushBackupLog(t *testing.T) {
+	logFile, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer os.Remove(logFile.Name())
+	defer logFile.Close()
+
+	logWriter := &backupLogWriter{gzip: gzip.NewWriter(logFile), file: logFile}
+
+	var uploaded []byte
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("PutBackupLog", "backup-1", mock.Anything).Return(func(_ string, log io.Reader) error {
+		var err error
+		uploaded, err = ioutil.ReadAll(log)
+		return err
+	})
+
+	readUploaded := func() string {
+		gzr, err := gzip.NewReader(bytes.NewReader(uploaded))
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(gzr)
+		// the log isn't a complete gzip stream until it's closed.
+		if err != io.ErrUnexpectedEOF {
+			require.NoError(t, err)
+		}
+		return string(data)
+	}
+
+	_, err = logWriter.Write([]byte("line 1\n"))
+	require.NoError(t, err)
+	require.NoError(t, flushBackupLog("backup-1", logWriter, backupStore))
+	assert.Equal(t, "line 1\n", readUploaded())
+
+	_, err = logWriter.Write([]byte("line 2\n"))
+	require.NoError(t, err)
+	require.NoError(t, flushBackupLog("backup-1", logWriter, backupStore))
+	assert.Equal(t, "line 1\nline 2\n", readUploaded())
+
+	require.NoError(t, logWriter.Close())
+	require.NoError(t, flushBackupLog("backup-1", logWriter, backupStore))
+	assert.Equal(t, "line 1\nline 2\n", readUploaded())
+}
+
+func TestPodVolumeBackupSize(t *testing.T) {
+	assert.Nil(t, podVolumeBackupSize(nil))
+
+	newPVB := func(name string, phase velerov1api.PodVolumeBackupPhase, totalBytes, bytesAdded int64) *velerov1api.PodVolumeBackup {
+		pvb := builder.ForPodVolumeBackup("velero", name).Phase(phase).Result()
+		pvb.Status.Progress.TotalBytes = totalBytes
+		pvb.Status.RepositoryBytesAdded = bytesAdded
+		return pvb
+	}
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		newPVB("pvb-1", velerov1api.PodVolumeBackupPhaseCompleted, 1000, 100),
+		newPVB("pvb-2", velerov1api.PodVolumeBackupPhaseCompleted, 500, 0),
+		newPVB("pvb-3", velerov1api.PodVolumeBackupPhaseFailed, 2000, 0),
+	}
+	assert.Equal(t, &velerov1api.PodVolumeBackupSize{LogicalBytes: 1500, RepositoryBytesAdded: 100}, podVolumeBackupSize(podVolumeBackups))
+
+	assert.Nil(t, podVolumeBackupSize(podVolumeBackups[2:]))
+}
+
 func TestValidateAndGetSnapshotLocations(t *testing.T) {
 	tests := []struct {
 		name                                string
@@ -899,6 +1363,24 @@ func TestValidateAndGetSnapshotLocations(t *testing.T) {
 			expectedErrors:  "more than one VolumeSnapshotLocation name specified for provider aws: aws-us-west-1; unexpected name was aws-us-east-1",
 			expectedSuccess: false,
 		},
+		{
+			name:   "location name corresponds to an unavailable location: error",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseNew).VolumeSnapshotLocations("aws-us-west-1").Result(),
+			locations: []*velerov1api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "aws-us-west-1").Provider("aws").Phase(velerov1api.VolumeSnapshotLocationPhaseUnavailable).Message("invalid credentials").Result(),
+			},
+			expectedErrors:  "volume snapshot location aws-us-west-1 is unavailable: invalid credentials",
+			expectedSuccess: false,
+		},
+		{
+			name:   "no location name for the provider exists, only one VSL for the provider and it's unavailable: use it",
+			backup: defaultBackup().Phase(velerov1api.BackupPhaseNew).Result(),
+			locations: []*velerov1api.VolumeSnapshotLocation{
+				builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "aws-us-east-1").Provider("aws").Phase(velerov1api.VolumeSnapshotLocationPhaseUnavailable).Message("invalid credentials").Result(),
+			},
+			expectedVolumeSnapshotLocationNames: []string{"aws-us-east-1"},
+			expectedSuccess:                     true,
+		},
 		{
 			name:   "no location name for the provider exists, only one VSL for the provider: use it",
 			backup: defaultBackup().Phase(velerov1api.BackupPhaseNew).Result(),
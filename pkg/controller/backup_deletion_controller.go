@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -34,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/velero/internal/delete"
@@ -246,6 +248,23 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		return err
 	}
 
+	// Don't allow deleting backups that incremental backups need to be restored
+	backups := &velerov1api.BackupList{}
+	if err := c.kbClient.List(context.Background(), backups, &client.ListOptions{Namespace: backup.Namespace}); err != nil {
+		return errors.Wrap(err, "error listing backups")
+	}
+	var namespaceBackups []*velerov1api.Backup
+	for i := range backups.Items {
+		namespaceBackups = append(namespaceBackups, &backups.Items[i])
+	}
+	if dependents := incrementalDependents(backup, namespaceBackups); len(dependents) > 0 {
+		_, err := c.patchDeleteBackupRequest(req, func(r *velerov1api.DeleteBackupRequest) {
+			r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+			r.Status.Errors = append(r.Status.Errors, fmt.Sprintf("cannot delete backup because it's the base of incremental backups %s, delete them first", strings.Join(dependents, ", ")))
+		})
+		return err
+	}
+
 	// if the request object has no labels defined, initialise an empty map since
 	// we will be updating labels
 	if req.Labels == nil {
@@ -294,6 +313,8 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 	backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
 	if err != nil {
 		errs = append(errs, err.Error())
+	} else {
+		backupStore = persistence.BackupStoreForBackup(backupStore, backup)
 	}
 
 	actions, err := pluginManager.GetDeleteItemActions()
@@ -305,7 +326,7 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 
 	if len(actions) > 0 {
 		// Download the tarball
-		backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
+		backupFile, err := downloadToTempFile(backup.Name, backup.Status.Encrypted, backupStore, log)
 		defer closeAndRemoveFile(backupFile, c.logger)
 
 		if err != nil {
@@ -370,6 +391,13 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 		}
 	}
 
+	if len(backup.Spec.MirrorStorageLocations) > 0 {
+		log.Info("Removing backup from mirror storage locations")
+		for _, err := range c.deleteBackupFromMirrors(backup, pluginManager, log) {
+			errs = append(errs, err.Error())
+		}
+	}
+
 	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
 		log.Info("Removing CSI volumesnapshots")
 		if csiErrs := deleteCSIVolumeSnapshots(backup.Name, c.csiSnapshotLister, c.csiSnapshotClient.SnapshotV1beta1(), log); len(csiErrs) > 0 {
@@ -447,6 +475,53 @@ func (c *backupDeletionController) processRequest(req *velerov1api.DeleteBackupR
 	return nil
 }
 
+// deleteBackupFromMirrors deletes the copies of a backup in its mirror storage
+// locations, so that they aren't synced back into the cluster. Mirror storage
+// locations that no longer exist, or that the backup wasn't copied to, are
+// skipped.
+func (c *backupDeletionController) deleteBackupFromMirrors(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, log logrus.FieldLogger) []error {
+	failed := sets.NewString()
+	for _, status := range backup.Status.MirrorStatuses {
+		if status.Phase == velerov1api.BackupMirrorPhaseFailed {
+			failed.Insert(status.StorageLocation)
+		}
+	}
+
+	var errs []error
+	for _, name := range backup.Spec.MirrorStorageLocations {
+		if failed.Has(name) {
+			continue
+		}
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{Namespace: backup.Namespace, Name: name}, location); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.WithField("mirrorStorageLocation", name).Info("Mirror storage location not found, skipping")
+				continue
+			}
+			errs = append(errs, errors.Wrapf(err, "error getting mirror storage location %s", name))
+			continue
+		}
+
+		if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+			errs = append(errs, errors.Errorf("cannot delete backup from mirror storage location %s because it is currently in read-only mode", name))
+			continue
+		}
+
+		backupStore, err := c.backupStoreGetter.Get(location, pluginManager, log)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error getting backup store for mirror storage location %s", name))
+			continue
+		}
+
+		if err := persistence.BackupStoreForBackup(backupStore, backup).DeleteBackup(backup.Name); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error deleting backup from mirror storage location %s", name))
+		}
+	}
+
+	return errs
+}
+
 func volumeSnapshotterForSnapshotLocation(
 	namespace, snapshotLocationName string,
 	snapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
@@ -508,7 +508,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
-		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName, false).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-1").Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-2").Return(nil)
@@ -669,7 +669,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
-		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName, false).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-1").Return(nil)
 		td.backupStore.On("DeleteRestore", "restore-2").Return(nil)
@@ -815,7 +815,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		err := td.controller.processRequest(td.req)
 		require.NoError(t, err)
 
-		td.backupStore.AssertNotCalled(t, "GetBackupContents", td.req.Spec.BackupName)
+		td.backupStore.AssertNotCalled(t, "GetBackupContents", td.req.Spec.BackupName, false)
 
 		expectedActions := []core.Action{
 			core.NewPatchAction(
@@ -940,7 +940,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
 		td.backupStore.On("GetBackupVolumeSnapshots", td.req.Spec.BackupName).Return(snapshots, nil)
-		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName).Return(nil, fmt.Errorf("error downloading tarball"))
+		td.backupStore.On("GetBackupContents", td.req.Spec.BackupName, false).Return(nil, fmt.Errorf("error downloading tarball"))
 		td.backupStore.On("DeleteBackup", td.req.Spec.BackupName).Return(nil)
 
 		err := td.controller.processRequest(td.req)
@@ -26,12 +26,14 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 )
@@ -46,12 +48,30 @@ type BackupStorageLocationReconciler struct {
 	// replaced with fakes for testing.
 	NewPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
 	BackupStoreGetter persistence.ObjectBackupStoreGetter
+	// ClusterName is the cluster whose backups are cleaned up when a
+	// location's delete policy enables orphan cleanup.
+	ClusterName string
+	// SyncFilter limits the backups that are cleaned up to the ones that the
+	// backup sync controller would sync into the cluster, so that the
+	// backups of other clusters sharing a location aren't orphans.
+	SyncFilter    BackupSyncFilter
+	EventRecorder record.EventRecorder
+	// StorageUsageInterval is how often the space used by the backups in
+	// each available location is measured. Zero disables measuring it.
+	StorageUsageInterval time.Duration
+	Metrics              *metrics.ServerMetrics
 
 	Log logrus.FieldLogger
+
+	// orphanedBackups maps the name of each location with orphan cleanup
+	// enabled to the time each of its orphaned backups was first found.
+	orphanedBackups map[string]map[string]time.Time
 }
 
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithField("controller", BackupStorageLocation)
 
@@ -90,7 +110,15 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 			defaultFound = true
 		}
 
-		if !storage.IsReadyToValidate(location.Spec.ValidationFrequency, location.Status.LastValidationTime, r.DefaultBackupLocationInfo.ServerValidationFrequency, log) {
+		// the last measured usage is reported even if it isn't due to be
+		// measured again, so that it survives server restarts.
+		r.setStorageUsageMetrics(location)
+
+		if requestedAt, requested := location.Annotations[velerov1api.AccessCheckRequestedAnnotation]; requested {
+			r.checkAccess(location, requestedAt, pluginManager, log)
+		}
+
+		if !r.isReadyToValidate(location, log) {
 			log.Debug("Validation not required, skipping...")
 			continue
 		}
@@ -114,12 +142,25 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 		log.Info("Validating backup storage location")
 		anyVerified = true
 		if err := backupStore.IsValid(); err != nil {
-			log.Info("Backup storage location is invalid, marking as unavailable")
-			unavailableErrors = append(unavailableErrors, errors.Wrapf(err, "Backup storage location %q is unavailable", location.Name).Error())
-			location.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
+			location.Status.ConsecutiveFailures++
+			if maxFailures := r.validationMaxFailures(); location.Status.ConsecutiveFailures < maxFailures {
+				backoff := storage.ValidationBackoff(location.Status.ConsecutiveFailures, r.DefaultBackupLocationInfo.ValidationBackoffBase, r.DefaultBackupLocationInfo.ValidationBackoffCap)
+				log.WithError(err).Infof("Backup storage location is invalid (%d of %d consecutive failures), retrying in %s", location.Status.ConsecutiveFailures, maxFailures, backoff)
+			} else {
+				log.Info("Backup storage location is invalid, marking as unavailable")
+				unavailableErrors = append(unavailableErrors, errors.Wrapf(err, "Backup storage location %q is unavailable", location.Name).Error())
+				location.Status.Phase = velerov1api.BackupStorageLocationPhaseUnavailable
+			}
 		} else {
 			log.Info("Backup storage location valid, marking as available")
 			location.Status.Phase = velerov1api.BackupStorageLocationPhaseAvailable
+			location.Status.ConsecutiveFailures = 0
+
+			if err := r.cleanUpOrphanedBackups(location, backupStore, log); err != nil {
+				log.WithError(err).Error("Error cleaning up orphaned backups")
+			}
+
+			r.refreshStorageUsage(location, backupStore, log)
 		}
 		location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
 
@@ -137,6 +178,105 @@ func (r *BackupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 	return ctrl.Result{Requeue: true}, nil
 }
 
+// checkAccess runs a location's requested access check, records its result in
+// the location's status and removes the request, whether or not the check
+// succeeds.
+func (r *BackupStorageLocationReconciler) checkAccess(location *velerov1api.BackupStorageLocation, requestedAt string, pluginManager clientmgmt.Manager, log logrus.FieldLogger) {
+	patchHelper, err := patch.NewHelper(location, r.Client)
+	if err != nil {
+		log.WithError(err).Error("Error getting a patch helper to update this resource")
+		return
+	}
+
+	log.Info("Checking access to backup storage location")
+	var results []persistence.AccessCheckResult
+	if backupStore, err := r.BackupStoreGetter.Get(location, pluginManager, log); err != nil {
+		// none of the operations can be run without a backup store.
+		for _, operation := range []string{persistence.AccessCheckPut, persistence.AccessCheckGet, persistence.AccessCheckList, persistence.AccessCheckDelete} {
+			results = append(results, persistence.AccessCheckResult{Operation: operation, Err: errors.Wrap(err, "error getting a backup store")})
+		}
+	} else {
+		results = backupStore.CheckAccess()
+	}
+
+	check := &velerov1api.BackupStorageLocationAccessCheck{
+		RequestedAt: requestedAt,
+		Time:        &metav1.Time{Time: time.Now().UTC()},
+	}
+	for _, result := range results {
+		operation := velerov1api.BackupStorageLocationAccessCheckOperation{
+			Operation: result.Operation,
+			Succeeded: result.Err == nil,
+		}
+		if result.Err != nil {
+			operation.Message = result.Err.Error()
+			log.WithError(result.Err).Warnf("Access check operation %s failed", result.Operation)
+		}
+		check.Operations = append(check.Operations, operation)
+	}
+
+	location.Status.LastAccessCheck = check
+	delete(location.Annotations, velerov1api.AccessCheckRequestedAnnotation)
+	if err := patchHelper.Patch(r.Ctx, location); err != nil {
+		log.WithError(err).Error("Error updating backup storage location access check")
+	}
+}
+
+// refreshStorageUsage measures the space used by a location's backups and
+// records it in the location's status, if it hasn't been measured within the
+// storage usage interval. Measuring it lists all of the backups' objects, so
+// it's done much less often than validation.
+func (r *BackupStorageLocationReconciler) refreshStorageUsage(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	if r.StorageUsageInterval <= 0 {
+		return
+	}
+	if usage := location.Status.StorageUsage; usage != nil && usage.LastUpdatedTime != nil && time.Since(usage.LastUpdatedTime.Time) < r.StorageUsageInterval {
+		return
+	}
+
+	log.Info("Measuring the storage usage of backup storage location")
+	usage, err := backupStore.GetStorageUsage()
+	if err != nil {
+		// the usage is measured again when the location is next validated.
+		log.WithError(err).Error("Error measuring the storage usage of backup storage location")
+		return
+	}
+
+	location.Status.StorageUsage = &velerov1api.BackupStorageLocationStorageUsage{
+		Bytes:           usage.Bytes,
+		Objects:         usage.Objects,
+		LastUpdatedTime: &metav1.Time{Time: time.Now().UTC()},
+	}
+	r.setStorageUsageMetrics(location)
+}
+
+func (r *BackupStorageLocationReconciler) setStorageUsageMetrics(location *velerov1api.BackupStorageLocation) {
+	if r.Metrics == nil || location.Status.StorageUsage == nil {
+		return
+	}
+	r.Metrics.SetBackupStorageUsage(location.Name, location.Status.StorageUsage.Bytes, location.Status.StorageUsage.Objects)
+}
+
+// isReadyToValidate returns whether location is due to be validated. A location that has failed
+// validation, but not enough times in a row to be marked unavailable, is revalidated with
+// exponential backoff instead of at its validation frequency.
+func (r *BackupStorageLocationReconciler) isReadyToValidate(location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) bool {
+	if failures := location.Status.ConsecutiveFailures; failures > 0 && failures < r.validationMaxFailures() {
+		return storage.IsReadyToRetryValidation(failures, location.Status.LastValidationTime, r.DefaultBackupLocationInfo.ValidationBackoffBase, r.DefaultBackupLocationInfo.ValidationBackoffCap)
+	}
+
+	return storage.IsReadyToValidate(location.Spec.ValidationFrequency, location.Status.LastValidationTime, r.DefaultBackupLocationInfo.ServerValidationFrequency, log)
+}
+
+// validationMaxFailures returns the number of consecutive failed validations after which a
+// location is marked unavailable, which is at least 1.
+func (r *BackupStorageLocationReconciler) validationMaxFailures() int {
+	if r.DefaultBackupLocationInfo.ValidationMaxFailures < 1 {
+		return 1
+	}
+	return r.DefaultBackupLocationInfo.ValidationMaxFailures
+}
+
 func (r *BackupStorageLocationReconciler) logReconciledPhase(defaultFound bool, locationList velerov1api.BackupStorageLocationList, errs []string) {
 	var availableBSLs []*velerov1api.BackupStorageLocation
 	var unAvailableBSLs []*velerov1api.BackupStorageLocation
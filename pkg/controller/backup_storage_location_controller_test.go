@@ -27,12 +27,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -174,6 +177,179 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 		}
 	})
 
+	It("Should only mark a backup storage location unavailable after the configured number of consecutive failures", func() {
+		tests := []struct {
+			backupLocation              *velerov1api.BackupStorageLocation
+			isValidError                error
+			expectedPhase               velerov1api.BackupStorageLocationPhase
+			expectedConsecutiveFailures int
+		}{
+			{
+				backupLocation:              builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(1 * time.Second).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+				isValidError:                errors.New("an error"),
+				expectedPhase:               velerov1api.BackupStorageLocationPhaseAvailable,
+				expectedConsecutiveFailures: 1,
+			},
+			{
+				backupLocation:              builder.ForBackupStorageLocation("ns-1", "location-2").ValidationFrequency(1 * time.Second).Phase(velerov1api.BackupStorageLocationPhaseAvailable).ConsecutiveFailures(2).Result(),
+				isValidError:                errors.New("an error"),
+				expectedPhase:               velerov1api.BackupStorageLocationPhaseUnavailable,
+				expectedConsecutiveFailures: 3,
+			},
+			{
+				backupLocation:              builder.ForBackupStorageLocation("ns-1", "location-3").ValidationFrequency(1 * time.Second).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).ConsecutiveFailures(3).Result(),
+				isValidError:                nil,
+				expectedPhase:               velerov1api.BackupStorageLocationPhaseAvailable,
+				expectedConsecutiveFailures: 0,
+			},
+		}
+
+		// Setup
+		var (
+			pluginManager = &pluginmocks.Manager{}
+			backupStores  = make(map[string]*persistencemocks.BackupStore)
+		)
+		pluginManager.On("CleanupClients").Return(nil)
+
+		locations := new(velerov1api.BackupStorageLocationList)
+		for i, test := range tests {
+			location := test.backupLocation
+			locations.Items = append(locations.Items, *location)
+			backupStores[location.Name] = &persistencemocks.BackupStore{}
+			backupStores[location.Name].On("IsValid").Return(tests[i].isValidError)
+		}
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:    ctx,
+			Client: fake.NewFakeClientWithScheme(scheme.Scheme, locations),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
+				StorageLocation:           "location-1",
+				ServerValidationFrequency: 0,
+				ValidationMaxFailures:     3,
+				ValidationBackoffBase:     1 * time.Second,
+				ValidationBackoffCap:      1 * time.Minute,
+			},
+			NewPluginManager:  func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			BackupStoreGetter: NewFakeObjectBackupStoreGetter(backupStores),
+			Log:               velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(err).To(BeNil())
+
+		// Assertions
+		for i, location := range locations.Items {
+			key := client.ObjectKey{Name: location.Name, Namespace: location.Namespace}
+			instance := &velerov1api.BackupStorageLocation{}
+			err := r.Client.Get(ctx, key, instance)
+			Expect(err).To(BeNil())
+			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
+			Expect(instance.Status.ConsecutiveFailures).To(BeIdenticalTo(tests[i].expectedConsecutiveFailures))
+		}
+	})
+
+	It("Should measure the storage usage of available backup storage locations at the storage usage interval", func() {
+		measuredRecently := builder.ForBackupStorageLocation("ns-1", "location-2").ValidationFrequency(1 * time.Second).Result()
+		measuredRecently.Status.StorageUsage = &velerov1api.BackupStorageLocationStorageUsage{
+			Bytes:           10,
+			Objects:         1,
+			LastUpdatedTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		}
+
+		tests := []struct {
+			backupLocation *velerov1api.BackupStorageLocation
+			isValidError   error
+			storageUsage   *persistence.StorageUsage
+			expectedBytes  int64
+			expectedCount  int
+			expectMeasured bool
+		}{
+			{
+				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-1").ValidationFrequency(1 * time.Second).Result(),
+				storageUsage:   &persistence.StorageUsage{Bytes: 1024, Objects: 3},
+				expectedBytes:  1024,
+				expectedCount:  3,
+				expectMeasured: true,
+			},
+			{
+				backupLocation: measuredRecently,
+				expectedBytes:  10,
+				expectedCount:  1,
+				expectMeasured: true,
+			},
+			{
+				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-3").ValidationFrequency(1 * time.Second).Result(),
+				isValidError:   errors.New("an error"),
+			},
+		}
+
+		// Setup
+		var (
+			pluginManager = &pluginmocks.Manager{}
+			backupStores  = make(map[string]*persistencemocks.BackupStore)
+		)
+		pluginManager.On("CleanupClients").Return(nil)
+
+		locations := new(velerov1api.BackupStorageLocationList)
+		for i, test := range tests {
+			location := test.backupLocation
+			locations.Items = append(locations.Items, *location)
+			backupStores[location.Name] = &persistencemocks.BackupStore{}
+			backupStores[location.Name].On("IsValid").Return(tests[i].isValidError)
+			if test.storageUsage != nil {
+				backupStores[location.Name].On("GetStorageUsage").Return(test.storageUsage, nil)
+			}
+		}
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:    ctx,
+			Client: fake.NewFakeClientWithScheme(scheme.Scheme, locations),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{
+				StorageLocation:           "location-1",
+				ServerValidationFrequency: 0,
+			},
+			NewPluginManager:     func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			BackupStoreGetter:    NewFakeObjectBackupStoreGetter(backupStores),
+			StorageUsageInterval: time.Hour,
+			Metrics:              metrics.NewServerMetrics(),
+			Log:                  velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(err).To(BeNil())
+
+		// Assertions
+		for i, location := range locations.Items {
+			key := client.ObjectKey{Name: location.Name, Namespace: location.Namespace}
+			instance := &velerov1api.BackupStorageLocation{}
+			err := r.Client.Get(ctx, key, instance)
+			Expect(err).To(BeNil())
+			if !tests[i].expectMeasured {
+				Expect(instance.Status.StorageUsage).To(BeNil())
+				continue
+			}
+			Expect(instance.Status.StorageUsage).NotTo(BeNil())
+			Expect(instance.Status.StorageUsage.Bytes).To(BeIdenticalTo(tests[i].expectedBytes))
+			Expect(instance.Status.StorageUsage.Objects).To(BeIdenticalTo(tests[i].expectedCount))
+			Expect(instance.Status.StorageUsage.LastUpdatedTime).NotTo(BeNil())
+		}
+		for _, backupStore := range backupStores {
+			backupStore.AssertExpectations(GinkgoT())
+		}
+	})
+
 	It("Should not patch a backup storage location object status phase if the location's validation frequency is specifically set to zero", func() {
 		tests := []struct {
 			backupLocation    *velerov1api.BackupStorageLocation
@@ -244,4 +420,63 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
 		}
 	})
+
+	It("Should run a requested access check and record its result", func() {
+		locations := &velerov1api.BackupStorageLocationList{
+			Items: []velerov1api.BackupStorageLocation{
+				*builder.ForBackupStorageLocation("ns-1", "location-1").
+					ObjectMeta(builder.WithAnnotations(velerov1api.AccessCheckRequestedAnnotation, "2021-01-01T00:00:00Z")).
+					ValidationFrequency(0).LastValidationTime(time.Now()).Result(),
+				*builder.ForBackupStorageLocation("ns-1", "location-2").ValidationFrequency(0).LastValidationTime(time.Now()).Result(),
+			},
+		}
+
+		// Setup
+		var (
+			pluginManager = &pluginmocks.Manager{}
+			backupStores  = map[string]*persistencemocks.BackupStore{
+				"location-1": {},
+				"location-2": {},
+			}
+		)
+		pluginManager.On("CleanupClients").Return(nil)
+		backupStores["location-1"].On("CheckAccess").Return([]persistence.AccessCheckResult{
+			{Operation: persistence.AccessCheckPut},
+			{Operation: persistence.AccessCheckGet, Err: errors.New("access denied")},
+			{Operation: persistence.AccessCheckList},
+			{Operation: persistence.AccessCheckDelete},
+		})
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := BackupStorageLocationReconciler{
+			Ctx:                       ctx,
+			Client:                    fake.NewFakeClientWithScheme(scheme.Scheme, locations),
+			DefaultBackupLocationInfo: storage.DefaultBackupLocationInfo{StorageLocation: "location-1"},
+			NewPluginManager:          func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			BackupStoreGetter:         NewFakeObjectBackupStoreGetter(backupStores),
+			Log:                       velerotest.NewLogger(),
+		}
+
+		_, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+		Expect(err).To(BeNil())
+
+		// Assertions
+		instance := &velerov1api.BackupStorageLocation{}
+		Expect(r.Client.Get(ctx, client.ObjectKey{Namespace: "ns-1", Name: "location-1"}, instance)).To(Succeed())
+		Expect(instance.Annotations).NotTo(HaveKey(velerov1api.AccessCheckRequestedAnnotation))
+		Expect(instance.Status.LastAccessCheck).NotTo(BeNil())
+		Expect(instance.Status.LastAccessCheck.RequestedAt).To(Equal("2021-01-01T00:00:00Z"))
+		Expect(instance.Status.LastAccessCheck.Operations).To(Equal([]velerov1api.BackupStorageLocationAccessCheckOperation{
+			{Operation: persistence.AccessCheckPut, Succeeded: true},
+			{Operation: persistence.AccessCheckGet, Succeeded: false, Message: "access denied"},
+			{Operation: persistence.AccessCheckList, Succeeded: true},
+			{Operation: persistence.AccessCheckDelete, Succeeded: true},
+		}))
+
+		Expect(r.Client.Get(ctx, client.ObjectKey{Namespace: "ns-1", Name: "location-2"}, instance)).To(Succeed())
+		Expect(instance.Status.LastAccessCheck).To(BeNil())
+	})
 })
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	snapshotterClientSet "github.com/kubernetes-csi/external-snapshotter/client/v4/clientset/versioned"
@@ -35,8 +36,10 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -52,9 +55,54 @@ type backupSyncController struct {
 	kubeClient              kubernetes.Interface
 	namespace               string
 	defaultBackupLocation   string
+	clusterName             string
+	syncAllClusters         bool
 	defaultBackupSyncPeriod time.Duration
+	syncFilter              BackupSyncFilter
 	newPluginManager        func(logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter       persistence.ObjectBackupStoreGetter
+	metrics                 *metrics.ServerMetrics
+
+	// syncedBackupVersions holds the metadata versions of the backups that
+	// have been synced from each location, keyed by location and then by
+	// backup name, so that backups whose metadata hasn't changed aren't
+	// downloaded again. It's only kept in memory, so all backups are synced
+	// again when the server restarts.
+	syncedBackupVersions map[string]map[string]string
+
+	// labelSkippedBackupVersions holds the metadata versions of the backups
+	// that were skipped because their labels don't match the sync filter,
+	// keyed like syncedBackupVersions, so that their metadata isn't
+	// downloaded on every sync.
+	labelSkippedBackupVersions map[string]map[string]string
+}
+
+// BackupSyncFilter limits the backups that are synced from backup storage
+// locations to the ones in the server's scope, for locations that are shared
+// with other clusters. Backups outside its scope are ignored: they aren't
+// synced into the cluster, and if they're already there, they aren't deleted
+// when they're deleted from the location.
+type BackupSyncFilter struct {
+	// Prefix is the prefix that the names of synced backups, and so their
+	// keys in the locations, must start with. If empty, backups with any
+	// name are synced.
+	Prefix string
+
+	// LabelSelector is the selector that the labels of synced backups must
+	// match. It's checked against the backups' metadata, so the metadata of
+	// every backup with a matching name is downloaded. If nil, backups with
+	// any labels are synced.
+	LabelSelector labels.Selector
+}
+
+// hasName returns true if a backup name is in the filter's scope.
+func (f *BackupSyncFilter) hasName(backupName string) bool {
+	return strings.HasPrefix(backupName, f.Prefix)
+}
+
+// matchesLabels returns true if a backup's labels are in the filter's scope.
+func (f *BackupSyncFilter) matchesLabels(backup *velerov1api.Backup) bool {
+	return f.LabelSelector == nil || f.LabelSelector.Matches(labels.Set(backup.Labels))
 }
 
 func NewBackupSyncController(
@@ -67,8 +115,12 @@ func NewBackupSyncController(
 	csiSnapshotClient *snapshotterClientSet.Clientset,
 	kubeClient kubernetes.Interface,
 	defaultBackupLocation string,
+	clusterName string,
+	syncAllClusters bool,
+	syncFilter BackupSyncFilter,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
 	logger logrus.FieldLogger,
 ) Interface {
 	if syncPeriod <= 0 {
@@ -83,7 +135,10 @@ func NewBackupSyncController(
 		podVolumeBackupClient:   podVolumeBackupClient,
 		namespace:               namespace,
 		defaultBackupLocation:   defaultBackupLocation,
+		clusterName:             clusterName,
+		syncAllClusters:         syncAllClusters,
 		defaultBackupSyncPeriod: syncPeriod,
+		syncFilter:              syncFilter,
 		backupLister:            backupLister,
 		csiSnapshotClient:       csiSnapshotClient,
 		kubeClient:              kubeClient,
@@ -92,6 +147,10 @@ func NewBackupSyncController(
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
+		metrics:           metrics,
+
+		syncedBackupVersions:       make(map[string]map[string]string),
+		labelSkippedBackupVersions: make(map[string]map[string]string),
 	}
 
 	c.resyncFunc = c.run
@@ -139,6 +198,22 @@ func (c *backupSyncController) run() {
 	}
 	locations := orderedBackupLocations(&locationList, c.defaultBackupLocation)
 
+	// forget the synced backups of locations that no longer exist
+	locationNames := sets.NewString()
+	for _, location := range locations {
+		locationNames.Insert(location.Name)
+	}
+	for locationName := range c.syncedBackupVersions {
+		if !locationNames.Has(locationName) {
+			delete(c.syncedBackupVersions, locationName)
+		}
+	}
+	for locationName := range c.labelSkippedBackupVersions {
+		if !locationNames.Has(locationName) {
+			delete(c.labelSkippedBackupVersions, locationName)
+		}
+	}
+
 	pluginManager := c.newPluginManager(c.logger)
 	defer pluginManager.CleanupClients()
 
@@ -176,14 +251,36 @@ func (c *backupSyncController) run() {
 			continue
 		}
 
-		// get a list of all the backups that are stored in the backup storage location
-		res, err := backupStore.ListBackups()
+		backupStores, err := c.clusterBackupStores(backupStore)
+		if err != nil {
+			log.WithError(err).Error("Error listing clusters in backup store")
+			continue
+		}
+
+		// get a list of all the backups of the synced clusters that are stored in the
+		// backup storage location and have names in the sync filter's scope
+		backupClusters, prefixSkipped, err := c.listBackups(backupStores)
 		if err != nil {
 			log.WithError(err).Error("Error listing backups in backup store")
 			continue
 		}
-		backupStoreBackups := sets.NewString(res...)
+		backupStoreBackups := sets.StringKeySet(backupClusters)
 		log.WithField("backupCount", len(backupStoreBackups)).Debug("Got backups from backup store")
+		if prefixSkipped > 0 {
+			log.WithField("backupCount", prefixSkipped).Debugf("Skipping backups whose names don't start with %q", c.syncFilter.Prefix)
+		}
+
+		// forget the synced and skipped backups that are no longer in the location
+		for backupName := range c.syncedBackupVersions[location.Name] {
+			if !backupStoreBackups.Has(backupName) {
+				delete(c.syncedBackupVersions[location.Name], backupName)
+			}
+		}
+		for backupName := range c.labelSkippedBackupVersions[location.Name] {
+			if !backupStoreBackups.Has(backupName) {
+				delete(c.labelSkippedBackupVersions[location.Name], backupName)
+			}
+		}
 
 		// get a list of all the backups that exist as custom resources in the cluster
 		clusterBackups, err := c.backupLister.Backups(c.namespace).List(labels.Everything())
@@ -209,14 +306,40 @@ func (c *backupSyncController) run() {
 		// sync each backup
 		for backupName := range backupsToSync {
 			log = log.WithField("backup", backupName)
+			cluster := backupClusters[backupName]
+			clusterStore := backupStores[cluster]
+
+			version, err := clusterStore.GetBackupMetadataVersion(backupName)
+			if err != nil && errors.Cause(err) != velero.ErrObjectMetadataNotSupported {
+				log.WithError(err).Debug("Error getting backup metadata version from backup store")
+			}
+			if version != "" && c.syncedBackupVersions[location.Name][backupName] == version {
+				log.Debug("Backup metadata hasn't changed since the backup was last synced, skipping")
+				continue
+			}
+			if skippedVersion, ok := c.labelSkippedBackupVersions[location.Name][backupName]; ok && version != "" && skippedVersion == version {
+				log.Debug("Backup metadata hasn't changed since the backup was skipped by the sync filter, skipping")
+				continue
+			}
+
 			log.Info("Attempting to sync backup into cluster")
 
-			backup, err := backupStore.GetBackupMetadata(backupName)
+			backup, err := clusterStore.GetBackupMetadata(backupName)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error getting backup metadata from backup store")
 				continue
 			}
 
+			if !c.syncFilter.matchesLabels(backup) {
+				log.Info("Backup's labels don't match the sync filter's label selector, skipping")
+				if c.labelSkippedBackupVersions[location.Name] == nil {
+					c.labelSkippedBackupVersions[location.Name] = make(map[string]string)
+				}
+				c.labelSkippedBackupVersions[location.Name][backupName] = version
+				continue
+			}
+			delete(c.labelSkippedBackupVersions[location.Name], backupName)
+
 			// We want to keep the namespace of the found backup instead of deploying it into the namespace where the
 			// controller resides.
 			//backup.Namespace = c.namespace
@@ -225,27 +348,41 @@ func (c *backupSyncController) run() {
 			// update the StorageLocation field and label since the name of the location
 			// may be different in this cluster than in the cluster that created the
 			// backup.
+			//
+			// if the backup was synced from one of its mirror storage locations,
+			// its original storage location becomes one of its mirrors instead.
+			for i, mirror := range backup.Spec.MirrorStorageLocations {
+				if mirror == location.Name {
+					backup.Spec.MirrorStorageLocations[i] = backup.Spec.StorageLocation
+				}
+			}
 			backup.Spec.StorageLocation = location.Name
 			if backup.Labels == nil {
 				backup.Labels = make(map[string]string)
 			}
 			backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(backup.Spec.StorageLocation)
+			if cluster != "" {
+				backup.Labels[velerov1api.ClusterNameLabel] = cluster
+			}
 
 			// attempt to create backup custom resource via API
-			backup, err = c.backupClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+			backupNamespace := backup.Namespace
+			backup, err = c.backupClient.Backups(backupNamespace).Create(context.TODO(), backup, metav1.CreateOptions{})
 			switch {
 			case err != nil && kuberrs.IsAlreadyExists(err):
 				log.Debug("Backup already exists in cluster")
+				c.recordSyncedBackup(location.Name, backupNamespace, backupName, version)
 				continue
 			case err != nil && !kuberrs.IsAlreadyExists(err):
 				log.WithError(errors.WithStack(err)).Error("Error syncing backup into cluster")
 				continue
 			default:
 				log.Info("Successfully synced backup into cluster")
+				c.metrics.RegisterBackupSynced(location.Name)
 			}
 
 			// process the pod volume backups from object store, if any
-			podVolumeBackups, err := backupStore.GetPodVolumeBackups(backupName)
+			podVolumeBackups, err := clusterStore.GetPodVolumeBackups(backupName)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error getting pod volume backups for this backup from backup store")
 				continue
@@ -286,7 +423,7 @@ func (c *backupSyncController) run() {
 				// we are syncing these objects only to ensure that the storage snapshots are cleaned up
 				// on backup deletion or expiry.
 				log.Info("Syncing CSI volumesnapshotcontents in backup")
-				snapConts, err := backupStore.GetCSIVolumeSnapshotContents(backupName)
+				snapConts, err := clusterStore.GetCSIVolumeSnapshotContents(backupName)
 				if err != nil {
 					log.WithError(errors.WithStack(err)).Error("Error getting CSI volumesnapshotcontents for this backup from backup store")
 					continue
@@ -309,9 +446,14 @@ func (c *backupSyncController) run() {
 					}
 				}
 			}
+
+			c.recordSyncedBackup(location.Name, backupNamespace, backupName, version)
 		}
 
-		c.deleteOrphanedBackups(location.Name, backupStoreBackups, log)
+		c.metrics.SetBackupSyncSkipped(location.Name, "prefix", prefixSkipped)
+		c.metrics.SetBackupSyncSkipped(location.Name, "label_selector", len(c.labelSkippedBackupVersions[location.Name]))
+
+		c.deleteOrphanedBackups(location.Name, backupStoreBackups, sets.StringKeySet(backupStores), log)
 
 		// update the location's last-synced time field
 		statusPatch := client.MergeFrom(location.DeepCopyObject())
@@ -323,9 +465,79 @@ func (c *backupSyncController) run() {
 	}
 }
 
-// deleteOrphanedBackups deletes backup objects (CRDs) from Kubernetes that have the specified location
-// and a phase of Completed, but no corresponding backup in object storage.
-func (c *backupSyncController) deleteOrphanedBackups(locationName string, backupStoreBackups sets.String, log logrus.FieldLogger) {
+// clusterBackupStores returns the stores for the backups that are synced from a
+// location, keyed by the name of the cluster they're tagged with, or "" for
+// untagged backups. Only the local cluster's backups are synced, unless the
+// backups of all clusters are.
+func (c *backupSyncController) clusterBackupStores(backupStore persistence.BackupStore) (map[string]persistence.BackupStore, error) {
+	if !c.syncAllClusters {
+		if c.clusterName == "" {
+			return map[string]persistence.BackupStore{"": backupStore}, nil
+		}
+		return map[string]persistence.BackupStore{c.clusterName: backupStore.ForCluster(c.clusterName)}, nil
+	}
+
+	clusters, err := backupStore.ListClusters()
+	if err != nil {
+		return nil, err
+	}
+
+	backupStores := map[string]persistence.BackupStore{"": backupStore}
+	for _, cluster := range clusters {
+		backupStores[cluster] = backupStore.ForCluster(cluster)
+	}
+	return backupStores, nil
+}
+
+// listBackups returns the names of the backups in the given stores, mapped to
+// the cluster each one is tagged with. If several clusters have a backup with
+// the same name, the local cluster's is used. Backups whose names aren't in
+// the sync filter's scope aren't returned, and the number of them is
+// returned instead.
+func (c *backupSyncController) listBackups(backupStores map[string]persistence.BackupStore) (map[string]string, int, error) {
+	backupClusters := make(map[string]string)
+	skipped := 0
+	for cluster, backupStore := range backupStores {
+		backups, err := backupStore.ListBackups()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, backup := range backups {
+			if !c.syncFilter.hasName(backup) {
+				skipped++
+				continue
+			}
+			if existing, ok := backupClusters[backup]; ok && existing == c.clusterName {
+				continue
+			}
+			backupClusters[backup] = cluster
+		}
+	}
+	return backupClusters, skipped, nil
+}
+
+// recordSyncedBackup records the metadata version of a backup that's been
+// synced into namespace, so it isn't synced again until its metadata changes.
+func (c *backupSyncController) recordSyncedBackup(locationName, namespace, backupName, version string) {
+	// backups synced into the controller's namespace are seen by the backup
+	// lister, so they're only synced again if they're deleted from the
+	// cluster. Backups synced into other namespaces aren't, so without this
+	// they'd be downloaded on every sync.
+	if version == "" || namespace == c.namespace {
+		return
+	}
+
+	if c.syncedBackupVersions[locationName] == nil {
+		c.syncedBackupVersions[locationName] = make(map[string]string)
+	}
+	c.syncedBackupVersions[locationName][backupName] = version
+}
+
+// deleteOrphanedBackups deletes backup objects (CRDs) from Kubernetes that have the specified location,
+// are tagged with one of the synced clusters, have a name in the sync filter's scope and have a phase of
+// Completed, but no corresponding backup in object storage.
+func (c *backupSyncController) deleteOrphanedBackups(locationName string, backupStoreBackups, syncedClusters sets.String, log logrus.FieldLogger) {
 	locationSelector := labels.Set(map[string]string{
 		velerov1api.StorageLocationLabel: label.GetValidName(locationName),
 	}).AsSelector()
@@ -345,6 +557,12 @@ func (c *backupSyncController) deleteOrphanedBackups(locationName string, backup
 			continue
 		}
 
+		// backups of clusters that aren't synced, and backups with names
+		// outside the sync filter's scope, weren't listed.
+		if !syncedClusters.Has(backup.Labels[velerov1api.ClusterNameLabel]) || !c.syncFilter.hasName(backup.Name) {
+			continue
+		}
+
 		if err := c.backupClient.Backups(backup.Namespace).Delete(context.TODO(), backup.Name, metav1.DeleteOptions{}); err != nil {
 			log.WithError(errors.WithStack(err)).Error("Error deleting orphaned backup from cluster")
 		} else {
@@ -24,7 +24,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	kuberrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	core "k8s.io/client-go/testing"
@@ -34,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
@@ -349,8 +352,12 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				nil, // csiSnapshotClient
 				nil, // kubeClient
 				"",
+				"",
+				false,
+				BackupSyncFilter{},
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
 				NewFakeObjectBackupStoreGetter(backupStores),
+				metrics.NewServerMetrics(),
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -368,6 +375,7 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				var backupNames []string
 				for _, bucket := range test.cloudBuckets[location.Spec.ObjectStorage.Bucket] {
 					backupNames = append(backupNames, bucket.backup.Name)
+					backupStore.On("GetBackupMetadataVersion", bucket.backup.Name).Return(bucket.backup.Name+"-version", nil)
 					backupStore.On("GetBackupMetadata", bucket.backup.Name).Return(bucket.backup, nil)
 					backupStore.On("GetPodVolumeBackups", bucket.backup.Name).Return(bucket.podVolumeBackups, nil)
 				}
@@ -465,6 +473,249 @@ func TestBackupSyncControllerRun(t *testing.T) {
 	}
 }
 
+func TestBackupSyncControllerSkipsUnchangedBackups(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		"",
+		false,
+		BackupSyncFilter{},
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"location-1": backupStore}),
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	pluginManager.On("CleanupClients").Return(nil)
+
+	// sync the location on every run
+	location := defaultLocationsList("ns-1")[0]
+	location.Spec.BackupSyncPeriod = &metav1.Duration{Duration: time.Nanosecond}
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+
+	// the backup is synced into a namespace the controller doesn't list
+	// backups from, so it's never seen as already being in the cluster.
+	backup := builder.ForBackup("ns-2", "backup-1").Result()
+	backupStore.On("ListBackups").Return([]string{"backup-1"}, nil)
+	backupStore.On("GetBackupMetadataVersion", "backup-1").Return("version-1", nil).Twice()
+	backupStore.On("GetBackupMetadataVersion", "backup-1").Return("version-2", nil).Once()
+	backupStore.On("GetBackupMetadata", "backup-1").Return(backup, nil)
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return(nil, nil)
+
+	// the first sync creates the backup
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupMetadata", 1)
+	_, err := client.VeleroV1().Backups("ns-2").Get(context.TODO(), "backup-1", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// the metadata hasn't changed, so it isn't downloaded again
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupMetadata", 1)
+
+	// the metadata has changed, so it's downloaded again
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupMetadata", 2)
+	backupStore.AssertExpectations(t)
+}
+
+func TestBackupSyncControllerFilter(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = &pluginmocks.Manager{}
+		backupStore     = &persistencemocks.BackupStore{}
+	)
+
+	selector, err := labels.Parse("team=a")
+	require.NoError(t, err)
+
+	c := NewBackupSyncController(
+		client.VeleroV1(),
+		fakeClient,
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups().Lister(),
+		time.Duration(0),
+		"ns-1",
+		nil, // csiSnapshotClient
+		nil, // kubeClient
+		"",
+		"",
+		false,
+		BackupSyncFilter{Prefix: "cluster-a-", LabelSelector: selector},
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"location-1": backupStore}),
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupSyncController)
+
+	pluginManager.On("CleanupClients").Return(nil)
+
+	// sync the location on every run
+	location := defaultLocationsList("ns-1")[0]
+	location.Spec.BackupSyncPeriod = &metav1.Duration{Duration: time.Nanosecond}
+	require.NoError(t, fakeClient.Create(context.Background(), location))
+
+	// completed backups that are no longer in the location are only deleted
+	// from the cluster if their names are in the filter's scope.
+	for _, name := range []string{"cluster-a-old", "cluster-b-old"} {
+		backup := builder.ForBackup("ns-1", name).
+			ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "location-1")).
+			Phase(velerov1api.BackupPhaseCompleted).
+			Result()
+		require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+		_, err := client.VeleroV1().Backups("ns-1").Create(context.TODO(), backup, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	// backups outside the prefix aren't looked at, so their metadata isn't
+	// expected to be fetched.
+	backupStore.On("ListBackups").Return([]string{"cluster-a-1", "cluster-a-2", "cluster-b-1"}, nil)
+	backupStore.On("GetBackupMetadataVersion", "cluster-a-1").Return("", nil)
+	backupStore.On("GetBackupMetadata", "cluster-a-1").Return(builder.ForBackup("ns-2", "cluster-a-1").ObjectMeta(builder.WithLabels("team", "a")).Result(), nil)
+	backupStore.On("GetPodVolumeBackups", "cluster-a-1").Return(nil, nil)
+	backupStore.On("GetBackupMetadataVersion", "cluster-a-2").Return("version-1", nil)
+	backupStore.On("GetBackupMetadata", "cluster-a-2").Return(builder.ForBackup("ns-2", "cluster-a-2").ObjectMeta(builder.WithLabels("team", "b")).Result(), nil)
+
+	c.run()
+
+	_, err = client.VeleroV1().Backups("ns-2").Get(context.TODO(), "cluster-a-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = client.VeleroV1().Backups("ns-2").Get(context.TODO(), "cluster-a-2", metav1.GetOptions{})
+	assert.True(t, kuberrs.IsNotFound(err))
+	_, err = client.VeleroV1().Backups("ns-1").Get(context.TODO(), "cluster-a-old", metav1.GetOptions{})
+	assert.True(t, kuberrs.IsNotFound(err))
+	_, err = client.VeleroV1().Backups("ns-1").Get(context.TODO(), "cluster-b-old", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// the skipped backup's metadata hasn't changed, so it isn't downloaded again
+	c.run()
+	backupStore.AssertNumberOfCalls(t, "GetBackupMetadata", 3)
+	backupStore.AssertExpectations(t)
+}
+
+func TestBackupSyncControllerClusterBackups(t *testing.T) {
+	tests := []struct {
+		name            string
+		syncAllClusters bool
+		clusterBackups  map[string][]string
+		wantSynced      map[string]string
+	}{
+		{
+			name: "only the local cluster's backups are synced by default",
+			clusterBackups: map[string][]string{
+				"cluster-a": {"backup-1"},
+			},
+			wantSynced: map[string]string{"backup-1": "cluster-a"},
+		},
+		{
+			name:            "the backups of all clusters, and untagged backups, are synced when enabled",
+			syncAllClusters: true,
+			clusterBackups: map[string][]string{
+				"":          {"backup-0", "backup-1"},
+				"cluster-a": {"backup-2"},
+				"cluster-b": {"backup-3"},
+			},
+			wantSynced: map[string]string{"backup-1": "", "backup-2": "cluster-a", "backup-3": "cluster-b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				fakeClient      = velerotest.NewFakeControllerRuntimeClient(t)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				backupStore     = &persistencemocks.BackupStore{}
+			)
+
+			c := NewBackupSyncController(
+				client.VeleroV1(),
+				fakeClient,
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups().Lister(),
+				time.Duration(0),
+				"ns-1",
+				nil, // csiSnapshotClient
+				nil, // kubeClient
+				"",
+				"cluster-a",
+				test.syncAllClusters,
+				BackupSyncFilter{},
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"location-1": backupStore}),
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			).(*backupSyncController)
+
+			pluginManager.On("CleanupClients").Return(nil)
+			require.NoError(t, fakeClient.Create(context.Background(), defaultLocationsList("ns-1")[0]))
+
+			// an untagged backup that's already in the cluster isn't deleted as an
+			// orphan, whether or not untagged backups are synced.
+			untagged := builder.ForBackup("ns-1", "backup-0").
+				ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "location-1")).
+				Phase(velerov1api.BackupPhaseCompleted).
+				Result()
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(untagged))
+			_, err := client.VeleroV1().Backups("ns-1").Create(context.TODO(), untagged, metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			var clusters []string
+			for cluster, backupNames := range test.clusterBackups {
+				clusterStore := backupStore
+				if cluster != "" {
+					clusters = append(clusters, cluster)
+					clusterStore = &persistencemocks.BackupStore{}
+					backupStore.On("ForCluster", cluster).Return(clusterStore)
+				}
+
+				clusterStore.On("ListBackups").Return(backupNames, nil)
+				for _, backupName := range backupNames {
+					if _, ok := test.wantSynced[backupName]; !ok {
+						continue
+					}
+					backup := builder.ForBackup("ns-1", backupName).Result()
+					clusterStore.On("GetBackupMetadataVersion", backupName).Return("", nil)
+					clusterStore.On("GetBackupMetadata", backupName).Return(backup, nil)
+					clusterStore.On("GetPodVolumeBackups", backupName).Return(nil, nil)
+				}
+			}
+			if test.syncAllClusters {
+				backupStore.On("ListClusters").Return(clusters, nil)
+			}
+
+			c.run()
+
+			for backupName, cluster := range test.wantSynced {
+				backup, err := client.VeleroV1().Backups("ns-1").Get(context.TODO(), backupName, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, cluster, backup.Labels[velerov1api.ClusterNameLabel])
+			}
+
+			_, err = client.VeleroV1().Backups("ns-1").Get(context.TODO(), "backup-0", metav1.GetOptions{})
+			assert.NoError(t, err)
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
+
 func TestDeleteOrphanedBackups(t *testing.T) {
 	baseBuilder := func(name string) *builder.BackupBuilder {
 		return builder.ForBackup("ns-1", name).ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "default"))
@@ -570,8 +821,12 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				nil, // csiSnapshotClient
 				nil, // kubeClient
 				"",
+				"",
+				false,
+				BackupSyncFilter{},
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
+				nil, // metrics
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -596,7 +851,7 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				}
 			}
 
-			c.deleteOrphanedBackups("default", test.cloudBackups, velerotest.NewLogger())
+			c.deleteOrphanedBackups("default", test.cloudBackups, sets.NewString(""), velerotest.NewLogger())
 
 			numBackups, err := numBackups(t, client, c.namespace)
 			assert.NoError(t, err)
@@ -664,8 +919,12 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				nil, // csiSnapshotClient
 				nil, // kubeClient
 				"",
+				"",
+				false,
+				BackupSyncFilter{},
 				nil, // new plugin manager func
 				nil, // backupStoreGetter
+				nil, // metrics
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -690,7 +949,7 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				}
 			}
 
-			c.deleteOrphanedBackups(longLabelName, test.cloudBackups, velerotest.NewLogger())
+			c.deleteOrphanedBackups(longLabelName, test.cloudBackups, sets.NewString(""), velerotest.NewLogger())
 
 			numBackups, err := numBackups(t, client, c.namespace)
 			assert.NoError(t, err)
@@ -17,18 +17,19 @@ limitations under the License.
 package controller
 
 const (
-	Backup                = "backup"
-	BackupDeletion        = "backup-deletion"
-	BackupStorageLocation = "backup-storage-location"
-	BackupSync            = "backup-sync"
-	DownloadRequest       = "download-request"
-	GarbageCollection     = "gc"
-	PodVolumeBackup       = "pod-volume-backup"
-	PodVolumeRestore      = "pod-volume-restore"
-	ResticRepo            = "restic-repo"
-	Restore               = "restore"
-	Schedule              = "schedule"
-	ServerStatusRequest   = "server-status-request"
+	Backup                 = "backup"
+	BackupDeletion         = "backup-deletion"
+	BackupStorageLocation  = "backup-storage-location"
+	BackupSync             = "backup-sync"
+	DownloadRequest        = "download-request"
+	GarbageCollection      = "gc"
+	PodVolumeBackup        = "pod-volume-backup"
+	PodVolumeRestore       = "pod-volume-restore"
+	ResticRepo             = "restic-repo"
+	Restore                = "restore"
+	Schedule               = "schedule"
+	ServerStatusRequest    = "server-status-request"
+	VolumeSnapshotLocation = "volume-snapshot-location"
 )
 
 // DisableableControllers is a list of controllers that can be disabled
@@ -148,6 +148,7 @@ func (r *DownloadRequestReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			log.WithError(err).Error("Error getting a backup store")
 			return ctrl.Result{}, errors.WithStack(err)
 		}
+		backupStore = persistence.BackupStoreForBackup(backupStore, backup)
 
 		if downloadRequest.Status.DownloadURL, err = backupStore.GetDownloadURL(downloadRequest.Spec.Target); err != nil {
 			return ctrl.Result{Requeue: true}, errors.WithStack(err)
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -118,22 +119,49 @@ func (c *gcController) processQueueItem(key string) error {
 		return errors.Wrap(err, "error getting backup")
 	}
 
+	// an expiration override keeps the backup past its status.expiration.
+	expiration, err := pkgbackup.GetExpiration(backup)
+	if err != nil {
+		log.WithError(err).Warn("Ignoring backup's invalid expiration override")
+	} else if override, _ := pkgbackup.GetExpirationOverride(backup); override != nil && expiration.Time.Equal(override.Expiration) {
+		// the requester is recorded by the client, so it's logged as given.
+		log.WithField("requestedBy", override.RequestedBy).Debugf("Backup's expiration is extended by an override requested at %s", override.RequestedAt.Format(time.RFC3339))
+	}
+
 	log = c.logger.WithFields(
 		logrus.Fields{
 			"backup":     key,
-			"expiration": backup.Status.Expiration,
+			"expiration": expiration,
 		},
 	)
 
 	now := c.clock.Now()
 
-	if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
+	if expiration == nil || expiration.After(now) {
 		log.Debug("Backup has not expired yet, skipping")
 		return nil
 	}
 
 	log.Info("Backup has expired")
 
+	// a held backup is kept until its hold is removed, which enqueues it
+	// again.
+	if pkgbackup.HasGCHold(backup) {
+		log.Infof("Skipping garbage collection of backup because it has the %s=true label", velerov1api.GCHoldLabel)
+		return nil
+	}
+
+	// incremental backups need the contents of the backups they're based on
+	// to be restored, so those are kept until the incremental backups expire.
+	backups, err := c.backupLister.Backups(ns).List(labels.Everything())
+	if err != nil {
+		return errors.Wrap(err, "error listing backups")
+	}
+	if dependents := incrementalDependents(backup, backups); len(dependents) > 0 {
+		log.Infof("Backup cannot be garbage-collected because it's the base of incremental backups %s", strings.Join(dependents, ", "))
+		return nil
+	}
+
 	loc := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: ns,
@@ -157,6 +157,7 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 	tests := []struct {
 		name                           string
 		backup                         *velerov1api.Backup
+		otherBackups                   []*velerov1api.Backup
 		deleteBackupRequests           []*velerov1api.DeleteBackupRequest
 		backupLocation                 *velerov1api.BackupStorageLocation
 		expectDeletion                 bool
@@ -172,6 +173,60 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectDeletion: false,
 		},
+		{
+			name: "expired backup with a later expiration override is not deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.ExpirationOverrideAnnotation, fakeClock.Now().Add(time.Hour).Format(time.RFC3339),
+					velerov1api.ExpirationOverrideRequestedAtAnnotation, fakeClock.Now().Add(-2*time.Hour).Format(time.RFC3339),
+				)).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
+		{
+			name: "backup whose expiration override has passed is deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Hour)).StorageLocation("default").
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.ExpirationOverrideAnnotation, fakeClock.Now().Add(-time.Minute).Format(time.RFC3339),
+					velerov1api.ExpirationOverrideRequestedAtAnnotation, fakeClock.Now().Add(-2*time.Hour).Format(time.RFC3339),
+				)).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
+		{
+			name: "expired backup with an invalid expiration override is deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").
+				ObjectMeta(builder.WithAnnotations(velerov1api.ExpirationOverrideAnnotation, "next week")).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
+		{
+			name: "expired backup with an expiration override that doesn't say when it was requested is deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").
+				ObjectMeta(builder.WithAnnotations(velerov1api.ExpirationOverrideAnnotation, fakeClock.Now().Add(time.Hour).Format(time.RFC3339))).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
+		{
+			name: "expired backup with a GC hold is not deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").
+				ObjectMeta(builder.WithLabels(velerov1api.GCHoldLabel, "true")).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
+		{
+			name: "expired backup whose GC hold isn't true is deleted",
+			backup: defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("default").
+				ObjectMeta(builder.WithLabels(velerov1api.GCHoldLabel, "false")).
+				Result(),
+			backupLocation: defaultBackupLocation,
+			expectDeletion: true,
+		},
 		{
 			name:           "expired backup in read-only storage location is not deleted",
 			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Minute)).StorageLocation("read-only").Result(),
@@ -232,6 +287,13 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 			},
 			expectDeletion: true,
 		},
+		{
+			name:           "expired backup that an incremental backup is based on is not deleted",
+			backup:         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
+			otherBackups:   []*velerov1api.Backup{builder.ForBackup(velerov1api.DefaultNamespace, "backup-2").BaseBackup("backup-1").Result()},
+			backupLocation: defaultBackupLocation,
+			expectDeletion: false,
+		},
 		{
 			name:                           "create DeleteBackupRequest error returns an error",
 			backup:                         defaultBackup().Expiration(fakeClock.Now().Add(-time.Second)).StorageLocation("default").Result(),
@@ -271,6 +333,10 @@ func TestGCControllerProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup)
 			}
 
+			for _, backup := range test.otherBackups {
+				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup)
+			}
+
 			for _, dbr := range test.deleteBackupRequests {
 				sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(dbr)
 			}
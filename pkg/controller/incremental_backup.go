@@ -0,0 +1,203 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+)
+
+// maxIncrementalChainLength is the most incremental backups that can be
+// based on each other, starting from a full backup. The next backup of the
+// schedule is a full backup, so that restores read a bounded number of
+// tarballs and old backups can eventually be garbage-collected.
+const maxIncrementalChainLength = 10
+
+// setBaseBackup makes an incremental backup's request based on the most
+// recent usable backup of the same schedule. The backup is a full backup if
+// there isn't one.
+func (c *backupController) setBaseBackup(request *pkgbackup.Request, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	scheduleName := request.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" {
+		log.Info("Incremental backup wasn't created by a schedule, backing up all items")
+		return
+	}
+
+	backups, err := c.lister.Backups(request.Namespace).List(labels.SelectorFromSet(labels.Set{velerov1api.ScheduleNameLabel: scheduleName}))
+	if err != nil {
+		log.WithError(err).Warn("Error listing the schedule's backups, backing up all items")
+		return
+	}
+
+	base := baseBackupCandidate(request.Backup, backups, c.clock.Now())
+	if base == nil {
+		log.Info("Schedule has no usable base backup, backing up all items")
+		return
+	}
+
+	length, ok := incrementalChainLength(base, backups)
+	if !ok {
+		log.Infof("Some of the backups that backup %s is based on no longer exist, backing up all items", base.Name)
+		return
+	}
+	if length >= maxIncrementalChainLength {
+		log.Infof("Backup %s is the last of %d incremental backups, backing up all items", base.Name, length)
+		return
+	}
+
+	index, err := backupStore.GetBackupItemIndex(base.Name)
+	if err != nil {
+		log.WithError(err).Warnf("Error getting the item index of backup %s, backing up all items", base.Name)
+		return
+	}
+	if index == nil {
+		log.Infof("Backup %s has no item index, backing up all items", base.Name)
+		return
+	}
+
+	log.Infof("Backup is incremental, based on backup %s", base.Name)
+	request.BaseItemIndex = index
+	request.Status.BaseBackup = base.Name
+}
+
+// baseBackupCandidate returns the most recent of a schedule's backups that an
+// incremental backup can be based on: a completed, unexpired backup with the
+// same spec, so that its items were backed up the same way.
+func baseBackupCandidate(backup *velerov1api.Backup, scheduleBackups []*velerov1api.Backup, now time.Time) *velerov1api.Backup {
+	var candidates []*velerov1api.Backup
+	for _, b := range scheduleBackups {
+		if b.Name == backup.Name || b.Status.Phase != velerov1api.BackupPhaseCompleted || b.Status.StartTimestamp == nil {
+			continue
+		}
+		// like garbage collection, an invalid expiration override is ignored.
+		if expiration, _ := pkgbackup.GetExpiration(b); expiration != nil && !expiration.After(now) {
+			continue
+		}
+		if !apiequality.Semantic.DeepEqual(b.Spec, backup.Spec) {
+			continue
+		}
+		candidates = append(candidates, b)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Status.StartTimestamp.After(candidates[j].Status.StartTimestamp.Time)
+	})
+	return candidates[0]
+}
+
+// incrementalChainLength returns how many incremental backups lead from a
+// full backup to backup, counting backup itself if it's incremental. It
+// returns false if one of the backups in the chain doesn't exist.
+func incrementalChainLength(backup *velerov1api.Backup, backups []*velerov1api.Backup) (int, bool) {
+	byName := make(map[string]*velerov1api.Backup)
+	for _, b := range backups {
+		byName[b.Name] = b
+	}
+
+	length := 0
+	for backup.Status.BaseBackup != "" {
+		base, ok := byName[backup.Status.BaseBackup]
+		if !ok || length >= len(backups) {
+			return length, false
+		}
+		length++
+		backup = base
+	}
+	return length, true
+}
+
+// incrementalDependents returns the names of the incremental backups that are
+// based on backup, which need its contents to be restored.
+func incrementalDependents(backup *velerov1api.Backup, backups []*velerov1api.Backup) []string {
+	var dependents []string
+	for _, b := range backups {
+		if b.Status.BaseBackup == backup.Name && b.Name != backup.Name {
+			dependents = append(dependents, b.Name)
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// downloadBaseBackups downloads the tarballs of the backups that an
+// incremental backup's unchanged items were backed up in, returning the files
+// to restore from each one. The returned temp files must be closed and removed
+// by the caller, even if there's an error.
+func (c *restoreController) downloadBaseBackups(backup *velerov1api.Backup, location string, backupStore persistence.BackupStore, log logrus.FieldLogger) ([]pkgrestore.BaseBackupContents, []*os.File, error) {
+	if backup.Status.BaseBackup == "" {
+		return nil, nil, nil
+	}
+
+	index, err := backupStore.GetBackupItemIndex(backup.Name)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error fetching backup item index")
+	}
+	if index == nil {
+		return nil, nil, errors.Errorf("incremental backup %s has no item index", backup.Name)
+	}
+
+	filesByBackup := make(map[string][]string)
+	for _, item := range index.Items {
+		if item.Backup != backup.Name {
+			filesByBackup[item.Backup] = append(filesByBackup[item.Backup], item.Files...)
+		}
+	}
+
+	var names []string
+	for name := range filesByBackup {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		contents  []pkgrestore.BaseBackupContents
+		tempFiles []*os.File
+	)
+	for _, name := range names {
+		base, err := c.backupLister.Backups(backup.Namespace).Get(name)
+		if err != nil {
+			return nil, tempFiles, errors.Wrapf(err, "error getting base backup %s", name)
+		}
+
+		file, err := downloadToTempFile(name, persistence.BackupContentsEncrypted(base, location), backupStore, log)
+		if err != nil {
+			return nil, tempFiles, errors.Wrapf(err, "error downloading base backup %s", name)
+		}
+		tempFiles = append(tempFiles, file)
+
+		contents = append(contents, pkgrestore.BaseBackupContents{
+			Backup: base,
+			Reader: file,
+			Files:  filesByBackup[name],
+		})
+	}
+	return contents, tempFiles, nil
+}
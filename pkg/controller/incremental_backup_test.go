@@ -0,0 +1,125 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestBaseBackupCandidate(t *testing.T) {
+	now := time.Now()
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-3").IncludedNamespaces("ns-1").Result()
+	scheduleBackup := func(name string, started time.Time) *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			IncludedNamespaces("ns-1").
+			Phase(velerov1api.BackupPhaseCompleted).
+			StartTimestamp(started).
+			Expiration(now.Add(time.Hour))
+	}
+
+	tests := []struct {
+		name    string
+		backups []*velerov1api.Backup
+		want    string
+	}{
+		{
+			name: "schedule without other backups has no base",
+		},
+		{
+			name: "most recent completed backup is the base",
+			backups: []*velerov1api.Backup{
+				scheduleBackup("backup-1", now.Add(-2*time.Hour)).Result(),
+				scheduleBackup("backup-2", now.Add(-time.Hour)).Result(),
+				scheduleBackup("backup-4", now.Add(-time.Minute)).Phase(velerov1api.BackupPhasePartiallyFailed).Result(),
+			},
+			want: "backup-2",
+		},
+		{
+			name: "backup with a different spec isn't the base",
+			backups: []*velerov1api.Backup{
+				scheduleBackup("backup-1", now.Add(-2*time.Hour)).Result(),
+				scheduleBackup("backup-2", now.Add(-time.Hour)).IncludedNamespaces("ns-2").Result(),
+			},
+			want: "backup-1",
+		},
+		{
+			name: "expired backup isn't the base",
+			backups: []*velerov1api.Backup{
+				scheduleBackup("backup-1", now.Add(-2*time.Hour)).Expiration(now.Add(-time.Minute)).Result(),
+			},
+		},
+		{
+			name: "backup itself isn't its base",
+			backups: []*velerov1api.Backup{
+				scheduleBackup("backup-3", now.Add(-time.Hour)).Result(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			base := baseBackupCandidate(backup, tc.backups, now)
+			if tc.want == "" {
+				assert.Nil(t, base)
+				return
+			}
+			if assert.NotNil(t, base) {
+				assert.Equal(t, tc.want, base.Name)
+			}
+		})
+	}
+}
+
+func TestIncrementalChainLength(t *testing.T) {
+	backups := []*velerov1api.Backup{
+		builder.ForBackup(velerov1api.DefaultNamespace, "full").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "incremental-1").BaseBackup("full").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "incremental-2").BaseBackup("incremental-1").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "broken").BaseBackup("deleted").Result(),
+	}
+
+	length, ok := incrementalChainLength(backups[0], backups)
+	assert.True(t, ok)
+	assert.Equal(t, 0, length)
+
+	length, ok = incrementalChainLength(backups[2], backups)
+	assert.True(t, ok)
+	assert.Equal(t, 2, length)
+
+	_, ok = incrementalChainLength(backups[3], backups)
+	assert.False(t, ok)
+}
+
+func TestIncrementalDependents(t *testing.T) {
+	backups := []*velerov1api.Backup{
+		builder.ForBackup(velerov1api.DefaultNamespace, "full").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "incremental-2").BaseBackup("full").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "incremental-1").BaseBackup("full").Result(),
+		builder.ForBackup(velerov1api.DefaultNamespace, "incremental-3").BaseBackup("incremental-1").Result(),
+	}
+
+	assert.Equal(t, []string{"incremental-1", "incremental-2"}, incrementalDependents(backups[0], backups))
+	assert.Equal(t, []string{"incremental-3"}, incrementalDependents(backups[2], backups))
+	assert.Empty(t, incrementalDependents(backups[3], backups))
+}
@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// The reasons of the events recorded for the lifecycle transitions of backups
+// and restores. Backups and restores use the same reasons, so that the events
+// can be matched on regardless of the kind of object they're for.
+const (
+	LifecycleReasonStarted          = "Started"
+	LifecycleReasonSnapshotting     = "Snapshotting"
+	LifecycleReasonUploading        = "Uploading"
+	LifecycleReasonCompleted        = "Completed"
+	LifecycleReasonPartiallyFailed  = "PartiallyFailed"
+	LifecycleReasonFailed           = "Failed"
+	LifecycleReasonFailedValidation = "FailedValidation"
+	LifecycleReasonCancelled        = "Cancelled"
+)
+
+// lifecycleObject is a backup or restore that lifecycle events are recorded
+// for.
+type lifecycleObject interface {
+	runtime.Object
+	metav1.Object
+}
+
+// lifecycleEventRecorder records the events for the lifecycle transitions of
+// backups and restores. Each reason is recorded at most once for an object,
+// so that a backup or restore that's processed again, such as after its
+// status failed to update, doesn't repeat its timeline. Beyond that, the
+// event recorder aggregates similar events and rate-limits the events of
+// each object. A nil lifecycleEventRecorder records nothing.
+type lifecycleEventRecorder struct {
+	recorder record.EventRecorder
+
+	lock     sync.Mutex
+	recorded map[types.UID]map[string]struct{}
+}
+
+func newLifecycleEventRecorder(recorder record.EventRecorder) *lifecycleEventRecorder {
+	if recorder == nil {
+		return nil
+	}
+	return &lifecycleEventRecorder{
+		recorder: recorder,
+		recorded: make(map[types.UID]map[string]struct{}),
+	}
+}
+
+// record records an event for an object's lifecycle transition, unless one
+// with the same reason has already been recorded for it. Events with a
+// failure reason are warnings. Once a final event has been recorded for an
+// object, it's forgotten.
+func (r *lifecycleEventRecorder) record(obj lifecycleObject, reason, messageFmt string, args ...interface{}) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	recorded, ok := r.recorded[obj.GetUID()]
+	if !ok {
+		recorded = make(map[string]struct{})
+		r.recorded[obj.GetUID()] = recorded
+	}
+	_, duplicate := recorded[reason]
+	recorded[reason] = struct{}{}
+
+	final := true
+	switch reason {
+	case LifecycleReasonStarted, LifecycleReasonSnapshotting, LifecycleReasonUploading:
+		final = false
+	}
+	if final {
+		delete(r.recorded, obj.GetUID())
+	}
+	r.lock.Unlock()
+
+	if duplicate {
+		return
+	}
+
+	eventType := corev1api.EventTypeNormal
+	switch reason {
+	case LifecycleReasonPartiallyFailed, LifecycleReasonFailed, LifecycleReasonFailedValidation:
+		eventType = corev1api.EventTypeWarning
+	}
+	r.recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
+
+// lifecycleDuration returns how long a backup or restore that started at
+// start has taken by now, rounded to the second.
+func lifecycleDuration(start *metav1.Time, now time.Time) time.Duration {
+	if start == nil {
+		return 0
+	}
+	return now.Sub(start.Time).Round(time.Second)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestLifecycleEventRecorder(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	events := newLifecycleEventRecorder(fakeRecorder)
+
+	backup := builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithUID("uid-1")).Result()
+	events.record(backup, LifecycleReasonStarted, "Backup started, storing it in backup storage location %s", "default")
+	// processing a backup again doesn't repeat its events.
+	events.record(backup, LifecycleReasonStarted, "Backup started, storing it in backup storage location %s", "default")
+	events.record(backup, LifecycleReasonUploading, "Uploading backup of %d items to backup storage location %s", 3, "default")
+	events.record(backup, LifecycleReasonPartiallyFailed, "Backup partially failed in %v: %d items backed up, %d errors, %d warnings", time.Minute, 3, 1, 0)
+
+	// a final event forgets the backup.
+	assert.Empty(t, events.recorded)
+
+	close(fakeRecorder.Events)
+	var got []string
+	for event := range fakeRecorder.Events {
+		got = append(got, event)
+	}
+	assert.Equal(t, []string{
+		"Normal Started Backup started, storing it in backup storage location default",
+		"Normal Uploading Uploading backup of 3 items to backup storage location default",
+		"Warning PartiallyFailed Backup partially failed in 1m0s: 3 items backed up, 1 errors, 0 warnings",
+	}, got)
+
+	// a nil recorder records nothing.
+	events = newLifecycleEventRecorder(nil)
+	assert.Nil(t, events)
+	events.record(backup, LifecycleReasonStarted, "Backup started")
+}
+
+func TestLifecycleDuration(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), lifecycleDuration(nil, now))
+	assert.Equal(t, 90*time.Second, lifecycleDuration(&metav1.Time{Time: now.Add(-90*time.Second - 300*time.Millisecond)}, now))
+}
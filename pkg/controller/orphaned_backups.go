@@ -0,0 +1,182 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+)
+
+// defaultOrphanCleanupGracePeriod is how long a backup must stay orphaned
+// before it's deleted if the delete policy doesn't set a grace period.
+const defaultOrphanCleanupGracePeriod = 24 * time.Hour
+
+// cleanUpOrphanedBackups deletes the location's backups that have had no
+// Backup in the cluster for the delete policy's grace period, after emitting
+// an event on the location listing them when they're first found orphaned.
+// Backups that a sync would bring back into the cluster are only orphaned
+// until it does, so in practice these are backups whose metadata can't be
+// read, or the backups of locations with sync disabled. Backups outside the
+// sync filter's scope are never orphans, since they're never synced.
+func (r *BackupStorageLocationReconciler) cleanUpOrphanedBackups(location *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
+	policy := location.Spec.DeletePolicy
+	if policy == nil || !policy.OrphanCleanup {
+		delete(r.orphanedBackups, location.Name)
+		return nil
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly && !policy.DryRun {
+		log.Debug("Backup storage location is read-only, not cleaning up orphaned backups")
+		return nil
+	}
+
+	if r.ClusterName != "" {
+		backupStore = backupStore.ForCluster(r.ClusterName)
+	}
+
+	// the location is listed before the cluster, so that a backup that's
+	// created in between is in the cluster's list.
+	storeBackups, err := backupStore.ListBackups()
+	if err != nil {
+		return errors.Wrap(err, "error listing backups in backup store")
+	}
+
+	// backups can be in any namespace, and one with the name of a backup in
+	// the location is enough for it not to be orphaned.
+	backupList := new(velerov1api.BackupList)
+	if err := r.Client.List(r.Ctx, backupList); err != nil {
+		return errors.Wrap(err, "error listing backups in cluster")
+	}
+	clusterBackups := sets.NewString()
+	for _, backup := range backupList.Items {
+		clusterBackups.Insert(backup.Name)
+	}
+
+	storeBackups = r.backupsInSyncScope(backupStore, storeBackups, clusterBackups, log)
+
+	if r.orphanedBackups == nil {
+		r.orphanedBackups = make(map[string]map[string]time.Time)
+	}
+	if r.orphanedBackups[location.Name] == nil {
+		r.orphanedBackups[location.Name] = make(map[string]time.Time)
+	}
+
+	gracePeriod := defaultOrphanCleanupGracePeriod
+	if policy.GracePeriod != nil {
+		gracePeriod = policy.GracePeriod.Duration
+	}
+
+	found, due := updateOrphanedBackups(r.orphanedBackups[location.Name], storeBackups, clusterBackups, time.Now(), gracePeriod)
+
+	if len(found) > 0 {
+		message := fmt.Sprintf("Found %d backups with no Backup in the cluster, which will be deleted if they're still orphaned after %s: %s", len(found), gracePeriod, strings.Join(found, ", "))
+		if policy.DryRun {
+			message = fmt.Sprintf("Found %d backups with no Backup in the cluster, which aren't deleted because deletePolicy.dryRun is set: %s", len(found), strings.Join(found, ", "))
+		}
+		log.WithField("backups", strings.Join(found, ", ")).Warn("Found orphaned backups")
+		r.EventRecorder.Event(location, corev1api.EventTypeWarning, "OrphanedBackupsFound", message)
+	}
+
+	if len(due) == 0 {
+		return nil
+	}
+
+	if policy.DryRun {
+		log.WithField("backups", strings.Join(due, ", ")).Info("Not deleting orphaned backups because deletePolicy.dryRun is set")
+		return nil
+	}
+
+	var deleted []string
+	for _, name := range due {
+		if err := backupStore.DeleteBackup(name); err != nil {
+			log.WithError(err).WithField("backup", name).Error("Error deleting orphaned backup")
+			continue
+		}
+		log.WithField("backup", name).Info("Deleted orphaned backup")
+		delete(r.orphanedBackups[location.Name], name)
+		deleted = append(deleted, name)
+	}
+
+	if len(deleted) > 0 {
+		r.EventRecorder.Event(location, corev1api.EventTypeNormal, "OrphanedBackupsDeleted", fmt.Sprintf("Deleted %d orphaned backups: %s", len(deleted), strings.Join(deleted, ", ")))
+	}
+	return nil
+}
+
+// backupsInSyncScope returns the backups in the location that are in the
+// sync filter's scope. The metadata of the backups that aren't in the cluster
+// is read to match their labels, and if it can't be, a backup is only in scope
+// if the filter has no label selector.
+func (r *BackupStorageLocationReconciler) backupsInSyncScope(backupStore persistence.BackupStore, storeBackups []string, clusterBackups sets.String, log logrus.FieldLogger) []string {
+	var inScope []string
+	for _, name := range storeBackups {
+		if !r.SyncFilter.hasName(name) {
+			continue
+		}
+
+		if r.SyncFilter.LabelSelector != nil && !clusterBackups.Has(name) {
+			backup, err := backupStore.GetBackupMetadata(name)
+			if err != nil {
+				log.WithError(err).WithField("backup", name).Debug("Unable to match backup's labels to the sync filter, so it isn't cleaned up")
+				continue
+			}
+			if !r.SyncFilter.matchesLabels(backup) {
+				continue
+			}
+		}
+
+		inScope = append(inScope, name)
+	}
+	return inScope
+}
+
+// updateOrphanedBackups records in orphans when each of the backups in the
+// location that isn't in the cluster was first found orphaned, forgetting
+// the ones that are no longer orphaned. It returns the backups that are
+// newly orphaned, and those that have been orphaned for at least gracePeriod.
+func updateOrphanedBackups(orphans map[string]time.Time, storeBackups []string, clusterBackups sets.String, now time.Time, gracePeriod time.Duration) (found, due []string) {
+	orphaned := sets.NewString(storeBackups...).Difference(clusterBackups)
+
+	for name := range orphans {
+		if !orphaned.Has(name) {
+			delete(orphans, name)
+		}
+	}
+
+	for _, name := range orphaned.List() {
+		firstFound, ok := orphans[name]
+		if !ok {
+			orphans[name] = now
+			found = append(found, name)
+			firstFound = now
+		}
+		if !now.Before(firstFound.Add(gracePeriod)) {
+			due = append(due, name)
+		}
+	}
+
+	return found, due
+}
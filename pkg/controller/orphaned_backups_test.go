@@ -0,0 +1,208 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestUpdateOrphanedBackups(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	orphans := map[string]time.Time{
+		"backup-1": now.Add(-2 * time.Hour),
+		"backup-2": now.Add(-30 * time.Minute),
+		"backup-3": now.Add(-2 * time.Hour),
+	}
+
+	found, due := updateOrphanedBackups(orphans, []string{"backup-1", "backup-2", "backup-3", "backup-4", "backup-5"}, sets.NewString("backup-3", "backup-5"), now, time.Hour)
+	assert.Equal(t, []string{"backup-4"}, found)
+	assert.Equal(t, []string{"backup-1"}, due)
+
+	// backup-3 is forgotten now that it's in the cluster, so it's only found
+	// again if it's orphaned again.
+	assert.Equal(t, map[string]time.Time{
+		"backup-1": now.Add(-2 * time.Hour),
+		"backup-2": now.Add(-30 * time.Minute),
+		"backup-4": now,
+	}, orphans)
+
+	// with no grace period, a backup is due as soon as it's found.
+	found, due = updateOrphanedBackups(map[string]time.Time{}, []string{"backup-1"}, sets.NewString(), now, 0)
+	assert.Equal(t, []string{"backup-1"}, found)
+	assert.Equal(t, []string{"backup-1"}, due)
+}
+
+func TestCleanUpOrphanedBackups(t *testing.T) {
+	tests := []struct {
+		name          string
+		deletePolicy  *velerov1api.BackupStorageLocationDeletePolicy
+		orphans       map[string]time.Time
+		expectDeleted []string
+		expectEvents  []string
+	}{
+		{
+			name: "orphan cleanup is disabled by default",
+		},
+		{
+			name:         "newly orphaned backups are reported and not deleted until the grace period has passed",
+			deletePolicy: &velerov1api.BackupStorageLocationDeletePolicy{OrphanCleanup: true},
+			expectEvents: []string{
+				"Warning OrphanedBackupsFound Found 2 backups with no Backup in the cluster, which will be deleted if they're still orphaned after 24h0m0s: backup-2, backup-3",
+			},
+		},
+		{
+			name:          "backups orphaned for the grace period are deleted",
+			deletePolicy:  &velerov1api.BackupStorageLocationDeletePolicy{OrphanCleanup: true, GracePeriod: &metav1.Duration{Duration: time.Hour}},
+			orphans:       map[string]time.Time{"backup-2": time.Now().Add(-2 * time.Hour)},
+			expectDeleted: []string{"backup-2"},
+			expectEvents: []string{
+				"Warning OrphanedBackupsFound Found 1 backups with no Backup in the cluster, which will be deleted if they're still orphaned after 1h0m0s: backup-3",
+				"Normal OrphanedBackupsDeleted Deleted 1 orphaned backups: backup-2",
+			},
+		},
+		{
+			name:         "dry run doesn't delete backups",
+			deletePolicy: &velerov1api.BackupStorageLocationDeletePolicy{OrphanCleanup: true, DryRun: true, GracePeriod: &metav1.Duration{Duration: time.Hour}},
+			orphans:      map[string]time.Time{"backup-2": time.Now().Add(-2 * time.Hour)},
+			expectEvents: []string{
+				"Warning OrphanedBackupsFound Found 1 backups with no Backup in the cluster, which aren't deleted because deletePolicy.dryRun is set: backup-3",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").DeletePolicy(tc.deletePolicy).Result()
+			recorder := record.NewFakeRecorder(10)
+
+			r := &BackupStorageLocationReconciler{
+				Ctx: context.Background(),
+				Client: velerotest.NewFakeControllerRuntimeClient(t,
+					builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result(),
+				),
+				EventRecorder: recorder,
+				Log:           velerotest.NewLogger(),
+			}
+			if tc.orphans != nil {
+				r.orphanedBackups = map[string]map[string]time.Time{location.Name: tc.orphans}
+			}
+
+			backupStore := new(persistencemocks.BackupStore)
+			backupStore.On("ListBackups").Return([]string{"backup-1", "backup-2", "backup-3"}, nil)
+			for _, name := range tc.expectDeleted {
+				backupStore.On("DeleteBackup", name).Return(nil)
+			}
+
+			require.NoError(t, r.cleanUpOrphanedBackups(location, backupStore, r.Log))
+			close(recorder.Events)
+
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			assert.Equal(t, tc.expectEvents, events)
+
+			backupStore.AssertNumberOfCalls(t, "DeleteBackup", len(tc.expectDeleted))
+			for _, name := range tc.expectDeleted {
+				assert.NotContains(t, r.orphanedBackups[location.Name], name)
+			}
+		})
+	}
+}
+
+func TestCleanUpOrphanedBackupsWithSyncFilter(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").
+		DeletePolicy(&velerov1api.BackupStorageLocationDeletePolicy{OrphanCleanup: true, GracePeriod: &metav1.Duration{Duration: time.Hour}}).
+		Result()
+	orphanedAt := time.Now().Add(-2 * time.Hour)
+
+	tests := []struct {
+		name          string
+		syncFilter    BackupSyncFilter
+		metadata      map[string]*velerov1api.Backup
+		expectDeleted []string
+	}{
+		{
+			name:          "sibling cluster's backups outside the prefix aren't orphans",
+			syncFilter:    BackupSyncFilter{Prefix: "cluster-a-"},
+			expectDeleted: []string{"cluster-a-2"},
+		},
+		{
+			name:       "backups whose labels don't match the label selector aren't orphans",
+			syncFilter: BackupSyncFilter{Prefix: "cluster-a-", LabelSelector: labels.SelectorFromSet(labels.Set{"team": "payments"})},
+			metadata: map[string]*velerov1api.Backup{
+				"cluster-a-2": builder.ForBackup(velerov1api.DefaultNamespace, "cluster-a-2").ObjectMeta(builder.WithLabels("team", "other")).Result(),
+			},
+		},
+		{
+			name:       "backups whose labels match the label selector are orphans",
+			syncFilter: BackupSyncFilter{Prefix: "cluster-a-", LabelSelector: labels.SelectorFromSet(labels.Set{"team": "payments"})},
+			metadata: map[string]*velerov1api.Backup{
+				"cluster-a-2": builder.ForBackup(velerov1api.DefaultNamespace, "cluster-a-2").ObjectMeta(builder.WithLabels("team", "payments")).Result(),
+			},
+			expectDeleted: []string{"cluster-a-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &BackupStorageLocationReconciler{
+				Ctx: context.Background(),
+				Client: velerotest.NewFakeControllerRuntimeClient(t,
+					builder.ForBackup(velerov1api.DefaultNamespace, "cluster-a-1").Result(),
+				),
+				SyncFilter:    tc.syncFilter,
+				EventRecorder: record.NewFakeRecorder(10),
+				Log:           velerotest.NewLogger(),
+				orphanedBackups: map[string]map[string]time.Time{
+					location.Name: {"cluster-a-2": orphanedAt, "cluster-b-1": orphanedAt},
+				},
+			}
+
+			backupStore := new(persistencemocks.BackupStore)
+			backupStore.On("ListBackups").Return([]string{"cluster-a-1", "cluster-a-2", "cluster-b-1"}, nil)
+			for name, backup := range tc.metadata {
+				backupStore.On("GetBackupMetadata", name).Return(backup, nil)
+			}
+			for _, name := range tc.expectDeleted {
+				backupStore.On("DeleteBackup", name).Return(nil)
+			}
+
+			require.NoError(t, r.cleanUpOrphanedBackups(location, backupStore, r.Log))
+
+			backupStore.AssertNumberOfCalls(t, "DeleteBackup", len(tc.expectDeleted))
+			backupStore.AssertNotCalled(t, "DeleteBackup", "cluster-b-1")
+			backupStore.AssertNotCalled(t, "GetBackupMetadata", "cluster-a-1")
+			assert.NotContains(t, r.orphanedBackups[location.Name], "cluster-b-1")
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -62,6 +63,7 @@ type podVolumeBackupController struct {
 	nodeName              string
 	metrics               *metrics.ServerMetrics
 	credentialsFileStore  credentials.FileStore
+	newPluginManager      func(logrus.FieldLogger) clientmgmt.Manager
 
 	processBackupFunc func(*velerov1api.PodVolumeBackup) error
 	fileSystem        filesystem.Interface
@@ -80,6 +82,7 @@ func NewPodVolumeBackupController(
 	kbClient client.Client,
 	nodeName string,
 	credentialsFileStore credentials.FileStore,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 ) Interface {
 	c := &podVolumeBackupController{
 		genericController:     newGenericController(PodVolumeBackup, logger),
@@ -92,6 +95,7 @@ func NewPodVolumeBackupController(
 		nodeName:              nodeName,
 		metrics:               metrics,
 		credentialsFileStore:  credentialsFileStore,
+		newPluginManager:      newPluginManager,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -190,6 +194,9 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 
 	log.Info("Backup starting")
 
+	c.metrics.RegisterPodVolumeBackupStart(c.nodeName)
+	defer c.metrics.RegisterPodVolumeBackupEnd(c.nodeName)
+
 	var err error
 
 	// update status to InProgress
@@ -208,13 +215,26 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		return c.fail(req, errors.Wrap(err, "error getting pod").Error(), log)
 	}
 
-	volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
-	if err != nil {
-		log.WithError(err).Error("Error getting volume directory name")
-		return c.fail(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
-	}
+	isBlock := req.Spec.VolumeMode == velerov1api.PodVolumeModeBlock
 
-	pathGlob := fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir)
+	var pathGlob string
+	if isBlock {
+		// raw block volumes aren't mounted, they're mapped to a device under
+		// the pod's volumeDevices directory instead.
+		deviceName, err := kube.GetVolumeDeviceName(pod, req.Spec.Volume, c.pvcLister)
+		if err != nil {
+			log.WithError(err).Error("Error getting volume device name")
+			return c.fail(req, errors.Wrap(err, "error getting volume device name").Error(), log)
+		}
+		pathGlob = fmt.Sprintf("/host_pods/%s/volumeDevices/*/%s", string(req.Spec.Pod.UID), deviceName)
+	} else {
+		volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
+		if err != nil {
+			log.WithError(err).Error("Error getting volume directory name")
+			return c.fail(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
+		}
+		pathGlob = fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir)
+	}
 	log.WithField("pathGlob", pathGlob).Debug("Looking for path matching glob")
 
 	path, err := singlePathMatch(pathGlob)
@@ -224,21 +244,41 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	}
 	log.WithField("path", path).Debugf("Found path matching glob")
 
+	if isBlock {
+		if path, err = resolveVolumeDevice(path); err != nil {
+			log.WithError(err).Error("Error resolving volume device")
+			return c.fail(req, err.Error(), log)
+		}
+		log.WithField("path", path).Debug("Resolved volume device")
+	}
+
 	// temp creds
 	credentialsFile, err := c.credentialsFileStore.Path(restic.RepoKeySelector())
 	if err != nil {
 		log.WithError(err).Error("Error creating temp restic credentials file")
 		return c.fail(req, errors.Wrap(err, "error creating temp restic credentials file").Error(), log)
 	}
-	// ignore error since there's nothing we can do and it's a temp file.
-	defer os.Remove(credentialsFile)
-
-	resticCmd := restic.BackupCommand(
-		req.Spec.RepoIdentifier,
-		credentialsFile,
-		path,
-		req.Spec.Tags,
-	)
+	// the credentials file isn't removed since it's shared by all of the
+	// backups running concurrently on this node, and is rewritten by each.
+
+	var resticCmd *restic.Command
+	if isBlock {
+		// the device is stored in the snapshot as a file named after the
+		// volume, which is what's dumped when it's restored.
+		resticCmd = restic.BlockBackupCommand(
+			req.Spec.RepoIdentifier,
+			credentialsFile,
+			req.Spec.Volume,
+			req.Spec.Tags,
+		)
+	} else {
+		resticCmd = restic.BackupCommand(
+			req.Spec.RepoIdentifier,
+			credentialsFile,
+			path,
+			req.Spec.Tags,
+		)
+	}
 
 	backupLocation := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
@@ -271,7 +311,8 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	// if the pod using the PVC (and therefore the directory path under /host_pods/) has
 	// changed since the PVC's last backup, restic will not be able to identify a suitable
 	// parent snapshot to use, and will have to do a full rescan of the contents of the PVC.
-	if pvcUID, ok := req.Labels[velerov1api.PVCUIDLabel]; ok {
+	// Block devices are always read in full, so they don't use a parent snapshot.
+	if pvcUID, ok := req.Labels[velerov1api.PVCUIDLabel]; ok && !isBlock {
 		parentSnapshotID := getParentSnapshot(log, pvcUID, req.Spec.BackupStorageLocation, c.podVolumeBackupLister.PodVolumeBackups(req.Namespace))
 		if parentSnapshotID == "" {
 			log.Info("No parent snapshot found for PVC, not using --parent flag for this backup")
@@ -284,7 +325,12 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	var stdout, stderr string
 
 	var emptySnapshot bool
-	if stdout, stderr, err = restic.RunBackup(resticCmd, log, c.updateBackupProgressFunc(req, log)); err != nil {
+	if isBlock {
+		stdout, stderr, err = restic.RunBlockBackup(resticCmd, path, log, c.updateBackupProgressFunc(req, log))
+	} else {
+		stdout, stderr, err = restic.RunBackup(resticCmd, log, c.updateBackupProgressFunc(req, log))
+	}
+	if err != nil {
 		if strings.Contains(stderr, "snapshot is empty") {
 			emptySnapshot = true
 		} else {
@@ -295,7 +341,15 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 
 	var snapshotID string
+	var bytesAdded int64
+	var dataDigests map[string]string
 	if !emptySnapshot {
+		// the bytes added to the repository are only informational, so
+		// failing to get them doesn't fail the backup.
+		if bytesAdded, err = restic.GetBackupDataAdded(stdout); err != nil {
+			log.WithError(err).Warn("Error getting the number of bytes added to the restic repository")
+		}
+
 		cmd := restic.GetSnapshotCommand(req.Spec.RepoIdentifier, credentialsFile, req.Spec.Tags)
 		cmd.Env = env
 		cmd.CACertFile = caCertFile
@@ -305,6 +359,16 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 			log.WithError(err).Error("Error getting SnapshotID")
 			return c.fail(req, errors.Wrap(err, "error getting snapshot id").Error(), log)
 		}
+
+		// the digests are of the volume's data as it is now, so they only
+		// match the snapshot if the data didn't change while it was taken.
+		// They're computed over files, so block devices don't have them.
+		if !isBlock {
+			if dataDigests, err = computeDataDigests(c.newPluginManager, path, log); err != nil {
+				log.WithError(err).Error("Error computing data digests")
+				return c.fail(req, err.Error(), log)
+			}
+		}
 	}
 
 	// update status to Completed with path & snapshot id
@@ -312,6 +376,8 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 		r.Status.Path = path
 		r.Status.Phase = velerov1api.PodVolumeBackupPhaseCompleted
 		r.Status.SnapshotID = snapshotID
+		r.Status.RepositoryBytesAdded = bytesAdded
+		r.Status.DataDigests = dataDigests
 		r.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
 		if emptySnapshot {
 			r.Status.Message = "volume was empty so no snapshot was taken"
@@ -432,6 +498,23 @@ func (c *podVolumeBackupController) fail(req *velerov1api.PodVolumeBackup, msg s
 	return nil
 }
 
+// resolveVolumeDevice resolves the path of a raw block volume's device in a
+// pod's volumeDevices directory. Kubelet creates it as a symlink to an
+// absolute path on the host, under /dev or kubelet's plugins directory, which
+// only resolves if the daemonset mounts that path at the same path, as it
+// does when it's installed with --restic-block-volumes.
+func resolveVolumeDevice(path string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		if target, linkErr := os.Readlink(path); linkErr == nil {
+			return "", errors.Wrapf(err, "error resolving volume device %s, which links to %s on the host; the restic daemonset must mount it at the same path", path, target)
+		}
+		return "", errors.Wrapf(err, "error resolving volume device %s", path)
+	}
+
+	return resolved, nil
+}
+
 func singlePathMatch(path string) (string, error) {
 	matches, err := filepath.Glob(path)
 	if err != nil {
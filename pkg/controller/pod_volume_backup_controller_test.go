@@ -17,6 +17,9 @@ limitations under the License.
 package controller
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -171,3 +174,33 @@ func TestPVBHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveVolumeDevice(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the temp dir may itself be under a link.
+	dir, err = filepath.EvalSymlinks(dir)
+	require.NoError(t, err)
+
+	device := filepath.Join(dir, "device")
+	require.NoError(t, ioutil.WriteFile(device, nil, 0600))
+	require.NoError(t, os.Symlink(device, filepath.Join(dir, "pv-1")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, "pv-2")))
+
+	// links are resolved to their targets.
+	resolved, err := resolveVolumeDevice(filepath.Join(dir, "pv-1"))
+	require.NoError(t, err)
+	assert.Equal(t, device, resolved)
+
+	// devices that aren't links are used as they are.
+	resolved, err = resolveVolumeDevice(device)
+	require.NoError(t, err)
+	assert.Equal(t, device, resolved)
+
+	// links to paths that aren't mounted say where they link to.
+	_, err = resolveVolumeDevice(filepath.Join(dir, "pv-2"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "which links to "+filepath.Join(dir, "missing")+" on the host")
+}
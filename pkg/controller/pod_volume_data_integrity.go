@@ -0,0 +1,100 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+// computeDataDigests runs every backup data integrity plugin on the pod
+// volume data at path, returning the digests they computed keyed by plugin
+// name, or nil if there aren't any plugins.
+func computeDataDigests(newPluginManager func(logrus.FieldLogger) clientmgmt.Manager, path string, log logrus.FieldLogger) (map[string]string, error) {
+	if newPluginManager == nil {
+		return nil, nil
+	}
+
+	pluginManager := newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	integrities, err := pluginManager.GetBackupDataIntegrities()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup data integrity plugins")
+	}
+
+	names := make([]string, 0, len(integrities))
+	for name := range integrities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var digests map[string]string
+	for _, name := range names {
+		digest, err := integrities[name].ComputeDigest(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error computing data digest with plugin %s", name)
+		}
+
+		if digests == nil {
+			digests = make(map[string]string)
+		}
+		digests[name] = digest
+		log.WithField("plugin", name).Info("Computed data digest")
+	}
+
+	return digests, nil
+}
+
+// verifyDataDigests verifies the pod volume data at path against each of
+// digests, using the backup data integrity plugin that computed it. The
+// plugins for all of them must be installed.
+func verifyDataDigests(newPluginManager func(logrus.FieldLogger) clientmgmt.Manager, path string, digests map[string]string, log logrus.FieldLogger) error {
+	if len(digests) == 0 {
+		return nil
+	}
+	if newPluginManager == nil {
+		return errors.New("backup data integrity plugins aren't available")
+	}
+
+	pluginManager := newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	names := make([]string, 0, len(digests))
+	for name := range digests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		integrity, err := pluginManager.GetBackupDataIntegrity(name)
+		if err != nil {
+			return errors.Wrapf(err, "error getting backup data integrity plugin %s", name)
+		}
+
+		if err := integrity.VerifyDigest(path, digests[name]); err != nil {
+			return errors.Wrapf(err, "data failed verification with plugin %s", name)
+		}
+		log.WithField("plugin", name).Info("Verified data digest")
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestComputeDataDigests(t *testing.T) {
+	// no plugin manager, as when the restic server has no plugins
+	digests, err := computeDataDigests(nil, "/data", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Nil(t, digests)
+
+	sha, signer := new(providermocks.BackupDataIntegrity), new(providermocks.BackupDataIntegrity)
+	sha.On("ComputeDigest", "/data").Return("sha256:abc", nil)
+	signer.On("ComputeDigest", "/data").Return("sig", nil)
+
+	pluginManager := new(pluginmocks.Manager)
+	pluginManager.On("GetBackupDataIntegrities").Return(map[string]velero.BackupDataIntegrity{"example.io/sha": sha, "example.io/signer": signer}, nil)
+	pluginManager.On("CleanupClients").Return()
+	newPluginManager := func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+	digests, err = computeDataDigests(newPluginManager, "/data", velerotest.NewLogger())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"example.io/sha": "sha256:abc", "example.io/signer": "sig"}, digests)
+	pluginManager.AssertExpectations(t)
+
+	signer.On("ComputeDigest", "/other").Return("", errors.New("no key"))
+	sha.On("ComputeDigest", "/other").Return("sha256:def", nil)
+	_, err = computeDataDigests(newPluginManager, "/other", velerotest.NewLogger())
+	assert.EqualError(t, err, "error computing data digest with plugin example.io/signer: no key")
+}
+
+func TestVerifyDataDigests(t *testing.T) {
+	sha := new(providermocks.BackupDataIntegrity)
+	sha.On("VerifyDigest", "/data", "sha256:abc").Return(nil)
+	sha.On("VerifyDigest", "/data", "sha256:def").Return(errors.New("digest mismatch"))
+
+	pluginManager := new(pluginmocks.Manager)
+	pluginManager.On("GetBackupDataIntegrity", "example.io/sha").Return(sha, nil)
+	pluginManager.On("GetBackupDataIntegrity", "example.io/missing").Return(nil, errors.New("not found"))
+	pluginManager.On("CleanupClients").Return()
+	newPluginManager := func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+	tests := []struct {
+		name             string
+		newPluginManager func(logrus.FieldLogger) clientmgmt.Manager
+		digests          map[string]string
+		wantErr          string
+	}{
+		{
+			name: "volume without digests isn't verified",
+		},
+		{
+			name:    "volume with digests fails without a plugin manager",
+			digests: map[string]string{"example.io/sha": "sha256:abc"},
+			wantErr: "backup data integrity plugins aren't available",
+		},
+		{
+			name:             "matching digest is verified",
+			newPluginManager: newPluginManager,
+			digests:          map[string]string{"example.io/sha": "sha256:abc"},
+		},
+		{
+			name:             "mismatched digest fails",
+			newPluginManager: newPluginManager,
+			digests:          map[string]string{"example.io/sha": "sha256:def"},
+			wantErr:          "data failed verification with plugin example.io/sha: digest mismatch",
+		},
+		{
+			name:             "digest from a plugin that isn't installed fails",
+			newPluginManager: newPluginManager,
+			digests:          map[string]string{"example.io/missing": "x", "example.io/sha": "sha256:abc"},
+			wantErr:          "error getting backup data integrity plugin example.io/missing: not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifyDataDigests(tc.newPluginManager, "/data", tc.digests, velerotest.NewLogger())
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ import (
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -62,6 +63,7 @@ type podVolumeRestoreController struct {
 	kbClient               client.Client
 	nodeName               string
 	credentialsFileStore   credentials.FileStore
+	newPluginManager       func(logrus.FieldLogger) clientmgmt.Manager
 
 	processRestoreFunc func(*velerov1api.PodVolumeRestore) error
 	fileSystem         filesystem.Interface
@@ -79,6 +81,7 @@ func NewPodVolumeRestoreController(
 	kbClient client.Client,
 	nodeName string,
 	credentialsFileStore credentials.FileStore,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
 ) Interface {
 	c := &podVolumeRestoreController{
 		genericController:      newGenericController(PodVolumeRestore, logger),
@@ -90,6 +93,7 @@ func NewPodVolumeRestoreController(
 		kbClient:               kbClient,
 		nodeName:               nodeName,
 		credentialsFileStore:   credentialsFileStore,
+		newPluginManager:       newPluginManager,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -297,16 +301,29 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 		return c.failRestore(req, errors.Wrap(err, "error getting pod").Error(), log)
 	}
 
-	volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
-	if err != nil {
-		log.WithError(err).Error("Error getting volume directory name")
-		return c.failRestore(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
-	}
-
 	// execute the restore process
-	if err := c.restorePodVolume(req, volumeDir, log); err != nil {
-		log.WithError(err).Error("Error restoring volume")
-		return c.failRestore(req, errors.Wrap(err, "error restoring volume").Error(), log)
+	if req.Spec.VolumeMode == velerov1api.PodVolumeModeBlock {
+		deviceName, err := kube.GetVolumeDeviceName(pod, req.Spec.Volume, c.pvcLister)
+		if err != nil {
+			log.WithError(err).Error("Error getting volume device name")
+			return c.failRestore(req, errors.Wrap(err, "error getting volume device name").Error(), log)
+		}
+
+		if err := c.restoreBlockVolume(req, deviceName, log); err != nil {
+			log.WithError(err).Error("Error restoring block volume")
+			return c.failRestore(req, errors.Wrap(err, "error restoring block volume").Error(), log)
+		}
+	} else {
+		volumeDir, err := kube.GetVolumeDirectory(pod, req.Spec.Volume, c.pvcLister, c.pvLister)
+		if err != nil {
+			log.WithError(err).Error("Error getting volume directory name")
+			return c.failRestore(req, errors.Wrap(err, "error getting volume directory name").Error(), log)
+		}
+
+		if err := c.restorePodVolume(req, volumeDir, log); err != nil {
+			log.WithError(err).Error("Error restoring volume")
+			return c.failRestore(req, errors.Wrap(err, "error restoring volume").Error(), log)
+		}
 	}
 
 	// update status to Completed
@@ -346,47 +363,131 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 		volumePath,
 	)
 
+	caCertFile, err := c.setResticCmdEnv(req, resticCmd, log)
+	if caCertFile != "" {
+		// ignore error since there's nothing we can do and it's a temp file.
+		defer os.Remove(caCertFile)
+	}
+	if err != nil {
+		return c.failRestore(req, err.Error(), log)
+	}
+
+	var stdout, stderr string
+
+	if stdout, stderr, err = restic.RunRestore(resticCmd, log, c.updateRestoreProgressFunc(req, log)); err != nil {
+		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
+	}
+	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
+
+	// Verify the restored data before the done file is written, so the pod
+	// doesn't start with data that failed verification, and before the
+	// .velero directory is changed, since it was included in the digests.
+	if err := verifyDataDigests(c.newPluginManager, volumePath, req.Spec.DataDigests, log); err != nil {
+		return err
+	}
+
+	// Remove the .velero directory from the restored volume (it may contain done files from previous restores
+	// of this volume, which we don't want to carry over). If this fails for any reason, log and continue, since
+	// this is non-essential cleanup (the done files are named based on restore UID and the init container looks
+	// for the one specific to the restore being executed).
+	if err := os.RemoveAll(filepath.Join(volumePath, ".velero")); err != nil {
+		log.WithError(err).Warnf("error removing .velero directory from directory %s", volumePath)
+	}
+
+	return writeDoneFile(req, volumePath)
+}
+
+// restoreBlockVolume writes a block volume's backup to the raw block device
+// of its new volume. The device is written to whether or not the pod's
+// containers have started, but the restic init container blocks them from
+// starting until the done file is written.
+func (c *podVolumeRestoreController) restoreBlockVolume(req *velerov1api.PodVolumeRestore, deviceName string, log logrus.FieldLogger) error {
+	// Get the full path of the new volume's device as mapped in the daemonset pod, which
+	// will look like: /host_pods/<new-pod-uid>/volumeDevices/<volume-plugin-name>/<pv-name>
+	devicePath, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumeDevices/*/%s", string(req.Spec.Pod.UID), deviceName))
+	if err != nil {
+		return errors.Wrap(err, "error identifying path of volume device")
+	}
+	if devicePath, err = resolveVolumeDevice(devicePath); err != nil {
+		return err
+	}
+
+	// Block devices can't be mounted into the restic init container, so the
+	// done file is written to the volume's subdirectory of the emptyDir
+	// that's mounted in its place.
+	statusDir, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), restic.BlockRestoresVolume))
+	if err != nil {
+		return errors.Wrap(err, "error identifying path of block restores volume")
+	}
+
+	credsFile, err := c.credentialsFileStore.Path(restic.RepoKeySelector())
+	if err != nil {
+		return errors.Wrap(err, "error creating temp restic credentials file")
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(credsFile)
+
+	resticCmd := restic.DumpCommand(
+		req.Spec.RepoIdentifier,
+		credsFile,
+		req.Spec.SnapshotID,
+		req.Spec.Volume,
+	)
+
+	caCertFile, err := c.setResticCmdEnv(req, resticCmd, log)
+	if caCertFile != "" {
+		// ignore error since there's nothing we can do and it's a temp file.
+		defer os.Remove(caCertFile)
+	}
+	if err != nil {
+		return err
+	}
+
+	stderr, err := restic.RunBlockRestore(resticCmd, devicePath, log, c.updateRestoreProgressFunc(req, log))
+	if err != nil {
+		return errors.Wrapf(err, "error running restic dump, cmd=%s, stderr=%s", resticCmd.String(), stderr)
+	}
+	log.Debugf("Ran command=%s, stderr=%s", resticCmd.String(), stderr)
+
+	return writeDoneFile(req, filepath.Join(statusDir, req.Spec.Volume))
+}
+
+// setResticCmdEnv sets the CA cert file and environment that a restic command
+// needs to access the repository in the restore's backup storage location. It
+// returns the path of the CA cert file it wrote, if any, which the caller
+// should remove once the command has run.
+func (c *podVolumeRestoreController) setResticCmdEnv(req *velerov1api.PodVolumeRestore, resticCmd *restic.Command, log logrus.FieldLogger) (string, error) {
 	backupLocation := &velerov1api.BackupStorageLocation{}
 	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: req.Namespace,
 		Name:      req.Spec.BackupStorageLocation,
 	}, backupLocation); err != nil {
-		return c.failRestore(req, errors.Wrap(err, "error getting backup storage location").Error(), log)
+		return "", errors.Wrap(err, "error getting backup storage location")
 	}
 
 	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
 	var caCertFile string
 	if backupLocation.Spec.ObjectStorage != nil && backupLocation.Spec.ObjectStorage.CACert != nil {
+		var err error
 		caCertFile, err = restic.TempCACertFile(backupLocation.Spec.ObjectStorage.CACert, req.Spec.BackupStorageLocation, c.fileSystem)
 		if err != nil {
 			log.WithError(err).Error("Error creating temp cacert file")
 		}
-		// ignore error since there's nothing we can do and it's a temp file.
-		defer os.Remove(caCertFile)
 	}
 	resticCmd.CACertFile = caCertFile
 
 	env, err := restic.CmdEnv(backupLocation, c.credentialsFileStore)
 	if err != nil {
-		return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
+		return caCertFile, errors.Wrap(err, "error setting restic cmd env")
 	}
 	resticCmd.Env = env
 
-	var stdout, stderr string
-
-	if stdout, stderr, err = restic.RunRestore(resticCmd, log, c.updateRestoreProgressFunc(req, log)); err != nil {
-		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
-	}
-	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
-
-	// Remove the .velero directory from the restored volume (it may contain done files from previous restores
-	// of this volume, which we don't want to carry over). If this fails for any reason, log and continue, since
-	// this is non-essential cleanup (the done files are named based on restore UID and the init container looks
-	// for the one specific to the restore being executed).
-	if err := os.RemoveAll(filepath.Join(volumePath, ".velero")); err != nil {
-		log.WithError(err).Warnf("error removing .velero directory from directory %s", volumePath)
-	}
+	return caCertFile, nil
+}
 
+// writeDoneFile writes the done file for a pod volume restore to the .velero
+// directory within dir, which the restic init container waits for.
+func writeDoneFile(req *velerov1api.PodVolumeRestore, dir string) error {
 	var restoreUID types.UID
 	for _, owner := range req.OwnerReferences {
 		if boolptr.IsSetToTrue(owner.Controller) {
@@ -397,14 +498,14 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 
 	// Create the .velero directory within the volume dir so we can write a done file
 	// for this restore.
-	if err := os.MkdirAll(filepath.Join(volumePath, ".velero"), 0755); err != nil {
+	if err := os.MkdirAll(filepath.Join(dir, ".velero"), 0755); err != nil {
 		return errors.Wrap(err, "error creating .velero directory for done file")
 	}
 
 	// Write a done file with name=<restore-uid> into the just-created .velero dir
 	// within the volume. The velero restic init container on the pod is waiting
 	// for this file to exist in each restored volume before completing.
-	if err := ioutil.WriteFile(filepath.Join(volumePath, ".velero", string(restoreUID)), nil, 0644); err != nil {
+	if err := ioutil.WriteFile(filepath.Join(dir, ".velero", string(restoreUID)), nil, 0644); err != nil {
 		return errors.Wrap(err, "error writing done file")
 	}
 
@@ -20,23 +20,28 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -49,6 +54,11 @@ type resticRepositoryController struct {
 	kbClient                    client.Client
 	repositoryManager           restic.RepositoryManager
 	defaultMaintenanceFrequency time.Duration
+	metrics                     *metrics.ServerMetrics
+
+	// maintaining is the set of repositories whose maintenance is running.
+	maintaining     sets.String
+	maintainingLock sync.Mutex
 
 	clock clock.Clock
 }
@@ -61,6 +71,7 @@ func NewResticRepositoryController(
 	kbClient client.Client,
 	repositoryManager restic.RepositoryManager,
 	defaultMaintenanceFrequency time.Duration,
+	metrics *metrics.ServerMetrics,
 ) Interface {
 	c := &resticRepositoryController{
 		genericController:           newGenericController(ResticRepo, logger),
@@ -69,6 +80,8 @@ func NewResticRepositoryController(
 		kbClient:                    kbClient,
 		repositoryManager:           repositoryManager,
 		defaultMaintenanceFrequency: defaultMaintenanceFrequency,
+		metrics:                     metrics,
+		maintaining:                 sets.NewString(),
 
 		clock: &clock.RealClock{},
 	}
@@ -83,6 +96,17 @@ func NewResticRepositoryController(
 	resticRepositoryInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: c.enqueue,
+			// repositories whose maintenance has been requested are enqueued
+			// right away rather than on the next resync.
+			UpdateFunc: func(_, obj interface{}) {
+				repo, ok := obj.(*velerov1api.ResticRepository)
+				if !ok {
+					return
+				}
+				if _, requested := repo.Annotations[velerov1api.MaintenanceRequestedAnnotation]; requested {
+					c.enqueue(repo)
+				}
+			},
 		},
 	)
 
@@ -136,19 +160,29 @@ func (c *resticRepositoryController) processQueueItem(key string) error {
 		return c.initializeRepo(reqCopy, log)
 	}
 
+	// the repositories of read-only locations are never written to, so
+	// they aren't unlocked or maintained.
+	readOnly := c.isReadOnlyLocation(reqCopy)
+
 	// If the repository is ready or not-ready, check it for stale locks, but if
 	// this fails for any reason, it's non-critical so we still continue on to the
 	// rest of the "process" logic.
-	log.Debug("Checking repository for stale locks")
-	if err := c.repositoryManager.UnlockRepo(reqCopy); err != nil {
-		log.WithError(err).Error("Error checking repository for stale locks")
+	if !readOnly {
+		log.Debug("Checking repository for stale locks")
+		if err := c.repositoryManager.UnlockRepo(reqCopy); err != nil {
+			log.WithError(err).Error("Error checking repository for stale locks")
+		}
 	}
 
 	switch req.Status.Phase {
 	case velerov1api.ResticRepositoryPhaseReady:
+		if readOnly {
+			log.Debug("Not running maintenance on restic repository of a read-only backup storage location")
+			return nil
+		}
 		return c.runMaintenanceIfDue(reqCopy, log)
 	case velerov1api.ResticRepositoryPhaseNotReady:
-		return c.checkNotReadyRepo(reqCopy, log)
+		return c.checkNotReadyRepo(reqCopy, readOnly, log)
 	}
 
 	return nil
@@ -189,7 +223,8 @@ func (c *resticRepositoryController) initializeRepo(req *velerov1api.ResticRepos
 		return err
 	}
 
-	if err := ensureRepo(req, c.repositoryManager); err != nil {
+	readOnly := loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+	if err := ensureRepo(req, c.repositoryManager, readOnly); err != nil {
 		return c.patchResticRepository(req, repoNotReady(err.Error()))
 	}
 
@@ -200,15 +235,18 @@ func (c *resticRepositoryController) initializeRepo(req *velerov1api.ResticRepos
 }
 
 // ensureRepo checks to see if a repository exists, and attempts to initialize it if
-// it does not exist. An error is returned if the repository can't be connected to
-// or initialized.
-func ensureRepo(repo *velerov1api.ResticRepository, repoManager restic.RepositoryManager) error {
+// it does not exist, unless its backup storage location is read-only. An error is
+// returned if the repository can't be connected to or initialized.
+func ensureRepo(repo *velerov1api.ResticRepository, repoManager restic.RepositoryManager, readOnly bool) error {
 	if err := repoManager.ConnectToRepo(repo); err != nil {
 		// If the repository has not yet been initialized, the error message will always include
 		// the following string. This is the only scenario where we should try to initialize it.
 		// Other errors (e.g. "already locked") should be returned as-is since the repository
 		// does already exist, but it can't be connected to.
 		if strings.Contains(err.Error(), "Is there a repository at the following location?") {
+			if readOnly {
+				return errors.Errorf("restic repository %s doesn't exist, and isn't initialized because its backup storage location is read-only", repo.Name)
+			}
 			return repoManager.InitRepo(repo)
 		}
 
@@ -223,35 +261,112 @@ func (c *resticRepositoryController) runMaintenanceIfDue(req *velerov1api.Restic
 
 	now := c.clock.Now()
 
-	if !dueForMaintenance(req, now) {
+	due, err := dueForMaintenance(req, now)
+	if err != nil {
+		log.WithError(err).Warn("Not running maintenance")
+		return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+			r.Status.Message = err.Error()
+		})
+	}
+	if !due {
 		log.Debug("not due for maintenance")
 		return nil
 	}
 
+	key := kubeutil.NamespaceAndName(req)
+	if !c.startMaintenance(key) {
+		log.Info("Maintenance is already running on restic repository")
+		return nil
+	}
+	defer c.finishMaintenance(key)
+
 	log.Info("Running maintenance on restic repository")
 
-	// prune failures should be displayed in the `.status.message` field but
-	// should not cause the repo to move to `NotReady`.
+	// prune failures should be displayed in the `.status.message` field and
+	// the maintenance condition but should not cause the repo to move to
+	// `NotReady`.
 	log.Debug("Pruning repo")
-	if err := c.repositoryManager.PruneRepo(req); err != nil {
-		log.WithError(err).Warn("error pruning repository")
-		if patchErr := c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
-			r.Status.Message = err.Error()
-		}); patchErr != nil {
-			return patchErr
-		}
+	freedBytes, pruneErr := c.repositoryManager.PruneRepo(req)
+	duration := c.clock.Since(now)
+
+	c.metrics.RegisterResticRepoMaintenanceDuration(req.Name, duration.Seconds())
+	if freedBytes >= 0 {
+		c.metrics.SetResticRepoMaintenanceFreedBytes(req.Name, freedBytes)
 	}
 
-	return c.patchResticRepository(req, func(req *velerov1api.ResticRepository) {
-		req.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+	condition := metav1.Condition{
+		Type:               velerov1api.ResticRepositoryConditionMaintenanceSucceeded,
+		Status:             metav1.ConditionTrue,
+		Reason:             "MaintenanceSucceeded",
+		Message:            "Maintenance succeeded",
+		ObservedGeneration: req.Generation,
+		LastTransitionTime: metav1.Time{Time: now},
+	}
+	result := velerov1api.ResticRepositoryMaintenanceResultSucceeded
+	if pruneErr != nil {
+		log.WithError(pruneErr).Warn("error pruning repository")
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "MaintenanceFailed"
+		condition.Message = pruneErr.Error()
+		result = velerov1api.ResticRepositoryMaintenanceResultFailed
+	}
+
+	return c.patchResticRepository(req, func(r *velerov1api.ResticRepository) {
+		if pruneErr != nil {
+			r.Status.Message = pruneErr.Error()
+		}
+		r.Status.LastMaintenanceTime = &metav1.Time{Time: now}
+		r.Status.LastMaintenanceDuration = &metav1.Duration{Duration: duration}
+		r.Status.LastMaintenanceResult = result
+		meta.SetStatusCondition(&r.Status.Conditions, condition)
+		delete(r.Annotations, velerov1api.MaintenanceRequestedAnnotation)
 	})
 }
 
-func dueForMaintenance(req *velerov1api.ResticRepository, now time.Time) bool {
-	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
+// startMaintenance records that maintenance of the repository with the given
+// key is running, returning false if it already is.
+func (c *resticRepositoryController) startMaintenance(key string) bool {
+	c.maintainingLock.Lock()
+	defer c.maintainingLock.Unlock()
+
+	if c.maintaining.Has(key) {
+		return false
+	}
+	c.maintaining.Insert(key)
+	return true
+}
+
+func (c *resticRepositoryController) finishMaintenance(key string) {
+	c.maintainingLock.Lock()
+	defer c.maintainingLock.Unlock()
+
+	c.maintaining.Delete(key)
 }
 
-func (c *resticRepositoryController) checkNotReadyRepo(req *velerov1api.ResticRepository, log logrus.FieldLogger) error {
+// dueForMaintenance returns whether maintenance should run on a repository:
+// if it's been requested with the MaintenanceRequestedAnnotation, if it's
+// never run, or if the repository's maintenance schedule, or its maintenance
+// frequency if it has no schedule, says it's due.
+func dueForMaintenance(req *velerov1api.ResticRepository, now time.Time) (bool, error) {
+	if _, requested := req.Annotations[velerov1api.MaintenanceRequestedAnnotation]; requested {
+		return true, nil
+	}
+	if req.Status.LastMaintenanceTime == nil {
+		return true, nil
+	}
+
+	if req.Spec.MaintenanceSchedule != "" {
+		schedule, err := parseCronExpression(req.Spec.MaintenanceSchedule)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid maintenance schedule %q", req.Spec.MaintenanceSchedule)
+		}
+		return !schedule.Next(req.Status.LastMaintenanceTime.Time).After(now), nil
+	}
+
+	return req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now), nil
+}
+
+func (c *resticRepositoryController) checkNotReadyRepo(req *velerov1api.ResticRepository, readOnly bool, log logrus.FieldLogger) error {
 	// no identifier: can't possibly be ready, so just return
 	if req.Spec.ResticIdentifier == "" {
 		return nil
@@ -261,13 +376,26 @@ func (c *resticRepositoryController) checkNotReadyRepo(req *velerov1api.ResticRe
 
 	// we need to ensure it (first check, if check fails, attempt to init)
 	// because we don't know if it's been successfully initialized yet.
-	if err := ensureRepo(req, c.repositoryManager); err != nil {
+	if err := ensureRepo(req, c.repositoryManager, readOnly); err != nil {
 		return c.patchResticRepository(req, repoNotReady(err.Error()))
 	}
 
 	return c.patchResticRepository(req, repoReady())
 }
 
+// isReadOnlyLocation returns whether a repository's backup storage location
+// is read-only. If the location can't be found, it's assumed not to be.
+func (c *resticRepositoryController) isReadOnlyLocation(req *velerov1api.ResticRepository) bool {
+	loc := &velerov1api.BackupStorageLocation{}
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+		Namespace: req.Namespace,
+		Name:      req.Spec.BackupStorageLocation,
+	}, loc); err != nil {
+		return false
+	}
+	return loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+}
+
 func repoNotReady(msg string) func(*velerov1api.ResticRepository) {
 	return func(r *velerov1api.ResticRepository) {
 		r.Status.Phase = velerov1api.ResticRepositoryPhaseNotReady
@@ -0,0 +1,105 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestDueForMaintenance(t *testing.T) {
+	now := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	newRepo := func(lastMaintenance time.Time, frequency time.Duration, schedule string) *velerov1api.ResticRepository {
+		repo := &velerov1api.ResticRepository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "repo-1"},
+			Spec: velerov1api.ResticRepositorySpec{
+				MaintenanceFrequency: metav1.Duration{Duration: frequency},
+				MaintenanceSchedule:  schedule,
+			},
+		}
+		if !lastMaintenance.IsZero() {
+			repo.Status.LastMaintenanceTime = &metav1.Time{Time: lastMaintenance}
+		}
+		return repo
+	}
+
+	tests := []struct {
+		name    string
+		repo    *velerov1api.ResticRepository
+		want    bool
+		wantErr string
+	}{
+		{
+			name: "repository that's never been maintained is due",
+			repo: newRepo(time.Time{}, time.Hour, ""),
+			want: true,
+		},
+		{
+			name: "repository maintained within its frequency isn't due",
+			repo: newRepo(now.Add(-30*time.Minute), time.Hour, ""),
+		},
+		{
+			name: "repository maintained before its frequency is due",
+			repo: newRepo(now.Add(-2*time.Hour), time.Hour, ""),
+			want: true,
+		},
+		{
+			name: "schedule is used instead of frequency",
+			repo: newRepo(now.Add(-2*time.Hour), time.Hour, "0 2 * * *"),
+		},
+		{
+			name: "repository is due once its schedule has passed",
+			repo: newRepo(now.Add(-11*time.Hour), 7*24*time.Hour, "0 2 * * *"),
+			want: true,
+		},
+		{
+			name: "requested maintenance is due right away",
+			repo: func() *velerov1api.ResticRepository {
+				repo := newRepo(now.Add(-time.Minute), time.Hour, "0 2 * * *")
+				repo.Annotations = map[string]string{velerov1api.MaintenanceRequestedAnnotation: ""}
+				return repo
+			}(),
+			want: true,
+		},
+		{
+			name:    "invalid schedule errors",
+			repo:    newRepo(now.Add(-2*time.Hour), time.Hour, "every day"),
+			wantErr: `invalid maintenance schedule "every day"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			due, err := dueForMaintenance(tc.repo, now)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, due)
+		})
+	}
+}
@@ -26,29 +26,38 @@ import (
 	"io/ioutil"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
@@ -91,9 +100,12 @@ type restoreController struct {
 	metrics                *metrics.ServerMetrics
 	logFormat              logging.Format
 	clock                  clock.Clock
+	streamBackups          bool
+	restoreMemoryLimit     int64
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
+	events            *lifecycleEventRecorder
 }
 
 func NewRestoreController(
@@ -111,6 +123,9 @@ func NewRestoreController(
 	backupStoreGetter persistence.ObjectBackupStoreGetter,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
+	eventRecorder record.EventRecorder,
+	streamBackups bool,
+	restoreMemoryLimit int64,
 ) Interface {
 	c := &restoreController{
 		genericController:      newGenericController(Restore, logger),
@@ -126,11 +141,14 @@ func NewRestoreController(
 		metrics:                metrics,
 		logFormat:              logFormat,
 		clock:                  &clock.RealClock{},
+		streamBackups:          streamBackups,
+		restoreMemoryLimit:     restoreMemoryLimit,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
 		newPluginManager:  newPluginManager,
 		backupStoreGetter: backupStoreGetter,
+		events:            newLifecycleEventRecorder(eventRecorder),
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -252,14 +270,19 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	restore = updatedRestore.DeepCopy()
 
 	if restore.Status.Phase == api.RestorePhaseFailedValidation {
+		c.events.record(restore, LifecycleReasonFailedValidation, "Restore failed validation: %s", strings.Join(restore.Status.ValidationErrors, "; "))
 		return nil
 	}
 
+	c.events.record(restore, LifecycleReasonStarted, "Restore started from backup %s", restore.Spec.BackupName)
+
 	if err := c.runValidatedRestore(restore, info); err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
 		c.metrics.RegisterRestoreFailed(backupScheduleName)
+	} else if restore.Status.Phase == api.RestorePhaseCancelled {
+		c.logger.Debug("Restore cancelled")
 	} else if restore.Status.Errors > 0 {
 		c.logger.Debug("Restore partially failed")
 		restore.Status.Phase = api.RestorePhasePartiallyFailed
@@ -271,6 +294,8 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	}
 
 	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	c.recordRestoreCompletion(restore)
+
 	c.logger.Debug("Updating restore's final status")
 	if _, err = patchRestore(original, restore, c.restoreClient); err != nil {
 		c.logger.WithError(errors.WithStack(err)).Info("Error updating restore's final status")
@@ -279,12 +304,64 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	return nil
 }
 
+// recordRestoreCompletion records the event for a restore's final phase.
+func (c *restoreController) recordRestoreCompletion(restore *api.Restore) {
+	duration := lifecycleDuration(restore.Status.StartTimestamp, restore.Status.CompletionTimestamp.Time)
+
+	var itemsRestored int
+	if restore.Status.Progress != nil {
+		itemsRestored = restore.Status.Progress.ItemsRestored
+	}
+
+	switch restore.Status.Phase {
+	case api.RestorePhaseCompleted:
+		c.events.record(restore, LifecycleReasonCompleted, "Restore completed in %v: %d items restored, %d warnings",
+			duration, itemsRestored, restore.Status.Warnings)
+	case api.RestorePhasePartiallyFailed:
+		c.events.record(restore, LifecycleReasonPartiallyFailed, "Restore partially failed in %v: %d items restored, %d errors, %d warnings",
+			duration, itemsRestored, restore.Status.Errors, restore.Status.Warnings)
+	case api.RestorePhaseFailed:
+		c.events.record(restore, LifecycleReasonFailed, "Restore failed after %v: %s", duration, restore.Status.FailureReason)
+	case api.RestorePhaseCancelled:
+		c.events.record(restore, LifecycleReasonCancelled, "Restore cancelled after %v: %d items restored", duration, itemsRestored)
+	}
+}
+
 type backupInfo struct {
 	backup      *api.Backup
 	location    *velerov1api.BackupStorageLocation
 	backupStore persistence.BackupStore
 }
 
+// validateNamespaceConflictPolicy checks a restore's namespace conflict
+// policy. Recreate deletes existing namespaces, so it's only allowed when
+// the restore lists the namespaces it includes explicitly rather than
+// matching them with patterns.
+func validateNamespaceConflictPolicy(restore *api.Restore) []error {
+	switch restore.Spec.NamespaceConflictPolicy {
+	case "", api.NamespaceConflictPolicyMerge, api.NamespaceConflictPolicyFail:
+		return nil
+	case api.NamespaceConflictPolicyRecreate:
+	default:
+		return []error{errors.Errorf("unsupported policy %q, must be one of %s, %s or %s", restore.Spec.NamespaceConflictPolicy,
+			api.NamespaceConflictPolicyMerge, api.NamespaceConflictPolicyFail, api.NamespaceConflictPolicyRecreate)}
+	}
+
+	var errs []error
+	if len(restore.Spec.IncludedNamespaces) == 0 {
+		errs = append(errs, errors.New("Recreate requires the included namespaces to be listed explicitly"))
+	}
+	for _, namespace := range restore.Spec.IncludedNamespaces {
+		if strings.HasPrefix(namespace, "regex:") || strings.ContainsAny(namespace, "*?[{") {
+			errs = append(errs, errors.Errorf("Recreate requires the included namespaces to be listed explicitly, but %q is a pattern", namespace))
+		}
+	}
+	if boolptr.IsSetToTrue(restore.Spec.Resume) {
+		errs = append(errs, errors.New("Recreate can't be used when resuming a restore"))
+	}
+	return errs
+}
+
 func (c *restoreController) validateAndComplete(restore *api.Restore, pluginManager clientmgmt.Manager) backupInfo {
 	// add non-restorable resources to restore's excluded resources
 	excludedResources := sets.NewString(restore.Spec.ExcludedResources...)
@@ -312,6 +389,87 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the per-namespace included/excluded resources
+	for _, err := range pkgrestore.ValidateNamespaceResourceFilters(restore.Spec.NamespaceResourceFilters) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace resource filters: %v", err))
+	}
+
+	// validate included/excluded volumes
+	for _, err := range collections.ValidateIncludesExcludes(restore.Spec.IncludedVolumes, restore.Spec.ExcludedVolumes) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded volume lists: %v", err))
+	}
+
+	// validate the volume selector, if specified
+	if restore.Spec.VolumeSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(restore.Spec.VolumeSelector); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid volume selector: %v", err))
+		}
+	}
+
+	// validate the namespace mapping template, if specified
+	if mappingTemplate := restore.Spec.NamespaceMappingTemplate; mappingTemplate != nil {
+		if _, err := pkgrestore.ParseNamespaceMappingTemplate(mappingTemplate.Template); err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace mapping template: %v", err))
+		}
+		if mappingTemplate.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(mappingTemplate.Selector); err != nil {
+				restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace mapping template selector: %v", err))
+			}
+		}
+	}
+
+	// validate the resource priorities, if specified
+	if priorities := restore.Spec.ResourcePriorities; priorities != nil {
+		for _, err := range pkgrestore.ValidateResourcePriorities(priorities) {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid resource priorities: %v", err))
+		}
+	}
+
+	if restore.Spec.ResourceTimeout != nil && restore.Spec.ResourceTimeout.Duration < 0 {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Invalid resource timeout: must not be negative")
+	}
+
+	for _, err := range validateNamespaceConflictPolicy(restore) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace conflict policy: %v", err))
+	}
+
+	switch restore.Spec.ExistingResourcePolicy {
+	case "", api.ExistingResourcePolicyNone, api.ExistingResourcePolicyUpdateIfChanged,
+		api.ExistingResourcePolicyAddMissingKeys, api.ExistingResourcePolicyOverwriteKeys:
+	default:
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid existing resource policy: unsupported policy %q, must be one of %s, %s, %s or %s",
+			restore.Spec.ExistingResourcePolicy, api.ExistingResourcePolicyNone, api.ExistingResourcePolicyUpdateIfChanged,
+			api.ExistingResourcePolicyAddMissingKeys, api.ExistingResourcePolicyOverwriteKeys))
+	}
+
+	for _, err := range pkgrestore.ValidateGenerateNameOnConflict(restore.Spec.GenerateNameOnConflict) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid generate name on conflict resources: %v", err))
+	}
+
+	for _, err := range pkgrestore.ValidatePreferredAPIVersions(restore.Spec.PreferredAPIVersions) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid preferred API versions: %v", err))
+	}
+
+	for _, err := range pkgrestore.ValidateWaitForWorkloads(restore.Spec.WaitForWorkloads) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid wait for workloads: %v", err))
+	}
+
+	// validate the resource modifiers, if specified
+	if _, errs := c.getResourceModifiers(restore); len(errs) > 0 {
+		for _, err := range errs {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid resource modifiers: %v", err))
+		}
+	}
+
+	// warn about included/excluded patterns that shadow each other
+	log := c.logger.WithField("restore", kubeutil.NamespaceAndName(restore))
+	for _, warning := range collections.ValidateIncludesExcludesOverlap(restore.Spec.IncludedResources, restore.Spec.ExcludedResources) {
+		log.Warnf("Overlapping included/excluded resource lists: %v", warning)
+	}
+	for _, warning := range collections.ValidateIncludesExcludesOverlap(restore.Spec.IncludedNamespaces, restore.Spec.ExcludedNamespaces) {
+		log.Warnf("Overlapping included/excluded namespace lists: %v", warning)
+	}
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
@@ -349,11 +507,38 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		return backupInfo{}
 	}
 
+	// the secrets of a backup that redacts them can't be restored, since
+	// their values are gone. Excluding secrets by their singular name is
+	// also accepted, since it's resolved to secrets later.
+	if boolptr.IsSetToTrue(info.backup.Spec.RedactSecrets) {
+		resources := collections.NewIncludesExcludes().Includes(restore.Spec.IncludedResources...).Excludes(restore.Spec.ExcludedResources...)
+		if resources.ShouldInclude(kuberesource.Secrets.String()) && resources.ShouldInclude("secret") {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s has redacted secret values, so secrets must be excluded from the restore", info.backup.Name))
+			return backupInfo{}
+		}
+	}
+
 	// Fill in the ScheduleName so it's easier to consume for metrics.
 	if restore.Spec.ScheduleName == "" {
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	// if Resume is set, find the failed restore of the same backup to resume
+	if boolptr.IsSetToTrue(restore.Spec.Resume) {
+		restores, err := c.restoreLister.Restores(restore.Namespace).List(labels.Everything())
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Unable to list restores to resume")
+			return backupInfo{}
+		}
+
+		resumed := mostRecentResumableRestore(restores, restore)
+		if resumed == nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("No failed, partially failed or cancelled restores of backup %s found to resume", restore.Spec.BackupName))
+			return backupInfo{}
+		}
+		restore.Status.ResumedFrom = resumed.Name
+	}
+
 	return info
 }
 
@@ -396,6 +581,31 @@ func mostRecentCompletedBackup(backups []*api.Backup) *api.Backup {
 	return nil
 }
 
+// mostRecentResumableRestore returns the most recently started restore, other
+// than restore, that failed, partially failed or was cancelled restoring the
+// same backup, or nil if there isn't one.
+func mostRecentResumableRestore(restores []*api.Restore, restore *api.Restore) *api.Restore {
+	var resumable *api.Restore
+	for _, r := range restores {
+		if r.Name == restore.Name || r.Spec.BackupName != restore.Spec.BackupName {
+			continue
+		}
+		switch r.Status.Phase {
+		case api.RestorePhaseFailed, api.RestorePhasePartiallyFailed, api.RestorePhaseCancelled:
+		default:
+			continue
+		}
+		if r.Status.StartTimestamp == nil {
+			continue
+		}
+		if resumable == nil || r.Status.StartTimestamp.After(resumable.Status.StartTimestamp.Time) {
+			resumable = r
+		}
+	}
+
+	return resumable
+}
+
 // fetchBackupInfo checks the backup lister for a backup that matches the given name. If it doesn't
 // find it, it returns an error.
 // NOTE: We adjust fetchBackupInfo to also accept a namespace explicitly, which will be the namespace of the
@@ -407,10 +617,27 @@ func (c *restoreController) fetchBackupInfo(backupName, namespace string, plugin
 	}
 
 	location := &velerov1api.BackupStorageLocation{}
-	if err := c.kbClient.Get(context.Background(), client.ObjectKey{
+	err = c.kbClient.Get(context.Background(), client.ObjectKey{
 		Namespace: namespace,
 		Name:      backup.Spec.StorageLocation,
-	}, location); err != nil {
+	}, location)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return backupInfo{}, errors.WithStack(err)
+	}
+
+	// if the backup's storage location doesn't exist or is unavailable,
+	// restore from one of its mirror storage locations instead.
+	if err != nil || location.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable {
+		if mirror, mirrorStore := c.availableMirrorLocation(backup, pluginManager); mirror != nil {
+			c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Infof("Backup storage location %s is unavailable, using mirror storage location %s", backup.Spec.StorageLocation, mirror.Name)
+			return backupInfo{
+				backup:      backup,
+				location:    mirror,
+				backupStore: mirrorStore,
+			}, nil
+		}
+	}
+	if err != nil {
 		return backupInfo{}, errors.WithStack(err)
 	}
 
@@ -422,10 +649,83 @@ func (c *restoreController) fetchBackupInfo(backupName, namespace string, plugin
 	return backupInfo{
 		backup:      backup,
 		location:    location,
-		backupStore: backupStore,
+		backupStore: persistence.BackupStoreForBackup(backupStore, backup),
 	}, nil
 }
 
+// availableMirrorLocation returns the first of the backup's mirror storage
+// locations that's available and has a copy of the backup, and its backup
+// store, or nil if none of them do.
+func (c *restoreController) availableMirrorLocation(backup *velerov1api.Backup, pluginManager clientmgmt.Manager) (*velerov1api.BackupStorageLocation, persistence.BackupStore) {
+	failed := sets.NewString()
+	for _, status := range backup.Status.MirrorStatuses {
+		if status.Phase == velerov1api.BackupMirrorPhaseFailed {
+			failed.Insert(status.StorageLocation)
+		}
+	}
+
+	for _, name := range backup.Spec.MirrorStorageLocations {
+		log := c.logger.WithFields(logrus.Fields{
+			Backup:                  kubeutil.NamespaceAndName(backup),
+			"mirrorStorageLocation": name,
+		})
+
+		if failed.Has(name) {
+			continue
+		}
+
+		location := &velerov1api.BackupStorageLocation{}
+		if err := c.kbClient.Get(context.Background(), client.ObjectKey{Namespace: backup.Namespace, Name: name}, location); err != nil {
+			log.WithError(err).Debug("Error getting mirror storage location")
+			continue
+		}
+		if location.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable {
+			continue
+		}
+
+		backupStore, err := c.backupStoreGetter.Get(location, pluginManager, c.logger)
+		if err != nil {
+			log.WithError(err).Debug("Error getting backup store for mirror storage location")
+			continue
+		}
+		backupStore = persistence.BackupStoreForBackup(backupStore, backup)
+
+		if exists, err := backupStore.BackupExists(location.Spec.StorageType.ObjectStorage.Bucket, backup.Name); err != nil || !exists {
+			log.WithError(err).Debug("Backup not found in mirror storage location")
+			continue
+		}
+
+		return location, backupStore
+	}
+
+	return nil, nil
+}
+
+// getResourceModifiers gets and parses the resource modifiers in the ConfigMap
+// that a restore references, if any.
+func (c *restoreController) getResourceModifiers(restore *api.Restore) (*pkgrestore.ResourceModifiers, []error) {
+	ref := restore.Spec.ResourceModifier
+	if ref == nil {
+		return nil, nil
+	}
+
+	if (ref.APIGroup != nil && *ref.APIGroup != "") || !strings.EqualFold(ref.Kind, "ConfigMap") {
+		return nil, []error{errors.Errorf("unsupported kind %q, must be ConfigMap", ref.Kind)}
+	}
+
+	configMap := new(corev1api.ConfigMap)
+	if err := c.kbClient.Get(context.Background(), client.ObjectKey{Namespace: restore.Namespace, Name: ref.Name}, configMap); err != nil {
+		return nil, []error{errors.Wrapf(err, "error getting config map %s/%s", restore.Namespace, ref.Name)}
+	}
+
+	data, err := pkgrestore.GetResourceModifiersData(configMap)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return pkgrestore.ParseResourceModifiers(data)
+}
+
 // runValidatedRestore takes a validated restore API object and executes the restore process.
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
@@ -447,11 +747,40 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error getting restore item actions")
 	}
 
-	backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
+	validators, err := pluginManager.GetRestoreValidators()
 	if err != nil {
-		return errors.Wrap(err, "error downloading backup")
+		return errors.Wrap(err, "error getting restore validators")
+	}
+
+	// the backup's contents must be encrypted if it's marked as encrypted in
+	// the location it's restored from.
+	encrypted := persistence.BackupContentsEncrypted(info.backup, info.location.Name)
+
+	var backupReader io.Reader
+	var fileSystem filesystem.Interface
+	if c.streamBackups {
+		contents, fs, err := c.streamBackupContents(info.backup, encrypted, info.backupStore, restoreLog)
+		if err != nil {
+			return errors.Wrap(err, "error streaming backup")
+		}
+		defer contents.Close()
+		backupReader, fileSystem = contents, fs
+	} else {
+		backupFile, err := downloadToTempFile(restore.Spec.BackupName, encrypted, info.backupStore, restoreLog)
+		if err != nil {
+			return errors.Wrap(err, "error downloading backup")
+		}
+		defer closeAndRemoveFile(backupFile, c.logger)
+		backupReader = backupFile
+	}
+
+	baseBackups, baseBackupFiles, err := c.downloadBaseBackups(info.backup, info.location.Name, info.backupStore, restoreLog)
+	for _, file := range baseBackupFiles {
+		defer closeAndRemoveFile(file, c.logger)
+	}
+	if err != nil {
+		return err
 	}
-	defer closeAndRemoveFile(backupFile, c.logger)
 
 	opts := label.NewListOptionsForBackup(restore.Spec.BackupName)
 
@@ -465,20 +794,108 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	itemStatus, err := info.backupStore.GetBackupItemStatus(restore.Spec.BackupName)
+	if err != nil {
+		return errors.Wrap(err, "error fetching backup item status")
+	}
+
+	// the resource modifiers were validated when the restore started, but
+	// are read again in case they've changed.
+	resourceModifiers, errs := c.getResourceModifiers(restore)
+	if len(errs) > 0 {
+		return errors.Wrap(kerrors.NewAggregate(errs), "error getting resource modifiers")
+	}
+
 	restoreLog.Info("starting restore")
 
+	// the restorer populates the dry-run summary, which is stored in the
+	// restore's results file alongside its warnings and errors.
+	var dryRunSummary *pkgrestore.DryRunSummary
+	if boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		restoreLog.Info("restore is a dry run, no changes will be made to the cluster")
+		dryRunSummary = new(pkgrestore.DryRunSummary)
+	}
+	// the change summary is only populated if the restore records its
+	// changes, since it has an overhead on restores of many items. A dry run
+	// doesn't change anything, and has a dry-run summary instead.
+	var changeSummary *pkgrestore.ChangeSummary
+	if boolptr.IsSetToTrue(restore.Spec.RecordChanges) && !boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		changeSummary = new(pkgrestore.ChangeSummary)
+	}
+	timedOutItems := new(pkgrestore.Result)
+	renamedItems := new(pkgrestore.Result)
+	unchangedItems := new(pkgrestore.Result)
+
+	// the restorer waits for the restored workloads, if the restore asks
+	// it to, before returning.
+	var workloadReadiness *api.WorkloadReadinessStatus
+	if restore.Spec.WaitForWorkloads != nil && !boolptr.IsSetToTrue(restore.Spec.DryRun) {
+		workloadReadiness = new(api.WorkloadReadinessStatus)
+	}
+
+	var resumeCheckpoint *pkgrestore.Checkpoint
+	if restore.Status.ResumedFrom != "" {
+		resumeCheckpoint, err = getCheckpoint(restore.Status.ResumedFrom, info.backupStore)
+		if err != nil {
+			return errors.Wrapf(err, "error getting checkpoint of restore %s", restore.Status.ResumedFrom)
+		}
+		if resumeCheckpoint == nil {
+			restoreLog.Infof("Restore %s has no checkpoint, so no items will be skipped", restore.Status.ResumedFrom)
+		} else {
+			restoreLog.Infof("Resuming restore %s, skipping the %d items it restored that still exist in the cluster", restore.Status.ResumedFrom, len(resumeCheckpoint.Items))
+		}
+	}
+
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
 	for i := range podVolumeBackupList.Items {
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
 	restoreReq := pkgrestore.Request{
-		Log:              restoreLog,
-		Restore:          restore,
-		Location:         info.location,
-		Backup:           info.backup,
-		PodVolumeBackups: podVolumeBackups,
-		VolumeSnapshots:  volumeSnapshots,
-		BackupReader:     backupFile,
+		Log:               restoreLog,
+		Restore:           restore,
+		Location:          info.location,
+		Backup:            info.backup,
+		PodVolumeBackups:  podVolumeBackups,
+		VolumeSnapshots:   volumeSnapshots,
+		BackupItemStatus:  itemStatus,
+		BackupReader:      backupReader,
+		BaseBackups:       baseBackups,
+		FileSystem:        fileSystem,
+		DryRunSummary:     dryRunSummary,
+		ChangeSummary:     changeSummary,
+		TimedOutItems:     timedOutItems,
+		RenamedItems:      renamedItems,
+		UnchangedItems:    unchangedItems,
+		WorkloadReadiness: workloadReadiness,
+		ResumeCheckpoint:  resumeCheckpoint,
+		ResourceModifiers: resourceModifiers,
+		Validators:        validators,
+	}
+
+	// a read-only location is never written to, so restores from it don't
+	// store their logs, results or checkpoints.
+	readOnly := info.location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly
+	if readOnly {
+		restoreLog.Infof("Backup storage location %s is read-only, so the restore's log, results and checkpoint won't be uploaded to it", info.location.Name)
+	} else {
+		restoreReq.PutCheckpoint = func(checkpoint *pkgrestore.Checkpoint) error {
+			return putCheckpoint(restore, checkpoint, info.backupStore)
+		}
+	}
+
+	// the restore is only canceled if the restorer saw the cancellation
+	// request, so that one made after the restore finished restoring items
+	// doesn't change its outcome.
+	var canceled bool
+	restoreReq.Canceled = func() bool {
+		current, err := c.restoreLister.Restores(restore.Namespace).Get(restore.Name)
+		if err != nil {
+			return false
+		}
+		if _, ok := current.Annotations[velerov1api.CancelRequestedAnnotation]; ok {
+			canceled = true
+		}
+		return canceled
 	}
 
 	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
@@ -490,10 +907,14 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	if err != nil {
 		return errors.Wrap(err, "error setting up backup store to persist log and results files")
 	}
+	info.backupStore = persistence.BackupStoreForBackup(info.backupStore, info.backup)
 
+	if !readOnly {
+		c.events.record(restore, LifecycleReasonUploading, "Uploading restore log and results to backup storage location %s", info.location.Name)
+	}
 	if logReader, err := restoreLog.done(c.logger); err != nil {
 		restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error getting restore log reader: %v", err))
-	} else {
+	} else if !readOnly {
 		if err := info.backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader); err != nil {
 			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading log file to backup storage: %v", err))
 		}
@@ -512,11 +933,42 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		restore.Status.Errors += len(e)
 	}
 
-	m := map[string]pkgrestore.Result{
+	restore.Status.RenamedItems = len(renamedItems.Cluster)
+	for _, r := range renamedItems.Namespaces {
+		restore.Status.RenamedItems += len(r)
+	}
+	restore.Status.UnchangedItems = len(unchangedItems.Cluster)
+	for _, r := range unchangedItems.Namespaces {
+		restore.Status.UnchangedItems += len(r)
+	}
+	restore.Status.WorkloadReadiness = workloadReadiness
+	if canceled {
+		restore.Status.Phase = api.RestorePhaseCancelled
+	}
+
+	m := map[string]interface{}{
 		"warnings": restoreWarnings,
 		"errors":   restoreErrors,
 	}
+	if dryRunSummary != nil {
+		m["dryRun"] = dryRunSummary
+	}
+	if changeSummary != nil {
+		m["changes"] = changeSummary
+	}
+	if len(timedOutItems.Cluster) > 0 || len(timedOutItems.Namespaces) > 0 {
+		m["timedOut"] = timedOutItems
+	}
+	if restore.Status.RenamedItems > 0 {
+		m["renamed"] = renamedItems
+	}
+	if restore.Status.UnchangedItems > 0 {
+		m["unchanged"] = unchangedItems
+	}
 
+	if readOnly {
+		return nil
+	}
 	if err := putResults(restore, m, info.backupStore, c.logger); err != nil {
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
@@ -524,7 +976,7 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	return nil
 }
 
-func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
+func putResults(restore *api.Restore, results map[string]interface{}, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
 	defer gzw.Close()
@@ -544,8 +996,87 @@ func putResults(restore *api.Restore, results map[string]pkgrestore.Result, back
 	return nil
 }
 
-func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
-	readCloser, err := backupStore.GetBackupContents(backupName)
+// getCheckpoint returns the checkpoint of the named restore, or nil if it
+// doesn't have one.
+func getCheckpoint(restoreName string, backupStore persistence.BackupStore) (*pkgrestore.Checkpoint, error) {
+	rc, err := backupStore.GetRestoreCheckpoint(restoreName)
+	if err != nil {
+		return nil, err
+	}
+	if rc == nil {
+		return nil, nil
+	}
+	defer rc.Close()
+
+	gzr, err := gzip.NewReader(rc)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating gzip reader")
+	}
+	defer gzr.Close()
+
+	checkpoint := new(pkgrestore.Checkpoint)
+	if err := json.NewDecoder(gzr).Decode(checkpoint); err != nil {
+		return nil, errors.Wrap(err, "error decoding restore checkpoint from JSON")
+	}
+
+	return checkpoint, nil
+}
+
+func putCheckpoint(restore *api.Restore, checkpoint *pkgrestore.Checkpoint, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(checkpoint); err != nil {
+		return errors.Wrap(err, "error encoding restore checkpoint to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestoreCheckpoint(restore.Spec.BackupName, restore.Name, buf)
+}
+
+// streamBackupContents opens a stream of a backup's contents tarball from the
+// object store, for the restore to extract as it downloads it, rather than
+// downloading the tarball to a temp file first. The restore reads items in
+// priority order rather than in the tarball's order, so the tarball is still
+// extracted, but only one copy of the backup is kept. If the restore memory
+// limit is set, the tarball is streamed twice: once to measure its extracted
+// size, and once to extract it to memory if it fits within the limit, in
+// which case the returned file system is non-nil, and to disk otherwise.
+func (c *restoreController) streamBackupContents(backup *api.Backup, encrypted bool, backupStore persistence.BackupStore, log logrus.FieldLogger) (io.ReadCloser, filesystem.Interface, error) {
+	var fileSystem filesystem.Interface
+	if c.restoreMemoryLimit > 0 {
+		contents, err := backupStore.GetBackupContents(backup.Name, encrypted)
+		if err != nil {
+			return nil, nil, err
+		}
+		size, err := archive.ContentsSize(contents, backup.Status.CompressionAlgorithm)
+		contents.Close()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error measuring backup contents")
+		}
+
+		log = log.WithFields(logrus.Fields{"bytes": size, "limit": c.restoreMemoryLimit})
+		if size <= c.restoreMemoryLimit {
+			log.Info("Extracting backup contents to memory")
+			fileSystem = filesystem.NewMemoryFileSystem()
+		} else {
+			log.Info("Backup contents exceed the restore memory limit, extracting them to disk")
+		}
+	}
+
+	contents, err := backupStore.GetBackupContents(backup.Name, encrypted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contents, fileSystem, nil
+}
+
+func downloadToTempFile(backupName string, encrypted bool, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
+	readCloser, err := backupStore.GetBackupContents(backupName, encrypted)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -62,7 +63,9 @@ func TestFetchBackupInfo(t *testing.T) {
 		informerBackups   []*velerov1api.Backup
 		backupStoreBackup *velerov1api.Backup
 		backupStoreError  error
+		backupStoreExists bool
 		expectedRes       *velerov1api.Backup
+		expectedLocation  string
 		expectedErr       bool
 	}{
 		{
@@ -82,6 +85,24 @@ func TestFetchBackupInfo(t *testing.T) {
 			informerBackups:   []*velerov1api.Backup{defaultBackup().StorageLocation("default").Result()},
 			expectedRes:       defaultBackup().StorageLocation("default").Result(),
 		},
+		{
+			name:       "backup in an unavailable location is fetched from an available mirror location",
+			backupName: "backup-1",
+			namespace:  "velero",
+			informerLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+				builder.ForBackupStorageLocation("velero", "mirror-1").Provider("myCloud").Bucket("bucket-1").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+				builder.ForBackupStorageLocation("velero", "mirror-2").Provider("myCloud").Bucket("bucket-2").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			},
+			informerBackups: []*velerov1api.Backup{
+				defaultBackup().StorageLocation("default").MirrorStorageLocations("mirror-1", "mirror-2").
+					MirrorStatuses(velerov1api.BackupMirrorStatus{StorageLocation: "mirror-1", Phase: velerov1api.BackupMirrorPhaseFailed}).Result(),
+			},
+			backupStoreExists: true,
+			expectedRes: defaultBackup().StorageLocation("default").MirrorStorageLocations("mirror-1", "mirror-2").
+				MirrorStatuses(velerov1api.BackupMirrorStatus{StorageLocation: "mirror-1", Phase: velerov1api.BackupMirrorPhaseFailed}).Result(),
+			expectedLocation: "mirror-2",
+		},
 		{
 			name:             "no backup",
 			backupName:       "backup-1",
@@ -123,6 +144,9 @@ func TestFetchBackupInfo(t *testing.T) {
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				nil,
+				false,
+				0,
 			).(*restoreController)
 
 			if test.backupStoreError == nil {
@@ -148,10 +172,17 @@ func TestFetchBackupInfo(t *testing.T) {
 				backupStore.On("GetBackupMetadata", test.backupName).Return(test.backupStoreBackup, nil).Maybe()
 			}
 
+			if test.backupStoreExists {
+				backupStore.On("BackupExists", mock.Anything, test.backupName).Return(true, nil)
+			}
+
 			info, err := c.fetchBackupInfo(test.backupName, test.namespace, pluginManager)
 
 			require.Equal(t, test.expectedErr, err != nil)
 			assert.Equal(t, test.expectedRes, info.backup)
+			if test.expectedLocation != "" {
+				assert.Equal(t, test.expectedLocation, info.location.Name)
+			}
 		})
 	}
 }
@@ -215,6 +246,9 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				nil, // backupStoreGetter
 				metrics.NewServerMetrics(),
 				formatFlag,
+				nil,
+				false,
+				0,
 			).(*restoreController)
 
 			if test.restore != nil {
@@ -275,6 +309,128 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with volume in both includedVolumes and excludedVolumes fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).IncludedVolumes("ns-1/pvc-1").ExcludedVolumes("ns-1/pvc-1").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid included/excluded volume lists: excludes list cannot contain an item in the includes list: ns-1/pvc-1"},
+		},
+		{
+			name:                     "restore with empty namespace mapping template fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).NamespaceMappingTemplate(nil, " ").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid namespace mapping template: template must be non-empty"},
+		},
+		{
+			name:                     "restore with a resource listed in both high and low resource priorities fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourcePriorities("", []string{"deployments.apps"}, []string{"deployments.apps"}).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid resource priorities: resource deployments.apps is listed more than once"},
+		},
+		{
+			name:                     "restore with a negative resource timeout fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceTimeout(-time.Minute).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid resource timeout: must not be negative"},
+		},
+		{
+			name:                     "restore with an unsupported namespace conflict policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).NamespaceConflictPolicy("Replace").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid namespace conflict policy: unsupported policy "Replace", must be one of Merge, Fail or Recreate`},
+		},
+		{
+			name:                     "restore with an unsupported existing resource policy fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ExistingResourcePolicy("update").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid existing resource policy: unsupported policy "update", must be one of none, updateIfChanged, addMissingKeys or overwriteKeys`},
+		},
+		{
+			name:          "restore with invalid preferred API versions fails validation",
+			location:      defaultStorageLocation,
+			restore:       NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).PreferredAPIVersions(map[string][]string{"widgets.example.com": {"v2", "example.com/v1"}, "gadgets.example.com": nil}).Result(),
+			backup:        defaultBackup().StorageLocation("default").Result(),
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				"Invalid preferred API versions: at least one version is required for resource gadgets.example.com",
+				`Invalid preferred API versions: version "example.com/v1" of resource widgets.example.com must be an API version, such as v1beta1`,
+			},
+		},
+		{
+			name:                     "restore recreating namespaces matched by a pattern fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-*", "", velerov1api.RestorePhaseNew).NamespaceConflictPolicy(velerov1api.NamespaceConflictPolicyRecreate).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid namespace conflict policy: Recreate requires the included namespaces to be listed explicitly, but "ns-*" is a pattern`},
+		},
+		{
+			name:                     "restore recreating namespaces without included namespaces fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "", "", velerov1api.RestorePhaseNew).NamespaceConflictPolicy(velerov1api.NamespaceConflictPolicyRecreate).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid namespace conflict policy: Recreate requires the included namespaces to be listed explicitly"},
+		},
+		{
+			name:                     "restore with resource modifiers in a missing config map fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).ResourceModifier("ConfigMap", "modifiers").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`Invalid resource modifiers: error getting config map foo/modifiers: configmaps "modifiers" not found`},
+		},
+		{
+			name:                     "resumed restore without a failed restore of the backup fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Resume(true).Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"No failed, partially failed or cancelled restores of backup backup-1 found to resume"},
+		},
+		{
+			name:                     "restore of the secrets of a backup with redacted secrets fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").RedactSecrets(true).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Backup backup-1 has redacted secret values, so secrets must be excluded from the restore"},
+		},
+		{
+			name:                  "restore excluding the secrets of a backup with redacted secrets gets executed",
+			location:              defaultStorageLocation,
+			restore:               NewRestore("foo", "bar", "backup-1", "ns-1", "configmaps", velerov1api.RestorePhaseNew).Result(),
+			backup:                defaultBackup().StorageLocation("default").RedactSecrets(true).Result(),
+			expectedErr:           false,
+			expectedPhase:         string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:     &timestamp,
+			expectedCompletedTime: &timestamp,
+			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "configmaps", velerov1api.RestorePhaseInProgress).Result(),
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -442,6 +598,9 @@ func TestProcessQueueItem(t *testing.T) {
 				NewFakeSingleObjectBackupStoreGetter(backupStore),
 				metrics.NewServerMetrics(),
 				formatFlag,
+				nil,
+				false,
+				0,
 			).(*restoreController)
 
 			c.clock = clock.NewFakeClock(now)
@@ -507,7 +666,7 @@ func TestProcessQueueItem(t *testing.T) {
 				errors.Velero = append(errors.Velero, "error uploading log file to object storage: "+test.putRestoreLogErr.Error())
 			}
 			if test.expectedRestorerCall != nil {
-				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+				backupStore.On("GetBackupContents", test.backup.Name, false).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
 
 				restorer.On("Restore", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
 
@@ -524,6 +683,7 @@ func TestProcessQueueItem(t *testing.T) {
 					},
 				}
 				backupStore.On("GetBackupVolumeSnapshots", test.backup.Name).Return(volumeSnapshots, nil)
+				backupStore.On("GetBackupItemStatus", test.backup.Name).Return(nil, nil)
 			}
 
 			var (
@@ -544,11 +704,12 @@ func TestProcessQueueItem(t *testing.T) {
 
 			if test.backupStoreGetBackupContentsErr != nil {
 				// TODO why do I need .Maybe() here?
-				backupStore.On("GetBackupContents", test.restore.Spec.BackupName).Return(nil, test.backupStoreGetBackupContentsErr).Maybe()
+				backupStore.On("GetBackupContents", test.restore.Spec.BackupName, false).Return(nil, test.backupStoreGetBackupContentsErr).Maybe()
 			}
 
 			if test.restore != nil {
 				pluginManager.On("GetRestoreItemActions").Return(nil, nil)
+				pluginManager.On("GetRestoreValidators").Return(nil, nil)
 				pluginManager.On("CleanupClients")
 			}
 
@@ -672,6 +833,9 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		nil, // backupStoreGetter
 		nil,
 		formatFlag,
+		nil,
+		false,
+		0,
 	).(*restoreController)
 
 	restore := &velerov1api.Restore{
@@ -829,6 +993,113 @@ func TestMostRecentCompletedBackup(t *testing.T) {
 	assert.Equal(t, expected, mostRecentCompletedBackup(backups))
 }
 
+func TestMostRecentResumableRestore(t *testing.T) {
+	now := time.Now()
+	restore := builder.ForRestore("velero", "restore-4").Backup("backup-1").Resume(true).Result()
+
+	restores := []*velerov1api.Restore{
+		restore,
+		builder.ForRestore("velero", "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseCompleted).StartTimestamp(now.Add(time.Minute)).Result(),
+		builder.ForRestore("velero", "restore-2").Backup("backup-2").Phase(velerov1api.RestorePhaseFailed).StartTimestamp(now.Add(time.Minute)).Result(),
+		builder.ForRestore("velero", "restore-3").Backup("backup-1").Phase(velerov1api.RestorePhaseInProgress).StartTimestamp(now.Add(time.Minute)).Result(),
+	}
+	assert.Nil(t, mostRecentResumableRestore(restores, restore))
+
+	older := builder.ForRestore("velero", "restore-5").Backup("backup-1").Phase(velerov1api.RestorePhaseFailed).StartTimestamp(now).Result()
+	newer := builder.ForRestore("velero", "restore-6").Backup("backup-1").Phase(velerov1api.RestorePhasePartiallyFailed).StartTimestamp(now.Add(time.Second)).Result()
+	restores = append(restores, older, newer)
+
+	assert.Equal(t, newer, mostRecentResumableRestore(restores, restore))
+
+	cancelled := builder.ForRestore("velero", "restore-7").Backup("backup-1").Phase(velerov1api.RestorePhaseCancelled).StartTimestamp(now.Add(2 * time.Second)).Result()
+	restores = append(restores, cancelled)
+
+	assert.Equal(t, cancelled, mostRecentResumableRestore(restores, restore))
+}
+
+func TestCheckpointRoundTrip(t *testing.T) {
+	restore := builder.ForRestore("velero", "restore-1").Backup("backup-1").Result()
+	checkpoint := &pkgrestore.Checkpoint{
+		Items: []pkgrestore.CheckpointItem{
+			{Resource: "pods", Namespace: "ns-1", Name: "pod-1"},
+			{Resource: "persistentvolumes", Name: "pv-1", RestoredName: "renamed-pv-1"},
+		},
+	}
+
+	var written []byte
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("PutRestoreCheckpoint", "backup-1", "restore-1", mock.Anything).Run(func(args mock.Arguments) {
+		var err error
+		written, err = ioutil.ReadAll(args.Get(2).(io.Reader))
+		require.NoError(t, err)
+	}).Return(nil)
+	require.NoError(t, putCheckpoint(restore, checkpoint, backupStore))
+
+	backupStore.On("GetRestoreCheckpoint", "restore-1").Return(ioutil.NopCloser(bytes.NewReader(written)), nil)
+	backupStore.On("GetRestoreCheckpoint", "restore-2").Return(nil, nil)
+
+	res, err := getCheckpoint("restore-1", backupStore)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint, res)
+
+	// restores that haven't written a checkpoint don't have one
+	res, err = getCheckpoint("restore-2", backupStore)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestStreamBackupContents(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").Result()
+	tarball := velerotest.NewTarWriter(t).
+		AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+		Done().Bytes()
+
+	tests := []struct {
+		name               string
+		restoreMemoryLimit int64
+		downloads          int
+		expectMemory       bool
+	}{
+		{
+			name:      "contents are streamed once to be extracted to disk without a memory limit",
+			downloads: 1,
+		},
+		{
+			name:               "contents within the memory limit are extracted to memory",
+			restoreMemoryLimit: 1024 * 1024,
+			downloads:          2,
+			expectMemory:       true,
+		},
+		{
+			name:               "contents over the memory limit are extracted to disk",
+			restoreMemoryLimit: 1,
+			downloads:          2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupStore := &persistencemocks.BackupStore{}
+			defer backupStore.AssertExpectations(t)
+			for i := 0; i < test.downloads; i++ {
+				backupStore.On("GetBackupContents", "backup-1", false).Return(ioutil.NopCloser(bytes.NewReader(tarball)), nil).Once()
+			}
+
+			c := &restoreController{restoreMemoryLimit: test.restoreMemoryLimit}
+			contents, fileSystem, err := c.streamBackupContents(backup, false, backupStore, velerotest.NewLogger())
+			require.NoError(t, err)
+			defer contents.Close()
+
+			// the returned stream is always unread, even if the contents
+			// were measured.
+			res, err := ioutil.ReadAll(contents)
+			require.NoError(t, err)
+			assert.Equal(t, tarball, res)
+			assert.Equal(t, test.expectMemory, fileSystem != nil)
+		})
+	}
+}
+
 func NewRestore(ns, name, backup, includeNS, includeResource string, phase velerov1api.RestorePhase) *builder.RestoreBuilder {
 	restore := builder.ForRestore(ns, name).Phase(phase).Backup(backup)
 
@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// renderBackupMetadata renders the keys and values of a schedule's label or
+// annotation templates, in the order of their key templates so that errors
+// are reported consistently.
+func renderBackupMetadata(kind string, templates map[string]string, data backupNameData) (map[string]string, error) {
+	keyTemplates := make([]string, 0, len(templates))
+	for keyTemplate := range templates {
+		keyTemplates = append(keyTemplates, keyTemplate)
+	}
+	sort.Strings(keyTemplates)
+
+	rendered := make(map[string]string, len(templates))
+	for _, keyTemplate := range keyTemplates {
+		key, err := renderBackupTemplate(fmt.Sprintf("%s key template %q", kind, keyTemplate), keyTemplate, data)
+		if err != nil {
+			return nil, err
+		}
+		value, err := renderBackupTemplate(fmt.Sprintf("%s value template of %s", kind, key), templates[keyTemplate], data)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := rendered[key]; ok {
+			return nil, errors.Errorf("more than one %s key template renders %s", kind, key)
+		}
+		rendered[key] = value
+	}
+	return rendered, nil
+}
+
+// getBackupLabels returns the labels rendered from a schedule's backup label
+// templates at timestamp.
+func getBackupLabels(schedule *api.Schedule, clusterName string, timestamp time.Time) (map[string]string, error) {
+	labels, err := renderBackupMetadata("label", schedule.Spec.BackupLabelTemplates, newBackupNameData(schedule, clusterName, timestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := labels[api.ScheduleNameLabel]; ok {
+		return nil, errors.Errorf("label %s is set by Velero and can't be templated", api.ScheduleNameLabel)
+	}
+	if errs := metav1validation.ValidateLabels(labels, field.NewPath("metadata", "labels")); len(errs) > 0 {
+		return nil, errors.Errorf("rendered labels are invalid: %v", errs.ToAggregate())
+	}
+	return labels, nil
+}
+
+// getBackupAnnotations returns the annotations rendered from a schedule's
+// backup annotation templates at timestamp.
+func getBackupAnnotations(schedule *api.Schedule, clusterName string, timestamp time.Time) (map[string]string, error) {
+	annotations, err := renderBackupMetadata("annotation", schedule.Spec.BackupAnnotationTemplates, newBackupNameData(schedule, clusterName, timestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := apivalidation.ValidateAnnotations(annotations, field.NewPath("metadata", "annotations")); len(errs) > 0 {
+		return nil, errors.Errorf("rendered annotations are invalid: %v", errs.ToAggregate())
+	}
+	return annotations, nil
+}
+
+// setBackupMetadata adds the labels and annotations rendered from a
+// schedule's templates at timestamp to its backup, replacing those copied
+// from the schedule.
+func setBackupMetadata(backup *api.Backup, schedule *api.Schedule, clusterName string, timestamp time.Time) error {
+	labels, err := getBackupLabels(schedule, clusterName, timestamp)
+	if err != nil {
+		return err
+	}
+	annotations, err := getBackupAnnotations(schedule, clusterName, timestamp)
+	if err != nil {
+		return err
+	}
+
+	if len(labels) > 0 && backup.Labels == nil {
+		backup.Labels = make(map[string]string, len(labels))
+	}
+	for key, value := range labels {
+		backup.Labels[key] = value
+	}
+
+	if len(annotations) > 0 && backup.Annotations == nil {
+		backup.Annotations = make(map[string]string, len(annotations))
+	}
+	for key, value := range annotations {
+		backup.Annotations[key] = value
+	}
+
+	return nil
+}
+
+// validateBackupMetadataTemplates checks that a schedule's backup label and
+// annotation templates render valid labels and annotations, as they would
+// at now.
+func validateBackupMetadataTemplates(schedule *api.Schedule, clusterName string, now time.Time) []string {
+	var errs []string
+	if _, err := getBackupLabels(schedule, clusterName, now); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid backupLabelTemplates: %v", err))
+	}
+	if _, err := getBackupAnnotations(schedule, clusterName, now); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid backupAnnotationTemplates: %v", err))
+	}
+	return errs
+}
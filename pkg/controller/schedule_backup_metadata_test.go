@@ -0,0 +1,124 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestSetBackupMetadata(t *testing.T) {
+	timestamp := parseTime("2017-07-25 14:15:00")
+
+	tests := []struct {
+		name            string
+		schedule        *velerov1api.Schedule
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+		wantErr         string
+	}{
+		{
+			name:       "schedule without templates only has its own labels",
+			schedule:   builder.ForSchedule("velero", "daily").ObjectMeta(builder.WithLabels("env", "prod")).Result(),
+			wantLabels: map[string]string{"env": "prod", velerov1api.ScheduleNameLabel: "daily"},
+		},
+		{
+			name: "keys and values are rendered, and take precedence over the schedule's labels",
+			schedule: builder.ForSchedule("velero", "daily").
+				ObjectMeta(builder.WithLabels("env", "Prod", "date", "static")).
+				BackupLabelTemplates(map[string]string{
+					"date":                      `{{ .Timestamp.Format "2006-01-02" }}`,
+					"{{ .ClusterName }}/env":    `{{ lower .Labels.env }}`,
+					"example.com/cost-schedule": "{{ .ScheduleName }}",
+				}).
+				BackupAnnotationTemplates(map[string]string{
+					"example.com/lifecycle": `{{ if eq .Labels.env "Prod" }}retain{{ else }}expire{{ end }} since {{ .Timestamp.Format "2006-01-02T15:04:05Z07:00" }}`,
+				}).
+				Result(),
+			wantLabels: map[string]string{
+				"env":                         "Prod",
+				"date":                        "2017-07-25",
+				"cluster-1/env":               "prod",
+				"example.com/cost-schedule":   "daily",
+				velerov1api.ScheduleNameLabel: "daily",
+			},
+			wantAnnotations: map[string]string{
+				"example.com/lifecycle": "retain since 2017-07-25T14:15:00Z",
+			},
+		},
+		{
+			name:     "label template that doesn't parse is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupLabelTemplates(map[string]string{"date": "{{ .Timestamp "}).Result(),
+			wantErr:  "error parsing label value template of date",
+		},
+		{
+			name:     "label template rendering an invalid value is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupLabelTemplates(map[string]string{"created": "{{ .Timestamp }}"}).Result(),
+			wantErr:  `rendered labels are invalid: metadata.labels: Invalid value: "2017-07-25 14:15:00 +0000 UTC"`,
+		},
+		{
+			name:     "label template rendering an invalid key is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupLabelTemplates(map[string]string{"{{ .ScheduleName }} env": "prod"}).Result(),
+			wantErr:  `rendered labels are invalid: metadata.labels: Invalid value: "daily env"`,
+		},
+		{
+			name:     "label templates can't set the schedule name label",
+			schedule: builder.ForSchedule("velero", "daily").BackupLabelTemplates(map[string]string{velerov1api.ScheduleNameLabel: "other"}).Result(),
+			wantErr:  "label velero.io/schedule-name is set by Velero and can't be templated",
+		},
+		{
+			name:     "key templates rendering the same key are invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupLabelTemplates(map[string]string{"{{ .ScheduleName }}": "a", "daily": "b"}).Result(),
+			wantErr:  "more than one label key template renders daily",
+		},
+		{
+			name:     "annotation template referring to a missing label is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupAnnotationTemplates(map[string]string{"example.com/env": "{{ .Labels.env }}"}).Result(),
+			wantErr:  "error rendering annotation value template of example.com/env",
+		},
+		{
+			name:     "annotation template rendering an invalid key is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupAnnotationTemplates(map[string]string{"example.com/{{ .ScheduleName }}/env": "prod"}).Result(),
+			wantErr:  `rendered annotations are invalid: metadata.annotations: Invalid value: "example.com/daily/env"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := getBackup(tc.schedule, timestamp)
+			err := setBackupMetadata(backup, tc.schedule, "cluster-1", timestamp)
+
+			errs := validateBackupMetadataTemplates(tc.schedule, "cluster-1", timestamp)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0], tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, errs)
+			assert.Equal(t, tc.wantLabels, backup.Labels)
+			assert.Equal(t, tc.wantAnnotations, backup.Annotations)
+		})
+	}
+}
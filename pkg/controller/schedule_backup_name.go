@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// maxBackupNameSuffix is the highest suffix appended to a schedule's backup
+// name when it's already taken.
+const maxBackupNameSuffix = 10
+
+// backupNameData is what a schedule's backup name template can refer to.
+type backupNameData struct {
+	ScheduleName string
+	Timestamp    time.Time
+	ClusterName  string
+	Labels       map[string]string
+}
+
+// backupNameFuncs are the functions available to backup name templates, on
+// top of Go's built-in template functions.
+var backupNameFuncs = template.FuncMap{
+	"lower": strings.ToLower,
+}
+
+func newBackupNameData(schedule *api.Schedule, clusterName string, timestamp time.Time) backupNameData {
+	labels := schedule.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	return backupNameData{
+		ScheduleName: schedule.Name,
+		Timestamp:    timestamp,
+		ClusterName:  clusterName,
+		Labels:       labels,
+	}
+}
+
+// renderBackupTemplate renders one of a schedule's templates for its backups,
+// which description names in errors.
+func renderBackupTemplate(description, text string, data backupNameData) (string, error) {
+	tmpl, err := template.New(description).Funcs(backupNameFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.Wrapf(err, "error parsing %s", description)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", errors.Wrapf(err, "error rendering %s", description)
+	}
+	return buf.String(), nil
+}
+
+// getBackupName returns the name of the backup a schedule creates at
+// timestamp, rendered from its backup name template, or the schedule's
+// timestamped name if it has none.
+func getBackupName(schedule *api.Schedule, clusterName string, timestamp time.Time) (string, error) {
+	if schedule.Spec.BackupNameTemplate == "" {
+		return schedule.TimestampedName(timestamp), nil
+	}
+
+	name, err := renderBackupTemplate("backup name template", schedule.Spec.BackupNameTemplate, newBackupNameData(schedule, clusterName, timestamp))
+	if err != nil {
+		return "", err
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", errors.Errorf("rendered backup name %q is invalid: %s", name, strings.Join(errs, "; "))
+	}
+	return name, nil
+}
+
+// validateBackupNameTemplate checks that a schedule's backup name template
+// renders a valid backup name, as it would at now.
+func validateBackupNameTemplate(schedule *api.Schedule, clusterName string, now time.Time) []string {
+	if _, err := getBackupName(schedule, clusterName, now); err != nil {
+		return []string{fmt.Sprintf("invalid backupNameTemplate: %v", err)}
+	}
+	return nil
+}
+
+// backupNameWithSuffix returns name with a numeric suffix, shortening it if
+// needed to keep it a valid object name.
+func backupNameWithSuffix(name string, suffix int) string {
+	suffixStr := fmt.Sprintf("-%d", suffix)
+	if maxLen := validation.DNS1123SubdomainMaxLength - len(suffixStr); len(name) > maxLen {
+		name = strings.TrimRight(name[:maxLen], "-.")
+	}
+	return name + suffixStr
+}
+
+// createScheduledBackup creates a schedule's backup, appending a suffix to
+// its name if it's already taken.
+func (c *scheduleController) createScheduledBackup(backup *api.Backup, log logrus.FieldLogger) error {
+	name := backup.Name
+	for suffix := 1; ; suffix++ {
+		_, err := c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+		if !apierrors.IsAlreadyExists(err) || suffix > maxBackupNameSuffix {
+			return err
+		}
+
+		taken := backup.Name
+		backup.Name = backupNameWithSuffix(name, suffix)
+		log.WithField("backup", backup.Name).Infof("Backup %s already exists, using a suffixed name", taken)
+	}
+}
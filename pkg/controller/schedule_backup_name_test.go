@@ -0,0 +1,134 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetBackupName(t *testing.T) {
+	timestamp := parseTime("2017-07-25 14:15:00")
+
+	tests := []struct {
+		name     string
+		schedule *velerov1api.Schedule
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "schedule without a template uses its timestamped name",
+			schedule: builder.ForSchedule("velero", "daily").Result(),
+			want:     "daily-20170725141500",
+		},
+		{
+			name: "template can use the schedule name, timestamp, cluster name and labels",
+			schedule: builder.ForSchedule("velero", "daily").
+				ObjectMeta(builder.WithLabels("env", "Prod", "region", "eu-west-1")).
+				BackupNameTemplate(`{{ .ClusterName }}-{{ lower (index .Labels "env") }}-{{ .Labels.region }}-{{ .ScheduleName }}-{{ .Timestamp.Format "20060102" }}`).
+				Result(),
+			want: "cluster-1-prod-eu-west-1-daily-20170725",
+		},
+		{
+			name:     "template that doesn't parse is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupNameTemplate("{{ .ScheduleName ").Result(),
+			wantErr:  "error parsing backup name template",
+		},
+		{
+			name:     "template referring to a missing label is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupNameTemplate("{{ .Labels.env }}-{{ .ScheduleName }}").Result(),
+			wantErr:  "error rendering backup name template",
+		},
+		{
+			name:     "template rendering an invalid name is invalid",
+			schedule: builder.ForSchedule("velero", "daily").BackupNameTemplate("{{ .ScheduleName }}_{{ .ClusterName }}").Result(),
+			wantErr:  `rendered backup name "daily_cluster-1" is invalid`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			name, err := getBackupName(tc.schedule, "cluster-1", timestamp)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, name)
+		})
+	}
+}
+
+func TestBackupNameWithSuffix(t *testing.T) {
+	assert.Equal(t, "daily-20170725141500-1", backupNameWithSuffix("daily-20170725141500", 1))
+
+	long := strings.Repeat("a", 250) + "-bc"
+	suffixed := backupNameWithSuffix(long, 10)
+	assert.Len(t, suffixed, 253)
+	assert.Equal(t, strings.Repeat("a", 250)+"-10", suffixed)
+}
+
+func TestCreateScheduledBackup(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		builder.ForBackup("velero", "daily").Result(),
+		builder.ForBackup("velero", "daily-1").Result(),
+	)
+	sharedInformers := informers.NewSharedInformerFactory(client, 0)
+
+	c := NewScheduleController(
+		"namespace",
+		"",
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Schedules(),
+		velerotest.NewLogger(),
+		metrics.NewServerMetrics(),
+	)
+
+	backup := builder.ForBackup("velero", "daily").Result()
+	require.NoError(t, c.createScheduledBackup(backup, velerotest.NewLogger()))
+	assert.Equal(t, "daily-2", backup.Name)
+
+	_, err := client.VeleroV1().Backups("velero").Get(context.TODO(), "daily-2", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// the schedule gives up once it's run out of suffixes.
+	for i := 2; i <= maxBackupNameSuffix; i++ {
+		_, err := client.VeleroV1().Backups("velero").Create(context.TODO(), builder.ForBackup("velero", backupNameWithSuffix("nightly", i)).Result(), metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	for _, name := range []string{"nightly", "nightly-1"} {
+		_, err := client.VeleroV1().Backups("velero").Create(context.TODO(), builder.ForBackup("velero", name).Result(), metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	backup = builder.ForBackup("velero", "nightly").Result()
+	assert.Error(t, c.createScheduledBackup(backup, velerotest.NewLogger()))
+}
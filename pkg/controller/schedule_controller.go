@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -39,6 +40,7 @@ import (
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -50,6 +52,7 @@ type scheduleController struct {
 	*genericController
 
 	namespace       string
+	clusterName     string
 	schedulesClient velerov1client.SchedulesGetter
 	backupsClient   velerov1client.BackupsGetter
 	schedulesLister velerov1listers.ScheduleLister
@@ -59,6 +62,7 @@ type scheduleController struct {
 
 func NewScheduleController(
 	namespace string,
+	clusterName string,
 	schedulesClient velerov1client.SchedulesGetter,
 	backupsClient velerov1client.BackupsGetter,
 	schedulesInformer velerov1informers.ScheduleInformer,
@@ -68,6 +72,7 @@ func NewScheduleController(
 	c := &scheduleController{
 		genericController: newGenericController(Schedule, logger),
 		namespace:         namespace,
+		clusterName:       clusterName,
 		schedulesClient:   schedulesClient,
 		backupsClient:     backupsClient,
 		schedulesLister:   schedulesInformer.Lister(),
@@ -85,7 +90,7 @@ func NewScheduleController(
 				schedule := obj.(*api.Schedule)
 
 				switch schedule.Status.Phase {
-				case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
+				case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled, api.SchedulePhasePaused:
 					// add to work queue
 				default:
 					c.logger.WithFields(logrus.Fields{
@@ -120,7 +125,8 @@ func (c *scheduleController) enqueueAllEnabledSchedules() {
 	}
 
 	for _, schedule := range schedules {
-		if schedule.Status.Phase != api.SchedulePhaseEnabled {
+		// paused schedules are enqueued too, so that they're resumed.
+		if schedule.Status.Phase != api.SchedulePhaseEnabled && schedule.Status.Phase != api.SchedulePhasePaused {
 			continue
 		}
 
@@ -154,7 +160,7 @@ func (c *scheduleController) processSchedule(key string) error {
 	}
 
 	switch schedule.Status.Phase {
-	case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
+	case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled, api.SchedulePhasePaused:
 		// valid phase for processing
 	default:
 		return nil
@@ -171,13 +177,36 @@ func (c *scheduleController) processSchedule(key string) error {
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	errs = append(errs, validateTTLOverrides(schedule)...)
+	errs = append(errs, validateBackupNameTemplate(schedule, c.clusterName, c.clock.Now())...)
+	errs = append(errs, validateBackupMetadataTemplates(schedule, c.clusterName, c.clock.Now())...)
 	if len(errs) > 0 {
 		schedule.Status.Phase = api.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
+	} else if schedule.Spec.Paused {
+		schedule.Status.Phase = api.SchedulePhasePaused
 	} else {
 		schedule.Status.Phase = api.SchedulePhaseEnabled
 	}
 
+	switch {
+	case schedule.Status.Phase == api.SchedulePhasePaused && currentPhase != api.SchedulePhasePaused:
+		// cancel the schedule's running backups before recording that it's
+		// paused, so that a failure to cancel them is retried.
+		if schedule.Spec.CancelRunningOnPause {
+			if err := c.cancelRunningBackups(schedule, log); err != nil {
+				return err
+			}
+		}
+	case schedule.Status.Phase == api.SchedulePhaseEnabled && currentPhase == api.SchedulePhasePaused:
+		// a resumed schedule skips the backup it missed while paused, unless
+		// configured not to, and then runs when it's next due. Either way,
+		// it runs at most one backup right away, however many it missed.
+		if !boolptr.IsSetToFalse(schedule.Spec.SkipImmediately) {
+			schedule.Status.LastSkipped = &metav1.Time{Time: c.clock.Now()}
+		}
+	}
+
 	// update status if it's changed
 	if currentPhase != schedule.Status.Phase {
 		updatedSchedule, err := patchSchedule(original, schedule, c.schedulesClient)
@@ -199,6 +228,33 @@ func (c *scheduleController) processSchedule(key string) error {
 	return nil
 }
 
+// cancelRunningBackups requests the cancellation of the schedule's backups
+// that haven't finished.
+func (c *scheduleController) cancelRunningBackups(schedule *api.Schedule, log logrus.FieldLogger) error {
+	backups, err := c.backupsClient.Backups(schedule.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.Set{api.ScheduleNameLabel: schedule.Name}.String(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "error listing the schedule's backups")
+	}
+
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:""}}}`, api.CancelRequestedAnnotation))
+	for _, backup := range backups.Items {
+		switch backup.Status.Phase {
+		case "", api.BackupPhaseNew, api.BackupPhaseInProgress:
+		default:
+			continue
+		}
+
+		log.WithField("backup", backup.Name).Info("Canceling backup of paused schedule")
+		if _, err := c.backupsClient.Backups(backup.Namespace).Patch(context.TODO(), backup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return errors.Wrapf(err, "error canceling backup %s", backup.Name)
+		}
+	}
+
+	return nil
+}
+
 func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Schedule, []string) {
 	var validationErrors []string
 	var schedule cron.Schedule
@@ -239,6 +295,87 @@ func parseCronSchedule(itm *api.Schedule, logger logrus.FieldLogger) (cron.Sched
 	return schedule, nil
 }
 
+// validateTTLOverrides returns a validation error for each of the schedule's TTL
+// overrides that doesn't specify exactly one valid Cron expression or list of days
+// of the week, or that doesn't have a positive TTL.
+func validateTTLOverrides(itm *api.Schedule) []string {
+	var validationErrors []string
+
+	for i, override := range itm.Spec.TTLOverrides {
+		switch {
+		case override.Schedule == "" && len(override.DaysOfWeek) == 0:
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid ttlOverrides[%d]: one of schedule or daysOfWeek must be specified", i))
+		case override.Schedule != "" && len(override.DaysOfWeek) > 0:
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid ttlOverrides[%d]: only one of schedule or daysOfWeek may be specified", i))
+		case override.Schedule != "":
+			if _, err := parseCronExpression(override.Schedule); err != nil {
+				validationErrors = append(validationErrors, fmt.Sprintf("invalid ttlOverrides[%d]: invalid schedule: %v", i, err))
+			}
+		default:
+			for _, day := range override.DaysOfWeek {
+				if _, ok := parseWeekday(day); !ok {
+					validationErrors = append(validationErrors, fmt.Sprintf("invalid ttlOverrides[%d]: invalid day of week %q", i, day))
+				}
+			}
+		}
+
+		if override.TTL.Duration <= 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid ttlOverrides[%d]: ttl must be greater than zero", i))
+		}
+	}
+
+	return validationErrors
+}
+
+// parseCronExpression parses a standard Cron expression, recovering from any
+// panic in the cron library.
+func parseCronExpression(expression string) (schedule cron.Schedule, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("%v", r)
+		}
+	}()
+
+	return cron.ParseStandard(expression)
+}
+
+// parseWeekday parses a day of the week from its full or three-letter English
+// name, ignoring case.
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(day, weekday.String()) || strings.EqualFold(day, weekday.String()[:3]) {
+			return weekday, true
+		}
+	}
+
+	return time.Sunday, false
+}
+
+// getTTLOverride returns the TTL of the first of the schedule's TTL overrides that
+// matches runTime, if any.
+func getTTLOverride(item *api.Schedule, runTime time.Time) (metav1.Duration, bool) {
+	runTime = runTime.Truncate(time.Minute)
+
+	for _, override := range item.Spec.TTLOverrides {
+		if override.Schedule != "" {
+			// Next returns the first activation strictly after its argument, so
+			// runTime matches if it's the activation following the previous second.
+			if schedule, err := parseCronExpression(override.Schedule); err == nil && schedule.Next(runTime.Add(-time.Second)).Equal(runTime) {
+				return override.TTL, true
+			}
+			continue
+		}
+
+		for _, day := range override.DaysOfWeek {
+			if weekday, ok := parseWeekday(day); ok && weekday == runTime.Weekday() {
+				return override.TTL, true
+			}
+		}
+	}
+
+	return metav1.Duration{}, false
+}
+
 func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule cron.Schedule) error {
 	var (
 		now                = c.clock.Now()
@@ -259,7 +396,27 @@ func (c *scheduleController) submitBackupIfDue(item *api.Schedule, cronSchedule
 	// lead to performance issues).
 	log.WithField("nextRunTime", nextRunTime).Info("Schedule is due, submitting Backup")
 	backup := getBackup(item, now)
-	if _, err := c.backupsClient.Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{}); err != nil {
+	name, err := getBackupName(item, c.clusterName, now)
+	if err != nil {
+		return errors.Wrap(err, "error getting Backup name")
+	}
+	backup.Name = name
+	if err := setBackupMetadata(backup, item, c.clusterName, now); err != nil {
+		return errors.Wrap(err, "error getting Backup labels and annotations")
+	}
+
+	// a schedule's first backup is submitted immediately rather than at a time
+	// matching its Cron expression, so match TTL overrides against the current time.
+	runTime := nextRunTime
+	if item.Status.LastBackup == nil && item.Status.LastSkipped == nil {
+		runTime = now
+	}
+	if ttl, ok := getTTLOverride(item, runTime); ok {
+		log.WithField("ttl", ttl.Duration).Info("Using TTL override for Backup")
+		backup.Spec.TTL = ttl
+	}
+
+	if err := c.createScheduledBackup(backup, log); err != nil {
 		return errors.Wrap(err, "error creating Backup")
 	}
 
@@ -282,6 +439,11 @@ func getNextRunTime(schedule *api.Schedule, cronSchedule cron.Schedule, asOf tim
 	if schedule.Status.LastBackup != nil {
 		lastBackupTime = schedule.Status.LastBackup.Time
 	}
+	// the backup missed while the schedule was paused is skipped by running
+	// the next one as if the schedule had run when it was resumed.
+	if schedule.Status.LastSkipped != nil && schedule.Status.LastSkipped.After(lastBackupTime) {
+		lastBackupTime = schedule.Status.LastSkipped.Time
+	}
 
 	nextRunTime := cronSchedule.Next(lastBackupTime)
 
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
@@ -116,6 +117,66 @@ func TestProcessSchedule(t *testing.T) {
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
 		},
+		{
+			name: "schedule with an invalid TTL override gets validated and failed",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseNew).
+				CronSchedule("@every 5m").
+				TTLOverrides(velerov1api.ScheduleTTLOverride{DaysOfWeek: []string{"Someday"}, TTL: metav1.Duration{Duration: time.Hour}}).
+				Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid ttlOverrides[0]: invalid day of week "Someday"`},
+		},
+		{
+			name:                     "schedule with an invalid backup name template gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").BackupNameTemplate("{{ .Labels.env }}-{{ .ScheduleName }}").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid backupNameTemplate: error rendering backup name template: template: backupName:1:10: executing "backupName" at <.Labels.env>: map has no entry for key "env"`},
+		},
+		{
+			name:                 "schedule with a backup name template triggers a backup with the rendered name",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").BackupNameTemplate(`{{ .ScheduleName }}-{{ .Timestamp.Format "2006-01-02-1504" }}`).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedErr:          false,
+			expectedBackupCreate: builder.ForBackup("ns", "name-2017-01-01-1200").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
+		{
+			name: "schedule with a matching TTL override triggers a backup with the override's TTL",
+			schedule: newScheduleBuilder(velerov1api.SchedulePhaseEnabled).
+				CronSchedule("0 1 * * *").
+				LastBackupTime("2017-01-07 01:00:00").
+				TTLOverrides(velerov1api.ScheduleTTLOverride{DaysOfWeek: []string{"Sunday"}, TTL: metav1.Duration{Duration: 90 * 24 * time.Hour}}).
+				Result(),
+			fakeClockTime:        "2017-01-08 01:00:30",
+			expectedErr:          false,
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170108010030").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).TTL(90 * 24 * time.Hour).Result(),
+			expectedLastBackup:   "2017-01-08 01:00:30",
+		},
+		{
+			name:          "paused schedule with phase Enabled gets paused and doesn't trigger a backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Paused(true).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.SchedulePhasePaused),
+		},
+		{
+			name:          "resumed schedule skips the backup it missed",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhasePaused).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.SchedulePhaseEnabled),
+		},
+		{
+			name:                 "resumed schedule that doesn't skip immediately triggers a backup",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhasePaused).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").SkipImmediately(false).Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedErr:          false,
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
 	}
 
 	for _, test := range tests {
@@ -128,6 +189,7 @@ func TestProcessSchedule(t *testing.T) {
 
 			c := NewScheduleController(
 				"namespace",
+				"",
 				client.VeleroV1(),
 				client.VeleroV1(),
 				sharedInformers.Velero().V1().Schedules(),
@@ -177,6 +239,16 @@ func TestProcessSchedule(t *testing.T) {
 						res.Status.LastBackup = &metav1.Time{Time: parsed}
 					}
 
+					lastSkippedStr, found, err := unstructured.NestedString(patchMap, "status", "lastSkipped")
+					if err == nil && found {
+						parsed, err := time.Parse(time.RFC3339, lastSkippedStr)
+						if err != nil {
+							t.Logf("error parsing status.lastSkipped: %s\n", err)
+							return false, nil, err
+						}
+						res.Status.LastSkipped = &metav1.Time{Time: parsed}
+					}
+
 					return true, res, nil
 				})
 			}
@@ -250,10 +322,51 @@ func TestProcessSchedule(t *testing.T) {
 
 				velerotest.ValidatePatch(t, actions[index], expected, decode)
 			}
+
+			if test.expectedBackupCreate == nil {
+				for _, action := range actions {
+					assert.False(t, action.Matches("create", "backups"), "unexpected backup created")
+				}
+			}
 		})
 	}
 }
 
+func TestCancelRunningBackups(t *testing.T) {
+	schedule := builder.ForSchedule("ns", "name").CronSchedule("@every 5m").Result()
+	newBackup := func(name string, phase velerov1api.BackupPhase, scheduleName string) *velerov1api.Backup {
+		return builder.ForBackup("ns", name).ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, scheduleName)).Phase(phase).Result()
+	}
+
+	client := fake.NewSimpleClientset(
+		newBackup("name-1", velerov1api.BackupPhaseCompleted, "name"),
+		newBackup("name-2", velerov1api.BackupPhaseInProgress, "name"),
+		newBackup("name-3", velerov1api.BackupPhaseNew, "name"),
+		newBackup("other-1", velerov1api.BackupPhaseInProgress, "other"),
+	)
+	sharedInformers := informers.NewSharedInformerFactory(client, 0)
+
+	c := NewScheduleController(
+		"namespace",
+		"",
+		client.VeleroV1(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Schedules(),
+		velerotest.NewLogger(),
+		metrics.NewServerMetrics(),
+	)
+
+	require.NoError(t, c.cancelRunningBackups(schedule, velerotest.NewLogger()))
+
+	for _, name := range []string{"name-1", "name-2", "name-3", "other-1"} {
+		backup, err := client.VeleroV1().Backups("ns").Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		_, canceled := backup.Annotations[velerov1api.CancelRequestedAnnotation]
+		assert.Equal(t, name == "name-2" || name == "name-3", canceled, "backup %s", name)
+	}
+}
+
 func parseTime(timeString string) time.Time {
 	res, _ := time.Parse("2006-01-02 15:04:05", timeString)
 	return res
@@ -387,6 +500,152 @@ func TestParseCronSchedule(t *testing.T) {
 	assert.Equal(t, time.Date(2017, 8, 12, 9, 0, 0, 0, time.UTC), next)
 }
 
+func TestValidateTTLOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides []velerov1api.ScheduleTTLOverride
+		want      []string
+	}{
+		{
+			name: "no overrides are valid",
+		},
+		{
+			name: "valid schedule and days of week overrides",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{Schedule: "0 1 * * 0", TTL: metav1.Duration{Duration: time.Hour}},
+				{DaysOfWeek: []string{"Sunday", "sat", "MON"}, TTL: metav1.Duration{Duration: time.Hour}},
+			},
+		},
+		{
+			name: "override without schedule or days of week is invalid",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{TTL: metav1.Duration{Duration: time.Hour}},
+			},
+			want: []string{"invalid ttlOverrides[0]: one of schedule or daysOfWeek must be specified"},
+		},
+		{
+			name: "override with both schedule and days of week is invalid",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{Schedule: "0 1 * * 0", DaysOfWeek: []string{"Sunday"}, TTL: metav1.Duration{Duration: time.Hour}},
+			},
+			want: []string{"invalid ttlOverrides[0]: only one of schedule or daysOfWeek may be specified"},
+		},
+		{
+			name: "override with an invalid day of week is invalid",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{DaysOfWeek: []string{"Sunday"}, TTL: metav1.Duration{Duration: time.Hour}},
+				{DaysOfWeek: []string{"Sundays"}, TTL: metav1.Duration{Duration: time.Hour}},
+			},
+			want: []string{`invalid ttlOverrides[1]: invalid day of week "Sundays"`},
+		},
+		{
+			name: "override without a ttl is invalid",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{DaysOfWeek: []string{"Sunday"}},
+			},
+			want: []string{"invalid ttlOverrides[0]: ttl must be greater than zero"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := builder.ForSchedule("ns", "name").TTLOverrides(test.overrides...).Result()
+
+			assert.Equal(t, test.want, validateTTLOverrides(schedule))
+		})
+	}
+}
+
+func TestValidateTTLOverridesInvalidSchedule(t *testing.T) {
+	schedule := builder.ForSchedule("ns", "name").
+		TTLOverrides(velerov1api.ScheduleTTLOverride{Schedule: "not a cron expression", TTL: metav1.Duration{Duration: time.Hour}}).
+		Result()
+
+	errs := validateTTLOverrides(schedule)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0], "invalid ttlOverrides[0]: invalid schedule: ")
+}
+
+func TestGetTTLOverride(t *testing.T) {
+	// 2017-01-08 is a Sunday
+	sunday := time.Date(2017, 1, 8, 1, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		overrides []velerov1api.ScheduleTTLOverride
+		runTime   time.Time
+		wantTTL   time.Duration
+		wantFound bool
+	}{
+		{
+			name:    "no overrides",
+			runTime: sunday,
+		},
+		{
+			name: "matching day of week",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{DaysOfWeek: []string{"sun"}, TTL: metav1.Duration{Duration: 90 * 24 * time.Hour}},
+			},
+			runTime:   sunday,
+			wantTTL:   90 * 24 * time.Hour,
+			wantFound: true,
+		},
+		{
+			name: "non-matching day of week",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{DaysOfWeek: []string{"Saturday"}, TTL: metav1.Duration{Duration: 90 * 24 * time.Hour}},
+			},
+			runTime: sunday,
+		},
+		{
+			name: "matching schedule",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{Schedule: "0 1 * * 0", TTL: metav1.Duration{Duration: 90 * 24 * time.Hour}},
+			},
+			runTime:   sunday,
+			wantTTL:   90 * 24 * time.Hour,
+			wantFound: true,
+		},
+		{
+			name: "matching schedule ignores seconds in the run time",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{Schedule: "0 1 * * 0", TTL: metav1.Duration{Duration: 90 * 24 * time.Hour}},
+			},
+			runTime:   sunday.Add(30 * time.Second),
+			wantTTL:   90 * 24 * time.Hour,
+			wantFound: true,
+		},
+		{
+			name: "non-matching schedule",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{Schedule: "0 2 * * 0", TTL: metav1.Duration{Duration: 90 * 24 * time.Hour}},
+			},
+			runTime: sunday,
+		},
+		{
+			name: "first matching override wins",
+			overrides: []velerov1api.ScheduleTTLOverride{
+				{DaysOfWeek: []string{"Saturday"}, TTL: metav1.Duration{Duration: time.Hour}},
+				{Schedule: "0 1 * * *", TTL: metav1.Duration{Duration: 2 * time.Hour}},
+				{DaysOfWeek: []string{"Sunday"}, TTL: metav1.Duration{Duration: 3 * time.Hour}},
+			},
+			runTime:   sunday,
+			wantTTL:   2 * time.Hour,
+			wantFound: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := builder.ForSchedule("ns", "name").TTLOverrides(test.overrides...).Result()
+
+			ttl, found := getTTLOverride(schedule, test.runTime)
+			assert.Equal(t, test.wantFound, found)
+			assert.Equal(t, test.wantTTL, ttl.Duration)
+		})
+	}
+}
+
 func TestGetBackup(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,132 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/storage"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// VolumeSnapshotLocationReconciler periodically validates VolumeSnapshotLocations
+// and records whether they're available in their status.
+type VolumeSnapshotLocationReconciler struct {
+	Ctx    context.Context
+	Client client.Client
+	Scheme *runtime.Scheme
+	// ValidationFrequency is how often each location is validated. Locations
+	// are always validated once, and never again if it's 0.
+	ValidationFrequency time.Duration
+	// use a variable to refer to this function so it can be
+	// replaced with a fake for testing.
+	NewPluginManager func(logrus.FieldLogger) clientmgmt.Manager
+
+	Log logrus.FieldLogger
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=volumesnapshotlocations,verbs=get;list;watch;update;patch
+func (r *VolumeSnapshotLocationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithField("controller", VolumeSnapshotLocation)
+
+	log.Debug("Validating availability of volume snapshot locations.")
+
+	locationList := new(velerov1api.VolumeSnapshotLocationList)
+	if err := r.Client.List(r.Ctx, locationList, &client.ListOptions{Namespace: req.Namespace}); err != nil {
+		log.WithError(err).Error("Error listing volume snapshot locations")
+		return ctrl.Result{}, errors.WithStack(err)
+	}
+
+	pluginManager := r.NewPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	for i := range locationList.Items {
+		location := &locationList.Items[i]
+		log := log.WithField(VolumeSnapshotLocation, location.Name)
+
+		if !storage.IsReadyToValidate(nil, location.Status.LastValidationTime, r.ValidationFrequency, log) {
+			log.Debug("Validation not required, skipping...")
+			continue
+		}
+
+		original := location.DeepCopy()
+
+		log.Info("Validating volume snapshot location")
+		if err := validateSnapshotLocation(location, pluginManager, log); err != nil {
+			log.WithError(err).Info("Volume snapshot location is invalid, marking as unavailable")
+			location.Status.Phase = velerov1api.VolumeSnapshotLocationPhaseUnavailable
+			location.Status.Message = err.Error()
+		} else {
+			log.Info("Volume snapshot location valid, marking as available")
+			location.Status.Phase = velerov1api.VolumeSnapshotLocationPhaseAvailable
+			location.Status.Message = ""
+		}
+		location.Status.LastValidationTime = &metav1.Time{Time: time.Now().UTC()}
+
+		if err := r.Client.Patch(r.Ctx, location, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("Error updating volume snapshot location phase")
+		}
+	}
+
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// validateSnapshotLocation initializes the location's volume snapshotter, then asks
+// it to check its credentials and permissions if it supports doing so. For volume
+// snapshotters that don't, a successful Init is all that's checked.
+func validateSnapshotLocation(location *velerov1api.VolumeSnapshotLocation, pluginManager clientmgmt.Manager, log logrus.FieldLogger) error {
+	volumeSnapshotter, err := pluginManager.GetVolumeSnapshotter(location.Spec.Provider)
+	if err != nil {
+		return errors.Wrapf(err, "error getting volume snapshotter for provider %s", location.Spec.Provider)
+	}
+
+	if err := volumeSnapshotter.Init(location.Spec.Config); err != nil {
+		return errors.Wrap(err, "error initializing volume snapshotter")
+	}
+
+	validator, ok := volumeSnapshotter.(velero.VolumeSnapshotterValidator)
+	if !ok {
+		return nil
+	}
+
+	if err := validator.ValidateLocation(); err != nil {
+		if errors.Cause(err) == velero.ErrLocationValidationNotSupported {
+			log.Debug("Volume snapshotter doesn't support validating its location, only checked that it can be initialized")
+			return nil
+		}
+		return errors.Wrap(err, "error validating volume snapshot location")
+	}
+
+	return nil
+}
+
+func (r *VolumeSnapshotLocationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.VolumeSnapshotLocation{}).
+		Complete(r)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// validatingVolumeSnapshotter is a volume snapshotter that supports validating its location.
+type validatingVolumeSnapshotter struct {
+	*providermocks.VolumeSnapshotter
+	validateErr error
+}
+
+func (v *validatingVolumeSnapshotter) ValidateLocation() error {
+	return v.validateErr
+}
+
+var _ = Describe("Volume Snapshot Location Reconciler", func() {
+	It("Should successfully patch a volume snapshot location object status according to whether it's valid or not", func() {
+		tests := []struct {
+			snapshotLocation *velerov1api.VolumeSnapshotLocation
+			initErr          error
+			validator        bool
+			validateErr      error
+			expectedPhase    velerov1api.VolumeSnapshotLocationPhase
+			expectedMessage  string
+		}{
+			{
+				snapshotLocation: builder.ForVolumeSnapshotLocation("ns-1", "location-1").Provider("provider-1").Result(),
+				expectedPhase:    velerov1api.VolumeSnapshotLocationPhaseAvailable,
+			},
+			{
+				snapshotLocation: builder.ForVolumeSnapshotLocation("ns-1", "location-2").Provider("provider-2").Result(),
+				initErr:          errors.New("missing region"),
+				expectedPhase:    velerov1api.VolumeSnapshotLocationPhaseUnavailable,
+				expectedMessage:  "error initializing volume snapshotter: missing region",
+			},
+			{
+				snapshotLocation: builder.ForVolumeSnapshotLocation("ns-1", "location-3").Provider("provider-3").Result(),
+				validator:        true,
+				validateErr:      errors.New("access denied"),
+				expectedPhase:    velerov1api.VolumeSnapshotLocationPhaseUnavailable,
+				expectedMessage:  "error validating volume snapshot location: access denied",
+			},
+			{
+				snapshotLocation: builder.ForVolumeSnapshotLocation("ns-1", "location-4").Provider("provider-4").Phase(velerov1api.VolumeSnapshotLocationPhaseUnavailable).Message("access denied").Result(),
+				validator:        true,
+				expectedPhase:    velerov1api.VolumeSnapshotLocationPhaseAvailable,
+			},
+			{
+				snapshotLocation: builder.ForVolumeSnapshotLocation("ns-1", "location-5").Provider("provider-5").Result(),
+				validator:        true,
+				validateErr:      errors.WithStack(velero.ErrLocationValidationNotSupported),
+				expectedPhase:    velerov1api.VolumeSnapshotLocationPhaseAvailable,
+			},
+		}
+
+		// Setup
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("CleanupClients").Return(nil)
+
+		locations := new(velerov1api.VolumeSnapshotLocationList)
+		for _, test := range tests {
+			location := test.snapshotLocation
+			locations.Items = append(locations.Items, *location)
+
+			volumeSnapshotter := new(providermocks.VolumeSnapshotter)
+			volumeSnapshotter.On("Init", location.Spec.Config).Return(test.initErr)
+			if test.validator {
+				pluginManager.On("GetVolumeSnapshotter", location.Spec.Provider).Return(&validatingVolumeSnapshotter{VolumeSnapshotter: volumeSnapshotter, validateErr: test.validateErr}, nil)
+			} else {
+				pluginManager.On("GetVolumeSnapshotter", location.Spec.Provider).Return(volumeSnapshotter, nil)
+			}
+		}
+
+		// Setup reconciler
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := VolumeSnapshotLocationReconciler{
+			Ctx:                 ctx,
+			Client:              fake.NewFakeClientWithScheme(scheme.Scheme, locations),
+			ValidationFrequency: time.Minute,
+			NewPluginManager:    func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			Log:                 velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(err).To(BeNil())
+
+		// Assertions
+		for i, location := range locations.Items {
+			key := client.ObjectKey{Name: location.Name, Namespace: location.Namespace}
+			instance := &velerov1api.VolumeSnapshotLocation{}
+			err := r.Client.Get(ctx, key, instance)
+			Expect(err).To(BeNil())
+			Expect(instance.Status.Phase).To(BeIdenticalTo(tests[i].expectedPhase))
+			Expect(instance.Status.Message).To(Equal(tests[i].expectedMessage))
+			Expect(instance.Status.LastValidationTime).NotTo(BeNil())
+		}
+	})
+
+	It("Should not validate a volume snapshot location before its validation frequency has elapsed", func() {
+		location := builder.ForVolumeSnapshotLocation("ns-1", "location-1").
+			Provider("provider-1").
+			Phase(velerov1api.VolumeSnapshotLocationPhaseAvailable).
+			LastValidationTime(time.Now().UTC()).
+			Result()
+
+		// the plugin manager has no expectations for GetVolumeSnapshotter, so
+		// the test fails if the location is validated.
+		pluginManager := &pluginmocks.Manager{}
+		pluginManager.On("CleanupClients").Return(nil)
+
+		Expect(velerov1api.AddToScheme(scheme.Scheme)).To(Succeed())
+		r := VolumeSnapshotLocationReconciler{
+			Ctx:                 ctx,
+			Client:              fake.NewFakeClientWithScheme(scheme.Scheme, location),
+			ValidationFrequency: time.Hour,
+			NewPluginManager:    func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			Log:                 velerotest.NewLogger(),
+		}
+
+		actualResult, err := r.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "ns-1"},
+		})
+
+		Expect(actualResult).To(BeEquivalentTo(ctrl.Result{Requeue: true}))
+		Expect(err).To(BeNil())
+
+		instance := &velerov1api.VolumeSnapshotLocation{}
+		Expect(r.Client.Get(ctx, client.ObjectKey{Name: location.Name, Namespace: location.Namespace}, instance)).To(Succeed())
+		Expect(instance.Status.Phase).To(BeIdenticalTo(velerov1api.VolumeSnapshotLocationPhaseAvailable))
+	})
+})
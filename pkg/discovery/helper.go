@@ -49,6 +49,11 @@ type Helper interface {
 	// APIResource for the provided partially-specified GroupVersionKind.
 	KindFor(input schema.GroupVersionKind) (schema.GroupVersionResource, metav1.APIResource, error)
 
+	// ResourcesForGroupVersion gets the Velero-backuppable resources
+	// served at an API group version, which needn't be the group's
+	// preferred version, from the discovery API.
+	ResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+
 	// Refresh pulls an updated set of Velero-backuppable resources from the
 	// discovery API.
 	Refresh() error
@@ -274,6 +279,19 @@ func (h *helper) Resources() []*metav1.APIResourceList {
 	return h.resources
 }
 
+func (h *helper) ResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	resourceList, err := h.discoveryClient.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	filtered := discovery.FilteredBy(discovery.ResourcePredicateFunc(filterByVerbs), []*metav1.APIResourceList{resourceList})
+	if len(filtered) == 0 {
+		return &metav1.APIResourceList{GroupVersion: groupVersion}, nil
+	}
+	return filtered[0], nil
+}
+
 func (h *helper) APIGroups() []metav1.APIGroup {
 	h.lock.RLock()
 	defer h.lock.RUnlock()
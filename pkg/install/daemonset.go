@@ -185,6 +185,44 @@ func DaemonSet(namespace string, opts ...podTemplateOption) *appsv1.DaemonSet {
 		}...)
 	}
 
+	if c.blockVolumes {
+		// kubelet maps a pod's raw block volumes into its volumeDevices
+		// directory as symlinks to absolute paths on the host, under /dev or
+		// kubelet's plugins directory, so those are mounted at the same paths
+		// for the links to resolve. Opening the devices requires privileged
+		// mode.
+		privileged := true
+		daemonSet.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+			Privileged: &privileged,
+		}
+
+		for _, hostPath := range []struct{ name, path string }{
+			{name: "host-dev", path: "/dev"},
+			{name: "host-plugins", path: "/var/lib/kubelet/plugins"},
+		} {
+			daemonSet.Spec.Template.Spec.Volumes = append(
+				daemonSet.Spec.Template.Spec.Volumes,
+				corev1.Volume{
+					Name: hostPath.name,
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: hostPath.path,
+						},
+					},
+				},
+			)
+
+			daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+				daemonSet.Spec.Template.Spec.Containers[0].VolumeMounts,
+				corev1.VolumeMount{
+					Name:             hostPath.name,
+					MountPath:        hostPath.path,
+					MountPropagation: &mountPropagationMode,
+				},
+			)
+		}
+	}
+
 	daemonSet.Spec.Template.Spec.Containers[0].Env = append(daemonSet.Spec.Template.Spec.Containers[0].Env, c.envVars...)
 
 	return daemonSet
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -40,4 +41,16 @@ func TestDaemonSet(t *testing.T) {
 	ds = DaemonSet("velero", WithFeatures([]string{"foo,bar,baz"}))
 	assert.Len(t, ds.Spec.Template.Spec.Containers[0].Args, 3)
 	assert.Equal(t, "--features=foo,bar,baz", ds.Spec.Template.Spec.Containers[0].Args[2])
+
+	ds = DaemonSet("velero")
+	assert.Nil(t, ds.Spec.Template.Spec.Containers[0].SecurityContext)
+
+	ds = DaemonSet("velero", WithBlockVolumes())
+	assert.True(t, *ds.Spec.Template.Spec.Containers[0].SecurityContext.Privileged)
+	assert.Equal(t, 4, len(ds.Spec.Template.Spec.Volumes))
+	mounts := ds.Spec.Template.Spec.Containers[0].VolumeMounts
+	require.Len(t, mounts, 4)
+	assert.Equal(t, "/dev", mounts[2].MountPath)
+	assert.Equal(t, "/var/lib/kubelet/plugins", mounts[3].MountPath)
+	assert.Equal(t, corev1.MountPropagationHostToContainer, *mounts[3].MountPropagation)
 }
@@ -41,6 +41,7 @@ type podTemplateConfig struct {
 	plugins                           []string
 	features                          []string
 	defaultVolumesToRestic            bool
+	blockVolumes                      bool
 }
 
 func WithImage(image string) podTemplateOption {
@@ -114,6 +115,14 @@ func WithDefaultVolumesToRestic() podTemplateOption {
 	}
 }
 
+// WithBlockVolumes lets the restic daemonset read and write the raw block
+// devices of pod volumes.
+func WithBlockVolumes() podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.blockVolumes = true
+	}
+}
+
 func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment {
 	// TODO: Add support for server args
 	c := &podTemplateConfig{
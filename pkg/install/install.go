@@ -17,6 +17,7 @@ limitations under the License.
 package install
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -241,7 +242,7 @@ func createResource(r *unstructured.Unstructured, factory client.DynamicFactory,
 		return err
 	}
 
-	if _, err := c.Create(r); apierrors.IsAlreadyExists(err) {
+	if _, err := c.Create(context.TODO(), r); apierrors.IsAlreadyExists(err) {
 		log("already exists, proceeding")
 	} else if err != nil {
 		return errors.Wrapf(err, "Error creating resource %s", id)
@@ -228,6 +228,7 @@ type VeleroOptions struct {
 	CACertData                        []byte
 	Features                          []string
 	DefaultVolumesToRestic            bool
+	ResticBlockVolumes                bool
 }
 
 func AllCRDs() *unstructured.UnstructuredList {
@@ -313,6 +314,9 @@ func AllResources(o *VeleroOptions) *unstructured.UnstructuredList {
 		if len(o.Features) > 0 {
 			dsOpts = append(dsOpts, WithFeatures(o.Features))
 		}
+		if o.ResticBlockVolumes {
+			dsOpts = append(dsOpts, WithBlockVolumes())
+		}
 		ds := DaemonSet(o.Namespace, dsOpts...)
 		appendUnstructured(resources, ds)
 	}
@@ -0,0 +1,52 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package itemindex defines the item index that a backup uploads alongside
+// its tarball, listing every item in the backup and the backup whose tarball
+// contains it.
+package itemindex
+
+// Index lists the items in a backup. It's uploaded to the backup's directory
+// in object storage as <backup>-item-index.json.gz.
+type Index struct {
+	Items []Item `json:"items"`
+}
+
+// Item identifies an item in a backup, and where its files are stored.
+type Item struct {
+	// Resource is the item's API group, version and kind, as
+	// "<group>/<version>/<kind>", or "<version>/<kind>" for the core API
+	// group.
+	Resource string `json:"resource"`
+
+	// Namespace is the item's namespace in the cluster. It's empty for
+	// cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the item's name in the cluster.
+	Name string `json:"name"`
+
+	// ResourceVersion is the item's resource version when it was backed up.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Backup is the name of the backup whose tarball contains the item's
+	// files. It's the backup itself, unless the backup is incremental and the
+	// item hadn't changed since its base backup.
+	Backup string `json:"backup"`
+
+	// Files are the paths of the item's files in the tarball.
+	Files []string `json:"files"`
+}
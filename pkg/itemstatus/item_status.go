@@ -0,0 +1,43 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package itemstatus defines the item status manifest that a backup
+// uploads alongside its tarball, recording the items that failed to be
+// backed up.
+package itemstatus
+
+// Manifest lists the items of a backup that failed to be backed up. It's
+// uploaded to the backup's directory in object storage as
+// <backup>-item-status.json.gz.
+type Manifest struct {
+	Items []Item `json:"items"`
+}
+
+// Item identifies an item that failed to be backed up, and why.
+type Item struct {
+	// Resource is the item's group-resource, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// Namespace is the item's namespace in the backup tarball, after any
+	// namespace mapping. It's empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the item's name.
+	Name string `json:"name"`
+
+	// Errors are the errors encountered while backing up the item.
+	Errors []string `json:"errors"`
+}
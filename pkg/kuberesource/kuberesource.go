@@ -21,16 +21,25 @@ import (
 )
 
 var (
+	APIServices               = schema.GroupResource{Group: "apiregistration.k8s.io", Resource: "apiservices"}
 	ClusterRoleBindings       = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}
 	ClusterRoles              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}
 	CustomResourceDefinitions = schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}
+	DaemonSets                = schema.GroupResource{Group: "apps", Resource: "daemonsets"}
+	Deployments               = schema.GroupResource{Group: "apps", Resource: "deployments"}
 	Jobs                      = schema.GroupResource{Group: "batch", Resource: "jobs"}
 	Namespaces                = schema.GroupResource{Group: "", Resource: "namespaces"}
 	PersistentVolumeClaims    = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
 	PersistentVolumes         = schema.GroupResource{Group: "", Resource: "persistentvolumes"}
 	Pods                      = schema.GroupResource{Group: "", Resource: "pods"}
+	PriorityClasses           = schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}
+	ResourceQuotas            = schema.GroupResource{Group: "", Resource: "resourcequotas"}
+	RoleBindings              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "rolebindings"}
+	RuntimeClasses            = schema.GroupResource{Group: "node.k8s.io", Resource: "runtimeclasses"}
 	ServiceAccounts           = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
 	Secrets                   = schema.GroupResource{Group: "", Resource: "secrets"}
+	StatefulSets              = schema.GroupResource{Group: "apps", Resource: "statefulsets"}
+	StorageClasses            = schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}
 	VolumeSnapshotClasses     = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotclasses"}
 	VolumeSnapshots           = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshots"}
 	VolumeSnapshotContents    = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
@@ -43,6 +43,12 @@ const (
 	backupDeletionSuccessTotal    = "backup_deletion_success_total"
 	backupDeletionFailureTotal    = "backup_deletion_failure_total"
 	backupLastSuccessfulTimestamp = "backup_last_successful_timestamp"
+	backupItemDurationSeconds     = "backup_item_duration_seconds"
+	backupItemsFilteredTotal      = "backup_items_filtered_total"
+	backupSyncedTotal             = "backup_synced_total"
+	backupSyncSkipped             = "backup_sync_skipped"
+	backupStorageBytes            = "backup_storage_bytes"
+	backupStorageObjects          = "backup_storage_objects"
 	restoreTotal                  = "restore_total"
 	restoreAttemptTotal           = "restore_attempt_total"
 	restoreValidationFailedTotal  = "restore_validation_failed_total"
@@ -53,9 +59,13 @@ const (
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
 
+	resticRepoMaintenanceDurationSeconds = "restic_repository_maintenance_duration_seconds"
+	resticRepoMaintenanceFreedBytes      = "restic_repository_maintenance_freed_bytes"
+
 	// Restic metrics
 	podVolumeBackupEnqueueTotal        = "pod_volume_backup_enqueue_count"
 	podVolumeBackupDequeueTotal        = "pod_volume_backup_dequeue_count"
+	podVolumeBackupActive              = "pod_volume_backup_active"
 	resticOperationLatencySeconds      = "restic_operation_latency_seconds"
 	resticOperationLatencyGaugeSeconds = "restic_operation_latency_seconds_gauge"
 
@@ -65,6 +75,10 @@ const (
 	pvbNameLabel         = "pod_volume_backup"
 	scheduleLabel        = "schedule"
 	backupNameLabel      = "backupName"
+	resourceLabel        = "resource"
+	repositoryLabel      = "repository"
+	filterLabel          = "filter"
+	backupLocationLabel  = "backup_location"
 
 	secondsInMinute = 60.0
 )
@@ -179,6 +193,26 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupItemDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      backupItemDurationSeconds,
+					Help:      "Time taken to back up an item, including its backup item actions and volume snapshots, in seconds",
+					Buckets: []float64{
+						toSeconds(10 * time.Millisecond),
+						toSeconds(50 * time.Millisecond),
+						toSeconds(100 * time.Millisecond),
+						toSeconds(500 * time.Millisecond),
+						toSeconds(1 * time.Second),
+						toSeconds(5 * time.Second),
+						toSeconds(10 * time.Second),
+						toSeconds(30 * time.Second),
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+					},
+				},
+				[]string{resourceLabel},
+			),
 			restoreTotal: prometheus.NewGauge(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -250,6 +284,73 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupItemsFilteredTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupItemsFilteredTotal,
+					Help:      "Total number of items left out of backups by each kind of filter",
+				},
+				[]string{scheduleLabel, filterLabel},
+			),
+			backupSyncedTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupSyncedTotal,
+					Help:      "Total number of backups synced into the cluster from each backup storage location",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupSyncSkipped: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupSyncSkipped,
+					Help:      "Number of backups in each backup storage location that the last sync skipped because of each kind of sync filter",
+				},
+				[]string{backupLocationLabel, filterLabel},
+			),
+			backupStorageBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageBytes,
+					Help:      "Total size of the objects of the backups in each backup storage location when it was last measured",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupStorageObjects: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageObjects,
+					Help:      "Number of objects of the backups in each backup storage location when it was last measured",
+				},
+				[]string{backupLocationLabel},
+			),
+			resticRepoMaintenanceDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      resticRepoMaintenanceDurationSeconds,
+					Help:      "Time taken to run maintenance on a restic repository, in seconds",
+					Buckets: []float64{
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(10 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(30 * time.Minute),
+						toSeconds(1 * time.Hour),
+						toSeconds(2 * time.Hour),
+						toSeconds(3 * time.Hour),
+						toSeconds(4 * time.Hour),
+					},
+				},
+				[]string{repositoryLabel},
+			),
+			resticRepoMaintenanceFreedBytes: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      resticRepoMaintenanceFreedBytes,
+					Help:      "Bytes freed by the last maintenance of a restic repository",
+				},
+				[]string{repositoryLabel},
+			),
 		},
 	}
 }
@@ -273,6 +374,14 @@ func NewResticServerMetrics() *ServerMetrics {
 				},
 				[]string{nodeMetricLabel},
 			),
+			podVolumeBackupActive: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: resticMetricsNamespace,
+					Name:      podVolumeBackupActive,
+					Help:      "Number of pod_volume_backup objects currently being backed up",
+				},
+				[]string{nodeMetricLabel},
+			),
 			resticOperationLatencyGaugeSeconds: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Namespace: resticMetricsNamespace,
@@ -371,6 +480,9 @@ func (m *ServerMetrics) InitResticMetricsForNode(node string) {
 	if c, ok := m.metrics[podVolumeBackupDequeueTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(node).Add(0)
 	}
+	if g, ok := m.metrics[podVolumeBackupActive].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node).Set(0)
+	}
 }
 
 // RegisterPodVolumeBackupEnqueue records enqueuing of a PodVolumeBackup object.
@@ -387,6 +499,21 @@ func (m *ServerMetrics) RegisterPodVolumeBackupDequeue(node string) {
 	}
 }
 
+// RegisterPodVolumeBackupStart records the start of backing up a PodVolumeBackup object.
+func (m *ServerMetrics) RegisterPodVolumeBackupStart(node string) {
+	if g, ok := m.metrics[podVolumeBackupActive].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node).Inc()
+	}
+}
+
+// RegisterPodVolumeBackupEnd records the end of backing up a PodVolumeBackup object,
+// whether it succeeded or not.
+func (m *ServerMetrics) RegisterPodVolumeBackupEnd(node string) {
+	if g, ok := m.metrics[podVolumeBackupActive].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(node).Dec()
+	}
+}
+
 // ObserveResticOpLatency records the number of seconds a restic operation took.
 func (m *ServerMetrics) ObserveResticOpLatency(node, pvbName, opName, backupName string, seconds float64) {
 	if h, ok := m.metrics[resticOperationLatencySeconds].(*prometheus.HistogramVec); ok {
@@ -465,6 +592,54 @@ func (m *ServerMetrics) RegisterBackupDuration(backupSchedule string, seconds fl
 	}
 }
 
+// RegisterBackupItemDuration records the number of seconds backing up an
+// item of the given group-resource took. Items are labeled by group-resource
+// only, so the metric's cardinality is bounded by the number of resources
+// backed up.
+func (m *ServerMetrics) RegisterBackupItemDuration(groupResource string, seconds float64) {
+	if c, ok := m.metrics[backupItemDurationSeconds].(*prometheus.HistogramVec); ok {
+		c.WithLabelValues(groupResource).Observe(seconds)
+	}
+}
+
+// RegisterBackupItemsFiltered records the number of items a backup left out
+// because of a kind of filter, resource, namespace, label_selector,
+// item_filter, owner_kind, default_resources or modified_since. Items aren't labeled by name, so the metric's cardinality is
+// bounded by the number of schedules.
+func (m *ServerMetrics) RegisterBackupItemsFiltered(backupSchedule, filter string, count int) {
+	if c, ok := m.metrics[backupItemsFilteredTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule, filter).Add(float64(count))
+	}
+}
+
+// RegisterBackupSynced records a backup synced into the cluster from a
+// backup storage location.
+func (m *ServerMetrics) RegisterBackupSynced(backupLocation string) {
+	if c, ok := m.metrics[backupSyncedTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupLocation).Inc()
+	}
+}
+
+// SetBackupSyncSkipped records the number of backups in a backup storage
+// location that the last sync skipped because of a kind of sync filter,
+// prefix or label_selector.
+func (m *ServerMetrics) SetBackupSyncSkipped(backupLocation, filter string, count int) {
+	if g, ok := m.metrics[backupSyncSkipped].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupLocation, filter).Set(float64(count))
+	}
+}
+
+// SetBackupStorageUsage records the total size and number of the objects of
+// the backups in a backup storage location.
+func (m *ServerMetrics) SetBackupStorageUsage(backupLocation string, bytes int64, objects int) {
+	if g, ok := m.metrics[backupStorageBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupLocation).Set(float64(bytes))
+	}
+	if g, ok := m.metrics[backupStorageObjects].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupLocation).Set(float64(objects))
+	}
+}
+
 // RegisterBackupDeletionAttempt records the number of attempted backup deletions
 func (m *ServerMetrics) RegisterBackupDeletionAttempt(backupSchedule string) {
 	if c, ok := m.metrics[backupDeletionAttemptTotal].(*prometheus.CounterVec); ok {
@@ -554,3 +729,19 @@ func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, vo
 		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// RegisterResticRepoMaintenanceDuration records the number of seconds
+// maintenance of a restic repository took.
+func (m *ServerMetrics) RegisterResticRepoMaintenanceDuration(repository string, seconds float64) {
+	if c, ok := m.metrics[resticRepoMaintenanceDurationSeconds].(*prometheus.HistogramVec); ok {
+		c.WithLabelValues(repository).Observe(seconds)
+	}
+}
+
+// SetResticRepoMaintenanceFreedBytes records the number of bytes the last
+// maintenance of a restic repository freed.
+func (m *ServerMetrics) SetResticRepoMaintenanceFreedBytes(repository string, bytes int64) {
+	if g, ok := m.metrics[resticRepoMaintenanceFreedBytes].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(repository).Set(float64(bytes))
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	uuid "github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// The operations of a backup storage location's access check, in the order
+// they're run.
+const (
+	AccessCheckPut    = "Put"
+	AccessCheckGet    = "Get"
+	AccessCheckList   = "List"
+	AccessCheckDelete = "Delete"
+)
+
+// AccessCheckResult is the result of one of the operations of an access
+// check. Err is nil if the operation succeeded.
+type AccessCheckResult struct {
+	Operation string
+	Err       error
+}
+
+// CheckAccess checks that the backup store's credentials and permissions
+// allow all of the operations Velero uses, by uploading a uniquely named test
+// object, downloading it, listing it and deleting it. The test object is
+// deleted whether or not the other operations succeed. Read-only locations
+// are never written to, so only listing them is checked.
+func (s *objectBackupStore) CheckAccess() []AccessCheckResult {
+	name, err := uuid.NewV4()
+	if err != nil {
+		return []AccessCheckResult{{Operation: AccessCheckPut, Err: errors.Wrap(err, "error generating the name of the test object")}}
+	}
+	key := s.layout.getAccessCheckKey(name.String())
+	content := []byte("velero access check " + name.String())
+
+	err = s.objectStore.PutObject(s.bucket, key, bytes.NewReader(content))
+	if errors.Cause(err) == ErrReadOnly {
+		_, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.rootPrefix, "/")
+		return []AccessCheckResult{{Operation: AccessCheckList, Err: errors.WithStack(err)}}
+	}
+
+	results := []AccessCheckResult{
+		{Operation: AccessCheckPut, Err: errors.WithStack(err)},
+		{Operation: AccessCheckGet, Err: s.checkGet(key, content)},
+		{Operation: AccessCheckList, Err: s.checkList(key)},
+		// the test object is deleted even if uploading it failed, in case it
+		// was uploaded in spite of the error.
+		{Operation: AccessCheckDelete, Err: errors.WithStack(s.objectStore.DeleteObject(s.bucket, key))},
+	}
+	return results
+}
+
+func (s *objectBackupStore) checkGet(key string, content []byte) error {
+	body, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer body.Close()
+
+	got, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrapf(err, "error reading %s", key)
+	}
+	if !bytes.Equal(got, content) {
+		return errors.Errorf("downloaded content of %s doesn't match the uploaded content", key)
+	}
+	return nil
+}
+
+func (s *objectBackupStore) checkList(key string) error {
+	keys, err := s.objectStore.ListObjects(s.bucket, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, listed := range keys {
+		if listed == key {
+			return nil
+		}
+	}
+	return errors.Errorf("%s isn't listed", key)
+}
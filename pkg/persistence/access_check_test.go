@@ -0,0 +1,88 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// forbiddenGetObjectStore fails to download objects, like an object store
+// whose credentials are only allowed to upload them.
+type forbiddenGetObjectStore struct {
+	*inMemoryObjectStore
+}
+
+func (o *forbiddenGetObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	return nil, errors.New("access denied")
+}
+
+func TestCheckAccess(t *testing.T) {
+	operations := func(results []AccessCheckResult) map[string]string {
+		got := make(map[string]string)
+		for _, result := range results {
+			got[result.Operation] = ""
+			if result.Err != nil {
+				got[result.Operation] = result.Err.Error()
+			}
+		}
+		return got
+	}
+
+	objectStore := newInMemoryObjectStore("bucket")
+	store := &objectBackupStore{
+		objectStore: objectStore,
+		bucket:      "bucket",
+		layout:      NewObjectStoreLayout("prefix"),
+		logger:      velerotest.NewLogger(),
+	}
+
+	// every operation succeeds, in order, and the test object is deleted.
+	results := store.CheckAccess()
+	require.Len(t, results, 4)
+	for i, operation := range []string{AccessCheckPut, AccessCheckGet, AccessCheckList, AccessCheckDelete} {
+		assert.Equal(t, operation, results[i].Operation)
+		assert.NoError(t, results[i].Err)
+	}
+	keys, err := objectStore.ListObjects("bucket", "")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// a failed download doesn't stop the test object from being deleted.
+	store.objectStore = &forbiddenGetObjectStore{inMemoryObjectStore: objectStore}
+	assert.Equal(t, map[string]string{
+		AccessCheckPut:    "",
+		AccessCheckGet:    "access denied",
+		AccessCheckList:   "",
+		AccessCheckDelete: "",
+	}, operations(store.CheckAccess()))
+	keys, err = objectStore.ListObjects("bucket", "")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// read-only locations are only listed.
+	store.objectStore = newReadOnlyObjectStore(objectStore, builder.ForBackupStorageLocation("velero", "archive").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result())
+	assert.Equal(t, map[string]string{AccessCheckList: ""}, operations(store.CheckAccess()))
+}
@@ -0,0 +1,297 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Encrypted objects are written as a header followed by a sequence of chunks.
+// The header is encryptionMagic followed by a random nonce prefix. Each chunk
+// is a 4-byte big-endian length of its plaintext, with the high bit set on the
+// final chunk, followed by the plaintext sealed with AES-256-GCM. A chunk's
+// nonce is the nonce prefix followed by the chunk's 4-byte big-endian index,
+// and its length is used as additional authenticated data, so chunks can't be
+// reordered, dropped or truncated without decryption failing.
+const (
+	// EncryptionKeySize is the required size, in bytes, of a backup storage
+	// location's encryption key.
+	EncryptionKeySize = 32
+
+	encryptionChunkSize   = 64 * 1024
+	encryptionNoncePrefix = 8
+	encryptionFinalChunk  = 1 << 31
+)
+
+// encryptionMagic marks an object as encrypted. Objects that don't start with
+// it are read as plaintext, unless they're known to have been encrypted.
+var encryptionMagic = []byte("VLRENC01")
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != EncryptionKeySize {
+		return nil, errors.Errorf("encryption key must be %d bytes, got %d", EncryptionKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return aead, nil
+}
+
+// chunkNonce returns the nonce for the chunk with the given index.
+func chunkNonce(aead cipher.AEAD, prefix []byte, index uint32) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[len(nonce)-4:], index)
+	return nonce
+}
+
+// encryptingReader encrypts the data read from an underlying reader.
+type encryptingReader struct {
+	aead   cipher.AEAD
+	src    *bufio.Reader
+	prefix []byte
+	index  uint32
+	chunk  []byte
+	out    bytes.Buffer
+	done   bool
+}
+
+// newEncryptingReader returns a reader of the encrypted form of the data read
+// from plaintext.
+func newEncryptingReader(key []byte, plaintext io.Reader) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &encryptingReader{
+		aead:   aead,
+		src:    bufio.NewReaderSize(plaintext, encryptionChunkSize),
+		prefix: make([]byte, encryptionNoncePrefix),
+		chunk:  make([]byte, encryptionChunkSize),
+	}
+	if _, err := io.ReadFull(rand.Reader, r.prefix); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+
+	r.out.Write(encryptionMagic)
+	r.out.Write(r.prefix)
+
+	return r, nil
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for r.out.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.sealChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.out.Read(p)
+}
+
+// sealChunk reads and encrypts the next chunk of plaintext.
+func (r *encryptingReader) sealChunk() error {
+	n, err := io.ReadFull(r.src, r.chunk)
+	switch err {
+	case nil:
+		// A full chunk was read, so it's only the final chunk if there's
+		// nothing left to read.
+		if _, err := r.src.Peek(1); err == io.EOF {
+			r.done = true
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		r.done = true
+	default:
+		return errors.WithStack(err)
+	}
+
+	if r.index == math.MaxUint32 {
+		return errors.New("too much data to encrypt")
+	}
+
+	header := make([]byte, 4)
+	length := uint32(n)
+	if r.done {
+		length |= encryptionFinalChunk
+	}
+	binary.BigEndian.PutUint32(header, length)
+
+	r.out.Write(header)
+	r.out.Write(r.aead.Seal(nil, chunkNonce(r.aead, r.prefix, r.index), r.chunk[:n], header))
+	r.index++
+
+	return nil
+}
+
+// decryptingReader decrypts the data read from an underlying reader that was
+// encrypted by an encryptingReader.
+type decryptingReader struct {
+	aead   cipher.AEAD
+	src    io.Reader
+	prefix []byte
+	index  uint32
+	chunk  []byte
+	out    bytes.Buffer
+	done   bool
+}
+
+// newDecryptingReader returns a reader of the decrypted form of the data read
+// from ciphertext, which must start with the encryption header.
+func newDecryptingReader(key []byte, ciphertext io.Reader) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, len(encryptionMagic)+encryptionNoncePrefix)
+	if _, err := io.ReadFull(ciphertext, header); err != nil {
+		return nil, errors.Wrap(err, "error reading encryption header")
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], encryptionMagic) {
+		return nil, errors.New("data is not encrypted")
+	}
+
+	return &decryptingReader{
+		aead:   aead,
+		src:    ciphertext,
+		prefix: header[len(encryptionMagic):],
+		chunk:  make([]byte, encryptionChunkSize+aead.Overhead()),
+	}, nil
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for r.out.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.openChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.out.Read(p)
+}
+
+// openChunk reads and decrypts the next chunk of ciphertext.
+func (r *decryptingReader) openChunk() error {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r.src, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return errors.New("encrypted data is truncated")
+		}
+		return errors.WithStack(err)
+	}
+
+	length := binary.BigEndian.Uint32(header)
+	final := length&encryptionFinalChunk != 0
+	length &^= encryptionFinalChunk
+	if length > encryptionChunkSize {
+		return errors.Errorf("encrypted chunk length %d is invalid", length)
+	}
+
+	sealed := r.chunk[:int(length)+r.aead.Overhead()]
+	if _, err := io.ReadFull(r.src, sealed); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return errors.New("encrypted data is truncated")
+		}
+		return errors.WithStack(err)
+	}
+
+	plaintext, err := r.aead.Open(sealed[:0], chunkNonce(r.aead, r.prefix, r.index), sealed, header)
+	if err != nil {
+		return errors.Wrap(err, "error decrypting data, check that the backup storage location's encryption key is correct")
+	}
+	r.out.Write(plaintext)
+	r.index++
+
+	if final {
+		if n, _ := r.src.Read(make([]byte, 1)); n > 0 {
+			return errors.New("encrypted data has unexpected trailing bytes")
+		}
+		r.done = true
+	}
+
+	return nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// openObject returns a reader of an object's plaintext. Objects that start
+// with the encryption header are decrypted with key. Other objects are
+// returned as-is, so encrypted and plaintext objects can be read from the
+// same location, unless encrypted is set, since an object that's known to
+// have been encrypted, but isn't, may have been replaced.
+func openObject(key []byte, encrypted bool, object io.ReadCloser) (io.ReadCloser, error) {
+	if encrypted && key == nil {
+		object.Close()
+		return nil, errors.New("backup data is encrypted but the backup storage location has no encryption key")
+	}
+
+	br := bufio.NewReader(object)
+
+	magic, err := br.Peek(len(encryptionMagic))
+	if err != nil && err != io.EOF {
+		object.Close()
+		return nil, errors.WithStack(err)
+	}
+	if !bytes.Equal(magic, encryptionMagic) {
+		if encrypted {
+			object.Close()
+			return nil, errors.New("backup is marked as encrypted but its data in object storage isn't encrypted")
+		}
+		return readCloser{Reader: br, Closer: object}, nil
+	}
+
+	if key == nil {
+		object.Close()
+		return nil, errors.New("backup data is encrypted but the backup storage location has no encryption key")
+	}
+
+	r, err := newDecryptingReader(key, br)
+	if err != nil {
+		object.Close()
+		return nil, err
+	}
+
+	return readCloser{Reader: r, Closer: object}, nil
+}
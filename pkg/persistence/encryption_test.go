@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEncryptionKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, EncryptionKeySize)
+}
+
+func encrypt(t *testing.T, key, plaintext []byte) []byte {
+	t.Helper()
+
+	r, err := newEncryptingReader(key, bytes.NewReader(plaintext))
+	require.NoError(t, err)
+
+	ciphertext, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+
+	return ciphertext
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	rc, err := openObject(key, false, ioutil.NopCloser(bytes.NewReader(ciphertext)))
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return ioutil.ReadAll(rc)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty data", size: 0},
+		{name: "less than one chunk", size: 100},
+		{name: "exactly one chunk", size: encryptionChunkSize},
+		{name: "one byte more than a chunk", size: encryptionChunkSize + 1},
+		{name: "several chunks", size: 3*encryptionChunkSize + 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			key := testEncryptionKey(1)
+			plaintext := bytes.Repeat([]byte("velero"), tc.size/6+1)[:tc.size]
+
+			ciphertext := encrypt(t, key, plaintext)
+			assert.True(t, bytes.HasPrefix(ciphertext, encryptionMagic))
+			if tc.size > 0 {
+				assert.False(t, bytes.Contains(ciphertext, plaintext))
+			}
+
+			res, err := decrypt(key, ciphertext)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, res)
+		})
+	}
+}
+
+func TestEncryptionUsesUniqueNonces(t *testing.T) {
+	key := testEncryptionKey(1)
+	plaintext := []byte("some backup data")
+
+	assert.NotEqual(t, encrypt(t, key, plaintext), encrypt(t, key, plaintext))
+}
+
+func TestDecryptionErrors(t *testing.T) {
+	key := testEncryptionKey(1)
+	plaintext := bytes.Repeat([]byte("a"), 2*encryptionChunkSize+10)
+	ciphertext := encrypt(t, key, plaintext)
+	headerSize := len(encryptionMagic) + encryptionNoncePrefix
+	firstChunkSize := 4 + encryptionChunkSize + 16
+
+	tampered := append([]byte{}, ciphertext...)
+	tampered[headerSize+10]++
+
+	tests := []struct {
+		name       string
+		key        []byte
+		ciphertext []byte
+		wantErr    string
+	}{
+		{
+			name:       "wrong key",
+			key:        testEncryptionKey(2),
+			ciphertext: ciphertext,
+			wantErr:    "error decrypting data, check that the backup storage location's encryption key is correct: cipher: message authentication failed",
+		},
+		{
+			name:       "no key",
+			key:        nil,
+			ciphertext: ciphertext,
+			wantErr:    "backup data is encrypted but the backup storage location has no encryption key",
+		},
+		{
+			name:       "tampered data",
+			key:        key,
+			ciphertext: tampered,
+			wantErr:    "error decrypting data, check that the backup storage location's encryption key is correct: cipher: message authentication failed",
+		},
+		{
+			name:       "data truncated mid-chunk",
+			key:        key,
+			ciphertext: ciphertext[:len(ciphertext)-5],
+			wantErr:    "encrypted data is truncated",
+		},
+		{
+			name:       "data truncated at a chunk boundary",
+			key:        key,
+			ciphertext: ciphertext[:headerSize+firstChunkSize],
+			wantErr:    "encrypted data is truncated",
+		},
+		{
+			name:       "trailing data after the final chunk",
+			key:        key,
+			ciphertext: append(append([]byte{}, ciphertext...), 'x'),
+			wantErr:    "encrypted data has unexpected trailing bytes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decrypt(tc.key, tc.ciphertext)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestOpenObjectPlaintext(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       []byte
+		plaintext []byte
+	}{
+		{
+			name:      "plaintext is returned as-is without a key",
+			plaintext: []byte("some backup data"),
+		},
+		{
+			name:      "plaintext is returned as-is with a key",
+			key:       testEncryptionKey(1),
+			plaintext: []byte("some backup data"),
+		},
+		{
+			name:      "plaintext shorter than the encryption header is returned as-is",
+			key:       testEncryptionKey(1),
+			plaintext: []byte("VLR"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := decrypt(tc.key, tc.plaintext)
+			require.NoError(t, err)
+			assert.Equal(t, tc.plaintext, res)
+		})
+	}
+}
+
+func TestOpenObjectMarkedEncrypted(t *testing.T) {
+	key := testEncryptionKey(1)
+	plaintext := []byte("some backup data")
+
+	rc, err := openObject(key, true, ioutil.NopCloser(bytes.NewReader(encrypt(t, key, plaintext))))
+	require.NoError(t, err)
+	res, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, res)
+
+	_, err = openObject(key, true, ioutil.NopCloser(bytes.NewReader(plaintext)))
+	assert.EqualError(t, err, "backup is marked as encrypted but its data in object storage isn't encrypted")
+
+	_, err = openObject(nil, true, ioutil.NopCloser(bytes.NewReader(plaintext)))
+	assert.EqualError(t, err, "backup data is encrypted but the backup storage location has no encryption key")
+}
+
+func TestNewEncryptingReaderInvalidKey(t *testing.T) {
+	_, err := newEncryptingReader([]byte("too-short"), bytes.NewReader(nil))
+	assert.EqualError(t, err, "encryption key must be 32 bytes, got 9")
+}
@@ -18,11 +18,15 @@ package persistence
 
 import (
 	"bytes"
+	"crypto/md5"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
 	"time"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 type BucketData map[string][]byte
@@ -96,6 +100,38 @@ func (o *inMemoryObjectStore) GetObject(bucket, key string) (io.ReadCloser, erro
 	return ioutil.NopCloser(bytes.NewReader(obj)), nil
 }
 
+// GetObjectMetadata returns the object's metadata, with an ETag computed from
+// its content and its size.
+func (o *inMemoryObjectStore) GetObjectMetadata(bucket, key string) (*velero.ObjectMetadata, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+
+	obj, ok := bucketData[key]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+
+	return &velero.ObjectMetadata{ETag: fmt.Sprintf("%x", md5.Sum(obj)), Size: int64(len(obj))}, nil
+}
+
+func (o *inMemoryObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+
+	sizes := make(map[string]int64)
+	for key, obj := range bucketData {
+		if strings.HasPrefix(key, prefix) {
+			sizes[key] = int64(len(obj))
+		}
+	}
+
+	return sizes, nil
+}
+
 func (o *inMemoryObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
 	keys, err := o.ListObjects(bucket, prefix)
 	if err != nil {
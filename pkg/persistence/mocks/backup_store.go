@@ -24,6 +24,8 @@ import (
 	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	itemindex "github.com/vmware-tanzu/velero/pkg/itemindex"
+	itemstatus "github.com/vmware-tanzu/velero/pkg/itemstatus"
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -82,19 +84,65 @@ func (_m *BackupStore) DeleteRestore(name string) error {
 	return r0
 }
 
-// GetBackupContents provides a mock function with given fields: name
-func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	ret := _m.Called(name)
+// GetBackupContents provides a mock function with given fields: name, encrypted
+func (_m *BackupStore) GetBackupContents(name string, encrypted bool) (io.ReadCloser, error) {
+	ret := _m.Called(name, encrypted)
 
 	var r0 io.ReadCloser
-	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, bool) io.ReadCloser); ok {
+		r0 = rf(name, encrypted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(io.ReadCloser)
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(name, encrypted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupItemIndex provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupItemIndex(name string) (*itemindex.Index, error) {
+	ret := _m.Called(name)
+
+	var r0 *itemindex.Index
+	if rf, ok := ret.Get(0).(func(string) *itemindex.Index); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*itemindex.Index)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupItemStatus provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupItemStatus(name string) (*itemstatus.Manifest, error) {
+	ret := _m.Called(name)
+
+	var r0 *itemstatus.Manifest
+	if rf, ok := ret.Get(0).(func(string) *itemstatus.Manifest); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*itemstatus.Manifest)
+		}
+	}
+
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(name)
@@ -128,6 +176,27 @@ func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	return r0, r1
 }
 
+// GetBackupMetadataVersion provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupMetadataVersion(name string) (string, error) {
+	ret := _m.Called(name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupVolumeSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	ret := _m.Called(name)
@@ -172,6 +241,29 @@ func (_m *BackupStore) GetDownloadURL(target v1.DownloadTarget) (string, error)
 	return r0, r1
 }
 
+// GetStorageUsage provides a mock function with given fields:
+func (_m *BackupStore) GetStorageUsage() (*persistence.StorageUsage, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.StorageUsage
+	if rf, ok := ret.Get(0).(func() *persistence.StorageUsage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.StorageUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPodVolumeBackups provides a mock function with given fields: name
 func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup, error) {
 	ret := _m.Called(name)
@@ -195,6 +287,22 @@ func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup,
 	return r0, r1
 }
 
+// CheckAccess provides a mock function with given fields:
+func (_m *BackupStore) CheckAccess() []persistence.AccessCheckResult {
+	ret := _m.Called()
+
+	var r0 []persistence.AccessCheckResult
+	if rf, ok := ret.Get(0).(func() []persistence.AccessCheckResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]persistence.AccessCheckResult)
+		}
+	}
+
+	return r0
+}
+
 // IsValid provides a mock function with given fields:
 func (_m *BackupStore) IsValid() error {
 	ret := _m.Called()
@@ -232,6 +340,45 @@ func (_m *BackupStore) ListBackups() ([]string, error) {
 	return r0, r1
 }
 
+// ListClusters provides a mock function with given fields:
+func (_m *BackupStore) ListClusters() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForCluster provides a mock function with given fields: name
+func (_m *BackupStore) ForCluster(name string) persistence.BackupStore {
+	ret := _m.Called(name)
+
+	var r0 persistence.BackupStore
+	if rf, ok := ret.Get(0).(func(string) persistence.BackupStore); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(persistence.BackupStore)
+		}
+	}
+
+	return r0
+}
+
 // PutBackup provides a mock function with given fields: info
 func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	ret := _m.Called(info)
@@ -246,6 +393,34 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupLog provides a mock function with given fields: backup, log
+func (_m *BackupStore) PutBackupLog(backup string, log io.Reader) error {
+	ret := _m.Called(backup, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupMetadata provides a mock function with given fields: backup, metadata
+func (_m *BackupStore) PutBackupMetadata(backup string, metadata io.Reader) error {
+	ret := _m.Called(backup, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -274,6 +449,43 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 	return r0
 }
 
+// PutRestoreCheckpoint provides a mock function with given fields: backup, restore, checkpoint
+func (_m *BackupStore) PutRestoreCheckpoint(backup string, restore string, checkpoint io.Reader) error {
+	ret := _m.Called(backup, restore, checkpoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(backup, restore, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRestoreCheckpoint provides a mock function with given fields: restore
+func (_m *BackupStore) GetRestoreCheckpoint(restore string) (io.ReadCloser, error) {
+	ret := _m.Called(restore)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 func (_m *BackupStore) GetCSIVolumeSnapshots(backup string) ([]*snapshotv1beta1api.VolumeSnapshot, error) {
 	panic("Not implemented")
 	return nil, nil
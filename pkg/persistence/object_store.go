@@ -28,12 +28,16 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
+	"github.com/vmware-tanzu/velero/pkg/itemindex"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -45,6 +49,8 @@ type BackupInfo struct {
 	PodVolumeBackups,
 	VolumeSnapshots,
 	BackupResourceList,
+	ItemStatus,
+	ItemIndex,
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents io.Reader
 }
@@ -53,14 +59,44 @@ type BackupInfo struct {
 // Velero backup and restore data in/from a persistent backup store.
 type BackupStore interface {
 	IsValid() error
+	// CheckAccess runs a round trip of the operations Velero uses against
+	// the store, returning the result of each.
+	CheckAccess() []AccessCheckResult
 
 	ListBackups() ([]string, error)
+	// ListClusters returns the names of the clusters that have tagged backups
+	// in the store.
+	ListClusters() ([]string, error)
+	// ForCluster returns a BackupStore for the backups tagged with the given
+	// cluster name, which are stored under the cluster's own prefix. An empty
+	// name returns a BackupStore for untagged backups.
+	ForCluster(name string) BackupStore
 
 	PutBackup(info BackupInfo) error
+	// PutBackupLog uploads the log of a backup that's still running, so that
+	// it can be followed. The complete log is uploaded by PutBackup.
+	PutBackupLog(backup string, log io.Reader) error
+	// PutBackupMetadata replaces the metadata of a backup that's already been
+	// uploaded by PutBackup, such as to record that it failed verification.
+	PutBackupMetadata(backup string, metadata io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
+	// GetBackupMetadataVersion returns a value that changes whenever the backup's
+	// metadata file is written. It returns velero.ErrObjectMetadataNotSupported if
+	// the object store can't provide one.
+	GetBackupMetadataVersion(name string) (string, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
-	GetBackupContents(name string) (io.ReadCloser, error)
+	// GetBackupItemStatus returns the manifest of the items that failed to
+	// be backed up, or nil if the backup doesn't have one.
+	GetBackupItemStatus(name string) (*itemstatus.Manifest, error)
+	// GetBackupItemIndex returns the index of the items in the backup, or nil
+	// if the backup doesn't have one.
+	GetBackupItemIndex(name string) (*itemindex.Index, error)
+	// GetBackupContents returns a reader of a backup's contents tarball.
+	// encrypted is whether the backup is marked as encrypted in the location,
+	// as returned by BackupContentsEncrypted, in which case its tarball must
+	// be encrypted.
+	GetBackupContents(name string, encrypted bool) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1beta1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1beta1api.VolumeSnapshotContent, error)
 
@@ -71,19 +107,36 @@ type BackupStore interface {
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+	PutRestoreCheckpoint(backup, restore string, checkpoint io.Reader) error
+	// GetRestoreCheckpoint returns a reader of a restore's checkpoint file, or
+	// nil if the restore doesn't have one.
+	GetRestoreCheckpoint(restore string) (io.ReadCloser, error)
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+
+	// GetStorageUsage returns the number and total size of the objects of
+	// the backups of all clusters in the store.
+	GetStorageUsage() (*StorageUsage, error)
+}
+
+// StorageUsage is the space used by the backups in a backup store.
+type StorageUsage struct {
+	// Bytes is the total size of the backups' objects.
+	Bytes int64
+	// Objects is the number of the backups' objects.
+	Objects int
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
 const DownloadURLTTL = 10 * time.Minute
 
 type objectBackupStore struct {
-	objectStore velero.ObjectStore
-	bucket      string
-	layout      *ObjectStoreLayout
-	logger      logrus.FieldLogger
+	objectStore   velero.ObjectStore
+	bucket        string
+	layout        *ObjectStoreLayout
+	logger        logrus.FieldLogger
+	encryptionKey []byte
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -100,11 +153,19 @@ type ObjectBackupStoreGetter interface {
 
 type objectBackupStoreGetter struct {
 	credentialStore credentials.FileStore
+	secretStore     credentials.SecretStore
+	limiter         *rate.Limiter
 }
 
 // NewObjectBackupStoreGetter returns a ObjectBackupStoreGetter that can get a velero.BackupStore.
-func NewObjectBackupStoreGetter(credentialStore credentials.FileStore) ObjectBackupStoreGetter {
-	return &objectBackupStoreGetter{credentialStore: credentialStore}
+// The combined bandwidth of the uploads and downloads of all of the backup stores it gets is
+// limited to bandwidthLimit bytes per second, or unlimited if it's 0.
+func NewObjectBackupStoreGetter(credentialStore credentials.FileStore, secretStore credentials.SecretStore, bandwidthLimit int64) ObjectBackupStoreGetter {
+	return &objectBackupStoreGetter{
+		credentialStore: credentialStore,
+		secretStore:     secretStore,
+		limiter:         newBandwidthLimiter(bandwidthLimit),
+	}
 }
 
 func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
@@ -153,6 +214,25 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		location.Spec.Config["credentialsFile"] = credsFile
 	}
 
+	// If the BSL specifies an encryption key, fetch it so that backup contents
+	// can be encrypted before they're uploaded and decrypted when they're read.
+	var encryptionKey []byte
+	if location.Spec.EncryptionKey != nil {
+		key, err := b.secretStore.Get(location.Spec.EncryptionKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get encryption key")
+		}
+		if len(key) != EncryptionKeySize {
+			return nil, errors.Errorf("backup storage location's encryption key must be %d bytes, got %d", EncryptionKeySize, len(key))
+		}
+
+		encryptionKey = key
+	}
+
+	if err := validateRequestPolicy(location.Spec.RequestPolicy); err != nil {
+		return nil, err
+	}
+
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
 	if err != nil {
 		return nil, err
@@ -168,10 +248,11 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 	}))
 
 	return &objectBackupStore{
-		objectStore: objectStore,
-		bucket:      bucket,
-		layout:      NewObjectStoreLayout(prefix),
-		logger:      log,
+		objectStore:   newReadOnlyObjectStore(newRequestPolicyObjectStore(newRateLimitedObjectStore(objectStore, b.limiter), location.Spec.RequestPolicy), location),
+		bucket:        bucket,
+		layout:        NewObjectStoreLayout(prefix),
+		logger:        log,
+		encryptionKey: encryptionKey,
 	}, nil
 }
 
@@ -224,6 +305,53 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 	return output, nil
 }
 
+func (s *objectBackupStore) ListClusters() ([]string, error) {
+	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["clusters"], "/")
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		output = append(output, strings.TrimSuffix(strings.TrimPrefix(prefix, s.layout.subdirs["clusters"]), "/"))
+	}
+
+	return output, nil
+}
+
+func (s *objectBackupStore) ForCluster(name string) BackupStore {
+	clusterStore := *s
+	clusterStore.layout = s.layout.forCluster(name)
+	if name != "" {
+		clusterStore.logger = s.logger.WithField("cluster", name)
+	}
+	return &clusterStore
+}
+
+// BackupStoreForBackup returns the BackupStore for a backup's own files, taking
+// into account the cluster the backup is tagged with, if any.
+func BackupStoreForBackup(store BackupStore, backup *velerov1api.Backup) BackupStore {
+	if cluster := backup.Labels[velerov1api.ClusterNameLabel]; cluster != "" {
+		return store.ForCluster(cluster)
+	}
+	return store
+}
+
+// BackupContentsEncrypted returns whether a backup's contents tarball was
+// encrypted when it was uploaded to the named location, which is either the
+// backup's storage location or one of its mirror storage locations.
+func BackupContentsEncrypted(backup *velerov1api.Backup, location string) bool {
+	if location == backup.Spec.StorageLocation {
+		return backup.Status.Encrypted
+	}
+	for _, status := range backup.Status.MirrorStatuses {
+		if status.StorageLocation == location {
+			return status.Encrypted
+		}
+	}
+	return false
+}
+
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
@@ -243,7 +371,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.putBackupContents(info.Name, info.Contents); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
@@ -254,6 +382,8 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getPodVolumeBackupsKey(info.Name):          info.PodVolumeBackups,
 		s.layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
 		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
+		s.layout.getBackupItemStatusKey(info.Name):          info.ItemStatus,
+		s.layout.getBackupItemIndexKey(info.Name):           info.ItemIndex,
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
 	}
@@ -303,6 +433,87 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 	return backupObj, nil
 }
 
+func (s *objectBackupStore) GetBackupMetadataVersion(name string) (string, error) {
+	getter, ok := s.objectStore.(velero.ObjectMetadataGetter)
+	if !ok {
+		return "", errors.WithStack(velero.ErrObjectMetadataNotSupported)
+	}
+
+	metadata, err := getter.GetObjectMetadata(s.bucket, s.layout.getBackupMetadataKey(name))
+	if err != nil {
+		return "", err
+	}
+
+	// not every object store provides an ETag, so fall back to when the
+	// metadata file was last written.
+	switch {
+	case metadata.ETag != "":
+		return metadata.ETag, nil
+	case !metadata.LastModified.IsZero():
+		return metadata.LastModified.UTC().Format(time.RFC3339Nano), nil
+	default:
+		return "", errors.WithStack(velero.ErrObjectMetadataNotSupported)
+	}
+}
+
+// GetStorageUsage lists the sizes of the backups' objects if the object store
+// supports it, and otherwise sums the sizes in the metadata of each object,
+// which takes a request per object.
+func (s *objectBackupStore) GetStorageUsage() (*StorageUsage, error) {
+	usage, err := s.listStorageUsage()
+	if errors.Cause(err) != velero.ErrListObjectSizesNotSupported {
+		return usage, err
+	}
+	return s.sumStorageUsage()
+}
+
+func (s *objectBackupStore) listStorageUsage() (*StorageUsage, error) {
+	lister, ok := s.objectStore.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrListObjectSizesNotSupported)
+	}
+
+	usage := new(StorageUsage)
+	for _, dir := range s.layout.getAllBackupsDirs() {
+		sizes, err := lister.ListObjectSizes(s.bucket, dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing the sizes of the objects in %s", dir)
+		}
+
+		for _, size := range sizes {
+			usage.Bytes += size
+			usage.Objects++
+		}
+	}
+	return usage, nil
+}
+
+func (s *objectBackupStore) sumStorageUsage() (*StorageUsage, error) {
+	getter, ok := s.objectStore.(velero.ObjectMetadataGetter)
+	if !ok {
+		return nil, errors.New("object store supports neither listing object sizes nor getting object metadata")
+	}
+
+	usage := new(StorageUsage)
+	for _, dir := range s.layout.getAllBackupsDirs() {
+		keys, err := s.objectStore.ListObjects(s.bucket, dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing the objects in %s", dir)
+		}
+
+		for _, key := range keys {
+			metadata, err := getter.GetObjectMetadata(s.bucket, key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error getting the metadata of %s", key)
+			}
+
+			usage.Bytes += metadata.Size
+			usage.Objects++
+		}
+	}
+	return usage, nil
+}
+
 func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	// if the volumesnapshots file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no snapshots would not have this file, so check for
@@ -411,28 +622,129 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 	return podVolumeBackups, nil
 }
 
-func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+func (s *objectBackupStore) GetBackupItemStatus(name string) (*itemstatus.Manifest, error) {
+	// backups made before item statuses were recorded don't have the file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemStatusKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	manifest := new(itemstatus.Manifest)
+	if err := decode(res, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (s *objectBackupStore) GetBackupItemIndex(name string) (*itemindex.Index, error) {
+	// backups made before items were indexed don't have the file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemIndexKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	index := new(itemindex.Index)
+	if err := decode(res, index); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// putBackupContents uploads a backup's contents tarball, encrypting it first if
+// the location has an encryption key. Only the contents tarball is encrypted:
+// the backup's metadata, logs, item lists, volume snapshot lists and other
+// files are uploaded in plaintext.
+func (s *objectBackupStore) putBackupContents(name string, contents io.Reader) error {
+	key := s.layout.getBackupContentsKey(name)
+	if contents == nil || s.encryptionKey == nil {
+		return seekAndPutObject(s.objectStore, s.bucket, key, contents)
+	}
+
+	if err := seekToBeginning(contents); err != nil {
+		return errors.WithStack(err)
+	}
+
+	encrypted, err := newEncryptingReader(s.encryptionKey, contents)
+	if err != nil {
+		return err
+	}
+
+	return s.objectStore.PutObject(s.bucket, key, encrypted)
+}
+
+// GetBackupContents returns a reader of a backup's contents tarball. Encrypted
+// tarballs are decrypted with the location's encryption key, and if encrypted
+// is set, the tarball must be encrypted. The signed URL from GetDownloadURL
+// serves the object as stored, so clients downloading an encrypted tarball
+// through it get ciphertext.
+func (s *objectBackupStore) GetBackupContents(name string, encrypted bool) (io.ReadCloser, error) {
+	res, err := s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+	if err != nil {
+		return nil, err
+	}
+
+	return openObject(s.encryptionKey, encrypted, res)
 }
 
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
 
+// backupKeyPatterns returns the globs, relative to a backup's directory,
+// of the keys that deleting the backup is allowed to delete: its metadata,
+// and the files named after it, such as its contents and logs, including
+// the ones left behind by writes that failed.
+func backupKeyPatterns(backup string) []string {
+	return []string{"velero-backup.json", backup + ".*", backup + "-*"}
+}
+
+// DeleteBackup deletes the objects under the backup's directory whose keys
+// match backupKeyPatterns. Other objects, including the ones in directories
+// under the backup's directory, and objects whose keys aren't in the
+// backup's directory, are left as they are, so that deleting a backup never
+// deletes objects that don't belong to it.
 func (s *objectBackupStore) DeleteBackup(name string) error {
-	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
+	prefix := s.layout.getBackupDir(name)
+	objects, err := s.objectStore.ListObjects(s.bucket, prefix)
 	if err != nil {
 		return err
 	}
 
+	allowed := collections.NewIncludesExcludes().Includes(backupKeyPatterns(name)...)
+
 	var errs []error
 	for _, key := range objects {
-		s.logger.WithFields(logrus.Fields{
+		log := s.logger.WithFields(logrus.Fields{
 			"key": key,
-		}).Debug("Trying to delete object")
+		})
+		if !strings.HasPrefix(key, prefix) {
+			log.Warnf("Not deleting object because it isn't under the backup's prefix %s", prefix)
+			continue
+		}
+		// globs match across "/", so keys in nested directories are
+		// checked separately.
+		rel := strings.TrimPrefix(key, prefix)
+		if strings.Contains(rel, "/") || !allowed.MatchesIncludePattern(rel) {
+			log.Warn("Not deleting object because its key doesn't match the backup's keys")
+			continue
+		}
+
+		log.Debug("Trying to delete object")
 		if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
 			errs = append(errs, err)
+			continue
 		}
+		log.Info("Deleted object")
 	}
 
 	return errors.WithStack(kerrors.NewAggregate(errs))
@@ -457,6 +769,14 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) PutBackupLog(backup string, log io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupLogKey(backup), log)
+}
+
+func (s *objectBackupStore) PutBackupMetadata(backup string, metadata io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupMetadataKey(backup), metadata)
+}
+
 func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
 }
@@ -465,6 +785,14 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
+func (s *objectBackupStore) PutRestoreCheckpoint(backup string, restore string, checkpoint io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreCheckpointKey(restore), checkpoint)
+}
+
+func (s *objectBackupStore) GetRestoreCheckpoint(restore string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getRestoreCheckpointKey(restore))
+}
+
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
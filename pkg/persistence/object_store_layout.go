@@ -40,6 +40,7 @@ func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 		"restic":   path.Join(prefix, "restic") + "/",
 		"metadata": path.Join(prefix, "metadata") + "/",
 		"plugins":  path.Join(prefix, "plugins") + "/",
+		"clusters": path.Join(prefix, "clusters") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -55,6 +56,38 @@ func (l *ObjectStoreLayout) GetResticDir() string {
 	return l.subdirs["restic"]
 }
 
+// forCluster returns the layout for the backups tagged with the given cluster
+// name, which are stored in a backups directory under the cluster's own
+// prefix. Everything else is stored in the same place as in l.
+func (l *ObjectStoreLayout) forCluster(cluster string) *ObjectStoreLayout {
+	subdirs := make(map[string]string, len(l.subdirs))
+	for name, dir := range l.subdirs {
+		subdirs[name] = dir
+	}
+	subdirs["backups"] = path.Join(l.rootPrefix, "backups") + "/"
+	if cluster != "" {
+		subdirs["backups"] = path.Join(l.rootPrefix, "clusters", cluster, "backups") + "/"
+	}
+
+	return &ObjectStoreLayout{
+		rootPrefix: l.rootPrefix,
+		subdirs:    subdirs,
+	}
+}
+
+// getAllBackupsDirs returns the directories of the backups of all
+// clusters, tagged or not.
+func (l *ObjectStoreLayout) getAllBackupsDirs() []string {
+	return []string{path.Join(l.rootPrefix, "backups") + "/", l.subdirs["clusters"]}
+}
+
+// getAccessCheckKey returns the key of an access check's test object, which
+// is in the metadata directory so that it doesn't make the store invalid if
+// it can't be deleted.
+func (l *ObjectStoreLayout) getAccessCheckKey(name string) string {
+	return path.Join(l.subdirs["metadata"], "access-check-"+name)
+}
+
 func (l *ObjectStoreLayout) isValidSubdir(name string) bool {
 	_, ok := l.subdirs[name]
 	return ok
@@ -92,6 +125,14 @@ func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupItemStatusKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-item-status.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupItemIndexKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-item-index.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -100,6 +141,10 @@ func (l *ObjectStoreLayout) getRestoreResultsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-results.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreCheckpointKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-checkpoint.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -30,12 +30,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemindex"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -140,6 +143,13 @@ func TestIsValid(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "backup store with clusters directory is valid",
+			storageData: map[string][]byte{
+				"clusters/cluster-a/backups/backup-1/velero-backup.json": {},
+			},
+			expectErr: false,
+		},
 		{
 			name: "backup store with plugins directory is valid",
 			storageData: map[string][]byte{
@@ -214,6 +224,67 @@ func TestListBackups(t *testing.T) {
 	}
 }
 
+func TestClusterBackups(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("foo", "velero-backups")
+
+	for key, obj := range map[string][]byte{
+		"velero-backups/backups/backup-1/velero-backup.json":                    encodeToBytes(builder.ForBackup("", "backup-1").Result()),
+		"velero-backups/clusters/cluster-a/backups/backup-2/velero-backup.json": encodeToBytes(builder.ForBackup("", "backup-2").Result()),
+		"velero-backups/clusters/cluster-b/backups/backup-3/velero-backup.json": encodeToBytes(builder.ForBackup("", "backup-3").Result()),
+	} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, bytes.NewReader(obj)))
+	}
+
+	clusters, err := harness.ListClusters()
+	require.NoError(t, err)
+	sort.Strings(clusters)
+	assert.Equal(t, []string{"cluster-a", "cluster-b"}, clusters)
+
+	// untagged backups don't include the clusters' backups
+	res, err := harness.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-1"}, res)
+
+	clusterStore := harness.ForCluster("cluster-a")
+	res, err = clusterStore.ListBackups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup-2"}, res)
+
+	backup, err := clusterStore.GetBackupMetadata("backup-2")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-2", backup.Name)
+
+	exists, err := clusterStore.BackupExists(harness.bucket, "backup-3")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// a tagged backup's files are stored under its cluster's prefix, while
+	// restores aren't
+	require.NoError(t, clusterStore.PutBackup(BackupInfo{
+		Name:     "backup-4",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("contents"),
+	}))
+	exists, err = harness.objectStore.ObjectExists(harness.bucket, "velero-backups/clusters/cluster-a/backups/backup-4/velero-backup.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, clusterStore.PutRestoreLog("backup-4", "restore-1", newStringReadSeeker("log")))
+	exists, err = harness.objectStore.ObjectExists(harness.bucket, "velero-backups/restores/restore-1/restore-restore-1-logs.gz")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// a backup is read from the store of the cluster it's tagged with
+	tagged := builder.ForBackup("", "backup-2").ObjectMeta(builder.WithLabels(velerov1api.ClusterNameLabel, "cluster-a")).Result()
+	exists, err = BackupStoreForBackup(harness, tagged).BackupExists(harness.bucket, "backup-2")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = BackupStoreForBackup(harness, builder.ForBackup("", "backup-2").Result()).BackupExists(harness.bucket, "backup-2")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
 func TestPutBackup(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -384,6 +455,73 @@ func TestGetBackupMetadata(t *testing.T) {
 	}
 }
 
+func TestGetBackupMetadataVersion(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// no metadata file returns an error
+	_, err := harness.GetBackupMetadataVersion("foo")
+	assert.EqualError(t, err, "key not found")
+
+	// the version only changes when the metadata file is written
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/foo/velero-backup.json", newStringReadSeeker("foo-1")))
+	v1, err := harness.GetBackupMetadataVersion("foo")
+	require.NoError(t, err)
+	assert.NotEmpty(t, v1)
+
+	v2, err := harness.GetBackupMetadataVersion("foo")
+	require.NoError(t, err)
+	assert.Equal(t, v1, v2)
+
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/foo/velero-backup.json", newStringReadSeeker("foo-2")))
+	v3, err := harness.GetBackupMetadataVersion("foo")
+	require.NoError(t, err)
+	assert.NotEqual(t, v1, v3)
+}
+
+// metadataOnlyObjectStore hides that an object store supports listing object
+// sizes.
+type metadataOnlyObjectStore struct {
+	velero.ObjectStore
+	velero.ObjectMetadataGetter
+}
+
+func TestGetStorageUsage(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix")
+
+	for key, data := range map[string]string{
+		"prefix/backups/backup-1/velero-backup.json":                    "12345",
+		"prefix/backups/backup-1/backup-1.tar.gz":                       "1234567890",
+		"prefix/clusters/cluster-1/backups/backup-2/velero-backup.json": "123",
+		"prefix/restores/restore-1/restore-restore-1-logs.gz":           "not counted",
+		"prefix/restic/ns-1/config":                                     "not counted",
+		"other/backups/backup-3/velero-backup.json":                     "not counted",
+	} {
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, key, newStringReadSeeker(data)))
+	}
+	want := &StorageUsage{Bytes: 18, Objects: 3}
+
+	// the object store lists object sizes
+	usage, err := harness.GetStorageUsage()
+	require.NoError(t, err)
+	assert.Equal(t, want, usage)
+
+	// tagged backups are counted by every cluster's store
+	usage, err = harness.ForCluster("cluster-1").GetStorageUsage()
+	require.NoError(t, err)
+	assert.Equal(t, want, usage)
+
+	// the object store only gets object metadata
+	harness.objectBackupStore.objectStore = &metadataOnlyObjectStore{ObjectStore: harness.objectStore, ObjectMetadataGetter: harness.objectStore}
+	usage, err = harness.GetStorageUsage()
+	require.NoError(t, err)
+	assert.Equal(t, want, usage)
+
+	// the object store supports neither
+	harness.objectBackupStore.objectStore = new(providermocks.ObjectStore)
+	_, err = harness.GetStorageUsage()
+	assert.EqualError(t, err, "object store supports neither listing object sizes nor getting object metadata")
+}
+
 func TestGetBackupVolumeSnapshots(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -426,12 +564,71 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 	assert.EqualValues(t, snapshots, res)
 }
 
+func TestGetBackupItemStatus(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// backups made before item statuses were recorded don't have the file
+	res, err := harness.GetBackupItemStatus("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	manifest := &itemstatus.Manifest{
+		Items: []itemstatus.Item{
+			{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Errors: []string{"error 1"}},
+		},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(manifest))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-item-status.json.gz", obj))
+
+	res, err = harness.GetBackupItemStatus("test-backup")
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, res)
+}
+
+func TestGetBackupItemIndex(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// backups made before item indexes were recorded don't have the file
+	res, err := harness.GetBackupItemIndex("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	index := &itemindex.Index{
+		Items: []itemindex.Item{
+			{
+				Resource:        "v1/Pod",
+				Namespace:       "ns-1",
+				Name:            "pod-1",
+				ResourceVersion: "1",
+				Backup:          "base-backup",
+				Files:           []string{"resources/pods/namespaces/ns-1/pod-1.json"},
+			},
+		},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(index))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-item-index.json.gz", obj))
+
+	res, err = harness.GetBackupItemIndex("test-backup")
+	assert.NoError(t, err)
+	assert.Equal(t, index, res)
+}
+
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
 	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup.tar.gz", newStringReadSeeker("foo"))
 
-	rc, err := harness.GetBackupContents("test-backup")
+	rc, err := harness.GetBackupContents("test-backup", false)
 	require.NoError(t, err)
 	require.NotNil(t, rc)
 
@@ -440,6 +637,90 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestGetBackupContentsEncrypted(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.encryptionKey = testEncryptionKey(1)
+
+	err := harness.PutBackup(BackupInfo{
+		Name:     "encrypted-backup",
+		Metadata: newStringReadSeeker("metadata"),
+		Contents: newStringReadSeeker("encrypted contents"),
+	})
+	require.NoError(t, err)
+
+	// backups written before the encryption key was set are stored as plaintext
+	harness.objectStore.PutObject(harness.bucket, "backups/plaintext-backup/plaintext-backup.tar.gz", newStringReadSeeker("plaintext contents"))
+
+	stored := harness.objectStore.Data[harness.bucket]["backups/encrypted-backup/encrypted-backup.tar.gz"]
+	assert.True(t, bytes.HasPrefix(stored, encryptionMagic))
+	assert.False(t, bytes.Contains(stored, []byte("encrypted contents")))
+
+	// the metadata isn't encrypted so that backups can be synced from the location
+	assert.Equal(t, []byte("metadata"), harness.objectStore.Data[harness.bucket]["backups/encrypted-backup/velero-backup.json"])
+
+	for _, tc := range []struct {
+		name      string
+		encrypted bool
+		want      string
+	}{
+		{name: "encrypted-backup", encrypted: true, want: "encrypted contents"},
+		{name: "plaintext-backup", encrypted: false, want: "plaintext contents"},
+	} {
+		rc, err := harness.GetBackupContents(tc.name, tc.encrypted)
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, string(data))
+	}
+
+	// a backup that's marked as encrypted must not be read as plaintext
+	_, err = harness.GetBackupContents("plaintext-backup", true)
+	assert.EqualError(t, err, "backup is marked as encrypted but its data in object storage isn't encrypted")
+
+	// an encrypted backup can't be read from a location without the key
+	harness.encryptionKey = nil
+	_, err = harness.GetBackupContents("encrypted-backup", true)
+	assert.EqualError(t, err, "backup data is encrypted but the backup storage location has no encryption key")
+}
+
+func TestBackupContentsEncrypted(t *testing.T) {
+	backup := builder.ForBackup("velero", "backup-1").StorageLocation("primary").Result()
+	backup.Status.Encrypted = true
+	backup.Status.MirrorStatuses = []velerov1api.BackupMirrorStatus{
+		{StorageLocation: "encrypted-mirror", Phase: velerov1api.BackupMirrorPhaseCompleted, Encrypted: true},
+		{StorageLocation: "plaintext-mirror", Phase: velerov1api.BackupMirrorPhaseCompleted},
+	}
+
+	assert.True(t, BackupContentsEncrypted(backup, "primary"))
+	assert.True(t, BackupContentsEncrypted(backup, "encrypted-mirror"))
+	assert.False(t, BackupContentsEncrypted(backup, "plaintext-mirror"))
+	assert.False(t, BackupContentsEncrypted(backup, "unknown"))
+}
+
+func TestRestoreCheckpoint(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a restore without a checkpoint file returns nil
+	res, err := harness.GetRestoreCheckpoint("test-restore")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	require.NoError(t, harness.PutRestoreCheckpoint("test-backup", "test-restore", newStringReadSeeker("checkpoint")))
+	exists, err := harness.objectStore.ObjectExists(harness.bucket, "restores/test-restore/restore-test-restore-checkpoint.json.gz")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	res, err = harness.GetRestoreCheckpoint("test-restore")
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	defer res.Close()
+
+	data, err := ioutil.ReadAll(res)
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint", string(data))
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -492,6 +773,46 @@ func TestDeleteBackup(t *testing.T) {
 	}
 }
 
+func TestDeleteBackupOnlyDeletesBackupKeys(t *testing.T) {
+	objectStore := new(providermocks.ObjectStore)
+	backupStore := &objectBackupStore{
+		objectStore: objectStore,
+		bucket:      "test-bucket",
+		layout:      NewObjectStoreLayout(""),
+		logger:      velerotest.NewLogger(),
+	}
+	defer objectStore.AssertExpectations(t)
+
+	deleted := []string{
+		"backups/bak/velero-backup.json",
+		"backups/bak/bak.tar.gz",
+		"backups/bak/bak-logs.gz",
+		"backups/bak/bak-item-status.json.gz",
+		"backups/bak/bak-resource-list.json.gz.tmp",
+	}
+	kept := []string{
+		// keys that aren't named after the backup.
+		"backups/bak/notes.txt",
+		"backups/bak/other-backup.tar.gz",
+		"backups/bak/nested/bak.tar.gz",
+		"backups/bak/bak-nested/bak.tar.gz",
+		// keys outside the backup's prefix, which object stores shouldn't
+		// return, but aren't trusted not to.
+		"backups/bak-2/bak-2.tar.gz",
+		"restores/bak/bak.tar.gz",
+	}
+
+	objectStore.On("ListObjects", backupStore.bucket, "backups/bak/").Return(append(deleted, kept...), nil)
+	for _, key := range deleted {
+		objectStore.On("DeleteObject", backupStore.bucket, key).Return(nil)
+	}
+
+	require.NoError(t, backupStore.DeleteBackup("bak"))
+	for _, key := range kept {
+		objectStore.AssertNotCalled(t, "DeleteObject", backupStore.bucket, key)
+	}
+}
+
 func TestGetDownloadURL(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -646,6 +967,22 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 			credFileStore: velerotest.NewFakeCredentialsFileStore("", fmt.Errorf("secret does not exist")),
 			wantErr:       "unable to get credentials: secret does not exist",
 		},
+		{
+			name: "when the request timeout isn't positive, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").RequestPolicy(
+				&velerov1api.BackupStorageLocationRequestPolicy{Timeout: &metav1.Duration{}},
+			).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "backup storage location's request timeout 0s must be positive",
+		},
+		{
+			name: "when the request retries are negative, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").RequestPolicy(
+				&velerov1api.BackupStorageLocationRequestPolicy{Retries: -1},
+			).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", nil),
+			wantErr:       "backup storage location's request retries -1 must not be negative",
+		},
 		{
 			name:     "when Bucket has a leading and trailing slash, they are both stripped",
 			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("/bucket/").Result(),
@@ -679,7 +1016,7 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			getter := NewObjectBackupStoreGetter(tc.credFileStore)
+			getter := NewObjectBackupStoreGetter(tc.credFileStore, newFakeSecretStore(nil, nil), 0)
 			res, err := getter.Get(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.EqualError(t, err, tc.wantErr)
@@ -712,7 +1049,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket but no prefix has config initialized with bucket and empty prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), newFakeSecretStore(nil, nil), 0),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -721,7 +1058,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with bucket and prefix has config initialized with bucket and prefix",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Prefix("prefix").Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), newFakeSecretStore(nil, nil), 0),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "prefix",
@@ -730,7 +1067,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 		{
 			name:     "location with CACert is initialized with caCert",
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).CACert([]byte("cacert-data")).Result(),
-			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil)),
+			getter:   NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), newFakeSecretStore(nil, nil), 0),
 			wantConfig: map[string]string{
 				"bucket": "bucket",
 				"prefix": "",
@@ -742,7 +1079,7 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 			location: builder.ForBackupStorageLocation("", "").Provider(provider).Bucket(bucket).Credential(
 				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
 			).Result(),
-			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil)),
+			getter: NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil), newFakeSecretStore(nil, nil), 0),
 			wantConfig: map[string]string{
 				"bucket":          "bucket",
 				"prefix":          "",
@@ -763,6 +1100,82 @@ func TestNewObjectBackupStoreGetterConfig(t *testing.T) {
 	}
 }
 
+// fakeSecretStore is a credentials.SecretStore that returns the same data and
+// error for every secret key.
+type fakeSecretStore struct {
+	data []byte
+	err  error
+}
+
+func newFakeSecretStore(data []byte, err error) credentials.SecretStore {
+	return &fakeSecretStore{data: data, err: err}
+}
+
+func (f *fakeSecretStore) Get(*corev1api.SecretKeySelector) ([]byte, error) {
+	return f.data, f.err
+}
+
+// TestNewObjectBackupStoreGetterEncryptionKey runs the NewObjectBackupStoreGetter constructor
+// and ensures that it initializes the ObjectBackupStore with the location's encryption key.
+func TestNewObjectBackupStoreGetterEncryptionKey(t *testing.T) {
+	location := builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").EncryptionKey(
+		builder.ForSecretKeySelector("encryption", "key").Result(),
+	).Result()
+
+	tests := []struct {
+		name        string
+		location    *velerov1api.BackupStorageLocation
+		secretStore credentials.SecretStore
+		wantKey     []byte
+		wantErr     string
+	}{
+		{
+			name:        "location without an encryption key has no key",
+			location:    builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").Result(),
+			secretStore: newFakeSecretStore(testEncryptionKey(1), nil),
+			wantKey:     nil,
+		},
+		{
+			name:        "location with an encryption key has the key from its secret",
+			location:    location,
+			secretStore: newFakeSecretStore(testEncryptionKey(1), nil),
+			wantKey:     testEncryptionKey(1),
+		},
+		{
+			name:        "location with an encryption key of the wrong size returns an error",
+			location:    location,
+			secretStore: newFakeSecretStore([]byte("too-short"), nil),
+			wantErr:     "backup storage location's encryption key must be 32 bytes, got 9",
+		},
+		{
+			name:        "location with an encryption key that can't be fetched returns an error",
+			location:    location,
+			secretStore: newFakeSecretStore(nil, errors.New("secret not found")),
+			wantErr:     "unable to get encryption key: secret not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objStoreGetter := objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			}
+
+			getter := NewObjectBackupStoreGetter(velerotest.NewFakeCredentialsFileStore("", nil), tc.secretStore, 0)
+			res, err := getter.Get(tc.location, objStoreGetter, velerotest.NewLogger())
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			store, ok := res.(*objectBackupStore)
+			require.True(t, ok)
+			assert.Equal(t, tc.wantKey, store.encryptionKey)
+		})
+	}
+}
+
 func encodeToBytes(obj runtime.Object) []byte {
 	res, err := encode.Encode(obj, "json")
 	if err != nil {
@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"context"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// newBandwidthLimiter returns a limiter of bandwidthLimit bytes per second,
+// or nil if bandwidthLimit isn't positive, meaning unlimited. Its burst is a
+// second's worth of bytes, which is also the most a single read waits for.
+func newBandwidthLimiter(bandwidthLimit int64) *rate.Limiter {
+	if bandwidthLimit <= 0 {
+		return nil
+	}
+
+	burst := bandwidthLimit
+	if burst > math.MaxInt32 {
+		burst = math.MaxInt32
+	}
+	return rate.NewLimiter(rate.Limit(bandwidthLimit), int(burst))
+}
+
+// rateLimitedObjectStore limits the bandwidth of the objects uploaded to and
+// downloaded from an ObjectStore. The limiter can be shared by any number of
+// object stores, so that their combined bandwidth is limited.
+type rateLimitedObjectStore struct {
+	velero.ObjectStore
+	limiter *rate.Limiter
+}
+
+func newRateLimitedObjectStore(objectStore velero.ObjectStore, limiter *rate.Limiter) velero.ObjectStore {
+	if limiter == nil {
+		return objectStore
+	}
+	return &rateLimitedObjectStore{ObjectStore: objectStore, limiter: limiter}
+}
+
+func (o *rateLimitedObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	return o.ObjectStore.PutObject(bucket, key, &rateLimitedReader{reader: body, limiter: o.limiter})
+}
+
+func (o *rateLimitedObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	body, err := o.ObjectStore.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return &rateLimitedReadCloser{
+		Reader: &rateLimitedReader{reader: body, limiter: o.limiter},
+		Closer: body,
+	}, nil
+}
+
+// GetObjectMetadata gets an object's metadata if the object store supports
+// it, so that limiting an object store doesn't hide that it does.
+func (o *rateLimitedObjectStore) GetObjectMetadata(bucket, key string) (*velero.ObjectMetadata, error) {
+	getter, ok := o.ObjectStore.(velero.ObjectMetadataGetter)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrObjectMetadataNotSupported)
+	}
+	return getter.GetObjectMetadata(bucket, key)
+}
+
+// ListObjectSizes lists the sizes of objects if the object store supports it.
+// Listings aren't limited, since only object contents are.
+func (o *rateLimitedObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	lister, ok := o.ObjectStore.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrListObjectSizesNotSupported)
+	}
+	return lister.ListObjectSizes(bucket, prefix)
+}
+
+// rateLimitedReader waits for the limiter to allow the bytes it reads.
+type rateLimitedReader struct {
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	// the limiter can't allow more than its burst at once, so reads are
+	// limited to it.
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(context.Background(), n); waitErr != nil && err == nil {
+			err = waitErr
+		}
+	}
+	return n, err
+}
+
+type rateLimitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,93 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBandwidthLimiter(t *testing.T) {
+	assert.Nil(t, newBandwidthLimiter(0))
+	assert.Nil(t, newBandwidthLimiter(-1))
+
+	limiter := newBandwidthLimiter(1024)
+	require.NotNil(t, limiter)
+	assert.Equal(t, 1024, limiter.Burst())
+
+	objectStore := newInMemoryObjectStore("bucket")
+	assert.Equal(t, objectStore, newRateLimitedObjectStore(objectStore, nil))
+}
+
+func TestRateLimitedObjectStoreThroughput(t *testing.T) {
+	const limit = 512 * 1024
+
+	objectStore := newRateLimitedObjectStore(newInMemoryObjectStore("bucket"), newBandwidthLimiter(limit))
+
+	// the limiter starts with a second's worth of bytes, so uploading two
+	// seconds' worth takes about a second.
+	data := bytes.Repeat([]byte("a"), 2*limit)
+
+	start := time.Now()
+	require.NoError(t, objectStore.PutObject("bucket", "key", bytes.NewReader(data)))
+	elapsed := time.Since(start)
+
+	assert.True(t, elapsed >= 900*time.Millisecond, "upload took %v, faster than the limit allows", elapsed)
+	assert.True(t, elapsed < 3*time.Second, "upload took %v, much slower than the limit", elapsed)
+
+	// downloads share the limiter, whose burst has been used up by the upload.
+	body, err := objectStore.GetObject("bucket", "key")
+	require.NoError(t, err)
+	defer body.Close()
+
+	start = time.Now()
+	downloaded, err := ioutil.ReadAll(io.LimitReader(body, limit/2))
+	require.NoError(t, err)
+	elapsed = time.Since(start)
+
+	assert.Equal(t, data[:limit/2], downloaded)
+	assert.True(t, elapsed >= 400*time.Millisecond, "download took %v, faster than the limit allows", elapsed)
+	assert.True(t, elapsed < 2*time.Second, "download took %v, much slower than the limit", elapsed)
+}
+
+func TestRateLimitedReaderReadSizes(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 100)
+
+	// small reads don't wait for more bytes than they read.
+	reader := &rateLimitedReader{reader: bytes.NewReader(data), limiter: newBandwidthLimiter(1000)}
+	buf := make([]byte, 1)
+	for i := 0; i < len(data); i++ {
+		n, err := reader.Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	}
+	_, err := reader.Read(buf)
+	assert.Equal(t, io.EOF, err)
+
+	// reads bigger than the limiter's burst are shortened to it rather than
+	// failing or waiting forever.
+	reader = &rateLimitedReader{reader: bytes.NewReader(data), limiter: newBandwidthLimiter(10)}
+	n, err := reader.Read(make([]byte, len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, 10, n)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ErrReadOnly is the cause of the errors returned by attempts to write to a
+// read-only backup storage location.
+var ErrReadOnly = errors.New("backup storage location is read-only")
+
+// readOnlyObjectStore refuses to upload or delete objects in the object store
+// of a read-only backup storage location, so that a location whose bucket is
+// immutable, or that's only meant to be restored from, is never written to
+// regardless of which code path attempts it.
+type readOnlyObjectStore struct {
+	velero.ObjectStore
+	location string
+}
+
+func newReadOnlyObjectStore(objectStore velero.ObjectStore, location *velerov1api.BackupStorageLocation) velero.ObjectStore {
+	if location.Spec.AccessMode != velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return objectStore
+	}
+	return &readOnlyObjectStore{ObjectStore: objectStore, location: location.Name}
+}
+
+func (o *readOnlyObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	return errors.Wrapf(ErrReadOnly, "error uploading %s to backup storage location %s", key, o.location)
+}
+
+func (o *readOnlyObjectStore) DeleteObject(bucket, key string) error {
+	return errors.Wrapf(ErrReadOnly, "error deleting %s from backup storage location %s", key, o.location)
+}
+
+// GetObjectMetadata gets an object's metadata if the object store supports
+// it, so that making an object store read-only doesn't hide that it does.
+func (o *readOnlyObjectStore) GetObjectMetadata(bucket, key string) (*velero.ObjectMetadata, error) {
+	getter, ok := o.ObjectStore.(velero.ObjectMetadataGetter)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrObjectMetadataNotSupported)
+	}
+	return getter.GetObjectMetadata(bucket, key)
+}
+
+// ListObjectSizes lists the sizes of objects if the object store supports it.
+func (o *readOnlyObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	lister, ok := o.ObjectStore.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrListObjectSizesNotSupported)
+	}
+	return lister.ListObjectSizes(bucket, prefix)
+}
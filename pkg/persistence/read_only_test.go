@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestReadOnlyObjectStore(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	require.NoError(t, objectStore.PutObject("bucket", "key", bytes.NewReader([]byte("data"))))
+
+	readWrite := builder.ForBackupStorageLocation("velero", "read-write").AccessMode(velerov1api.BackupStorageLocationAccessModeReadWrite).Result()
+	assert.Equal(t, objectStore, newReadOnlyObjectStore(objectStore, readWrite))
+
+	readOnly := newReadOnlyObjectStore(objectStore, builder.ForBackupStorageLocation("velero", "archive").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result())
+
+	// objects can be read and listed...
+	body, err := readOnly.GetObject("bucket", "key")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	keys, err := readOnly.ListObjects("bucket", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key"}, keys)
+
+	// ...but not written or deleted.
+	err = readOnly.PutObject("bucket", "other-key", bytes.NewReader([]byte("data")))
+	assert.EqualError(t, err, "error uploading other-key to backup storage location archive: backup storage location is read-only")
+	assert.Equal(t, ErrReadOnly, errors.Cause(err))
+
+	err = readOnly.DeleteObject("bucket", "key")
+	assert.EqualError(t, err, "error deleting key from backup storage location archive: backup storage location is read-only")
+
+	exists, err := objectStore.ObjectExists("bucket", "key")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = objectStore.ObjectExists("bucket", "other-key")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
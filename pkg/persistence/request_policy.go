@@ -0,0 +1,247 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// objectStoreRetryDelay is how long a failed object store request waits
+// before it's retried.
+var objectStoreRetryDelay = time.Second
+
+// validateRequestPolicy checks a backup storage location's request policy.
+func validateRequestPolicy(policy *velerov1api.BackupStorageLocationRequestPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.Timeout != nil && policy.Timeout.Duration <= 0 {
+		return errors.Errorf("backup storage location's request timeout %v must be positive", policy.Timeout.Duration)
+	}
+	if policy.Retries < 0 {
+		return errors.Errorf("backup storage location's request retries %d must not be negative", policy.Retries)
+	}
+	return nil
+}
+
+// requestTimeoutError is returned by object store requests that time out.
+type requestTimeoutError struct {
+	request string
+	timeout time.Duration
+}
+
+func (e *requestTimeoutError) Error() string {
+	return "object store " + e.request + " request timed out after " + e.timeout.String()
+}
+
+// requestPolicyObjectStore times out and retries the requests made to an
+// ObjectStore. The plugin interface can't cancel requests, so a request that
+// times out keeps running in the background until it returns, and any
+// object it returns is closed.
+type requestPolicyObjectStore struct {
+	velero.ObjectStore
+	timeout time.Duration
+	retries int
+}
+
+func newRequestPolicyObjectStore(objectStore velero.ObjectStore, policy *velerov1api.BackupStorageLocationRequestPolicy) velero.ObjectStore {
+	if policy == nil || (policy.Timeout == nil && policy.Retries == 0) {
+		return objectStore
+	}
+
+	o := &requestPolicyObjectStore{ObjectStore: objectStore, retries: policy.Retries}
+	if policy.Timeout != nil {
+		o.timeout = policy.Timeout.Duration
+	}
+	return o
+}
+
+type requestResult struct {
+	value interface{}
+	err   error
+}
+
+// do makes a request, retrying it if it fails for as long as retryable says
+// so.
+func (o *requestPolicyObjectStore) do(request string, retryable func(err error) bool, fn func() (interface{}, error)) (interface{}, error) {
+	for attempt := 0; ; attempt++ {
+		value, err := o.withTimeout(request, fn)
+		if err == nil || attempt >= o.retries || !retryable(err) {
+			return value, err
+		}
+		time.Sleep(objectStoreRetryDelay)
+	}
+}
+
+func (o *requestPolicyObjectStore) withTimeout(request string, fn func() (interface{}, error)) (interface{}, error) {
+	if o.timeout == 0 {
+		return fn()
+	}
+
+	done := make(chan requestResult, 1)
+	go func() {
+		value, err := fn()
+		done <- requestResult{value: value, err: err}
+	}()
+
+	timer := time.NewTimer(o.timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-done:
+		return result.value, result.err
+	case <-timer.C:
+		go func() {
+			if closer, ok := (<-done).value.(io.Closer); ok {
+				closer.Close()
+			}
+		}()
+		return nil, errors.WithStack(&requestTimeoutError{request: request, timeout: o.timeout})
+	}
+}
+
+func alwaysRetryable(error) bool {
+	return true
+}
+
+func (o *requestPolicyObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	// an upload can only be retried if its body can be read again, and not
+	// after it timed out, since the timed out upload may still be reading it.
+	seeker, seekable := body.(io.Seeker)
+	var offset int64
+	if seekable {
+		var err error
+		if offset, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+			seekable = false
+		}
+	}
+
+	attempt := 0
+	_, err := o.do("PutObject", func(err error) bool {
+		_, timedOut := errors.Cause(err).(*requestTimeoutError)
+		return seekable && !timedOut
+	}, func() (interface{}, error) {
+		if attempt > 0 {
+			if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+		attempt++
+		return nil, o.ObjectStore.PutObject(bucket, key, body)
+	})
+	return err
+}
+
+func (o *requestPolicyObjectStore) ObjectExists(bucket, key string) (bool, error) {
+	exists, err := o.do("ObjectExists", alwaysRetryable, func() (interface{}, error) {
+		return o.ObjectStore.ObjectExists(bucket, key)
+	})
+	if err != nil {
+		return false, err
+	}
+	return exists.(bool), nil
+}
+
+func (o *requestPolicyObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	body, err := o.do("GetObject", alwaysRetryable, func() (interface{}, error) {
+		return o.ObjectStore.GetObject(bucket, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return body.(io.ReadCloser), nil
+}
+
+func (o *requestPolicyObjectStore) ListCommonPrefixes(bucket, prefix, delimiter string) ([]string, error) {
+	prefixes, err := o.do("ListCommonPrefixes", alwaysRetryable, func() (interface{}, error) {
+		return o.ObjectStore.ListCommonPrefixes(bucket, prefix, delimiter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prefixes.([]string), nil
+}
+
+func (o *requestPolicyObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	keys, err := o.do("ListObjects", alwaysRetryable, func() (interface{}, error) {
+		return o.ObjectStore.ListObjects(bucket, prefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys.([]string), nil
+}
+
+func (o *requestPolicyObjectStore) DeleteObject(bucket, key string) error {
+	_, err := o.do("DeleteObject", alwaysRetryable, func() (interface{}, error) {
+		return nil, o.ObjectStore.DeleteObject(bucket, key)
+	})
+	return err
+}
+
+func (o *requestPolicyObjectStore) CreateSignedURL(bucket, key string, ttl time.Duration) (string, error) {
+	url, err := o.do("CreateSignedURL", alwaysRetryable, func() (interface{}, error) {
+		return o.ObjectStore.CreateSignedURL(bucket, key, ttl)
+	})
+	if err != nil {
+		return "", err
+	}
+	return url.(string), nil
+}
+
+// GetObjectMetadata gets an object's metadata if the object store supports
+// it, so that wrapping an object store doesn't hide that it does.
+func (o *requestPolicyObjectStore) GetObjectMetadata(bucket, key string) (*velero.ObjectMetadata, error) {
+	getter, ok := o.ObjectStore.(velero.ObjectMetadataGetter)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrObjectMetadataNotSupported)
+	}
+
+	metadata, err := o.do("GetObjectMetadata", func(err error) bool {
+		return errors.Cause(err) != velero.ErrObjectMetadataNotSupported
+	}, func() (interface{}, error) {
+		return getter.GetObjectMetadata(bucket, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metadata.(*velero.ObjectMetadata), nil
+}
+
+// ListObjectSizes lists the sizes of objects if the object store supports it.
+func (o *requestPolicyObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	lister, ok := o.ObjectStore.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrListObjectSizesNotSupported)
+	}
+
+	sizes, err := o.do("ListObjectSizes", func(err error) bool {
+		return errors.Cause(err) != velero.ErrListObjectSizesNotSupported
+	}, func() (interface{}, error) {
+		return lister.ListObjectSizes(bucket, prefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes.(map[string]int64), nil
+}
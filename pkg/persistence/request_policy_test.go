@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// slowObjectStore is an in-memory object store whose requests take delay.
+type slowObjectStore struct {
+	*inMemoryObjectStore
+	delay time.Duration
+}
+
+func (o *slowObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	time.Sleep(o.delay)
+	return o.inMemoryObjectStore.ListObjects(bucket, prefix)
+}
+
+func (o *slowObjectStore) GetObject(bucket, key string) (io.ReadCloser, error) {
+	time.Sleep(o.delay)
+	return o.inMemoryObjectStore.GetObject(bucket, key)
+}
+
+// flakyObjectStore is an in-memory object store whose first failures
+// requests fail.
+type flakyObjectStore struct {
+	*inMemoryObjectStore
+	failures int
+	requests int
+}
+
+func (o *flakyObjectStore) PutObject(bucket, key string, body io.Reader) error {
+	o.requests++
+	if o.requests <= o.failures {
+		// read part of the body, so that a retry has to read it again.
+		ioutil.ReadAll(io.LimitReader(body, 1))
+		return errors.New("connection reset")
+	}
+	return o.inMemoryObjectStore.PutObject(bucket, key, body)
+}
+
+func (o *flakyObjectStore) ListObjects(bucket, prefix string) ([]string, error) {
+	o.requests++
+	if o.requests <= o.failures {
+		return nil, errors.New("connection reset")
+	}
+	return o.inMemoryObjectStore.ListObjects(bucket, prefix)
+}
+
+func TestValidateRequestPolicy(t *testing.T) {
+	assert.NoError(t, validateRequestPolicy(nil))
+	assert.NoError(t, validateRequestPolicy(&velerov1api.BackupStorageLocationRequestPolicy{Timeout: &metav1.Duration{Duration: time.Minute}, Retries: 3}))
+	assert.EqualError(t, validateRequestPolicy(&velerov1api.BackupStorageLocationRequestPolicy{Timeout: &metav1.Duration{Duration: -time.Second}}), "backup storage location's request timeout -1s must be positive")
+	assert.EqualError(t, validateRequestPolicy(&velerov1api.BackupStorageLocationRequestPolicy{Retries: -1}), "backup storage location's request retries -1 must not be negative")
+}
+
+func TestNewRequestPolicyObjectStore(t *testing.T) {
+	objectStore := newInMemoryObjectStore("bucket")
+	assert.Equal(t, objectStore, newRequestPolicyObjectStore(objectStore, nil))
+	assert.Equal(t, objectStore, newRequestPolicyObjectStore(objectStore, &velerov1api.BackupStorageLocationRequestPolicy{}))
+}
+
+func TestRequestPolicyObjectStoreTimeout(t *testing.T) {
+	slowStore := &slowObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), delay: time.Second}
+	require.NoError(t, slowStore.PutObject("bucket", "key", bytes.NewReader([]byte("data"))))
+
+	objectStore := newRequestPolicyObjectStore(slowStore, &velerov1api.BackupStorageLocationRequestPolicy{
+		Timeout: &metav1.Duration{Duration: 50 * time.Millisecond},
+	})
+
+	start := time.Now()
+	_, err := objectStore.ListObjects("bucket", "")
+	assert.EqualError(t, err, "object store ListObjects request timed out after 50ms")
+	assert.True(t, time.Since(start) < 500*time.Millisecond, "request took %v, it didn't time out", time.Since(start))
+
+	_, err = objectStore.GetObject("bucket", "key")
+	assert.EqualError(t, err, "object store GetObject request timed out after 50ms")
+
+	// requests that finish in time aren't affected.
+	exists, err := objectStore.ObjectExists("bucket", "key")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRequestPolicyObjectStoreRetries(t *testing.T) {
+	objectStoreRetryDelay = 0
+	defer func() { objectStoreRetryDelay = time.Second }()
+
+	policy := &velerov1api.BackupStorageLocationRequestPolicy{Retries: 2}
+
+	// requests are retried until they succeed.
+	flakyStore := &flakyObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), failures: 2}
+	objectStore := newRequestPolicyObjectStore(flakyStore, policy)
+	require.NoError(t, objectStore.PutObject("bucket", "key", bytes.NewReader([]byte("data"))))
+	assert.Equal(t, 3, flakyStore.requests)
+	assert.Equal(t, []byte("data"), flakyStore.Data["bucket"]["key"])
+
+	// ...but no more than the policy's retries.
+	flakyStore = &flakyObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), failures: 3}
+	objectStore = newRequestPolicyObjectStore(flakyStore, policy)
+	_, err := objectStore.ListObjects("bucket", "")
+	assert.EqualError(t, err, "connection reset")
+	assert.Equal(t, 3, flakyStore.requests)
+
+	// uploads whose body can't be read again aren't retried.
+	flakyStore = &flakyObjectStore{inMemoryObjectStore: newInMemoryObjectStore("bucket"), failures: 1}
+	objectStore = newRequestPolicyObjectStore(flakyStore, policy)
+	assert.EqualError(t, objectStore.PutObject("bucket", "key", ioutil.NopCloser(bytes.NewReader([]byte("data")))), "connection reset")
+	assert.Equal(t, 1, flakyStore.requests)
+}
@@ -67,12 +67,18 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindObjectStore):         framework.NewObjectStorePlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindPluginLister):        &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindItemBlockAction):     framework.NewItemBlockActionPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupValidator):     framework.NewBackupValidatorPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindNamespaceMapper):     framework.NewNamespaceMapperPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupDataIntegrity): framework.NewBackupDataIntegrityPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindRestoreValidator):    framework.NewRestoreValidatorPlugin(framework.ClientLogger(b.clientLogger)),
+			string(framework.PluginKindBackupHookAction):    framework.NewBackupHookActionPlugin(framework.ClientLogger(b.clientLogger)),
 		},
 		Logger: b.pluginLogger,
 		Cmd:    exec.Command(b.commandName, b.commandArgs...),
@@ -60,12 +60,18 @@ func TestClientConfig(t *testing.T) {
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
 		Plugins: map[string]hcplugin.Plugin{
-			string(framework.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindObjectStore):       framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(framework.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
-			string(framework.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupItemAction):    framework.NewBackupItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindVolumeSnapshotter):   framework.NewVolumeSnapshotterPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindObjectStore):         framework.NewObjectStorePlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindPluginLister):        &framework.PluginListerPlugin{},
+			string(framework.PluginKindRestoreItemAction):   framework.NewRestoreItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindDeleteItemAction):    framework.NewDeleteItemActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindItemBlockAction):     framework.NewItemBlockActionPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupValidator):     framework.NewBackupValidatorPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindNamespaceMapper):     framework.NewNamespaceMapperPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupDataIntegrity): framework.NewBackupDataIntegrityPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindRestoreValidator):    framework.NewRestoreValidatorPlugin(framework.ClientLogger(logger)),
+			string(framework.PluginKindBackupHookAction):    framework.NewBackupHookActionPlugin(framework.ClientLogger(logger)),
 		},
 		Logger: cb.pluginLogger,
 		Cmd:    exec.Command(cb.commandName, cb.commandArgs...),
@@ -17,6 +17,7 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"sort"
 	"strings"
 	"sync"
 
@@ -52,6 +53,40 @@ type Manager interface {
 	// GetDeleteItemAction returns the delete item action plugin for name.
 	GetDeleteItemAction(name string) (velero.DeleteItemAction, error)
 
+	// GetItemBlockActions returns all item block action plugins.
+	GetItemBlockActions() ([]velero.ItemBlockAction, error)
+
+	// GetItemBlockAction returns the item block action plugin for name.
+	GetItemBlockAction(name string) (velero.ItemBlockAction, error)
+
+	// GetBackupValidators returns all backup validator plugins, in name order.
+	GetBackupValidators() ([]velero.BackupValidator, error)
+
+	// GetBackupValidator returns the backup validator plugin for name.
+	GetBackupValidator(name string) (velero.BackupValidator, error)
+
+	// GetNamespaceMappers returns all namespace mapper plugins, in name order.
+	GetNamespaceMappers() ([]velero.NamespaceMapper, error)
+
+	// GetNamespaceMapper returns the namespace mapper plugin for name.
+	GetNamespaceMapper(name string) (velero.NamespaceMapper, error)
+
+	// GetBackupDataIntegrities returns all backup data integrity plugins,
+	// keyed by name.
+	GetBackupDataIntegrities() (map[string]velero.BackupDataIntegrity, error)
+
+	// GetBackupDataIntegrity returns the backup data integrity plugin for name.
+	GetBackupDataIntegrity(name string) (velero.BackupDataIntegrity, error)
+
+	// GetRestoreValidators returns all restore validator plugins, in name order.
+	GetRestoreValidators() ([]velero.RestoreValidator, error)
+
+	// GetRestoreValidator returns the restore validator plugin for name.
+	GetRestoreValidator(name string) (velero.RestoreValidator, error)
+
+	// GetBackupHookAction returns the backup hook action plugin for name.
+	GetBackupHookAction(name string) (velero.BackupHookAction, error)
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -256,6 +291,198 @@ func (m *manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, err
 	return r, nil
 }
 
+// GetItemBlockActions returns all item block actions as restartableItemBlockActions.
+func (m *manager) GetItemBlockActions() ([]velero.ItemBlockAction, error) {
+	list := m.registry.List(framework.PluginKindItemBlockAction)
+
+	actions := make([]velero.ItemBlockAction, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetItemBlockAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetItemBlockAction returns a restartableItemBlockAction for name.
+func (m *manager) GetItemBlockAction(name string) (velero.ItemBlockAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindItemBlockAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableItemBlockAction(name, restartableProcess)
+	return r, nil
+}
+
+// GetBackupValidators returns all backup validators as restartableBackupValidators,
+// sorted by name so that they're always invoked in the same order.
+func (m *manager) GetBackupValidators() ([]velero.BackupValidator, error) {
+	list := append([]framework.PluginIdentifier(nil), m.registry.List(framework.PluginKindBackupValidator)...)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	validators := make([]velero.BackupValidator, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetBackupValidator(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, r)
+	}
+
+	return validators, nil
+}
+
+// GetBackupValidator returns a restartableBackupValidator for name.
+func (m *manager) GetBackupValidator(name string) (velero.BackupValidator, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindBackupValidator, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableBackupValidator(name, restartableProcess)
+	return r, nil
+}
+
+// GetNamespaceMappers returns all namespace mappers as restartableNamespaceMappers,
+// sorted by name so that they're always applied in the same order.
+func (m *manager) GetNamespaceMappers() ([]velero.NamespaceMapper, error) {
+	list := append([]framework.PluginIdentifier(nil), m.registry.List(framework.PluginKindNamespaceMapper)...)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	mappers := make([]velero.NamespaceMapper, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetNamespaceMapper(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		mappers = append(mappers, r)
+	}
+
+	return mappers, nil
+}
+
+// GetNamespaceMapper returns a restartableNamespaceMapper for name.
+func (m *manager) GetNamespaceMapper(name string) (velero.NamespaceMapper, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindNamespaceMapper, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableNamespaceMapper(name, restartableProcess)
+	return r, nil
+}
+
+// GetBackupDataIntegrities returns all backup data integrity plugins as
+// restartableBackupDataIntegrities, keyed by name so that the digests they
+// compute can be verified by the same plugin.
+func (m *manager) GetBackupDataIntegrities() (map[string]velero.BackupDataIntegrity, error) {
+	list := m.registry.List(framework.PluginKindBackupDataIntegrity)
+
+	integrities := make(map[string]velero.BackupDataIntegrity, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetBackupDataIntegrity(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		integrities[id.Name] = r
+	}
+
+	return integrities, nil
+}
+
+// GetBackupDataIntegrity returns a restartableBackupDataIntegrity for name.
+func (m *manager) GetBackupDataIntegrity(name string) (velero.BackupDataIntegrity, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindBackupDataIntegrity, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableBackupDataIntegrity(name, restartableProcess)
+	return r, nil
+}
+
+// GetRestoreValidators returns all restore validators as restartableRestoreValidators,
+// sorted by name so that they're always invoked in the same order.
+func (m *manager) GetRestoreValidators() ([]velero.RestoreValidator, error) {
+	list := append([]framework.PluginIdentifier(nil), m.registry.List(framework.PluginKindRestoreValidator)...)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	validators := make([]velero.RestoreValidator, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetRestoreValidator(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, r)
+	}
+
+	return validators, nil
+}
+
+// GetRestoreValidator returns a restartableRestoreValidator for name.
+func (m *manager) GetRestoreValidator(name string) (velero.RestoreValidator, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindRestoreValidator, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableRestoreValidator(name, restartableProcess)
+	return r, nil
+}
+
+// GetBackupHookAction returns a restartableBackupHookAction for name.
+func (m *manager) GetBackupHookAction(name string) (velero.BackupHookAction, error) {
+	name = sanitizeName(name)
+
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindBackupHookAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableBackupHookAction(name, restartableProcess)
+	return r, nil
+}
+
 // sanitizeName adds "velero.io" to legacy plugins that weren't namespaced.
 func sanitizeName(name string) string {
 	// Backwards compatibility with non-namespaced Velero plugins, following principle of least surprise
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -575,6 +576,508 @@ func TestGetDeleteItemActions(t *testing.T) {
 	}
 }
 
+func TestGetItemBlockAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindItemBlockAction,
+		"velero.io/blocker",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetItemBlockAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableItemBlockAction{
+				key:                 kindAndName{kind: framework.PluginKindItemBlockAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetItemBlockActions(t *testing.T) {
+	tests := []struct {
+		name                       string
+		names                      []string
+		newRestartableProcessError error
+		expectedError              string
+	}{
+		{
+			name:  "No items",
+			names: []string{},
+		},
+		{
+			name:                       "Error getting restartable process",
+			names:                      []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+			newRestartableProcessError: errors.Errorf("newRestartableProcess"),
+			expectedError:              "newRestartableProcess",
+		},
+		{
+			name:  "Happy path",
+			names: []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginKind := framework.PluginKindItemBlockAction
+			var pluginIDs []framework.PluginIdentifier
+			for i := range tc.names {
+				pluginID := framework.PluginIdentifier{
+					Command: "/command",
+					Kind:    pluginKind,
+					Name:    tc.names[i],
+				}
+				pluginIDs = append(pluginIDs, pluginID)
+			}
+			registry.On("List", pluginKind).Return(pluginIDs)
+
+			var expectedActions []interface{}
+			for i := range pluginIDs {
+				pluginID := pluginIDs[i]
+				pluginName := pluginID.Name
+
+				registry.On("Get", pluginKind, pluginName).Return(pluginID, nil)
+
+				restartableProcess := &mockRestartableProcess{}
+				defer restartableProcess.AssertExpectations(t)
+
+				expected := &restartableItemBlockAction{
+					key:                 kindAndName{kind: pluginKind, name: pluginName},
+					sharedPluginProcess: restartableProcess,
+				}
+
+				if tc.newRestartableProcessError != nil {
+					// Test 1: error getting restartable process
+					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+					break
+				}
+
+				// Test 2: happy path
+				if i == 0 {
+					factory.On("newRestartableProcess", pluginID.Command, logger, logLevel).Return(restartableProcess, nil).Once()
+				}
+
+				expectedActions = append(expectedActions, expected)
+			}
+
+			itemBlockActions, err := m.GetItemBlockActions()
+			if tc.newRestartableProcessError != nil {
+				assert.Nil(t, itemBlockActions)
+				assert.EqualError(t, err, "newRestartableProcess")
+			} else {
+				require.NoError(t, err)
+				var actual []interface{}
+				for i := range itemBlockActions {
+					actual = append(actual, itemBlockActions[i])
+				}
+				assert.Equal(t, expectedActions, actual)
+			}
+		})
+	}
+}
+
+func TestGetBackupValidator(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindBackupValidator,
+		"velero.io/policy",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetBackupValidator(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableBackupValidator{
+				key:                 kindAndName{kind: framework.PluginKindBackupValidator, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetBackupValidators(t *testing.T) {
+	tests := []struct {
+		name                       string
+		names                      []string
+		newRestartableProcessError error
+		expectedNames              []string
+		expectedError              string
+	}{
+		{
+			name:  "No items",
+			names: []string{},
+		},
+		{
+			name:                       "Error getting restartable process",
+			names:                      []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+			newRestartableProcessError: errors.Errorf("newRestartableProcess"),
+			expectedError:              "newRestartableProcess",
+		},
+		{
+			name:          "Happy path, validators are sorted by name",
+			names:         []string{"velero.io/c", "velero.io/a", "velero.io/b"},
+			expectedNames: []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginKind := framework.PluginKindBackupValidator
+			var pluginIDs []framework.PluginIdentifier
+			for i := range tc.names {
+				pluginID := framework.PluginIdentifier{
+					Command: "/command",
+					Kind:    pluginKind,
+					Name:    tc.names[i],
+				}
+				pluginIDs = append(pluginIDs, pluginID)
+			}
+			registry.On("List", pluginKind).Return(pluginIDs)
+
+			restartableProcess := &mockRestartableProcess{}
+			defer restartableProcess.AssertExpectations(t)
+
+			if tc.newRestartableProcessError != nil {
+				// Test 1: error getting restartable process
+				registry.On("Get", pluginKind, pluginIDs[0].Name).Return(pluginIDs[0], nil)
+				factory.On("newRestartableProcess", pluginIDs[0].Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+			} else if len(pluginIDs) > 0 {
+				// Test 2: happy path
+				for _, pluginID := range pluginIDs {
+					registry.On("Get", pluginKind, pluginID.Name).Return(pluginID, nil)
+				}
+				factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+			}
+
+			var expectedValidators []interface{}
+			for _, name := range tc.expectedNames {
+				expectedValidators = append(expectedValidators, &restartableBackupValidator{
+					key:                 kindAndName{kind: pluginKind, name: name},
+					sharedPluginProcess: restartableProcess,
+				})
+			}
+
+			validators, err := m.GetBackupValidators()
+			if tc.newRestartableProcessError != nil {
+				assert.Nil(t, validators)
+				assert.EqualError(t, err, "newRestartableProcess")
+			} else {
+				require.NoError(t, err)
+				var actual []interface{}
+				for i := range validators {
+					actual = append(actual, validators[i])
+				}
+				assert.Equal(t, expectedValidators, actual)
+			}
+		})
+	}
+}
+
+func TestGetNamespaceMapper(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindNamespaceMapper,
+		"velero.io/anonymizer",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetNamespaceMapper(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableNamespaceMapper{
+				key:                 kindAndName{kind: framework.PluginKindNamespaceMapper, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetNamespaceMappers(t *testing.T) {
+	tests := []struct {
+		name                       string
+		names                      []string
+		newRestartableProcessError error
+		expectedNames              []string
+		expectedError              string
+	}{
+		{
+			name:  "No items",
+			names: []string{},
+		},
+		{
+			name:                       "Error getting restartable process",
+			names:                      []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+			newRestartableProcessError: errors.Errorf("newRestartableProcess"),
+			expectedError:              "newRestartableProcess",
+		},
+		{
+			name:          "Happy path, mappers are sorted by name",
+			names:         []string{"velero.io/c", "velero.io/a", "velero.io/b"},
+			expectedNames: []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginKind := framework.PluginKindNamespaceMapper
+			var pluginIDs []framework.PluginIdentifier
+			for i := range tc.names {
+				pluginID := framework.PluginIdentifier{
+					Command: "/command",
+					Kind:    pluginKind,
+					Name:    tc.names[i],
+				}
+				pluginIDs = append(pluginIDs, pluginID)
+			}
+			registry.On("List", pluginKind).Return(pluginIDs)
+
+			restartableProcess := &mockRestartableProcess{}
+			defer restartableProcess.AssertExpectations(t)
+
+			if tc.newRestartableProcessError != nil {
+				// Test 1: error getting restartable process
+				registry.On("Get", pluginKind, pluginIDs[0].Name).Return(pluginIDs[0], nil)
+				factory.On("newRestartableProcess", pluginIDs[0].Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+			} else if len(pluginIDs) > 0 {
+				// Test 2: happy path
+				for _, pluginID := range pluginIDs {
+					registry.On("Get", pluginKind, pluginID.Name).Return(pluginID, nil)
+				}
+				factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+			}
+
+			var expectedMappers []interface{}
+			for _, name := range tc.expectedNames {
+				expectedMappers = append(expectedMappers, &restartableNamespaceMapper{
+					key:                 kindAndName{kind: pluginKind, name: name},
+					sharedPluginProcess: restartableProcess,
+				})
+			}
+
+			mappers, err := m.GetNamespaceMappers()
+			if tc.newRestartableProcessError != nil {
+				assert.Nil(t, mappers)
+				assert.EqualError(t, err, "newRestartableProcess")
+			} else {
+				require.NoError(t, err)
+				var actual []interface{}
+				for i := range mappers {
+					actual = append(actual, mappers[i])
+				}
+				assert.Equal(t, expectedMappers, actual)
+			}
+		})
+	}
+}
+
+func TestGetBackupDataIntegrity(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindBackupDataIntegrity,
+		"velero.io/checksum",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetBackupDataIntegrity(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableBackupDataIntegrity{
+				key:                 kindAndName{kind: framework.PluginKindBackupDataIntegrity, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetBackupDataIntegrities(t *testing.T) {
+	logger := test.NewLogger()
+	logLevel := logrus.InfoLevel
+
+	registry := &mockRegistry{}
+	defer registry.AssertExpectations(t)
+
+	m := NewManager(logger, logLevel, registry).(*manager)
+	factory := &mockRestartableProcessFactory{}
+	defer factory.AssertExpectations(t)
+	m.restartableProcessFactory = factory
+
+	pluginKind := framework.PluginKindBackupDataIntegrity
+	var pluginIDs []framework.PluginIdentifier
+	for _, name := range []string{"velero.io/checksum", "velero.io/merkle"} {
+		pluginID := framework.PluginIdentifier{
+			Command: "/command",
+			Kind:    pluginKind,
+			Name:    name,
+		}
+		pluginIDs = append(pluginIDs, pluginID)
+		registry.On("Get", pluginKind, name).Return(pluginID, nil)
+	}
+	registry.On("List", pluginKind).Return(pluginIDs)
+
+	restartableProcess := &mockRestartableProcess{}
+	defer restartableProcess.AssertExpectations(t)
+	factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+
+	integrities, err := m.GetBackupDataIntegrities()
+	require.NoError(t, err)
+
+	// the plugins are keyed by name, so that each digest can be verified by
+	// the plugin that computed it.
+	expected := map[string]velero.BackupDataIntegrity{}
+	for _, pluginID := range pluginIDs {
+		expected[pluginID.Name] = &restartableBackupDataIntegrity{
+			key:                 kindAndName{kind: pluginKind, name: pluginID.Name},
+			sharedPluginProcess: restartableProcess,
+		}
+	}
+	assert.Equal(t, expected, integrities)
+}
+
+func TestGetRestoreValidator(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindRestoreValidator,
+		"velero.io/replicas",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetRestoreValidator(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableRestoreValidator{
+				key:                 kindAndName{kind: framework.PluginKindRestoreValidator, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetBackupHookAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindBackupHookAction,
+		"velero.io/maintenance",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetBackupHookAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableBackupHookAction{
+				key:                 kindAndName{kind: framework.PluginKindBackupHookAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
+func TestGetRestoreValidators(t *testing.T) {
+	tests := []struct {
+		name                       string
+		names                      []string
+		newRestartableProcessError error
+		expectedNames              []string
+		expectedError              string
+	}{
+		{
+			name:  "No items",
+			names: []string{},
+		},
+		{
+			name:                       "Error getting restartable process",
+			names:                      []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+			newRestartableProcessError: errors.Errorf("newRestartableProcess"),
+			expectedError:              "newRestartableProcess",
+		},
+		{
+			name:          "Happy path, validators are sorted by name",
+			names:         []string{"velero.io/c", "velero.io/a", "velero.io/b"},
+			expectedNames: []string{"velero.io/a", "velero.io/b", "velero.io/c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := test.NewLogger()
+			logLevel := logrus.InfoLevel
+
+			registry := &mockRegistry{}
+			defer registry.AssertExpectations(t)
+
+			m := NewManager(logger, logLevel, registry).(*manager)
+			factory := &mockRestartableProcessFactory{}
+			defer factory.AssertExpectations(t)
+			m.restartableProcessFactory = factory
+
+			pluginKind := framework.PluginKindRestoreValidator
+			var pluginIDs []framework.PluginIdentifier
+			for i := range tc.names {
+				pluginID := framework.PluginIdentifier{
+					Command: "/command",
+					Kind:    pluginKind,
+					Name:    tc.names[i],
+				}
+				pluginIDs = append(pluginIDs, pluginID)
+			}
+			registry.On("List", pluginKind).Return(pluginIDs)
+
+			restartableProcess := &mockRestartableProcess{}
+			defer restartableProcess.AssertExpectations(t)
+
+			if tc.newRestartableProcessError != nil {
+				// Test 1: error getting restartable process
+				registry.On("Get", pluginKind, pluginIDs[0].Name).Return(pluginIDs[0], nil)
+				factory.On("newRestartableProcess", pluginIDs[0].Command, logger, logLevel).Return(nil, errors.Errorf("newRestartableProcess")).Once()
+			} else if len(pluginIDs) > 0 {
+				// Test 2: happy path
+				for _, pluginID := range pluginIDs {
+					registry.On("Get", pluginKind, pluginID.Name).Return(pluginID, nil)
+				}
+				factory.On("newRestartableProcess", "/command", logger, logLevel).Return(restartableProcess, nil).Once()
+			}
+
+			var expectedValidators []interface{}
+			for _, name := range tc.expectedNames {
+				expectedValidators = append(expectedValidators, &restartableRestoreValidator{
+					key:                 kindAndName{kind: pluginKind, name: name},
+					sharedPluginProcess: restartableProcess,
+				})
+			}
+
+			validators, err := m.GetRestoreValidators()
+			if tc.newRestartableProcessError != nil {
+				assert.Nil(t, validators)
+				assert.EqualError(t, err, "newRestartableProcess")
+			} else {
+				require.NoError(t, err)
+				var actual []interface{}
+				for i := range validators {
+					actual = append(actual, validators[i])
+				}
+				assert.Equal(t, expectedValidators, actual)
+			}
+		})
+	}
+}
+
 func TestSanitizeName(t *testing.T) {
 	tests := []struct {
 		name, pluginName, expectedName string
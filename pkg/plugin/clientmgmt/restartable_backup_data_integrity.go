@@ -0,0 +1,88 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableBackupDataIntegrity is a backup data integrity plugin for a given implementation. It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableBackupDataIntegrity asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableBackupDataIntegrity struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartableBackupDataIntegrity returns a new restartableBackupDataIntegrity.
+func newRestartableBackupDataIntegrity(name string, sharedPluginProcess RestartableProcess) *restartableBackupDataIntegrity {
+	r := &restartableBackupDataIntegrity{
+		key:                 kindAndName{kind: framework.PluginKindBackupDataIntegrity, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getBackupDataIntegrity returns the backup data integrity plugin for this restartableBackupDataIntegrity. It does
+// *not* restart the plugin process.
+func (r *restartableBackupDataIntegrity) getBackupDataIntegrity() (velero.BackupDataIntegrity, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	integrity, ok := plugin.(velero.BackupDataIntegrity)
+	if !ok {
+		return nil, errors.Errorf("%T is not a BackupDataIntegrity!", plugin)
+	}
+
+	return integrity, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the backup data integrity plugin for this
+// restartableBackupDataIntegrity.
+func (r *restartableBackupDataIntegrity) getDelegate() (velero.BackupDataIntegrity, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getBackupDataIntegrity()
+}
+
+// ComputeDigest restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupDataIntegrity) ComputeDigest(path string) (string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+
+	return delegate.ComputeDigest(path)
+}
+
+// VerifyDigest restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupDataIntegrity) VerifyDigest(path, digest string) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	return delegate.VerifyDigest(path, digest)
+}
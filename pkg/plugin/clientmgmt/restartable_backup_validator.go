@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableBackupValidator is a backup validator for a given implementation. It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableBackupValidator asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableBackupValidator struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartableBackupValidator returns a new restartableBackupValidator.
+func newRestartableBackupValidator(name string, sharedPluginProcess RestartableProcess) *restartableBackupValidator {
+	r := &restartableBackupValidator{
+		key:                 kindAndName{kind: framework.PluginKindBackupValidator, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getBackupValidator returns the backup validator for this restartableBackupValidator. It does *not* restart the
+// plugin process.
+func (r *restartableBackupValidator) getBackupValidator() (velero.BackupValidator, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	backupValidator, ok := plugin.(velero.BackupValidator)
+	if !ok {
+		return nil, errors.Errorf("%T is not a BackupValidator!", plugin)
+	}
+
+	return backupValidator, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the backup validator for this restartableBackupValidator.
+func (r *restartableBackupValidator) getDelegate() (velero.BackupValidator, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getBackupValidator()
+}
+
+// Validate restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupValidator) Validate(backup *api.Backup) (velero.BackupValidationResult, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.BackupValidationResult{}, err
+	}
+
+	return delegate.Validate(backup)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableItemBlockAction is a item block action for a given implementation (such as "pod"). It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableItemBlockAction asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableItemBlockAction struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+	config              map[string]string
+}
+
+// newRestartableItemBlockAction returns a new restartableItemBlockAction.
+func newRestartableItemBlockAction(name string, sharedPluginProcess RestartableProcess) *restartableItemBlockAction {
+	r := &restartableItemBlockAction{
+		key:                 kindAndName{kind: framework.PluginKindItemBlockAction, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getItemBlockAction returns the item block action for this restartableItemBlockAction. It does *not* restart the
+// plugin process.
+func (r *restartableItemBlockAction) getItemBlockAction() (velero.ItemBlockAction, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	itemBlockAction, ok := plugin.(velero.ItemBlockAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not a ItemBlockAction!", plugin)
+	}
+
+	return itemBlockAction, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the item block action for this restartableItemBlockAction.
+func (r *restartableItemBlockAction) getDelegate() (velero.ItemBlockAction, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getItemBlockAction()
+}
+
+// AppliesTo restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemBlockAction) AppliesTo() (velero.ResourceSelector, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	return delegate.AppliesTo()
+}
+
+// GetRelatedItems restarts the plugin's process if needed, then delegates the call.
+func (r *restartableItemBlockAction) GetRelatedItems(item runtime.Unstructured, backup *api.Backup) ([]velero.ResourceIdentifier, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	return delegate.GetRelatedItems(item, backup)
+}
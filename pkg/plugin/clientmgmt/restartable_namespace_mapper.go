@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableNamespaceMapper is a namespace mapper for a given implementation. It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableNamespaceMapper asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableNamespaceMapper struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartableNamespaceMapper returns a new restartableNamespaceMapper.
+func newRestartableNamespaceMapper(name string, sharedPluginProcess RestartableProcess) *restartableNamespaceMapper {
+	r := &restartableNamespaceMapper{
+		key:                 kindAndName{kind: framework.PluginKindNamespaceMapper, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getNamespaceMapper returns the namespace mapper for this restartableNamespaceMapper. It does *not* restart the
+// plugin process.
+func (r *restartableNamespaceMapper) getNamespaceMapper() (velero.NamespaceMapper, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceMapper, ok := plugin.(velero.NamespaceMapper)
+	if !ok {
+		return nil, errors.Errorf("%T is not a NamespaceMapper!", plugin)
+	}
+
+	return namespaceMapper, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the namespace mapper for this restartableNamespaceMapper.
+func (r *restartableNamespaceMapper) getDelegate() (velero.NamespaceMapper, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getNamespaceMapper()
+}
+
+// MapNamespace restarts the plugin's process if needed, then delegates the call.
+func (r *restartableNamespaceMapper) MapNamespace(namespace string, backup *api.Backup) (string, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return "", err
+	}
+
+	return delegate.MapNamespace(namespace, backup)
+}
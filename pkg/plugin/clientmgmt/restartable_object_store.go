@@ -173,3 +173,33 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	}
 	return delegate.CreateSignedURL(bucket, key, ttl)
 }
+
+// GetObjectMetadata restarts the plugin's process if needed, then delegates the call if
+// the plugin supports getting object metadata.
+func (r *restartableObjectStore) GetObjectMetadata(bucket string, key string) (*velero.ObjectMetadata, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	getter, ok := delegate.(velero.ObjectMetadataGetter)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrObjectMetadataNotSupported)
+	}
+	return getter.GetObjectMetadata(bucket, key)
+}
+
+// ListObjectSizes restarts the plugin's process if needed, then delegates the call if
+// the plugin supports listing object sizes.
+func (r *restartableObjectStore) ListObjectSizes(bucket string, prefix string) (map[string]int64, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+
+	lister, ok := delegate.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, errors.WithStack(velero.ErrListObjectSizesNotSupported)
+	}
+	return lister.ListObjectSizes(bucket, prefix)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -183,6 +184,82 @@ func TestRestartableObjectStoreInit(t *testing.T) {
 	assert.EqualError(t, err, "already initialized")
 }
 
+// metadataObjectStore is an object store that supports getting object metadata.
+type metadataObjectStore struct {
+	*providermocks.ObjectStore
+	metadata *velero.ObjectMetadata
+}
+
+func (o *metadataObjectStore) GetObjectMetadata(bucket, key string) (*velero.ObjectMetadata, error) {
+	return o.metadata, nil
+}
+
+func TestRestartableObjectStoreGetObjectMetadata(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: "aws"}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	// Delegate doesn't support getting object metadata
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(providermocks.ObjectStore), nil).Once()
+
+	metadata, err := r.GetObjectMetadata("bucket", "key")
+	assert.Nil(t, metadata)
+	assert.Equal(t, velero.ErrObjectMetadataNotSupported, errors.Cause(err))
+
+	// Delegate supports getting object metadata
+	expected := &velero.ObjectMetadata{ETag: "etag"}
+	p.On("getByKindAndName", key).Return(&metadataObjectStore{ObjectStore: new(providermocks.ObjectStore), metadata: expected}, nil).Once()
+
+	metadata, err = r.GetObjectMetadata("bucket", "key")
+	require.NoError(t, err)
+	assert.Equal(t, expected, metadata)
+}
+
+// sizeListerObjectStore is an object store that supports listing object sizes.
+type sizeListerObjectStore struct {
+	*providermocks.ObjectStore
+	sizes map[string]int64
+}
+
+func (o *sizeListerObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	return o.sizes, nil
+}
+
+func TestRestartableObjectStoreListObjectSizes(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := kindAndName{kind: framework.PluginKindObjectStore, name: "aws"}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	// Delegate doesn't support listing object sizes
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(providermocks.ObjectStore), nil).Once()
+
+	sizes, err := r.ListObjectSizes("bucket", "prefix/")
+	assert.Nil(t, sizes)
+	assert.Equal(t, velero.ErrListObjectSizesNotSupported, errors.Cause(err))
+
+	// Delegate supports listing object sizes
+	expected := map[string]int64{"prefix/a": 1, "prefix/b": 2}
+	p.On("getByKindAndName", key).Return(&sizeListerObjectStore{ObjectStore: new(providermocks.ObjectStore), sizes: expected}, nil).Once()
+
+	sizes, err = r.ListObjectSizes("bucket", "prefix/")
+	require.NoError(t, err)
+	assert.Equal(t, expected, sizes)
+}
+
 func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 	runRestartableDelegateTests(
 		t,
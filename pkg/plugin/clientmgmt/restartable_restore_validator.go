@@ -0,0 +1,87 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// restartableRestoreValidator is a restore validator for a given implementation. It is associated with
+// a restartableProcess, which may be shared and used to run multiple plugins. At the beginning of each method
+// call, the restartableRestoreValidator asks its restartableProcess to restart itself if needed (e.g. if the
+// process terminated for any reason), then it proceeds with the actual call.
+type restartableRestoreValidator struct {
+	key                 kindAndName
+	sharedPluginProcess RestartableProcess
+}
+
+// newRestartableRestoreValidator returns a new restartableRestoreValidator.
+func newRestartableRestoreValidator(name string, sharedPluginProcess RestartableProcess) *restartableRestoreValidator {
+	r := &restartableRestoreValidator{
+		key:                 kindAndName{kind: framework.PluginKindRestoreValidator, name: name},
+		sharedPluginProcess: sharedPluginProcess,
+	}
+	return r
+}
+
+// getRestoreValidator returns the restore validator for this restartableRestoreValidator. It does *not* restart the
+// plugin process.
+func (r *restartableRestoreValidator) getRestoreValidator() (velero.RestoreValidator, error) {
+	plugin, err := r.sharedPluginProcess.getByKindAndName(r.key)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreValidator, ok := plugin.(velero.RestoreValidator)
+	if !ok {
+		return nil, errors.Errorf("%T is not a RestoreValidator!", plugin)
+	}
+
+	return restoreValidator, nil
+}
+
+// getDelegate restarts the plugin process (if needed) and returns the restore validator for this restartableRestoreValidator.
+func (r *restartableRestoreValidator) getDelegate() (velero.RestoreValidator, error) {
+	if err := r.sharedPluginProcess.resetIfNeeded(); err != nil {
+		return nil, err
+	}
+
+	return r.getRestoreValidator()
+}
+
+// AppliesTo restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreValidator) AppliesTo() (velero.ResourceSelector, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ResourceSelector{}, err
+	}
+
+	return delegate.AppliesTo()
+}
+
+// Validate restarts the plugin's process if needed, then delegates the call.
+func (r *restartableRestoreValidator) Validate(input *velero.RestoreValidatorValidateInput) (velero.RestoreValidationResult, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.RestoreValidationResult{}, err
+	}
+
+	return delegate.Validate(input)
+}
@@ -159,3 +159,18 @@ func (r *restartableVolumeSnapshotter) DeleteSnapshot(snapshotID string) error {
 	}
 	return delegate.DeleteSnapshot(snapshotID)
 }
+
+// ValidateLocation restarts the plugin's process if needed, then delegates the call if
+// the plugin supports validating its location.
+func (r *restartableVolumeSnapshotter) ValidateLocation() error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+
+	validator, ok := delegate.(velero.VolumeSnapshotterValidator)
+	if !ok {
+		return errors.WithStack(velero.ErrLocationValidationNotSupported)
+	}
+	return validator.ValidateLocation()
+}
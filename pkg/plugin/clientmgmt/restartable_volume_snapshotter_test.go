@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 )
 
@@ -182,6 +183,41 @@ func TestRestartableVolumeSnapshotterInit(t *testing.T) {
 	assert.EqualError(t, err, "already initialized")
 }
 
+// validatingVolumeSnapshotter is a volume snapshotter that supports validating its location.
+type validatingVolumeSnapshotter struct {
+	*providermocks.VolumeSnapshotter
+	validateErr error
+}
+
+func (v *validatingVolumeSnapshotter) ValidateLocation() error {
+	return v.validateErr
+}
+
+func TestRestartableVolumeSnapshotterValidateLocation(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	key := kindAndName{kind: framework.PluginKindVolumeSnapshotter, name: "aws"}
+	r := &restartableVolumeSnapshotter{
+		key:                 key,
+		sharedPluginProcess: p,
+	}
+
+	// Delegate doesn't support validating its location
+	p.On("resetIfNeeded").Return(nil)
+	p.On("getByKindAndName", key).Return(new(providermocks.VolumeSnapshotter), nil).Once()
+
+	err := r.ValidateLocation()
+	assert.Equal(t, velero.ErrLocationValidationNotSupported, errors.Cause(err))
+
+	// Delegate supports validating its location
+	p.On("getByKindAndName", key).Return(&validatingVolumeSnapshotter{VolumeSnapshotter: new(providermocks.VolumeSnapshotter), validateErr: errors.New("access denied")}, nil).Once()
+
+	err = r.ValidateLocation()
+	assert.EqualError(t, err, "access denied")
+}
+
 func TestRestartableVolumeSnapshotterDelegatedFunctions(t *testing.T) {
 	pv := &unstructured.Unstructured{
 		Object: map[string]interface{}{
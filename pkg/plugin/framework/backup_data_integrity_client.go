@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.BackupDataIntegrity = &BackupDataIntegrityGRPCClient{}
+
+// NewBackupDataIntegrityPlugin constructs a BackupDataIntegrityPlugin.
+func NewBackupDataIntegrityPlugin(options ...PluginOption) *BackupDataIntegrityPlugin {
+	return &BackupDataIntegrityPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// BackupDataIntegrityGRPCClient implements the BackupDataIntegrity interface and uses a
+// gRPC client to make calls to the plugin server.
+type BackupDataIntegrityGRPCClient struct {
+	*clientBase
+	grpcClient proto.BackupDataIntegrityClient
+}
+
+func newBackupDataIntegrityGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupDataIntegrityGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewBackupDataIntegrityClient(clientConn),
+	}
+}
+
+func (c *BackupDataIntegrityGRPCClient) ComputeDigest(path string) (string, error) {
+	req := &proto.BackupDataIntegrityComputeDigestRequest{
+		Plugin: c.plugin,
+		Path:   path,
+	}
+
+	res, err := c.grpcClient.ComputeDigest(context.Background(), req)
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+
+	return res.Digest, nil
+}
+
+func (c *BackupDataIntegrityGRPCClient) VerifyDigest(path, digest string) error {
+	req := &proto.BackupDataIntegrityVerifyDigestRequest{
+		Plugin: c.plugin,
+		Path:   path,
+		Digest: digest,
+	}
+
+	if _, err := c.grpcClient.VerifyDigest(context.Background(), req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupDataIntegrityGRPCServer implements the proto-generated BackupDataIntegrity interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type BackupDataIntegrityGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *BackupDataIntegrityGRPCServer) getImpl(name string) (velero.BackupDataIntegrity, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	integrity, ok := impl.(velero.BackupDataIntegrity)
+	if !ok {
+		return nil, errors.Errorf("%T is not a backup data integrity plugin", impl)
+	}
+
+	return integrity, nil
+}
+
+func (s *BackupDataIntegrityGRPCServer) ComputeDigest(ctx context.Context, req *proto.BackupDataIntegrityComputeDigestRequest) (response *proto.BackupDataIntegrityComputeDigestResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	digest, err := impl.ComputeDigest(req.Path)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.BackupDataIntegrityComputeDigestResponse{Digest: digest}, nil
+}
+
+func (s *BackupDataIntegrityGRPCServer) VerifyDigest(ctx context.Context, req *proto.BackupDataIntegrityVerifyDigestRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	if err := impl.VerifyDigest(req.Path, req.Digest); err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
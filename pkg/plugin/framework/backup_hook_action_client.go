@@ -0,0 +1,71 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.BackupHookAction = &BackupHookActionGRPCClient{}
+
+// NewBackupHookActionPlugin constructs a BackupHookActionPlugin.
+func NewBackupHookActionPlugin(options ...PluginOption) *BackupHookActionPlugin {
+	return &BackupHookActionPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// BackupHookActionGRPCClient implements the BackupHookAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type BackupHookActionGRPCClient struct {
+	*clientBase
+	grpcClient proto.BackupHookActionClient
+}
+
+func newBackupHookActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupHookActionGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewBackupHookActionClient(clientConn),
+	}
+}
+
+func (c *BackupHookActionGRPCClient) Execute(backup *api.Backup, phase api.BackupHookPhase) error {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req := &proto.BackupHookActionExecuteRequest{
+		Plugin: c.plugin,
+		Backup: backupJSON,
+		Phase:  string(phase),
+	}
+
+	if _, err := c.grpcClient.Execute(context.Background(), req); err != nil {
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.BackupValidator = &BackupValidatorGRPCClient{}
+
+// NewBackupValidatorPlugin constructs a BackupValidatorPlugin.
+func NewBackupValidatorPlugin(options ...PluginOption) *BackupValidatorPlugin {
+	return &BackupValidatorPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// BackupValidatorGRPCClient implements the BackupValidator interface and uses a
+// gRPC client to make calls to the plugin server.
+type BackupValidatorGRPCClient struct {
+	*clientBase
+	grpcClient proto.BackupValidatorClient
+}
+
+func newBackupValidatorGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &BackupValidatorGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewBackupValidatorClient(clientConn),
+	}
+}
+
+func (c *BackupValidatorGRPCClient) Validate(backup *api.Backup) (velero.BackupValidationResult, error) {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return velero.BackupValidationResult{}, errors.WithStack(err)
+	}
+
+	req := &proto.BackupValidatorValidateRequest{
+		Plugin: c.plugin,
+		Backup: backupJSON,
+	}
+
+	res, err := c.grpcClient.Validate(context.Background(), req)
+	if err != nil {
+		return velero.BackupValidationResult{}, fromGRPCError(err)
+	}
+
+	return velero.BackupValidationResult{
+		Accepted: res.Accepted,
+		Message:  res.Message,
+	}, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupValidatorGRPCServer implements the proto-generated BackupValidator interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type BackupValidatorGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *BackupValidatorGRPCServer) getImpl(name string) (velero.BackupValidator, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, ok := impl.(velero.BackupValidator)
+	if !ok {
+		return nil, errors.Errorf("%T is not a backup validator", impl)
+	}
+
+	return validator, nil
+}
+
+func (s *BackupValidatorGRPCServer) Validate(ctx context.Context, req *proto.BackupValidatorValidateRequest) (response *proto.BackupValidatorValidateResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var backup api.Backup
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	result, err := impl.Validate(&backup)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.BackupValidatorValidateResponse{
+		Accepted: result.Accepted,
+		Message:  result.Message,
+	}, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.ItemBlockAction = &ItemBlockActionGRPCClient{}
+
+// NewItemBlockActionPlugin constructs an ItemBlockActionPlugin.
+func NewItemBlockActionPlugin(options ...PluginOption) *ItemBlockActionPlugin {
+	return &ItemBlockActionPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// ItemBlockActionGRPCClient implements the ItemBlockAction interface and uses a
+// gRPC client to make calls to the plugin server.
+type ItemBlockActionGRPCClient struct {
+	*clientBase
+	grpcClient proto.ItemBlockActionClient
+}
+
+func newItemBlockActionGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &ItemBlockActionGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewItemBlockActionClient(clientConn),
+	}
+}
+
+func (c *ItemBlockActionGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.ItemBlockActionAppliesToRequest{Plugin: c.plugin})
+	if err != nil {
+		return velero.ResourceSelector{}, fromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *ItemBlockActionGRPCClient) GetRelatedItems(item runtime.Unstructured, backup *api.Backup) ([]velero.ResourceIdentifier, error) {
+	itemJSON, err := json.Marshal(item.UnstructuredContent())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req := &proto.ItemBlockActionGetRelatedItemsRequest{
+		Plugin: c.plugin,
+		Item:   itemJSON,
+		Backup: backupJSON,
+	}
+
+	res, err := c.grpcClient.GetRelatedItems(context.Background(), req)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+
+	var relatedItems []velero.ResourceIdentifier
+
+	for _, itm := range res.RelatedItems {
+		relatedItems = append(relatedItems, velero.ResourceIdentifier{
+			GroupResource: schema.GroupResource{
+				Group:    itm.Group,
+				Resource: itm.Resource,
+			},
+			Namespace: itm.Namespace,
+			Name:      itm.Name,
+		})
+	}
+
+	return relatedItems, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ItemBlockActionGRPCServer implements the proto-generated ItemBlockAction interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type ItemBlockActionGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *ItemBlockActionGRPCServer) getImpl(name string) (velero.ItemBlockAction, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	itemAction, ok := impl.(velero.ItemBlockAction)
+	if !ok {
+		return nil, errors.Errorf("%T is not an item block action", impl)
+	}
+
+	return itemAction, nil
+}
+
+func (s *ItemBlockActionGRPCServer) AppliesTo(ctx context.Context, req *proto.ItemBlockActionAppliesToRequest) (response *proto.ItemBlockActionAppliesToResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	resourceSelector, err := impl.AppliesTo()
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.ItemBlockActionAppliesToResponse{
+		ResourceSelector: &proto.ResourceSelector{
+			IncludedNamespaces: resourceSelector.IncludedNamespaces,
+			ExcludedNamespaces: resourceSelector.ExcludedNamespaces,
+			IncludedResources:  resourceSelector.IncludedResources,
+			ExcludedResources:  resourceSelector.ExcludedResources,
+			Selector:           resourceSelector.LabelSelector,
+		},
+	}, nil
+}
+
+func (s *ItemBlockActionGRPCServer) GetRelatedItems(ctx context.Context, req *proto.ItemBlockActionGetRelatedItemsRequest) (response *proto.ItemBlockActionGetRelatedItemsResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var item unstructured.Unstructured
+	var backup api.Backup
+
+	if err := json.Unmarshal(req.Item, &item); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	relatedItems, err := impl.GetRelatedItems(&item, &backup)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	res := &proto.ItemBlockActionGetRelatedItemsResponse{}
+	for _, item := range relatedItems {
+		res.RelatedItems = append(res.RelatedItems, backupResourceIdentifierToProto(item))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.NamespaceMapper = &NamespaceMapperGRPCClient{}
+
+// NewNamespaceMapperPlugin constructs a NamespaceMapperPlugin.
+func NewNamespaceMapperPlugin(options ...PluginOption) *NamespaceMapperPlugin {
+	return &NamespaceMapperPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// NamespaceMapperGRPCClient implements the NamespaceMapper interface and uses a
+// gRPC client to make calls to the plugin server.
+type NamespaceMapperGRPCClient struct {
+	*clientBase
+	grpcClient proto.NamespaceMapperClient
+}
+
+func newNamespaceMapperGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &NamespaceMapperGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewNamespaceMapperClient(clientConn),
+	}
+}
+
+func (c *NamespaceMapperGRPCClient) MapNamespace(namespace string, backup *api.Backup) (string, error) {
+	backupJSON, err := json.Marshal(backup)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	req := &proto.NamespaceMapperMapNamespaceRequest{
+		Plugin:    c.plugin,
+		Namespace: namespace,
+		Backup:    backupJSON,
+	}
+
+	res, err := c.grpcClient.MapNamespace(context.Background(), req)
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+
+	return res.Namespace, nil
+}
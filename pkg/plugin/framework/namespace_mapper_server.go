@@ -0,0 +1,73 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// NamespaceMapperGRPCServer implements the proto-generated NamespaceMapper interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type NamespaceMapperGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *NamespaceMapperGRPCServer) getImpl(name string) (velero.NamespaceMapper, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, ok := impl.(velero.NamespaceMapper)
+	if !ok {
+		return nil, errors.Errorf("%T is not a namespace mapper", impl)
+	}
+
+	return mapper, nil
+}
+
+func (s *NamespaceMapperGRPCServer) MapNamespace(ctx context.Context, req *proto.NamespaceMapperMapNamespaceRequest) (response *proto.NamespaceMapperMapNamespaceResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var backup api.Backup
+	if err := json.Unmarshal(req.Backup, &backup); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	namespace, err := impl.MapNamespace(req.Namespace, &backup)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.NamespaceMapperMapNamespaceResponse{Namespace: namespace}, nil
+}
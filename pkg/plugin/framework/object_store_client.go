@@ -23,8 +23,11 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -218,3 +221,56 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 
 	return res.Url, nil
 }
+
+// GetObjectMetadata returns the metadata of the object with the given key in the
+// specified bucket. It returns velero.ErrObjectMetadataNotSupported if the plugin
+// doesn't support getting object metadata, including plugins built against
+// versions of Velero that predate it.
+func (c *ObjectStoreGRPCClient) GetObjectMetadata(bucket, key string) (*velero.ObjectMetadata, error) {
+	req := &proto.GetObjectMetadataRequest{
+		Plugin: c.plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	res, err := c.grpcClient.GetObjectMetadata(context.Background(), req)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, errors.WithStack(velero.ErrObjectMetadataNotSupported)
+		}
+		return nil, fromGRPCError(err)
+	}
+
+	metadata := &velero.ObjectMetadata{ETag: res.Etag, Size: res.Size}
+	if res.LastModified != 0 {
+		metadata.LastModified = time.Unix(0, res.LastModified).UTC()
+	}
+
+	return metadata, nil
+}
+
+// ListObjectSizes returns the size of each object in the specified bucket that
+// has the given prefix. It returns velero.ErrListObjectSizesNotSupported if the
+// plugin doesn't support listing object sizes, including plugins built against
+// versions of Velero that predate it.
+func (c *ObjectStoreGRPCClient) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	req := &proto.ListObjectSizesRequest{
+		Plugin: c.plugin,
+		Bucket: bucket,
+		Prefix: prefix,
+	}
+
+	res, err := c.grpcClient.ListObjectSizes(context.Background(), req)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, errors.WithStack(velero.ErrListObjectSizesNotSupported)
+		}
+		return nil, fromGRPCError(err)
+	}
+
+	sizes := res.Sizes
+	if sizes == nil {
+		sizes = make(map[string]int64)
+	}
+	return sizes, nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -270,3 +271,70 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// GetObjectMetadata returns the metadata of the object with the given key in the
+// specified bucket. It returns a codes.Unimplemented error if the ObjectStore doesn't
+// implement velero.ObjectMetadataGetter.
+func (s *ObjectStoreGRPCServer) GetObjectMetadata(ctx context.Context, req *proto.GetObjectMetadataRequest) (response *proto.GetObjectMetadataResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	getter, ok := impl.(velero.ObjectMetadataGetter)
+	if !ok {
+		return nil, newGRPCErrorWithCode(errors.WithStack(velero.ErrObjectMetadataNotSupported), codes.Unimplemented)
+	}
+
+	metadata, err := getter.GetObjectMetadata(req.Bucket, req.Key)
+	if err != nil {
+		if errors.Cause(err) == velero.ErrObjectMetadataNotSupported {
+			return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+		}
+		return nil, newGRPCError(err)
+	}
+
+	res := &proto.GetObjectMetadataResponse{Etag: metadata.ETag, Size: metadata.Size}
+	if !metadata.LastModified.IsZero() {
+		res.LastModified = metadata.LastModified.UnixNano()
+	}
+
+	return res, nil
+}
+
+// ListObjectSizes returns the size of each object in the specified bucket that
+// has the given prefix. It returns a codes.Unimplemented error if the ObjectStore
+// doesn't implement velero.ObjectSizeLister.
+func (s *ObjectStoreGRPCServer) ListObjectSizes(ctx context.Context, req *proto.ListObjectSizesRequest) (response *proto.ListObjectSizesResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	lister, ok := impl.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, newGRPCErrorWithCode(errors.WithStack(velero.ErrListObjectSizesNotSupported), codes.Unimplemented)
+	}
+
+	sizes, err := lister.ListObjectSizes(req.Bucket, req.Prefix)
+	if err != nil {
+		if errors.Cause(err) == velero.ErrListObjectSizesNotSupported {
+			return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+		}
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.ListObjectSizesResponse{Sizes: sizes}, nil
+}
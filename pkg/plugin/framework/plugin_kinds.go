@@ -41,6 +41,24 @@ const (
 	// PluginKindDeleteItemAction represents a delete item action plugin.
 	PluginKindDeleteItemAction PluginKind = "DeleteItemAction"
 
+	// PluginKindItemBlockAction represents an item block action plugin.
+	PluginKindItemBlockAction PluginKind = "ItemBlockAction"
+
+	// PluginKindBackupValidator represents a backup validator plugin.
+	PluginKindBackupValidator PluginKind = "BackupValidator"
+
+	// PluginKindNamespaceMapper represents a namespace mapper plugin.
+	PluginKindNamespaceMapper PluginKind = "NamespaceMapper"
+
+	// PluginKindBackupDataIntegrity represents a backup data integrity plugin.
+	PluginKindBackupDataIntegrity PluginKind = "BackupDataIntegrity"
+
+	// PluginKindRestoreValidator represents a restore validator plugin.
+	PluginKindRestoreValidator PluginKind = "RestoreValidator"
+
+	// PluginKindBackupHookAction represents a backup hook action plugin.
+	PluginKindBackupHookAction PluginKind = "BackupHookAction"
+
 	// PluginKindPluginLister represents a plugin lister plugin.
 	PluginKindPluginLister PluginKind = "PluginLister"
 )
@@ -54,5 +72,11 @@ func AllPluginKinds() map[string]PluginKind {
 	allPluginKinds[PluginKindBackupItemAction.String()] = PluginKindBackupItemAction
 	allPluginKinds[PluginKindRestoreItemAction.String()] = PluginKindRestoreItemAction
 	allPluginKinds[PluginKindDeleteItemAction.String()] = PluginKindDeleteItemAction
+	allPluginKinds[PluginKindItemBlockAction.String()] = PluginKindItemBlockAction
+	allPluginKinds[PluginKindBackupValidator.String()] = PluginKindBackupValidator
+	allPluginKinds[PluginKindNamespaceMapper.String()] = PluginKindNamespaceMapper
+	allPluginKinds[PluginKindBackupDataIntegrity.String()] = PluginKindBackupDataIntegrity
+	allPluginKinds[PluginKindRestoreValidator.String()] = PluginKindRestoreValidator
+	allPluginKinds[PluginKindBackupHookAction.String()] = PluginKindBackupHookAction
 	return allPluginKinds
 }
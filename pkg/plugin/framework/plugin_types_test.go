@@ -30,6 +30,12 @@ func TestPluginImplementationsAreGRPCPlugins(t *testing.T) {
 		new(ObjectStorePlugin),
 		new(PluginListerPlugin),
 		new(RestoreItemActionPlugin),
+		new(ItemBlockActionPlugin),
+		new(BackupValidatorPlugin),
+		new(NamespaceMapperPlugin),
+		new(BackupDataIntegrityPlugin),
+		new(RestoreValidatorPlugin),
+		new(BackupHookActionPlugin),
 	}
 
 	for _, impl := range pluginImpls {
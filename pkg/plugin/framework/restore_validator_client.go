@@ -0,0 +1,99 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var _ velero.RestoreValidator = &RestoreValidatorGRPCClient{}
+
+// NewRestoreValidatorPlugin constructs a RestoreValidatorPlugin.
+func NewRestoreValidatorPlugin(options ...PluginOption) *RestoreValidatorPlugin {
+	return &RestoreValidatorPlugin{
+		pluginBase: newPluginBase(options...),
+	}
+}
+
+// RestoreValidatorGRPCClient implements the RestoreValidator interface and uses a
+// gRPC client to make calls to the plugin server.
+type RestoreValidatorGRPCClient struct {
+	*clientBase
+	grpcClient proto.RestoreValidatorClient
+}
+
+func newRestoreValidatorGRPCClient(base *clientBase, clientConn *grpc.ClientConn) interface{} {
+	return &RestoreValidatorGRPCClient{
+		clientBase: base,
+		grpcClient: proto.NewRestoreValidatorClient(clientConn),
+	}
+}
+
+func (c *RestoreValidatorGRPCClient) AppliesTo() (velero.ResourceSelector, error) {
+	res, err := c.grpcClient.AppliesTo(context.Background(), &proto.RestoreValidatorAppliesToRequest{Plugin: c.plugin})
+	if err != nil {
+		return velero.ResourceSelector{}, fromGRPCError(err)
+	}
+
+	if res.ResourceSelector == nil {
+		return velero.ResourceSelector{}, nil
+	}
+
+	return velero.ResourceSelector{
+		IncludedNamespaces: res.ResourceSelector.IncludedNamespaces,
+		ExcludedNamespaces: res.ResourceSelector.ExcludedNamespaces,
+		IncludedResources:  res.ResourceSelector.IncludedResources,
+		ExcludedResources:  res.ResourceSelector.ExcludedResources,
+		LabelSelector:      res.ResourceSelector.Selector,
+	}, nil
+}
+
+func (c *RestoreValidatorGRPCClient) Validate(input *velero.RestoreValidatorValidateInput) (velero.RestoreValidationResult, error) {
+	itemJSON, err := json.Marshal(input.Item.UnstructuredContent())
+	if err != nil {
+		return velero.RestoreValidationResult{}, errors.WithStack(err)
+	}
+
+	restoreJSON, err := json.Marshal(input.Restore)
+	if err != nil {
+		return velero.RestoreValidationResult{}, errors.WithStack(err)
+	}
+
+	req := &proto.RestoreValidatorValidateRequest{
+		Plugin:  c.plugin,
+		Item:    itemJSON,
+		Restore: restoreJSON,
+	}
+
+	res, err := c.grpcClient.Validate(context.Background(), req)
+	if err != nil {
+		return velero.RestoreValidationResult{}, fromGRPCError(err)
+	}
+
+	return velero.RestoreValidationResult{
+		Passed:   res.Passed,
+		Message:  res.Message,
+		Severity: velero.RestoreValidationSeverity(res.Severity),
+	}, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// RestoreValidatorGRPCServer implements the proto-generated RestoreValidator interface, and accepts
+// gRPC calls and forwards them to an implementation of the pluggable interface.
+type RestoreValidatorGRPCServer struct {
+	mux *serverMux
+}
+
+func (s *RestoreValidatorGRPCServer) getImpl(name string) (velero.RestoreValidator, error) {
+	impl, err := s.mux.getHandler(name)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, ok := impl.(velero.RestoreValidator)
+	if !ok {
+		return nil, errors.Errorf("%T is not a restore validator", impl)
+	}
+
+	return validator, nil
+}
+
+func (s *RestoreValidatorGRPCServer) AppliesTo(ctx context.Context, req *proto.RestoreValidatorAppliesToRequest) (response *proto.RestoreValidatorAppliesToResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	resourceSelector, err := impl.AppliesTo()
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.RestoreValidatorAppliesToResponse{
+		ResourceSelector: &proto.ResourceSelector{
+			IncludedNamespaces: resourceSelector.IncludedNamespaces,
+			ExcludedNamespaces: resourceSelector.ExcludedNamespaces,
+			IncludedResources:  resourceSelector.IncludedResources,
+			ExcludedResources:  resourceSelector.ExcludedResources,
+			Selector:           resourceSelector.LabelSelector,
+		},
+	}, nil
+}
+
+func (s *RestoreValidatorGRPCServer) Validate(ctx context.Context, req *proto.RestoreValidatorValidateRequest) (response *proto.RestoreValidatorValidateResponse, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	var (
+		item       unstructured.Unstructured
+		restoreObj api.Restore
+	)
+
+	if err := json.Unmarshal(req.Item, &item); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	if err := json.Unmarshal(req.Restore, &restoreObj); err != nil {
+		return nil, newGRPCError(errors.WithStack(err))
+	}
+
+	result, err := impl.Validate(&velero.RestoreValidatorValidateInput{
+		Item:    &item,
+		Restore: &restoreObj,
+	})
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.RestoreValidatorValidateResponse{
+		Passed:   result.Passed,
+		Message:  result.Message,
+		Severity: string(result.Severity),
+	}, nil
+}
@@ -74,6 +74,48 @@ type Server interface {
 	// RegisterDeleteItemActions registers multiple Delete item actions.
 	RegisterDeleteItemActions(map[string]HandlerInitializer) Server
 
+	// RegisterItemBlockAction registers an item block action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterItemBlockAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterItemBlockActions registers multiple item block actions.
+	RegisterItemBlockActions(map[string]HandlerInitializer) Server
+
+	// RegisterBackupValidator registers a backup validator. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterBackupValidator(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupValidators registers multiple backup validators.
+	RegisterBackupValidators(map[string]HandlerInitializer) Server
+
+	// RegisterNamespaceMapper registers a namespace mapper. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterNamespaceMapper(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterNamespaceMappers registers multiple namespace mappers.
+	RegisterNamespaceMappers(map[string]HandlerInitializer) Server
+
+	// RegisterBackupDataIntegrity registers a backup data integrity plugin.
+	// Accepted format for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterBackupDataIntegrity(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupDataIntegrities registers multiple backup data integrity plugins.
+	RegisterBackupDataIntegrities(map[string]HandlerInitializer) Server
+
+	// RegisterRestoreValidator registers a restore validator. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterRestoreValidator(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterRestoreValidators registers multiple restore validators.
+	RegisterRestoreValidators(map[string]HandlerInitializer) Server
+
+	// RegisterBackupHookAction registers a backup hook action. Accepted format
+	// for the plugin name is <DNS subdomain>/<non-empty name>.
+	RegisterBackupHookAction(pluginName string, initializer HandlerInitializer) Server
+
+	// RegisterBackupHookActions registers multiple backup hook actions.
+	RegisterBackupHookActions(map[string]HandlerInitializer) Server
+
 	// Server runs the plugin server.
 	Serve()
 }
@@ -89,6 +131,12 @@ type server struct {
 	objectStore       *ObjectStorePlugin
 	restoreItemAction *RestoreItemActionPlugin
 	deleteItemAction  *DeleteItemActionPlugin
+	itemBlockAction   *ItemBlockActionPlugin
+	backupValidator   *BackupValidatorPlugin
+	namespaceMapper   *NamespaceMapperPlugin
+	dataIntegrity     *BackupDataIntegrityPlugin
+	restoreValidator  *RestoreValidatorPlugin
+	backupHookAction  *BackupHookActionPlugin
 }
 
 // NewServer returns a new Server
@@ -105,6 +153,12 @@ func NewServer() Server {
 		objectStore:       NewObjectStorePlugin(serverLogger(log)),
 		restoreItemAction: NewRestoreItemActionPlugin(serverLogger(log)),
 		deleteItemAction:  NewDeleteItemActionPlugin(serverLogger(log)),
+		itemBlockAction:   NewItemBlockActionPlugin(serverLogger(log)),
+		backupValidator:   NewBackupValidatorPlugin(serverLogger(log)),
+		namespaceMapper:   NewNamespaceMapperPlugin(serverLogger(log)),
+		dataIntegrity:     NewBackupDataIntegrityPlugin(serverLogger(log)),
+		restoreValidator:  NewRestoreValidatorPlugin(serverLogger(log)),
+		backupHookAction:  NewBackupHookActionPlugin(serverLogger(log)),
 	}
 }
 
@@ -177,6 +231,78 @@ func (s *server) RegisterDeleteItemActions(m map[string]HandlerInitializer) Serv
 	return s
 }
 
+func (s *server) RegisterItemBlockAction(name string, initializer HandlerInitializer) Server {
+	s.itemBlockAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterItemBlockActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterItemBlockAction(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterBackupValidator(name string, initializer HandlerInitializer) Server {
+	s.backupValidator.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupValidators(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupValidator(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterNamespaceMapper(name string, initializer HandlerInitializer) Server {
+	s.namespaceMapper.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterNamespaceMappers(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterNamespaceMapper(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterBackupDataIntegrity(name string, initializer HandlerInitializer) Server {
+	s.dataIntegrity.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupDataIntegrities(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupDataIntegrity(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterRestoreValidator(name string, initializer HandlerInitializer) Server {
+	s.restoreValidator.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterRestoreValidators(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterRestoreValidator(name, m[name])
+	}
+	return s
+}
+
+func (s *server) RegisterBackupHookAction(name string, initializer HandlerInitializer) Server {
+	s.backupHookAction.register(name, initializer)
+	return s
+}
+
+func (s *server) RegisterBackupHookActions(m map[string]HandlerInitializer) Server {
+	for name := range m {
+		s.RegisterBackupHookAction(name, m[name])
+	}
+	return s
+}
+
 // getNames returns a list of PluginIdentifiers registered with plugin.
 func getNames(command string, kind PluginKind, plugin Interface) []PluginIdentifier {
 	var pluginIdentifiers []PluginIdentifier
@@ -206,18 +332,30 @@ func (s *server) Serve() {
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindObjectStore, s.objectStore)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreItemAction, s.restoreItemAction)...)
 	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindDeleteItemAction, s.deleteItemAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindItemBlockAction, s.itemBlockAction)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupValidator, s.backupValidator)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindNamespaceMapper, s.namespaceMapper)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupDataIntegrity, s.dataIntegrity)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindRestoreValidator, s.restoreValidator)...)
+	pluginIdentifiers = append(pluginIdentifiers, getNames(command, PluginKindBackupHookAction, s.backupHookAction)...)
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
 
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake(),
 		Plugins: map[string]plugin.Plugin{
-			string(PluginKindBackupItemAction):  s.backupItemAction,
-			string(PluginKindVolumeSnapshotter): s.volumeSnapshotter,
-			string(PluginKindObjectStore):       s.objectStore,
-			string(PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
-			string(PluginKindRestoreItemAction): s.restoreItemAction,
-			string(PluginKindDeleteItemAction):  s.deleteItemAction,
+			string(PluginKindBackupItemAction):    s.backupItemAction,
+			string(PluginKindVolumeSnapshotter):   s.volumeSnapshotter,
+			string(PluginKindObjectStore):         s.objectStore,
+			string(PluginKindPluginLister):        NewPluginListerPlugin(pluginLister),
+			string(PluginKindRestoreItemAction):   s.restoreItemAction,
+			string(PluginKindDeleteItemAction):    s.deleteItemAction,
+			string(PluginKindItemBlockAction):     s.itemBlockAction,
+			string(PluginKindBackupValidator):     s.backupValidator,
+			string(PluginKindNamespaceMapper):     s.namespaceMapper,
+			string(PluginKindBackupDataIntegrity): s.dataIntegrity,
+			string(PluginKindRestoreValidator):    s.restoreValidator,
+			string(PluginKindBackupHookAction):    s.backupHookAction,
 		},
 		GRPCServer: plugin.DefaultGRPCServer,
 	})
@@ -22,10 +22,13 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // NewVolumeSnapshotterPlugin constructs a VolumeSnapshotterPlugin.
@@ -186,3 +189,22 @@ func (c *VolumeSnapshotterGRPCClient) SetVolumeID(pv runtime.Unstructured, volum
 
 	return &updatedPV, nil
 }
+
+// ValidateLocation checks that the volume snapshotter can take snapshots in its
+// configured location. It returns velero.ErrLocationValidationNotSupported if the
+// plugin doesn't support validating its location, including plugins built against
+// versions of Velero that predate it.
+func (c *VolumeSnapshotterGRPCClient) ValidateLocation() error {
+	req := &proto.ValidateLocationRequest{
+		Plugin: c.plugin,
+	}
+
+	if _, err := c.grpcClient.ValidateLocation(context.Background(), req); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return errors.WithStack(velero.ErrLocationValidationNotSupported)
+		}
+		return fromGRPCError(err)
+	}
+
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -227,3 +228,33 @@ func (s *VolumeSnapshotterGRPCServer) SetVolumeID(ctx context.Context, req *prot
 
 	return &proto.SetVolumeIDResponse{PersistentVolume: updatedPVBytes}, nil
 }
+
+// ValidateLocation checks that the VolumeSnapshotter can take snapshots in its
+// configured location. It returns a codes.Unimplemented error if the
+// VolumeSnapshotter doesn't implement velero.VolumeSnapshotterValidator.
+func (s *VolumeSnapshotterGRPCServer) ValidateLocation(ctx context.Context, req *proto.ValidateLocationRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := handlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, newGRPCError(err)
+	}
+
+	validator, ok := impl.(velero.VolumeSnapshotterValidator)
+	if !ok {
+		return nil, newGRPCErrorWithCode(errors.WithStack(velero.ErrLocationValidationNotSupported), codes.Unimplemented)
+	}
+
+	if err := validator.ValidateLocation(); err != nil {
+		if errors.Cause(err) == velero.ErrLocationValidationNotSupported {
+			return nil, newGRPCErrorWithCode(err, codes.Unimplemented)
+		}
+		return nil, newGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
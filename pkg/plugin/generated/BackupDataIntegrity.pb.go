@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: BackupDataIntegrity.proto
+
+/*
+Package generated is a generated protocol buffer package.
+
+It is generated from these files:
+	BackupDataIntegrity.proto
+	BackupHookAction.proto
+	BackupItemAction.proto
+	BackupValidator.proto
+	DeleteItemAction.proto
+	ItemBlockAction.proto
+	NamespaceMapper.proto
+	ObjectStore.proto
+	PluginLister.proto
+	RestoreItemAction.proto
+	RestoreValidator.proto
+	Shared.proto
+	VolumeSnapshotter.proto
+
+It has these top-level messages:
+	BackupDataIntegrityComputeDigestRequest
+	BackupDataIntegrityComputeDigestResponse
+	BackupDataIntegrityVerifyDigestRequest
+	BackupHookActionExecuteRequest
+	ExecuteRequest
+	ExecuteResponse
+	BackupItemActionAppliesToRequest
+	BackupItemActionAppliesToResponse
+	BackupValidatorValidateRequest
+	BackupValidatorValidateResponse
+	DeleteItemActionExecuteRequest
+	DeleteItemActionAppliesToRequest
+	DeleteItemActionAppliesToResponse
+	ItemBlockActionGetRelatedItemsRequest
+	ItemBlockActionGetRelatedItemsResponse
+	ItemBlockActionAppliesToRequest
+	ItemBlockActionAppliesToResponse
+	NamespaceMapperMapNamespaceRequest
+	NamespaceMapperMapNamespaceResponse
+	PutObjectRequest
+	ObjectExistsRequest
+	ObjectExistsResponse
+	GetObjectRequest
+	Bytes
+	ListCommonPrefixesRequest
+	ListCommonPrefixesResponse
+	ListObjectsRequest
+	ListObjectsResponse
+	DeleteObjectRequest
+	CreateSignedURLRequest
+	CreateSignedURLResponse
+	GetObjectMetadataRequest
+	GetObjectMetadataResponse
+	ListObjectSizesRequest
+	ListObjectSizesResponse
+	ObjectStoreInitRequest
+	PluginIdentifier
+	ListPluginsResponse
+	RestoreItemActionExecuteRequest
+	RestoreItemActionExecuteResponse
+	RestoreItemActionAppliesToRequest
+	RestoreItemActionAppliesToResponse
+	RestoreValidatorAppliesToRequest
+	RestoreValidatorAppliesToResponse
+	RestoreValidatorValidateRequest
+	RestoreValidatorValidateResponse
+	Empty
+	Stack
+	StackFrame
+	ResourceIdentifier
+	ResourceSelector
+	CreateVolumeRequest
+	CreateVolumeResponse
+	GetVolumeInfoRequest
+	GetVolumeInfoResponse
+	CreateSnapshotRequest
+	CreateSnapshotResponse
+	DeleteSnapshotRequest
+	GetVolumeIDRequest
+	GetVolumeIDResponse
+	SetVolumeIDRequest
+	SetVolumeIDResponse
+	ValidateLocationRequest
+	VolumeSnapshotterInitRequest
+*/
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type BackupDataIntegrityComputeDigestRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Path   string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *BackupDataIntegrityComputeDigestRequest) Reset() {
+	*m = BackupDataIntegrityComputeDigestRequest{}
+}
+func (m *BackupDataIntegrityComputeDigestRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupDataIntegrityComputeDigestRequest) ProtoMessage()    {}
+func (*BackupDataIntegrityComputeDigestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0}
+}
+
+func (m *BackupDataIntegrityComputeDigestRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupDataIntegrityComputeDigestRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type BackupDataIntegrityComputeDigestResponse struct {
+	Digest string `protobuf:"bytes,1,opt,name=digest" json:"digest,omitempty"`
+}
+
+func (m *BackupDataIntegrityComputeDigestResponse) Reset() {
+	*m = BackupDataIntegrityComputeDigestResponse{}
+}
+func (m *BackupDataIntegrityComputeDigestResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupDataIntegrityComputeDigestResponse) ProtoMessage()    {}
+func (*BackupDataIntegrityComputeDigestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{1}
+}
+
+func (m *BackupDataIntegrityComputeDigestResponse) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+type BackupDataIntegrityVerifyDigestRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Path   string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Digest string `protobuf:"bytes,3,opt,name=digest" json:"digest,omitempty"`
+}
+
+func (m *BackupDataIntegrityVerifyDigestRequest) Reset() {
+	*m = BackupDataIntegrityVerifyDigestRequest{}
+}
+func (m *BackupDataIntegrityVerifyDigestRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupDataIntegrityVerifyDigestRequest) ProtoMessage()    {}
+func (*BackupDataIntegrityVerifyDigestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2}
+}
+
+func (m *BackupDataIntegrityVerifyDigestRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupDataIntegrityVerifyDigestRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BackupDataIntegrityVerifyDigestRequest) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BackupDataIntegrityComputeDigestRequest)(nil), "generated.BackupDataIntegrityComputeDigestRequest")
+	proto.RegisterType((*BackupDataIntegrityComputeDigestResponse)(nil), "generated.BackupDataIntegrityComputeDigestResponse")
+	proto.RegisterType((*BackupDataIntegrityVerifyDigestRequest)(nil), "generated.BackupDataIntegrityVerifyDigestRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BackupDataIntegrity service
+
+type BackupDataIntegrityClient interface {
+	ComputeDigest(ctx context.Context, in *BackupDataIntegrityComputeDigestRequest, opts ...grpc.CallOption) (*BackupDataIntegrityComputeDigestResponse, error)
+	VerifyDigest(ctx context.Context, in *BackupDataIntegrityVerifyDigestRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type backupDataIntegrityClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupDataIntegrityClient(cc *grpc.ClientConn) BackupDataIntegrityClient {
+	return &backupDataIntegrityClient{cc}
+}
+
+func (c *backupDataIntegrityClient) ComputeDigest(ctx context.Context, in *BackupDataIntegrityComputeDigestRequest, opts ...grpc.CallOption) (*BackupDataIntegrityComputeDigestResponse, error) {
+	out := new(BackupDataIntegrityComputeDigestResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupDataIntegrity/ComputeDigest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupDataIntegrityClient) VerifyDigest(ctx context.Context, in *BackupDataIntegrityVerifyDigestRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.BackupDataIntegrity/VerifyDigest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BackupDataIntegrity service
+
+type BackupDataIntegrityServer interface {
+	ComputeDigest(context.Context, *BackupDataIntegrityComputeDigestRequest) (*BackupDataIntegrityComputeDigestResponse, error)
+	VerifyDigest(context.Context, *BackupDataIntegrityVerifyDigestRequest) (*Empty, error)
+}
+
+func RegisterBackupDataIntegrityServer(s *grpc.Server, srv BackupDataIntegrityServer) {
+	s.RegisterService(&_BackupDataIntegrity_serviceDesc, srv)
+}
+
+func _BackupDataIntegrity_ComputeDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDataIntegrityComputeDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupDataIntegrityServer).ComputeDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupDataIntegrity/ComputeDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupDataIntegrityServer).ComputeDigest(ctx, req.(*BackupDataIntegrityComputeDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupDataIntegrity_VerifyDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDataIntegrityVerifyDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupDataIntegrityServer).VerifyDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupDataIntegrity/VerifyDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupDataIntegrityServer).VerifyDigest(ctx, req.(*BackupDataIntegrityVerifyDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BackupDataIntegrity_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.BackupDataIntegrity",
+	HandlerType: (*BackupDataIntegrityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ComputeDigest",
+			Handler:    _BackupDataIntegrity_ComputeDigest_Handler,
+		},
+		{
+			MethodName: "VerifyDigest",
+			Handler:    _BackupDataIntegrity_VerifyDigest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "BackupDataIntegrity.proto",
+}
+
+func init() { proto.RegisterFile("BackupDataIntegrity.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x74, 0x4a, 0x4c, 0xce,
+	0x2e, 0x2d, 0x70, 0x49, 0x2c, 0x49, 0xf4, 0xcc, 0x2b, 0x49, 0x4d, 0x2f, 0xca, 0x2c, 0xa9, 0xd4,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4c, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d,
+	0x91, 0xe2, 0x09, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0x81, 0x48, 0x28, 0x85, 0x72, 0xa9, 0x63, 0xd1,
+	0xe5, 0x9c, 0x9f, 0x5b, 0x50, 0x5a, 0x92, 0xea, 0x92, 0x99, 0x9e, 0x5a, 0x5c, 0x12, 0x94, 0x5a,
+	0x58, 0x9a, 0x5a, 0x5c, 0x22, 0x24, 0xc6, 0xc5, 0x56, 0x90, 0x53, 0x9a, 0x9e, 0x99, 0x27, 0xc1,
+	0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe5, 0x09, 0x09, 0x71, 0xb1, 0x14, 0x24, 0x96, 0x64, 0x48,
+	0x30, 0x81, 0x45, 0xc1, 0x6c, 0x25, 0x27, 0x2e, 0x0d, 0xc2, 0xc6, 0x16, 0x17, 0xe4, 0xe7, 0x15,
+	0xa7, 0x82, 0xcc, 0x4d, 0x01, 0x8b, 0xc0, 0xcc, 0x85, 0xf0, 0x94, 0x72, 0xb8, 0xd4, 0xb0, 0x98,
+	0x11, 0x96, 0x5a, 0x94, 0x99, 0x56, 0x49, 0xb6, 0xcb, 0x90, 0x6c, 0x63, 0x46, 0xb6, 0xcd, 0xe8,
+	0x09, 0x23, 0x97, 0x30, 0x16, 0xeb, 0x84, 0x2a, 0xb8, 0x78, 0x51, 0x9c, 0x2d, 0x64, 0xa4, 0x07,
+	0x0f, 0x4b, 0x3d, 0x22, 0x83, 0x4e, 0xca, 0x98, 0x24, 0x3d, 0xd0, 0x70, 0x09, 0xe6, 0xe2, 0x41,
+	0xf6, 0xac, 0x90, 0x21, 0x7e, 0x43, 0xb0, 0x04, 0x8c, 0x94, 0x00, 0x92, 0x16, 0xd7, 0xdc, 0x82,
+	0x92, 0xca, 0x24, 0x36, 0x70, 0xb4, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xef, 0xae,
+	0x99, 0x2c, 0x02, 0x00, 0x00,
+}
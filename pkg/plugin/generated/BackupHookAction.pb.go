@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: BackupHookAction.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type BackupHookActionExecuteRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Backup []byte `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup,omitempty"`
+	Phase  string `protobuf:"bytes,3,opt,name=phase" json:"phase,omitempty"`
+}
+
+func (m *BackupHookActionExecuteRequest) Reset()                    { *m = BackupHookActionExecuteRequest{} }
+func (m *BackupHookActionExecuteRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupHookActionExecuteRequest) ProtoMessage()               {}
+func (*BackupHookActionExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+func (m *BackupHookActionExecuteRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupHookActionExecuteRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *BackupHookActionExecuteRequest) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BackupHookActionExecuteRequest)(nil), "generated.BackupHookActionExecuteRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BackupHookAction service
+
+type BackupHookActionClient interface {
+	Execute(ctx context.Context, in *BackupHookActionExecuteRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type backupHookActionClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupHookActionClient(cc *grpc.ClientConn) BackupHookActionClient {
+	return &backupHookActionClient{cc}
+}
+
+func (c *backupHookActionClient) Execute(ctx context.Context, in *BackupHookActionExecuteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.BackupHookAction/Execute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BackupHookAction service
+
+type BackupHookActionServer interface {
+	Execute(context.Context, *BackupHookActionExecuteRequest) (*Empty, error)
+}
+
+func RegisterBackupHookActionServer(s *grpc.Server, srv BackupHookActionServer) {
+	s.RegisterService(&_BackupHookAction_serviceDesc, srv)
+}
+
+func _BackupHookAction_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupHookActionExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupHookActionServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupHookAction/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupHookActionServer).Execute(ctx, req.(*BackupHookActionExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BackupHookAction_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.BackupHookAction",
+	HandlerType: (*BackupHookActionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Execute",
+			Handler:    _BackupHookAction_Execute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "BackupHookAction.proto",
+}
+
+func init() { proto.RegisterFile("BackupHookAction.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x73, 0x4a, 0x4c, 0xce,
+	0x2e, 0x2d, 0xf0, 0xc8, 0xcf, 0xcf, 0x76, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca,
+	0x2f, 0xc9, 0x17, 0xe2, 0x4c, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d, 0x91, 0xe2, 0x09,
+	0xce, 0x48, 0x2c, 0x4a, 0x4d, 0x81, 0x48, 0x28, 0xa5, 0x71, 0xc9, 0xa1, 0x6b, 0x71, 0xad, 0x48,
+	0x4d, 0x2e, 0x2d, 0x49, 0x0d, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x12, 0xe3, 0x62, 0x2b,
+	0xc8, 0x29, 0x4d, 0xcf, 0xcc, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0xf2, 0x40, 0xe2,
+	0x49, 0x60, 0x9d, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x50, 0x9e, 0x90, 0x08, 0x17, 0x6b,
+	0x41, 0x46, 0x62, 0x71, 0xaa, 0x04, 0x33, 0x58, 0x39, 0x84, 0x63, 0x14, 0xc5, 0x25, 0x80, 0x6e,
+	0x8f, 0x90, 0x1b, 0x17, 0x3b, 0xd4, 0x2e, 0x21, 0x4d, 0x3d, 0xb8, 0x03, 0xf5, 0xf0, 0xbb, 0x47,
+	0x4a, 0x00, 0x49, 0xa9, 0x6b, 0x6e, 0x41, 0x49, 0x65, 0x12, 0x1b, 0xd8, 0x2b, 0xc6, 0x80, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xaa, 0x22, 0xda, 0x31, 0xfd, 0x00, 0x00, 0x00,
+}
@@ -1,63 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: BackupItemAction.proto
 
-/*
-Package generated is a generated protocol buffer package.
-
-It is generated from these files:
-	BackupItemAction.proto
-	DeleteItemAction.proto
-	ObjectStore.proto
-	PluginLister.proto
-	RestoreItemAction.proto
-	Shared.proto
-	VolumeSnapshotter.proto
-
-It has these top-level messages:
-	ExecuteRequest
-	ExecuteResponse
-	BackupItemActionAppliesToRequest
-	BackupItemActionAppliesToResponse
-	DeleteItemActionExecuteRequest
-	DeleteItemActionAppliesToRequest
-	DeleteItemActionAppliesToResponse
-	PutObjectRequest
-	ObjectExistsRequest
-	ObjectExistsResponse
-	GetObjectRequest
-	Bytes
-	ListCommonPrefixesRequest
-	ListCommonPrefixesResponse
-	ListObjectsRequest
-	ListObjectsResponse
-	DeleteObjectRequest
-	CreateSignedURLRequest
-	CreateSignedURLResponse
-	ObjectStoreInitRequest
-	PluginIdentifier
-	ListPluginsResponse
-	RestoreItemActionExecuteRequest
-	RestoreItemActionExecuteResponse
-	RestoreItemActionAppliesToRequest
-	RestoreItemActionAppliesToResponse
-	Empty
-	Stack
-	StackFrame
-	ResourceIdentifier
-	ResourceSelector
-	CreateVolumeRequest
-	CreateVolumeResponse
-	GetVolumeInfoRequest
-	GetVolumeInfoResponse
-	CreateSnapshotRequest
-	CreateSnapshotResponse
-	DeleteSnapshotRequest
-	GetVolumeIDRequest
-	GetVolumeIDResponse
-	SetVolumeIDRequest
-	SetVolumeIDResponse
-	VolumeSnapshotterInitRequest
-*/
 package generated
 
 import proto "github.com/golang/protobuf/proto"
@@ -74,12 +17,6 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
-
 type ExecuteRequest struct {
 	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Item   []byte `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
@@ -89,7 +26,7 @@ type ExecuteRequest struct {
 func (m *ExecuteRequest) Reset()                    { *m = ExecuteRequest{} }
 func (m *ExecuteRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExecuteRequest) ProtoMessage()               {}
-func (*ExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*ExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
 
 func (m *ExecuteRequest) GetPlugin() string {
 	if m != nil {
@@ -120,7 +57,7 @@ type ExecuteResponse struct {
 func (m *ExecuteResponse) Reset()                    { *m = ExecuteResponse{} }
 func (m *ExecuteResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExecuteResponse) ProtoMessage()               {}
-func (*ExecuteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*ExecuteResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
 
 func (m *ExecuteResponse) GetItem() []byte {
 	if m != nil {
@@ -144,7 +81,7 @@ func (m *BackupItemActionAppliesToRequest) Reset()         { *m = BackupItemActi
 func (m *BackupItemActionAppliesToRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupItemActionAppliesToRequest) ProtoMessage()    {}
 func (*BackupItemActionAppliesToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{2}
+	return fileDescriptor2, []int{2}
 }
 
 func (m *BackupItemActionAppliesToRequest) GetPlugin() string {
@@ -162,7 +99,7 @@ func (m *BackupItemActionAppliesToResponse) Reset()         { *m = BackupItemAct
 func (m *BackupItemActionAppliesToResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupItemActionAppliesToResponse) ProtoMessage()    {}
 func (*BackupItemActionAppliesToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{3}
+	return fileDescriptor2, []int{3}
 }
 
 func (m *BackupItemActionAppliesToResponse) GetResourceSelector() *ResourceSelector {
@@ -284,9 +221,9 @@ var _BackupItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "BackupItemAction.proto",
 }
 
-func init() { proto.RegisterFile("BackupItemAction.proto", fileDescriptor0) }
+func init() { proto.RegisterFile("BackupItemAction.proto", fileDescriptor2) }
 
-var fileDescriptor0 = []byte{
+var fileDescriptor2 = []byte{
 	// 293 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
 	0x10, 0x86, 0x49, 0x2b, 0x95, 0x4e, 0x8b, 0x2d, 0x7b, 0x28, 0x31, 0x22, 0xc4, 0x9c, 0x02, 0x4a,
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: BackupValidator.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type BackupValidatorValidateRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Backup []byte `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *BackupValidatorValidateRequest) Reset()                    { *m = BackupValidatorValidateRequest{} }
+func (m *BackupValidatorValidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupValidatorValidateRequest) ProtoMessage()               {}
+func (*BackupValidatorValidateRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+
+func (m *BackupValidatorValidateRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *BackupValidatorValidateRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type BackupValidatorValidateResponse struct {
+	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *BackupValidatorValidateResponse) Reset()         { *m = BackupValidatorValidateResponse{} }
+func (m *BackupValidatorValidateResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupValidatorValidateResponse) ProtoMessage()    {}
+func (*BackupValidatorValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{1}
+}
+
+func (m *BackupValidatorValidateResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *BackupValidatorValidateResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BackupValidatorValidateRequest)(nil), "generated.BackupValidatorValidateRequest")
+	proto.RegisterType((*BackupValidatorValidateResponse)(nil), "generated.BackupValidatorValidateResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BackupValidator service
+
+type BackupValidatorClient interface {
+	Validate(ctx context.Context, in *BackupValidatorValidateRequest, opts ...grpc.CallOption) (*BackupValidatorValidateResponse, error)
+}
+
+type backupValidatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBackupValidatorClient(cc *grpc.ClientConn) BackupValidatorClient {
+	return &backupValidatorClient{cc}
+}
+
+func (c *backupValidatorClient) Validate(ctx context.Context, in *BackupValidatorValidateRequest, opts ...grpc.CallOption) (*BackupValidatorValidateResponse, error) {
+	out := new(BackupValidatorValidateResponse)
+	err := grpc.Invoke(ctx, "/generated.BackupValidator/Validate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BackupValidator service
+
+type BackupValidatorServer interface {
+	Validate(context.Context, *BackupValidatorValidateRequest) (*BackupValidatorValidateResponse, error)
+}
+
+func RegisterBackupValidatorServer(s *grpc.Server, srv BackupValidatorServer) {
+	s.RegisterService(&_BackupValidator_serviceDesc, srv)
+}
+
+func _BackupValidator_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupValidatorValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupValidatorServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.BackupValidator/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupValidatorServer).Validate(ctx, req.(*BackupValidatorValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BackupValidator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.BackupValidator",
+	HandlerType: (*BackupValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _BackupValidator_Validate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "BackupValidator.proto",
+}
+
+func init() { proto.RegisterFile("BackupValidator.proto", fileDescriptor3) }
+
+var fileDescriptor3 = []byte{
+	// 187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x75, 0x4a, 0x4c, 0xce,
+	0x2e, 0x2d, 0x08, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f, 0xd2, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0xe2, 0x4c, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d, 0x51, 0x0a, 0xe0, 0x92,
+	0x43, 0x53, 0x03, 0x65, 0xa4, 0x06, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x08, 0x89, 0x71, 0xb1,
+	0x15, 0xe4, 0x94, 0xa6, 0x67, 0xe6, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x79, 0x20,
+	0xf1, 0x24, 0xb0, 0x4e, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x9e, 0x20, 0x28, 0x4f, 0x29, 0x9c, 0x4b,
+	0x1e, 0xa7, 0x89, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x52, 0x5c, 0x1c, 0x89, 0xc9, 0xc9,
+	0xa9, 0x05, 0x25, 0xa9, 0x29, 0x60, 0x43, 0x39, 0x82, 0xe0, 0x7c, 0x21, 0x09, 0x2e, 0xf6, 0xdc,
+	0xd4, 0xe2, 0xe2, 0xc4, 0xf4, 0x54, 0xb0, 0xb9, 0x9c, 0x41, 0x30, 0xae, 0x51, 0x09, 0x17, 0x3f,
+	0x9a, 0xc1, 0x42, 0x89, 0x5c, 0x1c, 0x30, 0xc3, 0x85, 0x34, 0xf5, 0xe0, 0xbe, 0xd2, 0xc3, 0xef,
+	0x25, 0x29, 0x2d, 0x62, 0x94, 0x42, 0xdc, 0x9a, 0xc4, 0x06, 0x0e, 0x32, 0x63, 0x40, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xc2, 0x57, 0xe7, 0x69, 0x4b, 0x01, 0x00, 0x00,
+}
@@ -26,7 +26,7 @@ type DeleteItemActionExecuteRequest struct {
 func (m *DeleteItemActionExecuteRequest) Reset()                    { *m = DeleteItemActionExecuteRequest{} }
 func (m *DeleteItemActionExecuteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteItemActionExecuteRequest) ProtoMessage()               {}
-func (*DeleteItemActionExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*DeleteItemActionExecuteRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
 
 func (m *DeleteItemActionExecuteRequest) GetPlugin() string {
 	if m != nil {
@@ -57,7 +57,7 @@ func (m *DeleteItemActionAppliesToRequest) Reset()         { *m = DeleteItemActi
 func (m *DeleteItemActionAppliesToRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteItemActionAppliesToRequest) ProtoMessage()    {}
 func (*DeleteItemActionAppliesToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{1}
+	return fileDescriptor4, []int{1}
 }
 
 func (m *DeleteItemActionAppliesToRequest) GetPlugin() string {
@@ -75,7 +75,7 @@ func (m *DeleteItemActionAppliesToResponse) Reset()         { *m = DeleteItemAct
 func (m *DeleteItemActionAppliesToResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteItemActionAppliesToResponse) ProtoMessage()    {}
 func (*DeleteItemActionAppliesToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{2}
+	return fileDescriptor4, []int{2}
 }
 
 func (m *DeleteItemActionAppliesToResponse) GetResourceSelector() *ResourceSelector {
@@ -196,9 +196,9 @@ var _DeleteItemAction_serviceDesc = grpc.ServiceDesc{
 	Metadata: "DeleteItemAction.proto",
 }
 
-func init() { proto.RegisterFile("DeleteItemAction.proto", fileDescriptor1) }
+func init() { proto.RegisterFile("DeleteItemAction.proto", fileDescriptor4) }
 
-var fileDescriptor1 = []byte{
+var fileDescriptor4 = []byte{
 	// 253 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0xc3, 0x40,
 	0x14, 0x84, 0x89, 0x4a, 0x25, 0xcf, 0x1e, 0xc2, 0x1e, 0x4a, 0x88, 0x20, 0x31, 0xa7, 0x8a, 0x92,
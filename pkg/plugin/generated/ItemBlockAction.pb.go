@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: ItemBlockAction.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type ItemBlockActionGetRelatedItemsRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item   []byte `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Backup []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *ItemBlockActionGetRelatedItemsRequest) Reset()         { *m = ItemBlockActionGetRelatedItemsRequest{} }
+func (m *ItemBlockActionGetRelatedItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*ItemBlockActionGetRelatedItemsRequest) ProtoMessage()    {}
+func (*ItemBlockActionGetRelatedItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{0}
+}
+
+func (m *ItemBlockActionGetRelatedItemsRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *ItemBlockActionGetRelatedItemsRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *ItemBlockActionGetRelatedItemsRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type ItemBlockActionGetRelatedItemsResponse struct {
+	RelatedItems []*ResourceIdentifier `protobuf:"bytes,1,rep,name=relatedItems" json:"relatedItems,omitempty"`
+}
+
+func (m *ItemBlockActionGetRelatedItemsResponse) Reset() {
+	*m = ItemBlockActionGetRelatedItemsResponse{}
+}
+func (m *ItemBlockActionGetRelatedItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*ItemBlockActionGetRelatedItemsResponse) ProtoMessage()    {}
+func (*ItemBlockActionGetRelatedItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{1}
+}
+
+func (m *ItemBlockActionGetRelatedItemsResponse) GetRelatedItems() []*ResourceIdentifier {
+	if m != nil {
+		return m.RelatedItems
+	}
+	return nil
+}
+
+type ItemBlockActionAppliesToRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+}
+
+func (m *ItemBlockActionAppliesToRequest) Reset()         { *m = ItemBlockActionAppliesToRequest{} }
+func (m *ItemBlockActionAppliesToRequest) String() string { return proto.CompactTextString(m) }
+func (*ItemBlockActionAppliesToRequest) ProtoMessage()    {}
+func (*ItemBlockActionAppliesToRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{2}
+}
+
+func (m *ItemBlockActionAppliesToRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+type ItemBlockActionAppliesToResponse struct {
+	ResourceSelector *ResourceSelector `protobuf:"bytes,1,opt,name=ResourceSelector" json:"ResourceSelector,omitempty"`
+}
+
+func (m *ItemBlockActionAppliesToResponse) Reset()         { *m = ItemBlockActionAppliesToResponse{} }
+func (m *ItemBlockActionAppliesToResponse) String() string { return proto.CompactTextString(m) }
+func (*ItemBlockActionAppliesToResponse) ProtoMessage()    {}
+func (*ItemBlockActionAppliesToResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{3}
+}
+
+func (m *ItemBlockActionAppliesToResponse) GetResourceSelector() *ResourceSelector {
+	if m != nil {
+		return m.ResourceSelector
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ItemBlockActionGetRelatedItemsRequest)(nil), "generated.ItemBlockActionGetRelatedItemsRequest")
+	proto.RegisterType((*ItemBlockActionGetRelatedItemsResponse)(nil), "generated.ItemBlockActionGetRelatedItemsResponse")
+	proto.RegisterType((*ItemBlockActionAppliesToRequest)(nil), "generated.ItemBlockActionAppliesToRequest")
+	proto.RegisterType((*ItemBlockActionAppliesToResponse)(nil), "generated.ItemBlockActionAppliesToResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ItemBlockAction service
+
+type ItemBlockActionClient interface {
+	AppliesTo(ctx context.Context, in *ItemBlockActionAppliesToRequest, opts ...grpc.CallOption) (*ItemBlockActionAppliesToResponse, error)
+	GetRelatedItems(ctx context.Context, in *ItemBlockActionGetRelatedItemsRequest, opts ...grpc.CallOption) (*ItemBlockActionGetRelatedItemsResponse, error)
+}
+
+type itemBlockActionClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewItemBlockActionClient(cc *grpc.ClientConn) ItemBlockActionClient {
+	return &itemBlockActionClient{cc}
+}
+
+func (c *itemBlockActionClient) AppliesTo(ctx context.Context, in *ItemBlockActionAppliesToRequest, opts ...grpc.CallOption) (*ItemBlockActionAppliesToResponse, error) {
+	out := new(ItemBlockActionAppliesToResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemBlockAction/AppliesTo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemBlockActionClient) GetRelatedItems(ctx context.Context, in *ItemBlockActionGetRelatedItemsRequest, opts ...grpc.CallOption) (*ItemBlockActionGetRelatedItemsResponse, error) {
+	out := new(ItemBlockActionGetRelatedItemsResponse)
+	err := grpc.Invoke(ctx, "/generated.ItemBlockAction/GetRelatedItems", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ItemBlockAction service
+
+type ItemBlockActionServer interface {
+	AppliesTo(context.Context, *ItemBlockActionAppliesToRequest) (*ItemBlockActionAppliesToResponse, error)
+	GetRelatedItems(context.Context, *ItemBlockActionGetRelatedItemsRequest) (*ItemBlockActionGetRelatedItemsResponse, error)
+}
+
+func RegisterItemBlockActionServer(s *grpc.Server, srv ItemBlockActionServer) {
+	s.RegisterService(&_ItemBlockAction_serviceDesc, srv)
+}
+
+func _ItemBlockAction_AppliesTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemBlockActionAppliesToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemBlockActionServer).AppliesTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemBlockAction/AppliesTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemBlockActionServer).AppliesTo(ctx, req.(*ItemBlockActionAppliesToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemBlockAction_GetRelatedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemBlockActionGetRelatedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemBlockActionServer).GetRelatedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ItemBlockAction/GetRelatedItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemBlockActionServer).GetRelatedItems(ctx, req.(*ItemBlockActionGetRelatedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ItemBlockAction_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.ItemBlockAction",
+	HandlerType: (*ItemBlockActionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppliesTo",
+			Handler:    _ItemBlockAction_AppliesTo_Handler,
+		},
+		{
+			MethodName: "GetRelatedItems",
+			Handler:    _ItemBlockAction_GetRelatedItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ItemBlockAction.proto",
+}
+
+func init() { proto.RegisterFile("ItemBlockAction.proto", fileDescriptor5) }
+
+var fileDescriptor5 = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xd1, 0x4a, 0xc3, 0x30,
+	0x14, 0xa5, 0x4e, 0x06, 0xbd, 0x2b, 0x4c, 0x02, 0x4a, 0xa9, 0x88, 0xa5, 0xa0, 0x14, 0x85, 0xa2,
+	0xf5, 0xc9, 0xc7, 0xfa, 0x32, 0xf6, 0x9a, 0xf9, 0x03, 0x5d, 0x7b, 0x9d, 0xa1, 0x59, 0x12, 0x93,
+	0xd4, 0xdf, 0xf6, 0x17, 0xa4, 0x5d, 0x37, 0x66, 0xdd, 0xa8, 0xbe, 0xe5, 0xde, 0x7b, 0xce, 0x3d,
+	0xe7, 0x84, 0x0b, 0xe7, 0x73, 0x8b, 0xeb, 0x17, 0x2e, 0x8b, 0x2a, 0x2b, 0x2c, 0x93, 0x22, 0x51,
+	0x5a, 0x5a, 0x49, 0xdc, 0x15, 0x0a, 0xd4, 0xb9, 0xc5, 0x32, 0xf0, 0x16, 0xef, 0xb9, 0xc6, 0x72,
+	0x33, 0x88, 0x2a, 0xb8, 0xe9, 0x31, 0x66, 0x68, 0x29, 0xf2, 0x06, 0xda, 0x0c, 0x0c, 0xc5, 0x8f,
+	0x1a, 0x8d, 0x25, 0x17, 0x30, 0x56, 0xbc, 0x5e, 0x31, 0xe1, 0x3b, 0xa1, 0x13, 0xbb, 0xb4, 0xab,
+	0x08, 0x81, 0x53, 0x66, 0x71, 0xed, 0x9f, 0x84, 0x4e, 0xec, 0xd1, 0xf6, 0xdd, 0x60, 0x97, 0x79,
+	0x51, 0xd5, 0xca, 0x1f, 0xb5, 0xdd, 0xae, 0x8a, 0x2a, 0xb8, 0x1d, 0x12, 0x33, 0x4a, 0x0a, 0x83,
+	0x24, 0x03, 0x4f, 0xef, 0xf5, 0x7d, 0x27, 0x1c, 0xc5, 0x93, 0xf4, 0x2a, 0xd9, 0xc5, 0x48, 0x28,
+	0x1a, 0x59, 0xeb, 0x02, 0xe7, 0x25, 0x0a, 0xcb, 0xde, 0x18, 0x6a, 0xfa, 0x83, 0x12, 0x3d, 0xc3,
+	0x75, 0x4f, 0x2c, 0x53, 0x8a, 0x33, 0x34, 0xaf, 0x72, 0x20, 0x53, 0x54, 0x41, 0x78, 0x9c, 0xda,
+	0x39, 0x9c, 0xc1, 0xd9, 0xd6, 0xc2, 0x02, 0x39, 0x16, 0x56, 0xea, 0x76, 0xcb, 0x24, 0xbd, 0x3c,
+	0xe0, 0x72, 0x0b, 0xa1, 0xbf, 0x48, 0xe9, 0x97, 0x03, 0xd3, 0x9e, 0x1a, 0x29, 0xc1, 0xdd, 0x29,
+	0x92, 0xbb, 0xbd, 0x7d, 0x03, 0x89, 0x82, 0xfb, 0x3f, 0x61, 0xbb, 0x08, 0x9f, 0x30, 0xed, 0xfd,
+	0x3f, 0x79, 0x38, 0xce, 0x3f, 0x7c, 0x17, 0xc1, 0xe3, 0x3f, 0x18, 0x1b, 0xdd, 0xe5, 0xb8, 0x3d,
+	0xbd, 0xa7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x5b, 0x10, 0xc4, 0xac, 0x02, 0x00, 0x00,
+}
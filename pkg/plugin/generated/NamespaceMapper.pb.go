@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: NamespaceMapper.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type NamespaceMapperMapNamespaceRequest struct {
+	Plugin    string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	Backup    []byte `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (m *NamespaceMapperMapNamespaceRequest) Reset()         { *m = NamespaceMapperMapNamespaceRequest{} }
+func (m *NamespaceMapperMapNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*NamespaceMapperMapNamespaceRequest) ProtoMessage()    {}
+func (*NamespaceMapperMapNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{0}
+}
+
+func (m *NamespaceMapperMapNamespaceRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *NamespaceMapperMapNamespaceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *NamespaceMapperMapNamespaceRequest) GetBackup() []byte {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+type NamespaceMapperMapNamespaceResponse struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *NamespaceMapperMapNamespaceResponse) Reset()         { *m = NamespaceMapperMapNamespaceResponse{} }
+func (m *NamespaceMapperMapNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*NamespaceMapperMapNamespaceResponse) ProtoMessage()    {}
+func (*NamespaceMapperMapNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{1}
+}
+
+func (m *NamespaceMapperMapNamespaceResponse) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NamespaceMapperMapNamespaceRequest)(nil), "generated.NamespaceMapperMapNamespaceRequest")
+	proto.RegisterType((*NamespaceMapperMapNamespaceResponse)(nil), "generated.NamespaceMapperMapNamespaceResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for NamespaceMapper service
+
+type NamespaceMapperClient interface {
+	MapNamespace(ctx context.Context, in *NamespaceMapperMapNamespaceRequest, opts ...grpc.CallOption) (*NamespaceMapperMapNamespaceResponse, error)
+}
+
+type namespaceMapperClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNamespaceMapperClient(cc *grpc.ClientConn) NamespaceMapperClient {
+	return &namespaceMapperClient{cc}
+}
+
+func (c *namespaceMapperClient) MapNamespace(ctx context.Context, in *NamespaceMapperMapNamespaceRequest, opts ...grpc.CallOption) (*NamespaceMapperMapNamespaceResponse, error) {
+	out := new(NamespaceMapperMapNamespaceResponse)
+	err := grpc.Invoke(ctx, "/generated.NamespaceMapper/MapNamespace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for NamespaceMapper service
+
+type NamespaceMapperServer interface {
+	MapNamespace(context.Context, *NamespaceMapperMapNamespaceRequest) (*NamespaceMapperMapNamespaceResponse, error)
+}
+
+func RegisterNamespaceMapperServer(s *grpc.Server, srv NamespaceMapperServer) {
+	s.RegisterService(&_NamespaceMapper_serviceDesc, srv)
+}
+
+func _NamespaceMapper_MapNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceMapperMapNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceMapperServer).MapNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.NamespaceMapper/MapNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceMapperServer).MapNamespace(ctx, req.(*NamespaceMapperMapNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NamespaceMapper_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.NamespaceMapper",
+	HandlerType: (*NamespaceMapperServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MapNamespace",
+			Handler:    _NamespaceMapper_MapNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "NamespaceMapper.proto",
+}
+
+func init() { proto.RegisterFile("NamespaceMapper.proto", fileDescriptor6) }
+
+var fileDescriptor6 = []byte{
+	// 176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xf5, 0x4b, 0xcc, 0x4d,
+	0x2d, 0x2e, 0x48, 0x4c, 0x4e, 0xf5, 0x4d, 0x2c, 0x28, 0x48, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0xe2, 0x4c, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d, 0x51, 0x2a, 0xe2, 0x52,
+	0x42, 0x53, 0xe3, 0x9b, 0x58, 0x00, 0x17, 0x09, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x12,
+	0xe3, 0x62, 0x2b, 0xc8, 0x29, 0x4d, 0xcf, 0xcc, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82,
+	0xf2, 0x84, 0x64, 0xb8, 0x38, 0xf3, 0x60, 0x6a, 0x25, 0x98, 0xc0, 0x52, 0x08, 0x01, 0x90, 0xae,
+	0xa4, 0xc4, 0xe4, 0xec, 0xd2, 0x02, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x9e, 0x20, 0x28, 0x4f, 0xc9,
+	0x99, 0x4b, 0x19, 0xaf, 0x9d, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0xa8, 0x86, 0x33, 0xa2, 0x19,
+	0x6e, 0xd4, 0xc0, 0xc8, 0xc5, 0x8f, 0x66, 0x8a, 0x50, 0x2e, 0x17, 0x0f, 0xb2, 0x49, 0x42, 0xba,
+	0x7a, 0x70, 0x8f, 0xea, 0x11, 0xf6, 0xa5, 0x94, 0x1e, 0xb1, 0xca, 0x21, 0x0e, 0x4c, 0x62, 0x03,
+	0x87, 0xa6, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x4f, 0x4d, 0x8e, 0x66, 0x01, 0x00, 0x00,
+}
@@ -27,7 +27,7 @@ type PutObjectRequest struct {
 func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
 func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
 
 func (m *PutObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -66,7 +66,7 @@ type ObjectExistsRequest struct {
 func (m *ObjectExistsRequest) Reset()                    { *m = ObjectExistsRequest{} }
 func (m *ObjectExistsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsRequest) ProtoMessage()               {}
-func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
+func (*ObjectExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
 
 func (m *ObjectExistsRequest) GetPlugin() string {
 	if m != nil {
@@ -96,7 +96,7 @@ type ObjectExistsResponse struct {
 func (m *ObjectExistsResponse) Reset()                    { *m = ObjectExistsResponse{} }
 func (m *ObjectExistsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ObjectExistsResponse) ProtoMessage()               {}
-func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
+func (*ObjectExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
 
 func (m *ObjectExistsResponse) GetExists() bool {
 	if m != nil {
@@ -114,7 +114,7 @@ type GetObjectRequest struct {
 func (m *GetObjectRequest) Reset()                    { *m = GetObjectRequest{} }
 func (m *GetObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObjectRequest) ProtoMessage()               {}
-func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{3} }
+func (*GetObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
 
 func (m *GetObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -144,7 +144,7 @@ type Bytes struct {
 func (m *Bytes) Reset()                    { *m = Bytes{} }
 func (m *Bytes) String() string            { return proto.CompactTextString(m) }
 func (*Bytes) ProtoMessage()               {}
-func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{4} }
+func (*Bytes) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
 
 func (m *Bytes) GetData() []byte {
 	if m != nil {
@@ -163,7 +163,7 @@ type ListCommonPrefixesRequest struct {
 func (m *ListCommonPrefixesRequest) Reset()                    { *m = ListCommonPrefixesRequest{} }
 func (m *ListCommonPrefixesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesRequest) ProtoMessage()               {}
-func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{5} }
+func (*ListCommonPrefixesRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
 
 func (m *ListCommonPrefixesRequest) GetPlugin() string {
 	if m != nil {
@@ -200,7 +200,7 @@ type ListCommonPrefixesResponse struct {
 func (m *ListCommonPrefixesResponse) Reset()                    { *m = ListCommonPrefixesResponse{} }
 func (m *ListCommonPrefixesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListCommonPrefixesResponse) ProtoMessage()               {}
-func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{6} }
+func (*ListCommonPrefixesResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
 
 func (m *ListCommonPrefixesResponse) GetPrefixes() []string {
 	if m != nil {
@@ -218,7 +218,7 @@ type ListObjectsRequest struct {
 func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
 func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{7} }
 
 func (m *ListObjectsRequest) GetPlugin() string {
 	if m != nil {
@@ -248,7 +248,7 @@ type ListObjectsResponse struct {
 func (m *ListObjectsResponse) Reset()                    { *m = ListObjectsResponse{} }
 func (m *ListObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsResponse) ProtoMessage()               {}
-func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{8} }
 
 func (m *ListObjectsResponse) GetKeys() []string {
 	if m != nil {
@@ -266,7 +266,7 @@ type DeleteObjectRequest struct {
 func (m *DeleteObjectRequest) Reset()                    { *m = DeleteObjectRequest{} }
 func (m *DeleteObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectRequest) ProtoMessage()               {}
-func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{9} }
 
 func (m *DeleteObjectRequest) GetPlugin() string {
 	if m != nil {
@@ -299,7 +299,7 @@ type CreateSignedURLRequest struct {
 func (m *CreateSignedURLRequest) Reset()                    { *m = CreateSignedURLRequest{} }
 func (m *CreateSignedURLRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLRequest) ProtoMessage()               {}
-func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{10} }
+func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{10} }
 
 func (m *CreateSignedURLRequest) GetPlugin() string {
 	if m != nil {
@@ -336,7 +336,7 @@ type CreateSignedURLResponse struct {
 func (m *CreateSignedURLResponse) Reset()                    { *m = CreateSignedURLResponse{} }
 func (m *CreateSignedURLResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSignedURLResponse) ProtoMessage()               {}
-func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{11} }
+func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{11} }
 
 func (m *CreateSignedURLResponse) GetUrl() string {
 	if m != nil {
@@ -345,6 +345,118 @@ func (m *CreateSignedURLResponse) GetUrl() string {
 	return ""
 }
 
+type GetObjectMetadataRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+	Key    string `protobuf:"bytes,3,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *GetObjectMetadataRequest) Reset()                    { *m = GetObjectMetadataRequest{} }
+func (m *GetObjectMetadataRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetObjectMetadataRequest) ProtoMessage()               {}
+func (*GetObjectMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{12} }
+
+func (m *GetObjectMetadataRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *GetObjectMetadataRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *GetObjectMetadataRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetObjectMetadataResponse struct {
+	Etag         string `protobuf:"bytes,1,opt,name=etag" json:"etag,omitempty"`
+	LastModified int64  `protobuf:"varint,2,opt,name=lastModified" json:"lastModified,omitempty"`
+	Size         int64  `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *GetObjectMetadataResponse) Reset()                    { *m = GetObjectMetadataResponse{} }
+func (m *GetObjectMetadataResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetObjectMetadataResponse) ProtoMessage()               {}
+func (*GetObjectMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{13} }
+
+func (m *GetObjectMetadataResponse) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *GetObjectMetadataResponse) GetLastModified() int64 {
+	if m != nil {
+		return m.LastModified
+	}
+	return 0
+}
+
+func (m *GetObjectMetadataResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type ListObjectSizesRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket" json:"bucket,omitempty"`
+	Prefix string `protobuf:"bytes,3,opt,name=prefix" json:"prefix,omitempty"`
+}
+
+func (m *ListObjectSizesRequest) Reset()                    { *m = ListObjectSizesRequest{} }
+func (m *ListObjectSizesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListObjectSizesRequest) ProtoMessage()               {}
+func (*ListObjectSizesRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{14} }
+
+func (m *ListObjectSizesRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *ListObjectSizesRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *ListObjectSizesRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type ListObjectSizesResponse struct {
+	Sizes map[string]int64 `protobuf:"bytes,1,rep,name=sizes" json:"sizes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *ListObjectSizesResponse) Reset()                    { *m = ListObjectSizesResponse{} }
+func (m *ListObjectSizesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListObjectSizesResponse) ProtoMessage()               {}
+func (*ListObjectSizesResponse) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{15} }
+
+func (m *ListObjectSizesResponse) GetSizes() map[string]int64 {
+	if m != nil {
+		return m.Sizes
+	}
+	return nil
+}
+
 type ObjectStoreInitRequest struct {
 	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -353,7 +465,7 @@ type ObjectStoreInitRequest struct {
 func (m *ObjectStoreInitRequest) Reset()                    { *m = ObjectStoreInitRequest{} }
 func (m *ObjectStoreInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*ObjectStoreInitRequest) ProtoMessage()               {}
-func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{12} }
+func (*ObjectStoreInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{16} }
 
 func (m *ObjectStoreInitRequest) GetPlugin() string {
 	if m != nil {
@@ -382,6 +494,10 @@ func init() {
 	proto.RegisterType((*DeleteObjectRequest)(nil), "generated.DeleteObjectRequest")
 	proto.RegisterType((*CreateSignedURLRequest)(nil), "generated.CreateSignedURLRequest")
 	proto.RegisterType((*CreateSignedURLResponse)(nil), "generated.CreateSignedURLResponse")
+	proto.RegisterType((*GetObjectMetadataRequest)(nil), "generated.GetObjectMetadataRequest")
+	proto.RegisterType((*GetObjectMetadataResponse)(nil), "generated.GetObjectMetadataResponse")
+	proto.RegisterType((*ListObjectSizesRequest)(nil), "generated.ListObjectSizesRequest")
+	proto.RegisterType((*ListObjectSizesResponse)(nil), "generated.ListObjectSizesResponse")
 	proto.RegisterType((*ObjectStoreInitRequest)(nil), "generated.ObjectStoreInitRequest")
 }
 
@@ -404,6 +520,8 @@ type ObjectStoreClient interface {
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	GetObjectMetadata(ctx context.Context, in *GetObjectMetadataRequest, opts ...grpc.CallOption) (*GetObjectMetadataResponse, error)
+	ListObjectSizes(ctx context.Context, in *ListObjectSizesRequest, opts ...grpc.CallOption) (*ListObjectSizesResponse, error)
 }
 
 type objectStoreClient struct {
@@ -534,6 +652,24 @@ func (c *objectStoreClient) CreateSignedURL(ctx context.Context, in *CreateSigne
 	return out, nil
 }
 
+func (c *objectStoreClient) GetObjectMetadata(ctx context.Context, in *GetObjectMetadataRequest, opts ...grpc.CallOption) (*GetObjectMetadataResponse, error) {
+	out := new(GetObjectMetadataResponse)
+	err := grpc.Invoke(ctx, "/generated.ObjectStore/GetObjectMetadata", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStoreClient) ListObjectSizes(ctx context.Context, in *ListObjectSizesRequest, opts ...grpc.CallOption) (*ListObjectSizesResponse, error) {
+	out := new(ListObjectSizesResponse)
+	err := grpc.Invoke(ctx, "/generated.ObjectStore/ListObjectSizes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ObjectStore service
 
 type ObjectStoreServer interface {
@@ -545,6 +681,8 @@ type ObjectStoreServer interface {
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
 	DeleteObject(context.Context, *DeleteObjectRequest) (*Empty, error)
 	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	GetObjectMetadata(context.Context, *GetObjectMetadataRequest) (*GetObjectMetadataResponse, error)
+	ListObjectSizes(context.Context, *ListObjectSizesRequest) (*ListObjectSizesResponse, error)
 }
 
 func RegisterObjectStoreServer(s *grpc.Server, srv ObjectStoreServer) {
@@ -706,6 +844,42 @@ func _ObjectStore_CreateSignedURL_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ObjectStore_GetObjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).GetObjectMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ObjectStore/GetObjectMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).GetObjectMetadata(ctx, req.(*GetObjectMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStore_ListObjectSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectSizesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStoreServer).ListObjectSizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.ObjectStore/ListObjectSizes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStoreServer).ListObjectSizes(ctx, req.(*ListObjectSizesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.ObjectStore",
 	HandlerType: (*ObjectStoreServer)(nil),
@@ -734,6 +908,14 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSignedURL",
 			Handler:    _ObjectStore_CreateSignedURL_Handler,
 		},
+		{
+			MethodName: "GetObjectMetadata",
+			Handler:    _ObjectStore_GetObjectMetadata_Handler,
+		},
+		{
+			MethodName: "ListObjectSizes",
+			Handler:    _ObjectStore_ListObjectSizes_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -750,45 +932,53 @@ var _ObjectStore_serviceDesc = grpc.ServiceDesc{
 	Metadata: "ObjectStore.proto",
 }
 
-func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor2) }
-
-var fileDescriptor2 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xc6, 0x69, 0x54, 0x4f, 0x22, 0x61, 0xb6, 0x55, 0x30, 0x2e, 0x94, 0xb0, 0x02, 0x29,
-	0x08, 0x11, 0xa1, 0x72, 0x29, 0xd0, 0x03, 0x22, 0x44, 0x08, 0x29, 0x52, 0x2b, 0x07, 0x04, 0x07,
-	0x2e, 0x4e, 0x3c, 0x4d, 0x4d, 0x1c, 0x3b, 0xd8, 0x6b, 0x54, 0x1f, 0x79, 0x25, 0x9e, 0x89, 0x07,
-	0x41, 0xbb, 0xde, 0x26, 0xde, 0xfc, 0x10, 0xa9, 0xca, 0x6d, 0x66, 0x76, 0xe7, 0x9b, 0x6f, 0x66,
-	0xfd, 0x8d, 0xe1, 0xee, 0xf9, 0xf0, 0x07, 0x8e, 0xf8, 0x80, 0xc7, 0x09, 0x76, 0x66, 0x49, 0xcc,
-	0x63, 0x6a, 0x8e, 0x31, 0xc2, 0xc4, 0xe3, 0xe8, 0x3b, 0x8d, 0xc1, 0x95, 0x97, 0xa0, 0x5f, 0x1c,
-	0xb0, 0x2b, 0xb0, 0x2e, 0x32, 0x5e, 0x24, 0xb8, 0xf8, 0x33, 0xc3, 0x94, 0xd3, 0x26, 0xd4, 0x66,
-	0x61, 0x36, 0x0e, 0x22, 0x9b, 0xb4, 0x48, 0xdb, 0x74, 0x95, 0x27, 0xe2, 0xc3, 0x6c, 0x34, 0x41,
-	0x6e, 0x57, 0x8a, 0x78, 0xe1, 0x51, 0x0b, 0x8c, 0x09, 0xe6, 0xb6, 0x21, 0x83, 0xc2, 0xa4, 0x14,
-	0xaa, 0xc3, 0xd8, 0xcf, 0xed, 0x6a, 0x8b, 0xb4, 0x1b, 0xae, 0xb4, 0xd9, 0x57, 0x38, 0x28, 0xca,
-	0xf4, 0xae, 0x83, 0x94, 0xa7, 0x3b, 0x2b, 0xc6, 0x3a, 0x70, 0xa8, 0x03, 0xa7, 0xb3, 0x38, 0x4a,
-	0x51, 0x20, 0xa0, 0x8c, 0x48, 0xe4, 0x7d, 0x57, 0x79, 0xec, 0x33, 0x58, 0x1f, 0x71, 0xd7, 0x2d,
-	0xb3, 0x23, 0xd8, 0x7b, 0x9f, 0x73, 0x4c, 0x45, 0xef, 0xbe, 0xc7, 0x3d, 0x09, 0xd4, 0x70, 0xa5,
-	0xcd, 0x7e, 0x13, 0xb8, 0xdf, 0x0f, 0x52, 0xde, 0x8d, 0xa7, 0xd3, 0x38, 0xba, 0x48, 0xf0, 0x32,
-	0xb8, 0xc6, 0x5b, 0x8f, 0xe0, 0x01, 0x98, 0x3e, 0x86, 0xc1, 0x34, 0xe0, 0x98, 0x28, 0x0a, 0x8b,
-	0x80, 0x44, 0x93, 0x05, 0xe4, 0xf4, 0x05, 0x9a, 0xf4, 0xd8, 0x29, 0x38, 0xeb, 0x28, 0xa8, 0x61,
-	0x39, 0xb0, 0x3f, 0x53, 0x31, 0x9b, 0xb4, 0x8c, 0xb6, 0xe9, 0xce, 0x7d, 0xf6, 0x1d, 0xa8, 0xc8,
-	0x2c, 0x26, 0x76, 0x6b, 0xd6, 0x0b, 0x5e, 0x86, 0xc6, 0xeb, 0x19, 0x1c, 0x68, 0xe8, 0x8a, 0x10,
-	0x85, 0xea, 0x04, 0xf3, 0x1b, 0x32, 0xd2, 0x16, 0x9f, 0xd0, 0x07, 0x0c, 0x91, 0xe3, 0xae, 0x1f,
-	0x2f, 0x84, 0x66, 0x37, 0x41, 0x8f, 0xe3, 0x20, 0x18, 0x47, 0xe8, 0x7f, 0x71, 0xfb, 0xbb, 0xd3,
-	0x82, 0x05, 0x06, 0xe7, 0xa1, 0x7c, 0x0c, 0xc3, 0x15, 0x26, 0x7b, 0x0e, 0xf7, 0x56, 0xaa, 0xa9,
-	0xae, 0x2d, 0x30, 0xb2, 0x24, 0x54, 0xb5, 0x84, 0xc9, 0xfe, 0x10, 0x68, 0x96, 0xf4, 0xfc, 0x29,
-	0x0a, 0xb6, 0xf6, 0xdd, 0x83, 0xda, 0x28, 0x8e, 0x2e, 0x83, 0xb1, 0x5d, 0x69, 0x19, 0xed, 0xfa,
-	0xc9, 0x8b, 0xce, 0x5c, 0xfd, 0x9d, 0xf5, 0x50, 0x9d, 0xae, 0xbc, 0xdf, 0x8b, 0x78, 0x92, 0xbb,
-	0x2a, 0xd9, 0x79, 0x0d, 0xf5, 0x52, 0xf8, 0xa6, 0x33, 0xb2, 0xe8, 0xec, 0x10, 0xf6, 0x7e, 0x79,
-	0x61, 0x86, 0x6a, 0x04, 0x85, 0xf3, 0xa6, 0x72, 0x4a, 0x4e, 0xfe, 0x56, 0xa1, 0x5e, 0xaa, 0x44,
-	0xdf, 0x42, 0x55, 0x54, 0xa3, 0x8f, 0xb7, 0x32, 0x71, 0xac, 0xd2, 0x95, 0xde, 0x74, 0xc6, 0x73,
-	0x7a, 0x06, 0xe6, 0x7c, 0x45, 0xd1, 0xa3, 0xd2, 0xf1, 0xf2, 0xe2, 0x5a, 0xcd, 0x6d, 0x13, 0x7a,
-	0x0e, 0x8d, 0xf2, 0x76, 0xa0, 0xc7, 0x2b, 0x14, 0xb4, 0x7d, 0xe4, 0x3c, 0xda, 0x78, 0xae, 0x9e,
-	0xe8, 0x0c, 0xcc, 0xf9, 0xfa, 0xd0, 0xe8, 0x2c, 0x2f, 0x15, 0x8d, 0x8e, 0xdc, 0x0d, 0x2f, 0x09,
-	0xf5, 0x0a, 0x2d, 0xe9, 0x2a, 0xa4, 0x4f, 0x4a, 0x37, 0x37, 0xee, 0x09, 0xe7, 0xe9, 0x96, 0x5b,
-	0x8a, 0x60, 0x1f, 0xea, 0x25, 0x41, 0xd1, 0x87, 0x4b, 0x59, 0xba, 0x8c, 0x9d, 0xe3, 0x4d, 0xc7,
-	0x0a, 0xed, 0x1d, 0x34, 0xca, 0x9a, 0xd3, 0xe6, 0xb7, 0x46, 0x8c, 0x6b, 0xde, 0xef, 0x1b, 0xdc,
-	0x59, 0xfa, 0xdc, 0xb5, 0xef, 0x60, 0xbd, 0xf0, 0x1c, 0xf6, 0xbf, 0x2b, 0x05, 0xb7, 0x61, 0x4d,
-	0xfe, 0xc3, 0x5e, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x4e, 0x76, 0xa3, 0xf1, 0x06, 0x00,
-	0x00,
+func init() { proto.RegisterFile("ObjectStore.proto", fileDescriptor7) }
+
+var fileDescriptor7 = []byte{
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x10, 0x96, 0x71, 0x88, 0xf0, 0x24, 0x12, 0x61, 0x41, 0xc1, 0x98, 0xff, 0xa7, 0x74, 0x4b, 0xa5,
+	0x54, 0x55, 0xa3, 0x8a, 0x5e, 0x68, 0xcb, 0xa1, 0x6a, 0x1a, 0xa1, 0x4a, 0x20, 0x90, 0xd3, 0xaa,
+	0x1c, 0x50, 0x55, 0x07, 0x0f, 0x61, 0x8b, 0x63, 0xa7, 0xf6, 0xa6, 0x22, 0xdc, 0xfa, 0x16, 0x3d,
+	0xf5, 0x21, 0xfa, 0x84, 0xd5, 0xae, 0x97, 0xc4, 0x8e, 0x9d, 0x44, 0x42, 0xbe, 0xcd, 0xcc, 0xee,
+	0x7c, 0xf3, 0xed, 0xcc, 0xe4, 0x73, 0x60, 0xed, 0xb4, 0xfb, 0x1d, 0x2f, 0x79, 0x87, 0x07, 0x21,
+	0x36, 0x07, 0x61, 0xc0, 0x03, 0x62, 0xf4, 0xd0, 0xc7, 0xd0, 0xe1, 0xe8, 0x5a, 0xd5, 0xce, 0xb5,
+	0x13, 0xa2, 0x1b, 0x1f, 0xd0, 0x6b, 0xa8, 0x9d, 0x0d, 0x79, 0x9c, 0x60, 0xe3, 0x8f, 0x21, 0x46,
+	0x9c, 0xd4, 0xa1, 0x3c, 0xf0, 0x86, 0x3d, 0xe6, 0x9b, 0xda, 0xae, 0xd6, 0x30, 0x6c, 0xe5, 0x89,
+	0x78, 0x77, 0x78, 0x79, 0x83, 0xdc, 0x5c, 0x8a, 0xe3, 0xb1, 0x47, 0x6a, 0xa0, 0xdf, 0xe0, 0xc8,
+	0xd4, 0x65, 0x50, 0x98, 0x84, 0x40, 0xa9, 0x1b, 0xb8, 0x23, 0xb3, 0xb4, 0xab, 0x35, 0xaa, 0xb6,
+	0xb4, 0xe9, 0x17, 0x58, 0x8f, 0xcb, 0xb4, 0x6f, 0x59, 0xc4, 0xa3, 0xc2, 0x8a, 0xd1, 0x26, 0x6c,
+	0xa4, 0x81, 0xa3, 0x41, 0xe0, 0x47, 0x28, 0x10, 0x50, 0x46, 0x24, 0xf2, 0x8a, 0xad, 0x3c, 0xfa,
+	0x09, 0x6a, 0x47, 0x58, 0xf4, 0x93, 0xe9, 0x36, 0x2c, 0xbf, 0x1f, 0x71, 0x8c, 0xc4, 0xdb, 0x5d,
+	0x87, 0x3b, 0x12, 0xa8, 0x6a, 0x4b, 0x9b, 0xfe, 0xd2, 0x60, 0xeb, 0x98, 0x45, 0xbc, 0x15, 0xf4,
+	0xfb, 0x81, 0x7f, 0x16, 0xe2, 0x15, 0xbb, 0xc5, 0x07, 0xb7, 0xe0, 0x3f, 0x30, 0x5c, 0xf4, 0x58,
+	0x9f, 0x71, 0x0c, 0x15, 0x85, 0x49, 0x40, 0xa2, 0xc9, 0x02, 0x66, 0x49, 0xa1, 0x49, 0x8f, 0x1e,
+	0x80, 0x95, 0x47, 0x41, 0x35, 0xcb, 0x82, 0x95, 0x81, 0x8a, 0x99, 0xda, 0xae, 0xde, 0x30, 0xec,
+	0xb1, 0x4f, 0x2f, 0x80, 0x88, 0xcc, 0xb8, 0x63, 0x0f, 0x66, 0x3d, 0xe1, 0xa5, 0xa7, 0x78, 0x3d,
+	0x83, 0xf5, 0x14, 0xba, 0x22, 0x44, 0xa0, 0x74, 0x83, 0xa3, 0x7b, 0x32, 0xd2, 0x16, 0x2b, 0xf4,
+	0x01, 0x3d, 0xe4, 0x58, 0xf4, 0xf0, 0x3c, 0xa8, 0xb7, 0x42, 0x74, 0x38, 0x76, 0x58, 0xcf, 0x47,
+	0xf7, 0xb3, 0x7d, 0x5c, 0xdc, 0x6f, 0xa1, 0x06, 0x3a, 0xe7, 0x9e, 0x1c, 0x86, 0x6e, 0x0b, 0x93,
+	0x3e, 0x87, 0xcd, 0x4c, 0x35, 0xf5, 0xea, 0x1a, 0xe8, 0xc3, 0xd0, 0x53, 0xb5, 0x84, 0x49, 0x2f,
+	0xc0, 0x1c, 0x6f, 0xeb, 0x09, 0x72, 0x47, 0xec, 0x53, 0x71, 0x0f, 0xef, 0xc1, 0x56, 0x0e, 0xfa,
+	0x64, 0x04, 0xc8, 0x9d, 0x9e, 0x02, 0x97, 0x36, 0xa1, 0x50, 0xf5, 0x9c, 0x88, 0x9f, 0x04, 0x2e,
+	0xbb, 0x62, 0xe8, 0xca, 0x02, 0xba, 0x9d, 0x8a, 0x89, 0xbc, 0x88, 0xdd, 0xa1, 0xac, 0xa3, 0xdb,
+	0xd2, 0xa6, 0xdf, 0xa0, 0x3e, 0x99, 0x72, 0x87, 0xdd, 0x61, 0xe1, 0x7b, 0xf4, 0x5b, 0x83, 0xcd,
+	0x4c, 0x09, 0xf5, 0x92, 0x16, 0x2c, 0x0b, 0x16, 0xf1, 0x36, 0x55, 0xf6, 0x5f, 0x34, 0xc7, 0x72,
+	0xd8, 0x9c, 0x91, 0xd2, 0x94, 0x5e, 0xdb, 0xe7, 0xe1, 0xc8, 0x8e, 0x73, 0xad, 0x03, 0x80, 0x49,
+	0xf0, 0xbe, 0x97, 0xda, 0x64, 0xd0, 0x1b, 0xb0, 0xfc, 0xd3, 0xf1, 0x86, 0xa8, 0x7a, 0x12, 0x3b,
+	0x6f, 0x96, 0x0e, 0x34, 0xfa, 0x57, 0x83, 0x7a, 0x42, 0x93, 0x3f, 0xfa, 0x6c, 0xe1, 0xee, 0xb6,
+	0xa1, 0x7c, 0x19, 0xf8, 0x57, 0xac, 0x67, 0x2e, 0x65, 0x28, 0xe7, 0x43, 0x35, 0x5b, 0xf2, 0x7e,
+	0x4c, 0x59, 0x25, 0x5b, 0xaf, 0xa1, 0x92, 0x08, 0x2f, 0x22, 0x6d, 0x24, 0x48, 0xef, 0xff, 0x29,
+	0x43, 0x25, 0x51, 0x89, 0xbc, 0x85, 0x92, 0xa8, 0x46, 0x1e, 0x2f, 0x64, 0x62, 0xd5, 0x12, 0x57,
+	0xda, 0xfd, 0x01, 0x1f, 0x91, 0x43, 0x30, 0xc6, 0x9f, 0x19, 0xb2, 0x9d, 0x38, 0x9e, 0xfe, 0xf8,
+	0x64, 0x73, 0x1b, 0x1a, 0x39, 0x85, 0x6a, 0x52, 0xe1, 0xc9, 0x4e, 0x86, 0x42, 0xea, 0x9b, 0x62,
+	0x3d, 0x9a, 0x79, 0xae, 0xf6, 0xe1, 0x10, 0x8c, 0x23, 0xcc, 0xa3, 0x73, 0x84, 0x73, 0xe8, 0x48,
+	0x7d, 0x7f, 0xa9, 0x11, 0x07, 0x48, 0x56, 0x49, 0xc9, 0xde, 0xd4, 0x52, 0xe5, 0x6a, 0xbd, 0xf5,
+	0x74, 0xc1, 0x2d, 0x45, 0xf0, 0x18, 0x2a, 0x09, 0x51, 0x24, 0xff, 0xe7, 0x2e, 0xec, 0x18, 0x74,
+	0x67, 0xd6, 0xb1, 0x42, 0x7b, 0x07, 0xd5, 0xa4, 0x6e, 0xa6, 0xfa, 0x97, 0x23, 0xa8, 0x39, 0xf3,
+	0x3b, 0x87, 0xd5, 0x29, 0xc9, 0x4a, 0xed, 0x41, 0xbe, 0x78, 0x5a, 0x74, 0xde, 0x15, 0xc5, 0xed,
+	0x2b, 0xac, 0x65, 0x14, 0x88, 0x3c, 0xc9, 0x1b, 0xc9, 0x94, 0xfa, 0x59, 0x7b, 0xf3, 0x2f, 0x29,
+	0xfc, 0x73, 0x58, 0x9d, 0xfa, 0x89, 0xa7, 0x98, 0xe7, 0x8b, 0x92, 0x45, 0xe7, 0x5d, 0x89, 0x91,
+	0xbb, 0x65, 0xf9, 0x0f, 0xea, 0xd5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x7e, 0xb4, 0x0d,
+	0x6f, 0x09, 0x00, 0x00,
 }
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: RestoreValidator.proto
+
+package generated
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type RestoreValidatorAppliesToRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+}
+
+func (m *RestoreValidatorAppliesToRequest) Reset()         { *m = RestoreValidatorAppliesToRequest{} }
+func (m *RestoreValidatorAppliesToRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreValidatorAppliesToRequest) ProtoMessage()    {}
+func (*RestoreValidatorAppliesToRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{0}
+}
+
+func (m *RestoreValidatorAppliesToRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+type RestoreValidatorAppliesToResponse struct {
+	ResourceSelector *ResourceSelector `protobuf:"bytes,1,opt,name=ResourceSelector" json:"ResourceSelector,omitempty"`
+}
+
+func (m *RestoreValidatorAppliesToResponse) Reset()         { *m = RestoreValidatorAppliesToResponse{} }
+func (m *RestoreValidatorAppliesToResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreValidatorAppliesToResponse) ProtoMessage()    {}
+func (*RestoreValidatorAppliesToResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{1}
+}
+
+func (m *RestoreValidatorAppliesToResponse) GetResourceSelector() *ResourceSelector {
+	if m != nil {
+		return m.ResourceSelector
+	}
+	return nil
+}
+
+type RestoreValidatorValidateRequest struct {
+	Plugin  string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+	Item    []byte `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Restore []byte `protobuf:"bytes,3,opt,name=restore,proto3" json:"restore,omitempty"`
+}
+
+func (m *RestoreValidatorValidateRequest) Reset()         { *m = RestoreValidatorValidateRequest{} }
+func (m *RestoreValidatorValidateRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreValidatorValidateRequest) ProtoMessage()    {}
+func (*RestoreValidatorValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{2}
+}
+
+func (m *RestoreValidatorValidateRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *RestoreValidatorValidateRequest) GetItem() []byte {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *RestoreValidatorValidateRequest) GetRestore() []byte {
+	if m != nil {
+		return m.Restore
+	}
+	return nil
+}
+
+type RestoreValidatorValidateResponse struct {
+	Passed   bool   `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Severity string `protobuf:"bytes,3,opt,name=severity" json:"severity,omitempty"`
+}
+
+func (m *RestoreValidatorValidateResponse) Reset()         { *m = RestoreValidatorValidateResponse{} }
+func (m *RestoreValidatorValidateResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreValidatorValidateResponse) ProtoMessage()    {}
+func (*RestoreValidatorValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor10, []int{3}
+}
+
+func (m *RestoreValidatorValidateResponse) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *RestoreValidatorValidateResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RestoreValidatorValidateResponse) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RestoreValidatorAppliesToRequest)(nil), "generated.RestoreValidatorAppliesToRequest")
+	proto.RegisterType((*RestoreValidatorAppliesToResponse)(nil), "generated.RestoreValidatorAppliesToResponse")
+	proto.RegisterType((*RestoreValidatorValidateRequest)(nil), "generated.RestoreValidatorValidateRequest")
+	proto.RegisterType((*RestoreValidatorValidateResponse)(nil), "generated.RestoreValidatorValidateResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for RestoreValidator service
+
+type RestoreValidatorClient interface {
+	AppliesTo(ctx context.Context, in *RestoreValidatorAppliesToRequest, opts ...grpc.CallOption) (*RestoreValidatorAppliesToResponse, error)
+	Validate(ctx context.Context, in *RestoreValidatorValidateRequest, opts ...grpc.CallOption) (*RestoreValidatorValidateResponse, error)
+}
+
+type restoreValidatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRestoreValidatorClient(cc *grpc.ClientConn) RestoreValidatorClient {
+	return &restoreValidatorClient{cc}
+}
+
+func (c *restoreValidatorClient) AppliesTo(ctx context.Context, in *RestoreValidatorAppliesToRequest, opts ...grpc.CallOption) (*RestoreValidatorAppliesToResponse, error) {
+	out := new(RestoreValidatorAppliesToResponse)
+	err := grpc.Invoke(ctx, "/generated.RestoreValidator/AppliesTo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restoreValidatorClient) Validate(ctx context.Context, in *RestoreValidatorValidateRequest, opts ...grpc.CallOption) (*RestoreValidatorValidateResponse, error) {
+	out := new(RestoreValidatorValidateResponse)
+	err := grpc.Invoke(ctx, "/generated.RestoreValidator/Validate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RestoreValidator service
+
+type RestoreValidatorServer interface {
+	AppliesTo(context.Context, *RestoreValidatorAppliesToRequest) (*RestoreValidatorAppliesToResponse, error)
+	Validate(context.Context, *RestoreValidatorValidateRequest) (*RestoreValidatorValidateResponse, error)
+}
+
+func RegisterRestoreValidatorServer(s *grpc.Server, srv RestoreValidatorServer) {
+	s.RegisterService(&_RestoreValidator_serviceDesc, srv)
+}
+
+func _RestoreValidator_AppliesTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreValidatorAppliesToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreValidatorServer).AppliesTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreValidator/AppliesTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreValidatorServer).AppliesTo(ctx, req.(*RestoreValidatorAppliesToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestoreValidator_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreValidatorValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestoreValidatorServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.RestoreValidator/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestoreValidatorServer).Validate(ctx, req.(*RestoreValidatorValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RestoreValidator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "generated.RestoreValidator",
+	HandlerType: (*RestoreValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AppliesTo",
+			Handler:    _RestoreValidator_AppliesTo_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _RestoreValidator_Validate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "RestoreValidator.proto",
+}
+
+func init() { proto.RegisterFile("RestoreValidator.proto", fileDescriptor10) }
+
+var fileDescriptor10 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4f, 0xc3, 0x30,
+	0x10, 0x85, 0x15, 0x40, 0x25, 0x39, 0x3a, 0x54, 0x1e, 0xaa, 0x28, 0x0c, 0x84, 0x4c, 0x15, 0x45,
+	0x19, 0xca, 0xc6, 0xc6, 0xc4, 0xee, 0x22, 0x76, 0x93, 0x3c, 0x42, 0xa4, 0x34, 0x36, 0xb6, 0x83,
+	0xc4, 0xaf, 0xe5, 0xaf, 0xa0, 0x3a, 0x6e, 0x24, 0x82, 0x08, 0xdd, 0xee, 0xe5, 0xee, 0xdd, 0x77,
+	0x77, 0x31, 0x2d, 0x39, 0x8c, 0x95, 0x1a, 0xcf, 0xa2, 0xa9, 0x4b, 0x61, 0xa5, 0xce, 0x95, 0x96,
+	0x56, 0xb2, 0xa8, 0x42, 0x0b, 0x2d, 0x2c, 0xca, 0x64, 0xbe, 0x7d, 0x13, 0x1a, 0x65, 0x9f, 0xc8,
+	0xee, 0x29, 0x1d, 0x5b, 0x1e, 0x94, 0x6a, 0x6a, 0x98, 0x27, 0xc9, 0xf1, 0xde, 0xc1, 0x58, 0xb6,
+	0xa4, 0x99, 0x6a, 0xba, 0xaa, 0x6e, 0xe3, 0x20, 0x0d, 0x56, 0x11, 0xf7, 0x2a, 0x6b, 0xe8, 0x7a,
+	0xc2, 0x6b, 0x94, 0x6c, 0x0d, 0xd8, 0x23, 0x2d, 0x38, 0x8c, 0xec, 0x74, 0x81, 0x2d, 0x1a, 0x14,
+	0x56, 0x6a, 0xd7, 0xe6, 0x62, 0x73, 0x99, 0x0f, 0x43, 0xe5, 0xe3, 0x12, 0xfe, 0xcb, 0x94, 0x55,
+	0x74, 0x35, 0xa6, 0xf9, 0x00, 0xff, 0x0c, 0xca, 0x18, 0x9d, 0xd5, 0x16, 0xbb, 0xf8, 0x24, 0x0d,
+	0x56, 0x73, 0xee, 0x62, 0x16, 0xd3, 0xb9, 0xee, 0xdb, 0xc5, 0xa7, 0xee, 0xf3, 0x41, 0x66, 0x8a,
+	0xd2, 0xbf, 0x41, 0x7e, 0xab, 0x3d, 0x49, 0x18, 0x83, 0xd2, 0x91, 0x42, 0xee, 0xd5, 0xbe, 0xeb,
+	0x0e, 0xc6, 0x88, 0x0a, 0x0e, 0x16, 0xf1, 0x83, 0x64, 0x09, 0x85, 0x06, 0x1f, 0xd0, 0xb5, 0xfd,
+	0x74, 0xc0, 0x88, 0x0f, 0x7a, 0xf3, 0x15, 0xd0, 0x62, 0x8c, 0x64, 0xaf, 0x14, 0x0d, 0xd7, 0x64,
+	0xeb, 0x9f, 0xb7, 0x9a, 0xfc, 0x5f, 0xc9, 0xed, 0x71, 0xc5, 0x7e, 0x95, 0x82, 0x42, 0x9f, 0x05,
+	0xbb, 0x99, 0x70, 0x8e, 0x8e, 0x9d, 0xac, 0x8f, 0xaa, 0xed, 0x21, 0x2f, 0x33, 0xf7, 0xda, 0xee,
+	0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x95, 0xe6, 0xc3, 0xa0, 0x02, 0x00, 0x00,
+}
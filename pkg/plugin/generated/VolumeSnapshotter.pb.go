@@ -28,7 +28,7 @@ type CreateVolumeRequest struct {
 func (m *CreateVolumeRequest) Reset()                    { *m = CreateVolumeRequest{} }
 func (m *CreateVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateVolumeRequest) ProtoMessage()               {}
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{0} }
 
 func (m *CreateVolumeRequest) GetPlugin() string {
 	if m != nil {
@@ -72,7 +72,7 @@ type CreateVolumeResponse struct {
 func (m *CreateVolumeResponse) Reset()                    { *m = CreateVolumeResponse{} }
 func (m *CreateVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateVolumeResponse) ProtoMessage()               {}
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{1} }
 
 func (m *CreateVolumeResponse) GetVolumeID() string {
 	if m != nil {
@@ -90,7 +90,7 @@ type GetVolumeInfoRequest struct {
 func (m *GetVolumeInfoRequest) Reset()                    { *m = GetVolumeInfoRequest{} }
 func (m *GetVolumeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeInfoRequest) ProtoMessage()               {}
-func (*GetVolumeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+func (*GetVolumeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{2} }
 
 func (m *GetVolumeInfoRequest) GetPlugin() string {
 	if m != nil {
@@ -121,7 +121,7 @@ type GetVolumeInfoResponse struct {
 func (m *GetVolumeInfoResponse) Reset()                    { *m = GetVolumeInfoResponse{} }
 func (m *GetVolumeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeInfoResponse) ProtoMessage()               {}
-func (*GetVolumeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+func (*GetVolumeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{3} }
 
 func (m *GetVolumeInfoResponse) GetVolumeType() string {
 	if m != nil {
@@ -147,7 +147,7 @@ type CreateSnapshotRequest struct {
 func (m *CreateSnapshotRequest) Reset()                    { *m = CreateSnapshotRequest{} }
 func (m *CreateSnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSnapshotRequest) ProtoMessage()               {}
-func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{4} }
 
 func (m *CreateSnapshotRequest) GetPlugin() string {
 	if m != nil {
@@ -184,7 +184,7 @@ type CreateSnapshotResponse struct {
 func (m *CreateSnapshotResponse) Reset()                    { *m = CreateSnapshotResponse{} }
 func (m *CreateSnapshotResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSnapshotResponse) ProtoMessage()               {}
-func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{5} }
 
 func (m *CreateSnapshotResponse) GetSnapshotID() string {
 	if m != nil {
@@ -201,7 +201,7 @@ type DeleteSnapshotRequest struct {
 func (m *DeleteSnapshotRequest) Reset()                    { *m = DeleteSnapshotRequest{} }
 func (m *DeleteSnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteSnapshotRequest) ProtoMessage()               {}
-func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
+func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{6} }
 
 func (m *DeleteSnapshotRequest) GetPlugin() string {
 	if m != nil {
@@ -225,7 +225,7 @@ type GetVolumeIDRequest struct {
 func (m *GetVolumeIDRequest) Reset()                    { *m = GetVolumeIDRequest{} }
 func (m *GetVolumeIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeIDRequest) ProtoMessage()               {}
-func (*GetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
+func (*GetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{7} }
 
 func (m *GetVolumeIDRequest) GetPlugin() string {
 	if m != nil {
@@ -248,7 +248,7 @@ type GetVolumeIDResponse struct {
 func (m *GetVolumeIDResponse) Reset()                    { *m = GetVolumeIDResponse{} }
 func (m *GetVolumeIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetVolumeIDResponse) ProtoMessage()               {}
-func (*GetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+func (*GetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{8} }
 
 func (m *GetVolumeIDResponse) GetVolumeID() string {
 	if m != nil {
@@ -266,7 +266,7 @@ type SetVolumeIDRequest struct {
 func (m *SetVolumeIDRequest) Reset()                    { *m = SetVolumeIDRequest{} }
 func (m *SetVolumeIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetVolumeIDRequest) ProtoMessage()               {}
-func (*SetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
+func (*SetVolumeIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{9} }
 
 func (m *SetVolumeIDRequest) GetPlugin() string {
 	if m != nil {
@@ -296,7 +296,7 @@ type SetVolumeIDResponse struct {
 func (m *SetVolumeIDResponse) Reset()                    { *m = SetVolumeIDResponse{} }
 func (m *SetVolumeIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetVolumeIDResponse) ProtoMessage()               {}
-func (*SetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*SetVolumeIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{10} }
 
 func (m *SetVolumeIDResponse) GetPersistentVolume() []byte {
 	if m != nil {
@@ -305,6 +305,22 @@ func (m *SetVolumeIDResponse) GetPersistentVolume() []byte {
 	return nil
 }
 
+type ValidateLocationRequest struct {
+	Plugin string `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
+}
+
+func (m *ValidateLocationRequest) Reset()                    { *m = ValidateLocationRequest{} }
+func (m *ValidateLocationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ValidateLocationRequest) ProtoMessage()               {}
+func (*ValidateLocationRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{11} }
+
+func (m *ValidateLocationRequest) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
 type VolumeSnapshotterInitRequest struct {
 	Plugin string            `protobuf:"bytes,1,opt,name=plugin" json:"plugin,omitempty"`
 	Config map[string]string `protobuf:"bytes,2,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -313,7 +329,7 @@ type VolumeSnapshotterInitRequest struct {
 func (m *VolumeSnapshotterInitRequest) Reset()                    { *m = VolumeSnapshotterInitRequest{} }
 func (m *VolumeSnapshotterInitRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeSnapshotterInitRequest) ProtoMessage()               {}
-func (*VolumeSnapshotterInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*VolumeSnapshotterInitRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{12} }
 
 func (m *VolumeSnapshotterInitRequest) GetPlugin() string {
 	if m != nil {
@@ -341,6 +357,7 @@ func init() {
 	proto.RegisterType((*GetVolumeIDResponse)(nil), "generated.GetVolumeIDResponse")
 	proto.RegisterType((*SetVolumeIDRequest)(nil), "generated.SetVolumeIDRequest")
 	proto.RegisterType((*SetVolumeIDResponse)(nil), "generated.SetVolumeIDResponse")
+	proto.RegisterType((*ValidateLocationRequest)(nil), "generated.ValidateLocationRequest")
 	proto.RegisterType((*VolumeSnapshotterInitRequest)(nil), "generated.VolumeSnapshotterInitRequest")
 }
 
@@ -362,6 +379,7 @@ type VolumeSnapshotterClient interface {
 	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetVolumeID(ctx context.Context, in *GetVolumeIDRequest, opts ...grpc.CallOption) (*GetVolumeIDResponse, error)
 	SetVolumeID(ctx context.Context, in *SetVolumeIDRequest, opts ...grpc.CallOption) (*SetVolumeIDResponse, error)
+	ValidateLocation(ctx context.Context, in *ValidateLocationRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type volumeSnapshotterClient struct {
@@ -435,6 +453,15 @@ func (c *volumeSnapshotterClient) SetVolumeID(ctx context.Context, in *SetVolume
 	return out, nil
 }
 
+func (c *volumeSnapshotterClient) ValidateLocation(ctx context.Context, in *ValidateLocationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/generated.VolumeSnapshotter/ValidateLocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for VolumeSnapshotter service
 
 type VolumeSnapshotterServer interface {
@@ -445,6 +472,7 @@ type VolumeSnapshotterServer interface {
 	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*Empty, error)
 	GetVolumeID(context.Context, *GetVolumeIDRequest) (*GetVolumeIDResponse, error)
 	SetVolumeID(context.Context, *SetVolumeIDRequest) (*SetVolumeIDResponse, error)
+	ValidateLocation(context.Context, *ValidateLocationRequest) (*Empty, error)
 }
 
 func RegisterVolumeSnapshotterServer(s *grpc.Server, srv VolumeSnapshotterServer) {
@@ -577,6 +605,24 @@ func _VolumeSnapshotter_SetVolumeID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeSnapshotter_ValidateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeSnapshotterServer).ValidateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.VolumeSnapshotter/ValidateLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeSnapshotterServer).ValidateLocation(ctx, req.(*ValidateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generated.VolumeSnapshotter",
 	HandlerType: (*VolumeSnapshotterServer)(nil),
@@ -609,49 +655,55 @@ var _VolumeSnapshotter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetVolumeID",
 			Handler:    _VolumeSnapshotter_SetVolumeID_Handler,
 		},
+		{
+			MethodName: "ValidateLocation",
+			Handler:    _VolumeSnapshotter_ValidateLocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "VolumeSnapshotter.proto",
 }
 
-func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor6) }
-
-var fileDescriptor6 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xda, 0x6e, 0x44, 0x26, 0xa5, 0x0a, 0x9b, 0xa4, 0x58, 0x16, 0x04, 0xe3, 0x0b, 0x51,
-	0x0f, 0x96, 0x48, 0x0f, 0x14, 0x0e, 0x48, 0x51, 0x5d, 0x50, 0xd4, 0x4a, 0x48, 0x76, 0x41, 0x08,
-	0x4e, 0x86, 0x6e, 0x5c, 0x8b, 0xc4, 0x36, 0xde, 0x4d, 0xa5, 0x7c, 0x0c, 0xbf, 0x82, 0xf8, 0x14,
-	0x3e, 0x05, 0xc5, 0xde, 0x24, 0xbb, 0xb1, 0x1d, 0x87, 0x43, 0x6f, 0xde, 0x99, 0x9d, 0x37, 0x6f,
-	0x66, 0xdf, 0x8c, 0xe1, 0xf1, 0xa7, 0x78, 0x3a, 0x9f, 0x11, 0x2f, 0xf2, 0x13, 0x7a, 0x1b, 0x33,
-	0x46, 0x52, 0x3b, 0x49, 0x63, 0x16, 0xe3, 0x66, 0x40, 0x22, 0x92, 0xfa, 0x8c, 0xdc, 0x18, 0x87,
-	0xde, 0xad, 0x9f, 0x92, 0x9b, 0xdc, 0x61, 0xfd, 0x42, 0xd0, 0x39, 0x4f, 0x89, 0xcf, 0x48, 0x1e,
-	0xea, 0x92, 0x9f, 0x73, 0x42, 0x19, 0x3e, 0x86, 0x46, 0x32, 0x9d, 0x07, 0x61, 0xa4, 0x23, 0x13,
-	0x0d, 0x9a, 0x2e, 0x3f, 0xe1, 0x3e, 0x00, 0xe5, 0xe8, 0x63, 0x47, 0x57, 0x32, 0x9f, 0x60, 0x59,
-	0xfa, 0xef, 0x32, 0xa0, 0xeb, 0x45, 0x42, 0x74, 0x35, 0xf7, 0x6f, 0x2c, 0xd8, 0x80, 0x07, 0xf9,
-	0x69, 0xf4, 0x45, 0xd7, 0x32, 0xef, 0xfa, 0x8c, 0x31, 0x68, 0x61, 0x9c, 0x50, 0xfd, 0xc0, 0x44,
-	0x03, 0xd5, 0xcd, 0xbe, 0xad, 0x21, 0x74, 0x65, 0x7a, 0x34, 0x89, 0x23, 0x2a, 0xe0, 0x8c, 0x1d,
-	0xce, 0x70, 0x7d, 0xb6, 0x26, 0xd0, 0x7d, 0x4f, 0x58, 0x1e, 0x30, 0x8e, 0x26, 0x71, 0x5d, 0x4d,
-	0x22, 0x96, 0x22, 0x63, 0x49, 0x7c, 0x55, 0x99, 0xaf, 0x75, 0x09, 0xbd, 0xad, 0x3c, 0x9c, 0x9c,
-	0xdc, 0x04, 0x54, 0x68, 0xc2, 0xaa, 0x50, 0x45, 0x28, 0xf4, 0x2f, 0x82, 0x5e, 0x5e, 0xe9, 0xea,
-	0xf5, 0xee, 0x89, 0x36, 0x7e, 0x0b, 0x1a, 0xf3, 0x03, 0xaa, 0x6b, 0xa6, 0x3a, 0x68, 0x0d, 0x4f,
-	0xec, 0xb5, 0x34, 0xec, 0xd2, 0xfc, 0xf6, 0xb5, 0x1f, 0xd0, 0x8b, 0x88, 0xa5, 0x0b, 0x37, 0x8b,
-	0x33, 0x5e, 0x41, 0x73, 0x6d, 0xc2, 0x6d, 0x50, 0x7f, 0x90, 0x05, 0x67, 0xb6, 0xfc, 0xc4, 0x5d,
-	0x38, 0xb8, 0xf3, 0xa7, 0x73, 0xc2, 0x39, 0xe5, 0x87, 0x37, 0xca, 0x19, 0xb2, 0xce, 0xe0, 0x78,
-	0x3b, 0xc3, 0xa6, 0x61, 0x82, 0xaa, 0xd0, 0xb6, 0xaa, 0xac, 0x0f, 0xd0, 0x73, 0xc8, 0x94, 0xec,
-	0xdf, 0x9b, 0x1a, 0x99, 0x5a, 0x9f, 0x01, 0x6f, 0x9e, 0xce, 0xa9, 0x43, 0x3b, 0x81, 0x76, 0x42,
-	0x52, 0x1a, 0x52, 0x46, 0x22, 0x1e, 0x94, 0x61, 0x1e, 0xba, 0x05, 0xbb, 0xf5, 0x12, 0x3a, 0x12,
-	0xf2, 0x1e, 0x7a, 0x65, 0x80, 0xbd, 0x7b, 0x21, 0x23, 0x65, 0x55, 0xb7, 0xb2, 0x8e, 0xa0, 0xe3,
-	0x95, 0x10, 0x2d, 0x83, 0x47, 0x15, 0xb5, 0xfe, 0x46, 0xf0, 0xa4, 0xb0, 0x71, 0xc6, 0x51, 0x58,
-	0xfb, 0x3c, 0x97, 0xd0, 0xf8, 0x1e, 0x47, 0x93, 0x30, 0xd0, 0x95, 0x4c, 0x84, 0xa7, 0x82, 0x08,
-	0x77, 0x01, 0xda, 0xe7, 0x59, 0x54, 0xae, 0x46, 0x0e, 0x61, 0xbc, 0x86, 0x96, 0x60, 0xfe, 0x1f,
-	0x45, 0x0e, 0xff, 0x68, 0xf0, 0xa8, 0x90, 0x0f, 0x8f, 0x40, 0x5b, 0xe6, 0xc4, 0x2f, 0xf6, 0x64,
-	0x65, 0xb4, 0x85, 0x8b, 0x17, 0xb3, 0x84, 0x2d, 0xf0, 0x57, 0xd0, 0xc5, 0xb5, 0xf5, 0x2e, 0x8d,
-	0x67, 0xab, 0x58, 0xdc, 0x2f, 0x4c, 0x9c, 0xb4, 0x7a, 0x8d, 0x67, 0x95, 0x7e, 0xfe, 0x44, 0x2e,
-	0x3c, 0x94, 0xf6, 0x0e, 0x16, 0x23, 0xca, 0x36, 0x9f, 0x61, 0x56, 0x5f, 0xe0, 0x98, 0x1f, 0xe1,
-	0x48, 0x9e, 0x4d, 0x6c, 0xd6, 0x2d, 0x06, 0xe3, 0xf9, 0x8e, 0x1b, 0x1c, 0xd6, 0x81, 0x23, 0x79,
-	0x70, 0x25, 0xd8, 0xd2, 0x99, 0x2e, 0xe9, 0xe6, 0x15, 0xb4, 0x84, 0x99, 0xc2, 0x4f, 0x4b, 0xab,
-	0x59, 0x0d, 0x8e, 0xd1, 0xaf, 0x72, 0x73, 0x4e, 0x57, 0xd0, 0xf2, 0x2a, 0xd0, 0xbc, 0xdd, 0x68,
-	0x25, 0xf3, 0xf2, 0xad, 0x91, 0xfd, 0x47, 0x4f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xf2,
-	0xb8, 0x2f, 0x7b, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("VolumeSnapshotter.proto", fileDescriptor12) }
+
+var fileDescriptor12 = []byte{
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0x95, 0x31, 0x41, 0x65, 0x48, 0x23, 0xba, 0x40, 0x62, 0x59, 0x2d, 0xa5, 0xbe, 0x14, 0xe5,
+	0x80, 0x54, 0x72, 0x68, 0xda, 0x43, 0x25, 0x14, 0xd2, 0x16, 0x05, 0xa9, 0x92, 0x9d, 0x46, 0x55,
+	0x7b, 0xda, 0x86, 0x85, 0x58, 0x85, 0x5d, 0xd7, 0xbb, 0x44, 0xe2, 0x17, 0xfa, 0x0f, 0xfd, 0x95,
+	0x7e, 0x4b, 0x3f, 0xa5, 0xc2, 0x5e, 0x60, 0x17, 0xdb, 0x98, 0x1e, 0x72, 0xf3, 0xce, 0xec, 0xbc,
+	0x79, 0x33, 0xfb, 0x66, 0x0c, 0x27, 0x37, 0x6c, 0x3a, 0x9f, 0x11, 0x8f, 0xe2, 0x80, 0xdf, 0x31,
+	0x21, 0x48, 0xd8, 0x09, 0x42, 0x26, 0x18, 0x2a, 0x4f, 0x08, 0x25, 0x21, 0x16, 0x64, 0x64, 0x1f,
+	0x7a, 0x77, 0x38, 0x24, 0xa3, 0xd8, 0xe1, 0xfc, 0x36, 0xa0, 0x76, 0x11, 0x12, 0x2c, 0x48, 0x1c,
+	0xea, 0x92, 0x9f, 0x73, 0xc2, 0x05, 0x3a, 0x86, 0x52, 0x30, 0x9d, 0x4f, 0x7c, 0x6a, 0x19, 0x2d,
+	0xa3, 0x5d, 0x76, 0xe5, 0x09, 0x35, 0x01, 0xb8, 0x44, 0x1f, 0xf4, 0xad, 0x42, 0xe4, 0x53, 0x2c,
+	0x4b, 0xff, 0x7d, 0x04, 0x74, 0xbd, 0x08, 0x88, 0x65, 0xc6, 0xfe, 0x8d, 0x05, 0xd9, 0xf0, 0x28,
+	0x3e, 0xf5, 0xbe, 0x5a, 0xc5, 0xc8, 0xbb, 0x3e, 0x23, 0x04, 0x45, 0x9f, 0x05, 0xdc, 0x3a, 0x68,
+	0x19, 0x6d, 0xd3, 0x8d, 0xbe, 0x9d, 0x2e, 0xd4, 0x75, 0x7a, 0x3c, 0x60, 0x94, 0x2b, 0x38, 0x83,
+	0xbe, 0x64, 0xb8, 0x3e, 0x3b, 0x63, 0xa8, 0x7f, 0x20, 0x22, 0x0e, 0x18, 0xd0, 0x31, 0xcb, 0xab,
+	0x49, 0xc5, 0x2a, 0xe8, 0x58, 0x1a, 0x5f, 0x53, 0xe7, 0xeb, 0x5c, 0x41, 0x63, 0x2b, 0x8f, 0x24,
+	0xa7, 0x37, 0xc1, 0x48, 0x34, 0x61, 0x55, 0x68, 0x41, 0x29, 0xf4, 0xaf, 0x01, 0x8d, 0xb8, 0xd2,
+	0xd5, 0xeb, 0x3d, 0x10, 0x6d, 0xf4, 0x0e, 0x8a, 0x02, 0x4f, 0xb8, 0x55, 0x6c, 0x99, 0xed, 0x4a,
+	0xf7, 0xb4, 0xb3, 0x96, 0x46, 0x27, 0x35, 0x7f, 0xe7, 0x1a, 0x4f, 0xf8, 0x25, 0x15, 0xe1, 0xc2,
+	0x8d, 0xe2, 0xec, 0xd7, 0x50, 0x5e, 0x9b, 0x50, 0x15, 0xcc, 0x1f, 0x64, 0x21, 0x99, 0x2d, 0x3f,
+	0x51, 0x1d, 0x0e, 0xee, 0xf1, 0x74, 0x4e, 0x24, 0xa7, 0xf8, 0xf0, 0xb6, 0x70, 0x6e, 0x38, 0xe7,
+	0x70, 0xbc, 0x9d, 0x61, 0xd3, 0x30, 0x45, 0x55, 0xc6, 0xb6, 0xaa, 0x9c, 0x4f, 0xd0, 0xe8, 0x93,
+	0x29, 0xd9, 0xbf, 0x37, 0x39, 0x32, 0x75, 0xbe, 0x00, 0xda, 0x3c, 0x5d, 0x3f, 0x0f, 0xed, 0x14,
+	0xaa, 0x01, 0x09, 0xb9, 0xcf, 0x05, 0xa1, 0x32, 0x28, 0xc2, 0x3c, 0x74, 0x13, 0x76, 0xe7, 0x15,
+	0xd4, 0x34, 0xe4, 0x3d, 0xf4, 0x2a, 0x00, 0x79, 0x0f, 0x42, 0x46, 0xcb, 0x6a, 0x6e, 0x65, 0xed,
+	0x41, 0xcd, 0x4b, 0x21, 0x9a, 0x06, 0x6f, 0x64, 0xd6, 0x7a, 0x72, 0x83, 0xa7, 0xfe, 0x08, 0x0b,
+	0x32, 0x64, 0xb7, 0x58, 0xf8, 0x8c, 0xe6, 0xb0, 0x77, 0xfe, 0x18, 0xf0, 0x34, 0xb1, 0xa4, 0x06,
+	0xd4, 0xcf, 0x7d, 0xd1, 0x2b, 0x28, 0xdd, 0x32, 0x3a, 0xf6, 0x27, 0x56, 0x21, 0xd2, 0xed, 0x99,
+	0xa2, 0xdb, 0x5d, 0x80, 0x9d, 0x8b, 0x28, 0x2a, 0x16, 0xb0, 0x84, 0xb0, 0xdf, 0x40, 0x45, 0x31,
+	0xff, 0x8f, 0x88, 0xbb, 0xbf, 0x0e, 0xe0, 0x49, 0x22, 0x1f, 0xea, 0x41, 0x71, 0x99, 0x13, 0xbd,
+	0xdc, 0x93, 0x95, 0x5d, 0x55, 0x2e, 0x5e, 0xce, 0x02, 0xb1, 0x40, 0xdf, 0xc0, 0x52, 0x37, 0xdd,
+	0xfb, 0x90, 0xcd, 0x56, 0xb1, 0xa8, 0x99, 0x18, 0x52, 0x6d, 0x5b, 0xdb, 0xcf, 0x33, 0xfd, 0xf2,
+	0x55, 0x5d, 0x78, 0xac, 0xad, 0x2a, 0xa4, 0x46, 0xa4, 0x2d, 0x4b, 0xbb, 0x95, 0x7d, 0x41, 0x62,
+	0x7e, 0x86, 0x23, 0x7d, 0x9c, 0x51, 0x2b, 0x6f, 0x97, 0xd8, 0x2f, 0x76, 0xdc, 0x90, 0xb0, 0x7d,
+	0x38, 0xd2, 0x67, 0x5d, 0x83, 0x4d, 0x5d, 0x03, 0x29, 0xdd, 0x1c, 0x42, 0x45, 0x19, 0x43, 0xf4,
+	0x2c, 0xb5, 0x9a, 0xd5, 0xac, 0xd9, 0xcd, 0x2c, 0xb7, 0xe4, 0x34, 0x84, 0x8a, 0x97, 0x81, 0xe6,
+	0xed, 0x46, 0x4b, 0x1b, 0xb1, 0x8f, 0x50, 0xdd, 0x1e, 0x1b, 0xe4, 0xa8, 0xc2, 0x49, 0x9f, 0xa9,
+	0x64, 0x95, 0xdf, 0x4b, 0xd1, 0x4f, 0xfc, 0xec, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x29,
+	0x96, 0xcd, 0xf8, 0x07, 0x00, 0x00,
 }
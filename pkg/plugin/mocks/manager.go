@@ -17,6 +17,29 @@ func (_m *Manager) CleanupClients() {
 	_m.Called()
 }
 
+// GetBackupHookAction provides a mock function with given fields: name
+func (_m *Manager) GetBackupHookAction(name string) (velero.BackupHookAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.BackupHookAction
+	if rf, ok := ret.Get(0).(func(string) velero.BackupHookAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.BackupHookAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupItemAction provides a mock function with given fields: name
 func (_m *Manager) GetBackupItemAction(name string) (velero.BackupItemAction, error) {
 	ret := _m.Called(name)
@@ -63,6 +86,144 @@ func (_m *Manager) GetBackupItemActions() ([]velero.BackupItemAction, error) {
 	return r0, r1
 }
 
+// GetBackupValidator provides a mock function with given fields: name
+func (_m *Manager) GetBackupValidator(name string) (velero.BackupValidator, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.BackupValidator
+	if rf, ok := ret.Get(0).(func(string) velero.BackupValidator); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.BackupValidator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupValidators provides a mock function with given fields:
+func (_m *Manager) GetBackupValidators() ([]velero.BackupValidator, error) {
+	ret := _m.Called()
+
+	var r0 []velero.BackupValidator
+	if rf, ok := ret.Get(0).(func() []velero.BackupValidator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.BackupValidator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNamespaceMapper provides a mock function with given fields: name
+func (_m *Manager) GetNamespaceMapper(name string) (velero.NamespaceMapper, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.NamespaceMapper
+	if rf, ok := ret.Get(0).(func(string) velero.NamespaceMapper); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.NamespaceMapper)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNamespaceMappers provides a mock function with given fields:
+func (_m *Manager) GetNamespaceMappers() ([]velero.NamespaceMapper, error) {
+	ret := _m.Called()
+
+	var r0 []velero.NamespaceMapper
+	if rf, ok := ret.Get(0).(func() []velero.NamespaceMapper); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.NamespaceMapper)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupDataIntegrities provides a mock function with given fields:
+func (_m *Manager) GetBackupDataIntegrities() (map[string]velero.BackupDataIntegrity, error) {
+	ret := _m.Called()
+
+	var r0 map[string]velero.BackupDataIntegrity
+	if rf, ok := ret.Get(0).(func() map[string]velero.BackupDataIntegrity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]velero.BackupDataIntegrity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupDataIntegrity provides a mock function with given fields: name
+func (_m *Manager) GetBackupDataIntegrity(name string) (velero.BackupDataIntegrity, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.BackupDataIntegrity
+	if rf, ok := ret.Get(0).(func(string) velero.BackupDataIntegrity); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.BackupDataIntegrity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDeleteItemAction provides a mock function with given fields: name
 func (_m *Manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, error) {
 	ret := _m.Called(name)
@@ -109,6 +270,52 @@ func (_m *Manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
 	return r0, r1
 }
 
+// GetItemBlockAction provides a mock function with given fields: name
+func (_m *Manager) GetItemBlockAction(name string) (velero.ItemBlockAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.ItemBlockAction
+	if rf, ok := ret.Get(0).(func(string) velero.ItemBlockAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.ItemBlockAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItemBlockActions provides a mock function with given fields:
+func (_m *Manager) GetItemBlockActions() ([]velero.ItemBlockAction, error) {
+	ret := _m.Called()
+
+	var r0 []velero.ItemBlockAction
+	if rf, ok := ret.Get(0).(func() []velero.ItemBlockAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.ItemBlockAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetObjectStore provides a mock function with given fields: name
 func (_m *Manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	ret := _m.Called(name)
@@ -178,6 +385,52 @@ func (_m *Manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 	return r0, r1
 }
 
+// GetRestoreValidator provides a mock function with given fields: name
+func (_m *Manager) GetRestoreValidator(name string) (velero.RestoreValidator, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.RestoreValidator
+	if rf, ok := ret.Get(0).(func(string) velero.RestoreValidator); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.RestoreValidator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRestoreValidators provides a mock function with given fields:
+func (_m *Manager) GetRestoreValidators() ([]velero.RestoreValidator, error) {
+	ret := _m.Called()
+
+	var r0 []velero.RestoreValidator
+	if rf, ok := ret.Get(0).(func() []velero.RestoreValidator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.RestoreValidator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolumeSnapshotter provides a mock function with given fields: name
 func (_m *Manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error) {
 	ret := _m.Called(name)
@@ -0,0 +1,33 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+// BackupDataIntegrity is an actor that computes digests of the data in pod
+// volumes when they're backed up, and verifies restored volumes against
+// them. It runs on the node that the volume's pod runs on, after the volume
+// has been backed up or restored, and is given the path of the volume's data
+// on that node, so it sees the data unencrypted, as the pod does.
+type BackupDataIntegrity interface {
+	// ComputeDigest returns a digest of the data at path, which is recorded
+	// in the pod volume backup and stored with the backup's metadata. Any
+	// string can be returned, as long as VerifyDigest accepts it.
+	ComputeDigest(path string) (string, error)
+
+	// VerifyDigest returns an error if the data at path doesn't match a
+	// digest returned by ComputeDigest.
+	VerifyDigest(path, digest string) error
+}
@@ -0,0 +1,32 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupHookAction is an actor that runs a backup-level hook, once before any
+// of a backup's items are backed up and once after all of them have been, for
+// example to flush a database proxy or to toggle an application's maintenance
+// mode.
+type BackupHookAction interface {
+	// Execute is invoked once for each phase of each backup whose spec names
+	// the plugin in that phase's backup-level hooks. Returning an error fails
+	// the hook, which fails the backup if the hook's onError is Fail.
+	Execute(backup *api.Backup, phase api.BackupHookPhase) error
+}
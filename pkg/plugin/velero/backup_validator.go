@@ -0,0 +1,42 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupValidator is an actor that decides whether a new backup is allowed to
+// run, for example to enforce an organization's backup policies.
+type BackupValidator interface {
+	// Validate is invoked once for each new backup, after the backup's spec has
+	// passed Velero's own validation and before any of its items are backed up.
+	// A backup rejected by any validator is marked as FailedValidation, with the
+	// validator's message added to its validation errors. Returning an error
+	// also rejects the backup.
+	Validate(backup *api.Backup) (BackupValidationResult, error)
+}
+
+// BackupValidationResult is the decision of a BackupValidator about a backup.
+type BackupValidationResult struct {
+	// Accepted is whether the backup is allowed to run.
+	Accepted bool
+
+	// Message explains why the backup was rejected. It's shown to users, so it
+	// should name the policy that the backup doesn't meet.
+	Message string
+}
@@ -0,0 +1,37 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ItemBlockAction is an actor that identifies related items which should be backed up
+// together with an item, as a single block, before any other items are processed.
+type ItemBlockAction interface {
+	// AppliesTo returns information about which resources this action should be invoked for.
+	// An ItemBlockAction's GetRelatedItems function will only be invoked on items that match the
+	// returned selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// GetRelatedItems returns a list of ResourceIdentifiers specifying items that should be
+	// backed up in the same block as the item being backed up. The item itself must not be
+	// modified.
+	GetRelatedItems(item runtime.Unstructured, backup *api.Backup) ([]ResourceIdentifier, error)
+}
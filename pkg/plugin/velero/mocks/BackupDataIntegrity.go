@@ -0,0 +1,45 @@
+// Code generated by mockery v2.1.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// BackupDataIntegrity is an autogenerated mock type for the BackupDataIntegrity type
+type BackupDataIntegrity struct {
+	mock.Mock
+}
+
+// ComputeDigest provides a mock function with given fields: path
+func (_m *BackupDataIntegrity) ComputeDigest(path string) (string, error) {
+	ret := _m.Called(path)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyDigest provides a mock function with given fields: path, digest
+func (_m *BackupDataIntegrity) VerifyDigest(path string, digest string) error {
+	ret := _m.Called(path, digest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, digest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
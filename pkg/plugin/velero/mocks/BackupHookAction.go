@@ -0,0 +1,28 @@
+// Code generated by mockery v2.1.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// BackupHookAction is an autogenerated mock type for the BackupHookAction type
+type BackupHookAction struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: backup, phase
+func (_m *BackupHookAction) Execute(backup *v1.Backup, phase v1.BackupHookPhase) error {
+	ret := _m.Called(backup, phase)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*v1.Backup, v1.BackupHookPhase) error); ok {
+		r0 = rf(backup, phase)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
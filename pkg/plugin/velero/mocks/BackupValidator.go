@@ -0,0 +1,37 @@
+// Code generated by mockery v2.1.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// BackupValidator is an autogenerated mock type for the BackupValidator type
+type BackupValidator struct {
+	mock.Mock
+}
+
+// Validate provides a mock function with given fields: backup
+func (_m *BackupValidator) Validate(backup *v1.Backup) (velero.BackupValidationResult, error) {
+	ret := _m.Called(backup)
+
+	var r0 velero.BackupValidationResult
+	if rf, ok := ret.Get(0).(func(*v1.Backup) velero.BackupValidationResult); ok {
+		r0 = rf(backup)
+	} else {
+		r0 = ret.Get(0).(velero.BackupValidationResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.Backup) error); ok {
+		r1 = rf(backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.1.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// ItemBlockAction is an autogenerated mock type for the ItemBlockAction type
+type ItemBlockAction struct {
+	mock.Mock
+}
+
+// AppliesTo provides a mock function with given fields:
+func (_m *ItemBlockAction) AppliesTo() (velero.ResourceSelector, error) {
+	ret := _m.Called()
+
+	var r0 velero.ResourceSelector
+	if rf, ok := ret.Get(0).(func() velero.ResourceSelector); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(velero.ResourceSelector)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRelatedItems provides a mock function with given fields: item, backup
+func (_m *ItemBlockAction) GetRelatedItems(item runtime.Unstructured, backup *v1.Backup) ([]velero.ResourceIdentifier, error) {
+	ret := _m.Called(item, backup)
+
+	var r0 []velero.ResourceIdentifier
+	if rf, ok := ret.Get(0).(func(runtime.Unstructured, *v1.Backup) []velero.ResourceIdentifier); ok {
+		r0 = rf(item, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.ResourceIdentifier)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(runtime.Unstructured, *v1.Backup) error); ok {
+		r1 = rf(item, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,35 @@
+// Code generated by mockery v2.1.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// NamespaceMapper is an autogenerated mock type for the NamespaceMapper type
+type NamespaceMapper struct {
+	mock.Mock
+}
+
+// MapNamespace provides a mock function with given fields: namespace, backup
+func (_m *NamespaceMapper) MapNamespace(namespace string, backup *v1.Backup) (string, error) {
+	ret := _m.Called(namespace, backup)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, *v1.Backup) string); ok {
+		r0 = rf(namespace, backup)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *v1.Backup) error); ok {
+		r1 = rf(namespace, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,56 @@
+// Code generated by mockery v2.1.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// RestoreValidator is an autogenerated mock type for the RestoreValidator type
+type RestoreValidator struct {
+	mock.Mock
+}
+
+// AppliesTo provides a mock function with given fields:
+func (_m *RestoreValidator) AppliesTo() (velero.ResourceSelector, error) {
+	ret := _m.Called()
+
+	var r0 velero.ResourceSelector
+	if rf, ok := ret.Get(0).(func() velero.ResourceSelector); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(velero.ResourceSelector)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Validate provides a mock function with given fields: input
+func (_m *RestoreValidator) Validate(input *velero.RestoreValidatorValidateInput) (velero.RestoreValidationResult, error) {
+	ret := _m.Called(input)
+
+	var r0 velero.RestoreValidationResult
+	if rf, ok := ret.Get(0).(func(*velero.RestoreValidatorValidateInput) velero.RestoreValidationResult); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(velero.RestoreValidationResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*velero.RestoreValidatorValidateInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
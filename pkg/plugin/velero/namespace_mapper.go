@@ -0,0 +1,34 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// NamespaceMapper is an actor that renames the namespaces recorded in a
+// backup, for example to anonymize them before the backup is archived
+// offsite. It changes what's written to the backup tarball, unlike a
+// restore's namespace mapping, which changes where items are restored.
+type NamespaceMapper interface {
+	// MapNamespace is invoked once for each namespace with items in a backup,
+	// before any of them are written, and returns the name the namespace is
+	// recorded under in the backup tarball. Returning the namespace unchanged
+	// leaves it as it is. When several mappers are registered, each is given
+	// the name returned by the previous one.
+	MapNamespace(namespace string, backup *api.Backup) (string, error)
+}
@@ -19,6 +19,8 @@ package velero
 import (
 	"io"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ObjectStore exposes basic object-storage operations required
@@ -63,3 +65,44 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ObjectMetadata is metadata about an object in object storage.
+type ObjectMetadata struct {
+	// ETag identifies the object's content, and changes whenever the
+	// object is written. It may be empty if the object store doesn't
+	// provide one.
+	ETag string
+
+	// LastModified is when the object was last written.
+	LastModified time.Time
+
+	// Size is the size of the object in bytes. It may be 0 if the object
+	// store doesn't provide it.
+	Size int64
+}
+
+// ErrObjectMetadataNotSupported is returned by GetObjectMetadata when the
+// object store doesn't support getting an object's metadata.
+var ErrObjectMetadataNotSupported = errors.New("object store doesn't support getting object metadata")
+
+// ObjectMetadataGetter is an optional interface that an ObjectStore can
+// implement to let Velero check whether an object has changed without
+// downloading it.
+type ObjectMetadataGetter interface {
+	// GetObjectMetadata returns the metadata of the object with the given
+	// key in the specified bucket, without retrieving its content.
+	GetObjectMetadata(bucket, key string) (*ObjectMetadata, error)
+}
+
+// ErrListObjectSizesNotSupported is returned by ListObjectSizes when the
+// object store doesn't support listing the sizes of objects.
+var ErrListObjectSizesNotSupported = errors.New("object store doesn't support listing object sizes")
+
+// ObjectSizeLister is an optional interface that an ObjectStore can
+// implement to let Velero measure the space used by the objects with a
+// prefix without getting the metadata of each of them.
+type ObjectSizeLister interface {
+	// ListObjectSizes returns the size in bytes of each object in the
+	// specified bucket that has the given prefix, keyed by the object's key.
+	ListObjectSizes(bucket, prefix string) (map[string]int64, error)
+}
@@ -35,6 +35,10 @@ type RestoreItemAction interface {
 	// related items that should be restored, a warning (which will be logged but will not prevent
 	// the item from being restored) or error (which will be logged and will prevent the item
 	// from being restored) if applicable.
+	//
+	// Execute is also invoked for restores that are dry runs (input.Restore.Spec.DryRun),
+	// so actions with side effects outside of the returned item should check for that
+	// and avoid making changes.
 	Execute(input *RestoreItemActionExecuteInput) (*RestoreItemActionExecuteOutput, error)
 }
 
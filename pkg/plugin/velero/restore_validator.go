@@ -0,0 +1,78 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// RestoreValidator is an actor that checks restored items once a restore has
+// created them, for example that a restored deployment has the replicas an
+// application needs, or that a restored service has endpoints.
+type RestoreValidator interface {
+	// AppliesTo returns information about which resources this validator should be invoked for.
+	// A RestoreValidator's Validate function will only be invoked on restored items that match
+	// the returned selector. A zero-valued ResourceSelector matches all resources.
+	AppliesTo() (ResourceSelector, error)
+
+	// Validate is invoked for each restored item that the validator applies to, once all of
+	// the restore's items have been created, its pod volumes restored, its post-restore hooks
+	// run and its workloads waited for. It's given the item as it is in the cluster.
+	//
+	// An item that fails validation is added to the restore's errors, which makes the restore
+	// PartiallyFailed, unless the result's Severity is RestoreValidationSeverityWarning, in
+	// which case it's added to the restore's warnings. Returning an error also fails the
+	// item's validation. Validate isn't invoked for restores that are dry runs.
+	Validate(input *RestoreValidatorValidateInput) (RestoreValidationResult, error)
+}
+
+// RestoreValidatorValidateInput contains the input parameters for the RestoreValidator's
+// Validate function.
+type RestoreValidatorValidateInput struct {
+	// Item is the restored item, as it is in the cluster.
+	Item runtime.Unstructured
+	// Restore is the representation of the restore resource processed by Velero.
+	Restore *api.Restore
+}
+
+// RestoreValidationSeverity is how a failed validation affects a restore.
+type RestoreValidationSeverity string
+
+const (
+	// RestoreValidationSeverityError adds a failed validation to the restore's errors.
+	// It's the default.
+	RestoreValidationSeverityError RestoreValidationSeverity = "Error"
+
+	// RestoreValidationSeverityWarning adds a failed validation to the restore's warnings.
+	RestoreValidationSeverityWarning RestoreValidationSeverity = "Warning"
+)
+
+// RestoreValidationResult is the decision of a RestoreValidator about a restored item.
+type RestoreValidationResult struct {
+	// Passed is whether the item is as the validator expects.
+	Passed bool
+
+	// Message explains why the item failed validation. It's shown to users, so it should
+	// say what was expected of the item.
+	Message string
+
+	// Severity is how a failed validation affects the restore. If it's empty, the failure
+	// is an error.
+	Severity RestoreValidationSeverity
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package velero
 
 import (
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -51,3 +52,17 @@ type VolumeSnapshotter interface {
 	// DeleteSnapshot deletes the specified volume snapshot.
 	DeleteSnapshot(snapshotID string) error
 }
+
+// ErrLocationValidationNotSupported is returned by ValidateLocation when the
+// volume snapshotter doesn't support validating its location.
+var ErrLocationValidationNotSupported = errors.New("volume snapshotter doesn't support validating its location")
+
+// VolumeSnapshotterValidator is an optional interface that a VolumeSnapshotter
+// can implement to let Velero check that its location is usable before any
+// snapshots are taken in it.
+type VolumeSnapshotterValidator interface {
+	// ValidateLocation checks that the credentials and permissions the
+	// VolumeSnapshotter was initialized with allow it to take snapshots in
+	// the configured location. It must not create, modify or delete anything.
+	ValidateLocation() error
+}
@@ -19,6 +19,7 @@ package podexec
 import (
 	"bytes"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/remotecommand"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
 const defaultTimeout = 30 * time.Second
@@ -88,7 +90,13 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 		return errors.WithStack(err)
 	}
 
-	if hook.Container == "" {
+	if hook.ContainerImage != "" {
+		container, err := ContainerForImage(pod, hook.ContainerImage, hook.ContainerImageFirstMatch)
+		if err != nil {
+			return err
+		}
+		hook.Container = container
+	} else if hook.Container == "" {
 		if err := setDefaultHookContainer(pod, hook); err != nil {
 			return err
 		}
@@ -184,6 +192,31 @@ func ensureContainerExists(pod *corev1api.Pod, container string) error {
 	return errors.Errorf("no such container: %q", container)
 }
 
+// ContainerForImage returns the name of the pod's container whose image
+// matches imagePattern, a glob or "regex:"-prefixed regular expression. It's
+// an error if no container matches, or if more than one does and firstMatch
+// is false.
+func ContainerForImage(pod *corev1api.Pod, imagePattern string, firstMatch bool) (string, error) {
+	var matched []string
+	for _, c := range pod.Spec.Containers {
+		matches, err := collections.MatchPattern(imagePattern, c.Image)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid container image pattern")
+		}
+		if matches {
+			matched = append(matched, c.Name)
+		}
+	}
+
+	switch {
+	case len(matched) == 0:
+		return "", errors.Errorf("no container's image matches %q", imagePattern)
+	case len(matched) > 1 && !firstMatch:
+		return "", errors.Errorf("more than one container's image matches %q: %s", imagePattern, strings.Join(matched, ", "))
+	}
+	return matched[0], nil
+}
+
 func setDefaultHookContainer(pod *corev1api.Pod, hook *api.ExecHook) error {
 	if len(pod.Spec.Containers) < 1 {
 		return errors.New("need at least 1 container")
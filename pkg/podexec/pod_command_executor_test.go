@@ -238,6 +238,65 @@ func TestEnsureContainerExists(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestContainerForImage(t *testing.T) {
+	pod := &corev1api.Pod{
+		Spec: corev1api.PodSpec{
+			Containers: []corev1api.Container{
+				{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.9.0"},
+				{Name: "app-1", Image: "registry.example.com/app:1.0"},
+				{Name: "app-2", Image: "registry.example.com/app:1.0"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		imagePattern  string
+		firstMatch    bool
+		expected      string
+		expectedError string
+	}{
+		{
+			name:         "single matching container is selected",
+			imagePattern: "*/istio/proxyv2:*",
+			expected:     "istio-proxy",
+		},
+		{
+			name:         "regex pattern is supported",
+			imagePattern: "regex:istio/proxyv2:1\\.9",
+			expected:     "istio-proxy",
+		},
+		{
+			name:          "no matching container is an error",
+			imagePattern:  "*/mysql:*",
+			expectedError: `no container's image matches "*/mysql:*"`,
+		},
+		{
+			name:          "more than one matching container is an error",
+			imagePattern:  "registry.example.com/app:*",
+			expectedError: `more than one container's image matches "registry.example.com/app:*": app-1, app-2`,
+		},
+		{
+			name:         "first of more than one matching container is selected with firstMatch",
+			imagePattern: "registry.example.com/app:*",
+			firstMatch:   true,
+			expected:     "app-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			container, err := ContainerForImage(pod, tc.imagePattern, tc.firstMatch)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, container)
+		})
+	}
+}
+
 type mockStreamExecutorFactory struct {
 	mock.Mock
 }
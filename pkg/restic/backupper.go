@@ -158,6 +158,9 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 
 		volumeBackup := newPodVolumeBackup(backup, pod, volume, repo.Spec.ResticIdentifier, pvc)
+		if useBlockBackup(pvc, log) {
+			volumeBackup.Spec.VolumeMode = velerov1api.PodVolumeModeBlock
+		}
 		if volumeBackup, err = b.repoManager.veleroClient.VeleroV1().PodVolumeBackups(volumeBackup.Namespace).Create(context.TODO(), volumeBackup, metav1.CreateOptions{}); err != nil {
 			errs = append(errs, err)
 			continue
@@ -216,6 +219,24 @@ func isHostPathVolume(volume *corev1api.Volume, pvc *corev1api.PersistentVolumeC
 	return pv.Spec.HostPath != nil, nil
 }
 
+// useBlockBackup returns true if a PVC's raw block device should be backed up
+// rather than its filesystem: it must be annotated for it and have volumeMode
+// Block. The device of a filesystem-mode volume isn't backed up even if it's
+// annotated, since it can't be read consistently while the filesystem is
+// mounted by the pod, so its files are backed up instead.
+func useBlockBackup(pvc *corev1api.PersistentVolumeClaim, log logrus.FieldLogger) bool {
+	if pvc == nil || pvc.Annotations[BlockBackupAnnotation] != "true" {
+		return false
+	}
+
+	if pvc.Spec.VolumeMode == nil || *pvc.Spec.VolumeMode != corev1api.PersistentVolumeBlock {
+		log.Warnf("PVC %s/%s is annotated with %s but its volume is mounted as a filesystem, so its files are backed up instead of its block device", pvc.Namespace, pvc.Name, BlockBackupAnnotation)
+		return false
+	}
+
+	return true
+}
+
 func newPodVolumeBackup(backup *velerov1api.Backup, pod *corev1api.Pod, volume corev1api.Volume, repoIdentifier string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeBackup {
 	pvb := &velerov1api.PodVolumeBackup{
 		ObjectMeta: metav1.ObjectMeta{
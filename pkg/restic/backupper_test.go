@@ -24,6 +24,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestIsHostPathVolume(t *testing.T) {
@@ -128,6 +130,56 @@ func TestIsHostPathVolume(t *testing.T) {
 	assert.True(t, isHostPath)
 }
 
+func TestUseBlockBackup(t *testing.T) {
+	blockMode := corev1api.PersistentVolumeBlock
+	filesystemMode := corev1api.PersistentVolumeFilesystem
+
+	tests := []struct {
+		name       string
+		pvc        *corev1api.PersistentVolumeClaim
+		annotation string
+		want       bool
+	}{
+		{
+			name: "pod volume that isn't a PVC uses filesystem backup",
+		},
+		{
+			name: "block PVC without the annotation uses filesystem backup",
+			pvc:  &corev1api.PersistentVolumeClaim{Spec: corev1api.PersistentVolumeClaimSpec{VolumeMode: &blockMode}},
+		},
+		{
+			name:       "annotated block PVC uses block backup",
+			pvc:        &corev1api.PersistentVolumeClaim{Spec: corev1api.PersistentVolumeClaimSpec{VolumeMode: &blockMode}},
+			annotation: "true",
+			want:       true,
+		},
+		{
+			name:       "annotated filesystem PVC uses filesystem backup",
+			pvc:        &corev1api.PersistentVolumeClaim{Spec: corev1api.PersistentVolumeClaimSpec{VolumeMode: &filesystemMode}},
+			annotation: "true",
+		},
+		{
+			name:       "annotated PVC without a volume mode uses filesystem backup",
+			pvc:        &corev1api.PersistentVolumeClaim{},
+			annotation: "true",
+		},
+		{
+			name:       "block PVC annotated with false uses filesystem backup",
+			pvc:        &corev1api.PersistentVolumeClaim{Spec: corev1api.PersistentVolumeClaimSpec{VolumeMode: &blockMode}},
+			annotation: "false",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.annotation != "" {
+				tc.pvc.Annotations = map[string]string{BlockBackupAnnotation: tc.annotation}
+			}
+			assert.Equal(t, tc.want, useBlockBackup(tc.pvc, velerotest.NewLogger()))
+		})
+	}
+}
+
 type fakePVGetter struct {
 	pv *corev1api.PersistentVolume
 }
@@ -38,6 +38,18 @@ func BackupCommand(repoIdentifier, passwordFile, path string, tags map[string]st
 	}
 }
 
+// BlockBackupCommand returns a Command for running a restic backup of a
+// raw block device, which is read from the command's stdin and stored in
+// the snapshot as a single file with the provided name.
+func BlockBackupCommand(repoIdentifier, passwordFile, fileName string, tags map[string]string) *Command {
+	return &Command{
+		Command:        "backup",
+		RepoIdentifier: repoIdentifier,
+		PasswordFile:   passwordFile,
+		ExtraFlags:     append(backupTagFlags(tags), "--host=velero", "--json", "--stdin", fmt.Sprintf("--stdin-filename=%s", fileName)),
+	}
+}
+
 func backupTagFlags(tags map[string]string) []string {
 	var flags []string
 	for k, v := range tags {
@@ -58,6 +70,17 @@ func RestoreCommand(repoIdentifier, passwordFile, snapshotID, target string) *Co
 	}
 }
 
+// DumpCommand returns a Command for running a restic dump, which writes the
+// contents of a file in a snapshot to the command's stdout.
+func DumpCommand(repoIdentifier, passwordFile, snapshotID, fileName string) *Command {
+	return &Command{
+		Command:        "dump",
+		RepoIdentifier: repoIdentifier,
+		PasswordFile:   passwordFile,
+		Args:           []string{snapshotID, "/" + fileName},
+	}
+}
+
 // GetSnapshotCommand returns a Command for running a restic (get) snapshots.
 func GetSnapshotCommand(repoIdentifier, passwordFile string, tags map[string]string) *Command {
 	return &Command{
@@ -38,6 +38,21 @@ func TestBackupCommand(t *testing.T) {
 	assert.Equal(t, expected, c.ExtraFlags)
 }
 
+func TestBlockBackupCommand(t *testing.T) {
+	c := BlockBackupCommand("repo-id", "password-file", "volume-1", map[string]string{"foo": "bar"})
+
+	assert.Equal(t, "backup", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Empty(t, c.Dir)
+	assert.Empty(t, c.Args)
+
+	expected := []string{"--tag=foo=bar", "--host=velero", "--json", "--stdin", "--stdin-filename=volume-1"}
+	sort.Strings(expected)
+	sort.Strings(c.ExtraFlags)
+	assert.Equal(t, expected, c.ExtraFlags)
+}
+
 func TestRestoreCommand(t *testing.T) {
 	c := RestoreCommand("repo-id", "password-file", "snapshot-id", "target")
 
@@ -49,6 +64,16 @@ func TestRestoreCommand(t *testing.T) {
 	assert.Equal(t, []string{"--target=."}, c.ExtraFlags)
 }
 
+func TestDumpCommand(t *testing.T) {
+	c := DumpCommand("repo-id", "password-file", "snapshot-id", "volume-1")
+
+	assert.Equal(t, "dump", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, "password-file", c.PasswordFile)
+	assert.Equal(t, []string{"snapshot-id", "/volume-1"}, c.Args)
+	assert.Empty(t, c.ExtraFlags)
+}
+
 func TestGetSnapshotCommand(t *testing.T) {
 	expectedTags := map[string]string{"foo": "bar", "c": "d"}
 	c := GetSnapshotCommand("repo-id", "password-file", expectedTags)
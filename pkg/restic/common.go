@@ -62,6 +62,17 @@ const (
 	// should be excluded from restic backup.
 	VolumesToExcludeAnnotation = "backup.velero.io/backup-volumes-excludes"
 
+	// BlockBackupAnnotation is the annotation on a PVC whose raw block
+	// device, rather than its filesystem, should be backed up when it's
+	// backed up using restic. It only applies to PVCs with volumeMode Block.
+	BlockBackupAnnotation = "backup.velero.io/block-backup"
+
+	// BlockRestoresVolume is the name of the emptyDir volume that's added
+	// to restored pods with block volumes. Block devices can't be mounted
+	// into the restic init container, so the done files for their restores
+	// are written to a subdirectory of this volume instead.
+	BlockRestoresVolume = "velero-block-restores"
+
 	// credentialsFileKey is the key within a BSL config that is checked to see if
 	// the BSL is using its own credentials, rather than those in the environment
 	credentialsFileKey = "credentialsFile"
@@ -144,6 +155,39 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 	return getPodSnapshotAnnotations(pod)
 }
 
+// GetBlockVolumeBackupsForPod returns the names of the volumes of a pod
+// whose raw block devices were backed up.
+func GetBlockVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod *corev1api.Pod, sourcePodNs string) map[string]bool {
+	volumes := make(map[string]bool)
+
+	for _, pvb := range podVolumeBackups {
+		if !isPVBMatchPod(pvb, pod.GetName(), sourcePodNs) || pvb.Status.SnapshotID == "" {
+			continue
+		}
+		if pvb.Spec.VolumeMode == velerov1api.PodVolumeModeBlock {
+			volumes[pvb.Spec.Volume] = true
+		}
+	}
+
+	return volumes
+}
+
+// GetVolumeDataDigestsForPod returns the data digests of the pod volume
+// backups of a pod, keyed by volume name. Volumes backed up without
+// backup data integrity plugins aren't included.
+func GetVolumeDataDigestsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod *corev1api.Pod, sourcePodNs string) map[string]map[string]string {
+	digests := make(map[string]map[string]string)
+
+	for _, pvb := range podVolumeBackups {
+		if !isPVBMatchPod(pvb, pod.GetName(), sourcePodNs) || len(pvb.Status.DataDigests) == 0 {
+			continue
+		}
+		digests[pvb.Spec.Volume] = pvb.Status.DataDigests
+	}
+
+	return digests
+}
+
 // GetVolumesToBackup returns a list of volume names to backup for
 // the provided pod.
 // Deprecated: Use GetPodVolumesUsingRestic instead.
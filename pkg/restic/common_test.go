@@ -167,6 +167,44 @@ func TestGetVolumeBackupsForPod(t *testing.T) {
 	}
 }
 
+func TestGetVolumeDataDigestsForPod(t *testing.T) {
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").PodName("TestPod").PodNamespace("TestNS").SnapshotID("snapshot1").Volume("data").
+			DataDigest("velero.io/checksum", "sha256:abcd").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-2").PodName("TestPod").PodNamespace("TestNS").SnapshotID("snapshot2").Volume("logs").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-3").PodName("OtherPod").PodNamespace("TestNS").SnapshotID("snapshot3").Volume("data").
+			DataDigest("velero.io/checksum", "sha256:ef01").Result(),
+	}
+
+	pod := &corev1api.Pod{}
+	pod.Name = "TestPod"
+
+	res := GetVolumeDataDigestsForPod(podVolumeBackups, pod, "TestNS")
+	assert.Equal(t, map[string]map[string]string{
+		"data": {"velero.io/checksum": "sha256:abcd"},
+	}, res)
+}
+
+func TestGetBlockVolumeBackupsForPod(t *testing.T) {
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").PodName("TestPod").PodNamespace("TestNS").SnapshotID("snapshot1").Volume("data").
+			VolumeMode(velerov1api.PodVolumeModeBlock).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-2").PodName("TestPod").PodNamespace("TestNS").SnapshotID("snapshot2").Volume("logs").
+			VolumeMode(velerov1api.PodVolumeModeFilesystem).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-3").PodName("TestPod").PodNamespace("TestNS").SnapshotID("snapshot3").Volume("cache").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-4").PodName("TestPod").PodNamespace("TestNS").Volume("failed").
+			VolumeMode(velerov1api.PodVolumeModeBlock).Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-5").PodName("OtherPod").PodNamespace("TestNS").SnapshotID("snapshot5").Volume("db").
+			VolumeMode(velerov1api.PodVolumeModeBlock).Result(),
+	}
+
+	pod := &corev1api.Pod{}
+	pod.Name = "TestPod"
+
+	res := GetBlockVolumeBackupsForPod(podVolumeBackups, pod, "TestNS")
+	assert.Equal(t, map[string]bool{"data": true}, res)
+}
+
 func TestGetVolumesToBackup(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -20,6 +20,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -35,6 +40,11 @@ const backupProgressCheckInterval = 10 * time.Second
 
 var fileSystem = filesystem.NewFileSystem()
 
+// pruneFreedRegexp matches the line of 'restic prune' output that reports how
+// much data was deleted: "total prune: N blobs / SIZE" for restic 0.12 and
+// later, and "this frees SIZE" for earlier versions.
+var pruneFreedRegexp = regexp.MustCompile(`(?m)^(?:total prune:\s+\d+ blobs / |.*this frees )([\d.]+) (B|KiB|MiB|GiB|TiB)\s*$`)
+
 type backupStatusLine struct {
 	MessageType string `json:"message_type"`
 	// seen in status lines
@@ -42,6 +52,7 @@ type backupStatusLine struct {
 	BytesDone  int64 `json:"bytes_done"`
 	// seen in summary line at the end
 	TotalBytesProcessed int64 `json:"total_bytes_processed"`
+	DataAdded           int64 `json:"data_added"`
 }
 
 // GetSnapshotID runs provided 'restic snapshots' command to get the ID of a snapshot
@@ -71,6 +82,35 @@ func GetSnapshotID(snapshotIdCmd *Command) (string, error) {
 // RunBackup runs a `restic backup` command and watches the output to provide
 // progress updates to the caller.
 func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+	return runBackup(backupCmd, nil, 0, log, updateFunc)
+}
+
+// RunBlockBackup runs a `restic backup` command that reads the raw block
+// device at devicePath from its stdin, and watches the output to provide
+// progress updates to the caller. Every block of the device is read,
+// including unused ones, but restic stores identical chunks once, so runs
+// of zeroed blocks add almost nothing to the repository.
+func RunBlockBackup(backupCmd *Command, devicePath string, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+	device, err := os.Open(devicePath)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "error opening block device %s", devicePath)
+	}
+	defer device.Close()
+
+	// restic doesn't know how much data there is to read from stdin, so
+	// the device's size is used as the total for the progress updates.
+	size, err := device.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "error getting size of block device %s", devicePath)
+	}
+	if _, err := device.Seek(0, io.SeekStart); err != nil {
+		return "", "", errors.Wrapf(err, "error reading block device %s", devicePath)
+	}
+
+	return runBackup(backupCmd, device, size, log, updateFunc)
+}
+
+func runBackup(backupCmd *Command, stdin io.Reader, totalBytes int64, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
 	// buffers for copying command stdout/err output into
 	stdoutBuf := new(bytes.Buffer)
 	stderrBuf := new(bytes.Buffer)
@@ -80,6 +120,7 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 	quit := make(chan struct{})
 
 	cmd := backupCmd.Cmd()
+	cmd.Stdin = stdin
 	cmd.Stdout = stdoutBuf
 	cmd.Stderr = stderrBuf
 
@@ -103,6 +144,9 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 					// if the line contains a non-empty bytes_done field, we can update the
 					// caller with the progress
 					if stat.BytesDone != 0 {
+						if stat.TotalBytes == 0 {
+							stat.TotalBytes = totalBytes
+						}
 						updateFunc(velerov1api.PodVolumeOperationProgress{
 							TotalBytes: stat.TotalBytes,
 							BytesDone:  stat.BytesDone,
@@ -143,6 +187,20 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 	return string(summary), stderrBuf.String(), nil
 }
 
+// GetBackupDataAdded returns the number of bytes that a 'restic backup' command
+// added to its repository, after deduplication, from the summary line that
+// RunBackup returns.
+func GetBackupDataAdded(summary string) (int64, error) {
+	stat, err := decodeBackupStatusLine([]byte(summary))
+	if err != nil {
+		return 0, err
+	}
+	if stat.MessageType != "summary" {
+		return 0, errors.Errorf("error getting restic backup summary: %s", summary)
+	}
+	return stat.DataAdded, nil
+}
+
 func decodeBackupStatusLine(lastLine []byte) (backupStatusLine, error) {
 	var stat backupStatusLine
 	if err := json.Unmarshal(lastLine, &stat); err != nil {
@@ -230,6 +288,103 @@ func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(vel
 	return stdout, stderr, err
 }
 
+// RunBlockRestore runs a `restic dump` command of a raw block device's backup
+// and writes its output to the block device at devicePath, counting the bytes
+// written to provide progress updates to the caller. Every block is written,
+// including zeroed ones, since a newly provisioned volume isn't guaranteed to
+// read back as zeros.
+func RunBlockRestore(dumpCmd *Command, devicePath string, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, error) {
+	snapshotSize, err := getSnapshotSize(dumpCmd.RepoIdentifier, dumpCmd.PasswordFile, dumpCmd.CACertFile, dumpCmd.Args[0], dumpCmd.Env)
+	if err != nil {
+		return "", errors.Wrap(err, "error getting snapshot size")
+	}
+
+	device, err := os.OpenFile(devicePath, os.O_WRONLY, 0)
+	if err != nil {
+		return "", errors.Wrapf(err, "error opening block device %s", devicePath)
+	}
+	defer device.Close()
+
+	size, err := device.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting size of block device %s", devicePath)
+	}
+	if size < snapshotSize {
+		return "", errors.Errorf("block device %s is smaller than the backup, %d bytes < %d bytes", devicePath, size, snapshotSize)
+	}
+	if _, err := device.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Wrapf(err, "error writing block device %s", devicePath)
+	}
+
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+	})
+
+	writer := &countingWriter{writer: device}
+	stderrBuf := new(bytes.Buffer)
+
+	cmd := dumpCmd.Cmd()
+	cmd.Stdout = writer
+	cmd.Stderr = stderrBuf
+
+	// create a channel to signal when to end the goroutine scanning for progress
+	// updates
+	quit := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(restoreProgressCheckInterval)
+		for {
+			select {
+			case <-ticker.C:
+				updateFunc(velerov1api.PodVolumeOperationProgress{
+					TotalBytes: snapshotSize,
+					BytesDone:  writer.count(),
+				})
+			case <-quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	err = cmd.Run()
+	quit <- struct{}{}
+	if err != nil {
+		return stderrBuf.String(), errors.WithStack(err)
+	}
+
+	// the restore isn't done until the data is on the device, rather than
+	// in the node's page cache.
+	if err := device.Sync(); err != nil {
+		return stderrBuf.String(), errors.Wrapf(err, "error syncing block device %s", devicePath)
+	}
+
+	// update progress to 100%
+	updateFunc(velerov1api.PodVolumeOperationProgress{
+		TotalBytes: snapshotSize,
+		BytesDone:  snapshotSize,
+	})
+
+	return stderrBuf.String(), nil
+}
+
+// countingWriter counts the bytes written to a writer, so that they can be
+// read while it's being written to.
+type countingWriter struct {
+	writer  io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	atomic.AddInt64(&w.written, int64(n))
+	return n, err
+}
+
+func (w *countingWriter) count() int64 {
+	return atomic.LoadInt64(&w.written)
+}
+
 func getSnapshotSize(repoIdentifier, passwordFile, caCertFile, snapshotID string, env []string) (int64, error) {
 	cmd := StatsCommand(repoIdentifier, passwordFile, snapshotID)
 	cmd.Env = env
@@ -273,3 +428,27 @@ func getVolumeSize(path string) (int64, error) {
 
 	return size, nil
 }
+
+// getPruneFreedBytes returns the number of bytes that the 'restic prune' output
+// reports were freed, or -1 if it doesn't report it. Restic rounds sizes larger
+// than a KiB, so the result is approximate.
+func getPruneFreedBytes(output string) int64 {
+	matches := pruneFreedRegexp.FindStringSubmatch(output)
+	if matches == nil {
+		return -1
+	}
+
+	size, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return -1
+	}
+
+	units := map[string]float64{
+		"B":   1,
+		"KiB": 1 << 10,
+		"MiB": 1 << 20,
+		"GiB": 1 << 30,
+		"TiB": 1 << 40,
+	}
+	return int64(size * units[matches[2]])
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package restic
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,34 @@ func Test_getSummaryLine(t *testing.T) {
 	}
 }
 
+func TestGetBackupDataAdded(t *testing.T) {
+	summaryLine := `{"message_type":"summary","files_new":2,"files_changed":1,"files_unmodified":3,"data_blobs":4,"tree_blobs":1,"data_added":1048576,"total_files_processed":6,"total_bytes_processed":13238272000,"total_duration":0.319265105,"snapshot_id":"38515bb5"}`
+
+	dataAdded, err := GetBackupDataAdded(summaryLine)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1048576), dataAdded)
+
+	_, err = GetBackupDataAdded(`{"message_type":"status","percent_done":0.5}`)
+	assert.Error(t, err)
+
+	_, err = GetBackupDataAdded("not json")
+	assert.Error(t, err)
+}
+
+func Test_countingWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := &countingWriter{writer: buf}
+
+	for _, s := range []string{"abc", "", "defgh"} {
+		n, err := w.Write([]byte(s))
+		assert.NoError(t, err)
+		assert.Equal(t, len(s), n)
+	}
+
+	assert.Equal(t, int64(8), w.count())
+	assert.Equal(t, "abcdefgh", buf.String())
+}
+
 func Test_getLastLine(t *testing.T) {
 	tests := []struct {
 		output []byte
@@ -108,3 +137,52 @@ func Test_getVolumeSize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedSize, actualSize)
 }
+
+func Test_getPruneFreedBytes(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   int64
+	}{
+		{
+			name: "restic 0.12 output",
+			output: `loading indexes...
+loading all snapshots...
+finding data that is still in use for 3 snapshots
+[0:00] 100.00%  3 / 3 snapshots
+searching used packs...
+collecting packs for deletion and repacking
+[0:00] 100.00%  8 / 8 packs processed
+
+to repack:            2 blobs / 1.250 KiB
+this removes          1 blobs / 512 B
+to delete:            4 blobs / 2.000 MiB
+total prune:          5 blobs / 2.500 MiB
+remaining:           10 blobs / 4.000 MiB
+unused size after prune: 0 B (0.00% of remaining size)
+`,
+			want: 2621440,
+		},
+		{
+			name:   "output of earlier restic versions",
+			output: "will delete 3 packs and rewrite 1 packs, this frees 1.500 GiB\ncounting files in repo\n",
+			want:   1610612736,
+		},
+		{
+			name:   "nothing freed",
+			output: "total prune:          0 blobs / 0 B\n",
+			want:   0,
+		},
+		{
+			name:   "output without freed size",
+			output: "loading indexes...\ndone\n",
+			want:   -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getPruneFreedBytes(tt.output))
+		})
+	}
+}
@@ -50,8 +50,9 @@ type RepositoryManager interface {
 	// authenticated to.
 	ConnectToRepo(repo *velerov1api.ResticRepository) error
 
-	// PruneRepo deletes unused data from a repo.
-	PruneRepo(repo *velerov1api.ResticRepository) error
+	// PruneRepo deletes unused data from a repo, returning the number of
+	// bytes freed, or -1 if restic doesn't report it.
+	PruneRepo(repo *velerov1api.ResticRepository) (int64, error)
 
 	// UnlockRepo removes stale locks from a repo.
 	UnlockRepo(repo *velerov1api.ResticRepository) error
@@ -192,12 +193,16 @@ func (rm *repositoryManager) ConnectToRepo(repo *velerov1api.ResticRepository) e
 	return rm.exec(snapshotsCmd, repo.Spec.BackupStorageLocation)
 }
 
-func (rm *repositoryManager) PruneRepo(repo *velerov1api.ResticRepository) error {
+func (rm *repositoryManager) PruneRepo(repo *velerov1api.ResticRepository) (int64, error) {
 	// restic prune requires an exclusive lock
 	rm.repoLocker.LockExclusive(repo.Name)
 	defer rm.repoLocker.UnlockExclusive(repo.Name)
 
-	return rm.exec(PruneCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	stdout, err := rm.execWithOutput(PruneCommand(repo.Spec.ResticIdentifier), repo.Spec.BackupStorageLocation)
+	if err != nil {
+		return -1, err
+	}
+	return getPruneFreedBytes(stdout), nil
 }
 
 func (rm *repositoryManager) UnlockRepo(repo *velerov1api.ResticRepository) error {
@@ -230,9 +235,16 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 }
 
 func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
+	_, err := rm.execWithOutput(cmd, backupLocation)
+	return err
+}
+
+// execWithOutput runs a restic command against a repository in a backup
+// storage location, returning its stdout.
+func (rm *repositoryManager) execWithOutput(cmd *Command, backupLocation string) (string, error) {
 	file, err := rm.credentialsFileStore.Path(RepoKeySelector())
 	if err != nil {
-		return err
+		return "", err
 	}
 	// ignore error since there's nothing we can do and it's a temp file.
 	defer os.Remove(file)
@@ -244,7 +256,7 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 		Namespace: rm.namespace,
 		Name:      backupLocation,
 	}, loc); err != nil {
-		return errors.Wrap(err, "error getting backup storage location")
+		return "", errors.Wrap(err, "error getting backup storage location")
 	}
 
 	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
@@ -252,7 +264,7 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 	if loc.Spec.ObjectStorage != nil && loc.Spec.ObjectStorage.CACert != nil {
 		caCertFile, err = TempCACertFile(loc.Spec.ObjectStorage.CACert, backupLocation, rm.fileSystem)
 		if err != nil {
-			return errors.Wrap(err, "error creating temp cacert file")
+			return "", errors.Wrap(err, "error creating temp cacert file")
 		}
 		// ignore error since there's nothing we can do and it's a temp file.
 		defer os.Remove(caCertFile)
@@ -261,7 +273,7 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 
 	env, err := CmdEnv(loc, rm.credentialsFileStore)
 	if err != nil {
-		return err
+		return "", err
 	}
 	cmd.Env = env
 
@@ -273,8 +285,8 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 		"stderr":     stderr,
 	}).Debugf("Ran restic command")
 	if err != nil {
-		return errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
 	}
 
-	return nil
+	return stdout, nil
 }
@@ -118,8 +118,20 @@ func (r *restorer) RestorePodVolumes(data RestoreData) []error {
 		numRestores int
 	)
 
+	// the restored data is only verified if the restore asks for it, since
+	// verifying it means reading all of it again.
+	var dataDigests map[string]map[string]string
+	if boolptr.IsSetToTrue(data.Restore.Spec.VerifyPodVolumeData) {
+		dataDigests = GetVolumeDataDigestsForPod(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+	}
+
+	blockVolumes := GetBlockVolumeBackupsForPod(data.PodVolumeBackups, data.Pod, data.SourceNamespace)
+
 	for volume, snapshot := range volumesToRestore {
-		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, snapshot, repo.Spec.ResticIdentifier)
+		volumeRestore := newPodVolumeRestore(data.Restore, data.Pod, data.BackupLocation, volume, snapshot, repo.Spec.ResticIdentifier, dataDigests[volume])
+		if blockVolumes[volume] {
+			volumeRestore.Spec.VolumeMode = velerov1api.PodVolumeModeBlock
+		}
 
 		if err := errorOnly(r.repoManager.veleroClient.VeleroV1().PodVolumeRestores(volumeRestore.Namespace).Create(context.TODO(), volumeRestore, metav1.CreateOptions{})); err != nil {
 			errs = append(errs, errors.WithStack(err))
@@ -148,7 +160,7 @@ ForEachVolume:
 	return errs
 }
 
-func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string) *velerov1api.PodVolumeRestore {
+func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier string, dataDigests map[string]string) *velerov1api.PodVolumeRestore {
 	return &velerov1api.PodVolumeRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    restore.Namespace,
@@ -179,6 +191,7 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 			SnapshotID:            snapshot,
 			BackupStorageLocation: backupLocation,
 			RepoIdentifier:        repoIdentifier,
+			DataDigests:           dataDigests,
 		},
 	}
 }
@@ -0,0 +1,267 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// defaultImageRegistry is the registry of image references that don't
+// name one.
+const defaultImageRegistry = "docker.io"
+
+// ChangeImageRegistryAction rewrites the container images of pods and
+// workloads if a mapping for their registry is found in the plugin's config
+// map.
+type ChangeImageRegistryAction struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+}
+
+// NewChangeImageRegistryAction is the constructor for ChangeImageRegistryAction.
+func NewChangeImageRegistryAction(logger logrus.FieldLogger, configMapClient corev1client.ConfigMapInterface) *ChangeImageRegistryAction {
+	return &ChangeImageRegistryAction{
+		logger:          logger,
+		configMapClient: configMapClient,
+	}
+}
+
+// AppliesTo returns the resources that ChangeImageRegistryAction should
+// be run for.
+func (a *ChangeImageRegistryAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"pods", "deployments", "statefulsets", "daemonsets", "replicasets", "jobs", "cronjobs"},
+	}, nil
+}
+
+// Execute rewrites the images of the item's containers, init containers and
+// ephemeral containers that match a mapping in the config map for the plugin.
+func (a *ChangeImageRegistryAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing ChangeImageRegistryAction")
+	defer a.logger.Info("Done executing ChangeImageRegistryAction")
+
+	a.logger.Debug("Getting plugin config")
+	config, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/change-image-registry", a.configMapClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil || len(config.Data) == 0 {
+		a.logger.Debug("No image registry mappings found")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	mappings, err := parseImageRegistryMappings(config.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	log := a.logger.WithFields(map[string]interface{}{
+		"kind":      obj.GetKind(),
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	})
+
+	podSpecPath := []string{"spec", "template", "spec"}
+	switch obj.GetKind() {
+	case "Pod":
+		podSpecPath = []string{"spec"}
+	case "CronJob":
+		podSpecPath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	}
+
+	for _, field := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		fieldPath := append(append([]string{}, podSpecPath...), field)
+
+		containers, found, err := unstructured.NestedSlice(obj.UnstructuredContent(), fieldPath...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting item's %s", strings.Join(fieldPath, "."))
+		}
+		if !found {
+			continue
+		}
+
+		var changed bool
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			image, ok := container["image"].(string)
+			if !ok || image == "" {
+				continue
+			}
+
+			newImage, ok := mappings.rewrite(image)
+			if !ok {
+				continue
+			}
+
+			log.Infof("Rewriting image of container %v from %s to %s", container["name"], image, newImage)
+			container["image"] = newImage
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+		if err := unstructured.SetNestedSlice(obj.UnstructuredContent(), containers, fieldPath...); err != nil {
+			return nil, errors.Wrapf(err, "unable to set item's %s", strings.Join(fieldPath, "."))
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// imageRegistryMapping rewrites the image references that start with its
+// registry, which may contain wildcards, and path prefix.
+type imageRegistryMapping struct {
+	registry   string
+	pathPrefix string
+	to         string
+}
+
+type imageRegistryMappings []imageRegistryMapping
+
+// parseImageRegistryMappings parses the mappings from a plugin config map.
+// The keys are ignored, and each value is in the form FROM=TO, where FROM is
+// a registry, optionally followed by a path prefix, and TO is what replaces
+// it. Mappings without wildcards are tried before ones with wildcards, and
+// longer mappings are tried before shorter ones, so the most specific
+// mapping that matches an image is used.
+func parseImageRegistryMappings(data map[string]string) (imageRegistryMappings, error) {
+	var mappings imageRegistryMappings
+	for key, value := range data {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid image registry mapping %s: %q must be in the form FROM=TO", key, value)
+		}
+
+		from, to := strings.TrimSpace(parts[0]), strings.TrimSuffix(strings.TrimSpace(parts[1]), "/")
+		if from == "" || to == "" {
+			return nil, errors.Errorf("invalid image registry mapping %s: %q must be in the form FROM=TO", key, value)
+		}
+
+		registry, pathPrefix := strings.TrimSuffix(from, "/"), ""
+		if i := strings.Index(registry, "/"); i >= 0 {
+			registry, pathPrefix = registry[:i], registry[i+1:]
+		}
+		if registry == "index.docker.io" {
+			registry = defaultImageRegistry
+		}
+		// matching the pattern against itself makes path.Match read all of it,
+		// so malformed patterns are always reported.
+		if _, err := path.Match(registry, registry); err != nil {
+			return nil, errors.Wrapf(err, "invalid image registry mapping %s: invalid registry %q", key, registry)
+		}
+
+		mappings = append(mappings, imageRegistryMapping{
+			registry:   registry,
+			pathPrefix: pathPrefix,
+			to:         to,
+		})
+	}
+
+	sort.Slice(mappings, func(i, j int) bool {
+		iWildcard, jWildcard := strings.Contains(mappings[i].registry, "*"), strings.Contains(mappings[j].registry, "*")
+		if iWildcard != jWildcard {
+			return !iWildcard
+		}
+		iFrom, jFrom := mappings[i].from(), mappings[j].from()
+		if len(iFrom) != len(jFrom) {
+			return len(iFrom) > len(jFrom)
+		}
+		return iFrom < jFrom
+	})
+
+	return mappings, nil
+}
+
+func (m imageRegistryMapping) from() string {
+	if m.pathPrefix == "" {
+		return m.registry
+	}
+	return m.registry + "/" + m.pathPrefix
+}
+
+// rewrite returns image rewritten by the first mapping that matches it, and
+// whether any mapping did.
+func (m imageRegistryMappings) rewrite(image string) (string, bool) {
+	registry, remainder := splitImageRegistry(image)
+
+	for _, mapping := range m {
+		if ok, _ := path.Match(mapping.registry, registry); !ok {
+			continue
+		}
+
+		if mapping.pathPrefix == "" {
+			return mapping.to + "/" + remainder, true
+		}
+
+		// the path prefix only matches whole path components, or the whole
+		// repository before its tag or digest.
+		if !strings.HasPrefix(remainder, mapping.pathPrefix) {
+			continue
+		}
+		rest := remainder[len(mapping.pathPrefix):]
+		if rest == "" || strings.ContainsAny(rest[:1], "/:@") {
+			return mapping.to + rest, true
+		}
+	}
+
+	return image, false
+}
+
+// splitImageRegistry splits an image reference into its registry and the
+// rest of the reference. The first path component is only a registry if it
+// contains a "." or ":", or is "localhost", so references without one, such
+// as "nginx:1.19", are in the default registry, and official images in the
+// default registry get its "library/" path prefix.
+func splitImageRegistry(image string) (string, string) {
+	registry, remainder := defaultImageRegistry, image
+	if i := strings.Index(image, "/"); i >= 0 {
+		first := image[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			registry, remainder = first, image[i+1:]
+		}
+	}
+
+	if registry == "index.docker.io" {
+		registry = defaultImageRegistry
+	}
+
+	if registry == defaultImageRegistry && !strings.Contains(remainder, "/") {
+		remainder = "library/" + remainder
+	}
+
+	return registry, remainder
+}
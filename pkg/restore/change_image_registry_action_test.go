@@ -0,0 +1,175 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestImageRegistryMappingsRewrite(t *testing.T) {
+	mappings, err := parseImageRegistryMappings(map[string]string{
+		"docker-hub":    "docker.io=registry.internal/docker-hub",
+		"library":       "docker.io/library=registry.internal/library-mirror",
+		"gcr":           "*.gcr.io=registry.internal/gcr",
+		"gcr-team":      "*.gcr.io/team=registry.internal/team",
+		"quay-team":     "quay.io/team/=registry.internal/quay-team/",
+		"local-default": "localhost:5000=registry.internal/local",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		image     string
+		want      string
+		rewritten bool
+	}{
+		{image: "nginx", want: "registry.internal/library-mirror/nginx", rewritten: true},
+		{image: "nginx:1.19", want: "registry.internal/library-mirror/nginx:1.19", rewritten: true},
+		{image: "docker.io/library/nginx@sha256:abcd", want: "registry.internal/library-mirror/nginx@sha256:abcd", rewritten: true},
+		{image: "bitnami/redis:6.0", want: "registry.internal/docker-hub/bitnami/redis:6.0", rewritten: true},
+		{image: "index.docker.io/bitnami/redis", want: "registry.internal/docker-hub/bitnami/redis", rewritten: true},
+		{image: "eu.gcr.io/project/app:v1", want: "registry.internal/gcr/project/app:v1", rewritten: true},
+		{image: "us.gcr.io/team/app:v1", want: "registry.internal/team/app:v1", rewritten: true},
+		{image: "us.gcr.io/team-2/app:v1", want: "registry.internal/gcr/team-2/app:v1", rewritten: true},
+		{image: "quay.io/team/app", want: "registry.internal/quay-team/app", rewritten: true},
+		{image: "quay.io/other/app", want: "quay.io/other/app", rewritten: false},
+		{image: "localhost:5000/app", want: "registry.internal/local/app", rewritten: true},
+		{image: "gcr.io/project/app", want: "gcr.io/project/app", rewritten: false},
+		{image: "registry.internal/app", want: "registry.internal/app", rewritten: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.image, func(t *testing.T) {
+			got, rewritten := mappings.rewrite(tc.image)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.rewritten, rewritten)
+		})
+	}
+}
+
+func TestParseImageRegistryMappingsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		wantErr string
+	}{
+		{
+			name:    "mapping without a TO is invalid",
+			data:    map[string]string{"docker-hub": "docker.io"},
+			wantErr: `invalid image registry mapping docker-hub: "docker.io" must be in the form FROM=TO`,
+		},
+		{
+			name:    "mapping with an empty FROM is invalid",
+			data:    map[string]string{"docker-hub": "=registry.internal"},
+			wantErr: `invalid image registry mapping docker-hub: "=registry.internal" must be in the form FROM=TO`,
+		},
+		{
+			name:    "mapping with a malformed wildcard registry is invalid",
+			data:    map[string]string{"gcr": "[.gcr.io=registry.internal"},
+			wantErr: `invalid image registry mapping gcr: invalid registry "[.gcr.io": syntax error in pattern`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseImageRegistryMappings(tc.data)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestChangeImageRegistryActionExecute(t *testing.T) {
+	pluginConfig := builder.ForConfigMap("velero", "change-image-registry").
+		ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-image-registry", "RestoreItemAction")).
+		Data("docker-hub", "docker.io=registry.internal").
+		Result()
+
+	tests := []struct {
+		name      string
+		item      string
+		configMap *corev1api.ConfigMap
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "pod's containers, init containers and ephemeral containers are rewritten",
+			item:      `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"},"spec":{"initContainers":[{"name":"init","image":"busybox"}],"containers":[{"name":"app","image":"nginx:1.19"},{"name":"sidecar","image":"quay.io/team/sidecar"}],"ephemeralContainers":[{"name":"debug","image":"alpine"}]}}`,
+			configMap: pluginConfig,
+			want:      `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"},"spec":{"initContainers":[{"name":"init","image":"registry.internal/library/busybox"}],"containers":[{"name":"app","image":"registry.internal/library/nginx:1.19"},{"name":"sidecar","image":"quay.io/team/sidecar"}],"ephemeralContainers":[{"name":"debug","image":"registry.internal/library/alpine"}]}}`,
+		},
+		{
+			name:      "deployment's pod template is rewritten",
+			item:      `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"template":{"spec":{"containers":[{"name":"app","image":"bitnami/redis:6.0"}]}}}}`,
+			configMap: pluginConfig,
+			want:      `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"template":{"spec":{"containers":[{"name":"app","image":"registry.internal/bitnami/redis:6.0"}]}}}}`,
+		},
+		{
+			name:      "cron job's job template is rewritten",
+			item:      `{"apiVersion":"batch/v1beta1","kind":"CronJob","metadata":{"namespace":"ns-1","name":"cronjob-1"},"spec":{"jobTemplate":{"spec":{"template":{"spec":{"containers":[{"name":"app","image":"docker.io/library/busybox"}]}}}}}}`,
+			configMap: pluginConfig,
+			want:      `{"apiVersion":"batch/v1beta1","kind":"CronJob","metadata":{"namespace":"ns-1","name":"cronjob-1"},"spec":{"jobTemplate":{"spec":{"template":{"spec":{"containers":[{"name":"app","image":"registry.internal/library/busybox"}]}}}}}}`,
+		},
+		{
+			name: "when no config map exists for the plugin, the item is returned as-is",
+			item: `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"},"spec":{"containers":[{"name":"app","image":"nginx"}]}}`,
+			want: `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"},"spec":{"containers":[{"name":"app","image":"nginx"}]}}`,
+		},
+		{
+			name: "when a mapping in the plugin config map is invalid, an error is returned",
+			item: `{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"},"spec":{"containers":[{"name":"app","image":"nginx"}]}}`,
+			configMap: builder.ForConfigMap("velero", "change-image-registry").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-image-registry", "RestoreItemAction")).
+				Data("docker-hub", "docker.io").
+				Result(),
+			wantErr: `invalid image registry mapping docker-hub: "docker.io" must be in the form FROM=TO`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			a := NewChangeImageRegistryAction(logrus.StandardLogger(), clientset.CoreV1().ConfigMaps("velero"))
+
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{
+				Item: velerotest.UnstructuredOrDie(tc.item),
+			})
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, velerotest.UnstructuredOrDie(tc.want), res.UpdatedItem)
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -30,6 +32,19 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
+const (
+	// defaultStorageClassMapping can be used as the new storage class name in
+	// the plugin's config map to map a storage class to the cluster's default
+	// storage class. It isn't a valid storage class name, so it can't clash
+	// with one.
+	defaultStorageClassMapping = "velero.io/default-storage-class"
+
+	// isDefaultStorageClassAnnotation and betaIsDefaultStorageClassAnnotation
+	// mark a storage class as the cluster's default.
+	isDefaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaIsDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
 // ChangeStorageClassAction updates a PV or PVC's storage class name
 // if a mapping is found in the plugin's config map.
 type ChangeStorageClassAction struct {
@@ -59,14 +74,14 @@ func (a *ChangeStorageClassAction) AppliesTo() (velero.ResourceSelector, error)
 	}, nil
 }
 
-// Execute updates the item's spec.storageClassName if a mapping is found
-// in the config map for the plugin.
+// Execute updates the item's spec.storageClassName, and its beta storage class
+// annotation, if a mapping is found in the config map for the plugin.
 func (a *ChangeStorageClassAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 	a.logger.Info("Executing ChangeStorageClassAction")
 	defer a.logger.Info("Done executing ChangeStorageClassAction")
 
 	a.logger.Debug("Getting plugin config")
-	config, err := getPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/change-storage-class", a.configMapClient)
+	config, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/change-storage-class", a.configMapClient)
 	if err != nil {
 		return nil, err
 	}
@@ -93,27 +108,98 @@ func (a *ChangeStorageClassAction) Execute(input *velero.RestoreItemActionExecut
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting item's spec.storageClassName")
 	}
-	if storageClass == "" {
+
+	// the storage class can also be set with the beta annotation, which older
+	// items may still use instead of the field, and which takes precedence
+	// over it.
+	annotations := obj.GetAnnotations()
+	betaStorageClass := annotations[corev1api.BetaStorageClassAnnotation]
+
+	// an item with no storage class is left as-is: an empty storage class
+	// means the volume isn't dynamically provisioned, and a claim without one
+	// is given the cluster's default storage class when it's created.
+	if storageClass == "" && betaStorageClass == "" {
 		log.Debug("Item has no storage class specified")
 		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
 	}
 
-	newStorageClass, ok := config.Data[storageClass]
+	if storageClass != "" {
+		newStorageClass, err := a.mapStorageClass(log, config.Data, storageClass)
+		if err != nil {
+			return nil, err
+		}
+		if newStorageClass != "" {
+			log.Infof("Updating item's storage class name to %s", newStorageClass)
+
+			if err := unstructured.SetNestedField(obj.UnstructuredContent(), newStorageClass, "spec", "storageClassName"); err != nil {
+				return nil, errors.Wrap(err, "unable to set item's spec.storageClassName")
+			}
+		}
+	}
+
+	if betaStorageClass != "" {
+		newStorageClass, err := a.mapStorageClass(log, config.Data, betaStorageClass)
+		if err != nil {
+			return nil, err
+		}
+		if newStorageClass != "" {
+			log.Infof("Updating item's %s annotation to %s", corev1api.BetaStorageClassAnnotation, newStorageClass)
+
+			annotations[corev1api.BetaStorageClassAnnotation] = newStorageClass
+			obj.SetAnnotations(annotations)
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// mapStorageClass returns the storage class that a storage class is mapped to,
+// validating that it exists, or an empty string if there's no mapping for it.
+func (a *ChangeStorageClassAction) mapStorageClass(log logrus.FieldLogger, mappings map[string]string, storageClass string) (string, error) {
+	newStorageClass, ok := mappings[storageClass]
 	if !ok {
 		log.Debugf("No mapping found for storage class %s", storageClass)
-		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+		return "", nil
+	}
+
+	if newStorageClass == defaultStorageClassMapping {
+		return a.getDefaultStorageClass()
 	}
 
 	// validate that new storage class exists
 	if _, err := a.storageClassClient.Get(context.TODO(), newStorageClass, metav1.GetOptions{}); err != nil {
-		return nil, errors.Wrapf(err, "error getting storage class %s from API", newStorageClass)
+		return "", errors.Wrapf(err, "error getting storage class %s from API", newStorageClass)
 	}
 
-	log.Infof("Updating item's storage class name to %s", newStorageClass)
+	return newStorageClass, nil
+}
 
-	if err := unstructured.SetNestedField(obj.UnstructuredContent(), newStorageClass, "spec", "storageClassName"); err != nil {
-		return nil, errors.Wrap(err, "unable to set item's spec.storageClassName")
+// getDefaultStorageClass returns the name of the cluster's default storage
+// class, returning an error if there isn't exactly one.
+func (a *ChangeStorageClassAction) getDefaultStorageClass() (string, error) {
+	list, err := a.storageClassClient.List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "error listing storage classes from API")
 	}
 
-	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	var defaults []string
+	for i := range list.Items {
+		if isDefaultStorageClass(&list.Items[i]) {
+			defaults = append(defaults, list.Items[i].Name)
+		}
+	}
+
+	switch len(defaults) {
+	case 0:
+		return "", errors.New("no default storage class found in the cluster")
+	case 1:
+		return defaults[0], nil
+	default:
+		return "", errors.Errorf("found more than one default storage class in the cluster: %v", defaults)
+	}
+}
+
+func isDefaultStorageClass(storageClass *storagev1api.StorageClass) bool {
+	return storageClass.Annotations[isDefaultStorageClassAnnotation] == "true" ||
+		storageClass.Annotations[betaIsDefaultStorageClassAnnotation] == "true"
 }
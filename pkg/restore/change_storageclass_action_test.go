@@ -121,6 +121,67 @@ func TestChangeStorageClassActionExecute(t *testing.T) {
 				Result(),
 			want: builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("storageclass-1").Result(),
 		},
+		{
+			name: "a persistent volume claim's beta storage class annotation is mapped",
+			pvOrPVC: builder.ForPersistentVolumeClaim("velero", "pvc-1").
+				ObjectMeta(builder.WithAnnotations("volume.beta.kubernetes.io/storage-class", "storageclass-1")).
+				Result(),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "storageclass-2").
+				Result(),
+			storageClass: builder.ForStorageClass("storageclass-2").Result(),
+			want: builder.ForPersistentVolumeClaim("velero", "pvc-1").
+				ObjectMeta(builder.WithAnnotations("volume.beta.kubernetes.io/storage-class", "storageclass-2")).
+				Result(),
+		},
+		{
+			name: "a persistent volume's beta storage class annotation and storage class are both mapped",
+			pvOrPVC: builder.ForPersistentVolume("pv-1").
+				ObjectMeta(builder.WithAnnotations("volume.beta.kubernetes.io/storage-class", "storageclass-1")).
+				StorageClass("storageclass-1").
+				Result(),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "storageclass-2").
+				Result(),
+			storageClass: builder.ForStorageClass("storageclass-2").Result(),
+			want: builder.ForPersistentVolume("pv-1").
+				ObjectMeta(builder.WithAnnotations("volume.beta.kubernetes.io/storage-class", "storageclass-2")).
+				StorageClass("storageclass-2").
+				Result(),
+		},
+		{
+			name:    "a storage class mapped to the default storage class is changed to the cluster's default storage class",
+			pvOrPVC: builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("storageclass-1").Result(),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "velero.io/default-storage-class").
+				Result(),
+			storageClass: builder.ForStorageClass("storageclass-2").
+				ObjectMeta(builder.WithAnnotations("storageclass.kubernetes.io/is-default-class", "true")).
+				Result(),
+			want: builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("storageclass-2").Result(),
+		},
+		{
+			name:    "when a storage class is mapped to the default storage class and the cluster has none, an error is returned",
+			pvOrPVC: builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("storageclass-1").Result(),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "velero.io/default-storage-class").
+				Result(),
+			storageClass: builder.ForStorageClass("storageclass-2").Result(),
+			wantErr:      errors.New("no default storage class found in the cluster"),
+		},
+		{
+			name:    "when persistent volume claim has an empty storage class, the item is returned as-is",
+			pvOrPVC: builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("").Result(),
+			configMap: builder.ForConfigMap("velero", "change-storage-classs").
+				ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/change-storage-class", "RestoreItemAction")).
+				Data("storageclass-1", "storageclass-2").
+				Result(),
+			want: builder.ForPersistentVolumeClaim("velero", "pvc-1").StorageClass("").Result(),
+		},
 		{
 			name:    "when persistent volume's storage class is mapped to a nonexistent storage class, an error is returned",
 			pvOrPVC: builder.ForPersistentVolume("pv-1").StorageClass("storageclass-1").Result(),
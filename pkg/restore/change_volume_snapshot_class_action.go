@@ -0,0 +1,198 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"sort"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
+	snapshotv1beta1client "github.com/kubernetes-csi/external-snapshotter/client/v4/clientset/versioned/typed/volumesnapshot/v1beta1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// csiDriverNameAnnotation is set on backed up volume snapshots by the
+	// CSI plugin to the name of the CSI driver that took them.
+	csiDriverNameAnnotation = "velero.io/csi-driver-name"
+
+	// isDefaultSnapshotClassAnnotation marks a volume snapshot class as the
+	// default for its CSI driver.
+	isDefaultSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+// ChangeVolumeSnapshotClassAction updates a CSI volume snapshot or volume
+// snapshot content's volume snapshot class to one for its CSI driver in the
+// cluster, using the driver's mapping in the plugin's config map, or the
+// driver's default volume snapshot class if it has no mapping.
+type ChangeVolumeSnapshotClassAction struct {
+	logger              logrus.FieldLogger
+	configMapClient     corev1client.ConfigMapInterface
+	snapshotClassClient snapshotv1beta1client.VolumeSnapshotClassInterface
+}
+
+// NewChangeVolumeSnapshotClassAction is the constructor for
+// ChangeVolumeSnapshotClassAction.
+func NewChangeVolumeSnapshotClassAction(
+	logger logrus.FieldLogger,
+	configMapClient corev1client.ConfigMapInterface,
+	snapshotClassClient snapshotv1beta1client.VolumeSnapshotClassInterface,
+) *ChangeVolumeSnapshotClassAction {
+	return &ChangeVolumeSnapshotClassAction{
+		logger:              logger,
+		configMapClient:     configMapClient,
+		snapshotClassClient: snapshotClassClient,
+	}
+}
+
+// AppliesTo returns the resources that ChangeVolumeSnapshotClassAction
+// should be run for.
+func (a *ChangeVolumeSnapshotClassAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{kuberesource.VolumeSnapshots.String(), kuberesource.VolumeSnapshotContents.String()},
+	}, nil
+}
+
+// Execute updates the item's spec.volumeSnapshotClassName to the volume
+// snapshot class for its CSI driver, if a config map exists for the plugin.
+func (a *ChangeVolumeSnapshotClassAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing ChangeVolumeSnapshotClassAction")
+	defer a.logger.Info("Done executing ChangeVolumeSnapshotClassAction")
+
+	a.logger.Debug("Getting plugin config")
+	config, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/change-volume-snapshot-class", a.configMapClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// the config map can be empty, to use the CSI drivers' default volume
+	// snapshot classes without mapping any of them.
+	if config == nil {
+		a.logger.Debug("No volume snapshot class mappings found")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	log := a.logger.WithFields(map[string]interface{}{
+		"kind":      obj.GetKind(),
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	})
+
+	snapshotClass, _, err := unstructured.NestedString(obj.UnstructuredContent(), "spec", "volumeSnapshotClassName")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting item's spec.volumeSnapshotClassName")
+	}
+	if snapshotClass == "" {
+		log.Debug("Item has no volume snapshot class specified")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	// volume snapshot contents name their driver, and volume snapshots are
+	// annotated with it by the CSI plugin when they're backed up.
+	var driver string
+	if obj.GetKind() == "VolumeSnapshotContent" {
+		if driver, _, err = unstructured.NestedString(obj.UnstructuredContent(), "spec", "driver"); err != nil {
+			return nil, errors.Wrap(err, "error getting item's spec.driver")
+		}
+	} else {
+		driver = obj.GetAnnotations()[csiDriverNameAnnotation]
+	}
+	if driver == "" {
+		log.Debug("Item's CSI driver is unknown, leaving its volume snapshot class as-is")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	newSnapshotClass, err := a.getSnapshotClass(log, config.Data, driver, snapshotClass)
+	if err != nil {
+		return nil, err
+	}
+	if newSnapshotClass != snapshotClass {
+		log.Infof("Updating item's volume snapshot class name to %s", newSnapshotClass)
+
+		if err := unstructured.SetNestedField(obj.UnstructuredContent(), newSnapshotClass, "spec", "volumeSnapshotClassName"); err != nil {
+			return nil, errors.Wrap(err, "unable to set item's spec.volumeSnapshotClassName")
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// getSnapshotClass returns the volume snapshot class to use for a CSI
+// driver's snapshot that had snapshotClass in the backup: the class the
+// driver is mapped to, validating that it exists and is for the driver, or
+// otherwise snapshotClass if it exists for the driver, or the driver's
+// default volume snapshot class.
+func (a *ChangeVolumeSnapshotClassAction) getSnapshotClass(log logrus.FieldLogger, mappings map[string]string, driver, snapshotClass string) (string, error) {
+	if mapped, ok := mappings[driver]; ok {
+		class, err := a.snapshotClassClient.Get(context.TODO(), mapped, metav1.GetOptions{})
+		if err != nil {
+			return "", errors.Wrapf(err, "error getting volume snapshot class %s mapped to CSI driver %s from API", mapped, driver)
+		}
+		if class.Driver != driver {
+			return "", errors.Errorf("volume snapshot class %s mapped to CSI driver %s is for CSI driver %s", mapped, driver, class.Driver)
+		}
+		return mapped, nil
+	}
+
+	log.Debugf("No mapping found for CSI driver %s", driver)
+
+	list, err := a.snapshotClassClient.List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "error listing volume snapshot classes from API")
+	}
+
+	var defaults []string
+	for i := range list.Items {
+		class := &list.Items[i]
+		if class.Driver != driver {
+			continue
+		}
+		if class.Name == snapshotClass {
+			return snapshotClass, nil
+		}
+		if isDefaultSnapshotClass(class) {
+			defaults = append(defaults, class.Name)
+		}
+	}
+
+	sort.Strings(defaults)
+	switch len(defaults) {
+	case 0:
+		return "", errors.Errorf("no volume snapshot class found for CSI driver %s: %s doesn't exist for it and it has no default volume snapshot class, map the driver to a volume snapshot class in the plugin's config map", driver, snapshotClass)
+	case 1:
+		return defaults[0], nil
+	default:
+		return "", errors.Errorf("found more than one default volume snapshot class for CSI driver %s: %v", driver, defaults)
+	}
+}
+
+func isDefaultSnapshotClass(class *snapshotv1beta1api.VolumeSnapshotClass) bool {
+	return class.Annotations[isDefaultSnapshotClassAnnotation] == "true"
+}
@@ -0,0 +1,188 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	snapshotv1beta1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1beta1"
+	snapshotfake "github.com/kubernetes-csi/external-snapshotter/client/v4/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestChangeVolumeSnapshotClassActionExecute(t *testing.T) {
+	snapshotClass := func(name, driver string, isDefault bool) runtime.Object {
+		class := &snapshotv1beta1api.VolumeSnapshotClass{
+			ObjectMeta:     metav1.ObjectMeta{Name: name},
+			Driver:         driver,
+			DeletionPolicy: snapshotv1beta1api.VolumeSnapshotContentDelete,
+		}
+		if isDefault {
+			class.Annotations = map[string]string{"snapshot.storage.kubernetes.io/is-default-class": "true"}
+		}
+		return class
+	}
+
+	volumeSnapshot := func(class string) *unstructured.Unstructured {
+		return velerotest.UnstructuredOrDie(`{
+			"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+			"kind": "VolumeSnapshot",
+			"metadata": {"namespace": "ns-1", "name": "vs-1", "annotations": {"velero.io/csi-driver-name": "ebs.csi.aws.com"}},
+			"spec": {"volumeSnapshotClassName": "` + class + `", "source": {"volumeSnapshotContentName": "vsc-1"}}
+		}`)
+	}
+
+	volumeSnapshotContent := func(class string) *unstructured.Unstructured {
+		return velerotest.UnstructuredOrDie(`{
+			"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+			"kind": "VolumeSnapshotContent",
+			"metadata": {"name": "vsc-1"},
+			"spec": {"driver": "ebs.csi.aws.com", "volumeSnapshotClassName": "` + class + `", "deletionPolicy": "Retain"}
+		}`)
+	}
+
+	configMap := func(data ...string) *corev1api.ConfigMap {
+		return builder.ForConfigMap("velero", "change-volume-snapshot-class").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", "velero.io/change-volume-snapshot-class", "RestoreItemAction")).
+			Data(data...).
+			Result()
+	}
+
+	tests := []struct {
+		name            string
+		item            *unstructured.Unstructured
+		configMap       *corev1api.ConfigMap
+		snapshotClasses []runtime.Object
+		want            *unstructured.Unstructured
+		wantErr         string
+	}{
+		{
+			name:            "without a config map for the plugin, the item is returned as-is",
+			item:            volumeSnapshot("old-class"),
+			snapshotClasses: []runtime.Object{snapshotClass("ebs", "ebs.csi.aws.com", true)},
+			want:            volumeSnapshot("old-class"),
+		},
+		{
+			name:            "volume snapshot's class is changed to the one its driver is mapped to",
+			item:            volumeSnapshot("old-class"),
+			configMap:       configMap("ebs.csi.aws.com", "ebs-snapshots"),
+			snapshotClasses: []runtime.Object{snapshotClass("ebs-snapshots", "ebs.csi.aws.com", false), snapshotClass("ebs", "ebs.csi.aws.com", true)},
+			want:            volumeSnapshot("ebs-snapshots"),
+		},
+		{
+			name:            "volume snapshot content's class is changed to the one its driver is mapped to",
+			item:            volumeSnapshotContent("old-class"),
+			configMap:       configMap("ebs.csi.aws.com", "ebs-snapshots"),
+			snapshotClasses: []runtime.Object{snapshotClass("ebs-snapshots", "ebs.csi.aws.com", false)},
+			want:            volumeSnapshotContent("ebs-snapshots"),
+		},
+		{
+			name:      "driver mapped to a nonexistent class returns an error",
+			item:      volumeSnapshot("old-class"),
+			configMap: configMap("ebs.csi.aws.com", "ebs-snapshots"),
+			wantErr:   `error getting volume snapshot class ebs-snapshots mapped to CSI driver ebs.csi.aws.com from API: volumesnapshotclasses.snapshot.storage.k8s.io "ebs-snapshots" not found`,
+		},
+		{
+			name:            "driver mapped to another driver's class returns an error",
+			item:            volumeSnapshot("old-class"),
+			configMap:       configMap("ebs.csi.aws.com", "gce-snapshots"),
+			snapshotClasses: []runtime.Object{snapshotClass("gce-snapshots", "pd.csi.storage.gke.io", false)},
+			wantErr:         "volume snapshot class gce-snapshots mapped to CSI driver ebs.csi.aws.com is for CSI driver pd.csi.storage.gke.io",
+		},
+		{
+			name:            "unmapped driver keeps the original class if it exists for the driver",
+			item:            volumeSnapshot("old-class"),
+			configMap:       configMap(),
+			snapshotClasses: []runtime.Object{snapshotClass("old-class", "ebs.csi.aws.com", false), snapshotClass("ebs", "ebs.csi.aws.com", true)},
+			want:            volumeSnapshot("old-class"),
+		},
+		{
+			name:            "unmapped driver falls back to its default class",
+			item:            volumeSnapshot("old-class"),
+			configMap:       configMap(),
+			snapshotClasses: []runtime.Object{snapshotClass("old-class", "pd.csi.storage.gke.io", false), snapshotClass("gce", "pd.csi.storage.gke.io", true), snapshotClass("ebs", "ebs.csi.aws.com", true)},
+			want:            volumeSnapshot("ebs"),
+		},
+		{
+			name:            "unmapped driver without a default class returns an error",
+			item:            volumeSnapshot("old-class"),
+			configMap:       configMap(),
+			snapshotClasses: []runtime.Object{snapshotClass("ebs", "ebs.csi.aws.com", false)},
+			wantErr:         "no volume snapshot class found for CSI driver ebs.csi.aws.com: old-class doesn't exist for it and it has no default volume snapshot class, map the driver to a volume snapshot class in the plugin's config map",
+		},
+		{
+			name:            "unmapped driver with more than one default class returns an error",
+			item:            volumeSnapshot("old-class"),
+			configMap:       configMap(),
+			snapshotClasses: []runtime.Object{snapshotClass("ebs-1", "ebs.csi.aws.com", true), snapshotClass("ebs-2", "ebs.csi.aws.com", true)},
+			wantErr:         "found more than one default volume snapshot class for CSI driver ebs.csi.aws.com: [ebs-1 ebs-2]",
+		},
+		{
+			name: "volume snapshot without a driver annotation is returned as-is",
+			item: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+				"kind": "VolumeSnapshot",
+				"metadata": {"namespace": "ns-1", "name": "vs-1"},
+				"spec": {"volumeSnapshotClassName": "old-class"}
+			}`),
+			configMap: configMap("ebs.csi.aws.com", "ebs-snapshots"),
+			want: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+				"kind": "VolumeSnapshot",
+				"metadata": {"namespace": "ns-1", "name": "vs-1"},
+				"spec": {"volumeSnapshotClassName": "old-class"}
+			}`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			snapshotClient := snapshotfake.NewSimpleClientset(tc.snapshotClasses...)
+			a := NewChangeVolumeSnapshotClassAction(
+				logrus.StandardLogger(),
+				clientset.CoreV1().ConfigMaps("velero"),
+				snapshotClient.SnapshotV1beta1().VolumeSnapshotClasses(),
+			)
+
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{Item: tc.item})
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res.UpdatedItem)
+		})
+	}
+}
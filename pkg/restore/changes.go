@@ -0,0 +1,158 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// MaxChangeDiffSize is the largest diff, in bytes, that's recorded for
+	// an updated item. Larger diffs are truncated.
+	MaxChangeDiffSize = 4 * 1024
+
+	// MaxChangedItems is the most items that are recorded in a change
+	// summary. The items after them are only counted.
+	MaxChangedItems = 10000
+)
+
+// ChangeAction is what a restore did with an item.
+type ChangeAction string
+
+const (
+	// ChangeActionCreated means the item didn't exist in the cluster and
+	// was created.
+	ChangeActionCreated ChangeAction = "created"
+
+	// ChangeActionUpdated means the item existed in the cluster and was
+	// patched.
+	ChangeActionUpdated ChangeAction = "updated"
+
+	// ChangeActionSkipped means the item existed in the cluster and was
+	// left as it was.
+	ChangeActionSkipped ChangeAction = "skipped"
+)
+
+// ChangedItem describes what a restore did with a single item.
+type ChangedItem struct {
+	// Resource is the item's group/resource, formatted as "resource.group".
+	Resource string `json:"resource"`
+
+	// Namespace is the (remapped) namespace the item was restored into, or
+	// empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the item's name in the cluster.
+	Name string `json:"name"`
+
+	// Action is what was done with the item.
+	Action ChangeAction `json:"action"`
+
+	// Reason explains why the item was skipped.
+	Reason string `json:"reason,omitempty"`
+
+	// Diff is a JSON merge patch from the item as it was in the cluster
+	// before it was updated to the item as it was after, excluding its
+	// metadata other than its labels and annotations, and its status.
+	Diff string `json:"diff,omitempty"`
+
+	// DiffTruncated is true if Diff was longer than MaxChangeDiffSize and
+	// was truncated, in which case it isn't valid JSON.
+	DiffTruncated bool `json:"diffTruncated,omitempty"`
+}
+
+// ChangeSummary is a summary of what a restore did with the items that it
+// created, or that already existed in the cluster. It's stored in the
+// restore's results file under the "changes" key.
+type ChangeSummary struct {
+	Created int           `json:"created"`
+	Updated int           `json:"updated"`
+	Skipped int           `json:"skipped"`
+	Items   []ChangedItem `json:"items,omitempty"`
+
+	// ItemsTruncated is true if more than MaxChangedItems items were
+	// recorded, in which case only the first ones are in Items.
+	ItemsTruncated bool `json:"itemsTruncated,omitempty"`
+}
+
+// Add records an item in the summary and updates the counts.
+func (s *ChangeSummary) Add(item ChangedItem) {
+	switch item.Action {
+	case ChangeActionCreated:
+		s.Created++
+	case ChangeActionUpdated:
+		s.Updated++
+	case ChangeActionSkipped:
+		s.Skipped++
+	}
+
+	if len(s.Items) >= MaxChangedItems {
+		s.ItemsTruncated = true
+		return
+	}
+	if len(item.Diff) > MaxChangeDiffSize {
+		item.Diff = item.Diff[:MaxChangeDiffSize]
+		item.DiffTruncated = true
+	}
+	s.Items = append(s.Items, item)
+}
+
+// recordChange records what was done with an item in the change summary.
+// It's a no-op if the restore doesn't record changes.
+func (ctx *restoreContext) recordChange(groupResource schema.GroupResource, namespace, name string, action ChangeAction, reason string) {
+	if ctx.changeSummary == nil {
+		return
+	}
+
+	ctx.changeSummary.Add(ChangedItem{
+		Resource:  groupResource.String(),
+		Namespace: namespace,
+		Name:      name,
+		Action:    action,
+		Reason:    reason,
+	})
+}
+
+// recordUpdate records an item that was patched in the change summary, with
+// a diff of the item before it was patched, which must have been reset to
+// the fields that are restored, and after. It's a no-op if the restore
+// doesn't record changes.
+func (ctx *restoreContext) recordUpdate(groupResource schema.GroupResource, namespace string, before, after *unstructured.Unstructured) {
+	if ctx.changeSummary == nil {
+		return
+	}
+
+	item := ChangedItem{
+		Resource:  groupResource.String(),
+		Namespace: namespace,
+		Name:      before.GetName(),
+		Action:    ChangeActionUpdated,
+	}
+
+	if after != nil {
+		if after, err := resetMetadataAndStatus(after.DeepCopy()); err != nil {
+			ctx.log.WithError(err).Warnf("Error recording the changes to %s", getResourceID(groupResource, namespace, before.GetName()))
+		} else if diff, err := generatePatch(before, after); err != nil {
+			ctx.log.WithError(err).Warnf("Error recording the changes to %s", getResourceID(groupResource, namespace, before.GetName()))
+		} else {
+			item.Diff = string(diff)
+		}
+	}
+
+	ctx.changeSummary.Add(item)
+}
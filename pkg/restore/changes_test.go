@@ -0,0 +1,63 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeSummaryAdd(t *testing.T) {
+	items := []ChangedItem{
+		{Resource: "namespaces", Name: "ns-1", Action: ChangeActionCreated},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Action: ChangeActionCreated},
+		{Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: ChangeActionUpdated, Diff: `{"data":{"key":"val"}}`},
+		{Resource: "configmaps", Namespace: "ns-1", Name: "cm-2", Action: ChangeActionSkipped, Reason: "already exists in the cluster and is the same as the backed up version"},
+	}
+
+	summary := new(ChangeSummary)
+	for _, item := range items {
+		summary.Add(item)
+	}
+
+	assert.Equal(t, &ChangeSummary{
+		Created: 2,
+		Updated: 1,
+		Skipped: 1,
+		Items:   items,
+	}, summary)
+}
+
+func TestChangeSummaryAddBounds(t *testing.T) {
+	summary := new(ChangeSummary)
+
+	// diffs are truncated to the maximum size.
+	summary.Add(ChangedItem{Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: ChangeActionUpdated, Diff: strings.Repeat("a", MaxChangeDiffSize+1)})
+	assert.Len(t, summary.Items[0].Diff, MaxChangeDiffSize)
+	assert.True(t, summary.Items[0].DiffTruncated)
+
+	// items after the maximum number are only counted.
+	for i := 1; i <= MaxChangedItems; i++ {
+		summary.Add(ChangedItem{Resource: "pods", Namespace: "ns-1", Name: "pod", Action: ChangeActionCreated})
+	}
+	assert.Len(t, summary.Items, MaxChangedItems)
+	assert.True(t, summary.ItemsTruncated)
+	assert.Equal(t, MaxChangedItems, summary.Created)
+	assert.Equal(t, 1, summary.Updated)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// checkpointInterval is how often a restore's checkpoint is written while
+// items are being restored.
+const checkpointInterval = 30 * time.Second
+
+// Checkpoint records the items a restore has successfully applied to the
+// cluster, so a later restore of the same backup can resume from it.
+type Checkpoint struct {
+	Items []CheckpointItem `json:"items"`
+}
+
+// CheckpointItem identifies an item that a restore applied to the cluster.
+type CheckpointItem struct {
+	// Resource is the item's group-resource.
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item was restored into, after any
+	// namespace mapping. It's empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the item's name in the backup.
+	Name string `json:"name"`
+
+	// RestoredName is the name the item was restored with, if it's different
+	// from its name in the backup, e.g. for renamed persistent volumes.
+	RestoredName string `json:"restoredName,omitempty"`
+}
+
+func (i CheckpointItem) key() velero.ResourceIdentifier {
+	return velero.ResourceIdentifier{
+		GroupResource: schema.ParseGroupResource(i.Resource),
+		Namespace:     i.Namespace,
+		Name:          i.Name,
+	}
+}
+
+func (i CheckpointItem) restoredName() string {
+	if i.RestoredName != "" {
+		return i.RestoredName
+	}
+	return i.Name
+}
+
+// resumedItems returns the items in checkpoint, keyed the same way as a
+// restore's restored items.
+func resumedItems(checkpoint *Checkpoint) map[velero.ResourceIdentifier]CheckpointItem {
+	items := make(map[velero.ResourceIdentifier]CheckpointItem)
+	if checkpoint == nil {
+		return items
+	}
+	for _, item := range checkpoint.Items {
+		items[item.key()] = item
+	}
+	return items
+}
+
+// alreadyRestored returns whether the item was applied by the restore being
+// resumed and still exists in the cluster. Items that have been deleted since
+// are restored again.
+func (ctx *restoreContext) alreadyRestored(itemKey velero.ResourceIdentifier, resourceClient client.Dynamic) bool {
+	item, ok := ctx.resumedItems[itemKey]
+	if !ok {
+		return false
+	}
+
+	name := item.restoredName()
+	_, err := resourceClient.Get(name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		ctx.log.Infof("%s was restored by restore %s but no longer exists in the cluster, restoring it again", name, ctx.restore.Status.ResumedFrom)
+		return false
+	case err != nil:
+		ctx.log.WithError(err).Warnf("Error checking whether %s still exists in the cluster, restoring it again", name)
+		return false
+	}
+
+	// PVCs that are restored later need to reference the PV's restored name.
+	if itemKey.GroupResource == kuberesource.PersistentVolumes && name != itemKey.Name {
+		ctx.renamedPVs[itemKey.Name] = name
+	}
+
+	// carry the item over, so this restore can be resumed too.
+	ctx.recordRestored(itemKey, name)
+	return true
+}
+
+// recordRestored adds an item that was applied to the cluster to the
+// restore's checkpoint, and writes the checkpoint if it hasn't been written in
+// the last checkpointInterval.
+func (ctx *restoreContext) recordRestored(itemKey velero.ResourceIdentifier, restoredName string) {
+	ctx.recordRestoredWorkload(itemKey, restoredName)
+	ctx.recordItemToValidate(itemKey, restoredName)
+
+	if ctx.dryRun || ctx.putCheckpoint == nil {
+		return
+	}
+
+	item := CheckpointItem{
+		Resource:  itemKey.GroupResource.String(),
+		Namespace: itemKey.Namespace,
+		Name:      itemKey.Name,
+	}
+	if restoredName != itemKey.Name {
+		item.RestoredName = restoredName
+	}
+	ctx.checkpoint.Items = append(ctx.checkpoint.Items, item)
+
+	if time.Since(ctx.lastCheckpoint) >= ctx.checkpointInterval {
+		ctx.writeCheckpoint()
+	}
+}
+
+// writeCheckpoint writes the restore's checkpoint. Failing to write it doesn't
+// fail the restore, it only means less of it can be skipped if it's resumed.
+func (ctx *restoreContext) writeCheckpoint() {
+	if ctx.dryRun || ctx.putCheckpoint == nil {
+		return
+	}
+
+	if err := ctx.putCheckpoint(ctx.checkpoint); err != nil {
+		ctx.log.WithError(err).Warn("Error writing restore checkpoint")
+	}
+	ctx.lastCheckpoint = time.Now()
+}
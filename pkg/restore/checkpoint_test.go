@@ -0,0 +1,157 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestAlreadyRestored(t *testing.T) {
+	pod := velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"}
+	pv := velero.ResourceIdentifier{GroupResource: kuberesource.PersistentVolumes, Name: "pv-1"}
+
+	tests := []struct {
+		name               string
+		checkpoint         *Checkpoint
+		itemKey            velero.ResourceIdentifier
+		getName            string
+		getErr             error
+		want               bool
+		wantCheckpoint     []CheckpointItem
+		wantRenamedPVs     map[string]string
+		expectClientCalled bool
+	}{
+		{
+			name:           "item that isn't in the checkpoint is restored",
+			checkpoint:     &Checkpoint{Items: []CheckpointItem{{Resource: "pods", Namespace: "ns-1", Name: "pod-2"}}},
+			itemKey:        pod,
+			want:           false,
+			wantRenamedPVs: map[string]string{},
+		},
+		{
+			name:               "item in the checkpoint that still exists is skipped",
+			checkpoint:         &Checkpoint{Items: []CheckpointItem{{Resource: "pods", Namespace: "ns-1", Name: "pod-1"}}},
+			itemKey:            pod,
+			getName:            "pod-1",
+			want:               true,
+			wantCheckpoint:     []CheckpointItem{{Resource: "pods", Namespace: "ns-1", Name: "pod-1"}},
+			wantRenamedPVs:     map[string]string{},
+			expectClientCalled: true,
+		},
+		{
+			name:               "item in the checkpoint that has been deleted is restored again",
+			checkpoint:         &Checkpoint{Items: []CheckpointItem{{Resource: "pods", Namespace: "ns-1", Name: "pod-1"}}},
+			itemKey:            pod,
+			getName:            "pod-1",
+			getErr:             apierrors.NewNotFound(kuberesource.Pods, "pod-1"),
+			want:               false,
+			wantRenamedPVs:     map[string]string{},
+			expectClientCalled: true,
+		},
+		{
+			name:               "renamed persistent volume is looked up by its restored name",
+			checkpoint:         &Checkpoint{Items: []CheckpointItem{{Resource: "persistentvolumes", Name: "pv-1", RestoredName: "renamed-pv-1"}}},
+			itemKey:            pv,
+			getName:            "renamed-pv-1",
+			want:               true,
+			wantCheckpoint:     []CheckpointItem{{Resource: "persistentvolumes", Name: "pv-1", RestoredName: "renamed-pv-1"}},
+			wantRenamedPVs:     map[string]string{"pv-1": "renamed-pv-1"},
+			expectClientCalled: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceClient := &velerotest.FakeDynamicClient{}
+			defer resourceClient.AssertExpectations(t)
+			if tc.expectClientCalled {
+				resourceClient.On("Get", tc.getName, mock.Anything).Return(&unstructured.Unstructured{}, tc.getErr)
+			}
+
+			ctx := &restoreContext{
+				restore:       builder.ForRestore("velero", "restore-2").ResumedFrom("restore-1").Result(),
+				log:           velerotest.NewLogger(),
+				resumedItems:  resumedItems(tc.checkpoint),
+				renamedPVs:    make(map[string]string),
+				checkpoint:    new(Checkpoint),
+				putCheckpoint: func(*Checkpoint) error { return nil },
+			}
+
+			assert.Equal(t, tc.want, ctx.alreadyRestored(tc.itemKey, resourceClient))
+			assert.Equal(t, tc.wantCheckpoint, ctx.checkpoint.Items)
+			assert.Equal(t, tc.wantRenamedPVs, ctx.renamedPVs)
+		})
+	}
+}
+
+func TestRecordRestored(t *testing.T) {
+	pod := velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"}
+
+	t.Run("restored items are added to the checkpoint, which is written once the interval has passed", func(t *testing.T) {
+		var written int
+		ctx := &restoreContext{
+			log:        velerotest.NewLogger(),
+			checkpoint: new(Checkpoint),
+			putCheckpoint: func(*Checkpoint) error {
+				written++
+				return nil
+			},
+		}
+
+		ctx.recordRestored(pod, "pod-1")
+		assert.Equal(t, []CheckpointItem{{Resource: "pods", Namespace: "ns-1", Name: "pod-1"}}, ctx.checkpoint.Items)
+		assert.Equal(t, 1, written)
+
+		// the checkpoint was just written, so it isn't written again until
+		// the interval has passed.
+		ctx.checkpointInterval = checkpointInterval
+		ctx.recordRestored(velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-2"}, "pod-2")
+		assert.Len(t, ctx.checkpoint.Items, 2)
+		assert.Equal(t, 1, written)
+
+		ctx.writeCheckpoint()
+		assert.Equal(t, 2, written)
+	})
+
+	t.Run("nothing is checkpointed during a dry run", func(t *testing.T) {
+		var written int
+		ctx := &restoreContext{
+			log:        velerotest.NewLogger(),
+			checkpoint: new(Checkpoint),
+			putCheckpoint: func(*Checkpoint) error {
+				written++
+				return nil
+			},
+			dryRun: true,
+		}
+
+		ctx.recordRestored(pod, "pod-1")
+		ctx.writeCheckpoint()
+		assert.Empty(t, ctx.checkpoint.Items)
+		assert.Zero(t, written)
+	})
+}
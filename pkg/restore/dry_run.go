@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+// DryRunAction is what a restore would have done with an item, had it not
+// been a dry run.
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means the item doesn't exist in the cluster and
+	// would have been created.
+	DryRunActionCreate DryRunAction = "create"
+
+	// DryRunActionUpdate means the item exists in the cluster and would have
+	// been patched.
+	DryRunActionUpdate DryRunAction = "update"
+
+	// DryRunActionSkip means the item would not have been restored.
+	DryRunActionSkip DryRunAction = "skip"
+
+	// DryRunActionRecreate means the namespace exists in the cluster and
+	// would have been deleted and created again.
+	DryRunActionRecreate DryRunAction = "recreate"
+)
+
+// DryRunItem describes what a dry-run restore would have done with a
+// single item.
+type DryRunItem struct {
+	// Resource is the item's group/resource, formatted as "resource.group".
+	Resource string `json:"resource"`
+
+	// Namespace is the (remapped) namespace the item would have been
+	// restored into, or empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the item's name.
+	Name string `json:"name"`
+
+	// Action is what would have been done with the item.
+	Action DryRunAction `json:"action"`
+
+	// Reason explains why the item would have been skipped.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DryRunSummary is a machine-readable summary of a dry-run restore. It's
+// stored in the restore's results file under the "dryRun" key.
+type DryRunSummary struct {
+	Created   int          `json:"created"`
+	Updated   int          `json:"updated"`
+	Skipped   int          `json:"skipped"`
+	Recreated int          `json:"recreated"`
+	Items     []DryRunItem `json:"items,omitempty"`
+}
+
+// Add records an item in the summary and updates the counts.
+func (s *DryRunSummary) Add(item DryRunItem) {
+	switch item.Action {
+	case DryRunActionCreate:
+		s.Created++
+	case DryRunActionUpdate:
+		s.Updated++
+	case DryRunActionSkip:
+		s.Skipped++
+	case DryRunActionRecreate:
+		s.Recreated++
+	}
+	s.Items = append(s.Items, item)
+}
+
+// recordDryRun records what would have been done with an item in the dry-run
+// summary. It's a no-op if the restore isn't a dry run.
+func (ctx *restoreContext) recordDryRun(groupResource schema.GroupResource, namespace, name string, action DryRunAction, reason string) {
+	if !ctx.dryRun {
+		return
+	}
+
+	ctx.log.Infof("Dry run: would %s %s", action, getResourceID(groupResource, namespace, name))
+	ctx.dryRunSummary.Add(DryRunItem{
+		Resource:  groupResource.String(),
+		Namespace: namespace,
+		Name:      name,
+		Action:    action,
+		Reason:    reason,
+	})
+}
+
+// dryRunCreate stands in for creating an item during a dry run. It returns an
+// AlreadyExists error if the item exists in the cluster, so that existing
+// items are handled the same way they would be during a real restore.
+func dryRunCreate(resourceClient client.Dynamic, groupResource schema.GroupResource, name string) error {
+	_, err := resourceClient.Get(name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return apierrors.NewAlreadyExists(groupResource, name)
+}
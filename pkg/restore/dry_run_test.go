@@ -0,0 +1,44 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunSummaryAdd(t *testing.T) {
+	items := []DryRunItem{
+		{Resource: "namespaces", Name: "ns-1", Action: DryRunActionCreate},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Action: DryRunActionCreate},
+		{Resource: "serviceaccounts", Namespace: "ns-1", Name: "sa-1", Action: DryRunActionUpdate},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-2", Action: DryRunActionSkip, Reason: "pod is a mirror pod"},
+	}
+
+	summary := new(DryRunSummary)
+	for _, item := range items {
+		summary.Add(item)
+	}
+
+	assert.Equal(t, &DryRunSummary{
+		Created: 2,
+		Updated: 1,
+		Skipped: 1,
+		Items:   items,
+	}, summary)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// updatesIfChanged returns whether the restore patches the existing items
+// that are different than the backed up version.
+func (ctx *restoreContext) updatesIfChanged() bool {
+	return ctx.restore.Spec.ExistingResourcePolicy == velerov1api.ExistingResourcePolicyUpdateIfChanged
+}
+
+// keyFields are the fields that hold the keys of the types whose keys are
+// merged into the existing items by the addMissingKeys and overwriteKeys
+// policies.
+var keyFields = map[schema.GroupResource][]string{
+	kuberesource.ConfigMaps: {"data", "binaryData"},
+	kuberesource.Secrets:    {"data"},
+}
+
+// mergesKeys returns whether the restore merges the keys of the backed up
+// version of the items of a resource into the existing items.
+func (ctx *restoreContext) mergesKeys(groupResource schema.GroupResource) bool {
+	if _, ok := keyFields[groupResource]; !ok {
+		return false
+	}
+	return isKeyMergePolicy(ctx.restore.Spec.ExistingResourcePolicy)
+}
+
+func isKeyMergePolicy(policy velerov1api.ExistingResourcePolicy) bool {
+	return policy == velerov1api.ExistingResourcePolicyAddMissingKeys || policy == velerov1api.ExistingResourcePolicyOverwriteKeys
+}
+
+// withMergedKeys returns a copy of fromCluster with the keys of obj, whose
+// keys have been merged with fromCluster's by the merge-keys restore item
+// action. Nothing else of obj is restored into the existing item.
+func withMergedKeys(fromCluster, obj *unstructured.Unstructured, groupResource schema.GroupResource) *unstructured.Unstructured {
+	desired := fromCluster.DeepCopy()
+	for _, field := range keyFields[groupResource] {
+		if keys, ok := obj.Object[field]; ok {
+			desired.Object[field] = runtime.DeepCopyJSONValue(keys)
+		}
+	}
+	return desired
+}
+
+// recordUnchanged records an item that already existed in the cluster and
+// was the same as the backed up version in the restore's results.
+func (ctx *restoreContext) recordUnchanged(namespace, resourceID string) {
+	ctx.log.Infof("Not patching %s because it's the same as the backed up version", resourceID)
+	ctx.unchangedItems.Add(namespace, errors.Errorf("%s already exists and is unchanged", resourceID))
+}
+
+// updateIfChanged patches an item that already exists in the cluster with
+// the backed up version's fields that it doesn't have or that have another
+// value, or records it as unchanged if there are none. fromCluster and obj
+// must both have been reset to the fields that are restored.
+func (ctx *restoreContext) updateIfChanged(
+	resourceClient client.Dynamic,
+	fromCluster, obj *unstructured.Unstructured,
+	groupResource schema.GroupResource,
+	namespace, resourceID string,
+	itemKey velero.ResourceIdentifier,
+) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	patchBytes, err := changedFieldsPatch(fromCluster, obj)
+	if err != nil {
+		warnings.Add(namespace, errors.Wrapf(err, "error generating patch for %s", resourceID))
+		return warnings, errs
+	}
+
+	if patchBytes == nil {
+		ctx.recordUnchanged(namespace, resourceID)
+		ctx.recordDryRun(groupResource, namespace, obj.GetName(), DryRunActionSkip, "already exists in the cluster and is the same as the backed up version")
+		ctx.recordChange(groupResource, namespace, obj.GetName(), ChangeActionSkipped, "already exists in the cluster and is the same as the backed up version")
+		ctx.recordRestored(itemKey, obj.GetName())
+		return warnings, errs
+	}
+
+	if ctx.dryRun {
+		ctx.recordDryRun(groupResource, namespace, obj.GetName(), DryRunActionUpdate, "already exists in the cluster and is different than the backed up version")
+		return warnings, errs
+	}
+
+	var timedOut bool
+	var patched *unstructured.Unstructured
+	ctx.unlocked(func() {
+		timedOut, err = ctx.retryItemCall(resourceID, "patch", func(patchCtx go_context.Context) error {
+			var err error
+			patched, err = resourceClient.Patch(patchCtx, obj.GetName(), patchBytes)
+			return err
+		})
+	})
+	switch {
+	case timedOut:
+		ctx.recordTimedOut(namespace, resourceID, "patch")
+		errs.Add(namespace, fmt.Errorf("error patching %s: %v", resourceID, err))
+	case err != nil:
+		warnings.Add(namespace, errors.Wrapf(err, "error patching %s", resourceID))
+	default:
+		ctx.log.Infof("%s already existed and was patched because it was different than the backed up version", resourceID)
+		ctx.recordUpdate(groupResource, namespace, fromCluster, patched)
+		ctx.recordRestored(itemKey, obj.GetName())
+	}
+	return warnings, errs
+}
+
+// changedFieldsPatch returns a merge patch of the fields of desired that
+// fromCluster doesn't have or that have another value, or nil if there are
+// none. Fields that only fromCluster has, such as ones defaulted by the API
+// server or set by controllers, aren't a difference and aren't removed.
+func changedFieldsPatch(fromCluster, desired *unstructured.Unstructured) ([]byte, error) {
+	patchBytes, err := generatePatch(fromCluster, desired)
+	if err != nil || patchBytes == nil {
+		return nil, err
+	}
+
+	patch := make(map[string]interface{})
+	if err := json.Unmarshal(patchBytes, &patch); err != nil {
+		return nil, errors.Wrap(err, "unable to decode merge patch")
+	}
+
+	removeDeletions(patch)
+	if len(patch) == 0 {
+		return nil, nil
+	}
+
+	res, err := json.Marshal(patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encode merge patch")
+	}
+	return res, nil
+}
+
+// removeDeletions removes the fields a merge patch deletes, and the objects
+// that are left empty.
+func removeDeletions(patch map[string]interface{}) {
+	for key, val := range patch {
+		switch val := val.(type) {
+		case nil:
+			delete(patch, key)
+		case map[string]interface{}:
+			removeDeletions(val)
+			if len(val) == 0 {
+				delete(patch, key)
+			}
+		}
+	}
+}
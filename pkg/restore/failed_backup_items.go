@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// failedBackupItems returns the errors of the items in a backup's item
+// status manifest, keyed by the items' group-resources, namespaces and names
+// in the backup.
+func failedBackupItems(manifest *itemstatus.Manifest) map[velero.ResourceIdentifier][]string {
+	items := make(map[velero.ResourceIdentifier][]string)
+	if manifest == nil {
+		return items
+	}
+	for _, item := range manifest.Items {
+		key := velero.ResourceIdentifier{
+			GroupResource: schema.ParseGroupResource(item.Resource),
+			Namespace:     item.Namespace,
+			Name:          item.Name,
+		}
+		items[key] = append(items[key], item.Errors...)
+	}
+	return items
+}
+
+// skipFailedBackupItem returns whether an item is left out of the restore
+// because it failed to be backed up. Items that failed are skipped if the
+// restore's SkipFailedBackupItems is set, and otherwise restored with a
+// warning, since they may be incomplete.
+func (ctx *restoreContext) skipFailedBackupItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, warnings *Result) bool {
+	// the backup's item status lists items under their namespaces in the
+	// backup, not the remapped ones.
+	backupErrs, ok := ctx.failedBackupItems[velero.ResourceIdentifier{
+		GroupResource: groupResource,
+		Namespace:     obj.GetNamespace(),
+		Name:          obj.GetName(),
+	}]
+	if !ok {
+		return false
+	}
+
+	resourceID := getResourceID(groupResource, namespace, obj.GetName())
+	if boolptr.IsSetToTrue(ctx.restore.Spec.SkipFailedBackupItems) {
+		ctx.log.Infof("Not restoring %s because it failed to be backed up", resourceID)
+		ctx.recordDryRun(groupResource, namespace, obj.GetName(), DryRunActionSkip, "item failed to be backed up")
+		warnings.Add(namespace, errors.Errorf("not restoring %s because it failed to be backed up: %s", resourceID, strings.Join(backupErrs, "; ")))
+		return true
+	}
+
+	warnings.Add(namespace, errors.Errorf("%s failed to be backed up and may be incomplete: %s", resourceID, strings.Join(backupErrs, "; ")))
+	return false
+}
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestSkipFailedBackupItem(t *testing.T) {
+	manifest := &itemstatus.Manifest{
+		Items: []itemstatus.Item{
+			{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Errors: []string{"error 1", "error 2"}},
+			{Resource: "persistentvolumes", Name: "pv-1", Errors: []string{"error 3"}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		manifest     *itemstatus.Manifest
+		obj          string
+		namespace    string
+		want         bool
+		wantWarnings Result
+	}{
+		{
+			name:      "items are restored if the backup doesn't have an item status manifest",
+			restore:   builder.ForRestore("velero", "restore-1").SkipFailedBackupItems(true).Result(),
+			obj:       `{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-1"}}`,
+			namespace: "ns-1",
+		},
+		{
+			name:      "item that didn't fail is restored",
+			restore:   builder.ForRestore("velero", "restore-1").SkipFailedBackupItems(true).Result(),
+			manifest:  manifest,
+			obj:       `{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-2"}}`,
+			namespace: "ns-1",
+		},
+		{
+			name:      "failed item is skipped with a warning",
+			restore:   builder.ForRestore("velero", "restore-1").SkipFailedBackupItems(true).Result(),
+			manifest:  manifest,
+			obj:       `{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-1"}}`,
+			namespace: "ns-1",
+			want:      true,
+			wantWarnings: Result{Namespaces: map[string][]string{
+				"ns-1": {"not restoring pods/ns-1/pod-1 because it failed to be backed up: error 1; error 2"},
+			}},
+		},
+		{
+			name:     "failed item is restored with a warning unless failed items are skipped",
+			restore:  builder.ForRestore("velero", "restore-1").Result(),
+			manifest: manifest,
+			obj:      `{"apiVersion": "v1", "kind": "PersistentVolume", "metadata": {"name": "pv-1"}}`,
+			want:     false,
+			wantWarnings: Result{Cluster: []string{
+				"persistentvolumes/pv-1 failed to be backed up and may be incomplete: error 3",
+			}},
+		},
+		{
+			name:      "failed item is matched by its namespace in the backup",
+			restore:   builder.ForRestore("velero", "restore-1").SkipFailedBackupItems(true).Result(),
+			manifest:  manifest,
+			obj:       `{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-1"}}`,
+			namespace: "ns-2",
+			want:      true,
+			wantWarnings: Result{Namespaces: map[string][]string{
+				"ns-2": {"not restoring pods/ns-2/pod-1 because it failed to be backed up: error 1; error 2"},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				restore:           tc.restore,
+				log:               velerotest.NewLogger(),
+				failedBackupItems: failedBackupItems(tc.manifest),
+			}
+
+			obj := velerotest.UnstructuredOrDie(tc.obj)
+			groupResource := kuberesource.Pods
+			if obj.GetKind() == "PersistentVolume" {
+				groupResource = kuberesource.PersistentVolumes
+			}
+
+			var warnings Result
+			assert.Equal(t, tc.want, ctx.skipFailedBackupItem(obj, groupResource, tc.namespace, &warnings))
+			assert.Equal(t, tc.wantWarnings, warnings)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// jobNameLabels are the labels that Kubernetes sets on a job's pod template
+// to the job's name.
+var jobNameLabels = []string{"job-name", "batch.kubernetes.io/job-name"}
+
+// generateNameResources are the resources whose items can be restored with a
+// generated name when their name is taken. Nothing else refers to their
+// items by name, so giving one a new name doesn't leave references to it
+// pointing at the item that was already in the cluster.
+var generateNameResources = []schema.GroupResource{
+	kuberesource.Jobs,
+	kuberesource.Pods,
+}
+
+// resolveGenerateNameResource returns the resource that can be restored with
+// a generated name that's named by resource, either as "resource.group" or,
+// since the supported resources' names are unique, as just "resource".
+func resolveGenerateNameResource(resource string) (schema.GroupResource, bool) {
+	gr := schema.ParseGroupResource(resource)
+	for _, supported := range generateNameResources {
+		if gr == supported || (gr.Group == "" && gr.Resource == supported.Resource) {
+			return supported, true
+		}
+	}
+	return schema.GroupResource{}, false
+}
+
+// ValidateGenerateNameOnConflict checks that a restore's
+// generateNameOnConflict only lists resources that can be restored with a
+// generated name.
+func ValidateGenerateNameOnConflict(resources []string) []error {
+	var errs []error
+	for _, resource := range resources {
+		if _, ok := resolveGenerateNameResource(resource); !ok {
+			errs = append(errs, errors.Errorf("%s can't be restored with a generated name, only jobs and pods can", resource))
+		}
+	}
+	return errs
+}
+
+// getGenerateNameResources returns the group resources of a restore's
+// generateNameOnConflict.
+func getGenerateNameResources(resources []string) sets.String {
+	res := sets.NewString()
+	for _, resource := range resources {
+		if gr, ok := resolveGenerateNameResource(resource); ok {
+			res.Insert(gr.String())
+		}
+	}
+	return res
+}
+
+// createWithGeneratedName creates an item whose name is taken in the cluster
+// with a name generated from its original name, and records the new name in
+// the restore's renamed items.
+func (ctx *restoreContext) createWithGeneratedName(resourceClient client.Dynamic, obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace, resourceID string) (*unstructured.Unstructured, error) {
+	renamed := obj.DeepCopy()
+	renamed.SetName("")
+	renamed.SetGenerateName(obj.GetName() + "-")
+
+	// Kubernetes only sets the new job's name in its pod template's labels
+	// if they don't already have the original name.
+	if groupResource == kuberesource.Jobs {
+		for _, label := range jobNameLabels {
+			unstructured.RemoveNestedField(renamed.Object, "spec", "template", "metadata", "labels", label)
+		}
+	}
+
+	var createdObj *unstructured.Unstructured
+	timedOut, err := ctx.retryItemCall(resourceID, "create", func(createCtx go_context.Context) error {
+		var err error
+		createdObj, err = resourceClient.Create(createCtx, renamed)
+		return err
+	})
+	if timedOut {
+		ctx.recordTimedOut(namespace, resourceID, "create")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.log.Infof("Restored %s as %s because its name is already taken", resourceID, createdObj.GetName())
+	ctx.renamedItems.Add(namespace, errors.Errorf("%s restored as %s", resourceID, createdObj.GetName()))
+	return createdObj, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateGenerateNameOnConflict(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []string
+		wantErrs  []string
+		want      []string
+	}{
+		{
+			name: "no resources",
+		},
+		{
+			name:      "supported resources with and without their group",
+			resources: []string{"pods", "jobs.batch", "jobs"},
+			want:      []string{"jobs.batch", "pods"},
+		},
+		{
+			name:      "unsupported resources are rejected",
+			resources: []string{"pods", "deployments.apps", "jobs.example.com"},
+			wantErrs: []string{
+				"deployments.apps can't be restored with a generated name, only jobs and pods can",
+				"jobs.example.com can't be restored with a generated name, only jobs and pods can",
+			},
+			want: []string{"pods"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotErrs []string
+			for _, err := range ValidateGenerateNameOnConflict(tc.resources) {
+				gotErrs = append(gotErrs, err.Error())
+			}
+			assert.Equal(t, tc.wantErrs, gotErrs)
+
+			got := getGenerateNameResources(tc.resources).List()
+			if len(tc.want) == 0 {
+				assert.Empty(t, got)
+			} else {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCreateWithGeneratedName(t *testing.T) {
+	job := velerotest.UnstructuredOrDie(`{
+		"apiVersion": "batch/v1",
+		"kind": "Job",
+		"metadata": {"namespace": "ns-1", "name": "migrate"},
+		"spec": {
+			"template": {
+				"metadata": {"labels": {"app": "db", "job-name": "migrate", "batch.kubernetes.io/job-name": "migrate"}}
+			}
+		}
+	}`)
+
+	resourceClient := new(velerotest.FakeDynamicClient)
+	createdJob := job.DeepCopy()
+	createdJob.SetName("migrate-x7k2p")
+	resourceClient.On("Create", mock.Anything).Return(createdJob, nil)
+
+	ctx := &restoreContext{
+		log:           velerotest.NewLogger(),
+		timedOutItems: new(Result),
+		renamedItems:  new(Result),
+	}
+
+	created, err := ctx.createWithGeneratedName(resourceClient, job, kuberesource.Jobs, "ns-1", "jobs.batch/ns-1/migrate")
+	require.NoError(t, err)
+	assert.Equal(t, "migrate-x7k2p", created.GetName())
+
+	// the job is created with a generated name and without the labels that
+	// would select the existing job's pods.
+	require.Len(t, resourceClient.Calls, 1)
+	renamed := resourceClient.Calls[0].Arguments.Get(0).(*unstructured.Unstructured)
+	assert.Equal(t, "", renamed.GetName())
+	assert.Equal(t, "migrate-", renamed.GetGenerateName())
+	labels, _, _ := unstructured.NestedStringMap(renamed.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "db"}, labels)
+
+	// the original item isn't modified.
+	assert.Equal(t, "migrate", job.GetName())
+
+	assert.Equal(t, map[string][]string{"ns-1": {"jobs.batch/ns-1/migrate restored as migrate-x7k2p"}}, ctx.renamedItems.Namespaces)
+	assert.Empty(t, ctx.timedOutItems.Namespaces)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// itemRetryBackoff returns the backoff between attempts at a call made while
+// restoring an item, which waits twice as long after each failed attempt,
+// and makes at most retries+1 attempts.
+func itemRetryBackoff(retries int, backoff time.Duration) wait.Backoff {
+	if retries < 0 {
+		retries = 0
+	}
+	return wait.Backoff{
+		Duration: backoff,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    retries + 1,
+	}
+}
+
+// isRetryableItemError returns whether a call made while restoring an item
+// failed with an error that may not happen again: a conflict, too many
+// requests, or a server error. Other errors, such as invalid or forbidden
+// items, fail the item immediately.
+func isRetryableItemError(err error) bool {
+	if apierrors.IsConflict(err) || apierrors.IsTooManyRequests(err) {
+		return true
+	}
+
+	if status, ok := err.(apierrors.APIStatus); ok {
+		return status.Status().Code >= 500
+	}
+	return false
+}
+
+// retryItemCall calls fn, with a context that's cancelled once the resource
+// timeout has passed, until it succeeds, fails with an error that isn't
+// retryable or times out, or the item retries are used up. It returns fn's
+// last error and whether it timed out, and logs how many times a call that
+// was retried was.
+func (ctx *restoreContext) retryItemCall(resourceID, op string, fn func(callCtx go_context.Context) error) (bool, error) {
+	var attempts int
+	var timedOut bool
+
+	err := retry.OnError(itemRetryBackoff(ctx.itemRetries, ctx.itemRetryBackoff), func(err error) bool {
+		return !timedOut && isRetryableItemError(err)
+	}, func() error {
+		attempts++
+		if attempts > 1 {
+			ctx.log.Infof("Retrying %s of %s, attempt %d", op, resourceID, attempts)
+		}
+
+		callCtx, cancel := ctx.withResourceTimeout()
+		defer cancel()
+
+		err := fn(callCtx)
+		timedOut = isTimedOut(callCtx, err)
+		return err
+	})
+
+	if attempts > 1 {
+		if err != nil {
+			ctx.log.Warnf("Retried %s of %s %d times, giving up: %v", op, resourceID, attempts-1, err)
+		} else {
+			ctx.log.Infof("Retried %s of %s %d times, succeeded", op, resourceID, attempts-1)
+		}
+	}
+	return timedOut, err
+}
@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestIsRetryableItemError(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "conflict is retryable", err: apierrors.NewConflict(pods, "pod-1", errors.New("conflict")), want: true},
+		{name: "too many requests is retryable", err: apierrors.NewTooManyRequests("slow down", 1), want: true},
+		{name: "internal error is retryable", err: apierrors.NewInternalError(errors.New("etcd unavailable")), want: true},
+		{name: "service unavailable is retryable", err: apierrors.NewServiceUnavailable("unavailable"), want: true},
+		{name: "timeout is retryable", err: apierrors.NewTimeoutError("timed out", 1), want: true},
+		{name: "invalid item isn't retryable", err: apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "pod-1", nil), want: false},
+		{name: "forbidden isn't retryable", err: apierrors.NewForbidden(pods, "pod-1", errors.New("forbidden")), want: false},
+		{name: "non-API error isn't retryable", err: errors.New("connection refused"), want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isRetryableItemError(tc.err))
+		})
+	}
+}
+
+func TestRetryItemCall(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+	conflict := apierrors.NewConflict(pods, "pod-1", errors.New("conflict"))
+	forbidden := apierrors.NewForbidden(pods, "pod-1", errors.New("forbidden"))
+
+	tests := []struct {
+		name         string
+		retries      int
+		errs         []error
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "call that succeeds isn't retried",
+			retries:      3,
+			errs:         []error{nil},
+			wantAttempts: 1,
+		},
+		{
+			name:         "retryable error is retried until the call succeeds",
+			retries:      3,
+			errs:         []error{conflict, conflict, nil},
+			wantAttempts: 3,
+		},
+		{
+			name:         "retryable error is returned once the retries are used up",
+			retries:      2,
+			errs:         []error{conflict, conflict, conflict, nil},
+			wantAttempts: 3,
+			wantErr:      conflict,
+		},
+		{
+			name:         "error that isn't retryable is returned immediately",
+			retries:      3,
+			errs:         []error{forbidden, nil},
+			wantAttempts: 1,
+			wantErr:      forbidden,
+		},
+		{
+			name:         "retries can be disabled",
+			retries:      0,
+			errs:         []error{conflict, nil},
+			wantAttempts: 1,
+			wantErr:      conflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				log:              velerotest.NewLogger(),
+				itemRetries:      tc.retries,
+				itemRetryBackoff: time.Millisecond,
+			}
+
+			var attempts int
+			timedOut, err := ctx.retryItemCall("pods/ns-1/pod-1", "create", func(go_context.Context) error {
+				err := tc.errs[attempts]
+				attempts++
+				return err
+			})
+
+			assert.Equal(t, tc.wantAttempts, attempts)
+			assert.Equal(t, tc.wantErr, err)
+			assert.False(t, timedOut)
+		})
+	}
+}
+
+func TestRetryItemCallTimedOut(t *testing.T) {
+	ctx := &restoreContext{
+		log:              velerotest.NewLogger(),
+		resourceTimeout:  10 * time.Millisecond,
+		itemRetries:      3,
+		itemRetryBackoff: time.Millisecond,
+	}
+
+	// a call that's cancelled by the resource timeout isn't retried, even
+	// if it fails with a retryable error.
+	var attempts int
+	timedOut, err := ctx.retryItemCall("pods/ns-1/pod-1", "create", func(callCtx go_context.Context) error {
+		attempts++
+		<-callCtx.Done()
+		return apierrors.NewTimeoutError("timed out", 1)
+	})
+
+	assert.Equal(t, 1, attempts)
+	assert.True(t, timedOut)
+	assert.Error(t, err)
+}
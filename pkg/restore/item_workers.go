@@ -0,0 +1,122 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// serialResources are the resources whose items are always restored one at
+// a time: namespaces and custom resource definitions, which other items are
+// restored into or are instances of, and persistent volumes and their
+// claims, since how a claim is restored depends on how its volume was.
+var serialResources = sets.NewString(
+	kuberesource.Namespaces.String(),
+	kuberesource.CustomResourceDefinitions.String(),
+	kuberesource.PersistentVolumes.String(),
+	kuberesource.PersistentVolumeClaims.String(),
+)
+
+// restoresItemsConcurrently returns whether the items of a resource are
+// restored by more than one worker.
+func (ctx *restoreContext) restoresItemsConcurrently(groupResource schema.GroupResource) bool {
+	return ctx.itemWorkers > 1 && !serialResources.Has(groupResource.String())
+}
+
+// unlocked calls fn without holding the item lock while the items of a
+// resource are restored concurrently, so that the workers' calls to the
+// cluster and to restore item actions aren't serialized. fn must not change
+// the restore context's state, or read any that other items' restores change.
+func (ctx *restoreContext) unlocked(fn func()) {
+	if !ctx.concurrentItems {
+		fn()
+		return
+	}
+
+	ctx.itemLock.Unlock()
+	defer ctx.itemLock.Lock()
+	fn()
+}
+
+// itemWorkerPool restores the items of a resource on a bounded number of
+// concurrent workers. An item is restored holding the item lock, which is
+// only released while waiting for the cluster or plugins, so items are restored
+// concurrently without the restore context's state being shared unsafely.
+// A nil pool restores items serially, as they're submitted.
+type itemWorkerPool struct {
+	lock  *sync.Mutex
+	queue chan func()
+	wg    sync.WaitGroup
+}
+
+// newItemWorkerPool returns an itemWorkerPool that restores up to workers
+// items at a time. The pool must be waited on to release its workers.
+func newItemWorkerPool(lock *sync.Mutex, workers int) *itemWorkerPool {
+	p := &itemWorkerPool{
+		lock:  lock,
+		queue: make(chan func()),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer p.wg.Done()
+
+			for restore := range p.queue {
+				p.locked(restore)
+			}
+		}()
+	}
+
+	return p
+}
+
+// submit queues an item's restore, blocking until a worker is free.
+func (p *itemWorkerPool) submit(restore func()) {
+	if p == nil {
+		restore()
+		return
+	}
+	p.queue <- restore
+}
+
+// locked calls fn holding the item lock.
+func (p *itemWorkerPool) locked(fn func()) {
+	if p == nil {
+		fn()
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	fn()
+}
+
+// wait blocks until all submitted items have been restored.
+func (p *itemWorkerPool) wait() {
+	if p == nil {
+		return
+	}
+
+	close(p.queue)
+	p.wg.Wait()
+}
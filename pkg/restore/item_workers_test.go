@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestRestoresItemsConcurrently(t *testing.T) {
+	ctx := &restoreContext{itemWorkers: 1}
+	assert.False(t, ctx.restoresItemsConcurrently(kuberesource.Secrets))
+
+	ctx.itemWorkers = 4
+	assert.True(t, ctx.restoresItemsConcurrently(kuberesource.Secrets))
+	assert.True(t, ctx.restoresItemsConcurrently(schema.GroupResource{Group: "example.com", Resource: "widgets"}))
+	for _, groupResource := range []schema.GroupResource{
+		kuberesource.Namespaces,
+		kuberesource.CustomResourceDefinitions,
+		kuberesource.PersistentVolumes,
+		kuberesource.PersistentVolumeClaims,
+	} {
+		assert.False(t, ctx.restoresItemsConcurrently(groupResource), groupResource.String())
+	}
+}
+
+func TestItemWorkerPool(t *testing.T) {
+	const workers = 3
+
+	ctx := &restoreContext{itemWorkers: workers, concurrentItems: true}
+	pool := newItemWorkerPool(&ctx.itemLock, workers)
+
+	// restored counts the items restored, and is only changed holding the
+	// item lock, while the calls to the cluster are made without it.
+	var restored int
+	var inFlight, maxInFlight int32
+	for i := 0; i < 12; i++ {
+		pool.submit(func() {
+			restored++
+
+			ctx.unlocked(func() {
+				n := atomic.AddInt32(&inFlight, 1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				atomic.AddInt32(&inFlight, -1)
+			})
+		})
+	}
+	pool.wait()
+
+	assert.Equal(t, 12, restored)
+	assert.True(t, maxInFlight > 1, "items weren't restored concurrently")
+	assert.True(t, maxInFlight <= workers, "%d items were restored concurrently by %d workers", maxInFlight, workers)
+}
+
+func TestNilItemWorkerPool(t *testing.T) {
+	var pool *itemWorkerPool
+
+	// a nil pool restores items serially, as they're submitted.
+	var restored []int
+	for i := 0; i < 3; i++ {
+		i := i
+		pool.submit(func() {
+			restored = append(restored, i)
+		})
+		assert.Len(t, restored, i+1)
+	}
+	pool.wait()
+
+	assert.Equal(t, []int{0, 1, 2}, restored)
+}
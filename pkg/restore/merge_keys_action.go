@@ -0,0 +1,189 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// MergeKeysAction merges the keys of the existing ConfigMaps and Secrets
+// into the ones being restored, for restores whose existing resource policy
+// is addMissingKeys or overwriteKeys, so that only the result of the merge
+// is restored into the existing items.
+type MergeKeysAction struct {
+	logger           logrus.FieldLogger
+	configMapsGetter corev1client.ConfigMapsGetter
+	secretsGetter    corev1client.SecretsGetter
+}
+
+// NewMergeKeysAction is the constructor for MergeKeysAction.
+func NewMergeKeysAction(logger logrus.FieldLogger, configMapsGetter corev1client.ConfigMapsGetter, secretsGetter corev1client.SecretsGetter) *MergeKeysAction {
+	return &MergeKeysAction{
+		logger:           logger,
+		configMapsGetter: configMapsGetter,
+		secretsGetter:    secretsGetter,
+	}
+}
+
+// AppliesTo returns the resources that MergeKeysAction should be run for.
+func (a *MergeKeysAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"configmaps", "secrets"},
+	}, nil
+}
+
+// Execute merges the keys of the existing item, if there is one, into the
+// item's. With addMissingKeys, the keys that both have keep the existing
+// item's values, and with overwriteKeys they get the item's values. The keys
+// that only the existing item has are kept with either policy.
+func (a *MergeKeysAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	policy := input.Restore.Spec.ExistingResourcePolicy
+	if !isKeyMergePolicy(policy) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	// the item's namespace isn't remapped until after the restore item
+	// actions have run.
+	namespace := obj.GetNamespace()
+	if mapped, ok := input.Restore.Spec.NamespaceMapping[namespace]; ok {
+		namespace = mapped
+	}
+
+	log := a.logger.WithFields(logrus.Fields{
+		"kind":      obj.GetKind(),
+		"namespace": namespace,
+		"name":      obj.GetName(),
+	})
+
+	existing, err := a.getExisting(obj.GetKind(), namespace, obj.GetName())
+	if apierrors.IsNotFound(err) {
+		log.Debug("Item doesn't exist in the cluster, so there are no keys to merge")
+		return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting existing item")
+	}
+
+	groupResource := kuberesource.ConfigMaps
+	if obj.GetKind() == "Secret" {
+		groupResource = kuberesource.Secrets
+	}
+
+	for _, field := range keyFields[groupResource] {
+		merged, conflicts, err := mergeKeys(existing[field], obj.Object[field], policy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error merging %s", field)
+		}
+		if merged == nil {
+			continue
+		}
+		obj.Object[field] = merged
+
+		if len(conflicts) == 0 {
+			continue
+		}
+		if policy == velerov1api.ExistingResourcePolicyOverwriteKeys {
+			log.Infof("Overwriting the existing values of %s keys %v", field, conflicts)
+		} else {
+			log.Infof("Keeping the existing values of %s keys %v", field, conflicts)
+		}
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// getExisting returns the content of the existing ConfigMap or Secret.
+func (a *MergeKeysAction) getExisting(kind, namespace, name string) (map[string]interface{}, error) {
+	var existing runtime.Object
+	var err error
+	switch kind {
+	case "ConfigMap":
+		existing, err = a.configMapsGetter.ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	case "Secret":
+		existing, err = a.secretsGetter.Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	default:
+		return nil, errors.Errorf("unexpected kind %s", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to convert existing %s to unstructured", kind)
+	}
+	return content, nil
+}
+
+// mergeKeys merges the keys of a field of the existing item and the item
+// being restored, returning nil if neither has any, and the sorted keys that
+// both have with different values.
+func mergeKeys(existing, restored interface{}, policy velerov1api.ExistingResourcePolicy) (map[string]interface{}, []string, error) {
+	existingKeys, ok := existing.(map[string]interface{})
+	if existing != nil && !ok {
+		return nil, nil, errors.Errorf("existing item's keys are of unexpected type %T", existing)
+	}
+	restoredKeys, ok := restored.(map[string]interface{})
+	if restored != nil && !ok {
+		return nil, nil, errors.Errorf("item's keys are of unexpected type %T", restored)
+	}
+	if len(existingKeys) == 0 && len(restoredKeys) == 0 {
+		return nil, nil, nil
+	}
+
+	merged := make(map[string]interface{}, len(existingKeys)+len(restoredKeys))
+	for key, val := range existingKeys {
+		merged[key] = val
+	}
+
+	var conflicts []string
+	for key, val := range restoredKeys {
+		existingVal, exists := existingKeys[key]
+		if !exists {
+			merged[key] = val
+			continue
+		}
+		if existingVal == val {
+			continue
+		}
+		conflicts = append(conflicts, key)
+		if policy == velerov1api.ExistingResourcePolicyOverwriteKeys {
+			merged[key] = val
+		}
+	}
+	sort.Strings(conflicts)
+
+	return merged, conflicts, nil
+}
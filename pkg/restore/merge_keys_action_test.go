@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestMergeKeysActionExecute(t *testing.T) {
+	tests := []struct {
+		name     string
+		restore  *velerov1api.Restore
+		existing runtime.Object
+		item     runtime.Object
+		want     runtime.Object
+	}{
+		{
+			name:     "keys aren't merged with other policies",
+			restore:  builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(velerov1api.ExistingResourcePolicyUpdateIfChanged).Result(),
+			existing: builder.ForConfigMap("ns-1", "cm-1").Data("a", "1", "c", "3").Result(),
+			item:     builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2").Result(),
+			want:     builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2").Result(),
+		},
+		{
+			name:    "item that doesn't exist isn't modified",
+			restore: builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(velerov1api.ExistingResourcePolicyAddMissingKeys).Result(),
+			item:    builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2").Result(),
+			want:    builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2").Result(),
+		},
+		{
+			name:     "addMissingKeys keeps the existing values of the keys that both have",
+			restore:  builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(velerov1api.ExistingResourcePolicyAddMissingKeys).Result(),
+			existing: builder.ForConfigMap("ns-1", "cm-1").Data("a", "1", "c", "3").Result(),
+			item:     builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2").Result(),
+			want:     builder.ForConfigMap("ns-1", "cm-1").Data("a", "1", "b", "2", "c", "3").Result(),
+		},
+		{
+			name:     "overwriteKeys restores the backed up values of the keys that both have",
+			restore:  builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(velerov1api.ExistingResourcePolicyOverwriteKeys).Result(),
+			existing: builder.ForConfigMap("ns-1", "cm-1").Data("a", "1", "c", "3").Result(),
+			item:     builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2").Result(),
+			want:     builder.ForConfigMap("ns-1", "cm-1").Data("a", "2", "b", "2", "c", "3").Result(),
+		},
+		{
+			name:     "secret in a mapped namespace is merged with the existing secret in the mapped namespace",
+			restore:  builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(velerov1api.ExistingResourcePolicyAddMissingKeys).NamespaceMappings("ns-1", "ns-2").Result(),
+			existing: builder.ForSecret("ns-2", "secret-1").Data(map[string][]byte{"a": []byte("1"), "c": []byte("3")}).Result(),
+			item:     builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"a": []byte("2"), "b": []byte("2")}).Result(),
+			want:     builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"a": []byte("1"), "b": []byte("2"), "c": []byte("3")}).Result(),
+		},
+		{
+			name:     "existing item's keys are kept if the item has none",
+			restore:  builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(velerov1api.ExistingResourcePolicyOverwriteKeys).Result(),
+			existing: builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"a": []byte("1")}).Result(),
+			item:     builder.ForSecret("ns-1", "secret-1").Result(),
+			want:     builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"a": []byte("1")}).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			if tc.existing != nil {
+				switch existing := tc.existing.(type) {
+				case *corev1api.ConfigMap:
+					_, err := clientset.CoreV1().ConfigMaps(existing.Namespace).Create(context.TODO(), existing, metav1.CreateOptions{})
+					require.NoError(t, err)
+				case *corev1api.Secret:
+					_, err := clientset.CoreV1().Secrets(existing.Namespace).Create(context.TODO(), existing, metav1.CreateOptions{})
+					require.NoError(t, err)
+				}
+			}
+
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.item)
+			require.NoError(t, err)
+			want, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.want)
+			require.NoError(t, err)
+
+			a := NewMergeKeysAction(velerotest.NewLogger(), clientset.CoreV1(), clientset.CoreV1())
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           &unstructured.Unstructured{Object: item},
+				ItemFromBackup: &unstructured.Unstructured{Object: item},
+				Restore:        tc.restore,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, &unstructured.Unstructured{Object: want}, res.UpdatedItem)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// protectedNamespaces are never deleted by the Recreate namespace conflict
+// policy, since deleting them would break the cluster.
+var protectedNamespaces = sets.NewString("default", "kube-system", "kube-public", "kube-node-lease")
+
+// targetNamespaces returns the namespaces a restore restores items into:
+// every included source namespace in the backup, after namespace mapping.
+func (ctx *restoreContext) targetNamespaces(backupResources map[string]*archive.ResourceItems) []string {
+	targets := sets.NewString()
+	for _, namespace := range backupNamespaces(backupResources).List() {
+		if !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			continue
+		}
+		if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			namespace = target
+		}
+		targets.Insert(namespace)
+	}
+	return targets.List()
+}
+
+// applyNamespaceConflictPolicy handles the target namespaces that already
+// exist in the cluster according to the restore's namespace conflict policy,
+// before anything is restored. Errors are keyed by target namespace, and any
+// error means the restore must not go ahead.
+func (ctx *restoreContext) applyNamespaceConflictPolicy(backupResources map[string]*archive.ResourceItems) Result {
+	errs := Result{}
+
+	policy := ctx.restore.Spec.NamespaceConflictPolicy
+	if policy == "" || policy == velerov1api.NamespaceConflictPolicyMerge {
+		return errs
+	}
+
+	var existing []string
+	for _, namespace := range ctx.targetNamespaces(backupResources) {
+		_, err := ctx.namespaceClient.Get(go_context.TODO(), namespace, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			errs.Add(namespace, errors.Wrapf(err, "error getting namespace %s", namespace))
+			continue
+		}
+		existing = append(existing, namespace)
+	}
+	if len(errs.Namespaces) > 0 {
+		return errs
+	}
+
+	switch policy {
+	case velerov1api.NamespaceConflictPolicyFail:
+		for _, namespace := range existing {
+			errs.Add(namespace, errors.Errorf("namespace %s already exists and the restore's namespace conflict policy is %s", namespace, policy))
+		}
+	case velerov1api.NamespaceConflictPolicyRecreate:
+		// check every namespace before deleting any, so that a protected
+		// namespace doesn't leave the restore with some namespaces deleted.
+		for _, namespace := range existing {
+			if protectedNamespaces.Has(namespace) || namespace == ctx.restore.Namespace {
+				errs.Add(namespace, errors.Errorf("namespace %s is protected and can't be recreated", namespace))
+			}
+		}
+		if len(errs.Namespaces) > 0 {
+			return errs
+		}
+
+		for _, namespace := range existing {
+			if err := ctx.recreateNamespace(namespace); err != nil {
+				errs.Add(namespace, err)
+				return errs
+			}
+		}
+	default:
+		errs.AddVeleroError(errors.Errorf("unsupported namespace conflict policy %q", policy))
+	}
+
+	return errs
+}
+
+// recreateNamespace deletes an existing namespace and waits for it to be
+// gone, so that it's created afresh when items are restored into it. In a
+// dry run, the deletion is only recorded in the dry-run summary.
+func (ctx *restoreContext) recreateNamespace(namespace string) error {
+	ctx.recreatedNamespaces.Insert(namespace)
+
+	if ctx.dryRun {
+		ctx.recordDryRun(kuberesource.Namespaces, "", namespace, DryRunActionRecreate, "namespace exists and would be deleted before restoring into it")
+		return nil
+	}
+
+	ctx.log.Infof("Deleting namespace %s to recreate it, according to the restore's namespace conflict policy", namespace)
+	err := ctx.namespaceClient.Delete(go_context.TODO(), namespace, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error deleting namespace %s", namespace)
+	}
+
+	err = wait.PollImmediate(time.Second, ctx.resourceTerminatingTimeout, func() (bool, error) {
+		_, err := ctx.namespaceClient.Get(go_context.TODO(), namespace, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "error getting namespace %s", namespace)
+	})
+	if err == wait.ErrWaitTimeout {
+		return errors.Errorf("timed out waiting for namespace %s to be deleted, finalizers on it or on items in it may be blocking its deletion", namespace)
+	}
+	return err
+}
@@ -0,0 +1,223 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// namespaceMappingTemplateData is the data a NamespaceMappingTemplate is
+// rendered with for a single source namespace.
+type namespaceMappingTemplateData struct {
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// Label returns the value of the source namespace's label with the given
+// key, or an error if the namespace doesn't have that label.
+func (d namespaceMappingTemplateData) Label(key string) (string, error) {
+	val, ok := d.Labels[key]
+	if !ok {
+		return "", errors.Errorf("namespace %s has no label %q", d.Name, key)
+	}
+	return val, nil
+}
+
+// Annotation returns the value of the source namespace's annotation with the
+// given key, or an error if the namespace doesn't have that annotation.
+func (d namespaceMappingTemplateData) Annotation(key string) (string, error) {
+	val, ok := d.Annotations[key]
+	if !ok {
+		return "", errors.Errorf("namespace %s has no annotation %q", d.Name, key)
+	}
+	return val, nil
+}
+
+// ParseNamespaceMappingTemplate parses the template text of a restore's
+// NamespaceMappingTemplate. Referencing a missing map key, e.g.
+// {{ .Labels.tenant }}, is an error when the template is rendered.
+func ParseNamespaceMappingTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("template must be non-empty")
+	}
+
+	tmpl, err := template.New("namespaceMappingTemplate").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return tmpl, nil
+}
+
+// namespaceMappingTemplateSelector returns the selector for the source
+// namespaces a NamespaceMappingTemplate applies to.
+func namespaceMappingTemplateSelector(mappingTemplate *velerov1api.NamespaceMappingTemplate) (labels.Selector, error) {
+	// metav1.LabelSelectorAsSelector converts a nil LabelSelector to a
+	// Nothing Selector, but a nil selector here means every namespace.
+	ls := mappingTemplate.Selector
+	if ls == nil {
+		ls = &metav1.LabelSelector{}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ls)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return selector, nil
+}
+
+// renderNamespaceMappingTemplate renders the template for a single source
+// namespace and returns the target namespace name. An error is returned if
+// the template fails or doesn't produce a valid namespace name.
+func renderNamespaceMappingTemplate(tmpl *template.Template, data namespaceMappingTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", errors.Wrapf(err, "error rendering namespace mapping template for namespace %s", data.Name)
+	}
+
+	target := strings.TrimSpace(buf.String())
+	if target == "" {
+		return "", errors.Errorf("namespace mapping template produced an empty namespace name for namespace %s", data.Name)
+	}
+	if errs := validation.IsDNS1123Label(target); len(errs) > 0 {
+		return "", errors.Errorf("namespace mapping template produced invalid namespace name %q for namespace %s: %s", target, data.Name, strings.Join(errs, "; "))
+	}
+
+	return target, nil
+}
+
+// withArchivedNamespaces returns a copy of mapping with every namespace that
+// a backup records under an archived name mapped back to its source name,
+// unless mapping already maps the archived name.
+func withArchivedNamespaces(mapping, archivedNamespaces map[string]string) map[string]string {
+	merged := make(map[string]string, len(mapping)+len(archivedNamespaces))
+	for source, target := range mapping {
+		merged[source] = target
+	}
+	for source, archived := range archivedNamespaces {
+		if _, ok := merged[archived]; !ok && archived != source {
+			merged[archived] = source
+		}
+	}
+	return merged
+}
+
+// sourceNamespace returns the namespace that a namespace in the backup
+// tarball was backed up from, which is what the backup's pod volume backups
+// refer to.
+func (ctx *restoreContext) sourceNamespace(namespace string) string {
+	for source, archived := range ctx.backup.Status.ArchivedNamespaces {
+		if archived == namespace {
+			return source
+		}
+	}
+	return namespace
+}
+
+// backupNamespaces returns every source namespace in a backup, whether it
+// contains items or only the namespace object itself.
+func backupNamespaces(backupResources map[string]*archive.ResourceItems) sets.String {
+	namespaces := sets.NewString()
+	for _, resourceItems := range backupResources {
+		for namespace := range resourceItems.ItemsByNamespace {
+			if namespace != "" {
+				namespaces.Insert(namespace)
+			}
+		}
+	}
+	if resourceItems := backupResources[kuberesource.Namespaces.String()]; resourceItems != nil {
+		namespaces.Insert(resourceItems.ItemsByNamespace[""]...)
+	}
+	return namespaces
+}
+
+// resolveNamespaceMapping returns the restore's effective namespace mapping:
+// the static NamespaceMapping, with every included source namespace in the
+// backup that's matched by the NamespaceMappingTemplate's selector mapped to
+// the template's output. Errors are keyed by source namespace.
+func (ctx *restoreContext) resolveNamespaceMapping(backupResources map[string]*archive.ResourceItems) (map[string]string, Result) {
+	errs := Result{}
+
+	mappingTemplate := ctx.restore.Spec.NamespaceMappingTemplate
+	if mappingTemplate == nil {
+		return ctx.restore.Spec.NamespaceMapping, errs
+	}
+
+	tmpl, err := ParseNamespaceMappingTemplate(mappingTemplate.Template)
+	if err != nil {
+		errs.AddVeleroError(errors.Wrap(err, "error parsing namespace mapping template"))
+		return nil, errs
+	}
+	selector, err := namespaceMappingTemplateSelector(mappingTemplate)
+	if err != nil {
+		errs.AddVeleroError(errors.Wrap(err, "error parsing namespace mapping template selector"))
+		return nil, errs
+	}
+
+	mapping := make(map[string]string, len(ctx.restore.Spec.NamespaceMapping))
+	for source, target := range ctx.restore.Spec.NamespaceMapping {
+		mapping[source] = target
+	}
+
+	for _, namespace := range backupNamespaces(backupResources).List() {
+		if !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			continue
+		}
+
+		data := namespaceMappingTemplateData{Name: namespace}
+
+		// the namespace object may not be in the backup, in which case the
+		// template is rendered without any labels or annotations.
+		nsPath := archive.GetItemFilePath(ctx.restoreDir, kuberesource.Namespaces.String(), "", namespace)
+		if _, err := ctx.fileSystem.Stat(nsPath); err == nil {
+			obj, err := archive.Unmarshal(ctx.fileSystem, nsPath)
+			if err != nil {
+				errs.Add(namespace, errors.Wrapf(err, "error reading namespace %s from backup", namespace))
+				continue
+			}
+			data.Labels = obj.GetLabels()
+			data.Annotations = obj.GetAnnotations()
+		}
+
+		if !selector.Matches(labels.Set(data.Labels)) {
+			continue
+		}
+
+		target, err := renderNamespaceMappingTemplate(tmpl, data)
+		if err != nil {
+			errs.Add(namespace, err)
+			continue
+		}
+
+		ctx.log.Infof("Namespace %s will be restored into namespace %s according to the namespace mapping template", namespace, target)
+		mapping[namespace] = target
+	}
+
+	return mapping, errs
+}
@@ -0,0 +1,171 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNamespaceMappingTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{
+			name:     "empty template is an error",
+			template: "",
+			wantErr:  true,
+		},
+		{
+			name:     "whitespace-only template is an error",
+			template: "  ",
+			wantErr:  true,
+		},
+		{
+			name:     "malformed template is an error",
+			template: `tenant-{{ .Label "tenant-id"`,
+			wantErr:  true,
+		},
+		{
+			name:     "valid template parses",
+			template: `tenant-{{ .Label "tenant-id" }}`,
+			wantErr:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl, err := ParseNamespaceMappingTemplate(tc.template)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, tmpl)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, tmpl)
+			}
+		})
+	}
+}
+
+func TestRenderNamespaceMappingTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		data        namespaceMappingTemplateData
+		want        string
+		wantErrMsgs []string
+	}{
+		{
+			name:     "template referencing a label with .Label",
+			template: `tenant-{{ .Label "tenant-id" }}`,
+			data:     namespaceMappingTemplateData{Name: "ns-1", Labels: map[string]string{"tenant-id": "a"}},
+			want:     "tenant-a",
+		},
+		{
+			name:     "template referencing an annotation with .Annotation",
+			template: `{{ .Annotation "team" }}-{{ .Name }}`,
+			data:     namespaceMappingTemplateData{Name: "ns-1", Annotations: map[string]string{"team": "blue"}},
+			want:     "blue-ns-1",
+		},
+		{
+			name:     "surrounding whitespace is trimmed",
+			template: ` {{ .Labels.tenant }} `,
+			data:     namespaceMappingTemplateData{Name: "ns-1", Labels: map[string]string{"tenant": "a"}},
+			want:     "a",
+		},
+		{
+			name:        "template referencing a missing label with .Label errors",
+			template:    `tenant-{{ .Label "tenant-id" }}`,
+			data:        namespaceMappingTemplateData{Name: "ns-1", Labels: map[string]string{"other": "a"}},
+			wantErrMsgs: []string{"namespace ns-1", `namespace ns-1 has no label "tenant-id"`},
+		},
+		{
+			name:        "template referencing a missing annotation with .Annotation errors",
+			template:    `{{ .Annotation "team" }}`,
+			data:        namespaceMappingTemplateData{Name: "ns-1"},
+			wantErrMsgs: []string{`namespace ns-1 has no annotation "team"`},
+		},
+		{
+			name:        "template referencing a missing label with .Labels errors",
+			template:    `tenant-{{ .Labels.tenant }}`,
+			data:        namespaceMappingTemplateData{Name: "ns-1"},
+			wantErrMsgs: []string{"namespace ns-1", `map has no entry for key "tenant"`},
+		},
+		{
+			name:        "template producing an empty namespace name errors",
+			template:    `{{ index .Labels "tenant-id" }}`,
+			data:        namespaceMappingTemplateData{Name: "ns-1", Labels: map[string]string{}},
+			wantErrMsgs: []string{"namespace mapping template produced an empty namespace name for namespace ns-1"},
+		},
+		{
+			name:        "template producing an invalid namespace name errors",
+			template:    `{{ .Label "tenant-id" }}`,
+			data:        namespaceMappingTemplateData{Name: "ns-1", Labels: map[string]string{"tenant-id": "Tenant_A"}},
+			wantErrMsgs: []string{`namespace mapping template produced invalid namespace name "Tenant_A" for namespace ns-1`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl, err := ParseNamespaceMappingTemplate(tc.template)
+			require.NoError(t, err)
+
+			got, err := renderNamespaceMappingTemplate(tmpl, tc.data)
+			if len(tc.wantErrMsgs) > 0 {
+				require.Error(t, err)
+				for _, msg := range tc.wantErrMsgs {
+					assert.Contains(t, err.Error(), msg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWithArchivedNamespaces(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapping  map[string]string
+		archived map[string]string
+		want     map[string]string
+	}{
+		{
+			name:     "archived namespaces are mapped back to their source names",
+			archived: map[string]string{"ns-1": "archived-1", "ns-2": "ns-2"},
+			want:     map[string]string{"archived-1": "ns-1"},
+		},
+		{
+			name:     "the restore's own mapping takes precedence",
+			mapping:  map[string]string{"archived-1": "ns-3"},
+			archived: map[string]string{"ns-1": "archived-1", "ns-2": "archived-2"},
+			want:     map[string]string{"archived-1": "ns-3", "archived-2": "ns-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, withArchivedNamespaces(tc.mapping, tc.archived))
+		})
+	}
+}
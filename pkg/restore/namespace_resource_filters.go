@@ -0,0 +1,106 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// namespaceResourceFilter is the resources that are restored from the
+// namespaces matching pattern, from a restore's namespaceResourceFilters.
+type namespaceResourceFilter struct {
+	pattern    string
+	namespaces *collections.IncludesExcludes
+	resources  *collections.IncludesExcludes
+}
+
+// getNamespaceResourceFilters resolves a restore's namespace resource
+// filters, in pattern order so that the first filter to exclude a resource
+// is always the same one.
+func getNamespaceResourceFilters(helper discovery.Helper, filters map[string]velerov1api.NamespaceResourceFilter) []namespaceResourceFilter {
+	patterns := make([]string, 0, len(filters))
+	for pattern := range filters {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	var resolved []namespaceResourceFilter
+	for _, pattern := range patterns {
+		filter := filters[pattern]
+		resolved = append(resolved, namespaceResourceFilter{
+			pattern:    pattern,
+			namespaces: collections.NewIncludesExcludes().Includes(pattern),
+			resources:  collections.GetResourceIncludesExcludes(helper, filter.IncludedResources, filter.ExcludedResources),
+		})
+	}
+	return resolved
+}
+
+// ValidateNamespaceResourceFilters checks that a restore's namespace resource
+// filters have valid namespace patterns and resource lists.
+func ValidateNamespaceResourceFilters(filters map[string]velerov1api.NamespaceResourceFilter) []error {
+	patterns := make([]string, 0, len(filters))
+	for pattern := range filters {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+
+	var errs []error
+	for _, pattern := range patterns {
+		if pattern == "" {
+			errs = append(errs, errors.New("namespace pattern must not be empty"))
+			continue
+		}
+		for _, err := range collections.ValidateIncludesExcludes([]string{pattern}, nil) {
+			errs = append(errs, errors.Wrapf(err, "invalid namespace pattern %q", pattern))
+		}
+
+		filter := filters[pattern]
+		for _, err := range collections.ValidateIncludesExcludes(filter.IncludedResources, filter.ExcludedResources) {
+			errs = append(errs, errors.Wrapf(err, "invalid included/excluded resource lists for namespaces %q", pattern))
+		}
+	}
+	return errs
+}
+
+// namespaceResourceFiltersInclude returns whether the restore's namespace
+// resource filters allow a resource to be restored from a namespace in the
+// backup, and if they don't, why not. A resource must be included by the
+// filter of every pattern that matches the namespace. Cluster-scoped
+// resources aren't in a namespace, so they're always allowed.
+func (ctx *restoreContext) namespaceResourceFiltersInclude(namespace, groupResource string) (bool, string) {
+	if namespace == "" {
+		return true, ""
+	}
+
+	for _, filter := range ctx.namespaceResourceFilters {
+		if !filter.namespaces.ShouldInclude(namespace) {
+			continue
+		}
+		if include, reason := filter.resources.ShouldIncludeWithReason(groupResource); !include {
+			return false, fmt.Sprintf("the resource filter for namespaces %q: %s", filter.pattern, reason)
+		}
+	}
+	return true, ""
+}
@@ -0,0 +1,115 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNamespaceResourceFiltersInclude(t *testing.T) {
+	filters := map[string]velerov1api.NamespaceResourceFilter{
+		"app-*":  {ExcludedResources: []string{"secrets", "persistentvolumeclaims"}},
+		"app-db": {IncludedResources: []string{"persistentvolumeclaims", "secrets", "pods"}},
+		"shared": {IncludedResources: []string{"configmaps"}},
+	}
+	ctx := &restoreContext{
+		namespaceResourceFilters: getNamespaceResourceFilters(velerotest.NewFakeDiscoveryHelper(true, nil), filters),
+	}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		groupResource string
+		want          bool
+		wantReason    string
+	}{
+		{
+			name:          "cluster-scoped resources are always included",
+			groupResource: "secrets",
+			want:          true,
+		},
+		{
+			name:          "namespace matching no filters includes everything",
+			namespace:     "other",
+			groupResource: "secrets",
+			want:          true,
+		},
+		{
+			name:          "resource excluded by a matching glob is excluded",
+			namespace:     "app-web",
+			groupResource: "secrets",
+			wantReason:    `the resource filter for namespaces "app-*": excluded by "secrets"`,
+		},
+		{
+			name:          "resource not excluded by a matching glob is included",
+			namespace:     "app-web",
+			groupResource: "deployments.apps",
+			want:          true,
+		},
+		{
+			name:          "resource must be included by every matching filter",
+			namespace:     "app-db",
+			groupResource: "persistentvolumeclaims",
+			wantReason:    `the resource filter for namespaces "app-*": excluded by "persistentvolumeclaims"`,
+		},
+		{
+			name:          "resource included by every matching filter is included",
+			namespace:     "app-db",
+			groupResource: "pods",
+			want:          true,
+		},
+		{
+			name:          "resource not in a matching filter's includes is excluded",
+			namespace:     "shared",
+			groupResource: "secrets",
+			wantReason:    `the resource filter for namespaces "shared": not in includes list`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, reason := ctx.namespaceResourceFiltersInclude(tc.namespace, tc.groupResource)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantReason, reason)
+		})
+	}
+}
+
+func TestValidateNamespaceResourceFilters(t *testing.T) {
+	errs := ValidateNamespaceResourceFilters(map[string]velerov1api.NamespaceResourceFilter{
+		"":      {ExcludedResources: []string{"secrets"}},
+		"app-*": {ExcludedResources: []string{"secrets"}},
+		"web": {
+			IncludedResources: []string{"pods"},
+			ExcludedResources: []string{"pods"},
+		},
+	})
+
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Error())
+	}
+	assert.Equal(t, []string{
+		"namespace pattern must not be empty",
+		`invalid included/excluded resource lists for namespaces "web": excludes list cannot contain an item in the includes list: pods`,
+	}, got)
+}
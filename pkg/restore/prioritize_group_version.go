@@ -30,6 +30,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/features"
 )
 
 // ChosenGroupVersion is the API Group version that was selected to restore
@@ -41,16 +42,26 @@ type ChosenGroupVersion struct {
 	Dir     string
 }
 
-// chooseAPIVersionsToRestore will choose a version to restore based on a user-
-// provided config map prioritization or our version prioritization.
+// chooseAPIVersionsToRestore will choose a version to restore based on the
+// restore's preferred API versions, a user-provided config map prioritization
+// or our version prioritization. Unless the APIGroupVersionsFeatureFlag is
+// enabled, only the resources that were backed up at more than one version,
+// or that the restore prefers versions of, are considered.
 func (ctx *restoreContext) chooseAPIVersionsToRestore() error {
 	sourceGVs, targetGVs, userGVs, err := ctx.gatherSourceTargetUserGroupVersions()
 	if err != nil {
 		return err
 	}
 
+	allResources := features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag)
+
 OUTER:
 	for rg, sg := range sourceGVs {
+		restoreVersions := ctx.restore.Spec.PreferredAPIVersions[rg]
+		if !allResources && len(sg.Versions) < 2 && len(restoreVersions) == 0 {
+			continue
+		}
+
 		// Default to the source preferred version if no other common version
 		// can be found.
 		cgv := ChosenGroupVersion{
@@ -66,6 +77,26 @@ OUTER:
 			continue
 		}
 
+		// The restore's preferred versions take precedence over all the
+		// priorities below.
+		if len(restoreVersions) > 0 {
+			rv := findSupportedUserVersion(versionsToGroupVersionForDiscovery(restoreVersions), tg.Versions, sg.Versions)
+			if rv != "" {
+				cgv.Version = rv
+				cgv.Dir = rv
+
+				if rv == sg.PreferredVersion.Version {
+					cgv.Dir += velerov1api.PreferredVersionDir
+				}
+
+				ctx.chosenGrpVersToRestore[rg] = cgv
+				ctx.log.Debugf("Restore preferred API group version %s chosen for %s", rv, rg)
+				continue
+			}
+
+			ctx.log.Infof("Cannot find any of the restore's preferred API group versions %s in both the cluster and backup for %s", strings.Join(restoreVersions, ", "), rg)
+		}
+
 		// Priority 0: User Priority Version
 		if userGVs != nil {
 			uv := findSupportedUserVersion(userGVs[rg].Versions, tg.Versions, sg.Versions)
@@ -148,6 +179,31 @@ OUTER:
 	return nil
 }
 
+// ValidatePreferredAPIVersions returns an error for each resource of a
+// restore's preferred API versions whose versions aren't API versions, such
+// as v1beta1.
+func ValidatePreferredAPIVersions(preferredAPIVersions map[string][]string) []error {
+	resources := make([]string, 0, len(preferredAPIVersions))
+	for resource := range preferredAPIVersions {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	var errs []error
+	for _, resource := range resources {
+		versions := preferredAPIVersions[resource]
+		if len(versions) == 0 {
+			errs = append(errs, errors.Errorf("at least one version is required for resource %s", resource))
+		}
+		for _, version := range versions {
+			if version == "" || strings.ContainsAny(version, "/*., ") {
+				errs = append(errs, errors.Errorf("version %q of resource %s must be an API version, such as v1beta1", version, resource))
+			}
+		}
+	}
+	return errs
+}
+
 // gatherSourceTargetUserGroupVersions collects the source, target, and user priority versions.
 func (ctx *restoreContext) gatherSourceTargetUserGroupVersions() (
 	map[string]metav1.APIGroup,
@@ -172,6 +228,12 @@ func (ctx *restoreContext) gatherSourceTargetUserGroupVersions() (
 		k8sPrioritySort(target.Versions)
 	}
 
+	// The user-provided enableapigroupversion config map is only used with
+	// the feature flag.
+	if !features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
+		return sourceRGVersions, targetGroupVersions, nil, nil
+	}
+
 	// Get the user-provided enableapigroupversion config map.
 	cm, err := userPriorityConfigMap()
 	if err != nil {
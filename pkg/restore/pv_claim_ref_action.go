@@ -0,0 +1,242 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+const (
+	// pvResetClaimRefKey is the key of the plugin's config map that, if
+	// true, clears the claimRef of restored volumes whose claims aren't
+	// restored, so that they're available to any claim.
+	pvResetClaimRefKey = "resetClaimRef"
+
+	// pvReleasedAvailableKey is the key of the plugin's config map that, if
+	// true, clears the claimRef of restored volumes that were released when
+	// they were backed up, so that they're available rather than waiting
+	// for a claim that no longer exists.
+	pvReleasedAvailableKey = "makeReleasedAvailable"
+
+	// pvReclaimPolicyKey is the key of the plugin's config map that sets the
+	// reclaim policy of restored volumes. Their backed up policy is kept if
+	// it isn't set.
+	pvReclaimPolicyKey = "reclaimPolicy"
+)
+
+// pvClaimRefConfig is the opt-in handling of restored persistent volumes
+// that's set by PVClaimRefAction's plugin config map.
+type pvClaimRefConfig struct {
+	resetClaimRef     bool
+	releasedAvailable bool
+	reclaimPolicy     corev1api.PersistentVolumeReclaimPolicy
+}
+
+// PVClaimRefAction re-associates restored persistent volumes with their
+// restored claims, so that they're bound to them rather than left unbound,
+// released or bound to a claim in the wrong namespace. Its plugin config map
+// can opt in to releasing volumes from claims that aren't restored, and to
+// overriding their reclaim policy.
+type PVClaimRefAction struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+}
+
+// NewPVClaimRefAction is the constructor for PVClaimRefAction.
+func NewPVClaimRefAction(logger logrus.FieldLogger, configMapClient corev1client.ConfigMapInterface) *PVClaimRefAction {
+	return &PVClaimRefAction{
+		logger:          logger,
+		configMapClient: configMapClient,
+	}
+}
+
+// AppliesTo returns the resources that PVClaimRefAction should be run for.
+func (a *PVClaimRefAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumes"},
+	}, nil
+}
+
+// Execute rewrites a persistent volume's claimRef to the claim it's restored
+// with: in the claim's mapped namespace, and without the uid and resource
+// version of the backed up claim, which the restored claim doesn't have. The
+// volume may have been renamed already, in which case the claim's volumeName
+// is updated to the new name when the claim is restored.
+func (a *PVClaimRefAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	// the claimRef is computed from the item from the backup, since its
+	// namespace may have been remapped already.
+	pvFromBackup := new(corev1api.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(input.ItemFromBackup.UnstructuredContent(), pvFromBackup); err != nil {
+		return nil, errors.Wrap(err, "unable to convert unstructured item to persistent volume")
+	}
+
+	log := a.logger.WithField("persistentVolume", input.Item.GetName())
+	if originalName := input.Item.GetAnnotations()["velero.io/original-pv-name"]; originalName != "" {
+		log = log.WithField("originalPersistentVolume", originalName)
+	}
+
+	config, err := a.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(input.Item.UnstructuredContent())}
+
+	// the claimRef is computed with the reclaim policy the volume is
+	// restored with, since that's the one it'd be reclaimed with.
+	reclaimPolicy := pvFromBackup.Spec.PersistentVolumeReclaimPolicy
+	if config.reclaimPolicy != "" {
+		reclaimPolicy = config.reclaimPolicy
+		if config.reclaimPolicy == pvFromBackup.Spec.PersistentVolumeReclaimPolicy {
+			log.Infof("Persistent volume's reclaim policy is already %s", config.reclaimPolicy)
+		} else {
+			log.Infof("Changing persistent volume's reclaim policy from %s to %s", pvFromBackup.Spec.PersistentVolumeReclaimPolicy, config.reclaimPolicy)
+			if err := unstructured.SetNestedField(obj.Object, string(config.reclaimPolicy), "spec", "persistentVolumeReclaimPolicy"); err != nil {
+				return nil, errors.Wrap(err, "unable to set persistent volume's reclaim policy")
+			}
+		}
+	}
+
+	claimRef := pvFromBackup.Spec.ClaimRef
+	if claimRef == nil {
+		log.Debug("Persistent volume isn't claimed, not changing its claimRef")
+		return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	}
+
+	targetNamespace := claimRef.Namespace
+	if namespace, ok := input.Restore.Spec.NamespaceMapping[claimRef.Namespace]; ok {
+		targetNamespace = namespace
+	}
+
+	namespaces := collections.NewIncludesExcludes().
+		Includes(input.Restore.Spec.IncludedNamespaces...).
+		Excludes(input.Restore.Spec.ExcludedNamespaces...)
+
+	switch {
+	case claimRef.UID == "":
+		// the volume was pre-bound to a claim that didn't exist when it was
+		// backed up, so the claim isn't restored with it. It stays reserved
+		// for the claim, in the namespace the claim's namespace is mapped to,
+		// even if claimRefs are reset, since it was reserved on purpose.
+		log.Infof("Persistent volume is pre-bound, keeping it reserved for claim %s/%s", targetNamespace, claimRef.Name)
+
+	case pvFromBackup.Status.Phase == corev1api.VolumeReleased && config.releasedAvailable:
+		// the volume's claim was deleted before the backup, so it'd wait
+		// for a claim that's never restored.
+		log.Infof("Persistent volume was released from claim %s/%s when it was backed up, clearing its claimRef so it's available", claimRef.Namespace, claimRef.Name)
+		unstructured.RemoveNestedField(obj.Object, "spec", "claimRef")
+		return velero.NewRestoreItemActionExecuteOutput(obj), nil
+
+	case !namespaces.ShouldInclude(claimRef.Namespace):
+		if config.resetClaimRef {
+			// an available volume isn't reclaimed whatever its reclaim
+			// policy, so it's safe to release it from its claim.
+			log.Infof("Persistent volume's claim %s/%s isn't restored, clearing its claimRef so it's available", claimRef.Namespace, claimRef.Name)
+			unstructured.RemoveNestedField(obj.Object, "spec", "claimRef")
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		}
+
+		if reclaimPolicy != corev1api.PersistentVolumeReclaimDelete {
+			// without its claim, a retained volume is safe to restore as it
+			// is, so it's left for the cluster admin to bind or reclaim.
+			log.Infof("Not changing claimRef of retained persistent volume because its claim %s/%s isn't restored", claimRef.Namespace, claimRef.Name)
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		}
+
+		// a volume whose claim is gone is released, and a released volume
+		// whose reclaim policy is Delete is deleted with its storage asset.
+		// Clearing the claim's uid keeps it reserved for the claim instead.
+		log.Infof("Persistent volume's claim %s/%s isn't restored, keeping the volume reserved for it so it isn't deleted", claimRef.Namespace, claimRef.Name)
+		targetNamespace = claimRef.Namespace
+
+	default:
+		log.Infof("Binding persistent volume to restored claim %s/%s", targetNamespace, claimRef.Name)
+	}
+
+	if err := unstructured.SetNestedField(obj.Object, targetNamespace, "spec", "claimRef", "namespace"); err != nil {
+		return nil, errors.Wrap(err, "unable to set persistent volume's claimRef namespace")
+	}
+	if err := unstructured.SetNestedField(obj.Object, claimRef.Name, "spec", "claimRef", "name"); err != nil {
+		return nil, errors.Wrap(err, "unable to set persistent volume's claimRef name")
+	}
+	unstructured.RemoveNestedField(obj.Object, "spec", "claimRef", "uid")
+	unstructured.RemoveNestedField(obj.Object, "spec", "claimRef", "resourceVersion")
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// getConfig returns the opt-in handling set by the plugin's config map. None
+// of it is enabled if there's no config map.
+func (a *PVClaimRefAction) getConfig() (pvClaimRefConfig, error) {
+	configMap, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/pv-claim-ref", a.configMapClient)
+	if err != nil {
+		return pvClaimRefConfig{}, err
+	}
+	if configMap == nil {
+		return pvClaimRefConfig{}, nil
+	}
+
+	return parsePVClaimRefConfig(configMap.Data)
+}
+
+// parsePVClaimRefConfig parses the opt-in handling of restored persistent
+// volumes from a plugin config map. Recycle isn't a valid reclaim policy,
+// since it's deprecated and scrubs the volumes that it reclaims.
+func parsePVClaimRefConfig(data map[string]string) (pvClaimRefConfig, error) {
+	var config pvClaimRefConfig
+
+	parseBool := func(key string) (bool, error) {
+		value := strings.TrimSpace(data[key])
+		if value == "" {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, errors.Errorf("invalid %s %q, must be true or false", key, value)
+		}
+		return b, nil
+	}
+
+	var err error
+	if config.resetClaimRef, err = parseBool(pvResetClaimRefKey); err != nil {
+		return pvClaimRefConfig{}, err
+	}
+	if config.releasedAvailable, err = parseBool(pvReleasedAvailableKey); err != nil {
+		return pvClaimRefConfig{}, err
+	}
+
+	switch policy := corev1api.PersistentVolumeReclaimPolicy(strings.TrimSpace(data[pvReclaimPolicyKey])); policy {
+	case "", corev1api.PersistentVolumeReclaimRetain, corev1api.PersistentVolumeReclaimDelete:
+		config.reclaimPolicy = policy
+	default:
+		return pvClaimRefConfig{}, errors.Errorf("invalid %s %q, must be Retain or Delete", pvReclaimPolicyKey, policy)
+	}
+
+	return config, nil
+}
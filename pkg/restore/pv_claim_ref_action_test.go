@@ -0,0 +1,288 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPVClaimRefActionExecute(t *testing.T) {
+	boundPV := func(policy corev1api.PersistentVolumeReclaimPolicy) *corev1api.PersistentVolume {
+		pv := builder.ForPersistentVolume("pv-1").ReclaimPolicy(policy).ClaimRef("ns-1", "pvc-1").Result()
+		pv.Spec.ClaimRef.UID = "pvc-1-uid"
+		pv.Spec.ClaimRef.ResourceVersion = "1"
+		return pv
+	}
+	releasedPV := func(policy corev1api.PersistentVolumeReclaimPolicy) *corev1api.PersistentVolume {
+		pv := boundPV(policy)
+		pv.Status.Phase = corev1api.VolumeReleased
+		return pv
+	}
+	pluginConfig := func(data ...string) *corev1api.ConfigMap {
+		return builder.ForConfigMap("velero", "pv-claim-ref").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/pv-claim-ref", "RestoreItemAction")).
+			Data(data...).
+			Result()
+	}
+
+	tests := []struct {
+		name           string
+		restore        *velerov1api.Restore
+		configMap      *corev1api.ConfigMap
+		itemFromBackup *corev1api.PersistentVolume
+		item           *corev1api.PersistentVolume
+		want           *corev1api.PersistentVolume
+		wantErr        string
+	}{
+		{
+			name:           "unclaimed PV isn't changed",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			itemFromBackup: builder.ForPersistentVolume("pv-1").Result(),
+			item:           builder.ForPersistentVolume("pv-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").Result(),
+		},
+		{
+			name:           "bound PV's claimRef uid is cleared",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimDelete),
+			item:           boundPV(corev1api.PersistentVolumeReclaimDelete),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "bound PV's claimRef namespace is mapped",
+			restore:        builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-2", "pvc-1").Result(),
+		},
+		{
+			name:           "claimRef namespace that's already mapped isn't mapped again",
+			restore:        builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2", "ns-2", "ns-3").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimDelete),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-2", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-2", "pvc-1").Result(),
+		},
+		{
+			name:           "renamed PV is bound to the restored claim",
+			restore:        builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimDelete),
+			item: builder.ForPersistentVolume("pv-2").
+				ObjectMeta(builder.WithAnnotations("velero.io/original-pv-name", "pv-1")).
+				ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).
+				ClaimRef("ns-1", "pvc-1").
+				Result(),
+			want: builder.ForPersistentVolume("pv-2").
+				ObjectMeta(builder.WithAnnotations("velero.io/original-pv-name", "pv-1")).
+				ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).
+				ClaimRef("ns-2", "pvc-1").
+				Result(),
+		},
+		{
+			name:           "pre-bound PV stays reserved for its claim in the mapped namespace",
+			restore:        builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2").ExcludedNamespaces("ns-1").Result(),
+			itemFromBackup: builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-2", "pvc-1").Result(),
+		},
+		{
+			name:           "retained PV whose claim isn't restored isn't changed",
+			restore:        builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2").ExcludedNamespaces("ns-1").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			want:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+		},
+		{
+			name:           "deleted PV whose claim isn't restored stays reserved for it",
+			restore:        builder.ForRestore("velero", "restore-1").NamespaceMappings("ns-1", "ns-2").IncludedNamespaces("ns-3").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimDelete),
+			item:           boundPV(corev1api.PersistentVolumeReclaimDelete),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "recycled PV whose claim isn't restored isn't changed",
+			restore:        builder.ForRestore("velero", "restore-1").ExcludedNamespaces("ns-1").Result(),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRecycle),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRecycle).ClaimRef("ns-1", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRecycle).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "released PV is bound to the claim's name without config",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			itemFromBackup: releasedPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "released retained PV is made available",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("makeReleasedAvailable", "true"),
+			itemFromBackup: releasedPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+		},
+		{
+			name:           "released deleted PV is made available",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("makeReleasedAvailable", "true"),
+			itemFromBackup: releasedPV(corev1api.PersistentVolumeReclaimDelete),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-1", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).Result(),
+		},
+		{
+			name:           "bound PV isn't made available as if it was released",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("makeReleasedAvailable", "true"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "claimRef of retained PV whose claim isn't restored is reset",
+			restore:        builder.ForRestore("velero", "restore-1").ExcludedNamespaces("ns-1").Result(),
+			configMap:      pluginConfig("resetClaimRef", "true"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+		},
+		{
+			name:           "claimRef of deleted PV whose claim isn't restored is reset",
+			restore:        builder.ForRestore("velero", "restore-1").ExcludedNamespaces("ns-1").Result(),
+			configMap:      pluginConfig("resetClaimRef", "true"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimDelete),
+			item:           boundPV(corev1api.PersistentVolumeReclaimDelete),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).Result(),
+		},
+		{
+			name:           "claimRef of recycled PV whose claim isn't restored is reset",
+			restore:        builder.ForRestore("velero", "restore-1").ExcludedNamespaces("ns-1").Result(),
+			configMap:      pluginConfig("resetClaimRef", "true"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRecycle),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRecycle),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRecycle).Result(),
+		},
+		{
+			name:           "claimRef of PV whose claim is restored isn't reset",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("resetClaimRef", "true"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "pre-bound PV stays reserved for its claim when claimRefs are reset",
+			restore:        builder.ForRestore("velero", "restore-1").ExcludedNamespaces("ns-1").Result(),
+			configMap:      pluginConfig("resetClaimRef", "true", "makeReleasedAvailable", "true"),
+			itemFromBackup: builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "reclaim policy is overridden",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("reclaimPolicy", "Retain"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimDelete),
+			item:           boundPV(corev1api.PersistentVolumeReclaimDelete),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "reclaim policy of recycled PV is overridden",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("reclaimPolicy", "Delete"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRecycle),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRecycle),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "retained PV whose claim isn't restored stays reserved for it when it's restored as deleted",
+			restore:        builder.ForRestore("velero", "restore-1").ExcludedNamespaces("ns-1").Result(),
+			configMap:      pluginConfig("reclaimPolicy", "Delete"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-1", "pvc-1").Result(),
+		},
+		{
+			name:           "reclaim policy of unclaimed PV is overridden",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("reclaimPolicy", "Retain"),
+			itemFromBackup: builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).Result(),
+			item:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).Result(),
+			want:           builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+		},
+		{
+			name:           "Recycle reclaim policy is an error",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("reclaimPolicy", "Recycle"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			wantErr:        `invalid reclaimPolicy "Recycle", must be Retain or Delete`,
+		},
+		{
+			name:           "resetClaimRef that isn't a bool is an error",
+			restore:        builder.ForRestore("velero", "restore-1").Result(),
+			configMap:      pluginConfig("resetClaimRef", "sometimes"),
+			itemFromBackup: boundPV(corev1api.PersistentVolumeReclaimRetain),
+			item:           boundPV(corev1api.PersistentVolumeReclaimRetain),
+			wantErr:        `invalid resetClaimRef "sometimes", must be true or false`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			itemFromBackup, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.itemFromBackup)
+			require.NoError(t, err)
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.item)
+			require.NoError(t, err)
+
+			clientset := fake.NewSimpleClientset()
+			a := NewPVClaimRefAction(velerotest.NewLogger(), clientset.CoreV1().ConfigMaps("velero"))
+
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           &unstructured.Unstructured{Object: item},
+				ItemFromBackup: &unstructured.Unstructured{Object: itemFromBackup},
+				Restore:        tc.restore,
+			})
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			want, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.want)
+			require.NoError(t, err)
+			assert.Equal(t, want, res.UpdatedItem.UnstructuredContent())
+		})
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+	"regexp"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+// ResourceModifiersVersion is the only supported version of the resource
+// modifiers format.
+const ResourceModifiersVersion = "v1"
+
+// ResourceModifiers is a list of rules that modify restored items before
+// they're created. It's read from the only key of the ConfigMap that a
+// restore's spec.resourceModifier references.
+type ResourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []ResourceModifierRule `json:"resourceModifierRules"`
+
+	rules []compiledModifierRule
+}
+
+// ResourceModifierRule applies patches to the items that match its
+// conditions. JSON patches are applied first, then merge patches, then
+// strategic merge patches.
+type ResourceModifierRule struct {
+	Conditions       ModifierConditions `json:"conditions"`
+	Patches          []JSONPatch        `json:"patches,omitempty"`
+	MergePatches     []PatchData        `json:"mergePatches,omitempty"`
+	StrategicPatches []PatchData        `json:"strategicPatches,omitempty"`
+}
+
+// ModifierConditions select the items a rule applies to. GroupResource is
+// required, and the other conditions match every item if they're empty.
+type ModifierConditions struct {
+	GroupResource     string                `json:"groupResource"`
+	ResourceNameRegex string                `json:"resourceNameRegex,omitempty"`
+	Namespaces        []string              `json:"namespaces,omitempty"`
+	LabelSelector     *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// JSONPatch is a single RFC 6902 JSON patch operation.
+type JSONPatch struct {
+	Operation string      `json:"operation"`
+	From      string      `json:"from,omitempty"`
+	Path      string      `json:"path"`
+	Value     interface{} `json:"value,omitempty"`
+}
+
+// PatchData is a JSON merge patch or strategic merge patch, in JSON or YAML.
+type PatchData struct {
+	PatchData string `json:"patchData"`
+}
+
+type compiledModifierRule struct {
+	groupResource    string
+	nameRegex        *regexp.Regexp
+	namespaces       map[string]struct{}
+	selector         labels.Selector
+	jsonPatch        jsonpatch.Patch
+	mergePatches     [][]byte
+	strategicPatches [][]byte
+}
+
+// GetResourceModifiersData returns the resource modifiers in a ConfigMap,
+// which must have exactly one key.
+func GetResourceModifiersData(configMap *corev1api.ConfigMap) ([]byte, error) {
+	if len(configMap.Data) != 1 {
+		return nil, errors.Errorf("config map %s/%s must have exactly one key, found %d", configMap.Namespace, configMap.Name, len(configMap.Data))
+	}
+
+	for _, data := range configMap.Data {
+		return []byte(data), nil
+	}
+	return nil, nil
+}
+
+// ParseResourceModifiers parses and validates resource modifiers, returning
+// a list of errors describing what's wrong with them.
+func ParseResourceModifiers(data []byte) (*ResourceModifiers, []error) {
+	modifiers := new(ResourceModifiers)
+	if err := yaml.UnmarshalStrict(data, modifiers); err != nil {
+		return nil, []error{errors.Wrap(err, "error decoding resource modifiers")}
+	}
+
+	var errs []error
+	if modifiers.Version != ResourceModifiersVersion {
+		errs = append(errs, errors.Errorf("unsupported version %q, must be %s", modifiers.Version, ResourceModifiersVersion))
+	}
+
+	for i, rule := range modifiers.ResourceModifierRules {
+		compiled, ruleErrs := compileModifierRule(rule)
+		for _, err := range ruleErrs {
+			errs = append(errs, errors.Wrapf(err, "rule %d", i))
+		}
+		modifiers.rules = append(modifiers.rules, compiled)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return modifiers, nil
+}
+
+func compileModifierRule(rule ResourceModifierRule) (compiledModifierRule, []error) {
+	var errs []error
+
+	compiled := compiledModifierRule{
+		selector: labels.Everything(),
+	}
+
+	if rule.Conditions.GroupResource == "" {
+		errs = append(errs, errors.New("conditions.groupResource is required"))
+	}
+	compiled.groupResource = schema.ParseGroupResource(rule.Conditions.GroupResource).String()
+
+	if rule.Conditions.ResourceNameRegex != "" {
+		nameRegex, err := regexp.Compile(rule.Conditions.ResourceNameRegex)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "invalid conditions.resourceNameRegex"))
+		}
+		compiled.nameRegex = nameRegex
+	}
+
+	if len(rule.Conditions.Namespaces) > 0 {
+		compiled.namespaces = make(map[string]struct{})
+		for _, namespace := range rule.Conditions.Namespaces {
+			compiled.namespaces[namespace] = struct{}{}
+		}
+	}
+
+	if rule.Conditions.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(rule.Conditions.LabelSelector)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "invalid conditions.labelSelector"))
+		}
+		compiled.selector = selector
+	}
+
+	if len(rule.Patches) == 0 && len(rule.MergePatches) == 0 && len(rule.StrategicPatches) == 0 {
+		errs = append(errs, errors.New("at least one patch is required"))
+	}
+
+	if len(rule.Patches) > 0 {
+		jsonPatch, err := compileJSONPatch(rule.Patches)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		compiled.jsonPatch = jsonPatch
+	}
+
+	for i, patch := range rule.MergePatches {
+		patchJSON, err := patchDataToJSON(patch.PatchData)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid merge patch %d", i))
+		}
+		compiled.mergePatches = append(compiled.mergePatches, patchJSON)
+	}
+
+	for i, patch := range rule.StrategicPatches {
+		patchJSON, err := patchDataToJSON(patch.PatchData)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid strategic merge patch %d", i))
+		}
+		compiled.strategicPatches = append(compiled.strategicPatches, patchJSON)
+	}
+
+	return compiled, errs
+}
+
+func compileJSONPatch(patches []JSONPatch) (jsonpatch.Patch, error) {
+	var operations []map[string]interface{}
+	for i, patch := range patches {
+		switch patch.Operation {
+		case "add", "replace", "test", "remove":
+		case "move", "copy":
+			if patch.From == "" {
+				return nil, errors.Errorf("invalid patch %d: from is required for %s operations", i, patch.Operation)
+			}
+		default:
+			return nil, errors.Errorf("invalid patch %d: unsupported operation %q", i, patch.Operation)
+		}
+		if patch.Path == "" || patch.Path[0] != '/' {
+			return nil, errors.Errorf("invalid patch %d: path must be a JSON pointer starting with /", i)
+		}
+
+		operation := map[string]interface{}{
+			"op":   patch.Operation,
+			"path": patch.Path,
+		}
+		if patch.From != "" {
+			operation["from"] = patch.From
+		}
+		switch patch.Operation {
+		case "add", "replace", "test":
+			operation["value"] = patch.Value
+		}
+		operations = append(operations, operation)
+	}
+
+	patchJSON, err := json.Marshal(operations)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding patches")
+	}
+
+	jsonPatch, err := jsonpatch.DecodePatch(patchJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid patches")
+	}
+	return jsonPatch, nil
+}
+
+// patchDataToJSON converts a merge patch in JSON or YAML to JSON, checking
+// that it's an object.
+func patchDataToJSON(patchData string) ([]byte, error) {
+	patchJSON, err := yaml.YAMLToJSON([]byte(patchData))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var patch map[string]interface{}
+	if err := json.Unmarshal(patchJSON, &patch); err != nil || patch == nil {
+		return nil, errors.New("patch must be an object")
+	}
+	return patchJSON, nil
+}
+
+func (r *compiledModifierRule) matches(obj *unstructured.Unstructured, groupResource schema.GroupResource) bool {
+	if r.groupResource != groupResource.String() {
+		return false
+	}
+	if r.nameRegex != nil && !r.nameRegex.MatchString(obj.GetName()) {
+		return false
+	}
+	if r.namespaces != nil {
+		if _, ok := r.namespaces[obj.GetNamespace()]; !ok {
+			return false
+		}
+	}
+	return r.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// Apply applies the patches of each rule that matches an item to it, in
+// order. The item isn't modified if a patch fails to apply.
+func (m *ResourceModifiers) Apply(obj *unstructured.Unstructured, groupResource schema.GroupResource) error {
+	if m == nil {
+		return nil
+	}
+
+	var objJSON []byte
+	for i := range m.rules {
+		rule := &m.rules[i]
+		if !rule.matches(obj, groupResource) {
+			continue
+		}
+
+		if objJSON == nil {
+			var err error
+			if objJSON, err = obj.MarshalJSON(); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		var err error
+		if objJSON, err = rule.apply(objJSON, obj.GroupVersionKind()); err != nil {
+			return errors.Wrapf(err, "error applying resource modifier rule %d", i)
+		}
+	}
+
+	if objJSON == nil {
+		return nil
+	}
+
+	modified := new(unstructured.Unstructured)
+	if err := modified.UnmarshalJSON(objJSON); err != nil {
+		return errors.Wrap(err, "error decoding modified item")
+	}
+	obj.Object = modified.Object
+	return nil
+}
+
+func (r *compiledModifierRule) apply(objJSON []byte, gvk schema.GroupVersionKind) ([]byte, error) {
+	var err error
+	if r.jsonPatch != nil {
+		if objJSON, err = r.jsonPatch.Apply(objJSON); err != nil {
+			return nil, errors.Wrap(err, "error applying JSON patch")
+		}
+	}
+
+	for i, patch := range r.mergePatches {
+		if objJSON, err = jsonpatch.MergePatch(objJSON, patch); err != nil {
+			return nil, errors.Wrapf(err, "error applying merge patch %d", i)
+		}
+	}
+
+	if len(r.strategicPatches) > 0 {
+		// strategic merge patches need the item's Go type for its patch
+		// strategies, so only work for built-in Kubernetes types.
+		dataStruct, err := scheme.Scheme.New(gvk)
+		if err != nil {
+			return nil, errors.Errorf("strategic merge patches aren't supported for %s, use a merge patch instead", gvk.Kind)
+		}
+
+		for i, patch := range r.strategicPatches {
+			if objJSON, err = strategicpatch.StrategicMergePatch(objJSON, patch, dataStruct); err != nil {
+				return nil, errors.Wrapf(err, "error applying strategic merge patch %d", i)
+			}
+		}
+	}
+
+	return objJSON, nil
+}
@@ -0,0 +1,296 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestParseResourceModifiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr []string
+	}{
+		{
+			name: "valid resource modifiers are parsed",
+			data: `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: persistentvolumeclaims
+    resourceNameRegex: "^mysql-.*$"
+    namespaces: [ns-1]
+    labelSelector:
+      matchLabels:
+        app: mysql
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+  mergePatches:
+  - patchData: |
+      metadata:
+        finalizers: null
+`,
+		},
+		{
+			name:    "unknown fields are rejected",
+			data:    "version: v1\nrules: []\n",
+			wantErr: []string{`error decoding resource modifiers: error unmarshaling JSON: while decoding JSON: json: unknown field "rules"`},
+		},
+		{
+			name:    "unsupported version is rejected",
+			data:    "version: v2\n",
+			wantErr: []string{`unsupported version "v2", must be v1`},
+		},
+		{
+			name: "invalid rules are rejected",
+			data: `
+version: v1
+resourceModifierRules:
+- conditions:
+    resourceNameRegex: "("
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: rename
+    path: /spec
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: move
+    path: /spec/nodeName
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: remove
+    path: spec/nodeName
+- conditions:
+    groupResource: pods
+  mergePatches:
+  - patchData: "[]"
+`,
+			wantErr: []string{
+				"rule 0: conditions.groupResource is required",
+				"rule 0: invalid conditions.resourceNameRegex: error parsing regexp: missing closing ): `(`",
+				"rule 0: at least one patch is required",
+				`rule 1: invalid patch 0: unsupported operation "rename"`,
+				"rule 2: invalid patch 0: from is required for move operations",
+				"rule 3: invalid patch 0: path must be a JSON pointer starting with /",
+				"rule 4: invalid merge patch 0: patch must be an object",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			modifiers, errs := ParseResourceModifiers([]byte(tc.data))
+			if len(tc.wantErr) == 0 {
+				assert.Empty(t, errs)
+				assert.NotNil(t, modifiers)
+				return
+			}
+
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.wantErr, got)
+			assert.Nil(t, modifiers)
+		})
+	}
+}
+
+func TestResourceModifiersApply(t *testing.T) {
+	rules := `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: persistentvolumeclaims
+    namespaces: [ns-1]
+  patches:
+  - operation: replace
+    path: /spec/storageClassName
+    value: premium
+- conditions:
+    groupResource: persistentvolumeclaims
+    resourceNameRegex: "^mysql-"
+  mergePatches:
+  - patchData: '{"metadata": {"finalizers": null}}'
+- conditions:
+    groupResource: pods
+    labelSelector:
+      matchLabels:
+        app: web
+  strategicPatches:
+  - patchData: |
+      spec:
+        containers:
+        - name: web
+          image: registry.example.com/web:1.0
+- conditions:
+    groupResource: widgets.example.com
+  strategicPatches:
+  - patchData: '{"spec": {"size": 1}}'
+- conditions:
+    groupResource: services
+  patches:
+  - operation: test
+    path: /spec/type
+    value: LoadBalancer
+`
+	modifiers, errs := ParseResourceModifiers([]byte(rules))
+	require.Empty(t, errs)
+
+	pvc := func(namespace, name string) *unstructured.Unstructured {
+		return velerotest.UnstructuredOrDie(`{
+			"apiVersion": "v1",
+			"kind": "PersistentVolumeClaim",
+			"metadata": {"namespace": "` + namespace + `", "name": "` + name + `", "finalizers": ["kubernetes.io/pvc-protection"]},
+			"spec": {"storageClassName": "standard"}
+		}`)
+	}
+
+	tests := []struct {
+		name          string
+		obj           *unstructured.Unstructured
+		groupResource string
+		want          *unstructured.Unstructured
+		wantErr       string
+	}{
+		{
+			name:          "json patch is applied to an item in a matching namespace",
+			obj:           pvc("ns-1", "data"),
+			groupResource: "persistentvolumeclaims",
+			want: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "v1",
+				"kind": "PersistentVolumeClaim",
+				"metadata": {"namespace": "ns-1", "name": "data", "finalizers": ["kubernetes.io/pvc-protection"]},
+				"spec": {"storageClassName": "premium"}
+			}`),
+		},
+		{
+			name:          "matching rules are applied in order",
+			obj:           pvc("ns-1", "mysql-data"),
+			groupResource: "persistentvolumeclaims",
+			want: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "v1",
+				"kind": "PersistentVolumeClaim",
+				"metadata": {"namespace": "ns-1", "name": "mysql-data"},
+				"spec": {"storageClassName": "premium"}
+			}`),
+		},
+		{
+			name:          "item that matches no rules isn't modified",
+			obj:           pvc("ns-2", "data"),
+			groupResource: "persistentvolumeclaims",
+			want:          pvc("ns-2", "data"),
+		},
+		{
+			name: "strategic merge patch is applied to a built-in type",
+			obj: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "v1",
+				"kind": "Pod",
+				"metadata": {"namespace": "ns-1", "name": "web", "labels": {"app": "web"}},
+				"spec": {"containers": [{"name": "sidecar", "image": "sidecar:1.0"}, {"name": "web", "image": "web:1.0"}]}
+			}`),
+			groupResource: "pods",
+			want: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "v1",
+				"kind": "Pod",
+				"metadata": {"namespace": "ns-1", "name": "web", "labels": {"app": "web"}},
+				"spec": {"containers": [{"name": "sidecar", "image": "sidecar:1.0"}, {"name": "web", "image": "registry.example.com/web:1.0"}]}
+			}`),
+		},
+		{
+			name: "strategic merge patch of a custom resource fails",
+			obj: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "example.com/v1",
+				"kind": "Widget",
+				"metadata": {"namespace": "ns-1", "name": "widget-1"},
+				"spec": {"size": 3}
+			}`),
+			groupResource: "widgets.example.com",
+			wantErr:       "error applying resource modifier rule 3: strategic merge patches aren't supported for Widget, use a merge patch instead",
+		},
+		{
+			name: "failed patch leaves the item unmodified",
+			obj: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "v1",
+				"kind": "Service",
+				"metadata": {"namespace": "ns-1", "name": "svc-1"},
+				"spec": {"type": "ClusterIP"}
+			}`),
+			groupResource: "services",
+			want: velerotest.UnstructuredOrDie(`{
+				"apiVersion": "v1",
+				"kind": "Service",
+				"metadata": {"namespace": "ns-1", "name": "svc-1"},
+				"spec": {"type": "ClusterIP"}
+			}`),
+			wantErr: "error applying resource modifier rule 4: error applying JSON patch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := modifiers.Apply(tc.obj, schema.ParseGroupResource(tc.groupResource))
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tc.want != nil {
+				assert.Equal(t, tc.want, tc.obj)
+			}
+		})
+	}
+}
+
+func TestResourceModifiersApplyNil(t *testing.T) {
+	obj := velerotest.UnstructuredOrDie(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "pod-1"}}`)
+
+	var modifiers *ResourceModifiers
+	assert.NoError(t, modifiers.Apply(obj, kuberesource.Pods))
+}
+
+func TestGetResourceModifiersData(t *testing.T) {
+	configMap := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "modifiers"},
+		Data:       map[string]string{"rules.yaml": "version: v1\n"},
+	}
+
+	data, err := GetResourceModifiersData(configMap)
+	require.NoError(t, err)
+	assert.Equal(t, "version: v1\n", string(data))
+
+	configMap.Data["more-rules.yaml"] = "version: v1\n"
+	_, err = GetResourceModifiersData(configMap)
+	assert.EqualError(t, err, "config map velero/modifiers must have exactly one key, found 2")
+}
@@ -0,0 +1,222 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+// ValidateResourcePriorities returns a list of errors describing what's wrong
+// with a restore's resource priorities. Resources can't be checked against
+// discovery here, since they may be provided by custom resource definitions
+// that are restored by the restore itself.
+func ValidateResourcePriorities(priorities *velerov1api.RestoreResourcePriorities) []error {
+	var errs []error
+
+	switch priorities.Mode {
+	case "", velerov1api.ResourcePrioritiesModeAppend, velerov1api.ResourcePrioritiesModeReplace:
+	default:
+		errs = append(errs, errors.Errorf("invalid mode %q, must be %s or %s", priorities.Mode, velerov1api.ResourcePrioritiesModeAppend, velerov1api.ResourcePrioritiesModeReplace))
+	}
+
+	seen := sets.NewString()
+	for _, resource := range append(append([]string{}, priorities.HighPriorities...), priorities.LowPriorities...) {
+		if resource == "" {
+			errs = append(errs, errors.New("resources can't be empty"))
+			continue
+		}
+		if seen.Has(resource) {
+			errs = append(errs, errors.Errorf("resource %s is listed more than once", resource))
+		}
+		seen.Insert(resource)
+	}
+
+	for _, resource := range priorities.LowPriorities {
+		if schema.ParseGroupResource(resource).Resource == kuberesource.CustomResourceDefinitions.Resource {
+			errs = append(errs, errors.Errorf("%s are always restored first, so can't be low priority resources", resource))
+		}
+	}
+
+	errs = append(errs, validateGroupOrders(priorities.GroupOrders)...)
+
+	return errs
+}
+
+// validateGroupOrders returns a list of errors describing what's wrong with
+// a restore's group orders. Each order must list at least two resources of
+// the same API group, and each API group can only be ordered once.
+func validateGroupOrders(orders []velerov1api.ResourceGroupOrder) []error {
+	var errs []error
+
+	groups := sets.NewString()
+	for i, order := range orders {
+		if len(order.Resources) < 2 {
+			errs = append(errs, errors.Errorf("group order %d must list at least two resources", i))
+			continue
+		}
+
+		group := schema.ParseGroupResource(order.Resources[0]).Group
+		seen := sets.NewString()
+		for _, resource := range order.Resources {
+			if resource == "" {
+				errs = append(errs, errors.Errorf("group order %d: resources can't be empty", i))
+				continue
+			}
+			if seen.Has(resource) {
+				errs = append(errs, errors.Errorf("group order %d: resource %s is listed more than once", i, resource))
+			}
+			seen.Insert(resource)
+
+			if resourceGroup := schema.ParseGroupResource(resource).Group; resourceGroup != group {
+				errs = append(errs, errors.Errorf("group order %d: resource %s isn't in the same API group as %s", i, resource, order.Resources[0]))
+			}
+		}
+
+		if groups.Has(group) {
+			errs = append(errs, errors.Errorf("group order %d: API group %q is already ordered", i, group))
+		}
+		groups.Insert(group)
+	}
+
+	return errs
+}
+
+// getResourcePriorities returns the high and low priority resources for a
+// restore, given the server's default resource priorities.
+func getResourcePriorities(defaults []string, priorities *velerov1api.RestoreResourcePriorities) ([]string, []string) {
+	if priorities == nil {
+		return defaults, nil
+	}
+
+	if priorities.Mode == velerov1api.ResourcePrioritiesModeReplace {
+		return priorities.HighPriorities, priorities.LowPriorities
+	}
+
+	high := make([]string, 0, len(defaults)+len(priorities.HighPriorities))
+	high = append(high, defaults...)
+	high = append(high, priorities.HighPriorities...)
+	return high, priorities.LowPriorities
+}
+
+// resolveResourcePriorities resolves resources from a restore's resource
+// priorities to group-resources via discovery. Resources that can't be
+// resolved are left out, with a warning, since they have no items that can
+// be restored.
+func (ctx *restoreContext) resolveResourcePriorities(resources []string) ([]string, Result) {
+	var (
+		resolved []string
+		warnings Result
+	)
+
+	for _, resource := range resources {
+		gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			warnings.Add("", errors.Errorf("resource priority %s can't be resolved via discovery and is ignored", resource))
+			continue
+		}
+		resolved = append(resolved, gvr.GroupResource().String())
+	}
+
+	return resolved, warnings
+}
+
+// resolveGroupOrders resolves the resources of a restore's group orders to
+// group-resources via discovery. Resources that can't be resolved are left
+// out, with a warning, and so are orders whose resolved resources aren't all
+// in the same API group, since short names can't be checked before then.
+func (ctx *restoreContext) resolveGroupOrders(orders []velerov1api.ResourceGroupOrder) ([][]string, Result) {
+	var (
+		resolved [][]string
+		warnings Result
+	)
+
+	for i, order := range orders {
+		var (
+			resources []string
+			group     string
+			mixed     bool
+		)
+		for _, resource := range order.Resources {
+			gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+			if err != nil {
+				warnings.Add("", errors.Errorf("group order %d resource %s can't be resolved via discovery and is ignored", i, resource))
+				continue
+			}
+			if len(resources) > 0 && gvr.Group != group {
+				mixed = true
+			}
+			group = gvr.Group
+			resources = append(resources, gvr.GroupResource().String())
+		}
+
+		if mixed {
+			warnings.Add("", errors.Errorf("group order %d resources %s aren't all in the same API group and are ignored", i, strings.Join(resources, ", ")))
+			continue
+		}
+		if len(resources) > 1 {
+			resolved = append(resolved, resources)
+		}
+	}
+
+	return resolved, warnings
+}
+
+// orderGroupResources reorders the resources of each of the ordered API
+// groups in place, keeping the positions that the group's resources take up
+// in the list. The ordered resources take the group's first positions, in
+// order, followed by its other resources in their original order.
+func orderGroupResources(resources []string, groupOrders [][]string) {
+	for _, order := range groupOrders {
+		rank := make(map[string]int, len(order))
+		for i, resource := range order {
+			rank[resource] = i
+		}
+
+		group := schema.ParseGroupResource(order[0]).Group
+		var (
+			positions      []int
+			groupResources []string
+		)
+		for i, resource := range resources {
+			if schema.ParseGroupResource(resource).Group == group {
+				positions = append(positions, i)
+				groupResources = append(groupResources, resource)
+			}
+		}
+
+		sort.SliceStable(groupResources, func(i, j int) bool {
+			rankI, orderedI := rank[groupResources[i]]
+			rankJ, orderedJ := rank[groupResources[j]]
+			if orderedI && orderedJ {
+				return rankI < rankJ
+			}
+			return orderedI && !orderedJ
+		})
+
+		for i, position := range positions {
+			resources[position] = groupResources[i]
+		}
+	}
+}
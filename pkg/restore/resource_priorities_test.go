@@ -0,0 +1,172 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateResourcePriorities(t *testing.T) {
+	tests := []struct {
+		name       string
+		priorities *velerov1api.RestoreResourcePriorities
+		want       []string
+	}{
+		{
+			name: "valid high and low priorities",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				Mode:           velerov1api.ResourcePrioritiesModeReplace,
+				HighPriorities: []string{"namespaces", "deployments.apps"},
+				LowPriorities:  []string{"ingresses.networking.k8s.io"},
+			},
+		},
+		{
+			name: "invalid mode",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				Mode: "Prepend",
+			},
+			want: []string{`invalid mode "Prepend", must be Append or Replace`},
+		},
+		{
+			name: "empty and duplicate resources",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				HighPriorities: []string{"pods", ""},
+				LowPriorities:  []string{"pods"},
+			},
+			want: []string{
+				"resources can't be empty",
+				"resource pods is listed more than once",
+			},
+		},
+		{
+			name: "custom resource definitions can't be low priority",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				LowPriorities: []string{"customresourcedefinitions.apiextensions.k8s.io"},
+			},
+			want: []string{"customresourcedefinitions.apiextensions.k8s.io are always restored first, so can't be low priority resources"},
+		},
+		{
+			name: "valid group orders",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				GroupOrders: []velerov1api.ResourceGroupOrder{
+					{Resources: []string{"widgets.example.com", "gadgets.example.com"}},
+					{Resources: []string{"secrets", "configmaps"}},
+				},
+			},
+		},
+		{
+			name: "invalid group orders",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				GroupOrders: []velerov1api.ResourceGroupOrder{
+					{Resources: []string{"widgets.example.com"}},
+					{Resources: []string{"widgets.example.com", "", "widgets.example.com", "deployments.apps"}},
+					{Resources: []string{"gadgets.example.com", "things.example.com"}},
+				},
+			},
+			want: []string{
+				"group order 0 must list at least two resources",
+				"group order 1: resources can't be empty",
+				"group order 1: resource widgets.example.com is listed more than once",
+				"group order 1: resource deployments.apps isn't in the same API group as widgets.example.com",
+				`group order 2: API group "example.com" is already ordered`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, err := range ValidateResourcePriorities(tc.priorities) {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetResourcePriorities(t *testing.T) {
+	defaults := []string{"namespaces", "pods"}
+
+	tests := []struct {
+		name       string
+		priorities *velerov1api.RestoreResourcePriorities
+		wantHigh   []string
+		wantLow    []string
+	}{
+		{
+			name:     "nil priorities use the defaults",
+			wantHigh: defaults,
+		},
+		{
+			name: "append mode restores the defaults first",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				HighPriorities: []string{"deployments.apps"},
+				LowPriorities:  []string{"ingresses.networking.k8s.io"},
+			},
+			wantHigh: []string{"namespaces", "pods", "deployments.apps"},
+			wantLow:  []string{"ingresses.networking.k8s.io"},
+		},
+		{
+			name: "replace mode ignores the defaults",
+			priorities: &velerov1api.RestoreResourcePriorities{
+				Mode:           velerov1api.ResourcePrioritiesModeReplace,
+				HighPriorities: []string{"deployments.apps"},
+			},
+			wantHigh: []string{"deployments.apps"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			high, low := getResourcePriorities(defaults, tc.priorities)
+			assert.Equal(t, tc.wantHigh, high)
+			assert.Equal(t, tc.wantLow, low)
+		})
+	}
+
+	// appending must not modify the defaults
+	assert.Equal(t, []string{"namespaces", "pods"}, defaults)
+}
+
+func TestResolveGroupOrders(t *testing.T) {
+	resources := map[schema.GroupVersionResource]schema.GroupVersionResource{
+		{Group: "example.com", Resource: "widgets"}: {Group: "example.com", Version: "v1", Resource: "widgets"},
+		{Group: "example.com", Resource: "gadgets"}: {Group: "example.com", Version: "v1", Resource: "gadgets"},
+		{Resource: "wd"}:      {Group: "example.com", Version: "v1", Resource: "widgets"},
+		{Resource: "gd"}:      {Group: "example.com", Version: "v1", Resource: "gadgets"},
+		{Resource: "secrets"}: {Version: "v1", Resource: "secrets"},
+	}
+	ctx := &restoreContext{discoveryHelper: velerotest.NewFakeDiscoveryHelper(false, resources)}
+
+	resolved, warnings := ctx.resolveGroupOrders([]velerov1api.ResourceGroupOrder{
+		{Resources: []string{"wd", "gd"}},
+		{Resources: []string{"gadgets.example.com", "secrets"}},
+		{Resources: []string{"widgets.example.com", "things.example.com"}},
+	})
+
+	assert.Equal(t, [][]string{{"widgets.example.com", "gadgets.example.com"}}, resolved)
+	assert.Equal(t, []string{
+		"group order 1 resources gadgets.example.com, secrets aren't all in the same API group and are ignored",
+		"group order 2 resource things.example.com can't be resolved via discovery and is ignored",
+	}, warnings.Cluster)
+}
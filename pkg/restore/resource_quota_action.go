@@ -0,0 +1,277 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// resourceQuotaRelaxFactorKey is the key of the plugin's config map that
+	// sets the factor that restored quotas' hard limits are multiplied by
+	// until the restore is done.
+	resourceQuotaRelaxFactorKey = "relaxFactor"
+
+	// OriginalQuotaHardAnnotation is set on the resource quotas that are
+	// restored with relaxed hard limits to their original limits, in JSON,
+	// which they're set back to once the restore's items have been restored.
+	OriginalQuotaHardAnnotation = "velero.io/original-quota-hard"
+)
+
+// ResourceQuotaAction removes the status of restored resource quotas, and
+// if its plugin config map sets a relax factor, multiplies their hard limits
+// by it so that the restored workloads aren't blocked by them. The restore
+// sets the limits back once all of its items have been restored.
+type ResourceQuotaAction struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+}
+
+// NewResourceQuotaAction is the constructor for ResourceQuotaAction.
+func NewResourceQuotaAction(logger logrus.FieldLogger, configMapClient corev1client.ConfigMapInterface) *ResourceQuotaAction {
+	return &ResourceQuotaAction{
+		logger:          logger,
+		configMapClient: configMapClient,
+	}
+}
+
+// AppliesTo returns the resources that ResourceQuotaAction should be run for.
+func (a *ResourceQuotaAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"resourcequotas"},
+	}, nil
+}
+
+// Execute removes the item's status, so that its backed up usage isn't
+// restored, and relaxes its hard limits if the plugin's config map sets a
+// relax factor.
+func (a *ResourceQuotaAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing ResourceQuotaAction")
+	defer a.logger.Info("Done executing ResourceQuotaAction")
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	// the status of every restored item is removed before the restore item
+	// actions run, but it's removed here too so that a relaxed quota never
+	// carries the backed up usage, whatever the order of the actions.
+	unstructured.RemoveNestedField(obj.UnstructuredContent(), "status")
+
+	a.logger.Debug("Getting plugin config")
+	config, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/resource-quota", a.configMapClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]string
+	if config != nil {
+		data = config.Data
+	}
+	factor, err := parseResourceQuotaRelaxFactor(data)
+	if err != nil {
+		return nil, err
+	}
+	if factor == 1 {
+		return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	}
+
+	log := a.logger.WithFields(logrus.Fields{
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	})
+
+	hard, found, err := unstructured.NestedStringMap(obj.UnstructuredContent(), "spec", "hard")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting item's hard limits")
+	}
+	if !found || len(hard) == 0 {
+		log.Debug("Resource quota has no hard limits to relax")
+		return velero.NewRestoreItemActionExecuteOutput(obj), nil
+	}
+
+	relaxed, err := relaxQuotaHard(hard, factor)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := json.Marshal(hard)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding item's hard limits")
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[OriginalQuotaHardAnnotation] = string(original)
+	obj.SetAnnotations(annotations)
+
+	if err := unstructured.SetNestedStringMap(obj.UnstructuredContent(), relaxed, "spec", "hard"); err != nil {
+		return nil, errors.Wrap(err, "unable to set item's hard limits")
+	}
+	log.Infof("Relaxing the hard limits of the resource quota by a factor of %d until the restore is done", factor)
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// parseResourceQuotaRelaxFactor parses the relax factor from a plugin config
+// map. It's 1, meaning quotas aren't relaxed, if it isn't set.
+func parseResourceQuotaRelaxFactor(data map[string]string) (int64, error) {
+	value := strings.TrimSpace(data[resourceQuotaRelaxFactorKey])
+	if value == "" {
+		return 1, nil
+	}
+
+	factor, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || factor < 1 {
+		return 0, errors.Errorf("invalid resource quota relax factor %q, must be a positive integer", value)
+	}
+	return factor, nil
+}
+
+// relaxQuotaHard multiplies each of a quota's hard limits by factor. Limits
+// are multiplied in milli-units so that fractional CPUs are multiplied
+// exactly, and limits too big to be multiplied are kept as they are.
+func relaxQuotaHard(hard map[string]string, factor int64) (map[string]string, error) {
+	relaxed := make(map[string]string, len(hard))
+	for name, value := range hard {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing hard limit %s", name)
+		}
+
+		milli := quantity.MilliValue()
+		if milli > math.MaxInt64/factor {
+			relaxed[name] = value
+			continue
+		}
+		relaxed[name] = resource.NewMilliQuantity(milli*factor, quantity.Format).String()
+	}
+	return relaxed, nil
+}
+
+// unrelaxQuota sets the hard limits of a resource quota that was relaxed by
+// ResourceQuotaAction back to the original ones, and removes its original
+// limits annotation.
+func unrelaxQuota(obj *unstructured.Unstructured) {
+	annotations := obj.GetAnnotations()
+	value, ok := annotations[OriginalQuotaHardAnnotation]
+	if !ok {
+		return
+	}
+
+	var original map[string]string
+	if err := json.Unmarshal([]byte(value), &original); err != nil {
+		return
+	}
+	if err := unstructured.SetNestedStringMap(obj.UnstructuredContent(), original, "spec", "hard"); err != nil {
+		return
+	}
+
+	delete(annotations, OriginalQuotaHardAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// relaxedQuota is a resource quota that was restored with relaxed hard
+// limits, which are set back to the original ones at the end of the restore.
+type relaxedQuota struct {
+	namespace string
+	name      string
+	original  map[string]string
+}
+
+// recordRelaxedQuota adds a restored resource quota to the ones whose hard
+// limits are set back at the end of the restore, if it was restored with
+// relaxed limits.
+func (ctx *restoreContext) recordRelaxedQuota(obj *unstructured.Unstructured) {
+	value, ok := obj.GetAnnotations()[OriginalQuotaHardAnnotation]
+	if !ok {
+		return
+	}
+
+	var original map[string]string
+	if err := json.Unmarshal([]byte(value), &original); err != nil {
+		ctx.log.WithError(errors.WithStack(err)).Warnf("Unable to decode the original hard limits of resource quota %s/%s, they won't be set back", obj.GetNamespace(), obj.GetName())
+		return
+	}
+	ctx.relaxedQuotas = append(ctx.relaxedQuotas, relaxedQuota{namespace: obj.GetNamespace(), name: obj.GetName(), original: original})
+}
+
+// restoreRelaxedQuotas sets the hard limits of the resource quotas that were
+// restored with relaxed limits back to the original ones, and removes their
+// original limits annotation. Quotas whose limits can't be set back are
+// returned as errors, since they'd keep allowing more than they should.
+func (ctx *restoreContext) restoreRelaxedQuotas() Result {
+	errs := Result{}
+	if len(ctx.relaxedQuotas) == 0 {
+		return errs
+	}
+
+	ctx.log.Infof("Setting the hard limits of %d relaxed resource quotas back", len(ctx.relaxedQuotas))
+	apiResource := metav1.APIResource{Name: kuberesource.ResourceQuotas.Resource, Namespaced: true}
+	for _, quota := range ctx.relaxedQuotas {
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{OriginalQuotaHardAnnotation: nil},
+			},
+			"spec": map[string]interface{}{
+				"hard": quota.original,
+			},
+		})
+		if err != nil {
+			errs.Add(quota.namespace, errors.Wrapf(err, "error encoding the original hard limits of resource quota %s", quota.name))
+			continue
+		}
+
+		resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Version: "v1"}, apiResource, quota.namespace)
+		if err != nil {
+			errs.Add(quota.namespace, errors.Wrapf(err, "error getting client to set back the hard limits of resource quota %s", quota.name))
+			continue
+		}
+
+		_, err = resourceClient.Patch(go_context.TODO(), quota.name, patch)
+		switch {
+		case apierrors.IsNotFound(err):
+			ctx.log.Infof("Resource quota %s/%s was deleted before its hard limits could be set back", quota.namespace, quota.name)
+		case err != nil:
+			errs.Add(quota.namespace, errors.Wrapf(err, "error setting back the hard limits of resource quota %s", quota.name))
+		}
+	}
+
+	return errs
+}
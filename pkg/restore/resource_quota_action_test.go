@@ -0,0 +1,164 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestResourceQuotaActionExecute(t *testing.T) {
+	pluginConfig := func(data ...string) *corev1api.ConfigMap {
+		return builder.ForConfigMap("velero", "resource-quota").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/resource-quota", "RestoreItemAction")).
+			Data(data...).
+			Result()
+	}
+
+	tests := []struct {
+		name      string
+		item      string
+		configMap *corev1api.ConfigMap
+		want      string
+		wantErr   string
+	}{
+		{
+			name: "when no config map exists for the plugin, only the status is removed",
+			item: `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}},"status":{"hard":{"pods":"10"},"used":{"pods":"7"}}}`,
+			want: `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}}}`,
+		},
+		{
+			name:      "relax factor multiplies the hard limits and records the original ones",
+			item:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10","requests.cpu":"500m","limits.memory":"1Gi"}},"status":{"used":{"pods":"7"}}}`,
+			configMap: pluginConfig("relaxFactor", "2"),
+			want:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1","annotations":{"velero.io/original-quota-hard":"{\"limits.memory\":\"1Gi\",\"pods\":\"10\",\"requests.cpu\":\"500m\"}"}},"spec":{"hard":{"pods":"20","requests.cpu":"1","limits.memory":"2Gi"}}}`,
+		},
+		{
+			name:      "quota without hard limits isn't annotated",
+			item:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"scopes":["BestEffort"]}}`,
+			configMap: pluginConfig("relaxFactor", "2"),
+			want:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"scopes":["BestEffort"]}}`,
+		},
+		{
+			name:      "relax factor of 1 leaves the hard limits as they are",
+			item:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}}}`,
+			configMap: pluginConfig("relaxFactor", "1"),
+			want:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}}}`,
+		},
+		{
+			name:      "relax factor that isn't a positive integer is an error",
+			item:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}}}`,
+			configMap: pluginConfig("relaxFactor", "1.5"),
+			wantErr:   `invalid resource quota relax factor "1.5", must be a positive integer`,
+		},
+		{
+			name:      "hard limit that isn't a quantity is an error",
+			item:      `{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"many"}}}`,
+			configMap: pluginConfig("relaxFactor", "2"),
+			wantErr:   "error parsing hard limit pods: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			a := NewResourceQuotaAction(logrus.StandardLogger(), clientset.CoreV1().ConfigMaps("velero"))
+
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{
+				Item: velerotest.UnstructuredOrDie(tc.item),
+			})
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, velerotest.UnstructuredOrDie(tc.want), res.UpdatedItem)
+		})
+	}
+}
+
+func TestRestoreRelaxedQuotas(t *testing.T) {
+	resourceClient := &velerotest.FakeDynamicClient{}
+	resourceClient.On("Patch", "quota-1", []byte(`{"metadata":{"annotations":{"velero.io/original-quota-hard":null}},"spec":{"hard":{"pods":"10"}}}`)).Return(&unstructured.Unstructured{}, nil)
+	resourceClient.On("Patch", "quota-2", mock.Anything).Return((*unstructured.Unstructured)(nil), apierrors.NewNotFound(kuberesource.ResourceQuotas, "quota-2"))
+	resourceClient.On("Patch", "quota-3", mock.Anything).Return((*unstructured.Unstructured)(nil), apierrors.NewForbidden(kuberesource.ResourceQuotas, "quota-3", errors.New("denied")))
+	dynamicFactory := &velerotest.FakeDynamicFactory{}
+	dynamicFactory.On("ClientForGroupVersionResource", schema.GroupVersion{Version: "v1"}, mock.Anything, "ns-1").Return(resourceClient, nil)
+
+	ctx := &restoreContext{
+		log:            velerotest.NewLogger(),
+		dynamicFactory: dynamicFactory,
+	}
+
+	// only quotas with the original limits annotation are recorded.
+	quota := func(name string, annotations ...string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ResourceQuota"}}
+		obj.SetNamespace("ns-1")
+		obj.SetName(name)
+		if len(annotations) > 0 {
+			obj.SetAnnotations(map[string]string{OriginalQuotaHardAnnotation: annotations[0]})
+		}
+		return obj
+	}
+	ctx.recordRelaxedQuota(quota("quota-1", `{"pods":"10"}`))
+	ctx.recordRelaxedQuota(quota("quota-2", `{"pods":"5"}`))
+	ctx.recordRelaxedQuota(quota("quota-3", `{"pods":"1"}`))
+	ctx.recordRelaxedQuota(quota("quota-4"))
+	ctx.recordRelaxedQuota(quota("quota-5", "not json"))
+	require.Len(t, ctx.relaxedQuotas, 3)
+
+	// deleted quotas are skipped, and quotas that can't be set back are
+	// errors.
+	errs := ctx.restoreRelaxedQuotas()
+	assert.Equal(t, Result{Namespaces: map[string][]string{
+		"ns-1": {`error setting back the hard limits of resource quota quota-3: resourcequotas "quota-3" is forbidden: denied`},
+	}}, errs)
+	resourceClient.AssertExpectations(t)
+}
+
+func TestUnrelaxQuota(t *testing.T) {
+	obj := velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1","annotations":{"velero.io/original-quota-hard":"{\"pods\":\"10\"}"}},"spec":{"hard":{"pods":"20"}}}`)
+	unrelaxQuota(obj)
+	assert.Equal(t, velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}}}`), obj)
+
+	// quotas that weren't relaxed are left as they are.
+	unrelaxQuota(obj)
+	assert.Equal(t, velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"ResourceQuota","metadata":{"namespace":"ns-1","name":"quota-1"},"spec":{"hard":{"pods":"10"}}}`), obj)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// getResourceTimeout returns the restore's resource timeout if it's set, and
+// the server's default otherwise. A timeout of 0 means items never time out.
+func getResourceTimeout(defaultTimeout time.Duration, restore *velerov1api.Restore) time.Duration {
+	if restore.Spec.ResourceTimeout != nil {
+		return restore.Spec.ResourceTimeout.Duration
+	}
+	return defaultTimeout
+}
+
+// withResourceTimeout returns a context for a single blocking call made while
+// restoring an item, which is cancelled once the resource timeout has passed.
+func (ctx *restoreContext) withResourceTimeout() (go_context.Context, go_context.CancelFunc) {
+	if ctx.resourceTimeout <= 0 {
+		return go_context.WithCancel(go_context.Background())
+	}
+	return go_context.WithTimeout(go_context.Background(), ctx.resourceTimeout)
+}
+
+// isTimedOut returns whether err is the result of callCtx's deadline passing.
+func isTimedOut(callCtx go_context.Context, err error) bool {
+	return err != nil && callCtx.Err() == go_context.DeadlineExceeded
+}
+
+// recordTimedOut logs that an item couldn't be restored because op took
+// longer than the resource timeout, and records it in the timed out items.
+func (ctx *restoreContext) recordTimedOut(namespace, resourceID, op string) {
+	ctx.log.Errorf("Timed out restoring %s: %s took longer than %s", resourceID, op, ctx.resourceTimeout)
+	ctx.timedOutItems.Add(namespace, errors.Errorf("%s: %s timed out after %s", resourceID, op, ctx.resourceTimeout))
+}
+
+// executeItemAction runs a restore item action, giving up on it once callCtx
+// is done. Restore item actions can't be cancelled, so an action that's given
+// up on keeps running in the background and its output is discarded.
+func executeItemAction(callCtx go_context.Context, action velero.RestoreItemAction, input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	type result struct {
+		output *velero.RestoreItemActionExecuteOutput
+		err    error
+	}
+
+	// buffered so the goroutine can exit even if the action is given up on.
+	done := make(chan result, 1)
+	go func() {
+		output, err := action.Execute(input)
+		done <- result{output: output, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.output, r.err
+	case <-callCtx.Done():
+		return nil, errors.Wrap(callCtx.Err(), "restore item action didn't finish")
+	}
+}
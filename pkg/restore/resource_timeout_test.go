@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetResourceTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		restore *builder.RestoreBuilder
+		want    time.Duration
+	}{
+		{
+			name:    "restore without a resource timeout uses the server's default",
+			restore: builder.ForRestore("velero", "restore-1"),
+			want:    time.Minute,
+		},
+		{
+			name:    "restore's resource timeout overrides the server's default",
+			restore: builder.ForRestore("velero", "restore-1").ResourceTimeout(time.Second),
+			want:    time.Second,
+		},
+		{
+			name:    "restore can disable the resource timeout",
+			restore: builder.ForRestore("velero", "restore-1").ResourceTimeout(0),
+			want:    0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, getResourceTimeout(time.Minute, tc.restore.Result()))
+		})
+	}
+}
+
+func TestExecuteItemAction(t *testing.T) {
+	item := velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"}}`)
+	input := &velero.RestoreItemActionExecuteInput{Item: item, ItemFromBackup: item}
+
+	t.Run("action that finishes before the timeout returns its output", func(t *testing.T) {
+		callCtx, cancel := go_context.WithTimeout(go_context.Background(), time.Minute)
+		defer cancel()
+
+		output, err := executeItemAction(callCtx, &pluggableAction{}, input)
+		require.NoError(t, err)
+		assert.Equal(t, item, output.UpdatedItem)
+		assert.False(t, isTimedOut(callCtx, err))
+	})
+
+	t.Run("action that doesn't finish before the timeout is given up on", func(t *testing.T) {
+		callCtx, cancel := go_context.WithTimeout(go_context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		release := make(chan struct{})
+		defer close(release)
+		action := &pluggableAction{
+			executeFunc: func(*velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+				<-release
+				return nil, nil
+			},
+		}
+
+		output, err := executeItemAction(callCtx, action, input)
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		assert.True(t, isTimedOut(callCtx, err))
+	})
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -108,7 +107,7 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	// TODO we might want/need to get plugin config at the top of this method at some point; for now, wait
 	// until we know we're doing a restore before getting config.
 	log.Debugf("Getting plugin config")
-	config, err := getPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/restic", a.client)
+	config, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/restic", a.client)
 	if err != nil {
 		return nil, err
 	}
@@ -151,13 +150,28 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	initContainerBuilder.Resources(&resourceReqs)
 	initContainerBuilder.SecurityContext(&securityContext)
 
+	blockVolumes := restic.GetBlockVolumeBackupsForPod(podVolumeBackups, &pod, podFromBackup.Namespace)
 	for volumeName := range volumeSnapshots {
 		mount := &corev1.VolumeMount{
 			Name:      volumeName,
 			MountPath: "/restores/" + volumeName,
 		}
+		// raw block volumes can't be mounted, so a subdirectory of an
+		// emptyDir volume is mounted in their place for their done files.
+		if blockVolumes[volumeName] {
+			mount.Name = restic.BlockRestoresVolume
+			mount.SubPath = volumeName
+		}
 		initContainerBuilder.VolumeMounts(mount)
 	}
+	if len(blockVolumes) > 0 && !hasVolume(&pod, restic.BlockRestoresVolume) {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: restic.BlockRestoresVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
 	initContainerBuilder.Command(getCommand(log, config))
 
 	initContainer := *initContainerBuilder.Result()
@@ -175,6 +189,15 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
 }
 
+func hasVolume(pod *corev1.Pod, name string) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func getCommand(log logrus.FieldLogger, config *corev1.ConfigMap) []string {
 	if config == nil {
 		log.Debug("No config found for plugin")
@@ -255,35 +278,6 @@ func getSecurityContext(log logrus.FieldLogger, config *corev1.ConfigMap) (strin
 	return config.Data["secCtxRunAsUser"], config.Data["secCtxRunAsGroup"], config.Data["secCtxAllowPrivilegeEscalation"]
 }
 
-// TODO eventually this can move to pkg/plugin/framework since it'll be used across multiple
-// plugins.
-func getPluginConfig(kind framework.PluginKind, name string, client corev1client.ConfigMapInterface) (*corev1.ConfigMap, error) {
-	opts := metav1.ListOptions{
-		// velero.io/plugin-config: true
-		// velero.io/restic: RestoreItemAction
-		LabelSelector: fmt.Sprintf("velero.io/plugin-config,%s=%s", name, kind),
-	}
-
-	list, err := client.List(context.TODO(), opts)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-
-	if len(list.Items) == 0 {
-		return nil, nil
-	}
-
-	if len(list.Items) > 1 {
-		var items []string
-		for _, item := range list.Items {
-			items = append(items, item.Name)
-		}
-		return nil, errors.Errorf("found more than one ConfigMap matching label selector %q: %v", opts.LabelSelector, items)
-	}
-
-	return &list.Items[0], nil
-}
-
 func newResticInitContainerBuilder(image, restoreUID string) *builder.ContainerBuilder {
 	return builder.ForContainer(restic.InitContainer, image).
 		Args(restoreUID).
@@ -44,7 +44,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
-	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/cluster-api/controllers/remote"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
@@ -55,6 +55,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemstatus"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
@@ -88,6 +89,78 @@ type Request struct {
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
 	BackupReader     io.Reader
+
+	// BackupItemStatus, if non-nil, lists the items that failed to be backed
+	// up. They're skipped if the restore's SkipFailedBackupItems is set, and
+	// restored with a warning otherwise.
+	BackupItemStatus *itemstatus.Manifest
+
+	// DryRunSummary, if non-nil, is populated with the items a dry-run
+	// restore would have created, updated or skipped.
+	DryRunSummary *DryRunSummary
+
+	// ChangeSummary, if non-nil, is populated with what the restore did with
+	// the items that it created, or that already existed in the cluster.
+	ChangeSummary *ChangeSummary
+
+	// TimedOutItems, if non-nil, is populated with the items that couldn't
+	// be restored because a call made while restoring them exceeded the
+	// resource timeout.
+	TimedOutItems *Result
+
+	// RenamedItems, if non-nil, is populated with the original and new
+	// names of the items that were restored with a generated name because
+	// their name was taken.
+	RenamedItems *Result
+
+	// UnchangedItems, if non-nil, is populated with the items that already
+	// existed in the cluster and were the same as the backed up version, if
+	// the restore's existing resource policy is updateIfChanged, or
+	// addMissingKeys or overwriteKeys for ConfigMaps and Secrets.
+	UnchangedItems *Result
+
+	// WorkloadReadiness, if non-nil, is populated with the result of
+	// waiting for the restored workloads to become ready, if the restore's
+	// spec.waitForWorkloads is set.
+	WorkloadReadiness *velerov1api.WorkloadReadinessStatus
+
+	// ResumeCheckpoint, if non-nil, is the checkpoint of the restore being
+	// resumed. Its items are skipped if they still exist in the cluster.
+	ResumeCheckpoint *Checkpoint
+
+	// PutCheckpoint, if non-nil, is called periodically during the restore,
+	// and once all items have been restored, with the items restored so far.
+	PutCheckpoint func(*Checkpoint) error
+
+	// ResourceModifiers, if non-nil, are applied to each item before it's
+	// created.
+	ResourceModifiers *ResourceModifiers
+
+	// BaseBackups are the contents of the backups that an incremental
+	// backup's unchanged items were backed up in.
+	BaseBackups []BaseBackupContents
+
+	// FileSystem, if non-nil, is the file system the backup is extracted to
+	// and its items are read from, instead of the local disk.
+	FileSystem filesystem.Interface
+
+	// Validators check the restored items that they apply to once all of
+	// the restore's items have been created. They aren't invoked for dry
+	// runs.
+	Validators []velero.RestoreValidator
+
+	// Canceled reports whether the restore's cancellation has been
+	// requested, in which case no more items are restored. If it's nil, the
+	// restore can't be canceled.
+	Canceled func() bool
+}
+
+// BaseBackupContents is the tarball of a backup that an incremental backup is
+// based on, and the files in it that belong to the incremental backup.
+type BaseBackupContents struct {
+	Backup *velerov1api.Backup
+	Reader io.Reader
+	Files  []string
 }
 
 // Restorer knows how to restore a backup.
@@ -108,12 +181,15 @@ type kubernetesRestorer struct {
 	resticRestorerFactory      restic.RestorerFactory
 	resticTimeout              time.Duration
 	resourceTerminatingTimeout time.Duration
+	resourceTimeout            time.Duration
+	crdEstablishTimeout        time.Duration
+	itemRetries                int
+	itemRetryBackoff           time.Duration
+	itemWorkers                int
 	resourcePriorities         []string
 	fileSystem                 filesystem.Interface
 	pvRenamer                  func(string) (string, error)
 	logger                     logrus.FieldLogger
-	podCommandExecutor         podexec.PodCommandExecutor
-	podGetter                  cache.Getter
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -127,9 +203,12 @@ func NewKubernetesRestorer(
 	resticRestorerFactory restic.RestorerFactory,
 	resticTimeout time.Duration,
 	resourceTerminatingTimeout time.Duration,
+	resourceTimeout time.Duration,
+	crdEstablishTimeout time.Duration,
+	itemRetries int,
+	itemRetryBackoff time.Duration,
+	itemWorkers int,
 	logger logrus.FieldLogger,
-	podCommandExecutor podexec.PodCommandExecutor,
-	podGetter cache.Getter,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		restoreClient:              restoreClient,
@@ -137,6 +216,11 @@ func NewKubernetesRestorer(
 		resticRestorerFactory:      resticRestorerFactory,
 		resticTimeout:              resticTimeout,
 		resourceTerminatingTimeout: resourceTerminatingTimeout,
+		resourceTimeout:            resourceTimeout,
+		crdEstablishTimeout:        crdEstablishTimeout,
+		itemRetries:                itemRetries,
+		itemRetryBackoff:           itemRetryBackoff,
+		itemWorkers:                itemWorkers,
 		resourcePriorities:         resourcePriorities,
 		logger:                     logger,
 		pvRenamer: func(string) (string, error) {
@@ -147,9 +231,7 @@ func NewKubernetesRestorer(
 			veleroCloneName := "velero-clone-" + veleroCloneUuid.String()
 			return veleroCloneName, nil
 		},
-		fileSystem:         filesystem.NewFileSystem(),
-		podCommandExecutor: podCommandExecutor,
-		podGetter:          podGetter,
+		fileSystem: filesystem.NewFileSystem(),
 	}, nil
 }
 
@@ -164,10 +246,16 @@ func (kr *kubernetesRestorer) Restore(
 ) (Result, Result) {
 	// NOTE: This requires that the BackupStorageLocation must always be named exactly as the target cluster.
 	clusterName := req.Location.Name
-	clientSet, dynamicClient, err := kube.NewClusterClients(go_context.Background(), kr.client, kbclient.ObjectKey{
+	cluster := kbclient.ObjectKey{
 		Namespace: clusterName,
 		Name:      clusterName,
-	})
+	}
+	clientSet, dynamicClient, err := kube.NewClusterClients(go_context.Background(), kr.client, cluster)
+	if err != nil {
+		return Result{}, Result{Velero: []string{err.Error()}}
+	}
+	// Post-restore exec hooks run in the restored pods, so they need the target cluster's config.
+	restConfig, err := remote.RESTConfig(go_context.Background(), kr.client, cluster)
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
@@ -208,6 +296,11 @@ func (kr *kubernetesRestorer) Restore(
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
 
+	resolvedValidators, err := resolveValidators(req.Validators, discoveryHelper)
+	if err != nil {
+		return Result{}, Result{Velero: []string{err.Error()}}
+	}
+
 	podVolumeTimeout := kr.resticTimeout
 	if val := req.Restore.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
 		parsed, err := time.ParseDuration(val)
@@ -238,12 +331,38 @@ func (kr *kubernetesRestorer) Restore(
 	}
 	hooksCtx, hooksCancelFunc := go_context.WithCancel(go_context.Background())
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
-		PodCommandExecutor: kr.podCommandExecutor,
+		PodCommandExecutor: podexec.NewPodCommandExecutor(restConfig, clientSet.CoreV1().RESTClient()),
 		ListWatchFactory: &hook.DefaultListWatchFactory{
-			PodsGetter: kr.podGetter,
+			PodsGetter: clientSet.CoreV1().RESTClient(),
 		},
 	}
 
+	dryRunSummary := req.DryRunSummary
+	if dryRunSummary == nil {
+		dryRunSummary = new(DryRunSummary)
+	}
+
+	timedOutItems := req.TimedOutItems
+	if timedOutItems == nil {
+		timedOutItems = new(Result)
+	}
+
+	renamedItems := req.RenamedItems
+	if renamedItems == nil {
+		renamedItems = new(Result)
+	}
+	unchangedItems := req.UnchangedItems
+	if unchangedItems == nil {
+		unchangedItems = new(Result)
+	}
+
+	workloadReadiness := req.WorkloadReadiness
+	if workloadReadiness == nil {
+		workloadReadiness = new(velerov1api.WorkloadReadinessStatus)
+	}
+
+	resourcePriorities, lowResourcePriorities := getResourcePriorities(kr.resourcePriorities, req.Restore.Spec.ResourcePriorities)
+
 	pvRestorer := &pvRestorer{
 		logger:                  req.Log,
 		backup:                  req.Backup,
@@ -254,39 +373,71 @@ func (kr *kubernetesRestorer) Restore(
 		snapshotLocationLister:  snapshotLocationLister,
 	}
 
+	fileSystem := kr.fileSystem
+	if req.FileSystem != nil {
+		fileSystem = req.FileSystem
+	}
+
 	restoreCtx := &restoreContext{
 		backup:                     req.Backup,
 		backupReader:               req.BackupReader,
+		baseBackups:                req.BaseBackups,
 		restore:                    req.Restore,
 		resourceIncludesExcludes:   resourceIncludesExcludes,
 		namespaceIncludesExcludes:  namespaceIncludesExcludes,
+		namespaceResourceFilters:   getNamespaceResourceFilters(discoveryHelper, req.Restore.Spec.NamespaceResourceFilters),
 		chosenGrpVersToRestore:     make(map[string]ChosenGroupVersion),
 		selector:                   selector,
 		log:                        req.Log,
 		dynamicFactory:             dynamicFactory,
-		fileSystem:                 kr.fileSystem,
+		fileSystem:                 fileSystem,
 		namespaceClient:            namespaceClient,
 		actions:                    resolvedActions,
+		validators:                 resolvedValidators,
 		volumeSnapshotterGetter:    volumeSnapshotterGetter,
 		resticRestorer:             resticRestorer,
 		resticErrs:                 make(chan error),
 		pvsToProvision:             sets.NewString(),
+		recreatedNamespaces:        sets.NewString(),
 		pvRestorer:                 pvRestorer,
 		volumeSnapshots:            req.VolumeSnapshots,
 		podVolumeBackups:           req.PodVolumeBackups,
+		resourceModifiers:          req.ResourceModifiers,
 		resourceTerminatingTimeout: kr.resourceTerminatingTimeout,
+		resourceTimeout:            getResourceTimeout(kr.resourceTimeout, req.Restore),
+		crdEstablishTimeout:        kr.crdEstablishTimeout,
+		itemRetries:                kr.itemRetries,
+		itemRetryBackoff:           kr.itemRetryBackoff,
+		itemWorkers:                kr.itemWorkers,
+		unestablishedCRDs:          make(map[string]string),
+		timedOutItems:              timedOutItems,
+		generateNameResources:      getGenerateNameResources(req.Restore.Spec.GenerateNameOnConflict),
+		renamedItems:               renamedItems,
+		unchangedItems:             unchangedItems,
+		workloadReadiness:          workloadReadiness,
 		resourceClients:            make(map[resourceClientKey]client.Dynamic),
 		restoredItems:              make(map[velero.ResourceIdentifier]struct{}),
 		renamedPVs:                 make(map[string]string),
 		pvRenamer:                  kr.pvRenamer,
 		discoveryHelper:            discoveryHelper,
-		resourcePriorities:         kr.resourcePriorities,
+		resourcePriorities:         resourcePriorities,
+		lowResourcePriorities:      lowResourcePriorities,
 		resourceRestoreHooks:       resourceRestoreHooks,
-		hooksErrs:                  make(chan error),
+		hooksResults:               make(chan execHooksResult),
 		waitExecHookHandler:        waitExecHookHandler,
 		hooksContext:               hooksCtx,
 		hooksCancelFunc:            hooksCancelFunc,
 		restoreClient:              kr.restoreClient,
+		dryRun:                     boolptr.IsSetToTrue(req.Restore.Spec.DryRun),
+		dryRunSummary:              dryRunSummary,
+		changeSummary:              req.ChangeSummary,
+		resumedItems:               resumedItems(req.ResumeCheckpoint),
+		failedBackupItems:          failedBackupItems(req.BackupItemStatus),
+		checkpoint:                 new(Checkpoint),
+		putCheckpoint:              req.PutCheckpoint,
+		checkpointInterval:         checkpointInterval,
+		lastCheckpoint:             time.Now(),
+		cancelRequested:            req.Canceled,
 	}
 
 	return restoreCtx.execute()
@@ -309,14 +460,9 @@ func resolveActions(actions []velero.RestoreItemAction, helper discovery.Helper)
 			return nil, err
 		}
 
-		resources := collections.GetResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
-		namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
-
-		selector := labels.Everything()
-		if resourceSelector.LabelSelector != "" {
-			if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
-				return nil, err
-			}
+		resources, namespaces, selector, err := resolveResourceSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, err
 		}
 
 		res := resolvedAction{
@@ -332,14 +478,33 @@ func resolveActions(actions []velero.RestoreItemAction, helper discovery.Helper)
 	return resolved, nil
 }
 
+// resolveResourceSelector returns the resources, namespaces and labels that a
+// plugin's resource selector matches.
+func resolveResourceSelector(resourceSelector velero.ResourceSelector, helper discovery.Helper) (*collections.IncludesExcludes, *collections.IncludesExcludes, labels.Selector, error) {
+	resources := collections.GetResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
+	namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
+
+	selector := labels.Everything()
+	if resourceSelector.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return resources, namespaces, selector, nil
+}
+
 type restoreContext struct {
 	backup                     *velerov1api.Backup
 	backupReader               io.Reader
+	baseBackups                []BaseBackupContents
 	restore                    *velerov1api.Restore
 	restoreDir                 string
 	restoreClient              velerov1client.RestoresGetter
 	resourceIncludesExcludes   *collections.IncludesExcludes
 	namespaceIncludesExcludes  *collections.IncludesExcludes
+	namespaceResourceFilters   []namespaceResourceFilter
 	chosenGrpVersToRestore     map[string]ChosenGroupVersion
 	selector                   labels.Selector
 	log                        logrus.FieldLogger
@@ -347,27 +512,67 @@ type restoreContext struct {
 	fileSystem                 filesystem.Interface
 	namespaceClient            corev1.NamespaceInterface
 	actions                    []resolvedAction
+	validators                 []resolvedValidator
+	itemsToValidate            []velero.ResourceIdentifier
 	volumeSnapshotterGetter    VolumeSnapshotterGetter
 	resticRestorer             restic.Restorer
 	resticWaitGroup            sync.WaitGroup
 	resticErrs                 chan error
 	pvsToProvision             sets.String
+	recreatedNamespaces        sets.String
+	volumes                    *volumeSelection
 	pvRestorer                 PVRestorer
 	volumeSnapshots            []*volume.Snapshot
 	podVolumeBackups           []*velerov1api.PodVolumeBackup
+	resourceModifiers          *ResourceModifiers
 	resourceTerminatingTimeout time.Duration
+	resourceTimeout            time.Duration
+	crdEstablishTimeout        time.Duration
+	itemRetries                int
+	itemRetryBackoff           time.Duration
+	itemWorkers                int
+	itemLock                   sync.Mutex
+	concurrentItems            bool
+	unestablishedCRDs          map[string]string
+	timedOutItems              *Result
+	generateNameResources      sets.String
+	renamedItems               *Result
+	unchangedItems             *Result
+	restoredWorkloads          []restoredWorkload
+	relaxedQuotas              []relaxedQuota
+	workloadReadiness          *velerov1api.WorkloadReadinessStatus
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
 	renamedPVs                 map[string]string
 	pvRenamer                  func(string) (string, error)
 	discoveryHelper            discovery.Helper
 	resourcePriorities         []string
+	lowResourcePriorities      []string
+	groupOrders                [][]string
 	hooksWaitGroup             sync.WaitGroup
-	hooksErrs                  chan error
+	hooksResults               chan execHooksResult
 	resourceRestoreHooks       []hook.ResourceRestoreHook
 	waitExecHookHandler        hook.WaitExecHookHandler
 	hooksContext               go_context.Context
 	hooksCancelFunc            go_context.CancelFunc
+	dryRun                     bool
+	dryRunSummary              *DryRunSummary
+	changeSummary              *ChangeSummary
+	resumedItems               map[velero.ResourceIdentifier]CheckpointItem
+	failedBackupItems          map[velero.ResourceIdentifier][]string
+	checkpoint                 *Checkpoint
+	putCheckpoint              func(*Checkpoint) error
+	checkpointInterval         time.Duration
+	lastCheckpoint             time.Time
+	cancelRequested            func() bool
+	canceled                   bool
+}
+
+// execHooksResult holds the warnings and errors from executing a restored
+// pod's post-restore exec hooks.
+type execHooksResult struct {
+	warnings Result
+	errs     Result
 }
 
 type resourceClientKey struct {
@@ -378,14 +583,16 @@ type resourceClientKey struct {
 // getOrderedResources returns an ordered list of resource identifiers to restore,
 // based on the provided resource priorities and backup contents. The returned list
 // begins with all of the prioritized resources (in order), and appends to that
-// an alphabetized list of all resources in the backup.
-func getOrderedResources(resourcePriorities []string, backupResources map[string]*archive.ResourceItems) []string {
+// an alphabetized list of all resources in the backup, with the resources of each
+// of the group orders' API groups reordered by them.
+func getOrderedResources(resourcePriorities []string, groupOrders [][]string, backupResources map[string]*archive.ResourceItems) []string {
 	// alphabetize resources in the backup
 	orderedBackupResources := make([]string, 0, len(backupResources))
 	for resource := range backupResources {
 		orderedBackupResources = append(orderedBackupResources, resource)
 	}
 	sort.Strings(orderedBackupResources)
+	orderGroupResources(orderedBackupResources, groupOrders)
 
 	// Main list: everything in resource priorities, followed by what's in the
 	// backup (alphabetized).
@@ -400,8 +607,11 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	warnings, errs := Result{}, Result{}
 
 	ctx.log.Infof("Starting restore of backup %s", kube.NamespaceAndName(ctx.backup))
+	if ctx.dryRun {
+		ctx.log.Info("Restore is a dry run, nothing will be created or patched in the cluster")
+	}
 
-	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader)
+	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader, ctx.backup.Status.CompressionAlgorithm)
 	if err != nil {
 		ctx.log.Infof("error unzipping and extracting: %v", err)
 		errs.AddVeleroError(err)
@@ -409,6 +619,14 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	defer ctx.fileSystem.RemoveAll(dir)
 
+	for _, base := range ctx.baseBackups {
+		ctx.log.Infof("Extracting %d unchanged items' files from base backup %s", len(base.Files), base.Backup.Name)
+		if err := archive.NewExtractor(ctx.log, ctx.fileSystem).ExtractBackupFiles(base.Reader, base.Backup.Status.CompressionAlgorithm, dir, base.Files); err != nil {
+			errs.AddVeleroError(errors.Wrapf(err, "error extracting unchanged items from base backup %s", base.Backup.Name))
+			return warnings, errs
+		}
+	}
+
 	// Need to set this for additionalItems to be restored.
 	ctx.restoreDir = dir
 
@@ -419,7 +637,10 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 
 	// TODO: Remove outer feature flag check to make this feature a default in Velero.
-	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
+	// Without the feature flag, versions are only chosen for the resources
+	// that the backup stored at other API versions, or that the restore
+	// prefers versions of.
+	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) || len(ctx.backup.Spec.ResourceAPIVersions) > 0 || len(ctx.restore.Spec.PreferredAPIVersions) > 0 {
 		if ctx.backup.Status.FormatVersion >= "1.1.0" {
 			if err := ctx.chooseAPIVersionsToRestore(); err != nil {
 				errs.AddVeleroError(errors.Wrap(err, "choosing API version to restore"))
@@ -428,6 +649,48 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		}
 	}
 
+	// Resolve the namespace mapping template before restoring anything, so that
+	// a template that can't be rendered for a namespace fails the restore rather
+	// than restoring items into the wrong namespace.
+	if ctx.restore.Spec.NamespaceMappingTemplate != nil {
+		namespaceMapping, e := ctx.resolveNamespaceMapping(backupResources)
+		if len(e.Velero) > 0 || len(e.Cluster) > 0 || len(e.Namespaces) > 0 {
+			errs.Merge(&e)
+			return warnings, errs
+		}
+
+		// Work on a copy of the restore carrying the resolved mapping, so that
+		// all consumers of Spec.NamespaceMapping, including restore item
+		// actions, see the same target namespaces.
+		ctx.restore = ctx.restore.DeepCopy()
+		ctx.restore.Spec.NamespaceMapping = namespaceMapping
+	}
+
+	// Namespaces that a namespace mapper renamed in the backup tarball are
+	// restored under their source names, unless the restore maps them
+	// elsewhere.
+	if len(ctx.backup.Status.ArchivedNamespaces) > 0 {
+		ctx.restore = ctx.restore.DeepCopy()
+		ctx.restore.Spec.NamespaceMapping = withArchivedNamespaces(ctx.restore.Spec.NamespaceMapping, ctx.backup.Status.ArchivedNamespaces)
+	}
+
+	// Handle target namespaces that already exist before restoring anything,
+	// so that a conflict doesn't leave the restore half done.
+	if e := ctx.applyNamespaceConflictPolicy(backupResources); len(e.Velero) > 0 || len(e.Cluster) > 0 || len(e.Namespaces) > 0 {
+		errs.Merge(&e)
+		return warnings, errs
+	}
+
+	// Find the volumes whose data isn't restored before restoring anything,
+	// since persistent volumes are restored before their claims.
+	volumes, err := ctx.selectVolumes(backupResources)
+	if err != nil {
+		errs.AddVeleroError(errors.Wrap(err, "error selecting volumes to restore"))
+		return warnings, errs
+	}
+	ctx.volumes = volumes
+	ctx.podVolumeBackups = volumes.filterPodVolumeBackups(ctx.podVolumeBackups)
+
 	update := make(chan progressUpdate)
 
 	quit := make(chan struct{})
@@ -500,17 +763,43 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		errs.Merge(&e)
 	}
 
-	// Restore everything else
-	selectedResourceCollection, _, w, e := ctx.getOrderedResourceCollection(
+	// Resolve the restore's own resource priorities now that its CRDs have
+	// been restored, so that resources they provide can be resolved too.
+	if priorities := ctx.restore.Spec.ResourcePriorities; priorities != nil {
+		_, w := ctx.resolveResourcePriorities(priorities.HighPriorities)
+		warnings.Merge(&w)
+		ctx.groupOrders, w = ctx.resolveGroupOrders(priorities.GroupOrders)
+		warnings.Merge(&w)
+	}
+	lowResourcePriorities, w := ctx.resolveResourcePriorities(ctx.lowResourcePriorities)
+	warnings.Merge(&w)
+
+	// Restore everything else, holding back the low priority resources by
+	// treating them as already processed.
+	deferredResources := sets.NewString(lowResourcePriorities...)
+	selectedResourceCollection, processedResources, w, e := ctx.getOrderedResourceCollection(
 		backupResources,
 		crdResourceCollection,
-		processedResources,
+		processedResources.Union(deferredResources),
 		ctx.resourcePriorities,
 		true,
 	)
 	warnings.Merge(&w)
 	errs.Merge(&e)
 
+	// Then restore the low priority resources, in order, after everything else.
+	if len(lowResourcePriorities) > 0 {
+		selectedResourceCollection, _, w, e = ctx.getOrderedResourceCollection(
+			backupResources,
+			selectedResourceCollection,
+			processedResources.Difference(deferredResources),
+			lowResourcePriorities,
+			false,
+		)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
 	// reset processedItems and totalItems before processing full resource list
 	processedItems = 0
 	totalItems = 0
@@ -518,6 +807,13 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		totalItems += selectedResource.totalItems
 	}
 
+	// report the total now that the items to restore have been collected,
+	// rather than waiting for the first of them to be restored.
+	update <- progressUpdate{
+		totalItems:    len(ctx.restoredItems) + totalItems,
+		itemsRestored: len(ctx.restoredItems),
+	}
+
 	for _, selectedResource := range selectedResourceCollection {
 		var w, e Result
 		// Restore this resource
@@ -532,6 +828,8 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		errs.Merge(&e)
 	}
 
+	ctx.writeCheckpoint()
+
 	// Close the progress update channel.
 	quit <- struct{}{}
 
@@ -553,6 +851,12 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	if err != nil {
 		ctx.log.WithError(errors.WithStack((err))).Warn("Updating restore status.progress")
 	}
+	// the final progress is also kept in the restore, so that it's part of
+	// the restore's final status.
+	ctx.restore.Status.Progress = &velerov1api.RestoreProgress{
+		TotalItems:    len(ctx.restoredItems),
+		ItemsRestored: len(ctx.restoredItems),
+	}
 
 	// Wait for all of the restic restore goroutines to be done, which is
 	// only possible once all of their errors have been received by the loop
@@ -576,25 +880,57 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all restic restores to complete")
 
-	// Because we don't want to use hooks.
-	//
 	// Wait for all post-restore exec hooks with same logic as restic wait above.
-	//go func() {
-	//	ctx.log.Info("Waiting for all post-restore-exec hooks to complete")
+	go func() {
+		ctx.log.Info("Waiting for all post-restore-exec hooks to complete")
 
-	//	ctx.hooksWaitGroup.Wait()
-	//	close(ctx.hooksErrs)
-	//}()
-	// Because we don't want to use hooks.
-	//
-	//for err := range ctx.hooksErrs {
-	//	errs.Velero = append(errs.Velero, err.Error())
-	//}
-	//ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
+		ctx.hooksWaitGroup.Wait()
+		close(ctx.hooksResults)
+	}()
+
+	for result := range ctx.hooksResults {
+		warnings.Merge(&result.warnings)
+		errs.Merge(&result.errs)
+	}
+	ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
+
+	if !ctx.canceled {
+		workloadWarnings := ctx.waitForWorkloads()
+		warnings.Merge(&workloadWarnings)
+	}
+
+	// Relaxed quotas are set back even if the restore was canceled, since
+	// they'd otherwise stay relaxed, but only once the workloads are ready,
+	// so that their pods aren't blocked by them.
+	quotaErrs := ctx.restoreRelaxedQuotas()
+	errs.Merge(&quotaErrs)
+
+	if ctx.canceled {
+		ctx.log.Info("Not validating restored items because the restore was canceled")
+		return warnings, errs
+	}
+
+	// Validate the restored items last, so that validators see them after
+	// their volumes, hooks and workloads have had a chance to settle.
+	w, e = ctx.validateRestoredItems()
+	warnings.Merge(&w)
+	errs.Merge(&e)
 
 	return warnings, errs
 }
 
+// isCanceled returns whether the restore's cancellation has been requested.
+// Once it has, no more items are restored, and the post-restore hooks that
+// are still waiting for their pods to be ready are stopped.
+func (ctx *restoreContext) isCanceled() bool {
+	if !ctx.canceled && ctx.cancelRequested != nil && ctx.cancelRequested() {
+		ctx.log.Warnf("Restore was canceled after restoring %d items, no more items will be restored", len(ctx.restoredItems))
+		ctx.canceled = true
+		ctx.hooksCancelFunc()
+	}
+	return ctx.canceled
+}
+
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
 // in the expected total restore count.
@@ -608,82 +944,100 @@ func (ctx *restoreContext) processSelectedResource(
 	warnings, errs := Result{}, Result{}
 	groupResource := schema.ParseGroupResource(selectedResource.resource)
 
-	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
-		for _, selectedItem := range selectedItems {
-			// If we don't know whether this namespace exists yet, attempt to create
-			// it in order to ensure it exists. Try to get it from the backup tarball
-			// (in order to get any backed-up metadata), but if we don't find it there,
-			// create a blank one.
-			if namespace != "" && !existingNamespaces.Has(selectedItem.targetNamespace) {
-				logger := ctx.log.WithField("namespace", namespace)
-
-				ns := getNamespace(
-					logger,
-					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
-					selectedItem.targetNamespace,
-				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-				)
-				if err != nil {
-					errs.AddVeleroError(err)
-					continue
-				}
+	// The items of a resource may be restored concurrently, but all of them
+	// are restored before the next resource's, so resources are still
+	// restored in priority order.
+	var pool *itemWorkerPool
+	if ctx.restoresItemsConcurrently(groupResource) {
+		ctx.log.Infof("Restoring %s with %d concurrent workers", groupResource.String(), ctx.itemWorkers)
+		ctx.concurrentItems = true
+		pool = newItemWorkerPool(&ctx.itemLock, ctx.itemWorkers)
+	}
+
+	restoreSelectedItem := func(namespace string, selectedItem restoreableItem) {
+		// If we don't know whether this namespace exists yet, attempt to create
+		// it in order to ensure it exists. Try to get it from the backup tarball
+		// (in order to get any backed-up metadata), but if we don't find it there,
+		// create a blank one.
+		if namespace != "" && !existingNamespaces.Has(selectedItem.targetNamespace) {
+			logger := ctx.log.WithField("namespace", namespace)
+
+			ns := getNamespace(
+				logger,
+				archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
+				selectedItem.targetNamespace,
+			)
+			if err := ctx.ensureNamespace(ns); err != nil {
+				errs.AddVeleroError(err)
+				return
+			}
 
-				// Add the newly created namespace to the list of restored items.
-				if nsCreated {
-					itemKey := velero.ResourceIdentifier{
-						GroupResource: kuberesource.Namespaces,
-						Namespace:     ns.Namespace,
-						Name:          ns.Name,
-					}
-					ctx.restoredItems[itemKey] = struct{}{}
-				}
+			// Keep track of namespaces that we know exist so we don't
+			// have to try to create them multiple times.
+			existingNamespaces.Insert(selectedItem.targetNamespace)
+		}
 
-				// Keep track of namespaces that we know exist so we don't
-				// have to try to create them multiple times.
-				existingNamespaces.Insert(selectedItem.targetNamespace)
-			}
+		obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
+		if err != nil {
+			errs.Add(
+				selectedItem.targetNamespace,
+				fmt.Errorf(
+					"error decoding %q: %v",
+					strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
+					err,
+				),
+			)
+			return
+		}
 
-			obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
-			if err != nil {
-				errs.Add(
-					selectedItem.targetNamespace,
-					fmt.Errorf(
-						"error decoding %q: %v",
-						strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
-						err,
-					),
-				)
-				continue
+		w, e := ctx.restoreItem(obj, groupResource, selectedItem.targetNamespace)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		processedItems++
+
+		// totalItems keeps the count of items previously known. There
+		// may be additional items restored by plugins. We want to include
+		// the additional items by looking at restoredItems at the same
+		// time, we don't want previously known items counted twice as
+		// they are present in both restoredItems and totalItems.
+		actualTotalItems := len(ctx.restoredItems) + (totalItems - processedItems)
+		update <- progressUpdate{
+			totalItems:    actualTotalItems,
+			itemsRestored: len(ctx.restoredItems),
+		}
+		ctx.log.WithFields(map[string]interface{}{
+			"progress":  "",
+			"resource":  groupResource.String(),
+			"namespace": selectedItem.targetNamespace,
+			"name":      selectedItem.name,
+		}).Infof("Restored %d items out of an estimated total of %d (estimate will change throughout the restore)", len(ctx.restoredItems), actualTotalItems)
+	}
+
+items:
+	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
+		for _, selectedItem := range selectedItems {
+			var canceled bool
+			pool.locked(func() {
+				canceled = ctx.isCanceled()
+			})
+			if canceled {
+				break items
 			}
 
-			w, e := ctx.restoreItem(obj, groupResource, selectedItem.targetNamespace)
-			warnings.Merge(&w)
-			errs.Merge(&e)
-			processedItems++
-
-			// totalItems keeps the count of items previously known. There
-			// may be additional items restored by plugins. We want to include
-			// the additional items by looking at restoredItems at the same
-			// time, we don't want previously known items counted twice as
-			// they are present in both restoredItems and totalItems.
-			actualTotalItems := len(ctx.restoredItems) + (totalItems - processedItems)
-			update <- progressUpdate{
-				totalItems:    actualTotalItems,
-				itemsRestored: len(ctx.restoredItems),
-			}
-			ctx.log.WithFields(map[string]interface{}{
-				"progress":  "",
-				"resource":  groupResource.String(),
-				"namespace": selectedItem.targetNamespace,
-				"name":      selectedItem.name,
-			}).Infof("Restored %d items out of an estimated total of %d (estimate will change throughout the restore)", len(ctx.restoredItems), actualTotalItems)
+			namespace, selectedItem := namespace, selectedItem
+			pool.submit(func() {
+				restoreSelectedItem(namespace, selectedItem)
+			})
 		}
 	}
 
+	pool.wait()
+	ctx.concurrentItems = false
+
+	if ctx.canceled {
+		return processedItems, warnings, errs
+	}
+
 	// If we just restored custom resource definitions (CRDs), refresh
 	// discovery because the restored CRDs may have created new APIs that
 	// didn't previously exist in the cluster, and we want to be able to
@@ -732,6 +1086,46 @@ func getNamespace(logger logrus.FieldLogger, path, remappedName string) *v1.Name
 	}
 }
 
+// ensureNamespace ensures that the namespace items are being restored into
+// exists in the cluster, adding it to the list of restored items if it had to
+// be created. In a dry run, a missing namespace is only recorded in the
+// dry-run summary.
+func (ctx *restoreContext) ensureNamespace(ns *v1.Namespace) error {
+	itemKey := velero.ResourceIdentifier{
+		GroupResource: kuberesource.Namespaces,
+		Namespace:     ns.Namespace,
+		Name:          ns.Name,
+	}
+
+	if ctx.dryRun {
+		if _, exists := ctx.restoredItems[itemKey]; exists {
+			return nil
+		}
+
+		_, err := ctx.namespaceClient.Get(go_context.TODO(), ns.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			ctx.restoredItems[itemKey] = struct{}{}
+			ctx.recordDryRun(kuberesource.Namespaces, "", ns.Name, DryRunActionCreate, "")
+		case err != nil:
+			return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+		}
+		return nil
+	}
+
+	_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+	if err != nil {
+		return err
+	}
+
+	// Add the newly created namespace to the list of restored items.
+	if nsCreated {
+		ctx.restoredItems[itemKey] = struct{}{}
+		ctx.recordChange(kuberesource.Namespaces, "", ns.Name, ChangeActionCreated, "")
+	}
+	return nil
+}
+
 // TODO: this should be combined with DeleteItemActions at some point.
 func (ctx *restoreContext) getApplicableActions(groupResource schema.GroupResource, namespace string) []resolvedAction {
 	var actions []resolvedAction
@@ -854,9 +1248,9 @@ func (ctx *restoreContext) crdAvailable(name string, crdClient client.Dynamic) (
 	crdLogger := ctx.log.WithField("crdName", name)
 
 	var available bool
-	// Wait 1 minute rather than the standard resource timeout, since each CRD
-	// will transition fairly quickly.
-	err := wait.PollImmediate(time.Second, time.Minute*1, func() (bool, error) {
+	// Wait for the CRD establish timeout rather than the standard resource
+	// timeout, since each CRD will transition fairly quickly.
+	err := wait.PollImmediate(time.Second, ctx.crdEstablishTimeout, func() (bool, error) {
 		unstructuredCRD, err := crdClient.Get(name, metav1.GetOptions{})
 		if err != nil {
 			return true, err
@@ -887,6 +1281,47 @@ func (ctx *restoreContext) crdAvailable(name string, crdClient client.Dynamic) (
 	return available, err
 }
 
+// recordUnestablishedCRD records that a restored CRD never became established,
+// so that its custom resources are failed rather than restored.
+func (ctx *restoreContext) recordUnestablishedCRD(crd *unstructured.Unstructured) {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	if plural == "" {
+		return
+	}
+
+	ctx.unestablishedCRDs[schema.GroupResource{Group: group, Resource: plural}.String()] = crd.GetName()
+}
+
+// failUnestablishedResource returns an error for each item in the backup of a
+// custom resource whose CRD never became established.
+func (ctx *restoreContext) failUnestablishedResource(resource, crdName string, resourceItems *archive.ResourceItems) Result {
+	var errs Result
+	if resourceItems == nil {
+		return errs
+	}
+
+	for namespace, items := range resourceItems.ItemsByNamespace {
+		if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			continue
+		}
+		if include, _ := ctx.namespaceResourceFiltersInclude(namespace, resource); !include {
+			continue
+		}
+
+		targetNamespace := namespace
+		if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			targetNamespace = target
+		}
+
+		for _, item := range items {
+			errs.Add(targetNamespace, errors.Errorf("error restoring %s: custom resource definition %s never became established", getResourceID(schema.ParseGroupResource(resource), targetNamespace, item), crdName))
+		}
+	}
+
+	return errs
+}
+
 func (ctx *restoreContext) getResourceClient(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace string) (client.Dynamic, error) {
 	key := resourceClientKey{
 		resource:  groupResource.WithVersion(obj.GroupVersionKind().Version),
@@ -939,6 +1374,26 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs
 	}
 
+	if crdName, ok := ctx.unestablishedCRDs[groupResource.String()]; ok {
+		errs.Add(namespace, errors.Errorf("error restoring %s: custom resource definition %s never became established", resourceID, crdName))
+		return warnings, errs
+	}
+
+	if excluded := ctx.restore.Spec.ExcludedAnnotation; excluded.Matches(obj.GetAnnotations()) {
+		ctx.log.WithFields(logrus.Fields{
+			"namespace":     obj.GetNamespace(),
+			"name":          obj.GetName(),
+			"groupResource": groupResource.String(),
+			"reason":        fmt.Sprintf("item has annotation %s", excluded),
+		}).Info("Not restoring item because it has an excluded annotation")
+		ctx.recordDryRun(groupResource, namespace, obj.GetName(), DryRunActionSkip, fmt.Sprintf("item has excluded annotation %s", excluded))
+		return warnings, errs
+	}
+
+	if ctx.skipFailedBackupItem(obj, groupResource, namespace, &warnings) {
+		return warnings, errs
+	}
+
 	// Check if namespace/cluster-scoped resource should be restored. We need
 	// to do this here since this method may be getting called for an additional
 	// item which is in a namespace that's excluded, or which is cluster-scoped
@@ -955,23 +1410,23 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
+		if include, reason := ctx.namespaceResourceFiltersInclude(obj.GetNamespace(), groupResource.String()); !include {
+			ctx.log.WithFields(logrus.Fields{
+				"namespace":     obj.GetNamespace(),
+				"name":          obj.GetName(),
+				"groupResource": groupResource.String(),
+				"reason":        reason,
+			}).Info("Not restoring item because the namespace's resource filters exclude it")
+			return warnings, errs
+		}
+
 		// If the namespace scoped resource should be restored, ensure that the
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		if _, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
+		if err := ctx.ensureNamespace(nsToEnsure); err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs
-		} else {
-			// Add the newly created namespace to the list of restored items.
-			if nsCreated {
-				itemKey := velero.ResourceIdentifier{
-					GroupResource: kuberesource.Namespaces,
-					Namespace:     nsToEnsure.Namespace,
-					Name:          nsToEnsure.Name,
-				}
-				ctx.restoredItems[itemKey] = struct{}{}
-			}
 		}
 	} else {
 		if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
@@ -984,6 +1439,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	if groupResource == kuberesource.Secrets && obj.GetAnnotations()[velerov1api.RedactedSecretAnnotation] != "" {
+		errs.Add(namespace, errors.Errorf("error restoring %s: its data values were redacted when it was backed up", resourceID))
+		return warnings, errs
+	}
+
 	// Make a copy of object retrieved from backup to make it available unchanged
 	//inside restore actions.
 	itemFromBackup := obj.DeepCopy()
@@ -995,6 +1455,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 	if complete {
 		ctx.log.Infof("%s is complete - skipping", kube.NamespaceAndName(obj))
+		ctx.recordDryRun(groupResource, namespace, obj.GetName(), DryRunActionSkip, "item is complete")
 		return warnings, errs
 	}
 
@@ -1016,6 +1477,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	// to the interface.
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
 		ctx.log.Infof("Not restoring pod because it's a mirror pod")
+		ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "pod is a mirror pod")
+		return warnings, errs
+	}
+
+	if ctx.volumes.skipItem(groupResource, obj, boolptr.IsSetToTrue(ctx.restore.Spec.SkipUnselectedVolumes)) {
+		ctx.log.Infof("Not restoring %s because its volume isn't selected by the restore's volume filters", resourceID)
+		ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "volume isn't selected by the restore's volume filters")
 		return warnings, errs
 	}
 
@@ -1025,8 +1493,23 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs
 	}
 
+	if ctx.alreadyRestored(itemKey, resourceClient) {
+		ctx.log.Infof("Skipping %s because it was already restored by restore %s", resourceID, ctx.restore.Status.ResumedFrom)
+		ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "already restored by the restore being resumed")
+		return warnings, errs
+	}
+
 	if groupResource == kuberesource.PersistentVolumes {
 		switch {
+		case !ctx.volumes.pvSelected(name):
+			// Whether its claim is restored empty or skipped, the volume
+			// isn't restored, from a snapshot or as-is.
+			ctx.log.Infof("Not restoring persistent volume because its claim isn't selected by the restore's volume filters.")
+			ctx.pvsToProvision.Insert(name)
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "claim isn't selected by the restore's volume filters")
+
+			return warnings, errs
+
 		case hasSnapshot(name, ctx.volumeSnapshots):
 			oldName := obj.GetName()
 			shouldRenamePV, err := shouldRenamePV(ctx, obj, resourceClient)
@@ -1064,19 +1547,25 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				// associate it with the restored PVC.
 				obj = resetVolumeBindingInfo(obj)
 
-				// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
-				// uses the original name to look up metadata about the snapshot.
-				ctx.log.Infof("Restoring persistent volume from snapshot.")
-				updatedObj, err := ctx.pvRestorer.executePVAction(obj)
-				if err != nil {
-					errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
-					return warnings, errs
-				}
-				obj = updatedObj
+				if ctx.dryRun {
+					// Creating a volume from a snapshot can't be undone, so the
+					// volume snapshotter isn't called during a dry run.
+					ctx.log.Infof("Dry run: not restoring persistent volume from snapshot.")
+				} else {
+					// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
+					// uses the original name to look up metadata about the snapshot.
+					ctx.log.Infof("Restoring persistent volume from snapshot.")
+					updatedObj, err := ctx.pvRestorer.executePVAction(obj)
+					if err != nil {
+						errs.Add(namespace, fmt.Errorf("error executing PVAction for %s: %v", resourceID, err))
+						return warnings, errs
+					}
+					obj = updatedObj
 
-				// VolumeSnapshotter has modified the PV name, we should rename the PV.
-				if oldName != obj.GetName() {
-					shouldRenamePV = true
+					// VolumeSnapshotter has modified the PV name, we should rename the PV.
+					if oldName != obj.GetName() {
+						shouldRenamePV = true
+					}
 				}
 			}
 
@@ -1110,6 +1599,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "persistent volume will be dynamically re-provisioned")
 
 			// Return early because we don't want to restore the PV itself, we
 			// want to dynamically re-provision it.
@@ -1153,11 +1643,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 		ctx.log.Infof("Executing item action for %v", &groupResource)
 
-		executeOutput, err := action.Execute(&velero.RestoreItemActionExecuteInput{
-			Item:           obj,
-			ItemFromBackup: itemFromBackup,
-			Restore:        ctx.restore,
+		actionCtx, cancel := ctx.withResourceTimeout()
+		var executeOutput *velero.RestoreItemActionExecuteOutput
+		var err error
+		ctx.unlocked(func() {
+			executeOutput, err = executeItemAction(actionCtx, action, &velero.RestoreItemActionExecuteInput{
+				Item:           obj,
+				ItemFromBackup: itemFromBackup,
+				Restore:        ctx.restore,
+			})
 		})
+		cancel()
+		if isTimedOut(actionCtx, err) {
+			ctx.recordTimedOut(namespace, resourceID, "restore item action")
+		}
 		if err != nil {
 			errs.Add(namespace, fmt.Errorf("error preparing %s: %v", resourceID, err))
 			return warnings, errs
@@ -1165,6 +1664,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "a restore item action discarded it")
 			return warnings, errs
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1208,6 +1708,21 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	// The restic restore item action adds an init container to wait for all
+	// of the pod's volumes backed up by restic, not only the selected ones.
+	if groupResource == kuberesource.Pods && ctx.volumes != nil {
+		pod := new(v1.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(itemFromBackup.UnstructuredContent(), pod); err != nil {
+			errs.Add(namespace, err)
+			return warnings, errs
+		}
+
+		if err := pruneResticInitContainerMounts(obj, restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, ctx.sourceNamespace(pod.Namespace))); err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error updating restic init container of %s", resourceID))
+			return warnings, errs
+		}
+	}
+
 	// This comes after running item actions because we have built-in actions that restore
 	// a PVC's associated PV (if applicable). As part of the PV being restored, the 'pvsToProvision'
 	// set may be inserted into, and this needs to happen *before* running the following block of logic.
@@ -1235,6 +1750,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			}
 		}
 
+		if !ctx.volumes.pvcSelected(obj.GetNamespace(), name) {
+			ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s to be restored empty because it isn't selected by the restore's volume filters", namespace, name)
+			resetUnselectedPVC(obj)
+		}
+
 		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
@@ -1251,6 +1771,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	// Apply the restore's resource modifiers last, so that they see the item
+	// as it would otherwise be created.
+	if err := ctx.resourceModifiers.Apply(obj, groupResource); err != nil {
+		errs.Add(namespace, errors.Wrapf(err, "error applying resource modifiers to %s", resourceID))
+		return warnings, errs
+	}
+
 	// NOTE: We want restores to be transparent, so we don't want to add those labels, as they would overwrite the ones
 	//  that might have been set by the customers' Velero.
 	// Label the resource with the restore's name and the restored backup's name
@@ -1259,9 +1786,61 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	//addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
-	createdObj, restoreErr := resourceClient.Create(obj)
+	var createdObj *unstructured.Unstructured
+	var restoreErr error
+	restoredName := name
+	if ctx.dryRun {
+		// items in a namespace that would have been recreated would all
+		// have been created.
+		if !ctx.recreatedNamespaces.Has(namespace) {
+			ctx.unlocked(func() {
+				restoreErr = dryRunCreate(resourceClient, groupResource, name)
+			})
+		}
+	} else {
+		var timedOut bool
+		ctx.unlocked(func() {
+			timedOut, restoreErr = ctx.retryItemCall(resourceID, "create", func(createCtx go_context.Context) error {
+				var err error
+				createdObj, err = resourceClient.Create(createCtx, obj)
+				return err
+			})
+		})
+		if timedOut {
+			ctx.recordTimedOut(namespace, resourceID, "create")
+		}
+	}
+	if apierrors.IsAlreadyExists(restoreErr) && ctx.generateNameResources.Has(groupResource.String()) {
+		if ctx.dryRun {
+			ctx.recordDryRun(groupResource, namespace, name, DryRunActionCreate, "already exists in the cluster, would be created with a generated name")
+			return warnings, errs
+		}
+
+		if createdObj, restoreErr = ctx.createWithGeneratedName(resourceClient, obj, groupResource, namespace, resourceID); restoreErr != nil {
+			ctx.log.Errorf("error restoring %s with a generated name: %+v", name, restoreErr)
+			errs.Add(namespace, fmt.Errorf("error restoring %s with a generated name: %v", resourceID, restoreErr))
+			return warnings, errs
+		}
+		restoredName = createdObj.GetName()
+	}
 	if apierrors.IsAlreadyExists(restoreErr) {
-		fromCluster, err := resourceClient.Get(name, metav1.GetOptions{})
+		// existing quotas are compared with, and updated to, their backed
+		// up limits, since they wouldn't be set back at the end of the
+		// restore.
+		if groupResource == kuberesource.ResourceQuotas {
+			unrelaxQuota(obj)
+		}
+
+		// existing workloads aren't scaled down, so that they keep running.
+		if boolptr.IsSetToTrue(ctx.restore.Spec.ScaleDownWorkloads) && (groupResource == kuberesource.Deployments || groupResource == kuberesource.StatefulSets) {
+			unscaleWorkload(obj)
+		}
+
+		var fromCluster *unstructured.Unstructured
+		var err error
+		ctx.unlocked(func() {
+			fromCluster, err = resourceClient.Get(name, metav1.GetOptions{})
+		})
 		if err != nil {
 			ctx.log.Infof("Error retrieving cluster version of %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
@@ -1302,23 +1881,68 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if patchBytes == nil {
 					// In-cluster and desired state are the same, so move on to
 					// the next item.
+					if ctx.updatesIfChanged() {
+						ctx.recordUnchanged(namespace, resourceID)
+					}
+					ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "already exists in the cluster and is the same as the backed up version")
+					ctx.recordChange(groupResource, namespace, name, ChangeActionSkipped, "already exists in the cluster and is the same as the backed up version")
+					ctx.recordRestored(itemKey, obj.GetName())
 					return warnings, errs
 				}
 
-				_, err = resourceClient.Patch(name, patchBytes)
-				if err != nil {
+				if ctx.dryRun {
+					ctx.recordDryRun(groupResource, namespace, name, DryRunActionUpdate, "")
+					return warnings, errs
+				}
+
+				var patched *unstructured.Unstructured
+				var timedOut bool
+				ctx.unlocked(func() {
+					timedOut, err = ctx.retryItemCall(resourceID, "patch", func(patchCtx go_context.Context) error {
+						var err error
+						patched, err = resourceClient.Patch(patchCtx, name, patchBytes)
+						return err
+					})
+				})
+				if timedOut {
+					ctx.recordTimedOut(namespace, resourceID, "patch")
+					errs.Add(namespace, fmt.Errorf("error patching %s: %v", resourceID, err))
+				} else if err != nil {
 					warnings.Add(namespace, err)
 				} else {
 					ctx.log.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
+					ctx.recordUpdate(groupResource, namespace, fromCluster, patched)
+					ctx.recordRestored(itemKey, obj.GetName())
 				}
 			default:
+				if ctx.mergesKeys(groupResource) {
+					w, e := ctx.updateIfChanged(resourceClient, fromCluster, withMergedKeys(fromCluster, obj, groupResource), groupResource, namespace, resourceID, itemKey)
+					warnings.Merge(&w)
+					errs.Merge(&e)
+					return warnings, errs
+				}
+				if ctx.updatesIfChanged() {
+					w, e := ctx.updateIfChanged(resourceClient, fromCluster, obj, groupResource, namespace, resourceID, itemKey)
+					warnings.Merge(&w)
+					errs.Merge(&e)
+					return warnings, errs
+				}
+
 				e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
 				warnings.Add(namespace, e)
+				ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "already exists in the cluster and is different than the backed up version")
+				ctx.recordChange(groupResource, namespace, name, ChangeActionSkipped, "already exists in the cluster and is different than the backed up version")
 			}
 			return warnings, errs
 		}
 
 		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+		if ctx.updatesIfChanged() || ctx.mergesKeys(groupResource) {
+			ctx.recordUnchanged(namespace, resourceID)
+		}
+		ctx.recordDryRun(groupResource, namespace, name, DryRunActionSkip, "already exists in the cluster and is the same as the backed up version")
+		ctx.recordChange(groupResource, namespace, name, ChangeActionSkipped, "already exists in the cluster and is the same as the backed up version")
+		ctx.recordRestored(itemKey, obj.GetName())
 		return warnings, errs
 	}
 
@@ -1329,6 +1953,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs
 	}
 
+	// Nothing was created during a dry run, so there are no pod volumes to
+	// restore, hooks to run or CRDs to wait for.
+	if ctx.dryRun {
+		ctx.recordDryRun(groupResource, namespace, name, DryRunActionCreate, "")
+		return warnings, errs
+	}
+
+	ctx.recordRestored(itemKey, restoredName)
+	ctx.recordChange(groupResource, namespace, restoredName, ChangeActionCreated, "")
+
+	if groupResource == kuberesource.ResourceQuotas {
+		ctx.recordRelaxedQuota(createdObj)
+	}
+
 	if groupResource == kuberesource.Pods {
 		pod := new(v1.Pod)
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
@@ -1336,8 +1974,8 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
-		if len(restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, originalNamespace)) > 0 {
-			restorePodVolumeBackups(ctx, createdObj, originalNamespace)
+		if len(restic.GetVolumeBackupsForPod(ctx.podVolumeBackups, pod, ctx.sourceNamespace(originalNamespace))) > 0 {
+			restorePodVolumeBackups(ctx, createdObj, ctx.sourceNamespace(originalNamespace))
 		}
 	}
 
@@ -1346,7 +1984,8 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 
 	// Wait for a CRD to be available for instantiating resources
-	// before continuing.
+	// before continuing. If it never becomes available, its custom
+	// resources are failed but the rest of the restore continues.
 	if groupResource == kuberesource.CustomResourceDefinitions {
 		available, err := ctx.crdAvailable(name, resourceClient)
 		if err != nil {
@@ -1354,6 +1993,9 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		} else if !available {
 			errs.Add(namespace, fmt.Errorf("CRD %s is not available to use for custom resources.", name))
 		}
+		if err != nil || !available {
+			ctx.recordUnestablishedCRD(obj)
+		}
 	}
 
 	return warnings, errs
@@ -1474,40 +2116,47 @@ func restorePodVolumeBackups(ctx *restoreContext, createdObj *unstructured.Unstr
 func (ctx *restoreContext) waitExec(createdObj *unstructured.Unstructured) {
 	ctx.hooksWaitGroup.Add(1)
 	go func() {
-		// Done() will only be called after all errors have been successfully sent
-		// on the ctx.resticErrs channel.
+		// Done() will only be called after the result has been successfully sent
+		// on the ctx.hooksResults channel.
 		defer ctx.hooksWaitGroup.Done()
 
+		var result execHooksResult
+
 		pod := new(v1.Pod)
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(createdObj.UnstructuredContent(), &pod); err != nil {
 			ctx.log.WithError(err).Error("error converting unstructured pod")
-			// Because we don't want to use hooks.
-			//
-			//ctx.hooksErrs <- err
+			result.errs.Add(createdObj.GetNamespace(), err)
+			ctx.hooksResults <- result
 			return
 		}
-		// Because we don't want to use hooks.
-		//
-		//execHooksByContainer, err := hook.GroupRestoreExecHooks(
-		//	ctx.resourceRestoreHooks,
-		//	pod,
-		//	ctx.log,
-		//)
-		//if err != nil {
-		//	ctx.log.WithError(err).Errorf("error getting exec hooks for pod %s/%s", pod.Namespace, pod.Name)
-		//	ctx.hooksErrs <- err
-		//	return
-		//}
-
-		//if errs := ctx.waitExecHookHandler.HandleHooks(ctx.hooksContext, ctx.log, pod, execHooksByContainer); len(errs) > 0 {
-		//	ctx.log.WithError(kubeerrs.NewAggregate(errs)).Error("unable to successfully execute post-restore hooks")
-		//	ctx.hooksCancelFunc()
-
-		//	for _, err := range errs {
-		//		// Errors are already logged in the HandleHooks method.
-		//		ctx.hooksErrs <- err
-		//	}
-		//}
+		execHooksByContainer, err := hook.GroupRestoreExecHooks(
+			ctx.resourceRestoreHooks,
+			pod,
+			ctx.log,
+		)
+		if err != nil {
+			ctx.log.WithError(err).Errorf("error getting exec hooks for pod %s/%s", pod.Namespace, pod.Name)
+			result.errs.Add(pod.Namespace, err)
+			ctx.hooksResults <- result
+			return
+		}
+		if len(execHooksByContainer) == 0 {
+			return
+		}
+
+		// Warnings and errors are already logged in the HandleHooks method.
+		hookWarnings, hookErrs := ctx.waitExecHookHandler.HandleHooks(ctx.hooksContext, ctx.log, pod, execHooksByContainer)
+		if len(hookErrs) > 0 {
+			ctx.log.WithError(kubeerrs.NewAggregate(hookErrs)).Error("unable to successfully execute post-restore hooks")
+			ctx.hooksCancelFunc()
+		}
+		for _, err := range hookWarnings {
+			result.warnings.Add(pod.Namespace, err)
+		}
+		for _, err := range hookErrs {
+			result.errs.Add(pod.Namespace, err)
+		}
+		ctx.hooksResults <- result
 	}()
 }
 
@@ -1692,11 +2341,23 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 	// ordered list twice.
 	var resourceList []string
 	if includeAllResources {
-		resourceList = getOrderedResources(resourcePriorities, backupResources)
+		resourceList = getOrderedResources(resourcePriorities, ctx.groupOrders, backupResources)
 	} else {
 		resourceList = resourcePriorities
 	}
 	for _, resource := range resourceList {
+		// Custom resources whose CRD was restored but never became
+		// established can't be created, so fail them rather than silently
+		// skipping them because they can't be resolved via discovery.
+		if crdName, ok := ctx.unestablishedCRDs[resource]; ok && !processedResources.Has(resource) {
+			if ctx.resourceIncludesExcludes.ShouldInclude(resource) {
+				e := ctx.failUnestablishedResource(resource, crdName, backupResources[resource])
+				errs.Merge(&e)
+			}
+			processedResources.Insert(resource)
+			continue
+		}
+
 		// try to resolve the resource via discovery to a complete group/version/resource
 		gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
 		if err != nil {
@@ -1742,6 +2403,11 @@ func (ctx *restoreContext) getOrderedResourceCollection(
 				continue
 			}
 
+			if include, reason := ctx.namespaceResourceFiltersInclude(namespace, groupResource.String()); !include {
+				ctx.log.Infof("Skipping resource %s in namespace %s because it's excluded by %s", groupResource, namespace, reason)
+				continue
+			}
+
 			// get target namespace to restore into, if different
 			// from source namespace
 			targetNamespace := namespace
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -39,6 +40,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	kubetesting "k8s.io/client-go/testing"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -51,6 +53,7 @@ import (
 	resticmocks "github.com/vmware-tanzu/velero/pkg/restic/mocks"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -250,6 +253,29 @@ func TestRestoreResourceFiltering(t *testing.T) {
 				test.PVs():         {"/pv-1"},
 			},
 		},
+		{
+			name:    "excluded annotation skips restoring matching resources",
+			restore: defaultRestore().ExcludedAnnotation(&velerov1api.AnnotationMatch{Key: "restore.velero.io/exclude", Value: "true"}).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithAnnotations("restore.velero.io/exclude", "true")).Result(),
+					builder.ForPod("ns-2", "pod-2").ObjectMeta(builder.WithAnnotations("restore.velero.io/exclude", "false")).Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithAnnotations("restore.velero.io/exclude", "true")).Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-2/pod-2"},
+				test.PVs():  {"/pv-2"},
+			},
+		},
 		{
 			name:    "should include cluster-scoped resources if restoring subset of namespaces and IncludeClusterResources=true",
 			restore: defaultRestore().IncludedNamespaces("ns-1").IncludeClusterResources(true).Result(),
@@ -604,6 +630,50 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				test.Pods(): {"mapped-ns-1/pod-1", "mapped-ns-2/pod-2"},
 			},
 		},
+		{
+			name: "namespace mapping template is applied to namespaces matching its selector, others fall back to the static mappings",
+			restore: defaultRestore().
+				NamespaceMappings("ns-2", "mapped-ns-2").
+				NamespaceMappingTemplate(&metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}}, `tenant-{{ .Label "tenant-id" }}`).
+				Result(),
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("namespaces",
+					builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("tenant", "true", "tenant-id", "a")).Result(),
+					builder.ForNamespace("ns-2").Result(),
+				).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+					builder.ForPod("ns-3", "pod-3").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"tenant-a/pod-1", "mapped-ns-2/pod-2", "ns-3/pod-3"},
+			},
+		},
+		{
+			name:    "namespace mapping template without a selector can reference annotations and the namespace name",
+			restore: defaultRestore().NamespaceMappingTemplate(nil, `{{ .Annotations.team }}-{{ .Name }}`).Result(),
+			backup:  defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("namespaces",
+					builder.ForNamespace("ns-1").ObjectMeta(builder.WithAnnotations("team", "blue")).Result(),
+				).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"blue-ns-1/pod-1"},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -636,6 +706,72 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 	}
 }
 
+// TestRestoreNamespaceMappingTemplateErrors runs restores with namespace mapping
+// templates that can't be rendered for some of the backup's namespaces, and
+// verifies that the restore fails with an error for each of those namespaces
+// and that no items are restored.
+func TestRestoreNamespaceMappingTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		want    Result
+	}{
+		{
+			name:    "template referencing a missing label with .Label errors",
+			restore: defaultRestore().NamespaceMappingTemplate(nil, `tenant-{{ .Label "tenant-id" }}`).Result(),
+			want: Result{
+				Namespaces: map[string][]string{
+					"ns-2": {`error rendering namespace mapping template for namespace ns-2: template: namespaceMappingTemplate:1:10: executing "namespaceMappingTemplate" at <.Label>: error calling Label: namespace ns-2 has no label "tenant-id"`},
+				},
+			},
+		},
+		{
+			name:    "template referencing a missing label with .Labels errors",
+			restore: defaultRestore().NamespaceMappingTemplate(nil, `tenant-{{ .Labels.team }}`).Result(),
+			want: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {`error rendering namespace mapping template for namespace ns-1: template: namespaceMappingTemplate:1:17: executing "namespaceMappingTemplate" at <.Labels.team>: map has no entry for key "team"`},
+					"ns-2": {`error rendering namespace mapping template for namespace ns-2: template: namespaceMappingTemplate:1:17: executing "namespaceMappingTemplate" at <.Labels.team>: map has no entry for key "team"`},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.DiscoveryClient.WithAPIResource(test.Pods())
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:     h.log,
+				Restore: tc.restore,
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("namespaces",
+						builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("tenant-id", "a")).Result(),
+						builder.ForNamespace("ns-2").Result(),
+					).
+					AddItems("pods",
+						builder.ForPod("ns-1", "pod-1").Result(),
+						builder.ForPod("ns-2", "pod-2").Result(),
+					).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings)
+			assert.Equal(t, tc.want, errs)
+			assertAPIContents(t, h, map[*test.APIResource][]string{test.Pods(): {}})
+		})
+	}
+}
+
 // TestRestoreResourcePriorities runs restores with resource priorities specified,
 // and verifies that the set of items created in the API are created in the expected
 // order. Validation is done by adding a Reactor to the fake dynamic client that records
@@ -648,6 +784,9 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		apiResources       []*test.APIResource
 		tarball            io.Reader
 		resourcePriorities []string
+		itemWorkers        int
+		// wantOrder, if set, is the exact order resources must be created in.
+		wantOrder []string
 	}{
 		{
 			name:    "resources are restored according to the specified resource priorities",
@@ -683,11 +822,89 @@ func TestRestoreResourcePriorities(t *testing.T) {
 			},
 			resourcePriorities: []string{"persistentvolumes", "serviceaccounts", "pods", "deployments.apps"},
 		},
+		{
+			name: "restore's resource priorities are appended to the server's, and low priority resources are restored last",
+			restore: defaultRestore().
+				ResourcePriorities("", []string{"deployments.apps"}, []string{"persistentvolumes"}).
+				Result(),
+			backup: defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+				AddItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").Result()).
+				AddItems("serviceaccounts", builder.ForServiceAccount("ns-1", "sa-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: []string{"serviceaccounts"},
+			wantOrder:          []string{"serviceaccounts", "deployments.apps", "pods", "persistentvolumes"},
+		},
+		{
+			name: "restore's resource priorities replace the server's",
+			restore: defaultRestore().
+				ResourcePriorities(velerov1api.ResourcePrioritiesModeReplace, []string{"deployments.apps", "pods"}, nil).
+				Result(),
+			backup: defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+				AddItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").Result()).
+				AddItems("serviceaccounts", builder.ForServiceAccount("ns-1", "sa-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: []string{"serviceaccounts"},
+			wantOrder:          []string{"deployments.apps", "pods", "serviceaccounts"},
+		},
+		{
+			name:    "resources are restored according to the resource priorities when their items are restored concurrently",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+					builder.ForPod("ns-2", "pod-3").Result(),
+					builder.ForPod("ns-2", "pod-4").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+					builder.ForPersistentVolume("pv-3").Result(),
+				).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "secret-1").Result(),
+					builder.ForSecret("ns-1", "secret-2").Result(),
+					builder.ForSecret("ns-2", "secret-3").Result(),
+					builder.ForSecret("ns-2", "secret-4").Result(),
+				).
+				AddItems("serviceaccounts",
+					builder.ForServiceAccount("ns-1", "sa-1").Result(),
+					builder.ForServiceAccount("ns-1", "sa-2").Result(),
+					builder.ForServiceAccount("ns-2", "sa-3").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.PVs(),
+				test.Secrets(),
+				test.ServiceAccounts(),
+			},
+			resourcePriorities: []string{"persistentvolumes", "secrets", "serviceaccounts", "pods"},
+			itemWorkers:        3,
+		},
 	}
 
 	for _, tc := range tests {
 		h := newHarness(t)
 		h.restorer.resourcePriorities = tc.resourcePriorities
+		h.restorer.itemWorkers = tc.itemWorkers
 
 		recorder := &createRecorder{t: t}
 		h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
@@ -713,7 +930,115 @@ func TestRestoreResourcePriorities(t *testing.T) {
 		)
 
 		assertEmptyResults(t, warnings, errs)
-		assertResourceCreationOrder(t, tc.resourcePriorities, recorder.resources)
+		if tc.wantOrder != nil {
+			assert.Equal(t, tc.wantOrder, createdGroupResources(recorder.resources), tc.name)
+		} else {
+			assertResourceCreationOrder(t, tc.resourcePriorities, recorder.resources)
+		}
+	}
+}
+
+// createdGroupResources returns the group-resources of createdResources, in
+// the order they were first created.
+func createdGroupResources(createdResources []resourceID) []string {
+	var res []string
+	for _, r := range createdResources {
+		if len(res) == 0 || res[len(res)-1] != r.groupResource {
+			res = append(res, r.groupResource)
+		}
+	}
+	return res
+}
+
+// TestRestoreAPIVersions runs restores of backups that stored a resource at
+// multiple API versions, and verifies that its items are restored at the
+// chosen version.
+func TestRestoreAPIVersions(t *testing.T) {
+	widgets := func(version string, items ...metav1.Object) *test.APIResource {
+		return &test.APIResource{
+			Group:      "example.com",
+			Version:    version,
+			Name:       "widgets",
+			Namespaced: true,
+			Items:      items,
+		}
+	}
+	widget := func(version string) []byte {
+		return []byte(fmt.Sprintf(`{"apiVersion": "example.com/%s", "kind": "Widget", "metadata": {"namespace": "ns-1", "name": "widget-1"}}`, version))
+	}
+	tarball := func(t *testing.T) io.Reader {
+		return test.NewTarWriter(t).
+			Add("resources/widgets.example.com/namespaces/ns-1/widget-1.json", widget("v1")).
+			Add("resources/widgets.example.com/v1-preferredversion/namespaces/ns-1/widget-1.json", widget("v1")).
+			Add("resources/widgets.example.com/v2/namespaces/ns-1/widget-1.json", widget("v2")).
+			Done()
+	}
+	backup := defaultBackup().
+		ResourceAPIVersions(map[string][]string{"widgets.example.com": {"v2"}}).
+		FormatVersion("1.1.0").
+		Result()
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		apiResources []*test.APIResource
+		want         map[*test.APIResource][]string
+	}{
+		{
+			name:         "cluster preferred version is restored by default",
+			restore:      defaultRestore().Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2")},
+			want: map[*test.APIResource][]string{
+				widgets("v1"): {"ns-1/widget-1"},
+				widgets("v2"): {},
+			},
+		},
+		{
+			name:         "backed up version that the cluster prefers is restored",
+			restore:      defaultRestore().Result(),
+			apiResources: []*test.APIResource{widgets("v2"), widgets("v1")},
+			want: map[*test.APIResource][]string{
+				widgets("v1"): {},
+				widgets("v2"): {"ns-1/widget-1"},
+			},
+		},
+		{
+			name:         "restore's preferred version is restored",
+			restore:      defaultRestore().PreferredAPIVersions(map[string][]string{"widgets.example.com": {"v3", "v2"}}).Result(),
+			apiResources: []*test.APIResource{widgets("v1"), widgets("v2"), widgets("v3")},
+			want: map[*test.APIResource][]string{
+				widgets("v1"): {},
+				widgets("v2"): {"ns-1/widget-1"},
+				widgets("v3"): {},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       backup,
+				BackupReader: tarball(t),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertAPIContents(t, h, tc.want)
+		})
 	}
 }
 
@@ -1010,6 +1335,398 @@ func TestRestoreItems(t *testing.T) {
 	}
 }
 
+// TestRestoreDryRun runs a dry-run restore and verifies that restore item
+// actions are still executed, that nothing is created or patched in the API,
+// and that the dry-run summary records what would have been done with each
+// item.
+func TestRestoreDryRun(t *testing.T) {
+	h := newHarness(t)
+
+	apiResources := []*test.APIResource{
+		test.Pods(),
+		test.ServiceAccounts(
+			builder.ForServiceAccount("ns-1", "sa-1").Result(),
+			builder.ForServiceAccount("ns-1", "sa-2").Result(),
+		),
+	}
+	for _, r := range apiResources {
+		h.AddItems(t, r)
+	}
+
+	action := new(recordResourcesAction).ForResource("pods")
+	summary := new(DryRunSummary)
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().DryRun(true).Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods", builder.ForPod("ns-1", "pod-1").Result()).
+			AddItems("serviceaccounts",
+				builder.ForServiceAccount("ns-1", "sa-1").Result(),
+				&corev1api.ServiceAccount{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ServiceAccount",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "sa-2",
+					},
+					Secrets: []corev1api.ObjectReference{{Name: "secret-1"}},
+				},
+			).
+			Done(),
+		DryRunSummary: summary,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		[]velero.RestoreItemAction{action},
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assert.Equal(t, []string{"ns-1/pod-1"}, action.ids)
+	assertRestoredItems(t, h, apiResources)
+
+	_, err := h.KubeClient.CoreV1().Namespaces().Get(context.TODO(), "ns-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	want := &DryRunSummary{
+		Created: 2,
+		Updated: 1,
+		Skipped: 1,
+		Items: []DryRunItem{
+			{Resource: "namespaces", Name: "ns-1", Action: DryRunActionCreate},
+			{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Action: DryRunActionCreate},
+			{Resource: "serviceaccounts", Namespace: "ns-1", Name: "sa-1", Action: DryRunActionSkip, Reason: "already exists in the cluster and is the same as the backed up version"},
+			{Resource: "serviceaccounts", Namespace: "ns-1", Name: "sa-2", Action: DryRunActionUpdate},
+		},
+	}
+	assert.Equal(t, want, summary)
+}
+
+// TestRestoreExistingResourcePolicy verifies that a restore whose existing
+// resource policy is updateIfChanged or overwriteKeys only patches the
+// existing items that are different than the backed up version, and records
+// the rest as unchanged.
+func TestRestoreExistingResourcePolicy(t *testing.T) {
+	secret := func(name, data string, opts ...builder.ObjectMetaOpt) *corev1api.Secret {
+		return builder.ForSecret("ns-1", name).ObjectMeta(opts...).Data(map[string][]byte{"key": []byte(data)}).Result()
+	}
+
+	tests := []struct {
+		name          string
+		policy        velerov1api.ExistingResourcePolicy
+		wantPatched   []string
+		wantWarnings  map[string][]string
+		wantUnchanged map[string][]string
+		want          []*test.APIResource
+	}{
+		{
+			name:   "existing items that are different are left as they are by default",
+			policy: "",
+			wantWarnings: map[string][]string{
+				"ns-1": {`could not restore, secrets "secret-2" already exists. Warning: the in-cluster version is different than the backed-up version.`},
+			},
+			want: []*test.APIResource{
+				test.Secrets(
+					secret("secret-1", "a"),
+					secret("secret-2", "a", builder.WithLabels("owner", "cluster")),
+					secret("secret-3", "c"),
+				),
+			},
+		},
+		{
+			name:        "existing items that are different are patched with updateIfChanged",
+			policy:      velerov1api.ExistingResourcePolicyUpdateIfChanged,
+			wantPatched: []string{"secret-2"},
+			wantUnchanged: map[string][]string{
+				"ns-1": {"secrets/ns-1/secret-1 already exists and is unchanged"},
+			},
+			want: []*test.APIResource{
+				test.Secrets(
+					secret("secret-1", "a"),
+					// fields that only the existing item has are kept.
+					secret("secret-2", "b", builder.WithLabels("owner", "cluster")),
+					secret("secret-3", "c"),
+				),
+			},
+		},
+		{
+			name:        "keys of existing secrets are patched with overwriteKeys",
+			policy:      velerov1api.ExistingResourcePolicyOverwriteKeys,
+			wantPatched: []string{"secret-2"},
+			wantUnchanged: map[string][]string{
+				"ns-1": {"secrets/ns-1/secret-1 already exists and is unchanged"},
+			},
+			want: []*test.APIResource{
+				test.Secrets(
+					secret("secret-1", "a"),
+					secret("secret-2", "b", builder.WithLabels("owner", "cluster")),
+					secret("secret-3", "c"),
+				),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Secrets(
+				secret("secret-1", "a"),
+				secret("secret-2", "a", builder.WithLabels("owner", "cluster")),
+			))
+			h.DynamicClient.ClearActions()
+
+			unchanged := new(Result)
+			data := Request{
+				Log:     h.log,
+				Restore: defaultRestore().ExistingResourcePolicy(tc.policy).Result(),
+				Backup:  defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).
+					AddItems("secrets",
+						secret("secret-1", "a"),
+						secret("secret-2", "b"),
+						secret("secret-3", "c"),
+					).
+					Done(),
+				UnchangedItems: unchanged,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assert.Empty(t, errs.Namespaces)
+			assert.Equal(t, tc.wantWarnings, warnings.Namespaces)
+			assert.Equal(t, tc.wantUnchanged, unchanged.Namespaces)
+			assertRestoredItems(t, h, tc.want)
+
+			var patched []string
+			for _, action := range h.DynamicClient.Actions() {
+				if patch, ok := action.(kubetesting.PatchAction); ok {
+					patched = append(patched, patch.GetName())
+				}
+			}
+			assert.Equal(t, tc.wantPatched, patched)
+		})
+	}
+}
+
+// TestRestoreRecordChanges verifies that a restore that records its changes
+// records the items it created, patched or skipped, with a diff of the
+// patched items.
+func TestRestoreRecordChanges(t *testing.T) {
+	secret := func(name, data string, opts ...builder.ObjectMetaOpt) *corev1api.Secret {
+		return builder.ForSecret("ns-1", name).ObjectMeta(opts...).Data(map[string][]byte{"key": []byte(data)}).Result()
+	}
+
+	h := newHarness(t)
+	h.AddItems(t, test.Secrets(
+		secret("secret-1", "a"),
+		secret("secret-2", "a", builder.WithLabels("owner", "cluster")),
+	))
+
+	changes := new(ChangeSummary)
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().ExistingResourcePolicy(velerov1api.ExistingResourcePolicyUpdateIfChanged).RecordChanges(true).Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("secrets",
+				secret("secret-1", "a"),
+				secret("secret-2", "b"),
+				secret("secret-3", "c"),
+			).
+			Done(),
+		ChangeSummary: changes,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // actions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	require.Len(t, changes.Items, 4)
+
+	// the diff only has the fields that were patched.
+	assert.JSONEq(t, `{"data": {"key": "Yg=="}}`, changes.Items[2].Diff)
+	changes.Items[2].Diff = ""
+
+	assert.Equal(t, &ChangeSummary{
+		Created: 2,
+		Updated: 1,
+		Skipped: 1,
+		Items: []ChangedItem{
+			{Resource: "namespaces", Name: "ns-1", Action: ChangeActionCreated},
+			{Resource: "secrets", Namespace: "ns-1", Name: "secret-1", Action: ChangeActionSkipped, Reason: "already exists in the cluster and is the same as the backed up version"},
+			{Resource: "secrets", Namespace: "ns-1", Name: "secret-2", Action: ChangeActionUpdated},
+			{Resource: "secrets", Namespace: "ns-1", Name: "secret-3", Action: ChangeActionCreated},
+		},
+	}, changes)
+}
+
+func TestChangedFieldsPatch(t *testing.T) {
+	fromCluster := test.UnstructuredOrDie(`{
+		"apiVersion": "v1",
+		"kind": "ConfigMap",
+		"metadata": {"namespace": "ns-1", "name": "cm-1", "labels": {"a": "1", "b": "2"}},
+		"data": {"key-1": "val-1", "key-2": "val-2"}
+	}`)
+
+	patch, err := changedFieldsPatch(fromCluster, fromCluster.DeepCopy())
+	require.NoError(t, err)
+	assert.Nil(t, patch)
+
+	// fields that only the cluster's version has aren't a difference.
+	patch, err = changedFieldsPatch(fromCluster, test.UnstructuredOrDie(`{
+		"apiVersion": "v1",
+		"kind": "ConfigMap",
+		"metadata": {"namespace": "ns-1", "name": "cm-1", "labels": {"a": "1"}},
+		"data": {"key-1": "val-1"}
+	}`))
+	require.NoError(t, err)
+	assert.Nil(t, patch)
+
+	patch, err = changedFieldsPatch(fromCluster, test.UnstructuredOrDie(`{
+		"apiVersion": "v1",
+		"kind": "ConfigMap",
+		"metadata": {"namespace": "ns-1", "name": "cm-1", "labels": {"a": "1"}},
+		"data": {"key-1": "val-3", "key-3": "val-3"}
+	}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data": {"key-1": "val-3", "key-3": "val-3"}}`, string(patch))
+}
+
+// TestRestoreIsCanceled verifies that a restore stops restoring items once
+// its cancellation has been requested.
+func TestRestoreIsCanceled(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	action := new(recordResourcesAction).ForResource("pods")
+
+	checks := 0
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			Done(),
+		// cancel the restore after its first item.
+		Canceled: func() bool {
+			checks++
+			return checks > 1
+		},
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		[]velero.RestoreItemAction{action},
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assert.Len(t, action.ids, 1)
+
+	pods, err := h.KubeClient.CoreV1().Pods("ns-1").List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+}
+
+// TestRestoreNamespaceConflictPolicy runs restores into a namespace that
+// already exists and verifies that each namespace conflict policy merges
+// into it, fails the restore, or deletes it before restoring.
+func TestRestoreNamespaceConflictPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        velerov1api.NamespaceConflictPolicy
+		namespace     string
+		wantErrs      map[string][]string
+		wantRestored  bool
+		wantOldLabels bool
+	}{
+		{
+			name:          "Merge restores into the existing namespace",
+			policy:        velerov1api.NamespaceConflictPolicyMerge,
+			namespace:     "ns-1",
+			wantRestored:  true,
+			wantOldLabels: true,
+		},
+		{
+			name:          "Fail doesn't restore anything",
+			policy:        velerov1api.NamespaceConflictPolicyFail,
+			namespace:     "ns-1",
+			wantErrs:      map[string][]string{"ns-1": {"namespace ns-1 already exists and the restore's namespace conflict policy is Fail"}},
+			wantOldLabels: true,
+		},
+		{
+			name:         "Recreate deletes the existing namespace before restoring",
+			policy:       velerov1api.NamespaceConflictPolicyRecreate,
+			namespace:    "ns-1",
+			wantRestored: true,
+		},
+		{
+			name:          "Recreate doesn't delete protected namespaces",
+			policy:        velerov1api.NamespaceConflictPolicyRecreate,
+			namespace:     "kube-system",
+			wantErrs:      map[string][]string{"kube-system": {"namespace kube-system is protected and can't be recreated"}},
+			wantOldLabels: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods())
+
+			_, err := h.KubeClient.CoreV1().Namespaces().Create(context.TODO(), builder.ForNamespace(tc.namespace).ObjectMeta(builder.WithLabels("old", "true")).Result(), metav1.CreateOptions{})
+			require.NoError(t, err)
+
+			data := Request{
+				Log:          h.log,
+				Restore:      defaultRestore().NamespaceConflictPolicy(tc.policy).Result(),
+				Backup:       defaultBackup().Result(),
+				BackupReader: test.NewTarWriter(t).AddItems("pods", builder.ForPod(tc.namespace, "pod-1").Result()).Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assert.Empty(t, warnings.Velero)
+			if tc.wantErrs == nil {
+				assertEmptyResults(t, errs)
+			} else {
+				assert.Equal(t, tc.wantErrs, errs.Namespaces)
+			}
+
+			_, err = h.DynamicClient.Resource(test.Pods().GVR()).Namespace(tc.namespace).Get(context.TODO(), "pod-1", metav1.GetOptions{})
+			if tc.wantRestored {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+
+			ns, err := h.KubeClient.CoreV1().Namespaces().Get(context.TODO(), tc.namespace, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOldLabels, ns.Labels["old"] == "true")
+		})
+	}
+}
+
 // recordResourcesAction is a restore item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
@@ -2540,6 +3257,124 @@ func TestRestoreWithRestic(t *testing.T) {
 	}
 }
 
+// fakeWaitExecHookHandler is a test fake for the hook.WaitExecHookHandler interface
+// that records the hooks it's asked to execute and returns canned results.
+type fakeWaitExecHookHandler struct {
+	warnings []error
+	errs     []error
+	called   map[string][]hook.PodExecRestoreHook
+}
+
+func (h *fakeWaitExecHookHandler) HandleHooks(_ context.Context, _ logrus.FieldLogger, _ *corev1api.Pod, byContainer map[string][]hook.PodExecRestoreHook) ([]error, []error) {
+	h.called = byContainer
+	return h.warnings, h.errs
+}
+
+// TestRestoreExecHooks runs post-restore exec hooks declared in the restore spec
+// against restored pods, and verifies that the applicable hooks are executed and
+// that their warnings and errors are surfaced in the restore's results.
+func TestRestoreExecHooks(t *testing.T) {
+	hooksSpec := &velerov1api.RestoreHooks{
+		Resources: []velerov1api.RestoreResourceHookSpec{
+			{
+				Name:               "my-hook",
+				IncludedNamespaces: []string{"ns-1"},
+				LabelSelector:      &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				PostHooks: []velerov1api.RestoreResourceHook{
+					{
+						Exec: &velerov1api.ExecRestoreHook{
+							Container: "container-1",
+							Command:   []string{"/bin/true"},
+							OnError:   velerov1api.HookErrorModeFail,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		pod          *corev1api.Pod
+		handler      *fakeWaitExecHookHandler
+		wantExecuted bool
+		wantWarnings Result
+		wantErrs     Result
+	}{
+		{
+			name: "hooks for a pod that isn't selected aren't executed",
+			pod: builder.ForPod("ns-2", "pod-1").
+				ObjectMeta(builder.WithLabels("app", "db")).
+				Containers(&corev1api.Container{Name: "container-1"}).
+				Result(),
+			handler:      &fakeWaitExecHookHandler{errs: []error{errors.New("should not be called")}},
+			wantExecuted: false,
+		},
+		{
+			name: "hooks for a selected pod that succeed produce no warnings or errors",
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithLabels("app", "db")).
+				Containers(&corev1api.Container{Name: "container-1"}).
+				Result(),
+			handler:      &fakeWaitExecHookHandler{},
+			wantExecuted: true,
+		},
+		{
+			name: "hook warnings and errors are added to the pod's namespace",
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithLabels("app", "db")).
+				Containers(&corev1api.Container{Name: "container-1"}).
+				Result(),
+			handler: &fakeWaitExecHookHandler{
+				warnings: []error{errors.New("hook warning")},
+				errs:     []error{errors.New("hook error")},
+			},
+			wantExecuted: true,
+			wantWarnings: Result{Namespaces: map[string][]string{"ns-1": {"hook warning"}}},
+			wantErrs:     Result{Namespaces: map[string][]string{"ns-1": {"hook error"}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceRestoreHooks, err := hook.GetRestoreHooksFromSpec(hooksSpec)
+			require.NoError(t, err)
+
+			hooksCtx, hooksCancelFunc := context.WithCancel(context.Background())
+			defer hooksCancelFunc()
+
+			ctx := &restoreContext{
+				log:                  testutil.NewLogger(),
+				resourceRestoreHooks: resourceRestoreHooks,
+				hooksResults:         make(chan execHooksResult),
+				waitExecHookHandler:  tc.handler,
+				hooksContext:         hooksCtx,
+				hooksCancelFunc:      hooksCancelFunc,
+			}
+
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pod)
+			require.NoError(t, err)
+
+			ctx.waitExec(&unstructured.Unstructured{Object: obj})
+
+			go func() {
+				ctx.hooksWaitGroup.Wait()
+				close(ctx.hooksResults)
+			}()
+
+			var warnings, errs Result
+			for result := range ctx.hooksResults {
+				warnings.Merge(&result.warnings)
+				errs.Merge(&result.errs)
+			}
+
+			assert.Equal(t, tc.wantExecuted, tc.handler.called != nil)
+			assert.Equal(t, tc.wantWarnings, warnings)
+			assert.Equal(t, tc.wantErrs, errs)
+		})
+	}
+}
+
 func TestResetMetadataAndStatus(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -2650,6 +3485,7 @@ func Test_getOrderedResources(t *testing.T) {
 	tests := []struct {
 		name               string
 		resourcePriorities []string
+		groupOrders        [][]string
 		backupResources    map[string]*archive.ResourceItems
 		want               []string
 	}{
@@ -2680,11 +3516,25 @@ func Test_getOrderedResources(t *testing.T) {
 			},
 			want: []string{"prio-3", "prio-2", "prio-1", "backup-resource-1", "backup-resource-2", "backup-resource-3", "prio-3"},
 		},
+		{
+			name: "when group orders are specified, their groups' backup resources are reordered in place",
+			groupOrders: [][]string{
+				{"widgets.example.com", "gadgets.example.com"},
+			},
+			backupResources: map[string]*archive.ResourceItems{
+				"gadgets.example.com": nil,
+				"things.example.com":  nil,
+				"widgets.example.com": nil,
+				"pods":                nil,
+				"deployments.apps":    nil,
+			},
+			want: []string{"deployments.apps", "widgets.example.com", "pods", "gadgets.example.com", "things.example.com"},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			assert.Equal(t, tc.want, getOrderedResources(tc.resourcePriorities, tc.backupResources))
+			assert.Equal(t, tc.want, getOrderedResources(tc.resourcePriorities, tc.groupOrders, tc.backupResources))
 		})
 	}
 }
@@ -2894,3 +3744,85 @@ func Test_resetVolumeBindingInfo(t *testing.T) {
 		})
 	}
 }
+
+// TestRestoreRedactedSecrets runs a restore of a backup with a redacted secret,
+// and verifies that it's an error rather than being restored.
+func TestRestoreRedactedSecrets(t *testing.T) {
+	h := newHarness(t)
+	h.DiscoveryClient.WithAPIResource(test.Secrets())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().RedactSecrets(true).Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("secrets",
+				builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithAnnotations(velerov1api.RedactedSecretAnnotation, "true")).Result(),
+				builder.ForSecret("ns-1", "secret-2").Result(),
+			).
+			Done(),
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // actions
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assert.Empty(t, warnings.Namespaces)
+	assert.Equal(t, map[string][]string{
+		"ns-1": {"error restoring secrets/ns-1/secret-1: its data values were redacted when it was backed up"},
+	}, errs.Namespaces)
+	assertAPIContents(t, h, map[*test.APIResource][]string{test.Secrets(): {"ns-1/secret-2"}})
+}
+
+func TestUnestablishedCRDFailsCustomResources(t *testing.T) {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": "widgets.example.com"},
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"names": map[string]interface{}{"plural": "widgets"},
+		},
+	}}
+
+	ctx := &restoreContext{
+		log:                       test.NewLogger(),
+		restore:                   builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NamespaceMappings("ns-1", "ns-2").Result(),
+		resourceIncludesExcludes:  collections.NewIncludesExcludes(),
+		namespaceIncludesExcludes: collections.NewIncludesExcludes().Excludes("ns-3"),
+		unestablishedCRDs:         make(map[string]string),
+	}
+	ctx.recordUnestablishedCRD(crd)
+	assert.Equal(t, map[string]string{"widgets.example.com": "widgets.example.com"}, ctx.unestablishedCRDs)
+
+	backupResources := map[string]*archive.ResourceItems{
+		"widgets.example.com": {
+			GroupResource: "widgets.example.com",
+			ItemsByNamespace: map[string][]string{
+				"ns-1": {"widget-1"},
+				"ns-3": {"widget-2"},
+			},
+		},
+	}
+
+	collection, processed, warnings, errs := ctx.getOrderedResourceCollection(backupResources, nil, sets.NewString(), nil, true)
+	assert.Empty(t, collection)
+	assert.True(t, processed.Has("widgets.example.com"))
+	assert.Empty(t, warnings)
+	assert.Equal(t, Result{
+		Namespaces: map[string][]string{
+			"ns-2": {"error restoring widgets.example.com/ns-2/widget-1: custom resource definition widgets.example.com never became established"},
+		},
+	}, errs)
+
+	widget := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"namespace": "ns-1", "name": "widget-3"},
+	}}
+	_, errs = ctx.restoreItem(widget, schema.GroupResource{Group: "example.com", Resource: "widgets"}, "ns-2")
+	assert.Equal(t, []string{"error restoring widgets.example.com/ns-2/widget-3: custom resource definition widgets.example.com never became established"}, errs.Namespaces["ns-2"])
+}
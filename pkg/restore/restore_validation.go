@@ -0,0 +1,173 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// resolvedValidator is a restore validator and the restored items it
+// applies to.
+type resolvedValidator struct {
+	velero.RestoreValidator
+
+	resourceIncludesExcludes  *collections.IncludesExcludes
+	namespaceIncludesExcludes *collections.IncludesExcludes
+	selector                  labels.Selector
+}
+
+func resolveValidators(validators []velero.RestoreValidator, helper discovery.Helper) ([]resolvedValidator, error) {
+	var resolved []resolvedValidator
+
+	for _, validator := range validators {
+		resourceSelector, err := validator.AppliesTo()
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting the resources a restore validator applies to")
+		}
+
+		resources, namespaces, selector, err := resolveResourceSelector(resourceSelector, helper)
+		if err != nil {
+			return nil, errors.Wrap(err, "error resolving the resources a restore validator applies to")
+		}
+
+		resolved = append(resolved, resolvedValidator{
+			RestoreValidator:          validator,
+			resourceIncludesExcludes:  resources,
+			namespaceIncludesExcludes: namespaces,
+			selector:                  selector,
+		})
+	}
+
+	return resolved, nil
+}
+
+// appliesTo returns whether the validator applies to restored items of a
+// resource in a namespace, which is empty for cluster-scoped resources.
+// Their labels are matched against the validator's selector once they've
+// been got from the cluster.
+func (v *resolvedValidator) appliesTo(groupResource schema.GroupResource, namespace string) bool {
+	if !v.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+		return false
+	}
+	if namespace == "" {
+		return v.namespaceIncludesExcludes.IncludeEverything()
+	}
+	return v.namespaceIncludesExcludes.ShouldInclude(namespace)
+}
+
+// recordItemToValidate adds a restored item to the items that are validated
+// once the restore's items have all been created, if any validator applies
+// to it.
+func (ctx *restoreContext) recordItemToValidate(itemKey velero.ResourceIdentifier, restoredName string) {
+	if ctx.dryRun {
+		return
+	}
+
+	for i := range ctx.validators {
+		if ctx.validators[i].appliesTo(itemKey.GroupResource, itemKey.Namespace) {
+			item := itemKey
+			item.Name = restoredName
+			ctx.itemsToValidate = append(ctx.itemsToValidate, item)
+			return
+		}
+	}
+}
+
+// validateRestoredItems invokes the validators for each restored item they
+// apply to, with the item as it is in the cluster. Items that fail
+// validation are returned as errors, or as warnings if the validator says
+// so, as are items that can't be validated.
+func (ctx *restoreContext) validateRestoredItems() (Result, Result) {
+	warnings, errs := Result{}, Result{}
+	if len(ctx.itemsToValidate) == 0 {
+		return warnings, errs
+	}
+
+	ctx.log.Infof("Validating %d restored items", len(ctx.itemsToValidate))
+	failed := 0
+	for _, item := range ctx.itemsToValidate {
+		obj, err := ctx.getRestoredItem(item)
+		if err != nil {
+			ctx.log.WithError(err).Errorf("Error getting %s %s to validate it", item.GroupResource, item.Name)
+			errs.Add(item.Namespace, errors.Wrapf(err, "error getting %s %s to validate it", item.GroupResource, item.Name))
+			failed++
+			continue
+		}
+
+		for i := range ctx.validators {
+			validator := &ctx.validators[i]
+			if !validator.appliesTo(item.GroupResource, item.Namespace) || !validator.selector.Matches(labels.Set(obj.GetLabels())) {
+				continue
+			}
+
+			result, err := validator.Validate(&velero.RestoreValidatorValidateInput{
+				Item:    obj.DeepCopy(),
+				Restore: ctx.restore,
+			})
+			if err != nil {
+				ctx.log.WithError(err).Errorf("Error validating %s %s", item.GroupResource, item.Name)
+				errs.Add(item.Namespace, errors.Wrapf(err, "error validating %s %s", item.GroupResource, item.Name))
+				failed++
+				continue
+			}
+			if result.Passed {
+				continue
+			}
+
+			failed++
+			failure := errors.Errorf("%s %s failed validation: %s", item.GroupResource, item.Name, result.Message)
+			if result.Severity == velero.RestoreValidationSeverityWarning {
+				ctx.log.Warn(failure.Error())
+				warnings.Add(item.Namespace, failure)
+			} else {
+				ctx.log.Error(failure.Error())
+				errs.Add(item.Namespace, failure)
+			}
+		}
+	}
+	ctx.log.Infof("Done validating restored items, %d validations failed", failed)
+
+	return warnings, errs
+}
+
+// getRestoredItem gets a restored item from the cluster, using its
+// resource's preferred version.
+func (ctx *restoreContext) getRestoredItem(item velero.ResourceIdentifier) (*unstructured.Unstructured, error) {
+	gvr, resource, err := ctx.discoveryHelper.ResourceFor(item.GroupResource.WithVersion(""))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, item.Namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting client")
+	}
+
+	obj, err := resourceClient.Get(item.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return obj, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeRestoreValidator fails the items named in its failures, and returns an
+// error for the items named in its errors.
+type fakeRestoreValidator struct {
+	selector  velero.ResourceSelector
+	failures  map[string]velero.RestoreValidationResult
+	errors    map[string]error
+	validated []string
+}
+
+func (v *fakeRestoreValidator) AppliesTo() (velero.ResourceSelector, error) {
+	return v.selector, nil
+}
+
+func (v *fakeRestoreValidator) Validate(input *velero.RestoreValidatorValidateInput) (velero.RestoreValidationResult, error) {
+	name, _, _ := unstructured.NestedString(input.Item.UnstructuredContent(), "metadata", "name")
+	v.validated = append(v.validated, name)
+
+	if err := v.errors[name]; err != nil {
+		return velero.RestoreValidationResult{}, err
+	}
+	if result, ok := v.failures[name]; ok {
+		return result, nil
+	}
+	return velero.RestoreValidationResult{Passed: true}, nil
+}
+
+func TestValidateRestoredItems(t *testing.T) {
+	deployments := &fakeRestoreValidator{
+		selector: velero.ResourceSelector{
+			IncludedResources:  []string{"deployments.apps"},
+			IncludedNamespaces: []string{"ns-1"},
+			LabelSelector:      "app=web",
+		},
+		failures: map[string]velero.RestoreValidationResult{
+			"web-restored": {Message: "expected 3 available replicas, found 1"},
+		},
+	}
+	services := &fakeRestoreValidator{
+		selector: velero.ResourceSelector{IncludedResources: []string{"services"}},
+		failures: map[string]velero.RestoreValidationResult{
+			"frontend": {Message: "service has no endpoints", Severity: velero.RestoreValidationSeverityWarning},
+		},
+		errors: map[string]error{
+			"backend": errors.New("error listing endpoints"),
+		},
+	}
+
+	helper := velerotest.NewFakeDiscoveryHelper(true, nil)
+	validators, err := resolveValidators([]velero.RestoreValidator{deployments, services}, helper)
+	require.NoError(t, err)
+
+	items := map[string]string{
+		"web-restored": `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"namespace": "ns-1", "name": "web-restored", "labels": {"app": "web"}}}`,
+		"db":           `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"namespace": "ns-1", "name": "db", "labels": {"app": "db"}}}`,
+		"frontend":     `{"apiVersion": "v1", "kind": "Service", "metadata": {"namespace": "ns-2", "name": "frontend"}}`,
+		"backend":      `{"apiVersion": "v1", "kind": "Service", "metadata": {"namespace": "ns-2", "name": "backend"}}`,
+	}
+	resourceClient := &velerotest.FakeDynamicClient{}
+	for name, item := range items {
+		resourceClient.On("Get", name, metav1.GetOptions{}).Return(velerotest.UnstructuredOrDie(item), nil)
+	}
+	dynamicFactory := &velerotest.FakeDynamicFactory{}
+	dynamicFactory.On("ClientForGroupVersionResource", mock.Anything, mock.Anything, mock.Anything).Return(resourceClient, nil)
+
+	ctx := &restoreContext{
+		log:             velerotest.NewLogger(),
+		restore:         builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+		dynamicFactory:  dynamicFactory,
+		discoveryHelper: helper,
+		validators:      validators,
+	}
+
+	// only items that a validator's resources and namespaces apply to are
+	// recorded, with their restored names.
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: kuberesource.Deployments, Namespace: "ns-1", Name: "web"}, "web-restored")
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: kuberesource.Deployments, Namespace: "ns-1", Name: "db"}, "db")
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: kuberesource.Deployments, Namespace: "ns-2", Name: "api"}, "api")
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: schema.GroupResource{Resource: "services"}, Namespace: "ns-2", Name: "frontend"}, "frontend")
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: schema.GroupResource{Resource: "services"}, Namespace: "ns-2", Name: "backend"}, "backend")
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"}, "pod-1")
+	assert.Len(t, ctx.itemsToValidate, 4)
+
+	warnings, errs := ctx.validateRestoredItems()
+
+	assert.Equal(t, Result{Namespaces: map[string][]string{
+		"ns-2": {"services frontend failed validation: service has no endpoints"},
+	}}, warnings)
+	assert.Equal(t, Result{Namespaces: map[string][]string{
+		"ns-1": {"deployments.apps web-restored failed validation: expected 3 available replicas, found 1"},
+		"ns-2": {"error validating services backend: error listing endpoints"},
+	}}, errs)
+
+	// the label selector is matched against the item in the cluster.
+	assert.Equal(t, []string{"web-restored"}, deployments.validated)
+	assert.Equal(t, []string{"frontend", "backend"}, services.validated)
+}
+
+func TestValidateRestoredItemsDryRun(t *testing.T) {
+	validators, err := resolveValidators([]velero.RestoreValidator{&fakeRestoreValidator{}}, velerotest.NewFakeDiscoveryHelper(true, nil))
+	require.NoError(t, err)
+
+	ctx := &restoreContext{
+		log:        velerotest.NewLogger(),
+		validators: validators,
+		dryRun:     true,
+	}
+	ctx.recordItemToValidate(velero.ResourceIdentifier{GroupResource: kuberesource.Pods, Namespace: "ns-1", Name: "pod-1"}, "pod-1")
+
+	warnings, errs := ctx.validateRestoredItems()
+	assert.Empty(t, ctx.itemsToValidate)
+	assert.Equal(t, Result{}, warnings)
+	assert.Equal(t, Result{}, errs)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// OriginalReplicasAnnotation is set on the workloads that a restore with
+// spec.scaleDownWorkloads scales to zero replicas to the replicas they were
+// backed up with, so that they can be scaled back up by hand.
+const OriginalReplicasAnnotation = "velero.io/original-replicas"
+
+// ScaleDownWorkloadsAction scales restored Deployments and StatefulSets to
+// zero replicas if the restore's spec.scaleDownWorkloads is set, so that
+// they don't run until they're scaled up on purpose.
+type ScaleDownWorkloadsAction struct {
+	logger logrus.FieldLogger
+}
+
+// NewScaleDownWorkloadsAction is the constructor for ScaleDownWorkloadsAction.
+func NewScaleDownWorkloadsAction(logger logrus.FieldLogger) *ScaleDownWorkloadsAction {
+	return &ScaleDownWorkloadsAction{logger: logger}
+}
+
+// AppliesTo returns the resources that ScaleDownWorkloadsAction should be
+// run for.
+func (a *ScaleDownWorkloadsAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"deployments.apps", "statefulsets.apps"},
+	}, nil
+}
+
+// Execute sets the item's replicas to zero and records the replicas it was
+// backed up with in the original replicas annotation. An item that already
+// has the annotation, because it was backed up after being restored scaled
+// down, keeps it, since its replicas are zero.
+func (a *ScaleDownWorkloadsAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !boolptr.IsSetToTrue(input.Restore.Spec.ScaleDownWorkloads) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	log := a.logger.WithFields(logrus.Fields{
+		"kind":      obj.GetKind(),
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	})
+
+	// a workload without replicas has the default of one.
+	replicas, found, err := unstructured.NestedInt64(obj.UnstructuredContent(), "spec", "replicas")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting item's replicas")
+	}
+	if !found {
+		replicas = 1
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if original, ok := annotations[OriginalReplicasAnnotation]; ok {
+		log.Infof("Workload was already scaled down from %s replicas, keeping its original replicas", original)
+	} else {
+		annotations[OriginalReplicasAnnotation] = strconv.FormatInt(replicas, 10)
+		log.Infof("Scaling down workload from %d replicas to zero", replicas)
+	}
+	obj.SetAnnotations(annotations)
+
+	if err := unstructured.SetNestedField(obj.UnstructuredContent(), int64(0), "spec", "replicas"); err != nil {
+		return nil, errors.Wrap(err, "unable to set item's replicas")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// unscaleWorkload sets the replicas of a workload that was scaled down by
+// ScaleDownWorkloadsAction back to the original ones, and removes its
+// original replicas annotation.
+func unscaleWorkload(obj *unstructured.Unstructured) {
+	annotations := obj.GetAnnotations()
+	value, ok := annotations[OriginalReplicasAnnotation]
+	if !ok {
+		return
+	}
+
+	replicas, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return
+	}
+	if err := unstructured.SetNestedField(obj.UnstructuredContent(), replicas, "spec", "replicas"); err != nil {
+		return
+	}
+
+	delete(annotations, OriginalReplicasAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+}
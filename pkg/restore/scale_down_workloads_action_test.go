@@ -0,0 +1,92 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestScaleDownWorkloadsActionExecute(t *testing.T) {
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		item    string
+		want    string
+	}{
+		{
+			name:    "workload isn't scaled down if the restore doesn't scale down workloads",
+			restore: builder.ForRestore("velero", "restore-1").Result(),
+			item:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"replicas":3}}`,
+			want:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"replicas":3}}`,
+		},
+		{
+			name:    "deployment is scaled down and its replicas are recorded",
+			restore: builder.ForRestore("velero", "restore-1").ScaleDownWorkloads(true).Result(),
+			item:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"replicas":3}}`,
+			want:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1","annotations":{"velero.io/original-replicas":"3"}},"spec":{"replicas":0}}`,
+		},
+		{
+			name:    "stateful set is scaled down and its replicas are recorded",
+			restore: builder.ForRestore("velero", "restore-1").ScaleDownWorkloads(true).Result(),
+			item:    `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"namespace":"ns-1","name":"sts-1","annotations":{"a":"b"}},"spec":{"replicas":2}}`,
+			want:    `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"namespace":"ns-1","name":"sts-1","annotations":{"a":"b","velero.io/original-replicas":"2"}},"spec":{"replicas":0}}`,
+		},
+		{
+			name:    "workload without replicas is recorded with the default of one",
+			restore: builder.ForRestore("velero", "restore-1").ScaleDownWorkloads(true).Result(),
+			item:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{}}`,
+			want:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1","annotations":{"velero.io/original-replicas":"1"}},"spec":{"replicas":0}}`,
+		},
+		{
+			name:    "workload that was already scaled down keeps its original replicas",
+			restore: builder.ForRestore("velero", "restore-1").ScaleDownWorkloads(true).Result(),
+			item:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1","annotations":{"velero.io/original-replicas":"5"}},"spec":{"replicas":0}}`,
+			want:    `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1","annotations":{"velero.io/original-replicas":"5"}},"spec":{"replicas":0}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewScaleDownWorkloadsAction(velerotest.NewLogger())
+
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{
+				Item:    velerotest.UnstructuredOrDie(tc.item),
+				Restore: tc.restore,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, velerotest.UnstructuredOrDie(tc.want), res.UpdatedItem)
+		})
+	}
+}
+
+func TestUnscaleWorkload(t *testing.T) {
+	obj := velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1","annotations":{"velero.io/original-replicas":"3"}},"spec":{"replicas":0}}`)
+	unscaleWorkload(obj)
+	assert.Equal(t, velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"replicas":3}}`), obj)
+
+	// workloads that weren't scaled down are left as they are.
+	unscaleWorkload(obj)
+	assert.Equal(t, velerotest.UnstructuredOrDie(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"namespace":"ns-1","name":"deploy-1"},"spec":{"replicas":3}}`), obj)
+}
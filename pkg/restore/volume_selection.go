@@ -0,0 +1,269 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// volumeSelection holds the persistent volume claims in a backup whose data
+// isn't restored because the restore's volume filters don't select them,
+// along with the persistent volumes and CSI volume snapshots holding that
+// data. A nil *volumeSelection selects every volume.
+type volumeSelection struct {
+	// unselectedPVCs and unselectedVolumeSnapshots are keyed by
+	// namespace/name in the backup, unselectedPVs by name.
+	unselectedPVCs            sets.String
+	unselectedPVs             sets.String
+	unselectedVolumeSnapshots sets.String
+
+	// archivedNamespaces maps the namespaces pod volume backups refer to to
+	// the names they're recorded under in the backup.
+	archivedNamespaces map[string]string
+}
+
+// hasVolumeFilters returns whether a restore only restores the data of some
+// of the backup's volumes.
+func hasVolumeFilters(restore *velerov1api.Restore) bool {
+	return len(restore.Spec.IncludedVolumes) > 0 || len(restore.Spec.ExcludedVolumes) > 0 || restore.Spec.VolumeSelector != nil
+}
+
+// selectVolumes reads the backup's persistent volume claims and CSI volume
+// snapshots to find the ones that the restore's volume filters don't select.
+// It returns nil if the restore has no volume filters.
+func (ctx *restoreContext) selectVolumes(backupResources map[string]*archive.ResourceItems) (*volumeSelection, error) {
+	if !hasVolumeFilters(ctx.restore) {
+		return nil, nil
+	}
+
+	includesExcludes := collections.NewIncludesExcludes().
+		Includes(ctx.restore.Spec.IncludedVolumes...).
+		Excludes(ctx.restore.Spec.ExcludedVolumes...)
+
+	selector := labels.Everything()
+	if ctx.restore.Spec.VolumeSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(ctx.restore.Spec.VolumeSelector); err != nil {
+			return nil, errors.Wrap(err, "error parsing volume selector")
+		}
+	}
+
+	selection := &volumeSelection{
+		unselectedPVCs:            sets.NewString(),
+		unselectedPVs:             sets.NewString(),
+		unselectedVolumeSnapshots: sets.NewString(),
+		archivedNamespaces:        ctx.backup.Status.ArchivedNamespaces,
+	}
+
+	err := ctx.forEachBackupItem(backupResources, kuberesource.PersistentVolumeClaims, func(obj *unstructured.Unstructured) error {
+		key := volumeKey(obj.GetNamespace(), obj.GetName())
+		if includesExcludes.ShouldInclude(key) && selector.Matches(labels.Set(obj.GetLabels())) {
+			return nil
+		}
+
+		ctx.log.Infof("Not restoring the data of persistent volume claim %s because it isn't selected by the restore's volume filters", key)
+		selection.unselectedPVCs.Insert(key)
+
+		volumeName, _, err := unstructured.NestedString(obj.Object, "spec", "volumeName")
+		if err != nil {
+			return errors.Wrapf(err, "error getting volume name of persistent volume claim %s", key)
+		}
+		if volumeName != "" {
+			selection.unselectedPVs.Insert(volumeName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.forEachBackupItem(backupResources, kuberesource.VolumeSnapshots, func(obj *unstructured.Unstructured) error {
+		pvcName, _, err := unstructured.NestedString(obj.Object, "spec", "source", "persistentVolumeClaimName")
+		if err != nil {
+			return errors.Wrapf(err, "error getting source of volume snapshot %s", volumeKey(obj.GetNamespace(), obj.GetName()))
+		}
+		if selection.unselectedPVCs.Has(volumeKey(obj.GetNamespace(), pvcName)) {
+			selection.unselectedVolumeSnapshots.Insert(volumeKey(obj.GetNamespace(), obj.GetName()))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return selection, nil
+}
+
+// forEachBackupItem calls fn with each of the backup's items of a resource.
+func (ctx *restoreContext) forEachBackupItem(backupResources map[string]*archive.ResourceItems, groupResource schema.GroupResource, fn func(*unstructured.Unstructured) error) error {
+	resourceItems := backupResources[groupResource.String()]
+	if resourceItems == nil {
+		return nil
+	}
+
+	for namespace, items := range resourceItems.ItemsByNamespace {
+		for _, item := range items {
+			obj, err := archive.Unmarshal(ctx.fileSystem, archive.GetItemFilePath(ctx.restoreDir, groupResource.String(), namespace, item))
+			if err != nil {
+				return errors.Wrapf(err, "error reading %s %s from backup", groupResource, volumeKey(namespace, item))
+			}
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func volumeKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// pvcSelected returns whether the data of a persistent volume claim, by its
+// namespace in the backup, is restored.
+func (s *volumeSelection) pvcSelected(namespace, name string) bool {
+	return s == nil || !s.unselectedPVCs.Has(volumeKey(namespace, name))
+}
+
+// pvSelected returns whether a persistent volume is restored, i.e. whether it
+// isn't bound to an unselected persistent volume claim.
+func (s *volumeSelection) pvSelected(name string) bool {
+	return s == nil || !s.unselectedPVs.Has(name)
+}
+
+// skipItem returns whether an item isn't restored at all because it's a CSI
+// volume snapshot, or the content of one, of an unselected persistent volume
+// claim, or, if skipPVCs is true, an unselected persistent volume claim.
+func (s *volumeSelection) skipItem(groupResource schema.GroupResource, obj *unstructured.Unstructured, skipPVCs bool) bool {
+	if s == nil {
+		return false
+	}
+
+	switch groupResource {
+	case kuberesource.PersistentVolumeClaims:
+		return skipPVCs && !s.pvcSelected(obj.GetNamespace(), obj.GetName())
+	case kuberesource.VolumeSnapshots:
+		return s.unselectedVolumeSnapshots.Has(volumeKey(obj.GetNamespace(), obj.GetName()))
+	case kuberesource.VolumeSnapshotContents:
+		namespace, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeSnapshotRef", "namespace")
+		name, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeSnapshotRef", "name")
+		return s.unselectedVolumeSnapshots.Has(volumeKey(namespace, name))
+	}
+
+	return false
+}
+
+// filterPodVolumeBackups returns the pod volume backups whose data is
+// restored. Pod volume backups of volumes that aren't persistent volume
+// claims are always restored.
+func (s *volumeSelection) filterPodVolumeBackups(podVolumeBackups []*velerov1api.PodVolumeBackup) []*velerov1api.PodVolumeBackup {
+	if s == nil {
+		return podVolumeBackups
+	}
+
+	var selected []*velerov1api.PodVolumeBackup
+	for _, pvb := range podVolumeBackups {
+		pvcName := pvb.GetAnnotations()[restic.PVCNameAnnotation]
+		namespace := pvb.Spec.Pod.Namespace
+		if archived, ok := s.archivedNamespaces[namespace]; ok {
+			namespace = archived
+		}
+		if pvcName != "" && !s.pvcSelected(namespace, pvcName) {
+			continue
+		}
+		selected = append(selected, pvb)
+	}
+
+	return selected
+}
+
+// resetUnselectedPVC clears the fields of an unselected persistent volume
+// claim that would bind it to its volume in the backup or restore that
+// volume's data, so that it's dynamically provisioned empty.
+func resetUnselectedPVC(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+
+	if kind, _, _ := unstructured.NestedString(obj.Object, "spec", "dataSource", "kind"); kind == "VolumeSnapshot" {
+		unstructured.RemoveNestedField(obj.Object, "spec", "dataSource")
+	}
+}
+
+// pruneResticInitContainerMounts removes the volume mounts of a pod's restic
+// restore init container for the volumes whose pod volume backups aren't
+// restored, since the init container waits for the restores of all of the
+// volumes it mounts. The init container is removed if no mounts are left.
+func pruneResticInitContainerMounts(obj *unstructured.Unstructured, restoredVolumes map[string]string) error {
+	initContainers, found, err := unstructured.NestedSlice(obj.Object, "spec", "initContainers")
+	if err != nil {
+		return errors.Wrap(err, "error getting pod's init containers")
+	}
+	if !found {
+		return nil
+	}
+
+	for i, c := range initContainers {
+		container, ok := c.(map[string]interface{})
+		if !ok || container["name"] != restic.InitContainer {
+			continue
+		}
+
+		mounts, _, err := unstructured.NestedSlice(container, "volumeMounts")
+		if err != nil {
+			return errors.Wrap(err, "error getting restic init container's volume mounts")
+		}
+
+		var kept []interface{}
+		for _, m := range mounts {
+			mount, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := mount["name"].(string)
+			if _, ok := restoredVolumes[name]; ok {
+				kept = append(kept, mount)
+			}
+		}
+
+		if len(kept) == 0 {
+			initContainers = append(initContainers[:i], initContainers[i+1:]...)
+		} else {
+			container["volumeMounts"] = kept
+		}
+
+		break
+	}
+
+	if len(initContainers) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "spec", "initContainers")
+		return nil
+	}
+	return unstructured.SetNestedSlice(obj.Object, initContainers, "spec", "initContainers")
+}
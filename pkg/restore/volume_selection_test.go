@@ -0,0 +1,340 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// TestRestoreVolumeFilters runs restores with volume filters and verifies that
+// only the selected volumes are restored from their snapshots, and that the
+// claims of the others are restored empty or skipped.
+func TestRestoreVolumeFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		restore     *velerov1api.Restore
+		want        map[*test.APIResource][]string
+		wantVolumes map[string]string
+	}{
+		{
+			name:    "the volume of a claim that isn't included isn't restored, and the claim is restored empty",
+			restore: defaultRestore().IncludedVolumes("ns-1/pvc-2").Result(),
+			want: map[*test.APIResource][]string{
+				test.PVs():  {"/pv-2"},
+				test.PVCs(): {"ns-1/pvc-1", "ns-1/pvc-2"},
+			},
+			wantVolumes: map[string]string{"pvc-1": "", "pvc-2": "pv-2"},
+		},
+		{
+			name:    "the volume of a claim that's excluded isn't restored, and the claim is skipped if unselected volumes are skipped",
+			restore: defaultRestore().ExcludedVolumes("ns-1/pvc-1").SkipUnselectedVolumes(true).Result(),
+			want: map[*test.APIResource][]string{
+				test.PVs():  {"/pv-2"},
+				test.PVCs(): {"ns-1/pvc-2"},
+			},
+			wantVolumes: map[string]string{"pvc-2": "pv-2"},
+		},
+		{
+			name:    "volumes are selected by the labels of their claims",
+			restore: defaultRestore().VolumeSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}).Result(),
+			want: map[*test.APIResource][]string{
+				test.PVs():  {"/pv-2"},
+				test.PVCs(): {"ns-1/pvc-1", "ns-1/pvc-2"},
+			},
+			wantVolumes: map[string]string{"pvc-1": "", "pvc-2": "pv-2"},
+		},
+		{
+			name:    "all volumes are restored without volume filters",
+			restore: defaultRestore().Result(),
+			want: map[*test.APIResource][]string{
+				test.PVs():  {"/pv-1", "/pv-2"},
+				test.PVCs(): {"ns-1/pvc-1", "ns-1/pvc-2"},
+			},
+			wantVolumes: map[string]string{"pvc-1": "pv-1", "pvc-2": "pv-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.resourcePriorities = []string{"persistentvolumes", "persistentvolumeclaims"}
+			h.AddItems(t, test.PVs())
+			h.AddItems(t, test.PVCs())
+
+			vslInformer := velerov1informers.NewSharedInformerFactory(h.VeleroClient, 0).Velero().V1().VolumeSnapshotLocations()
+			require.NoError(t, vslInformer.Informer().GetStore().Add(
+				builder.ForVolumeSnapshotLocation(velerov1api.DefaultNamespace, "default").Provider("provider-1").Result(),
+			))
+
+			var volumeSnapshots []*volume.Snapshot
+			for _, pv := range []string{"pv-1", "pv-2"} {
+				volumeSnapshots = append(volumeSnapshots, &volume.Snapshot{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: pv,
+					},
+					Status: volume.SnapshotStatus{
+						Phase:              volume.SnapshotPhaseCompleted,
+						ProviderSnapshotID: "snapshot-of-" + pv,
+					},
+				})
+			}
+
+			data := Request{
+				Log:             h.log,
+				Restore:         tc.restore,
+				Backup:          defaultBackup().Result(),
+				VolumeSnapshots: volumeSnapshots,
+				BackupReader: test.NewTarWriter(t).
+					AddItems("persistentvolumes",
+						builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).AWSEBSVolumeID("old-volume-1").ClaimRef("ns-1", "pvc-1").Result(),
+						builder.ForPersistentVolume("pv-2").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).AWSEBSVolumeID("old-volume-2").ClaimRef("ns-1", "pvc-2").Result(),
+					).
+					AddItems("persistentvolumeclaims",
+						builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+						builder.ForPersistentVolumeClaim("ns-1", "pvc-2").ObjectMeta(builder.WithLabels("app", "db")).VolumeName("pv-2").Result(),
+					).
+					Done(),
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				vslInformer.Lister(),
+				volumeSnapshotterGetter{
+					"provider-1": &volumeSnapshotter{
+						snapshotVolumes: map[string]string{"snapshot-of-pv-1": "new-volume-1", "snapshot-of-pv-2": "new-volume-2"},
+					},
+				},
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertAPIContents(t, h, tc.want)
+
+			for name, wantVolume := range tc.wantVolumes {
+				pvc, err := h.KubeClient.CoreV1().PersistentVolumeClaims("ns-1").Get(context.TODO(), name, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, wantVolume, pvc.Spec.VolumeName)
+			}
+		})
+	}
+}
+
+func TestVolumeSelectionSkipItem(t *testing.T) {
+	selection := &volumeSelection{
+		unselectedPVCs:            sets.NewString("ns-1/pvc-1"),
+		unselectedPVs:             sets.NewString("pv-1"),
+		unselectedVolumeSnapshots: sets.NewString("ns-1/snapshot-1"),
+	}
+
+	newItem := func(namespace, name string, spec map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+
+	tests := []struct {
+		name          string
+		selection     *volumeSelection
+		groupResource schema.GroupResource
+		obj           *unstructured.Unstructured
+		skipPVCs      bool
+		want          bool
+	}{
+		{
+			name:          "an unselected claim is restored if unselected claims aren't skipped",
+			selection:     selection,
+			groupResource: kuberesource.PersistentVolumeClaims,
+			obj:           newItem("ns-1", "pvc-1", nil),
+			want:          false,
+		},
+		{
+			name:          "an unselected claim is skipped if unselected claims are skipped",
+			selection:     selection,
+			groupResource: kuberesource.PersistentVolumeClaims,
+			obj:           newItem("ns-1", "pvc-1", nil),
+			skipPVCs:      true,
+			want:          true,
+		},
+		{
+			name:          "a selected claim is restored",
+			selection:     selection,
+			groupResource: kuberesource.PersistentVolumeClaims,
+			obj:           newItem("ns-1", "pvc-2", nil),
+			skipPVCs:      true,
+			want:          false,
+		},
+		{
+			name:          "a volume snapshot of an unselected claim is skipped",
+			selection:     selection,
+			groupResource: kuberesource.VolumeSnapshots,
+			obj:           newItem("ns-1", "snapshot-1", nil),
+			want:          true,
+		},
+		{
+			name:          "the content of a volume snapshot of an unselected claim is skipped",
+			selection:     selection,
+			groupResource: kuberesource.VolumeSnapshotContents,
+			obj: newItem("", "content-1", map[string]interface{}{
+				"volumeSnapshotRef": map[string]interface{}{"namespace": "ns-1", "name": "snapshot-1"},
+			}),
+			want: true,
+		},
+		{
+			name:          "the content of a volume snapshot of a selected claim is restored",
+			selection:     selection,
+			groupResource: kuberesource.VolumeSnapshotContents,
+			obj: newItem("", "content-2", map[string]interface{}{
+				"volumeSnapshotRef": map[string]interface{}{"namespace": "ns-1", "name": "snapshot-2"},
+			}),
+			want: false,
+		},
+		{
+			name:          "nothing is skipped without volume filters",
+			groupResource: kuberesource.PersistentVolumeClaims,
+			obj:           newItem("ns-1", "pvc-1", nil),
+			skipPVCs:      true,
+			want:          false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.selection.skipItem(tc.groupResource, tc.obj, tc.skipPVCs))
+		})
+	}
+}
+
+func TestVolumeSelectionFilterPodVolumeBackups(t *testing.T) {
+	pvbs := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup("velero", "pvb-1").ObjectMeta(builder.WithAnnotations(restic.PVCNameAnnotation, "pvc-1")).PodNamespace("ns-1").PodName("pod-1").Volume("data").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-2").ObjectMeta(builder.WithAnnotations(restic.PVCNameAnnotation, "pvc-2")).PodNamespace("ns-1").PodName("pod-1").Volume("logs").Result(),
+		builder.ForPodVolumeBackup("velero", "pvb-3").PodNamespace("ns-1").PodName("pod-1").Volume("scratch").Result(),
+	}
+
+	selection := &volumeSelection{unselectedPVCs: sets.NewString("ns-1/pvc-1")}
+	assert.Equal(t, pvbs[1:], selection.filterPodVolumeBackups(pvbs))
+
+	var noSelection *volumeSelection
+	assert.Equal(t, pvbs, noSelection.filterPodVolumeBackups(pvbs))
+}
+
+func TestPruneResticInitContainerMounts(t *testing.T) {
+	resticInitContainer := func(volumes ...string) *corev1api.Container {
+		container := builder.ForContainer(restic.InitContainer, "velero/velero-restic-restore-helper").Result()
+		for _, volume := range volumes {
+			container.VolumeMounts = append(container.VolumeMounts, corev1api.VolumeMount{Name: volume, MountPath: "/restores/" + volume})
+		}
+		return container
+	}
+	otherInitContainer := builder.ForContainer("init", "busybox").Result()
+
+	tests := []struct {
+		name            string
+		pod             *corev1api.Pod
+		restoredVolumes map[string]string
+		want            *corev1api.Pod
+	}{
+		{
+			name:            "mounts of volumes that aren't restored are removed",
+			pod:             builder.ForPod("ns-1", "pod-1").InitContainers(resticInitContainer("data", "logs"), otherInitContainer).Result(),
+			restoredVolumes: map[string]string{"logs": "snapshot-1"},
+			want:            builder.ForPod("ns-1", "pod-1").InitContainers(resticInitContainer("logs"), otherInitContainer).Result(),
+		},
+		{
+			name:            "the init container is removed if none of its volumes are restored",
+			pod:             builder.ForPod("ns-1", "pod-1").InitContainers(resticInitContainer("data"), otherInitContainer).Result(),
+			restoredVolumes: map[string]string{},
+			want:            builder.ForPod("ns-1", "pod-1").InitContainers(otherInitContainer).Result(),
+		},
+		{
+			name:            "a pod without a restic init container is unchanged",
+			pod:             builder.ForPod("ns-1", "pod-1").InitContainers(otherInitContainer).Result(),
+			restoredVolumes: map[string]string{},
+			want:            builder.ForPod("ns-1", "pod-1").InitContainers(otherInitContainer).Result(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.pod)
+			require.NoError(t, err)
+
+			u := &unstructured.Unstructured{Object: obj}
+			require.NoError(t, pruneResticInitContainerMounts(u, tc.restoredVolumes))
+
+			got := new(corev1api.Pod)
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, got))
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResetUnselectedPVC(t *testing.T) {
+	tests := []struct {
+		name       string
+		dataSource *corev1api.TypedLocalObjectReference
+		want       *corev1api.TypedLocalObjectReference
+	}{
+		{
+			name:       "a volume snapshot data source is removed",
+			dataSource: &corev1api.TypedLocalObjectReference{Kind: "VolumeSnapshot", Name: "snapshot-1"},
+		},
+		{
+			name:       "a persistent volume claim data source is kept",
+			dataSource: &corev1api.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "pvc-2"},
+			want:       &corev1api.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "pvc-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
+			pvc.Spec.DataSource = tc.dataSource
+
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+			require.NoError(t, err)
+
+			u := &unstructured.Unstructured{Object: obj}
+			resetUnselectedPVC(u)
+
+			got := new(corev1api.PersistentVolumeClaim)
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, got))
+			assert.Empty(t, got.Spec.VolumeName)
+			assert.Equal(t, tc.want, got.Spec.DataSource)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+const (
+	// webhookCABundleModeKey is the key of the plugin's config map that sets
+	// what's done with webhooks' CA bundles. Every other key is a mapping.
+	webhookCABundleModeKey = "mode"
+
+	// webhookCABundleModeKeep restores CA bundles as they were backed up.
+	webhookCABundleModeKeep = "keep"
+	// webhookCABundleModeStrip removes every CA bundle.
+	webhookCABundleModeStrip = "strip"
+	// webhookCABundleModeRemap replaces the CA bundles that match a mapping
+	// in the plugin's config map.
+	webhookCABundleModeRemap = "remap"
+
+	// anyCABundle can be used as the FROM of a mapping to replace any CA
+	// bundle that no other mapping matches.
+	anyCABundle = "*"
+)
+
+// caInjectionAnnotations are the annotations that ask a controller to
+// inject a CA bundle into a webhook configuration's webhooks.
+var caInjectionAnnotations = []string{
+	"cert-manager.io/inject-ca-from",
+	"cert-manager.io/inject-ca-from-secret",
+	"cert-manager.io/inject-apiserver-ca",
+	"service.beta.openshift.io/inject-cabundle",
+}
+
+// WebhookCABundleAction updates the CA bundles of the webhooks in validating
+// and mutating webhook configurations, which are usually signed by the
+// source cluster's certificates and so break admission in another cluster.
+type WebhookCABundleAction struct {
+	logger          logrus.FieldLogger
+	configMapClient corev1client.ConfigMapInterface
+}
+
+// NewWebhookCABundleAction is the constructor for WebhookCABundleAction.
+func NewWebhookCABundleAction(logger logrus.FieldLogger, configMapClient corev1client.ConfigMapInterface) *WebhookCABundleAction {
+	return &WebhookCABundleAction{
+		logger:          logger,
+		configMapClient: configMapClient,
+	}
+}
+
+// AppliesTo returns the resources that WebhookCABundleAction should
+// be run for.
+func (a *WebhookCABundleAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{
+			"validatingwebhookconfigurations.admissionregistration.k8s.io",
+			"mutatingwebhookconfigurations.admissionregistration.k8s.io",
+		},
+	}, nil
+}
+
+// Execute keeps, removes or replaces the CA bundle of each of the item's
+// webhooks, as set by the config map for the plugin. Without a config map,
+// the CA bundles of items with a CA injection annotation are removed, so
+// that the controller that injected them injects the target cluster's, and
+// other items are left as-is. The item's annotations are never changed.
+func (a *WebhookCABundleAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	a.logger.Info("Executing WebhookCABundleAction")
+	defer a.logger.Info("Done executing WebhookCABundleAction")
+
+	a.logger.Debug("Getting plugin config")
+	config, err := framework.GetPluginConfig(framework.PluginKindRestoreItemAction, "velero.io/webhook-ca-bundle", a.configMapClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]string
+	if config != nil {
+		data = config.Data
+	}
+	mode, mappings, err := parseWebhookCABundleConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	log := a.logger.WithFields(map[string]interface{}{
+		"kind": obj.GetKind(),
+		"name": obj.GetName(),
+	})
+
+	injectionAnnotation := getCAInjectionAnnotation(obj.GetAnnotations())
+	if mode == "" {
+		if injectionAnnotation == "" {
+			log.Debug("Item has no CA injection annotation, keeping its webhooks' CA bundles")
+			return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+		}
+		mode = webhookCABundleModeStrip
+	}
+	if mode == webhookCABundleModeKeep {
+		log.Debug("Keeping the item's webhooks' CA bundles")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	webhooks, found, err := unstructured.NestedSlice(obj.UnstructuredContent(), "webhooks")
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting item's webhooks")
+	}
+	if !found {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	for _, w := range webhooks {
+		webhook, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		clientConfig, ok := webhook["clientConfig"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		caBundle, ok := clientConfig["caBundle"].(string)
+		if !ok || caBundle == "" {
+			log.Debugf("Webhook %v has no CA bundle", webhook["name"])
+			continue
+		}
+
+		if mode == webhookCABundleModeStrip {
+			if injectionAnnotation != "" {
+				log.Infof("Removing the CA bundle of webhook %v, to be injected again as set by the item's %s annotation", webhook["name"], injectionAnnotation)
+			} else {
+				log.Infof("Removing the CA bundle of webhook %v", webhook["name"])
+			}
+			delete(clientConfig, "caBundle")
+			continue
+		}
+
+		newCABundle, ok := mappings.remap(caBundle)
+		if !ok {
+			log.Warnf("No mapping found for the CA bundle of webhook %v, keeping it", webhook["name"])
+			continue
+		}
+		log.Infof("Replacing the CA bundle of webhook %v", webhook["name"])
+		clientConfig["caBundle"] = newCABundle
+	}
+
+	if err := unstructured.SetNestedSlice(obj.UnstructuredContent(), webhooks, "webhooks"); err != nil {
+		return nil, errors.Wrap(err, "unable to set item's webhooks")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
+
+// getCAInjectionAnnotation returns the first CA injection annotation found
+// in annotations, or an empty string if there isn't one.
+func getCAInjectionAnnotation(annotations map[string]string) string {
+	for _, annotation := range caInjectionAnnotations {
+		if _, ok := annotations[annotation]; ok {
+			return annotation
+		}
+	}
+	return ""
+}
+
+// caBundleMappings maps base64-encoded CA bundles to the ones that replace
+// them.
+type caBundleMappings map[string]string
+
+// remap returns the CA bundle that caBundle is mapped to, and whether a
+// mapping was found for it.
+func (m caBundleMappings) remap(caBundle string) (string, bool) {
+	if to, ok := m[caBundle]; ok {
+		return to, true
+	}
+	to, ok := m[anyCABundle]
+	return to, ok
+}
+
+// parseWebhookCABundleConfig parses the mode and mappings from a plugin
+// config map. The mode is empty if it isn't set. The other keys are ignored,
+// and each of their values is a mapping in the form FROM:TO, where FROM and
+// TO are base64-encoded CA bundles, and FROM can be * to match any CA bundle.
+// Mappings are required in remap mode and not allowed in other modes.
+func parseWebhookCABundleConfig(data map[string]string) (string, caBundleMappings, error) {
+	mode := strings.TrimSpace(data[webhookCABundleModeKey])
+	switch mode {
+	case "", webhookCABundleModeKeep, webhookCABundleModeStrip, webhookCABundleModeRemap:
+	default:
+		return "", nil, errors.Errorf("invalid webhook CA bundle mode %q, must be one of %s, %s or %s", mode, webhookCABundleModeKeep, webhookCABundleModeStrip, webhookCABundleModeRemap)
+	}
+
+	mappings := make(caBundleMappings)
+	for key, value := range data {
+		if key == webhookCABundleModeKey {
+			continue
+		}
+
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) != 2 {
+			return "", nil, errors.Errorf("invalid CA bundle mapping %s: must be in the form FROM:TO", key)
+		}
+		from, to := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if from != anyCABundle {
+			if _, err := base64.StdEncoding.DecodeString(from); err != nil || from == "" {
+				return "", nil, errors.Errorf("invalid CA bundle mapping %s: FROM must be a base64-encoded CA bundle or %s", key, anyCABundle)
+			}
+		}
+		if _, err := base64.StdEncoding.DecodeString(to); err != nil || to == "" {
+			return "", nil, errors.Errorf("invalid CA bundle mapping %s: TO must be a base64-encoded CA bundle", key)
+		}
+		if _, ok := mappings[from]; ok {
+			return "", nil, errors.Errorf("invalid CA bundle mapping %s: more than one mapping for the same CA bundle", key)
+		}
+		mappings[from] = to
+	}
+
+	if mode == webhookCABundleModeRemap && len(mappings) == 0 {
+		return "", nil, errors.Errorf("webhook CA bundle mode %s requires at least one mapping", webhookCABundleModeRemap)
+	}
+	if mode != webhookCABundleModeRemap && len(mappings) > 0 {
+		return "", nil, errors.Errorf("CA bundle mappings are only used in webhook CA bundle mode %s", webhookCABundleModeRemap)
+	}
+
+	return mode, mappings, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestParseWebhookCABundleConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		wantErr string
+	}{
+		{
+			name:    "unknown mode is invalid",
+			data:    map[string]string{"mode": "replace"},
+			wantErr: `invalid webhook CA bundle mode "replace", must be one of keep, strip or remap`,
+		},
+		{
+			name:    "mapping without a TO is invalid",
+			data:    map[string]string{"mode": "remap", "source": "b2xk"},
+			wantErr: "invalid CA bundle mapping source: must be in the form FROM:TO",
+		},
+		{
+			name:    "mapping from a CA bundle that isn't base64-encoded is invalid",
+			data:    map[string]string{"mode": "remap", "source": "not base64:bmV3"},
+			wantErr: "invalid CA bundle mapping source: FROM must be a base64-encoded CA bundle or *",
+		},
+		{
+			name:    "mapping to an empty CA bundle is invalid",
+			data:    map[string]string{"mode": "remap", "source": "b2xk:"},
+			wantErr: "invalid CA bundle mapping source: TO must be a base64-encoded CA bundle",
+		},
+		{
+			name:    "remap mode without mappings is invalid",
+			data:    map[string]string{"mode": "remap"},
+			wantErr: "webhook CA bundle mode remap requires at least one mapping",
+		},
+		{
+			name:    "mappings in strip mode are invalid",
+			data:    map[string]string{"mode": "strip", "source": "b2xk:bmV3"},
+			wantErr: "CA bundle mappings are only used in webhook CA bundle mode remap",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := parseWebhookCABundleConfig(tc.data)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestWebhookCABundleActionExecute(t *testing.T) {
+	pluginConfig := func(data ...string) *corev1api.ConfigMap {
+		return builder.ForConfigMap("velero", "webhook-ca-bundle").
+			ObjectMeta(builder.WithLabels("velero.io/plugin-config", "true", "velero.io/webhook-ca-bundle", "RestoreItemAction")).
+			Data(data...).
+			Result()
+	}
+
+	tests := []struct {
+		name      string
+		item      string
+		configMap *corev1api.ConfigMap
+		want      string
+		wantErr   string
+	}{
+		{
+			name: "when no config map exists for the plugin, CA bundles of an item with a CA injection annotation are removed",
+			item: `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1","annotations":{"cert-manager.io/inject-ca-from":"ns-1/cert-1"}},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk","service":{"namespace":"ns-1","name":"svc-1"}}},{"name":"b.example.com","clientConfig":{"url":"https://b.example.com"}}]}`,
+			want: `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1","annotations":{"cert-manager.io/inject-ca-from":"ns-1/cert-1"}},"webhooks":[{"name":"a.example.com","clientConfig":{"service":{"namespace":"ns-1","name":"svc-1"}}},{"name":"b.example.com","clientConfig":{"url":"https://b.example.com"}}]}`,
+		},
+		{
+			name: "when no config map exists for the plugin, an item without a CA injection annotation is returned as-is",
+			item: `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"MutatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}}]}`,
+			want: `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"MutatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}}]}`,
+		},
+		{
+			name:      "keep mode returns an item with a CA injection annotation as-is",
+			item:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"MutatingWebhookConfiguration","metadata":{"name":"webhook-1","annotations":{"cert-manager.io/inject-ca-from":"ns-1/cert-1"}},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}}]}`,
+			configMap: pluginConfig("mode", "keep"),
+			want:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"MutatingWebhookConfiguration","metadata":{"name":"webhook-1","annotations":{"cert-manager.io/inject-ca-from":"ns-1/cert-1"}},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}}]}`,
+		},
+		{
+			name:      "strip mode removes the CA bundles of an item without a CA injection annotation",
+			item:      `{"apiVersion":"admissionregistration.k8s.io/v1beta1","kind":"MutatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}}]}`,
+			configMap: pluginConfig("mode", "strip"),
+			want:      `{"apiVersion":"admissionregistration.k8s.io/v1beta1","kind":"MutatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{}}]}`,
+		},
+		{
+			name:      "remap mode replaces mapped CA bundles and keeps others",
+			item:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}},{"name":"b.example.com","clientConfig":{"caBundle":"b3RoZXI="}}]}`,
+			configMap: pluginConfig("mode", "remap", "source", "b2xk:bmV3"),
+			want:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"bmV3"}},{"name":"b.example.com","clientConfig":{"caBundle":"b3RoZXI="}}]}`,
+		},
+		{
+			name:      "remap mode replaces unmapped CA bundles with the wildcard mapping",
+			item:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"b2xk"}},{"name":"b.example.com","clientConfig":{"caBundle":"b3RoZXI="}}]}`,
+			configMap: pluginConfig("mode", "remap", "source", "b2xk:bmV3", "default", "*:ZGVmYXVsdA=="),
+			want:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[{"name":"a.example.com","clientConfig":{"caBundle":"bmV3"}},{"name":"b.example.com","clientConfig":{"caBundle":"ZGVmYXVsdA=="}}]}`,
+		},
+		{
+			name:      "when the plugin config map is invalid, an error is returned",
+			item:      `{"apiVersion":"admissionregistration.k8s.io/v1","kind":"ValidatingWebhookConfiguration","metadata":{"name":"webhook-1"},"webhooks":[]}`,
+			configMap: pluginConfig("mode", "remap"),
+			wantErr:   "webhook CA bundle mode remap requires at least one mapping",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			a := NewWebhookCABundleAction(logrus.StandardLogger(), clientset.CoreV1().ConfigMaps("velero"))
+
+			if tc.configMap != nil {
+				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			res, err := a.Execute(&velero.RestoreItemActionExecuteInput{
+				Item: velerotest.UnstructuredOrDie(tc.item),
+			})
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, velerotest.UnstructuredOrDie(tc.want), res.UpdatedItem)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// workloadReadinessPollInterval is how often a restore checks whether the
+// restored workloads it's waiting for have become ready.
+var workloadReadinessPollInterval = 5 * time.Second
+
+// restoredWorkload is a restored workload that a restore waits for to become
+// ready, for up to timeout after its items have been restored.
+type restoredWorkload struct {
+	velero.ResourceIdentifier
+	timeout time.Duration
+}
+
+// workloadTimeout returns how long a restore waits for its restored items of
+// a resource to become ready, or false if it doesn't wait for them.
+func workloadTimeout(wait *velerov1api.WorkloadReadinessWait, groupResource schema.GroupResource) (time.Duration, bool) {
+	if wait == nil {
+		return 0, false
+	}
+
+	var timeout *metav1.Duration
+	switch groupResource {
+	case kuberesource.Deployments:
+		timeout = wait.Deployments
+	case kuberesource.StatefulSets:
+		timeout = wait.StatefulSets
+	case kuberesource.DaemonSets:
+		timeout = wait.DaemonSets
+	}
+	if timeout == nil {
+		return 0, false
+	}
+	return timeout.Duration, true
+}
+
+// ValidateWaitForWorkloads checks that none of a restore's workload readiness
+// timeouts are negative.
+func ValidateWaitForWorkloads(wait *velerov1api.WorkloadReadinessWait) []error {
+	if wait == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, timeout := range []struct {
+		name     string
+		duration *metav1.Duration
+	}{
+		{"deployments", wait.Deployments},
+		{"statefulSets", wait.StatefulSets},
+		{"daemonSets", wait.DaemonSets},
+	} {
+		if timeout.duration != nil && timeout.duration.Duration < 0 {
+			errs = append(errs, errors.Errorf("%s timeout %v must not be negative", timeout.name, timeout.duration.Duration))
+		}
+	}
+	return errs
+}
+
+// recordRestoredWorkload adds a restored item to the workloads the restore
+// waits for, if the restore waits for items of its resource.
+func (ctx *restoreContext) recordRestoredWorkload(itemKey velero.ResourceIdentifier, restoredName string) {
+	if ctx.dryRun {
+		return
+	}
+	timeout, ok := workloadTimeout(ctx.restore.Spec.WaitForWorkloads, itemKey.GroupResource)
+	if !ok {
+		return
+	}
+
+	workload := restoredWorkload{ResourceIdentifier: itemKey, timeout: timeout}
+	workload.Name = restoredName
+	ctx.restoredWorkloads = append(ctx.restoredWorkloads, workload)
+}
+
+// waitForWorkloads polls the restored workloads until each of them is ready
+// or its timeout has elapsed, recording the result in the restore's workload
+// readiness. Workloads that don't become ready in time are returned as
+// warnings rather than errors, since they may still become ready later.
+func (ctx *restoreContext) waitForWorkloads() Result {
+	warnings := Result{}
+	if len(ctx.restoredWorkloads) == 0 {
+		return warnings
+	}
+
+	ctx.log.Infof("Waiting for %d restored workloads to become ready", len(ctx.restoredWorkloads))
+	start := time.Now()
+	pending := ctx.restoredWorkloads
+	for {
+		var stillPending []restoredWorkload
+		for _, workload := range pending {
+			ready, reason := ctx.workloadReady(workload.ResourceIdentifier)
+			if ready {
+				ctx.workloadReadiness.Ready++
+				continue
+			}
+			if time.Since(start) < workload.timeout {
+				stillPending = append(stillPending, workload)
+				continue
+			}
+
+			ctx.log.Warnf("%s %s/%s didn't become ready within %v: %s", workload.GroupResource, workload.Namespace, workload.Name, workload.timeout, reason)
+			warnings.Add(workload.Namespace, errors.Errorf("%s %s didn't become ready within %v: %s", workload.GroupResource, workload.Name, workload.timeout, reason))
+			ctx.workloadReadiness.NotReady = append(ctx.workloadReadiness.NotReady, velerov1api.NotReadyWorkload{
+				Resource:  workload.GroupResource.String(),
+				Namespace: workload.Namespace,
+				Name:      workload.Name,
+				Reason:    reason,
+			})
+		}
+
+		pending = stillPending
+		if len(pending) == 0 {
+			break
+		}
+		time.Sleep(workloadReadinessPollInterval)
+	}
+	ctx.log.Infof("Done waiting for restored workloads, %d of %d became ready", ctx.workloadReadiness.Ready, len(ctx.restoredWorkloads))
+
+	return warnings
+}
+
+// workloadReady gets a restored workload from the cluster and returns
+// whether it's ready, and if it isn't, why not.
+func (ctx *restoreContext) workloadReady(workload velero.ResourceIdentifier) (bool, string) {
+	resource := metav1.APIResource{Name: workload.GroupResource.Resource, Namespaced: true}
+	resourceClient, err := ctx.dynamicFactory.ClientForGroupVersionResource(schema.GroupVersion{Group: "apps", Version: "v1"}, resource, workload.Namespace)
+	if err != nil {
+		return false, fmt.Sprintf("error getting client: %v", err)
+	}
+
+	obj, err := resourceClient.Get(workload.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Sprintf("error getting %s: %v", workload.GroupResource, err)
+	}
+
+	return isWorkloadReady(workload.GroupResource, obj)
+}
+
+// isWorkloadReady returns whether a deployment's available replicas or a
+// stateful set's ready replicas have reached its desired replicas, or a
+// daemon set's available pods have reached its desired number of scheduled
+// pods, and if not, why not. A workload isn't ready until its controller has
+// observed its latest generation.
+func isWorkloadReady(groupResource schema.GroupResource, obj *unstructured.Unstructured) (bool, string) {
+	observedGeneration, _, _ := unstructured.NestedInt64(obj.UnstructuredContent(), "status", "observedGeneration")
+	if observedGeneration < obj.GetGeneration() {
+		return false, "its latest spec hasn't been observed by its controller"
+	}
+
+	desiredReplicas, found, _ := unstructured.NestedInt64(obj.UnstructuredContent(), "spec", "replicas")
+	if !found {
+		desiredReplicas = 1
+	}
+
+	switch groupResource {
+	case kuberesource.Deployments:
+		available, _, _ := unstructured.NestedInt64(obj.UnstructuredContent(), "status", "availableReplicas")
+		if available < desiredReplicas {
+			return false, fmt.Sprintf("%d of %d replicas are available", available, desiredReplicas)
+		}
+	case kuberesource.StatefulSets:
+		ready, _, _ := unstructured.NestedInt64(obj.UnstructuredContent(), "status", "readyReplicas")
+		if ready < desiredReplicas {
+			return false, fmt.Sprintf("%d of %d replicas are ready", ready, desiredReplicas)
+		}
+	case kuberesource.DaemonSets:
+		desired, _, _ := unstructured.NestedInt64(obj.UnstructuredContent(), "status", "desiredNumberScheduled")
+		available, _, _ := unstructured.NestedInt64(obj.UnstructuredContent(), "status", "numberAvailable")
+		if available < desired {
+			return false, fmt.Sprintf("%d of %d scheduled pods are available", available, desired)
+		}
+	}
+
+	return true, ""
+}
@@ -0,0 +1,163 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateWaitForWorkloads(t *testing.T) {
+	assert.Empty(t, ValidateWaitForWorkloads(nil))
+	assert.Empty(t, ValidateWaitForWorkloads(&velerov1api.WorkloadReadinessWait{Deployments: &metav1.Duration{Duration: time.Minute}}))
+
+	errs := ValidateWaitForWorkloads(&velerov1api.WorkloadReadinessWait{
+		Deployments: &metav1.Duration{Duration: time.Minute},
+		DaemonSets:  &metav1.Duration{Duration: -time.Minute},
+	})
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "daemonSets timeout -1m0s must not be negative")
+	}
+}
+
+func TestIsWorkloadReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		groupResource schema.GroupResource
+		obj           string
+		wantReady     bool
+		wantReason    string
+	}{
+		{
+			name:          "deployment with its desired replicas available is ready",
+			groupResource: kuberesource.Deployments,
+			obj:           `{"metadata": {"generation": 2}, "spec": {"replicas": 3}, "status": {"observedGeneration": 2, "availableReplicas": 3}}`,
+			wantReady:     true,
+		},
+		{
+			name:          "deployment with fewer available replicas than desired isn't ready",
+			groupResource: kuberesource.Deployments,
+			obj:           `{"metadata": {"generation": 1}, "spec": {"replicas": 3}, "status": {"observedGeneration": 1, "availableReplicas": 1}}`,
+			wantReason:    "1 of 3 replicas are available",
+		},
+		{
+			name:          "deployment without replicas defaults to one",
+			groupResource: kuberesource.Deployments,
+			obj:           `{"metadata": {"generation": 1}, "status": {"observedGeneration": 1}}`,
+			wantReason:    "0 of 1 replicas are available",
+		},
+		{
+			name:          "deployment whose latest generation hasn't been observed isn't ready",
+			groupResource: kuberesource.Deployments,
+			obj:           `{"metadata": {"generation": 2}, "spec": {"replicas": 1}, "status": {"observedGeneration": 1, "availableReplicas": 1}}`,
+			wantReason:    "its latest spec hasn't been observed by its controller",
+		},
+		{
+			name:          "stateful set with its desired replicas ready is ready",
+			groupResource: kuberesource.StatefulSets,
+			obj:           `{"metadata": {"generation": 1}, "spec": {"replicas": 2}, "status": {"observedGeneration": 1, "readyReplicas": 2}}`,
+			wantReady:     true,
+		},
+		{
+			name:          "stateful set with fewer ready replicas than desired isn't ready",
+			groupResource: kuberesource.StatefulSets,
+			obj:           `{"metadata": {"generation": 1}, "spec": {"replicas": 2}, "status": {"observedGeneration": 1, "readyReplicas": 1}}`,
+			wantReason:    "1 of 2 replicas are ready",
+		},
+		{
+			name:          "scaled down stateful set is ready",
+			groupResource: kuberesource.StatefulSets,
+			obj:           `{"metadata": {"generation": 1}, "spec": {"replicas": 0}, "status": {"observedGeneration": 1}}`,
+			wantReady:     true,
+		},
+		{
+			name:          "daemon set with all of its scheduled pods available is ready",
+			groupResource: kuberesource.DaemonSets,
+			obj:           `{"metadata": {"generation": 1}, "status": {"observedGeneration": 1, "desiredNumberScheduled": 3, "numberAvailable": 3}}`,
+			wantReady:     true,
+		},
+		{
+			name:          "daemon set with fewer available pods than scheduled isn't ready",
+			groupResource: kuberesource.DaemonSets,
+			obj:           `{"metadata": {"generation": 1}, "status": {"observedGeneration": 1, "desiredNumberScheduled": 3, "numberAvailable": 2}}`,
+			wantReason:    "2 of 3 scheduled pods are available",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ready, reason := isWorkloadReady(tc.groupResource, velerotest.UnstructuredOrDie(tc.obj))
+			assert.Equal(t, tc.wantReady, ready)
+			assert.Equal(t, tc.wantReason, reason)
+		})
+	}
+}
+
+func TestWaitForWorkloads(t *testing.T) {
+	defer func(interval time.Duration) { workloadReadinessPollInterval = interval }(workloadReadinessPollInterval)
+	workloadReadinessPollInterval = 10 * time.Millisecond
+
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").WaitForWorkloads(&velerov1api.WorkloadReadinessWait{
+		Deployments:  &metav1.Duration{Duration: time.Minute},
+		StatefulSets: &metav1.Duration{Duration: 50 * time.Millisecond},
+	}).Result()
+
+	ready := velerotest.UnstructuredOrDie(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"namespace": "ns-1", "name": "web-restored"}, "spec": {"replicas": 1}, "status": {"availableReplicas": 1}}`)
+	notReady := velerotest.UnstructuredOrDie(`{"apiVersion": "apps/v1", "kind": "StatefulSet", "metadata": {"namespace": "ns-1", "name": "db"}, "spec": {"replicas": 1}}`)
+
+	resourceClient := &velerotest.FakeDynamicClient{}
+	resourceClient.On("Get", "web-restored", metav1.GetOptions{}).Return(ready, nil)
+	resourceClient.On("Get", "db", metav1.GetOptions{}).Return(notReady, nil)
+	dynamicFactory := &velerotest.FakeDynamicFactory{}
+	dynamicFactory.On("ClientForGroupVersionResource", schema.GroupVersion{Group: "apps", Version: "v1"}, mock.Anything, "ns-1").Return(resourceClient, nil)
+
+	ctx := &restoreContext{
+		log:               velerotest.NewLogger(),
+		restore:           restore,
+		dynamicFactory:    dynamicFactory,
+		workloadReadiness: new(velerov1api.WorkloadReadinessStatus),
+	}
+
+	// only the types the restore waits for are recorded, with their restored
+	// names.
+	ctx.recordRestoredWorkload(velero.ResourceIdentifier{GroupResource: kuberesource.Deployments, Namespace: "ns-1", Name: "web"}, "web-restored")
+	ctx.recordRestoredWorkload(velero.ResourceIdentifier{GroupResource: kuberesource.StatefulSets, Namespace: "ns-1", Name: "db"}, "db")
+	ctx.recordRestoredWorkload(velero.ResourceIdentifier{GroupResource: kuberesource.DaemonSets, Namespace: "ns-1", Name: "agent"}, "agent")
+
+	warnings := ctx.waitForWorkloads()
+
+	assert.Equal(t, Result{Namespaces: map[string][]string{
+		"ns-1": {"statefulsets.apps db didn't become ready within 50ms: 0 of 1 replicas are ready"},
+	}}, warnings)
+	assert.Equal(t, &velerov1api.WorkloadReadinessStatus{
+		Ready: 1,
+		NotReady: []velerov1api.NotReadyWorkload{
+			{Resource: "statefulsets.apps", Namespace: "ns-1", Name: "db", Reason: "0 of 1 replicas are ready"},
+		},
+	}, ctx.workloadReadiness)
+}
@@ -143,6 +143,16 @@ func (dh *FakeDiscoveryHelper) ResourceFor(input schema.GroupVersionResource) (s
 	return schema.GroupVersionResource{}, metav1.APIResource{}, errors.New("APIResource not found")
 }
 
+func (dh *FakeDiscoveryHelper) ResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	for _, resourceList := range dh.ResourceList {
+		if resourceList.GroupVersion == groupVersion {
+			return resourceList, nil
+		}
+	}
+
+	return nil, errors.New("APIResourceList not found")
+}
+
 func (dh *FakeDiscoveryHelper) APIGroups() []metav1.APIGroup {
 	return dh.APIGroupsList
 }
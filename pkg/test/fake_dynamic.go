@@ -17,6 +17,8 @@ limitations under the License.
 package test
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -48,7 +50,7 @@ func (c *FakeDynamicClient) List(options metav1.ListOptions) (*unstructured.Unst
 	return args.Get(0).(*unstructured.UnstructuredList), args.Error(1)
 }
 
-func (c *FakeDynamicClient) Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (c *FakeDynamicClient) Create(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	args := c.Called(obj)
 	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
 }
@@ -63,7 +65,7 @@ func (c *FakeDynamicClient) Get(name string, opts metav1.GetOptions) (*unstructu
 	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
 }
 
-func (c *FakeDynamicClient) Patch(name string, data []byte) (*unstructured.Unstructured, error) {
+func (c *FakeDynamicClient) Patch(ctx context.Context, name string, data []byte) (*unstructured.Unstructured, error) {
 	args := c.Called(name, data)
 	return args.Get(0).(*unstructured.Unstructured), args.Error(1)
 }
@@ -150,3 +150,13 @@ func VSLs(items ...metav1.Object) *APIResource {
 		Items:      items,
 	}
 }
+
+func APIServices(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "apiregistration.k8s.io",
+		Version:    "v1",
+		Name:       "apiservices",
+		Namespaced: false,
+		Items:      items,
+	}
+}
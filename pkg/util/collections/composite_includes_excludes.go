@@ -0,0 +1,116 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Combinator decides how a CompositeIncludesExcludes combines the
+// decisions of its filters.
+type Combinator string
+
+const (
+	// All includes an item only if every filter includes it.
+	All Combinator = "All"
+
+	// Any includes an item if at least one filter includes it.
+	Any Combinator = "Any"
+)
+
+// CompositeIncludesExcludes combines several IncludesExcludes filters of
+// the same kind of item, such as filters from different sources, into one.
+// Nil filters are ignored, and a composite without any filters includes
+// everything regardless of its combinator.
+type CompositeIncludesExcludes struct {
+	combinator Combinator
+	filters    []*IncludesExcludes
+}
+
+// NewCompositeIncludesExcludes returns a CompositeIncludesExcludes that
+// combines filters with combinator, which defaults to All if it's empty.
+func NewCompositeIncludesExcludes(combinator Combinator, filters ...*IncludesExcludes) *CompositeIncludesExcludes {
+	if combinator == "" {
+		combinator = All
+	}
+
+	c := &CompositeIncludesExcludes{combinator: combinator}
+	for _, filter := range filters {
+		if filter != nil {
+			c.filters = append(c.filters, filter)
+		}
+	}
+	return c
+}
+
+// ShouldInclude returns whether the specified item should be included
+// according to the composite's filters and combinator.
+func (c *CompositeIncludesExcludes) ShouldInclude(s string) bool {
+	include, _ := c.ShouldIncludeWithReason(s)
+	return include
+}
+
+// ShouldIncludeWithReason returns whether the specified item should be
+// included or not, along with the reason given by the filter that decided
+// it, or by every filter if none of them did on its own.
+func (c *CompositeIncludesExcludes) ShouldIncludeWithReason(s string) (bool, string) {
+	if len(c.filters) == 0 {
+		return true, "no filters"
+	}
+
+	// All is decided by the first filter that excludes the item, and Any
+	// by the first that includes it.
+	decisive := c.combinator != Any
+	var reasons []string
+	for i, filter := range c.filters {
+		include, reason := filter.ShouldIncludeWithReason(s)
+		if include != decisive {
+			return include, fmt.Sprintf("filter %d: %s", i, reason)
+		}
+		reasons = append(reasons, fmt.Sprintf("filter %d: %s", i, reason))
+	}
+
+	return decisive, strings.Join(reasons, "; ")
+}
+
+// IncludeEverything returns true if the composite includes every item:
+// with All, if every filter includes everything, and with Any, if at
+// least one does. Like IncludesExcludes.IncludeEverything, it can return
+// false for filters that happen to include every item, such as an Any of
+// a filter and its opposite.
+func (c *CompositeIncludesExcludes) IncludeEverything() bool {
+	if len(c.filters) == 0 {
+		return true
+	}
+
+	if c.combinator == Any {
+		for _, filter := range c.filters {
+			if filter.IncludeEverything() {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, filter := range c.filters {
+		if !filter.IncludeEverything() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompositeShouldInclude(t *testing.T) {
+	var (
+		everything = NewIncludesExcludes().Includes("*")
+		prodOnly   = NewIncludesExcludes().Includes("prod-*")
+		noTest     = NewIncludesExcludes().Includes("*").Excludes("*-test")
+		appsOnly   = NewIncludesExcludes().Includes("regex:^(prod|dev)-app")
+	)
+
+	tests := []struct {
+		name       string
+		combinator Combinator
+		filters    []*IncludesExcludes
+		check      string
+		should     bool
+	}{
+		{
+			name:   "no filters include everything",
+			check:  "foo",
+			should: true,
+		},
+		{
+			name:       "only nil filters include everything",
+			combinator: Any,
+			filters:    []*IncludesExcludes{nil, nil},
+			check:      "foo",
+			should:     true,
+		},
+		{
+			name:       "all - included by every filter",
+			combinator: All,
+			filters:    []*IncludesExcludes{prodOnly, noTest},
+			check:      "prod-app",
+			should:     true,
+		},
+		{
+			name:       "all - excluded by one filter",
+			combinator: All,
+			filters:    []*IncludesExcludes{prodOnly, noTest},
+			check:      "prod-test",
+			should:     false,
+		},
+		{
+			name:       "all - wildcard filter doesn't widen the others",
+			combinator: All,
+			filters:    []*IncludesExcludes{everything, prodOnly},
+			check:      "dev-app",
+			should:     false,
+		},
+		{
+			name:    "empty combinator defaults to all",
+			filters: []*IncludesExcludes{everything, prodOnly},
+			check:   "dev-app",
+			should:  false,
+		},
+		{
+			name:       "any - included by one filter",
+			combinator: Any,
+			filters:    []*IncludesExcludes{prodOnly, appsOnly},
+			check:      "dev-app",
+			should:     true,
+		},
+		{
+			name:       "any - excluded by every filter",
+			combinator: Any,
+			filters:    []*IncludesExcludes{prodOnly, appsOnly},
+			check:      "dev-db",
+			should:     false,
+		},
+		{
+			name:       "any - wildcard filter includes what the others exclude",
+			combinator: Any,
+			filters:    []*IncludesExcludes{noTest, everything},
+			check:      "prod-test",
+			should:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewCompositeIncludesExcludes(tc.combinator, tc.filters...)
+			assert.Equal(t, tc.should, c.ShouldInclude(tc.check))
+		})
+	}
+}
+
+func TestCompositeShouldIncludeWithReason(t *testing.T) {
+	prodOnly := NewIncludesExcludes().Includes("prod-*")
+	noTest := NewIncludesExcludes().Excludes("*-test")
+
+	include, reason := NewCompositeIncludesExcludes(All, prodOnly, noTest).ShouldIncludeWithReason("prod-test")
+	assert.False(t, include)
+	assert.Equal(t, `filter 1: excluded by "*-test"`, reason)
+
+	include, reason = NewCompositeIncludesExcludes(All, prodOnly, noTest).ShouldIncludeWithReason("prod-app")
+	assert.True(t, include)
+	assert.Equal(t, `filter 0: included by "prod-*"; filter 1: includes list is empty`, reason)
+
+	include, reason = NewCompositeIncludesExcludes(Any, prodOnly, noTest).ShouldIncludeWithReason("dev-app")
+	assert.True(t, include)
+	assert.Equal(t, "filter 1: includes list is empty", reason)
+
+	include, reason = NewCompositeIncludesExcludes(Any, prodOnly, noTest).ShouldIncludeWithReason("dev-test")
+	assert.False(t, include)
+	assert.Equal(t, `filter 0: not in includes list; filter 1: excluded by "*-test"`, reason)
+}
+
+func TestCompositeIncludeEverything(t *testing.T) {
+	var (
+		empty      = NewIncludesExcludes()
+		everything = NewIncludesExcludes().Includes("*")
+		prodOnly   = NewIncludesExcludes().Includes("prod-*")
+		noTest     = NewIncludesExcludes().Includes("*").Excludes("*-test")
+	)
+
+	tests := []struct {
+		name       string
+		combinator Combinator
+		filters    []*IncludesExcludes
+		want       bool
+	}{
+		{
+			name: "no filters",
+			want: true,
+		},
+		{
+			name:       "all - every filter includes everything",
+			combinator: All,
+			filters:    []*IncludesExcludes{empty, everything},
+			want:       true,
+		},
+		{
+			name:       "all - one filter has excludes",
+			combinator: All,
+			filters:    []*IncludesExcludes{everything, noTest},
+			want:       false,
+		},
+		{
+			name:       "any - one filter includes everything",
+			combinator: Any,
+			filters:    []*IncludesExcludes{prodOnly, everything},
+			want:       true,
+		},
+		{
+			name:       "any - no filter includes everything",
+			combinator: Any,
+			filters:    []*IncludesExcludes{prodOnly, noTest},
+			want:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NewCompositeIncludesExcludes(tc.combinator, tc.filters...).IncludeEverything())
+		})
+	}
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package collections
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
@@ -25,46 +28,195 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/util/stringslice"
 )
 
+const (
+	// regexPrefix marks an includes/excludes entry as a regular expression
+	// rather than a glob pattern.
+	regexPrefix = "regex:"
+
+	// reincludePrefix marks an excludes entry as a re-include, i.e. an item
+	// that is included even though it matches another exclude.
+	reincludePrefix = "!"
+)
+
+// isRegexPattern returns true if the pattern should be compiled as a
+// regular expression.
+func isRegexPattern(pattern string) bool {
+	return strings.HasPrefix(pattern, regexPrefix)
+}
+
+// regexMatcher adapts a compiled regular expression to the glob.Glob
+// interface so that regex and glob patterns can be matched uniformly.
+type regexMatcher struct {
+	*regexp.Regexp
+}
+
+func (r regexMatcher) Match(s string) bool {
+	return r.MatchString(s)
+}
+
+// compilePattern compiles an includes/excludes entry. Entries prefixed with
+// "regex:" are compiled as regular expressions, all others as globs. If
+// caseInsensitive is true, the compiled pattern matches lowercased input
+// regardless of the case of the pattern.
+func compilePattern(pattern string, caseInsensitive bool) (glob.Glob, error) {
+	if isRegexPattern(pattern) {
+		expr := strings.TrimPrefix(pattern, regexPrefix)
+		if caseInsensitive {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid regex pattern %q", pattern)
+		}
+		return regexMatcher{re}, nil
+	}
+
+	expr := pattern
+	if caseInsensitive {
+		expr = strings.ToLower(expr)
+	}
+	g, err := glob.Compile(expr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid glob pattern %q", pattern)
+	}
+	return g, nil
+}
+
+// compiledPattern is the result of compiling a single includes/excludes entry.
+type compiledPattern struct {
+	glob glob.Glob
+	err  error
+}
+
+// patternCache holds compiled patterns keyed by their pattern string. It is
+// safe for concurrent use.
+type patternCache struct {
+	caseInsensitive bool
+
+	lock     sync.RWMutex
+	patterns map[string]compiledPattern
+}
+
+func newPatternCache(caseInsensitive bool) *patternCache {
+	return &patternCache{
+		caseInsensitive: caseInsensitive,
+		patterns:        make(map[string]compiledPattern),
+	}
+}
+
+// get returns the compiled form of pattern, compiling and caching it on
+// first use. Compilation errors are cached as well so that invalid patterns
+// are not recompiled on every match.
+func (c *patternCache) get(pattern string) (glob.Glob, error) {
+	c.lock.RLock()
+	compiled, found := c.patterns[pattern]
+	c.lock.RUnlock()
+	if found {
+		return compiled.glob, compiled.err
+	}
+
+	g, err := compilePattern(pattern, c.caseInsensitive)
+
+	c.lock.Lock()
+	c.patterns[pattern] = compiledPattern{glob: g, err: err}
+	c.lock.Unlock()
+
+	return g, err
+}
+
 type globStringSet struct {
 	sets.String
+	// cache is shared by all copies of the set. Since it's keyed by
+	// pattern string, patterns added by Insert are compiled lazily on
+	// their first match without invalidating existing entries.
+	cache *patternCache
 }
 
 func newGlobStringSet() globStringSet {
-	return globStringSet{sets.NewString()}
+	return globStringSet{
+		String: sets.NewString(),
+		cache:  newPatternCache(false),
+	}
 }
 
 func (gss globStringSet) match(match string) bool {
+	_, found := gss.find(match)
+	return found
+}
+
+// find returns the first item in the set that matches the provided string,
+// and whether one was found.
+func (gss globStringSet) find(match string) (string, bool) {
+	if gss.cache.caseInsensitive {
+		match = strings.ToLower(match)
+	}
+
 	for _, item := range gss.List() {
-		g, err := glob.Compile(item)
+		g, err := gss.cache.get(item)
 		if err != nil {
-			return false
+			if isRegexPattern(item) {
+				// an invalid regex is rejected by ValidateIncludesExcludes, so skip
+				// it here rather than treating it as a literal.
+				continue
+			}
+			return "", false
 		}
 		if g.Match(match) {
-			return true
+			return item, true
 		}
 	}
-	return false
+	return "", false
 }
 
 // IncludesExcludes is a type that manages lists of included
 // and excluded items. The logic implemented is that everything
 // in the included list except those items in the excluded list
 // should be included. '*' in the includes list means "include
-// everything", but it is not valid in the exclude list.
+// everything", but it is not valid in the exclude list. Items
+// prefixed with "regex:" are matched as regular expressions,
+// all other items are matched as globs.
+//
+// Items in the excluded list that are prefixed with '!' are
+// re-includes. Items in the denied list are excluded regardless
+// of the other lists, e.g. for excludes set by an administrator
+// that users can't override. The precedence used to decide
+// whether an item should be included is:
+//  1. an item matching a denied item is excluded.
+//  2. otherwise, an item matching a re-include is included, even
+//     if it matches an exclude or is not in the includes list.
+//  3. otherwise, an item matching an exclude is excluded.
+//  4. otherwise, an item is included if the includes list is
+//     empty, contains '*', or has an item matching it.
 type IncludesExcludes struct {
-	includes globStringSet
-	excludes globStringSet
+	includes   globStringSet
+	excludes   globStringSet
+	reincludes globStringSet
+	denied     globStringSet
 }
 
 func NewIncludesExcludes() *IncludesExcludes {
 	return &IncludesExcludes{
-		includes: newGlobStringSet(),
-		excludes: newGlobStringSet(),
+		includes:   newGlobStringSet(),
+		excludes:   newGlobStringSet(),
+		reincludes: newGlobStringSet(),
+		denied:     newGlobStringSet(),
 	}
 }
 
+// CaseInsensitive switches ie to matching items without regard to case.
+// The default is case-sensitive matching. GetIncludes and GetExcludes
+// still return the items as they were provided.
+func (ie *IncludesExcludes) CaseInsensitive() *IncludesExcludes {
+	ie.includes.cache = newPatternCache(true)
+	ie.excludes.cache = newPatternCache(true)
+	ie.reincludes.cache = newPatternCache(true)
+	ie.denied.cache = newPatternCache(true)
+	return ie
+}
+
 // Includes adds items to the includes list. '*' is a wildcard
 // value meaning "include everything".
 func (ie *IncludesExcludes) Includes(includes ...string) *IncludesExcludes {
@@ -72,32 +224,156 @@ func (ie *IncludesExcludes) Includes(includes ...string) *IncludesExcludes {
 	return ie
 }
 
+// IncludesByPrefix adds a glob matching each of the provided prefixes
+// to the includes list, e.g. "prod-" is added as "prod-*".
+func (ie *IncludesExcludes) IncludesByPrefix(prefixes ...string) *IncludesExcludes {
+	for _, prefix := range prefixes {
+		if !strings.HasSuffix(prefix, "*") {
+			prefix += "*"
+		}
+		ie.includes.Insert(prefix)
+	}
+	return ie
+}
+
 // GetIncludes returns the items in the includes list
 func (ie *IncludesExcludes) GetIncludes() []string {
 	return ie.includes.List()
 }
 
-// Excludes adds items to the excludes list
+// Excludes adds items to the excludes list. Items prefixed with '!'
+// are added as re-includes.
 func (ie *IncludesExcludes) Excludes(excludes ...string) *IncludesExcludes {
-	ie.excludes.Insert(excludes...)
+	for _, item := range excludes {
+		if strings.HasPrefix(item, reincludePrefix) {
+			ie.reincludes.Insert(strings.TrimPrefix(item, reincludePrefix))
+			continue
+		}
+		ie.excludes.Insert(item)
+	}
+	return ie
+}
+
+// ExcludesBySuffix adds a glob matching each of the provided suffixes
+// to the excludes list, e.g. "-test" is added as "*-test".
+func (ie *IncludesExcludes) ExcludesBySuffix(suffixes ...string) *IncludesExcludes {
+	for _, suffix := range suffixes {
+		if !strings.HasPrefix(suffix, "*") {
+			suffix = "*" + suffix
+		}
+		ie.excludes.Insert(suffix)
+	}
 	return ie
 }
 
-// GetExcludes returns the items in the excludes list
+// GetExcludes returns the items in the excludes list, including
+// re-includes prefixed with '!'.
 func (ie *IncludesExcludes) GetExcludes() []string {
-	return ie.excludes.List()
+	excludes := sets.NewString(ie.excludes.List()...)
+	for _, item := range ie.reincludes.List() {
+		excludes.Insert(reincludePrefix + item)
+	}
+	return excludes.List()
+}
+
+// Denies adds items to the denied list. Items matching them are
+// excluded even if they match a re-include.
+func (ie *IncludesExcludes) Denies(denied ...string) *IncludesExcludes {
+	ie.denied.Insert(denied...)
+	return ie
+}
+
+// GetDenied returns the items in the denied list.
+func (ie *IncludesExcludes) GetDenied() []string {
+	return ie.denied.List()
+}
+
+// Merge adds the includes, excludes and denied items of other to those of ie, and
+// returns ie. Since excludes take precedence over includes in
+// ShouldInclude, an exclude from either object suppresses a matching
+// include from the other. If either object includes '*', the merged
+// includes list is just '*'. Note that an empty includes list on one
+// side does not widen a non-empty includes list on the other. The
+// matching mode of ie is kept.
+func (ie *IncludesExcludes) Merge(other *IncludesExcludes) *IncludesExcludes {
+	if other == nil {
+		return ie
+	}
+
+	ie.includes.Insert(other.GetIncludes()...)
+	if ie.includes.Has("*") {
+		ie.includes.String = sets.NewString("*")
+	}
+
+	ie.Excludes(other.GetExcludes()...)
+	ie.Denies(other.GetDenied()...)
+
+	return ie
 }
 
 // ShouldInclude returns whether the specified item should be
 // included or not. Everything in the includes list except those
 // items in the excludes list should be included.
 func (ie *IncludesExcludes) ShouldInclude(s string) bool {
-	if ie.excludes.match(s) {
-		return false
+	include, _ := ie.ShouldIncludeWithReason(s)
+	return include
+}
+
+// ShouldIncludeWithReason returns whether the specified item should be
+// included or not, along with a human-readable description of the rule
+// that led to the decision.
+func (ie *IncludesExcludes) ShouldIncludeWithReason(s string) (bool, string) {
+	if item, found := ie.denied.find(s); found {
+		return false, fmt.Sprintf("denied by %q", item)
+	}
+
+	if item, found := ie.reincludes.find(s); found {
+		return true, fmt.Sprintf("re-included by %q", reincludePrefix+item)
+	}
+
+	if item, found := ie.excludes.find(s); found {
+		return false, fmt.Sprintf("excluded by %q", item)
 	}
 
 	// len=0 means include everything
-	return ie.includes.Len() == 0 || ie.includes.Has("*") || ie.includes.match(s)
+	if ie.includes.Len() == 0 {
+		return true, "includes list is empty"
+	}
+
+	if ie.includes.Has("*") {
+		return true, `included by "*"`
+	}
+
+	if item, found := ie.includes.find(s); found {
+		return true, fmt.Sprintf("included by %q", item)
+	}
+
+	return false, "not in includes list"
+}
+
+// ShouldExclude returns whether the specified item is explicitly excluded,
+// i.e. it matches a denied item, or an item in the excludes list and no
+// re-include. It's false for items that are only left out because they're
+// not in the includes list.
+func (ie *IncludesExcludes) ShouldExclude(s string) bool {
+	if ie.denied.match(s) {
+		return true
+	}
+	if ie.reincludes.match(s) {
+		return false
+	}
+	return ie.excludes.match(s)
+}
+
+// MatchesIncludePattern returns whether the specified item is explicitly
+// included, i.e. it matches an item in the includes list other than '*'.
+// It's false for everything if the includes list is empty or '*', even
+// though ShouldInclude is then true, and doesn't consider the excludes.
+func (ie *IncludesExcludes) MatchesIncludePattern(s string) bool {
+	if ie.includes.Len() == 0 || ie.includes.Has("*") {
+		return false
+	}
+	return ie.includes.match(s)
 }
 
 // IncludesString returns a string containing all of the includes, separated by commas, or * if the
@@ -120,9 +396,11 @@ func asString(in []string, empty string) string {
 }
 
 // IncludeEverything returns true if the includes list is empty or '*'
-// and the excludes list is empty, or false otherwise.
+// and the excludes and denied lists are empty, or false otherwise. A
+// "regex:" item is never treated as "include everything", even if it
+// would match every string.
 func (ie *IncludesExcludes) IncludeEverything() bool {
-	return ie.excludes.Len() == 0 && (ie.includes.Len() == 0 || (ie.includes.Len() == 1 && ie.includes.Has("*")))
+	return ie.excludes.Len() == 0 && ie.denied.Len() == 0 && (ie.includes.Len() == 0 || (ie.includes.Len() == 1 && ie.includes.Has("*")))
 }
 
 // ValidateIncludesExcludes checks provided lists of included and excluded
@@ -145,6 +423,20 @@ func ValidateIncludesExcludes(includesList, excludesList []string) []error {
 		errs = append(errs, errors.New("excludes list cannot contain '*'"))
 	}
 
+	if excludes.Has(reincludePrefix) || excludes.Has(reincludePrefix+"*") {
+		errs = append(errs, errors.New("excludes list cannot contain a bare '!' or '!*'"))
+	}
+
+	for _, itm := range append(includes.List(), excludes.List()...) {
+		itm = strings.TrimPrefix(itm, reincludePrefix)
+		if !isRegexPattern(itm) {
+			continue
+		}
+		if _, err := compilePattern(itm, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	for _, itm := range excludes.List() {
 		if includes.Has(itm) {
 			errs = append(errs, errors.Errorf("excludes list cannot contain an item in the includes list: %v", itm))
@@ -154,15 +446,99 @@ func ValidateIncludesExcludes(includesList, excludesList []string) []error {
 	return errs
 }
 
+// ValidateIncludesExcludesOverlap checks provided lists of included and
+// excluded items for patterns in one list that match an item in the other,
+// e.g. an include of "apps/*" and an exclude of "apps/deployments". Since
+// such an overlap is sometimes intentional, the returned errors are meant
+// to be surfaced as warnings rather than as validation failures. Items that
+// appear verbatim in both lists are reported by ValidateIncludesExcludes.
+func ValidateIncludesExcludesOverlap(includesList, excludesList []string) []error {
+	var warnings []error
+
+	includes := sets.NewString(includesList...)
+	excludes := sets.NewString(excludesList...)
+
+	for _, exc := range excludes.List() {
+		if strings.HasPrefix(exc, reincludePrefix) {
+			continue
+		}
+
+		for _, inc := range includes.List() {
+			if inc == exc || inc == "*" {
+				continue
+			}
+
+			if patternMatches(exc, inc) {
+				warnings = append(warnings, errors.Errorf("included item %v is shadowed by excluded pattern %v and will not be included", inc, exc))
+			} else if patternMatches(inc, exc) {
+				warnings = append(warnings, errors.Errorf("excluded item %v matches included pattern %v and will not be included", exc, inc))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// patternMatches returns true if pattern compiles and matches item.
+func patternMatches(pattern, item string) bool {
+	matches, err := MatchPattern(pattern, item)
+	return err == nil && matches
+}
+
+// MatchPattern returns true if item matches pattern, which is a glob or, if
+// it's prefixed with "regex:", a regular expression, as in includes/excludes
+// lists. It returns an error if the pattern can't be compiled.
+func MatchPattern(pattern, item string) (bool, error) {
+	g, err := compilePattern(pattern, false)
+	if err != nil {
+		return false, err
+	}
+	return g.Match(item), nil
+}
+
+// coreGroupItemKey is the group of the core API group's resources in item
+// keys, which would otherwise start with '/'.
+const coreGroupItemKey = "core"
+
+// ItemKey returns the key that an item filter's patterns are matched
+// against for an object, "<group>/<resource>:<namespace>/<name>". The group
+// is "core" for the core API group, and the namespace is empty for
+// cluster-scoped objects, e.g. "core/configmaps:kube-system/coredns" and
+// "rbac.authorization.k8s.io/clusterroles:/admin".
+func ItemKey(groupResource schema.GroupResource, namespace, name string) string {
+	group := groupResource.Group
+	if group == "" {
+		group = coreGroupItemKey
+	}
+	return fmt.Sprintf("%s/%s:%s/%s", group, groupResource.Resource, namespace, name)
+}
+
+// ValidateItemKeyPatterns checks that the glob patterns of an item filter
+// can match item keys, which patterns without a ':', such as a resource
+// name on its own, can't unless they're '*'. "regex:" patterns aren't
+// checked.
+func ValidateItemKeyPatterns(patterns []string) []error {
+	var errs []error
+	for _, pattern := range patterns {
+		item := strings.TrimPrefix(pattern, reincludePrefix)
+		if item == "*" || isRegexPattern(item) || strings.Contains(item, ":") {
+			continue
+		}
+		errs = append(errs, errors.Errorf("pattern %q must be formatted as <group>/<resource>:<namespace>/<name>", pattern))
+	}
+	return errs
+}
+
 // GenerateIncludesExcludes constructs an IncludesExcludes struct by taking the provided
 // include/exclude slices, applying the specified mapping function to each item in them,
 // and adding the output of the function to the new struct. If the mapping function returns
-// an empty string for an item, it is omitted from the result.
+// an empty string for an item, it is omitted from the result. "regex:" items are added
+// without being passed to the mapping function.
 func GenerateIncludesExcludes(includes, excludes []string, mapFunc func(string) string) *IncludesExcludes {
 	res := NewIncludesExcludes()
 
 	for _, item := range includes {
-		if item == "*" {
+		if item == "*" || isRegexPattern(item) {
 			res.Includes(item)
 			continue
 		}
@@ -181,11 +557,24 @@ func GenerateIncludesExcludes(includes, excludes []string, mapFunc func(string)
 			continue
 		}
 
+		// re-includes are mapped without their prefix, which
+		// is then added back to the output of the function.
+		prefix := ""
+		if strings.HasPrefix(item, reincludePrefix) {
+			prefix = reincludePrefix
+			item = strings.TrimPrefix(item, reincludePrefix)
+		}
+
+		if isRegexPattern(item) {
+			res.Excludes(prefix + item)
+			continue
+		}
+
 		key := mapFunc(item)
 		if key == "" {
 			continue
 		}
-		res.Excludes(key)
+		res.Excludes(prefix + key)
 	}
 
 	return res
@@ -193,24 +582,118 @@ func GenerateIncludesExcludes(includes, excludes []string, mapFunc func(string)
 
 // GetResourceIncludesExcludes takes the lists of resources to include and exclude, uses the
 // discovery helper to resolve them to fully-qualified group-resource names, and returns an
-// IncludesExcludes list.
+// IncludesExcludes list. Items may be specified as resources, kinds or short names, optionally
+// qualified by group (e.g. "deployments", "Deployment", "deploy" or "deployments.apps").
 func GetResourceIncludesExcludes(helper discovery.Helper, includes, excludes []string) *IncludesExcludes {
+	resources, _ := GetResourceIncludesExcludesWithUnresolved(helper, includes, excludes)
+	return resources
+}
+
+// GetResourceIncludesExcludesWithUnresolved is like GetResourceIncludesExcludes, but also returns
+// the includes that could not be resolved via discovery. Unresolved items are kept as-is in the
+// returned IncludesExcludes. Excludes, wildcards and "regex:" patterns are never reported as
+// unresolved.
+func GetResourceIncludesExcludesWithUnresolved(helper discovery.Helper, includes, excludes []string) (*IncludesExcludes, []string) {
 	resources := GenerateIncludesExcludes(
 		includes,
 		excludes,
 		func(item string) string {
-			gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
-			if err != nil {
-				// If we can't resolve it, return it as-is. This prevents the generated
-				// includes-excludes list from including *everything*, if none of the includes
-				// can be resolved. ref. https://github.com/vmware-tanzu/velero/issues/2461
+			// If we can't resolve it, return it as-is. This prevents the generated
+			// includes-excludes list from including *everything*, if none of the includes
+			// can be resolved. ref. https://github.com/vmware-tanzu/velero/issues/2461
+			gr, found := resolveResource(helper, item)
+			if !found {
 				return item
 			}
-
-			gr := gvr.GroupResource()
 			return gr.String()
 		},
 	)
 
-	return resources
+	var unresolved []string
+	for _, item := range includes {
+		if isRegexPattern(item) || strings.ContainsAny(item, "*?[{") {
+			continue
+		}
+		if _, found := resolveResource(helper, item); !found {
+			unresolved = append(unresolved, item)
+		}
+	}
+
+	return resources, unresolved
+}
+
+// resolveResource resolves a resource, kind or short name, optionally qualified by group, to
+// a fully-qualified group-resource via discovery.
+func resolveResource(helper discovery.Helper, item string) (schema.GroupResource, bool) {
+	if gr, found := resolveKindOrShortName(helper, item); found {
+		return gr, true
+	}
+
+	gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
+	if err != nil {
+		return schema.GroupResource{}, false
+	}
+	return gvr.GroupResource(), true
+}
+
+// resolveKindOrShortName looks for a resource known to the discovery helper whose kind or one of
+// whose short names is the provided item. The item may be qualified by group, e.g. "Deployment.apps".
+// If it matches resources in several groups, e.g. "Deployment" in apps and extensions, the one the
+// discovery helper resolves the unqualified resource name to, from the server's preferred group,
+// is used, and if there isn't one the item is ambiguous and isn't resolved.
+func resolveKindOrShortName(helper discovery.Helper, item string) (schema.GroupResource, bool) {
+	gk := schema.ParseGroupKind(item)
+
+	var matches []schema.GroupResource
+	for _, resourceList := range helper.Resources() {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		if gk.Group != "" && gk.Group != gv.Group {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			// skip subresources, which share the kind of another resource
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+
+			if resource.Kind == gk.Kind || stringslice.Has(resource.ShortNames, gk.Kind) {
+				gr := schema.GroupResource{Group: gv.Group, Resource: resource.Name}
+				if !hasGroupResource(matches, gr) {
+					matches = append(matches, gr)
+				}
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return schema.GroupResource{}, false
+	case 1:
+		return matches[0], true
+	}
+
+	for _, match := range matches {
+		gvr, _, err := helper.ResourceFor(schema.GroupVersionResource{Resource: match.Resource})
+		if err != nil {
+			continue
+		}
+		if preferred := gvr.GroupResource(); hasGroupResource(matches, preferred) {
+			return preferred, true
+		}
+	}
+
+	return schema.GroupResource{}, false
+}
+
+func hasGroupResource(grs []schema.GroupResource, gr schema.GroupResource) bool {
+	for _, item := range grs {
+		if item == gr {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// patternFileComment starts a comment line in an includes or excludes file.
+const patternFileComment = "#"
+
+// patternLine is an item read from an includes or excludes file, along
+// with where it was read from.
+type patternLine struct {
+	path    string
+	number  int
+	pattern string
+}
+
+func (l patternLine) wrap(err error) error {
+	return errors.Wrapf(err, "%s line %d (%q)", l.path, l.number, l.pattern)
+}
+
+// NewIncludesExcludesFromFile returns an IncludesExcludes with the items in
+// the files at includesPath and excludesPath, either of which can be empty
+// for no items. A file has an item per line, and whitespace around items,
+// blank lines and lines starting with '#' are ignored. The items are
+// validated as by ValidateIncludesExcludes, and the first invalid item is
+// returned as an error identifying its file and line.
+func NewIncludesExcludesFromFile(includesPath, excludesPath string) (*IncludesExcludes, error) {
+	includes, err := readPatternFile(includesPath)
+	if err != nil {
+		return nil, err
+	}
+	excludes, err := readPatternFile(excludesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePatternLines(includes, excludes); err != nil {
+		return nil, err
+	}
+
+	ie := NewIncludesExcludes()
+	for _, line := range includes {
+		ie.Includes(line.pattern)
+	}
+	for _, line := range excludes {
+		ie.Excludes(line.pattern)
+	}
+	return ie, nil
+}
+
+func readPatternFile(path string) ([]patternLine, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	var lines []patternLine
+	scanner := bufio.NewScanner(file)
+	for number := 1; scanner.Scan(); number++ {
+		pattern := strings.TrimSpace(scanner.Text())
+		if pattern == "" || strings.HasPrefix(pattern, patternFileComment) {
+			continue
+		}
+		lines = append(lines, patternLine{path: path, number: number, pattern: pattern})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "error reading %s", path)
+	}
+
+	return lines, nil
+}
+
+// validatePatternLines runs ValidateIncludesExcludes on every item on its
+// own, and then checks the items against each other, so that an error can
+// be attributed to a line.
+func validatePatternLines(includes, excludes []patternLine) error {
+	for _, line := range includes {
+		if errs := ValidateIncludesExcludes([]string{line.pattern}, nil); len(errs) > 0 {
+			return line.wrap(errs[0])
+		}
+	}
+	for _, line := range excludes {
+		if errs := ValidateIncludesExcludes(nil, []string{line.pattern}); len(errs) > 0 {
+			return line.wrap(errs[0])
+		}
+	}
+
+	includeSet := sets.NewString(patterns(includes)...)
+
+	if includeSet.Len() > 1 && includeSet.Has("*") {
+		for _, line := range includes {
+			if line.pattern == "*" {
+				return line.wrap(errors.New("includes list must either contain '*' only, or a non-empty list of items"))
+			}
+		}
+	}
+
+	for _, line := range excludes {
+		if includeSet.Has(line.pattern) {
+			return line.wrap(errors.Errorf("excludes list cannot contain an item in the includes list: %v", line.pattern))
+		}
+	}
+
+	// the checks above cover everything ValidateIncludesExcludes checks
+	// today, so this only catches checks added to it later, which can't be
+	// attributed to a line.
+	if errs := ValidateIncludesExcludes(patterns(includes), patterns(excludes)); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+func patterns(lines []patternLine) []string {
+	res := make([]string, 0, len(lines))
+	for _, line := range lines {
+		res = append(res, line.pattern)
+	}
+	return res
+}
@@ -0,0 +1,109 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIncludesExcludesFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "includes-excludes")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+		return path
+	}
+
+	tests := []struct {
+		name         string
+		includes     string
+		excludes     string
+		wantIncludes []string
+		wantExcludes []string
+		wantErr      string
+	}{
+		{
+			name:         "no files",
+			wantIncludes: []string{},
+			wantExcludes: []string{},
+		},
+		{
+			name:         "comments, blank lines and whitespace are ignored",
+			includes:     "# shared resources\n\n  deployments.apps  \n\tregex:^config\n",
+			excludes:     "#secrets.v1\nsecrets\n!secrets-public\n",
+			wantIncludes: []string{"deployments.apps", "regex:^config"},
+			wantExcludes: []string{"!secrets-public", "secrets"},
+		},
+		{
+			name:     "invalid regex is reported with its line",
+			includes: "pods\n# comment\nregex:(\n",
+			wantErr:  `includes line 3 ("regex:("): invalid regex pattern "regex:("`,
+		},
+		{
+			name:     "wildcard exclude is reported with its line",
+			excludes: "pods\n*\n",
+			wantErr:  `excludes line 2 ("*"): excludes list cannot contain '*'`,
+		},
+		{
+			name:     "wildcard include with other items is reported with its line",
+			includes: "pods\n\n*\n",
+			wantErr:  `includes line 3 ("*"): includes list must either contain '*' only, or a non-empty list of items`,
+		},
+		{
+			name:     "item in both files is reported with its excludes line",
+			includes: "pods\nsecrets\n",
+			excludes: "configmaps\nsecrets\n",
+			wantErr:  `excludes line 2 ("secrets"): excludes list cannot contain an item in the includes list: secrets`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var includesPath, excludesPath string
+			if tc.includes != "" {
+				includesPath = writeFile("includes", tc.includes)
+			}
+			if tc.excludes != "" {
+				excludesPath = writeFile("excludes", tc.excludes)
+			}
+
+			ie, err := NewIncludesExcludesFromFile(includesPath, excludesPath)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIncludes, ie.GetIncludes())
+			assert.Equal(t, tc.wantExcludes, ie.GetExcludes())
+		})
+	}
+}
+
+func TestNewIncludesExcludesFromFileMissing(t *testing.T) {
+	_, err := NewIncludesExcludesFromFile("/nonexistent/includes", "")
+	assert.Error(t, err)
+}
@@ -17,11 +17,16 @@ limitations under the License.
 package collections
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestShouldInclude(t *testing.T) {
@@ -102,6 +107,65 @@ func TestShouldInclude(t *testing.T) {
 			check:    "bar.foo",
 			should:   true,
 		},
+		{
+			name:     "regex include",
+			includes: []string{"regex:^app-[0-9]{4}-(prod|stage)$"},
+			check:    "app-1234-prod",
+			should:   true,
+		},
+		{
+			name:     "regex include fail",
+			includes: []string{"regex:^app-[0-9]{4}-(prod|stage)$"},
+			check:    "app-12345-prod",
+			should:   false,
+		},
+		{
+			name:     "regex exclude",
+			includes: []string{"*"},
+			excludes: []string{"regex:^app-[0-9]+$"},
+			check:    "app-42",
+			should:   false,
+		},
+		{
+			name:     "regex exclude fail",
+			includes: []string{"*"},
+			excludes: []string{"regex:^app-[0-9]+$"},
+			check:    "app-foo",
+			should:   true,
+		},
+		{
+			name:     "regex and glob includes",
+			includes: []string{"regex:^foo$", "*.bar"},
+			check:    "baz.bar",
+			should:   true,
+		},
+		{
+			name:     "re-include overrides a broader exclude",
+			includes: []string{"*"},
+			excludes: []string{"*.apps", "!deployments.apps"},
+			check:    "deployments.apps",
+			should:   true,
+		},
+		{
+			name:     "re-include does not affect other excluded items",
+			includes: []string{"*"},
+			excludes: []string{"*.apps", "!deployments.apps"},
+			check:    "statefulsets.apps",
+			should:   false,
+		},
+		{
+			name:     "re-include takes precedence over includes",
+			includes: []string{"foo"},
+			excludes: []string{"!bar"},
+			check:    "bar",
+			should:   true,
+		},
+		{
+			name:     "invalid regex include does not match literally",
+			includes: []string{"regex:app-[0-9"},
+			check:    "regex:app-[0-9",
+			should:   false,
+		},
 	}
 
 	for _, test := range tests {
@@ -114,6 +178,427 @@ func TestShouldInclude(t *testing.T) {
 	}
 }
 
+func TestIncludesByPrefixExcludesBySuffix(t *testing.T) {
+	tests := []struct {
+		name             string
+		prefixes         []string
+		suffixes         []string
+		expectedIncludes string
+		expectedExcludes string
+		checks           map[string]bool
+	}{
+		{
+			name:             "prefixes and suffixes are normalized to globs",
+			prefixes:         []string{"prod-", "stage-"},
+			suffixes:         []string{"-test"},
+			expectedIncludes: "prod-*, stage-*",
+			expectedExcludes: "*-test",
+			checks: map[string]bool{
+				"prod-web":       true,
+				"stage-db":       true,
+				"prod-web-test":  false,
+				"dev-web":        false,
+				"web-prod-stage": false,
+			},
+		},
+		{
+			name:             "existing wildcards are not duplicated",
+			prefixes:         []string{"prod-*"},
+			suffixes:         []string{"*-test"},
+			expectedIncludes: "prod-*",
+			expectedExcludes: "*-test",
+			checks:           map[string]bool{"prod-web": true, "prod-test": false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ie := NewIncludesExcludes().IncludesByPrefix(test.prefixes...).ExcludesBySuffix(test.suffixes...)
+			assert.Equal(t, test.expectedIncludes, ie.IncludesString())
+			assert.Equal(t, test.expectedExcludes, ie.ExcludesString())
+			assert.Empty(t, ValidateIncludesExcludes(ie.GetIncludes(), ie.GetExcludes()))
+
+			for check, expected := range test.checks {
+				assert.Equal(t, expected, ie.ShouldInclude(check), check)
+			}
+		})
+	}
+}
+
+func TestExcludesBySuffixEmptyIsInvalid(t *testing.T) {
+	ie := NewIncludesExcludes().ExcludesBySuffix("")
+	assert.Equal(t, "*", ie.ExcludesString())
+	assert.Len(t, ValidateIncludesExcludes(ie.GetIncludes(), ie.GetExcludes()), 1)
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name             string
+		includes         []string
+		excludes         []string
+		otherIncludes    []string
+		otherExcludes    []string
+		expectedIncludes []string
+		expectedExcludes []string
+		checks           map[string]bool
+	}{
+		{
+			name:             "includes and excludes are unioned",
+			includes:         []string{"foo"},
+			excludes:         []string{"baz"},
+			otherIncludes:    []string{"bar"},
+			otherExcludes:    []string{"qux"},
+			expectedIncludes: []string{"bar", "foo"},
+			expectedExcludes: []string{"baz", "qux"},
+			checks:           map[string]bool{"foo": true, "bar": true, "baz": false, "qux": false},
+		},
+		{
+			name:             "exclude from one side suppresses include from the other",
+			includes:         []string{"foo", "bar"},
+			otherExcludes:    []string{"foo"},
+			expectedIncludes: []string{"bar", "foo"},
+			expectedExcludes: []string{"foo"},
+			checks:           map[string]bool{"foo": false, "bar": true},
+		},
+		{
+			name:             "receiver includes everything",
+			includes:         []string{"*"},
+			otherIncludes:    []string{"foo"},
+			otherExcludes:    []string{"bar"},
+			expectedIncludes: []string{"*"},
+			expectedExcludes: []string{"bar"},
+			checks:           map[string]bool{"foo": true, "baz": true, "bar": false},
+		},
+		{
+			name:             "other includes everything",
+			includes:         []string{"foo"},
+			otherIncludes:    []string{"*"},
+			expectedIncludes: []string{"*"},
+			expectedExcludes: []string{},
+			checks:           map[string]bool{"foo": true, "bar": true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ie := NewIncludesExcludes().Includes(test.includes...).Excludes(test.excludes...)
+			other := NewIncludesExcludes().Includes(test.otherIncludes...).Excludes(test.otherExcludes...)
+
+			res := ie.Merge(other)
+			assert.Same(t, ie, res)
+			assert.Equal(t, test.expectedIncludes, res.GetIncludes())
+			assert.Equal(t, test.expectedExcludes, res.GetExcludes())
+
+			for check, expected := range test.checks {
+				assert.Equal(t, expected, res.ShouldInclude(check), check)
+			}
+		})
+	}
+}
+
+func TestDenies(t *testing.T) {
+	ie := NewIncludesExcludes().Denies("events")
+	assert.False(t, ie.IncludeEverything())
+	assert.Equal(t, []string{"events"}, ie.GetDenied())
+	assert.Empty(t, ie.GetExcludes())
+
+	merged := NewIncludesExcludes().Includes("events").Merge(ie)
+	assert.Equal(t, []string{"events"}, merged.GetDenied())
+	assert.False(t, merged.ShouldInclude("events"))
+}
+
+func TestShouldIncludeWithReason(t *testing.T) {
+	tests := []struct {
+		name           string
+		includes       []string
+		excludes       []string
+		denied         []string
+		check          string
+		expected       bool
+		expectedReason string
+	}{
+		{
+			name:           "empty includes",
+			check:          "foo",
+			expected:       true,
+			expectedReason: "includes list is empty",
+		},
+		{
+			name:           "include *",
+			includes:       []string{"*"},
+			check:          "foo",
+			expected:       true,
+			expectedReason: `included by "*"`,
+		},
+		{
+			name:           "included by wildcard",
+			includes:       []string{"*.bar"},
+			check:          "foo.bar",
+			expected:       true,
+			expectedReason: `included by "*.bar"`,
+		},
+		{
+			name:           "excluded by explicit item",
+			includes:       []string{"*"},
+			excludes:       []string{"secrets"},
+			check:          "secrets",
+			expected:       false,
+			expectedReason: `excluded by "secrets"`,
+		},
+		{
+			name:           "re-included by explicit item",
+			includes:       []string{"*"},
+			excludes:       []string{"*.apps", "!deployments.apps"},
+			check:          "deployments.apps",
+			expected:       true,
+			expectedReason: `re-included by "!deployments.apps"`,
+		},
+		{
+			name:           "denied item isn't re-included",
+			includes:       []string{"*"},
+			excludes:       []string{"!events"},
+			denied:         []string{"events*"},
+			check:          "events",
+			expected:       false,
+			expectedReason: `denied by "events*"`,
+		},
+		{
+			name:           "denied item isn't included by an explicit include",
+			includes:       []string{"leases.coordination.k8s.io"},
+			denied:         []string{"leases.coordination.k8s.io"},
+			check:          "leases.coordination.k8s.io",
+			expected:       false,
+			expectedReason: `denied by "leases.coordination.k8s.io"`,
+		},
+		{
+			name:           "not in includes list",
+			includes:       []string{"foo"},
+			check:          "bar",
+			expected:       false,
+			expectedReason: "not in includes list",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ie := NewIncludesExcludes().Includes(test.includes...).Excludes(test.excludes...).Denies(test.denied...)
+			include, reason := ie.ShouldIncludeWithReason(test.check)
+			assert.Equal(t, test.expected, include)
+			assert.Equal(t, test.expectedReason, reason)
+		})
+	}
+}
+
+func TestShouldExcludeAndMatchesIncludePattern(t *testing.T) {
+	tests := []struct {
+		name           string
+		includes       []string
+		excludes       []string
+		denied         []string
+		check          string
+		shouldExclude  bool
+		matchesInclude bool
+	}{
+		{
+			name:  "empty - neither excluded nor explicitly included",
+			check: "foo",
+		},
+		{
+			name:     "include * isn't an explicit include",
+			includes: []string{"*"},
+			check:    "foo",
+		},
+		{
+			name:           "included by glob",
+			includes:       []string{"foo*", "bar"},
+			check:          "foobar",
+			matchesInclude: true,
+		},
+		{
+			name:           "included by regex",
+			includes:       []string{"regex:^ba[rz]$"},
+			check:          "baz",
+			matchesInclude: true,
+		},
+		{
+			name:     "not in includes list isn't excluded",
+			includes: []string{"foo"},
+			check:    "bar",
+		},
+		{
+			name:          "excluded by glob",
+			includes:      []string{"*"},
+			excludes:      []string{"*.apps"},
+			check:         "deployments.apps",
+			shouldExclude: true,
+		},
+		{
+			name:           "included and excluded",
+			includes:       []string{"foo*"},
+			excludes:       []string{"*bar"},
+			check:          "foobar",
+			shouldExclude:  true,
+			matchesInclude: true,
+		},
+		{
+			name:     "re-included item isn't excluded",
+			includes: []string{"*"},
+			excludes: []string{"*.apps", "!deployments.apps"},
+			check:    "deployments.apps",
+		},
+		{
+			name:          "denied item is excluded even if it's re-included",
+			includes:      []string{"*"},
+			excludes:      []string{"!events"},
+			denied:        []string{"events"},
+			check:         "events",
+			shouldExclude: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ie := NewIncludesExcludes().Includes(test.includes...).Excludes(test.excludes...).Denies(test.denied...)
+			assert.Equal(t, test.shouldExclude, ie.ShouldExclude(test.check))
+			assert.Equal(t, test.matchesInclude, ie.MatchesIncludePattern(test.check))
+		})
+	}
+}
+
+func TestItemKey(t *testing.T) {
+	assert.Equal(t, "core/configmaps:kube-system/coredns", ItemKey(schema.GroupResource{Resource: "configmaps"}, "kube-system", "coredns"))
+	assert.Equal(t, "apps/deployments:ns-1/web", ItemKey(schema.GroupResource{Group: "apps", Resource: "deployments"}, "ns-1", "web"))
+	assert.Equal(t, "rbac.authorization.k8s.io/clusterroles:/admin", ItemKey(schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}, "", "admin"))
+}
+
+func TestShouldIncludeItemKeys(t *testing.T) {
+	ie := NewIncludesExcludes().Excludes("core/configmaps:kube-system/*", "*:/*", "!core/namespaces:/*")
+
+	tests := []struct {
+		groupResource schema.GroupResource
+		namespace     string
+		name          string
+		should        bool
+	}{
+		{groupResource: schema.GroupResource{Resource: "configmaps"}, namespace: "kube-system", name: "coredns", should: false},
+		{groupResource: schema.GroupResource{Resource: "configmaps"}, namespace: "default", name: "coredns", should: true},
+		{groupResource: schema.GroupResource{Resource: "secrets"}, namespace: "kube-system", name: "coredns", should: true},
+		{groupResource: schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}, name: "gp2", should: false},
+		{groupResource: schema.GroupResource{Resource: "namespaces"}, name: "kube-system", should: true},
+	}
+
+	for _, tc := range tests {
+		key := ItemKey(tc.groupResource, tc.namespace, tc.name)
+		assert.Equal(t, tc.should, ie.ShouldInclude(key), key)
+	}
+}
+
+func TestValidateItemKeyPatterns(t *testing.T) {
+	errs := ValidateItemKeyPatterns([]string{"*", "core/configmaps:*", "!apps/*:ns-1/*", "regex:^core/", "configmaps", "!secrets"})
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `pattern "configmaps" must be formatted as <group>/<resource>:<namespace>/<name>`)
+	assert.EqualError(t, errs[1], `pattern "!secrets" must be formatted as <group>/<resource>:<namespace>/<name>`)
+}
+
+func TestMatchPattern(t *testing.T) {
+	matches, err := MatchPattern("*/mysql:*", "docker.io/library/mysql:8.0")
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	matches, err = MatchPattern("regex:^mysql:", "docker.io/library/mysql:8.0")
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	_, err = MatchPattern("regex:(", "mysql")
+	assert.Error(t, err)
+}
+
+func TestShouldIncludeCaseInsensitive(t *testing.T) {
+	tests := []struct {
+		name            string
+		includes        []string
+		excludes        []string
+		caseInsensitive bool
+		check           string
+		should          bool
+	}{
+		{
+			name:     "case-sensitive by default",
+			includes: []string{"Foo"},
+			check:    "foo",
+			should:   false,
+		},
+		{
+			name:            "case-insensitive include",
+			includes:        []string{"Foo"},
+			caseInsensitive: true,
+			check:           "fOO",
+			should:          true,
+		},
+		{
+			name:            "case-insensitive wildcard exclude",
+			includes:        []string{"*"},
+			excludes:        []string{"*.BAR"},
+			caseInsensitive: true,
+			check:           "foo.Bar",
+			should:          false,
+		},
+		{
+			name:            "case-insensitive regex include",
+			includes:        []string{"regex:^APP-[0-9]+$"},
+			caseInsensitive: true,
+			check:           "App-1",
+			should:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i := NewIncludesExcludes().Includes(test.includes...).Excludes(test.excludes...)
+			if test.caseInsensitive {
+				i.CaseInsensitive()
+			}
+			assert.Equal(t, test.should, i.ShouldInclude(test.check))
+		})
+	}
+}
+
+func TestCaseInsensitiveKeepsOriginalItems(t *testing.T) {
+	ie := NewIncludesExcludes().CaseInsensitive().Includes("Foo", "Bar").Excludes("BAZ")
+
+	assert.Equal(t, []string{"Bar", "Foo"}, ie.GetIncludes())
+	assert.Equal(t, []string{"BAZ"}, ie.GetExcludes())
+	assert.Equal(t, "Bar, Foo", ie.IncludesString())
+}
+
+func TestShouldIncludeCachesCompiledPatterns(t *testing.T) {
+	ie := NewIncludesExcludes().Includes("*.bar", "regex:^baz-[0-9]+$")
+
+	assert.True(t, ie.ShouldInclude("baz-1"))
+	assert.Len(t, ie.includes.cache.patterns, 2)
+
+	// patterns added after the first match are compiled on their next use
+	ie.Includes("qux")
+	assert.True(t, ie.ShouldInclude("qux"))
+	assert.Len(t, ie.includes.cache.patterns, 3)
+}
+
+func TestShouldIncludeConcurrent(t *testing.T) {
+	ie := NewIncludesExcludes().Includes("*.bar", "regex:^baz-[0-9]+$").Excludes("foo.*")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.True(t, ie.ShouldInclude("qux.bar"))
+			assert.True(t, ie.ShouldInclude("baz-1"))
+			assert.False(t, ie.ShouldInclude("foo.bar"))
+		}()
+	}
+	wg.Wait()
+}
+
 func TestValidateIncludesExcludes(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -146,6 +631,45 @@ func TestValidateIncludesExcludes(t *testing.T) {
 			excludes: []string{"bar"},
 			expected: []error{errors.New("excludes list cannot contain an item in the includes list: bar")},
 		},
+		{
+			name:     "re-includes are allowed",
+			includes: []string{"*"},
+			excludes: []string{"*.apps", "!deployments.apps"},
+		},
+		{
+			name:     "bare re-include not allowed",
+			includes: []string{"*"},
+			excludes: []string{"*.apps", "!"},
+			expected: []error{errors.New("excludes list cannot contain a bare '!' or '!*'")},
+		},
+		{
+			name:     "re-include everything not allowed",
+			includes: []string{"*"},
+			excludes: []string{"*.apps", "!*"},
+			expected: []error{errors.New("excludes list cannot contain a bare '!' or '!*'")},
+		},
+		{
+			name:     "invalid regex re-include is not allowed",
+			includes: []string{"*"},
+			excludes: []string{"!regex:(foo"},
+			expected: []error{errors.New("invalid regex pattern \"regex:(foo\": error parsing regexp: missing closing ): `(foo`")},
+		},
+		{
+			name:     "valid regex includes and excludes are allowed",
+			includes: []string{"regex:^app-[0-9]{4}-(prod|stage)$"},
+			excludes: []string{"regex:^app-0000-.*$"},
+		},
+		{
+			name:     "invalid regex include is not allowed",
+			includes: []string{"regex:app-[0-9"},
+			expected: []error{errors.New("invalid regex pattern \"regex:app-[0-9\": error parsing regexp: missing closing ]: `[0-9`")},
+		},
+		{
+			name:     "invalid regex exclude is not allowed",
+			includes: []string{"foo"},
+			excludes: []string{"regex:(foo"},
+			expected: []error{errors.New("invalid regex pattern \"regex:(foo\": error parsing regexp: missing closing ): `(foo`")},
+		},
 	}
 
 	for _, test := range tests {
@@ -161,6 +685,61 @@ func TestValidateIncludesExcludes(t *testing.T) {
 	}
 }
 
+func TestValidateIncludesExcludesOverlap(t *testing.T) {
+	tests := []struct {
+		name     string
+		includes []string
+		excludes []string
+		expected []error
+	}{
+		{
+			name:     "no overlap",
+			includes: []string{"apps/*"},
+			excludes: []string{"batch/jobs"},
+		},
+		{
+			name:     "include everything is not reported",
+			includes: []string{"*"},
+			excludes: []string{"apps/deployments"},
+		},
+		{
+			name:     "identical items are not reported",
+			includes: []string{"foo"},
+			excludes: []string{"foo"},
+		},
+		{
+			name:     "exclude matching an include glob",
+			includes: []string{"apps/*"},
+			excludes: []string{"apps/deployments"},
+			expected: []error{errors.New("excluded item apps/deployments matches included pattern apps/* and will not be included")},
+		},
+		{
+			name:     "exclude glob shadowing an include",
+			includes: []string{"foo.bar", "baz"},
+			excludes: []string{"*.bar"},
+			expected: []error{errors.New("included item foo.bar is shadowed by excluded pattern *.bar and will not be included")},
+		},
+		{
+			name:     "exclude regex shadowing an include",
+			includes: []string{"app-1"},
+			excludes: []string{"regex:^app-[0-9]+$"},
+			expected: []error{errors.New("included item app-1 is shadowed by excluded pattern regex:^app-[0-9]+$ and will not be included")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := ValidateIncludesExcludesOverlap(test.includes, test.excludes)
+
+			require.Equal(t, len(test.expected), len(res))
+
+			for i := 0; i < len(test.expected); i++ {
+				assert.Equal(t, test.expected[i].Error(), res[i].Error())
+			}
+		})
+	}
+}
+
 func TestIncludeExcludeString(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -183,6 +762,13 @@ func TestIncludeExcludeString(t *testing.T) {
 			expectedIncludes: "bar, foo",
 			expectedExcludes: "baz, xyz",
 		},
+		{
+			name:             "re-includes are rendered with their prefix",
+			includes:         []string{"*"},
+			excludes:         []string{"*.apps", "!deployments.apps"},
+			expectedIncludes: "*",
+			expectedExcludes: "!deployments.apps, *.apps",
+		},
 	}
 
 	for _, test := range tests {
@@ -193,3 +779,148 @@ func TestIncludeExcludeString(t *testing.T) {
 		})
 	}
 }
+
+func newDeploymentsDiscoveryHelper() *velerotest.FakeDiscoveryHelper {
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	return &velerotest.FakeDiscoveryHelper{
+		Mapper: &velerotest.FakeMapper{
+			Resources: map[schema.GroupVersionResource]schema.GroupVersionResource{
+				{Resource: "deployments"}:                deployments,
+				{Group: "apps", Resource: "deployments"}: deployments,
+			},
+		},
+		ResourceList: []*metav1.APIResourceList{
+			{
+				GroupVersion: "apps/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "deployments", Kind: "Deployment", ShortNames: []string{"deploy"}},
+					{Name: "deployments/scale", Kind: "Scale"},
+				},
+			},
+		},
+	}
+}
+
+func TestGetResourceIncludesExcludes(t *testing.T) {
+	helper := newDeploymentsDiscoveryHelper()
+
+	tests := []struct {
+		name             string
+		includes         []string
+		excludes         []string
+		expectedIncludes []string
+		expectedExcludes []string
+	}{
+		{
+			name:             "short name",
+			includes:         []string{"deploy"},
+			expectedIncludes: []string{"deployments.apps"},
+			expectedExcludes: []string{},
+		},
+		{
+			name:             "kind",
+			includes:         []string{"Deployment"},
+			expectedIncludes: []string{"deployments.apps"},
+			expectedExcludes: []string{},
+		},
+		{
+			name:             "group-qualified kind",
+			includes:         []string{"Deployment.apps"},
+			expectedIncludes: []string{"deployments.apps"},
+			expectedExcludes: []string{},
+		},
+		{
+			name:             "resource",
+			includes:         []string{"deployments"},
+			expectedIncludes: []string{"deployments.apps"},
+			expectedExcludes: []string{},
+		},
+		{
+			name:             "group-resource",
+			includes:         []string{"deployments.apps"},
+			expectedIncludes: []string{"deployments.apps"},
+			expectedExcludes: []string{},
+		},
+		{
+			name:             "kind in excludes",
+			includes:         []string{"*"},
+			excludes:         []string{"Deployment"},
+			expectedIncludes: []string{"*"},
+			expectedExcludes: []string{"deployments.apps"},
+		},
+		{
+			name:             "re-include of a kind",
+			includes:         []string{"*"},
+			excludes:         []string{"*.apps", "!Deployment"},
+			expectedIncludes: []string{"*"},
+			expectedExcludes: []string{"!deployments.apps", "*.apps"},
+		},
+		{
+			name:             "unresolvable items are kept as-is",
+			includes:         []string{"deployemnts", "Deployment.batch"},
+			expectedIncludes: []string{"Deployment.batch", "deployemnts"},
+			expectedExcludes: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := GetResourceIncludesExcludes(helper, test.includes, test.excludes)
+			assert.Equal(t, test.expectedIncludes, res.GetIncludes())
+			assert.Equal(t, test.expectedExcludes, res.GetExcludes())
+		})
+	}
+}
+
+func TestGetResourceIncludesExcludesKindInSeveralGroups(t *testing.T) {
+	resourceList := []*metav1.APIResourceList{
+		{
+			GroupVersion: "extensions/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", ShortNames: []string{"deploy"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", ShortNames: []string{"deploy"}},
+			},
+		},
+	}
+
+	// the preferred group is used, regardless of the order discovery lists the groups in.
+	helper := &velerotest.FakeDiscoveryHelper{
+		Mapper: &velerotest.FakeMapper{
+			Resources: map[schema.GroupVersionResource]schema.GroupVersionResource{
+				{Resource: "deployments"}: {Group: "apps", Version: "v1", Resource: "deployments"},
+			},
+		},
+		ResourceList: resourceList,
+	}
+	res := GetResourceIncludesExcludes(helper, []string{"Deployment", "deploy", "Deployment.extensions"}, nil)
+	assert.Equal(t, []string{"deployments.apps", "deployments.extensions"}, res.GetIncludes())
+
+	// without a preferred group, the kind is ambiguous and isn't resolved.
+	helper = &velerotest.FakeDiscoveryHelper{
+		Mapper:       &velerotest.FakeMapper{},
+		ResourceList: resourceList,
+	}
+	res, unresolved := GetResourceIncludesExcludesWithUnresolved(helper, []string{"Deployment"}, nil)
+	assert.Equal(t, []string{"Deployment"}, res.GetIncludes())
+	assert.Equal(t, []string{"Deployment"}, unresolved)
+}
+
+func TestGetResourceIncludesExcludesWithUnresolved(t *testing.T) {
+	helper := newDeploymentsDiscoveryHelper()
+
+	res, unresolved := GetResourceIncludesExcludesWithUnresolved(
+		helper,
+		[]string{"deploy", "deployemnts", "*.apps", "regex:^foo$"},
+		[]string{"Deploymnet"},
+	)
+
+	assert.Equal(t, []string{"*.apps", "deployemnts", "deployments.apps", "regex:^foo$"}, res.GetIncludes())
+	assert.Equal(t, []string{"Deploymnet"}, res.GetExcludes())
+	// only includes are reported, so the misspelled exclude isn't.
+	assert.Equal(t, []string{"deployemnts"}, unresolved)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filesystem
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/afero"
+)
+
+// NewMemoryFileSystem returns a file system that keeps its files in memory,
+// for contents small enough that writing them to disk isn't worth it. Its
+// files are lost when it's garbage collected.
+func NewMemoryFileSystem() Interface {
+	return &memoryFileSystem{fs: afero.NewMemMapFs()}
+}
+
+type memoryFileSystem struct {
+	fs afero.Fs
+}
+
+func (fs *memoryFileSystem) TempDir(dir, prefix string) (string, error) {
+	return afero.TempDir(fs.fs, dir, prefix)
+}
+
+func (fs *memoryFileSystem) MkdirAll(path string, perm os.FileMode) error {
+	return fs.fs.MkdirAll(path, perm)
+}
+
+func (fs *memoryFileSystem) Create(name string) (io.WriteCloser, error) {
+	return fs.fs.Create(name)
+}
+
+func (fs *memoryFileSystem) OpenFile(name string, flag int, perm os.FileMode) (io.WriteCloser, error) {
+	return fs.fs.OpenFile(name, flag, perm)
+}
+
+func (fs *memoryFileSystem) RemoveAll(path string) error {
+	return fs.fs.RemoveAll(path)
+}
+
+func (fs *memoryFileSystem) ReadDir(dirname string) ([]os.FileInfo, error) {
+	return afero.ReadDir(fs.fs, dirname)
+}
+
+func (fs *memoryFileSystem) ReadFile(filename string) ([]byte, error) {
+	return afero.ReadFile(fs.fs, filename)
+}
+
+func (fs *memoryFileSystem) DirExists(path string) (bool, error) {
+	return afero.DirExists(fs.fs, path)
+}
+
+func (fs *memoryFileSystem) TempFile(dir, prefix string) (NameWriteCloser, error) {
+	return afero.TempFile(fs.fs, dir, prefix)
+}
+
+func (fs *memoryFileSystem) Stat(path string) (os.FileInfo, error) {
+	return fs.fs.Stat(path)
+}
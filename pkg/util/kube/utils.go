@@ -145,6 +145,30 @@ func GetVolumeDirectory(pod *corev1api.Pod, volumeName string, pvcLister corev1l
 	return pvc.Spec.VolumeName, nil
 }
 
+// GetVolumeDeviceName gets the name of the block device on the host, under
+// /var/lib/kubelet/pods/<podUID>/volumeDevices/<volume-plugin-name>/, that
+// the specified raw block volume is mapped to. Only PVCs can be raw block
+// volumes, and their devices are named after their PVs.
+func GetVolumeDeviceName(pod *corev1api.Pod, volumeName string, pvcLister corev1listers.PersistentVolumeClaimLister) (string, error) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name != volumeName {
+			continue
+		}
+
+		if volume.PersistentVolumeClaim == nil {
+			return "", errors.New("volume isn't a persistent volume claim")
+		}
+
+		pvc, err := pvcLister.PersistentVolumeClaims(pod.Namespace).Get(volume.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return pvc.Spec.VolumeName, nil
+	}
+
+	return "", errors.New("volume not found in pod")
+}
+
 // IsCRDReady checks a CRD to see if it's ready, with both the Established and NamesAccepted conditions.
 func IsCRDReady(crd *apiextv1beta1.CustomResourceDefinition) bool {
 	var isEstablished, namesAccepted bool
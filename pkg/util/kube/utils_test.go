@@ -210,6 +210,28 @@ func TestGetVolumeDirectorySuccess(t *testing.T) {
 	}
 }
 
+func TestGetVolumeDeviceName(t *testing.T) {
+	h := newHarness(t)
+
+	pvcInformer := kubeinformers.NewSharedInformerFactoryWithOptions(h.KubeClient, 0, kubeinformers.WithNamespace("ns-1")).Core().V1().PersistentVolumeClaims()
+	require.NoError(t, pvcInformer.Informer().GetStore().Add(builder.ForPersistentVolumeClaim("ns-1", "my-pvc").VolumeName("a-pv").Result()))
+
+	pod := builder.ForPod("ns-1", "my-pod").Volumes(
+		builder.ForVolume("my-vol").PersistentVolumeClaimSource("my-pvc").Result(),
+		builder.ForVolume("scratch").Result(),
+	).Result()
+
+	name, err := GetVolumeDeviceName(pod, "my-vol", pvcInformer.Lister())
+	require.NoError(t, err)
+	assert.Equal(t, "a-pv", name)
+
+	_, err = GetVolumeDeviceName(pod, "scratch", pvcInformer.Lister())
+	assert.EqualError(t, err, "volume isn't a persistent volume claim")
+
+	_, err = GetVolumeDeviceName(pod, "missing", pvcInformer.Lister())
+	assert.EqualError(t, err, "volume not found in pod")
+}
+
 func TestIsCRDReady(t *testing.T) {
 	tests := []struct {
 		name string